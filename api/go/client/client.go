@@ -8,6 +8,7 @@ import (
 	"net/url"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client/mocks"
@@ -24,6 +25,10 @@ type Client struct {
 	auth          v1connect.AuthServiceClient
 	skill         v1connect.SkillServiceClient
 	event         v1connect.EventServiceClient
+	webhook       v1connect.WebhookServiceClient
+	audit         v1connect.AuditServiceClient
+	quota         v1connect.QuotaServiceClient
+	encryption    v1connect.EncryptionServiceClient
 }
 
 type ClientOptions struct {
@@ -45,36 +50,90 @@ func WithConnectOptions(options ...connect.ClientOption) ClientOption {
 	}
 }
 
+// TokenSource supplies the bearer token of every request, e.g. to refresh tokens that expire.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenRotateAfterHeader is sent by the daemon with responses to requests that are authenticated
+// with an API token. It tells when the token should be rotated.
+const TokenRotateAfterHeader = "Construct-Token-Rotate-After"
+
+// RotationObserver is implemented by token sources that rotate their token. They are told when
+// the daemon wants the token to be rotated.
+type RotationObserver interface {
+	ObserveRotateAfter(rotateAfter time.Time)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token(context.Context) (string, error) {
+	return string(s), nil
+}
+
 func WithAuthToken(token string) ClientOption {
+	return WithTokenSource(staticTokenSource(token))
+}
+
+func WithTokenSource(source TokenSource) ClientOption {
 	return func(o *ClientOptions) {
-		interceptor := newAuthInterceptor(token)
+		interceptor := newAuthInterceptor(source)
 		o.ConnectOptions = append(o.ConnectOptions, connect.WithInterceptors(interceptor))
 	}
 }
 
 type authInterceptor struct {
-	token string
+	source TokenSource
 }
 
-func newAuthInterceptor(token string) *authInterceptor {
-	return &authInterceptor{token: token}
+func newAuthInterceptor(source TokenSource) *authInterceptor {
+	return &authInterceptor{source: source}
 }
 
 func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		req.Header().Set("Authorization", "Bearer "+i.token)
-		return next(ctx, req)
+		token, err := i.source.Token(ctx)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		req.Header().Set("Authorization", "Bearer "+token)
+
+		resp, err := next(ctx, req)
+		if observer, ok := i.source.(RotationObserver); ok && err == nil {
+			if rotateAfter, err := time.Parse(time.RFC3339, resp.Header().Get(TokenRotateAfterHeader)); err == nil {
+				observer.ObserveRotateAfter(rotateAfter)
+			}
+		}
+		return resp, err
 	}
 }
 
 func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
 		conn := next(ctx, spec)
-		conn.RequestHeader().Set("Authorization", "Bearer "+i.token)
+		token, err := i.source.Token(ctx)
+		if err != nil {
+			return &failedStreamingClientConn{StreamingClientConn: conn, err: connect.NewError(connect.CodeUnauthenticated, err)}
+		}
+		conn.RequestHeader().Set("Authorization", "Bearer "+token)
 		return conn
 	}
 }
 
+// failedStreamingClientConn fails a stream that cannot be authenticated before anything is sent.
+type failedStreamingClientConn struct {
+	connect.StreamingClientConn
+	err error
+}
+
+func (c *failedStreamingClientConn) Send(any) error {
+	return c.err
+}
+
+func (c *failedStreamingClientConn) Receive(any) error {
+	return c.err
+}
+
 func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return next
 }
@@ -88,6 +147,16 @@ func NewClient(endpointContext EndpointContext, options ...ClientOption) (*Clien
 	}
 
 	baseURL := endpointContext.Address
+	if endpointContext.Kind == "http" && endpointContext.TLS != nil {
+		tlsConfig, err := endpointContext.TLS.ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		opts.HTTPClient.Transport = transport
+	}
+
 	if endpointContext.Kind == "unix" {
 		opts.HTTPClient.Transport = &http.Transport{
 			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
@@ -110,6 +179,10 @@ func NewClient(endpointContext EndpointContext, options ...ClientOption) (*Clien
 		auth:          v1connect.NewAuthServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 		skill:         v1connect.NewSkillServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 		event:         v1connect.NewEventServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		webhook:       v1connect.NewWebhookServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		audit:         v1connect.NewAuditServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		quota:         v1connect.NewQuotaServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
+		encryption:    v1connect.NewEncryptionServiceClient(opts.HTTPClient, baseURL, opts.ConnectOptions...),
 	}, nil
 }
 
@@ -145,6 +218,22 @@ func (c *Client) Event() v1connect.EventServiceClient {
 	return c.event
 }
 
+func (c *Client) Webhook() v1connect.WebhookServiceClient {
+	return c.webhook
+}
+
+func (c *Client) Audit() v1connect.AuditServiceClient {
+	return c.audit
+}
+
+func (c *Client) Quota() v1connect.QuotaServiceClient {
+	return c.quota
+}
+
+func (c *Client) Encryption() v1connect.EncryptionServiceClient {
+	return c.encryption
+}
+
 type MockClient struct {
 	ModelProvider *mocks.MockModelProviderServiceClient
 	Model         *mocks.MockModelServiceClient
@@ -154,6 +243,10 @@ type MockClient struct {
 	Auth          *mocks.MockAuthServiceClient
 	Skill         *mocks.MockSkillServiceClient
 	Event         *mocks.MockEventServiceClient
+	Webhook       *mocks.MockWebhookServiceClient
+	Audit         *mocks.MockAuditServiceClient
+	Quota         *mocks.MockQuotaServiceClient
+	Encryption    *mocks.MockEncryptionServiceClient
 }
 
 func NewMockClient(ctrl *gomock.Controller) *MockClient {
@@ -166,6 +259,10 @@ func NewMockClient(ctrl *gomock.Controller) *MockClient {
 		Auth:          mocks.NewMockAuthServiceClient(ctrl),
 		Skill:         mocks.NewMockSkillServiceClient(ctrl),
 		Event:         mocks.NewMockEventServiceClient(ctrl),
+		Webhook:       mocks.NewMockWebhookServiceClient(ctrl),
+		Audit:         mocks.NewMockAuditServiceClient(ctrl),
+		Quota:         mocks.NewMockQuotaServiceClient(ctrl),
+		Encryption:    mocks.NewMockEncryptionServiceClient(ctrl),
 	}
 }
 
@@ -179,6 +276,10 @@ func (c *MockClient) Client() *Client {
 		auth:          c.Auth,
 		skill:         c.Skill,
 		event:         c.Event,
+		webhook:       c.Webhook,
+		audit:         c.Audit,
+		quota:         c.Quota,
+		encryption:    c.Encryption,
 	}
 }
 
@@ -233,6 +334,7 @@ func (c *EndpointContexts) SetPrevious(contextName string) error {
 
 const (
 	AuthTypeToken    = "token"
+	AuthTypeOIDC     = "oidc"
 	KeyringRefPrefix = "keyring://construct/"
 )
 
@@ -240,6 +342,16 @@ type AuthConfig struct {
 	Type     string `yaml:"type,omitempty"`
 	Token    string `yaml:"token,omitempty"`
 	TokenRef string `yaml:"token-ref,omitempty"`
+
+	// RotateAfter is when the token of the token type should be rotated, as last announced by the
+	// daemon. The token is not rotated automatically while it is unknown.
+	RotateAfter *time.Time `yaml:"rotate-after,omitempty"`
+
+	// Issuer, ClientID and Scopes configure the OpenID Connect login of the oidc type. The
+	// credentials of the login are kept in the keyring under TokenRef.
+	Issuer   string   `yaml:"issuer,omitempty"`
+	ClientID string   `yaml:"client-id,omitempty"`
+	Scopes   []string `yaml:"scopes,omitempty"`
 }
 
 func (a *AuthConfig) Validate() error {
@@ -247,8 +359,8 @@ func (a *AuthConfig) Validate() error {
 		return nil
 	}
 
-	if a.Type != "" && a.Type != AuthTypeToken {
-		return fmt.Errorf("invalid auth type: %s (supported: %s)", a.Type, AuthTypeToken)
+	if a.Type != "" && a.Type != AuthTypeToken && a.Type != AuthTypeOIDC {
+		return fmt.Errorf("invalid auth type: %s (supported: %s, %s)", a.Type, AuthTypeToken, AuthTypeOIDC)
 	}
 
 	if a.Token != "" && a.TokenRef != "" {
@@ -263,6 +375,15 @@ func (a *AuthConfig) Validate() error {
 		return fmt.Errorf("auth type 'token' requires either token or token-ref")
 	}
 
+	if a.Type == AuthTypeOIDC {
+		if a.Issuer == "" || a.ClientID == "" {
+			return fmt.Errorf("auth type 'oidc' requires issuer and client-id")
+		}
+		if a.Token != "" || a.TokenRef == "" {
+			return fmt.Errorf("auth type 'oidc' requires token-ref and no token")
+		}
+	}
+
 	return nil
 }
 
@@ -281,6 +402,7 @@ type EndpointContext struct {
 	Address string      `yaml:"address"`
 	Kind    string      `yaml:"kind"`
 	Auth    *AuthConfig `yaml:"auth,omitempty"`
+	TLS     *TLSConfig  `yaml:"tls,omitempty"`
 }
 
 func (c *EndpointContext) Validate() error {
@@ -304,6 +426,14 @@ func (c *EndpointContext) Validate() error {
 		return fmt.Errorf("invalid auth config: %w", err)
 	}
 
+	if c.TLS != nil && c.Kind != "http" {
+		return fmt.Errorf("tls can only be configured for http contexts")
+	}
+
+	if err := c.TLS.Validate(); err != nil {
+		return fmt.Errorf("invalid tls config: %w", err)
+	}
+
 	return nil
 }
 
@@ -117,6 +117,21 @@ func (mr *MockAuthServiceClientMockRecorder) RevokeToken(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RevokeToken), arg0, arg1)
 }
 
+// RotateToken mocks base method.
+func (m *MockAuthServiceClient) RotateToken(arg0 context.Context, arg1 *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateToken", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RotateTokenResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateToken indicates an expected call of RotateToken.
+func (mr *MockAuthServiceClientMockRecorder) RotateToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateToken", reflect.TypeOf((*MockAuthServiceClient)(nil).RotateToken), arg0, arg1)
+}
+
 // MockAuthServiceHandler is a mock of AuthServiceHandler interface.
 type MockAuthServiceHandler struct {
 	ctrl     *gomock.Controller
@@ -215,3 +230,18 @@ func (mr *MockAuthServiceHandlerMockRecorder) RevokeToken(arg0, arg1 any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeToken", reflect.TypeOf((*MockAuthServiceHandler)(nil).RevokeToken), arg0, arg1)
 }
+
+// RotateToken mocks base method.
+func (m *MockAuthServiceHandler) RotateToken(arg0 context.Context, arg1 *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateToken", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RotateTokenResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateToken indicates an expected call of RotateToken.
+func (mr *MockAuthServiceHandlerMockRecorder) RotateToken(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateToken", reflect.TypeOf((*MockAuthServiceHandler)(nil).RotateToken), arg0, arg1)
+}
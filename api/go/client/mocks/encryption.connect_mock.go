@@ -0,0 +1,156 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/encryption.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/encryption.connect.go -destination=./mocks/encryption.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEncryptionServiceClient is a mock of EncryptionServiceClient interface.
+type MockEncryptionServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncryptionServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockEncryptionServiceClientMockRecorder is the mock recorder for MockEncryptionServiceClient.
+type MockEncryptionServiceClientMockRecorder struct {
+	mock *MockEncryptionServiceClient
+}
+
+// NewMockEncryptionServiceClient creates a new mock instance.
+func NewMockEncryptionServiceClient(ctrl *gomock.Controller) *MockEncryptionServiceClient {
+	mock := &MockEncryptionServiceClient{ctrl: ctrl}
+	mock.recorder = &MockEncryptionServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncryptionServiceClient) EXPECT() *MockEncryptionServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ExportEncryptionKeyset mocks base method.
+func (m *MockEncryptionServiceClient) ExportEncryptionKeyset(arg0 context.Context, arg1 *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEncryptionKeyset", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ExportEncryptionKeysetResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEncryptionKeyset indicates an expected call of ExportEncryptionKeyset.
+func (mr *MockEncryptionServiceClientMockRecorder) ExportEncryptionKeyset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEncryptionKeyset", reflect.TypeOf((*MockEncryptionServiceClient)(nil).ExportEncryptionKeyset), arg0, arg1)
+}
+
+// ImportEncryptionKeyset mocks base method.
+func (m *MockEncryptionServiceClient) ImportEncryptionKeyset(arg0 context.Context, arg1 *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEncryptionKeyset", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ImportEncryptionKeysetResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEncryptionKeyset indicates an expected call of ImportEncryptionKeyset.
+func (mr *MockEncryptionServiceClientMockRecorder) ImportEncryptionKeyset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEncryptionKeyset", reflect.TypeOf((*MockEncryptionServiceClient)(nil).ImportEncryptionKeyset), arg0, arg1)
+}
+
+// RotateEncryptionKey mocks base method.
+func (m *MockEncryptionServiceClient) RotateEncryptionKey(arg0 context.Context, arg1 *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.ServerStreamForClient[v1.RotateEncryptionKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.ServerStreamForClient[v1.RotateEncryptionKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateEncryptionKey indicates an expected call of RotateEncryptionKey.
+func (mr *MockEncryptionServiceClientMockRecorder) RotateEncryptionKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateEncryptionKey", reflect.TypeOf((*MockEncryptionServiceClient)(nil).RotateEncryptionKey), arg0, arg1)
+}
+
+// MockEncryptionServiceHandler is a mock of EncryptionServiceHandler interface.
+type MockEncryptionServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEncryptionServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockEncryptionServiceHandlerMockRecorder is the mock recorder for MockEncryptionServiceHandler.
+type MockEncryptionServiceHandlerMockRecorder struct {
+	mock *MockEncryptionServiceHandler
+}
+
+// NewMockEncryptionServiceHandler creates a new mock instance.
+func NewMockEncryptionServiceHandler(ctrl *gomock.Controller) *MockEncryptionServiceHandler {
+	mock := &MockEncryptionServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockEncryptionServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEncryptionServiceHandler) EXPECT() *MockEncryptionServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// ExportEncryptionKeyset mocks base method.
+func (m *MockEncryptionServiceHandler) ExportEncryptionKeyset(arg0 context.Context, arg1 *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEncryptionKeyset", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ExportEncryptionKeysetResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEncryptionKeyset indicates an expected call of ExportEncryptionKeyset.
+func (mr *MockEncryptionServiceHandlerMockRecorder) ExportEncryptionKeyset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEncryptionKeyset", reflect.TypeOf((*MockEncryptionServiceHandler)(nil).ExportEncryptionKeyset), arg0, arg1)
+}
+
+// ImportEncryptionKeyset mocks base method.
+func (m *MockEncryptionServiceHandler) ImportEncryptionKeyset(arg0 context.Context, arg1 *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEncryptionKeyset", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ImportEncryptionKeysetResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEncryptionKeyset indicates an expected call of ImportEncryptionKeyset.
+func (mr *MockEncryptionServiceHandlerMockRecorder) ImportEncryptionKeyset(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEncryptionKeyset", reflect.TypeOf((*MockEncryptionServiceHandler)(nil).ImportEncryptionKeyset), arg0, arg1)
+}
+
+// RotateEncryptionKey mocks base method.
+func (m *MockEncryptionServiceHandler) RotateEncryptionKey(arg0 context.Context, arg1 *connect.Request[v1.RotateEncryptionKeyRequest], arg2 *connect.ServerStream[v1.RotateEncryptionKeyResponse]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateEncryptionKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateEncryptionKey indicates an expected call of RotateEncryptionKey.
+func (mr *MockEncryptionServiceHandlerMockRecorder) RotateEncryptionKey(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateEncryptionKey", reflect.TypeOf((*MockEncryptionServiceHandler)(nil).RotateEncryptionKey), arg0, arg1, arg2)
+}
@@ -102,6 +102,21 @@ func (mr *MockModelServiceClientMockRecorder) ListModels(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockModelServiceClient)(nil).ListModels), arg0, arg1)
 }
 
+// SyncModels mocks base method.
+func (m *MockModelServiceClient) SyncModels(arg0 context.Context, arg1 *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncModels", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.SyncModelsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncModels indicates an expected call of SyncModels.
+func (mr *MockModelServiceClientMockRecorder) SyncModels(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncModels", reflect.TypeOf((*MockModelServiceClient)(nil).SyncModels), arg0, arg1)
+}
+
 // UpdateModel mocks base method.
 func (m *MockModelServiceClient) UpdateModel(arg0 context.Context, arg1 *connect.Request[v1.UpdateModelRequest]) (*connect.Response[v1.UpdateModelResponse], error) {
 	m.ctrl.T.Helper()
@@ -201,6 +216,21 @@ func (mr *MockModelServiceHandlerMockRecorder) ListModels(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModels", reflect.TypeOf((*MockModelServiceHandler)(nil).ListModels), arg0, arg1)
 }
 
+// SyncModels mocks base method.
+func (m *MockModelServiceHandler) SyncModels(arg0 context.Context, arg1 *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncModels", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.SyncModelsResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncModels indicates an expected call of SyncModels.
+func (mr *MockModelServiceHandlerMockRecorder) SyncModels(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncModels", reflect.TypeOf((*MockModelServiceHandler)(nil).SyncModels), arg0, arg1)
+}
+
 // UpdateModel mocks base method.
 func (m *MockModelServiceHandler) UpdateModel(arg0 context.Context, arg1 *connect.Request[v1.UpdateModelRequest]) (*connect.Response[v1.UpdateModelResponse], error) {
 	m.ctrl.T.Helper()
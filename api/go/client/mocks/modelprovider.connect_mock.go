@@ -42,6 +42,21 @@ func (m *MockModelProviderServiceClient) EXPECT() *MockModelProviderServiceClien
 	return m.recorder
 }
 
+// AddModelProviderKey mocks base method.
+func (m *MockModelProviderServiceClient) AddModelProviderKey(arg0 context.Context, arg1 *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModelProviderKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.AddModelProviderKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddModelProviderKey indicates an expected call of AddModelProviderKey.
+func (mr *MockModelProviderServiceClientMockRecorder) AddModelProviderKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModelProviderKey", reflect.TypeOf((*MockModelProviderServiceClient)(nil).AddModelProviderKey), arg0, arg1)
+}
+
 // CreateModelProvider mocks base method.
 func (m *MockModelProviderServiceClient) CreateModelProvider(arg0 context.Context, arg1 *connect.Request[v1.CreateModelProviderRequest]) (*connect.Response[v1.CreateModelProviderResponse], error) {
 	m.ctrl.T.Helper()
@@ -102,6 +117,36 @@ func (mr *MockModelProviderServiceClientMockRecorder) ListModelProviders(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelProviders", reflect.TypeOf((*MockModelProviderServiceClient)(nil).ListModelProviders), arg0, arg1)
 }
 
+// RetireModelProviderKey mocks base method.
+func (m *MockModelProviderServiceClient) RetireModelProviderKey(arg0 context.Context, arg1 *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetireModelProviderKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RetireModelProviderKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetireModelProviderKey indicates an expected call of RetireModelProviderKey.
+func (mr *MockModelProviderServiceClientMockRecorder) RetireModelProviderKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireModelProviderKey", reflect.TypeOf((*MockModelProviderServiceClient)(nil).RetireModelProviderKey), arg0, arg1)
+}
+
+// TestModelProvider mocks base method.
+func (m *MockModelProviderServiceClient) TestModelProvider(arg0 context.Context, arg1 *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestModelProvider", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.TestModelProviderResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestModelProvider indicates an expected call of TestModelProvider.
+func (mr *MockModelProviderServiceClientMockRecorder) TestModelProvider(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestModelProvider", reflect.TypeOf((*MockModelProviderServiceClient)(nil).TestModelProvider), arg0, arg1)
+}
+
 // UpdateModelProvider mocks base method.
 func (m *MockModelProviderServiceClient) UpdateModelProvider(arg0 context.Context, arg1 *connect.Request[v1.UpdateModelProviderRequest]) (*connect.Response[v1.UpdateModelProviderResponse], error) {
 	m.ctrl.T.Helper()
@@ -141,6 +186,21 @@ func (m *MockModelProviderServiceHandler) EXPECT() *MockModelProviderServiceHand
 	return m.recorder
 }
 
+// AddModelProviderKey mocks base method.
+func (m *MockModelProviderServiceHandler) AddModelProviderKey(arg0 context.Context, arg1 *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModelProviderKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.AddModelProviderKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddModelProviderKey indicates an expected call of AddModelProviderKey.
+func (mr *MockModelProviderServiceHandlerMockRecorder) AddModelProviderKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModelProviderKey", reflect.TypeOf((*MockModelProviderServiceHandler)(nil).AddModelProviderKey), arg0, arg1)
+}
+
 // CreateModelProvider mocks base method.
 func (m *MockModelProviderServiceHandler) CreateModelProvider(arg0 context.Context, arg1 *connect.Request[v1.CreateModelProviderRequest]) (*connect.Response[v1.CreateModelProviderResponse], error) {
 	m.ctrl.T.Helper()
@@ -201,6 +261,36 @@ func (mr *MockModelProviderServiceHandlerMockRecorder) ListModelProviders(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelProviders", reflect.TypeOf((*MockModelProviderServiceHandler)(nil).ListModelProviders), arg0, arg1)
 }
 
+// RetireModelProviderKey mocks base method.
+func (m *MockModelProviderServiceHandler) RetireModelProviderKey(arg0 context.Context, arg1 *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetireModelProviderKey", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.RetireModelProviderKeyResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetireModelProviderKey indicates an expected call of RetireModelProviderKey.
+func (mr *MockModelProviderServiceHandlerMockRecorder) RetireModelProviderKey(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireModelProviderKey", reflect.TypeOf((*MockModelProviderServiceHandler)(nil).RetireModelProviderKey), arg0, arg1)
+}
+
+// TestModelProvider mocks base method.
+func (m *MockModelProviderServiceHandler) TestModelProvider(arg0 context.Context, arg1 *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestModelProvider", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.TestModelProviderResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TestModelProvider indicates an expected call of TestModelProvider.
+func (mr *MockModelProviderServiceHandlerMockRecorder) TestModelProvider(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestModelProvider", reflect.TypeOf((*MockModelProviderServiceHandler)(nil).TestModelProvider), arg0, arg1)
+}
+
 // UpdateModelProvider mocks base method.
 func (m *MockModelProviderServiceHandler) UpdateModelProvider(arg0 context.Context, arg1 *connect.Request[v1.UpdateModelProviderRequest]) (*connect.Response[v1.UpdateModelProviderResponse], error) {
 	m.ctrl.T.Helper()
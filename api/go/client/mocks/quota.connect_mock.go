@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ../v1/v1connect/quota.connect.go
+//
+// Generated by this command:
+//
+//	mockgen -source=../v1/v1connect/quota.connect.go -destination=./mocks/quota.connect_mock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	connect "connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuotaServiceClient is a mock of QuotaServiceClient interface.
+type MockQuotaServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaServiceClientMockRecorder is the mock recorder for MockQuotaServiceClient.
+type MockQuotaServiceClientMockRecorder struct {
+	mock *MockQuotaServiceClient
+}
+
+// NewMockQuotaServiceClient creates a new mock instance.
+func NewMockQuotaServiceClient(ctrl *gomock.Controller) *MockQuotaServiceClient {
+	mock := &MockQuotaServiceClient{ctrl: ctrl}
+	mock.recorder = &MockQuotaServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaServiceClient) EXPECT() *MockQuotaServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetQuotaUsage mocks base method.
+func (m *MockQuotaServiceClient) GetQuotaUsage(arg0 context.Context, arg1 *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaUsage", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetQuotaUsageResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaUsage indicates an expected call of GetQuotaUsage.
+func (mr *MockQuotaServiceClientMockRecorder) GetQuotaUsage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaUsage", reflect.TypeOf((*MockQuotaServiceClient)(nil).GetQuotaUsage), arg0, arg1)
+}
+
+// MockQuotaServiceHandler is a mock of QuotaServiceHandler interface.
+type MockQuotaServiceHandler struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuotaServiceHandlerMockRecorder
+	isgomock struct{}
+}
+
+// MockQuotaServiceHandlerMockRecorder is the mock recorder for MockQuotaServiceHandler.
+type MockQuotaServiceHandlerMockRecorder struct {
+	mock *MockQuotaServiceHandler
+}
+
+// NewMockQuotaServiceHandler creates a new mock instance.
+func NewMockQuotaServiceHandler(ctrl *gomock.Controller) *MockQuotaServiceHandler {
+	mock := &MockQuotaServiceHandler{ctrl: ctrl}
+	mock.recorder = &MockQuotaServiceHandlerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuotaServiceHandler) EXPECT() *MockQuotaServiceHandlerMockRecorder {
+	return m.recorder
+}
+
+// GetQuotaUsage mocks base method.
+func (m *MockQuotaServiceHandler) GetQuotaUsage(arg0 context.Context, arg1 *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaUsage", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetQuotaUsageResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaUsage indicates an expected call of GetQuotaUsage.
+func (mr *MockQuotaServiceHandlerMockRecorder) GetQuotaUsage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaUsage", reflect.TypeOf((*MockQuotaServiceHandler)(nil).GetQuotaUsage), arg0, arg1)
+}
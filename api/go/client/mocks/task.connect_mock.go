@@ -72,6 +72,21 @@ func (mr *MockTaskServiceClientMockRecorder) DeleteTask(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskServiceClient)(nil).DeleteTask), arg0, arg1)
 }
 
+// GetModelCallTrace mocks base method.
+func (m *MockTaskServiceClient) GetModelCallTrace(arg0 context.Context, arg1 *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelCallTrace", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetModelCallTraceResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelCallTrace indicates an expected call of GetModelCallTrace.
+func (mr *MockTaskServiceClientMockRecorder) GetModelCallTrace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelCallTrace", reflect.TypeOf((*MockTaskServiceClient)(nil).GetModelCallTrace), arg0, arg1)
+}
+
 // GetTask mocks base method.
 func (m *MockTaskServiceClient) GetTask(arg0 context.Context, arg1 *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -102,6 +117,21 @@ func (mr *MockTaskServiceClientMockRecorder) ListTasks(arg0, arg1 any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskServiceClient)(nil).ListTasks), arg0, arg1)
 }
 
+// ShareTask mocks base method.
+func (m *MockTaskServiceClient) ShareTask(arg0 context.Context, arg1 *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ShareTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareTask indicates an expected call of ShareTask.
+func (mr *MockTaskServiceClientMockRecorder) ShareTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareTask", reflect.TypeOf((*MockTaskServiceClient)(nil).ShareTask), arg0, arg1)
+}
+
 // SuspendTask mocks base method.
 func (m *MockTaskServiceClient) SuspendTask(arg0 context.Context, arg1 *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -117,6 +147,21 @@ func (mr *MockTaskServiceClientMockRecorder) SuspendTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendTask", reflect.TypeOf((*MockTaskServiceClient)(nil).SuspendTask), arg0, arg1)
 }
 
+// UnshareTask mocks base method.
+func (m *MockTaskServiceClient) UnshareTask(arg0 context.Context, arg1 *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnshareTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UnshareTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnshareTask indicates an expected call of UnshareTask.
+func (mr *MockTaskServiceClientMockRecorder) UnshareTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnshareTask", reflect.TypeOf((*MockTaskServiceClient)(nil).UnshareTask), arg0, arg1)
+}
+
 // UpdateTask mocks base method.
 func (m *MockTaskServiceClient) UpdateTask(arg0 context.Context, arg1 *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -186,6 +231,21 @@ func (mr *MockTaskServiceHandlerMockRecorder) DeleteTask(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).DeleteTask), arg0, arg1)
 }
 
+// GetModelCallTrace mocks base method.
+func (m *MockTaskServiceHandler) GetModelCallTrace(arg0 context.Context, arg1 *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelCallTrace", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.GetModelCallTraceResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelCallTrace indicates an expected call of GetModelCallTrace.
+func (mr *MockTaskServiceHandlerMockRecorder) GetModelCallTrace(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelCallTrace", reflect.TypeOf((*MockTaskServiceHandler)(nil).GetModelCallTrace), arg0, arg1)
+}
+
 // GetTask mocks base method.
 func (m *MockTaskServiceHandler) GetTask(arg0 context.Context, arg1 *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -216,6 +276,21 @@ func (mr *MockTaskServiceHandlerMockRecorder) ListTasks(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTasks", reflect.TypeOf((*MockTaskServiceHandler)(nil).ListTasks), arg0, arg1)
 }
 
+// ShareTask mocks base method.
+func (m *MockTaskServiceHandler) ShareTask(arg0 context.Context, arg1 *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.ShareTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShareTask indicates an expected call of ShareTask.
+func (mr *MockTaskServiceHandlerMockRecorder) ShareTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).ShareTask), arg0, arg1)
+}
+
 // SuspendTask mocks base method.
 func (m *MockTaskServiceHandler) SuspendTask(arg0 context.Context, arg1 *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error) {
 	m.ctrl.T.Helper()
@@ -231,6 +306,21 @@ func (mr *MockTaskServiceHandlerMockRecorder) SuspendTask(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).SuspendTask), arg0, arg1)
 }
 
+// UnshareTask mocks base method.
+func (m *MockTaskServiceHandler) UnshareTask(arg0 context.Context, arg1 *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnshareTask", arg0, arg1)
+	ret0, _ := ret[0].(*connect.Response[v1.UnshareTaskResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnshareTask indicates an expected call of UnshareTask.
+func (mr *MockTaskServiceHandlerMockRecorder) UnshareTask(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnshareTask", reflect.TypeOf((*MockTaskServiceHandler)(nil).UnshareTask), arg0, arg1)
+}
+
 // UpdateTask mocks base method.
 func (m *MockTaskServiceHandler) UpdateTask(arg0 context.Context, arg1 *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error) {
 	m.ctrl.T.Helper()
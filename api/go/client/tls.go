@@ -0,0 +1,120 @@
+package client
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FingerprintPrefix marks the hash function of a certificate fingerprint.
+const FingerprintPrefix = "sha256:"
+
+// CertificateFingerprint returns the SHA-256 fingerprint of a DER encoded certificate.
+func CertificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return FingerprintPrefix + hex.EncodeToString(sum[:])
+}
+
+// NormalizeFingerprint accepts fingerprints with or without prefix, in any case and with colons
+// between the bytes, as printed by openssl.
+func NormalizeFingerprint(fingerprint string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(fingerprint))
+	normalized = strings.TrimPrefix(normalized, FingerprintPrefix)
+	normalized = strings.ReplaceAll(normalized, ":", "")
+
+	decoded, err := hex.DecodeString(normalized)
+	if err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid certificate fingerprint %q: expected a SHA-256 hash", fingerprint)
+	}
+
+	return FingerprintPrefix + normalized, nil
+}
+
+// TLSConfig configures how the client verifies the daemon and authenticates itself over TLS.
+type TLSConfig struct {
+	// CAFile is a PEM bundle of the certificate authorities that are trusted in addition to the
+	// system roots.
+	CAFile string `yaml:"ca-file,omitempty"`
+	// Fingerprint pins the certificate of the daemon, e.g. a self-signed one. If set, the
+	// certificate is trusted if and only if its fingerprint matches.
+	Fingerprint string `yaml:"fingerprint,omitempty"`
+	// CertFile and KeyFile are the client certificate that is presented for mutual TLS.
+	CertFile string `yaml:"cert-file,omitempty"`
+	KeyFile  string `yaml:"key-file,omitempty"`
+}
+
+func (c *TLSConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("cert-file and key-file must be specified together")
+	}
+
+	if c.Fingerprint != "" {
+		if _, err := NormalizeFingerprint(c.Fingerprint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClientConfig builds the configuration for crypto/tls.
+func (c *TLSConfig) ClientConfig() (*tls.Config, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA file %s", c.CAFile)
+		}
+		config.RootCAs = pool
+	}
+
+	if c.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	if c.Fingerprint != "" {
+		pinned, _ := NormalizeFingerprint(c.Fingerprint)
+		// The pin replaces the verification of the chain, which would fail for self-signed
+		// certificates anyway.
+		config.InsecureSkipVerify = true
+		config.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return fmt.Errorf("daemon did not present a certificate")
+			}
+			actual := CertificateFingerprint(state.PeerCertificates[0].Raw)
+			if subtle.ConstantTimeCompare([]byte(actual), []byte(pinned)) != 1 {
+				return fmt.Errorf("certificate fingerprint %s of the daemon does not match the pinned fingerprint %s", actual, pinned)
+			}
+			return nil
+		}
+	}
+
+	return config, nil
+}
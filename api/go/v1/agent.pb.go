@@ -27,6 +27,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TurnType describes what triggered a model invocation.
+type TurnType int32
+
+const (
+	// TURN_TYPE_UNSPECIFIED indicates an unknown or unset turn type.
+	TurnType_TURN_TYPE_UNSPECIFIED TurnType = 0
+	// TURN_TYPE_FIRST is the first model invocation of a task.
+	TurnType_TURN_TYPE_FIRST TurnType = 1
+	// TURN_TYPE_USER_MESSAGE is a model invocation in response to a user message.
+	TurnType_TURN_TYPE_USER_MESSAGE TurnType = 2
+	// TURN_TYPE_TOOL_RESULT is a model invocation after all tool calls succeeded.
+	TurnType_TURN_TYPE_TOOL_RESULT TurnType = 3
+	// TURN_TYPE_TOOL_ERROR is a model invocation after at least one tool call failed.
+	TurnType_TURN_TYPE_TOOL_ERROR TurnType = 4
+)
+
+// Enum value maps for TurnType.
+var (
+	TurnType_name = map[int32]string{
+		0: "TURN_TYPE_UNSPECIFIED",
+		1: "TURN_TYPE_FIRST",
+		2: "TURN_TYPE_USER_MESSAGE",
+		3: "TURN_TYPE_TOOL_RESULT",
+		4: "TURN_TYPE_TOOL_ERROR",
+	}
+	TurnType_value = map[string]int32{
+		"TURN_TYPE_UNSPECIFIED":  0,
+		"TURN_TYPE_FIRST":        1,
+		"TURN_TYPE_USER_MESSAGE": 2,
+		"TURN_TYPE_TOOL_RESULT":  3,
+		"TURN_TYPE_TOOL_ERROR":   4,
+	}
+)
+
+func (x TurnType) Enum() *TurnType {
+	p := new(TurnType)
+	*p = x
+	return p
+}
+
+func (x TurnType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TurnType) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_agent_proto_enumTypes[0].Descriptor()
+}
+
+func (TurnType) Type() protoreflect.EnumType {
+	return &file_construct_v1_agent_proto_enumTypes[0]
+}
+
+func (x TurnType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TurnType.Descriptor instead.
+func (TurnType) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{0}
+}
+
 // Agent represents a complete agent entity with metadata, specification, and status.
 type Agent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -90,7 +151,9 @@ type AgentMetadata struct {
 	// created_at is the timestamp when the agent was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at is the timestamp when the agent was last modified.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// owner is the subject of the identity that created the agent (empty for built-in agents).
+	Owner         string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -146,6 +209,13 @@ func (x *AgentMetadata) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AgentMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 // AgentSpec defines the user-configurable specification of an agent.
 type AgentSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -156,7 +226,14 @@ type AgentSpec struct {
 	// instructions define the agent's behavior and capabilities (1-10000 characters).
 	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	// model_id references the AI model that powers this agent (UUID format).
-	ModelId       string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// fallback_model_ids is the ordered list of models that take over when the primary model
+	// is overloaded, rate limited or unavailable (max 8, UUID format).
+	FallbackModelIds []string `protobuf:"bytes,5,rep,name=fallback_model_ids,json=fallbackModelIds,proto3" json:"fallback_model_ids,omitempty"`
+	// model_router selects a different model per turn, e.g. a cheaper model for summarizing tool output.
+	ModelRouter *ModelRouter `protobuf:"bytes,6,opt,name=model_router,json=modelRouter,proto3" json:"model_router,omitempty"`
+	// redaction configures how secrets are removed from the output of the agent's tools.
+	Redaction     *Redaction `protobuf:"bytes,7,opt,name=redaction,proto3" json:"redaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -219,6 +296,244 @@ func (x *AgentSpec) GetModelId() string {
 	return ""
 }
 
+func (x *AgentSpec) GetFallbackModelIds() []string {
+	if x != nil {
+		return x.FallbackModelIds
+	}
+	return nil
+}
+
+func (x *AgentSpec) GetModelRouter() *ModelRouter {
+	if x != nil {
+		return x.ModelRouter
+	}
+	return nil
+}
+
+func (x *AgentSpec) GetRedaction() *Redaction {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
+// ModelRouter selects the model that serves each model invocation of an agent.
+// Rules are evaluated in order and the first matching rule wins.
+// If no rule matches, the agent's default model is used.
+type ModelRouter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rules is the ordered list of routing rules (max 32).
+	Rules         []*ModelRouteRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelRouter) Reset() {
+	*x = ModelRouter{}
+	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelRouter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelRouter) ProtoMessage() {}
+
+func (x *ModelRouter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelRouter.ProtoReflect.Descriptor instead.
+func (*ModelRouter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ModelRouter) GetRules() []*ModelRouteRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ModelRouteRule routes a turn to a model if all of its conditions match.
+// Conditions that are not set are ignored.
+type ModelRouteRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name identifies the rule in logs and routing decisions (1-64 characters).
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// model_id references the model that serves matching turns (UUID format).
+	ModelId string `protobuf:"bytes,2,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// turn_types restricts the rule to the given kinds of turns.
+	TurnTypes []TurnType `protobuf:"varint,3,rep,packed,name=turn_types,json=turnTypes,proto3,enum=construct.v1.TurnType" json:"turn_types,omitempty"`
+	// min_prompt_tokens is the minimum estimated prompt size in tokens.
+	MinPromptTokens int64 `protobuf:"varint,4,opt,name=min_prompt_tokens,json=minPromptTokens,proto3" json:"min_prompt_tokens,omitempty"`
+	// max_prompt_tokens is the maximum estimated prompt size in tokens.
+	MaxPromptTokens int64 `protobuf:"varint,5,opt,name=max_prompt_tokens,json=maxPromptTokens,proto3" json:"max_prompt_tokens,omitempty"`
+	// min_recent_tool_failures is the minimum number of failed tool calls in the recent history.
+	MinRecentToolFailures int64 `protobuf:"varint,6,opt,name=min_recent_tool_failures,json=minRecentToolFailures,proto3" json:"min_recent_tool_failures,omitempty"`
+	// max_recent_tool_failures is the maximum number of failed tool calls in the recent history.
+	MaxRecentToolFailures *int64 `protobuf:"varint,7,opt,name=max_recent_tool_failures,json=maxRecentToolFailures,proto3,oneof" json:"max_recent_tool_failures,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ModelRouteRule) Reset() {
+	*x = ModelRouteRule{}
+	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelRouteRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelRouteRule) ProtoMessage() {}
+
+func (x *ModelRouteRule) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelRouteRule.ProtoReflect.Descriptor instead.
+func (*ModelRouteRule) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ModelRouteRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModelRouteRule) GetModelId() string {
+	if x != nil {
+		return x.ModelId
+	}
+	return ""
+}
+
+func (x *ModelRouteRule) GetTurnTypes() []TurnType {
+	if x != nil {
+		return x.TurnTypes
+	}
+	return nil
+}
+
+func (x *ModelRouteRule) GetMinPromptTokens() int64 {
+	if x != nil {
+		return x.MinPromptTokens
+	}
+	return 0
+}
+
+func (x *ModelRouteRule) GetMaxPromptTokens() int64 {
+	if x != nil {
+		return x.MaxPromptTokens
+	}
+	return 0
+}
+
+func (x *ModelRouteRule) GetMinRecentToolFailures() int64 {
+	if x != nil {
+		return x.MinRecentToolFailures
+	}
+	return 0
+}
+
+func (x *ModelRouteRule) GetMaxRecentToolFailures() int64 {
+	if x != nil && x.MaxRecentToolFailures != nil {
+		return *x.MaxRecentToolFailures
+	}
+	return 0
+}
+
+// Redaction configures how secrets are removed from the output of an agent's tools before it is
+// stored, published or sent to the model provider. Secrets with a well-known format, e.g. cloud
+// keys, tokens and private keys, and the API keys of the model providers are always redacted.
+type Redaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// disabled turns off redaction for the agent.
+	Disabled bool `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// high_entropy_strings also redacts standalone strings that look random, e.g. unknown tokens.
+	// This may also redact hashes and other random identifiers.
+	HighEntropyStrings bool `protobuf:"varint,2,opt,name=high_entropy_strings,json=highEntropyStrings,proto3" json:"high_entropy_strings,omitempty"`
+	// patterns are additional regular expressions (RE2 syntax) that match secrets. If a pattern
+	// has a capturing group, only the first group is redacted (max 32).
+	Patterns      []string `protobuf:"bytes,3,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Redaction) Reset() {
+	*x = Redaction{}
+	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Redaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redaction) ProtoMessage() {}
+
+func (x *Redaction) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Redaction.ProtoReflect.Descriptor instead.
+func (*Redaction) Descriptor() ([]byte, []int) {
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Redaction) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Redaction) GetHighEntropyStrings() bool {
+	if x != nil {
+		return x.HighEntropyStrings
+	}
+	return false
+}
+
+func (x *Redaction) GetPatterns() []string {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
 // CreateAgentRequest contains the parameters needed to create a new agent.
 type CreateAgentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -229,14 +544,21 @@ type CreateAgentRequest struct {
 	// instructions define the agent's behavior and capabilities (1-65536 characters).
 	Instructions string `protobuf:"bytes,3,opt,name=instructions,proto3" json:"instructions,omitempty"`
 	// model_id references the AI model that will power this agent (UUID format).
-	ModelId       string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	ModelId string `protobuf:"bytes,4,opt,name=model_id,json=modelId,proto3" json:"model_id,omitempty"`
+	// fallback_model_ids is the ordered list of models that take over when the primary model
+	// is overloaded, rate limited or unavailable (max 8, UUID format).
+	FallbackModelIds []string `protobuf:"bytes,5,rep,name=fallback_model_ids,json=fallbackModelIds,proto3" json:"fallback_model_ids,omitempty"`
+	// model_router selects a different model per turn, e.g. a cheaper model for summarizing tool output.
+	ModelRouter *ModelRouter `protobuf:"bytes,6,opt,name=model_router,json=modelRouter,proto3" json:"model_router,omitempty"`
+	// redaction configures how secrets are removed from the output of the agent's tools.
+	Redaction     *Redaction `protobuf:"bytes,7,opt,name=redaction,proto3" json:"redaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateAgentRequest) Reset() {
 	*x = CreateAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	mi := &file_construct_v1_agent_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -248,7 +570,7 @@ func (x *CreateAgentRequest) String() string {
 func (*CreateAgentRequest) ProtoMessage() {}
 
 func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[3]
+	mi := &file_construct_v1_agent_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -261,7 +583,7 @@ func (x *CreateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentRequest.ProtoReflect.Descriptor instead.
 func (*CreateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{3}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateAgentRequest) GetName() string {
@@ -292,6 +614,27 @@ func (x *CreateAgentRequest) GetModelId() string {
 	return ""
 }
 
+func (x *CreateAgentRequest) GetFallbackModelIds() []string {
+	if x != nil {
+		return x.FallbackModelIds
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetModelRouter() *ModelRouter {
+	if x != nil {
+		return x.ModelRouter
+	}
+	return nil
+}
+
+func (x *CreateAgentRequest) GetRedaction() *Redaction {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
 // CreateAgentResponse contains the newly created agent.
 type CreateAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -303,7 +646,7 @@ type CreateAgentResponse struct {
 
 func (x *CreateAgentResponse) Reset() {
 	*x = CreateAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	mi := &file_construct_v1_agent_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -315,7 +658,7 @@ func (x *CreateAgentResponse) String() string {
 func (*CreateAgentResponse) ProtoMessage() {}
 
 func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[4]
+	mi := &file_construct_v1_agent_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +671,7 @@ func (x *CreateAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAgentResponse.ProtoReflect.Descriptor instead.
 func (*CreateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{4}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateAgentResponse) GetAgent() *Agent {
@@ -349,7 +692,7 @@ type GetAgentRequest struct {
 
 func (x *GetAgentRequest) Reset() {
 	*x = GetAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	mi := &file_construct_v1_agent_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +704,7 @@ func (x *GetAgentRequest) String() string {
 func (*GetAgentRequest) ProtoMessage() {}
 
 func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[5]
+	mi := &file_construct_v1_agent_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +717,7 @@ func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
 func (*GetAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{5}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetAgentRequest) GetId() string {
@@ -395,7 +738,7 @@ type GetAgentResponse struct {
 
 func (x *GetAgentResponse) Reset() {
 	*x = GetAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	mi := &file_construct_v1_agent_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +750,7 @@ func (x *GetAgentResponse) String() string {
 func (*GetAgentResponse) ProtoMessage() {}
 
 func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[6]
+	mi := &file_construct_v1_agent_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +763,7 @@ func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAgentResponse.ProtoReflect.Descriptor instead.
 func (*GetAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{6}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetAgentResponse) GetAgent() *Agent {
@@ -449,7 +792,7 @@ type ListAgentsRequest struct {
 
 func (x *ListAgentsRequest) Reset() {
 	*x = ListAgentsRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[7]
+	mi := &file_construct_v1_agent_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -461,7 +804,7 @@ func (x *ListAgentsRequest) String() string {
 func (*ListAgentsRequest) ProtoMessage() {}
 
 func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[7]
+	mi := &file_construct_v1_agent_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,7 +817,7 @@ func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListAgentsRequest) GetFilter() *ListAgentsRequest_Filter {
@@ -525,7 +868,7 @@ type ListAgentsResponse struct {
 
 func (x *ListAgentsResponse) Reset() {
 	*x = ListAgentsResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[8]
+	mi := &file_construct_v1_agent_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -537,7 +880,7 @@ func (x *ListAgentsResponse) String() string {
 func (*ListAgentsResponse) ProtoMessage() {}
 
 func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[8]
+	mi := &file_construct_v1_agent_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +893,7 @@ func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
 func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{8}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListAgentsResponse) GetAgents() []*Agent {
@@ -579,14 +922,22 @@ type UpdateAgentRequest struct {
 	// instructions are the new instructions for the agent (1-65536 characters, optional).
 	Instructions *string `protobuf:"bytes,4,opt,name=instructions,proto3,oneof" json:"instructions,omitempty"`
 	// model_id is the new model reference for the agent (UUID format, optional).
-	ModelId       *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	ModelId *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	// fallback_model_ids replaces the agent's fallback models when non-empty (max 8, UUID format).
+	FallbackModelIds []string `protobuf:"bytes,6,rep,name=fallback_model_ids,json=fallbackModelIds,proto3" json:"fallback_model_ids,omitempty"`
+	// clear_fallback_model_ids removes all fallback models from the agent.
+	ClearFallbackModelIds bool `protobuf:"varint,7,opt,name=clear_fallback_model_ids,json=clearFallbackModelIds,proto3" json:"clear_fallback_model_ids,omitempty"`
+	// model_router replaces the agent's model router. A router without rules removes routing.
+	ModelRouter *ModelRouter `protobuf:"bytes,8,opt,name=model_router,json=modelRouter,proto3" json:"model_router,omitempty"`
+	// redaction replaces the agent's redaction settings.
+	Redaction     *Redaction `protobuf:"bytes,9,opt,name=redaction,proto3" json:"redaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateAgentRequest) Reset() {
 	*x = UpdateAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[9]
+	mi := &file_construct_v1_agent_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -598,7 +949,7 @@ func (x *UpdateAgentRequest) String() string {
 func (*UpdateAgentRequest) ProtoMessage() {}
 
 func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[9]
+	mi := &file_construct_v1_agent_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -611,7 +962,7 @@ func (x *UpdateAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{9}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateAgentRequest) GetId() string {
@@ -649,6 +1000,34 @@ func (x *UpdateAgentRequest) GetModelId() string {
 	return ""
 }
 
+func (x *UpdateAgentRequest) GetFallbackModelIds() []string {
+	if x != nil {
+		return x.FallbackModelIds
+	}
+	return nil
+}
+
+func (x *UpdateAgentRequest) GetClearFallbackModelIds() bool {
+	if x != nil {
+		return x.ClearFallbackModelIds
+	}
+	return false
+}
+
+func (x *UpdateAgentRequest) GetModelRouter() *ModelRouter {
+	if x != nil {
+		return x.ModelRouter
+	}
+	return nil
+}
+
+func (x *UpdateAgentRequest) GetRedaction() *Redaction {
+	if x != nil {
+		return x.Redaction
+	}
+	return nil
+}
+
 // UpdateAgentResponse contains the updated agent.
 type UpdateAgentResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -660,7 +1039,7 @@ type UpdateAgentResponse struct {
 
 func (x *UpdateAgentResponse) Reset() {
 	*x = UpdateAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[10]
+	mi := &file_construct_v1_agent_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -672,7 +1051,7 @@ func (x *UpdateAgentResponse) String() string {
 func (*UpdateAgentResponse) ProtoMessage() {}
 
 func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[10]
+	mi := &file_construct_v1_agent_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +1064,7 @@ func (x *UpdateAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAgentResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateAgentResponse) GetAgent() *Agent {
@@ -706,7 +1085,7 @@ type DeleteAgentRequest struct {
 
 func (x *DeleteAgentRequest) Reset() {
 	*x = DeleteAgentRequest{}
-	mi := &file_construct_v1_agent_proto_msgTypes[11]
+	mi := &file_construct_v1_agent_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -718,7 +1097,7 @@ func (x *DeleteAgentRequest) String() string {
 func (*DeleteAgentRequest) ProtoMessage() {}
 
 func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[11]
+	mi := &file_construct_v1_agent_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +1110,7 @@ func (x *DeleteAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAgentRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{11}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteAgentRequest) GetId() string {
@@ -750,7 +1129,7 @@ type DeleteAgentResponse struct {
 
 func (x *DeleteAgentResponse) Reset() {
 	*x = DeleteAgentResponse{}
-	mi := &file_construct_v1_agent_proto_msgTypes[12]
+	mi := &file_construct_v1_agent_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -762,7 +1141,7 @@ func (x *DeleteAgentResponse) String() string {
 func (*DeleteAgentResponse) ProtoMessage() {}
 
 func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[12]
+	mi := &file_construct_v1_agent_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1154,7 @@ func (x *DeleteAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAgentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAgentResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{12}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{15}
 }
 
 // Filter specifies criteria for narrowing the list of returned agents.
@@ -789,7 +1168,7 @@ type ListAgentsRequest_Filter struct {
 
 func (x *ListAgentsRequest_Filter) Reset() {
 	*x = ListAgentsRequest_Filter{}
-	mi := &file_construct_v1_agent_proto_msgTypes[13]
+	mi := &file_construct_v1_agent_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -801,7 +1180,7 @@ func (x *ListAgentsRequest_Filter) String() string {
 func (*ListAgentsRequest_Filter) ProtoMessage() {}
 
 func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_agent_proto_msgTypes[13]
+	mi := &file_construct_v1_agent_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1193,7 @@ func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAgentsRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListAgentsRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_construct_v1_agent_proto_rawDescGZIP(), []int{7, 0}
+	return file_construct_v1_agent_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *ListAgentsRequest_Filter) GetNames() []string {
@@ -831,25 +1210,48 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\x18construct/v1/agent.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"m\n" +
 	"\x05Agent\x127\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1b.construct.v1.AgentMetadataR\bmetadata\x12+\n" +
-	"\x04spec\x18\x02 \x01(\v2\x17.construct.v1.AgentSpecR\x04spec\"\xaf\x01\n" +
+	"\x04spec\x18\x02 \x01(\v2\x17.construct.v1.AgentSpecR\x04spec\"\xc5\x01\n" +
 	"\rAgentMetadata\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xad\x01\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\"\xe3\x02\n" +
 	"\tAgentSpec\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12/\n" +
 	"\finstructions\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\finstructions\x12#\n" +
-	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\"\xb6\x01\n" +
+	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x12?\n" +
+	"\x12fallback_model_ids\x18\x05 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\b\x18\x01\"\x05r\x03\xb0\x01\x01R\x10fallbackModelIds\x12<\n" +
+	"\fmodel_router\x18\x06 \x01(\v2\x19.construct.v1.ModelRouterR\vmodelRouter\x125\n" +
+	"\tredaction\x18\a \x01(\v2\x17.construct.v1.RedactionR\tredaction\"K\n" +
+	"\vModelRouter\x12<\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1c.construct.v1.ModelRouteRuleB\b\xbaH\x05\x92\x01\x02\x10 R\x05rules\"\xac\x03\n" +
+	"\x0eModelRouteRule\x12\x1d\n" +
+	"\x04name\x18\x01 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x04name\x12#\n" +
+	"\bmodel_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x12F\n" +
+	"\n" +
+	"turn_types\x18\x03 \x03(\x0e2\x16.construct.v1.TurnTypeB\x0f\xbaH\f\x92\x01\t\x18\x01\"\x05\x82\x01\x02\x10\x01R\tturnTypes\x123\n" +
+	"\x11min_prompt_tokens\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x0fminPromptTokens\x123\n" +
+	"\x11max_prompt_tokens\x18\x05 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x0fmaxPromptTokens\x12@\n" +
+	"\x18min_recent_tool_failures\x18\x06 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x15minRecentToolFailures\x12E\n" +
+	"\x18max_recent_tool_failures\x18\a \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x00R\x15maxRecentToolFailures\x88\x01\x01B\x1b\n" +
+	"\x19_max_recent_tool_failures\"\x85\x01\n" +
+	"\tRedaction\x12\x1a\n" +
+	"\bdisabled\x18\x01 \x01(\bR\bdisabled\x120\n" +
+	"\x14high_entropy_strings\x18\x02 \x01(\bR\x12highEntropyStrings\x12*\n" +
+	"\bpatterns\x18\x03 \x03(\tB\x0e\xbaH\v\x92\x01\b\x10 \"\x04r\x02\x10\x01R\bpatterns\"\xec\x02\n" +
 	"\x12CreateAgentRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12*\n" +
 	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12/\n" +
 	"\finstructions\x18\x03 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\finstructions\x12#\n" +
-	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\"H\n" +
+	"\bmodel_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\amodelId\x12?\n" +
+	"\x12fallback_model_ids\x18\x05 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\b\x18\x01\"\x05r\x03\xb0\x01\x01R\x10fallbackModelIds\x12<\n" +
+	"\fmodel_router\x18\x06 \x01(\v2\x19.construct.v1.ModelRouterR\vmodelRouter\x125\n" +
+	"\tredaction\x18\a \x01(\v2\x17.construct.v1.RedactionR\tredaction\"H\n" +
 	"\x13CreateAgentResponse\x121\n" +
 	"\x05agent\x18\x01 \x01(\v2\x13.construct.v1.AgentB\x06\xbaH\x03\xc8\x01\x01R\x05agent\"+\n" +
 	"\x0fGetAgentRequest\x12\x18\n" +
@@ -873,14 +1275,18 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\v_sort_order\"i\n" +
 	"\x12ListAgentsResponse\x12+\n" +
 	"\x06agents\x18\x01 \x03(\v2\x13.construct.v1.AgentR\x06agents\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9b\x02\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8a\x04\n" +
 	"\x12UpdateAgentRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x04name\x88\x01\x01\x12/\n" +
 	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aH\x01R\vdescription\x88\x01\x01\x124\n" +
 	"\finstructions\x18\x04 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04H\x02R\finstructions\x88\x01\x01\x12(\n" +
-	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\amodelId\x88\x01\x01B\a\n" +
+	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\amodelId\x88\x01\x01\x12?\n" +
+	"\x12fallback_model_ids\x18\x06 \x03(\tB\x11\xbaH\x0e\x92\x01\v\x10\b\x18\x01\"\x05r\x03\xb0\x01\x01R\x10fallbackModelIds\x127\n" +
+	"\x18clear_fallback_model_ids\x18\a \x01(\bR\x15clearFallbackModelIds\x12<\n" +
+	"\fmodel_router\x18\b \x01(\v2\x19.construct.v1.ModelRouterR\vmodelRouter\x125\n" +
+	"\tredaction\x18\t \x01(\v2\x17.construct.v1.RedactionR\tredactionB\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x0f\n" +
 	"\r_instructionsB\v\n" +
@@ -889,7 +1295,13 @@ const file_construct_v1_agent_proto_rawDesc = "" +
 	"\x05agent\x18\x01 \x01(\v2\x13.construct.v1.AgentB\x06\xbaH\x03\xc8\x01\x01R\x05agent\".\n" +
 	"\x12DeleteAgentRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x15\n" +
-	"\x13DeleteAgentResponse2\xb6\x03\n" +
+	"\x13DeleteAgentResponse*\x8b\x01\n" +
+	"\bTurnType\x12\x19\n" +
+	"\x15TURN_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTURN_TYPE_FIRST\x10\x01\x12\x1a\n" +
+	"\x16TURN_TYPE_USER_MESSAGE\x10\x02\x12\x19\n" +
+	"\x15TURN_TYPE_TOOL_RESULT\x10\x03\x12\x18\n" +
+	"\x14TURN_TYPE_TOOL_ERROR\x10\x042\xb6\x03\n" +
 	"\fAgentService\x12T\n" +
 	"\vCreateAgent\x12 .construct.v1.CreateAgentRequest\x1a!.construct.v1.CreateAgentResponse\"\x00\x12N\n" +
 	"\bGetAgent\x12\x1d.construct.v1.GetAgentRequest\x1a\x1e.construct.v1.GetAgentResponse\"\x03\x90\x02\x01\x12T\n" +
@@ -910,53 +1322,66 @@ func file_construct_v1_agent_proto_rawDescGZIP() []byte {
 	return file_construct_v1_agent_proto_rawDescData
 }
 
-var file_construct_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_construct_v1_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_construct_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_construct_v1_agent_proto_goTypes = []any{
-	(*Agent)(nil),                    // 0: construct.v1.Agent
-	(*AgentMetadata)(nil),            // 1: construct.v1.AgentMetadata
-	(*AgentSpec)(nil),                // 2: construct.v1.AgentSpec
-	(*CreateAgentRequest)(nil),       // 3: construct.v1.CreateAgentRequest
-	(*CreateAgentResponse)(nil),      // 4: construct.v1.CreateAgentResponse
-	(*GetAgentRequest)(nil),          // 5: construct.v1.GetAgentRequest
-	(*GetAgentResponse)(nil),         // 6: construct.v1.GetAgentResponse
-	(*ListAgentsRequest)(nil),        // 7: construct.v1.ListAgentsRequest
-	(*ListAgentsResponse)(nil),       // 8: construct.v1.ListAgentsResponse
-	(*UpdateAgentRequest)(nil),       // 9: construct.v1.UpdateAgentRequest
-	(*UpdateAgentResponse)(nil),      // 10: construct.v1.UpdateAgentResponse
-	(*DeleteAgentRequest)(nil),       // 11: construct.v1.DeleteAgentRequest
-	(*DeleteAgentResponse)(nil),      // 12: construct.v1.DeleteAgentResponse
-	(*ListAgentsRequest_Filter)(nil), // 13: construct.v1.ListAgentsRequest.Filter
-	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
-	(SortField)(0),                   // 15: construct.v1.SortField
-	(SortOrder)(0),                   // 16: construct.v1.SortOrder
+	(TurnType)(0),                    // 0: construct.v1.TurnType
+	(*Agent)(nil),                    // 1: construct.v1.Agent
+	(*AgentMetadata)(nil),            // 2: construct.v1.AgentMetadata
+	(*AgentSpec)(nil),                // 3: construct.v1.AgentSpec
+	(*ModelRouter)(nil),              // 4: construct.v1.ModelRouter
+	(*ModelRouteRule)(nil),           // 5: construct.v1.ModelRouteRule
+	(*Redaction)(nil),                // 6: construct.v1.Redaction
+	(*CreateAgentRequest)(nil),       // 7: construct.v1.CreateAgentRequest
+	(*CreateAgentResponse)(nil),      // 8: construct.v1.CreateAgentResponse
+	(*GetAgentRequest)(nil),          // 9: construct.v1.GetAgentRequest
+	(*GetAgentResponse)(nil),         // 10: construct.v1.GetAgentResponse
+	(*ListAgentsRequest)(nil),        // 11: construct.v1.ListAgentsRequest
+	(*ListAgentsResponse)(nil),       // 12: construct.v1.ListAgentsResponse
+	(*UpdateAgentRequest)(nil),       // 13: construct.v1.UpdateAgentRequest
+	(*UpdateAgentResponse)(nil),      // 14: construct.v1.UpdateAgentResponse
+	(*DeleteAgentRequest)(nil),       // 15: construct.v1.DeleteAgentRequest
+	(*DeleteAgentResponse)(nil),      // 16: construct.v1.DeleteAgentResponse
+	(*ListAgentsRequest_Filter)(nil), // 17: construct.v1.ListAgentsRequest.Filter
+	(*timestamppb.Timestamp)(nil),    // 18: google.protobuf.Timestamp
+	(SortField)(0),                   // 19: construct.v1.SortField
+	(SortOrder)(0),                   // 20: construct.v1.SortOrder
 }
 var file_construct_v1_agent_proto_depIdxs = []int32{
-	1,  // 0: construct.v1.Agent.metadata:type_name -> construct.v1.AgentMetadata
-	2,  // 1: construct.v1.Agent.spec:type_name -> construct.v1.AgentSpec
-	14, // 2: construct.v1.AgentMetadata.created_at:type_name -> google.protobuf.Timestamp
-	14, // 3: construct.v1.AgentMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: construct.v1.CreateAgentResponse.agent:type_name -> construct.v1.Agent
-	0,  // 5: construct.v1.GetAgentResponse.agent:type_name -> construct.v1.Agent
-	13, // 6: construct.v1.ListAgentsRequest.filter:type_name -> construct.v1.ListAgentsRequest.Filter
-	15, // 7: construct.v1.ListAgentsRequest.sort_field:type_name -> construct.v1.SortField
-	16, // 8: construct.v1.ListAgentsRequest.sort_order:type_name -> construct.v1.SortOrder
-	0,  // 9: construct.v1.ListAgentsResponse.agents:type_name -> construct.v1.Agent
-	0,  // 10: construct.v1.UpdateAgentResponse.agent:type_name -> construct.v1.Agent
-	3,  // 11: construct.v1.AgentService.CreateAgent:input_type -> construct.v1.CreateAgentRequest
-	5,  // 12: construct.v1.AgentService.GetAgent:input_type -> construct.v1.GetAgentRequest
-	7,  // 13: construct.v1.AgentService.ListAgents:input_type -> construct.v1.ListAgentsRequest
-	9,  // 14: construct.v1.AgentService.UpdateAgent:input_type -> construct.v1.UpdateAgentRequest
-	11, // 15: construct.v1.AgentService.DeleteAgent:input_type -> construct.v1.DeleteAgentRequest
-	4,  // 16: construct.v1.AgentService.CreateAgent:output_type -> construct.v1.CreateAgentResponse
-	6,  // 17: construct.v1.AgentService.GetAgent:output_type -> construct.v1.GetAgentResponse
-	8,  // 18: construct.v1.AgentService.ListAgents:output_type -> construct.v1.ListAgentsResponse
-	10, // 19: construct.v1.AgentService.UpdateAgent:output_type -> construct.v1.UpdateAgentResponse
-	12, // 20: construct.v1.AgentService.DeleteAgent:output_type -> construct.v1.DeleteAgentResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	2,  // 0: construct.v1.Agent.metadata:type_name -> construct.v1.AgentMetadata
+	3,  // 1: construct.v1.Agent.spec:type_name -> construct.v1.AgentSpec
+	18, // 2: construct.v1.AgentMetadata.created_at:type_name -> google.protobuf.Timestamp
+	18, // 3: construct.v1.AgentMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	4,  // 4: construct.v1.AgentSpec.model_router:type_name -> construct.v1.ModelRouter
+	6,  // 5: construct.v1.AgentSpec.redaction:type_name -> construct.v1.Redaction
+	5,  // 6: construct.v1.ModelRouter.rules:type_name -> construct.v1.ModelRouteRule
+	0,  // 7: construct.v1.ModelRouteRule.turn_types:type_name -> construct.v1.TurnType
+	4,  // 8: construct.v1.CreateAgentRequest.model_router:type_name -> construct.v1.ModelRouter
+	6,  // 9: construct.v1.CreateAgentRequest.redaction:type_name -> construct.v1.Redaction
+	1,  // 10: construct.v1.CreateAgentResponse.agent:type_name -> construct.v1.Agent
+	1,  // 11: construct.v1.GetAgentResponse.agent:type_name -> construct.v1.Agent
+	17, // 12: construct.v1.ListAgentsRequest.filter:type_name -> construct.v1.ListAgentsRequest.Filter
+	19, // 13: construct.v1.ListAgentsRequest.sort_field:type_name -> construct.v1.SortField
+	20, // 14: construct.v1.ListAgentsRequest.sort_order:type_name -> construct.v1.SortOrder
+	1,  // 15: construct.v1.ListAgentsResponse.agents:type_name -> construct.v1.Agent
+	4,  // 16: construct.v1.UpdateAgentRequest.model_router:type_name -> construct.v1.ModelRouter
+	6,  // 17: construct.v1.UpdateAgentRequest.redaction:type_name -> construct.v1.Redaction
+	1,  // 18: construct.v1.UpdateAgentResponse.agent:type_name -> construct.v1.Agent
+	7,  // 19: construct.v1.AgentService.CreateAgent:input_type -> construct.v1.CreateAgentRequest
+	9,  // 20: construct.v1.AgentService.GetAgent:input_type -> construct.v1.GetAgentRequest
+	11, // 21: construct.v1.AgentService.ListAgents:input_type -> construct.v1.ListAgentsRequest
+	13, // 22: construct.v1.AgentService.UpdateAgent:input_type -> construct.v1.UpdateAgentRequest
+	15, // 23: construct.v1.AgentService.DeleteAgent:input_type -> construct.v1.DeleteAgentRequest
+	8,  // 24: construct.v1.AgentService.CreateAgent:output_type -> construct.v1.CreateAgentResponse
+	10, // 25: construct.v1.AgentService.GetAgent:output_type -> construct.v1.GetAgentResponse
+	12, // 26: construct.v1.AgentService.ListAgents:output_type -> construct.v1.ListAgentsResponse
+	14, // 27: construct.v1.AgentService.UpdateAgent:output_type -> construct.v1.UpdateAgentResponse
+	16, // 28: construct.v1.AgentService.DeleteAgent:output_type -> construct.v1.DeleteAgentResponse
+	24, // [24:29] is the sub-list for method output_type
+	19, // [19:24] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_agent_proto_init() }
@@ -965,20 +1390,22 @@ func file_construct_v1_agent_proto_init() {
 		return
 	}
 	file_construct_v1_common_proto_init()
-	file_construct_v1_agent_proto_msgTypes[7].OneofWrappers = []any{}
-	file_construct_v1_agent_proto_msgTypes[9].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[4].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[10].OneofWrappers = []any{}
+	file_construct_v1_agent_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_agent_proto_rawDesc), len(file_construct_v1_agent_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_construct_v1_agent_proto_goTypes,
 		DependencyIndexes: file_construct_v1_agent_proto_depIdxs,
+		EnumInfos:         file_construct_v1_agent_proto_enumTypes,
 		MessageInfos:      file_construct_v1_agent_proto_msgTypes,
 	}.Build()
 	File_construct_v1_agent_proto = out.File
@@ -0,0 +1,659 @@
+// Audit API exposes the append-only record of changes made through the API and of the side
+// effects of the tools that agents call.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/audit.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEventKind tells what was audited.
+type AuditEventKind int32
+
+const (
+	// AUDIT_EVENT_KIND_UNSPECIFIED indicates an unknown or unset kind.
+	AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED AuditEventKind = 0
+	// AUDIT_EVENT_KIND_API indicates a call of a mutating API procedure.
+	AuditEventKind_AUDIT_EVENT_KIND_API AuditEventKind = 1
+	// AUDIT_EVENT_KIND_TOOL indicates a tool call of an agent with side effects.
+	AuditEventKind_AUDIT_EVENT_KIND_TOOL AuditEventKind = 2
+)
+
+// Enum value maps for AuditEventKind.
+var (
+	AuditEventKind_name = map[int32]string{
+		0: "AUDIT_EVENT_KIND_UNSPECIFIED",
+		1: "AUDIT_EVENT_KIND_API",
+		2: "AUDIT_EVENT_KIND_TOOL",
+	}
+	AuditEventKind_value = map[string]int32{
+		"AUDIT_EVENT_KIND_UNSPECIFIED": 0,
+		"AUDIT_EVENT_KIND_API":         1,
+		"AUDIT_EVENT_KIND_TOOL":        2,
+	}
+)
+
+func (x AuditEventKind) Enum() *AuditEventKind {
+	p := new(AuditEventKind)
+	*p = x
+	return p
+}
+
+func (x AuditEventKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditEventKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_audit_proto_enumTypes[0].Descriptor()
+}
+
+func (AuditEventKind) Type() protoreflect.EnumType {
+	return &file_construct_v1_audit_proto_enumTypes[0]
+}
+
+func (x AuditEventKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditEventKind.Descriptor instead.
+func (AuditEventKind) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+// AuditOutcome tells whether an audited action succeeded.
+type AuditOutcome int32
+
+const (
+	// AUDIT_OUTCOME_UNSPECIFIED indicates an unknown or unset outcome.
+	AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED AuditOutcome = 0
+	// AUDIT_OUTCOME_SUCCESS indicates that the action succeeded.
+	AuditOutcome_AUDIT_OUTCOME_SUCCESS AuditOutcome = 1
+	// AUDIT_OUTCOME_FAILURE indicates that the action failed.
+	AuditOutcome_AUDIT_OUTCOME_FAILURE AuditOutcome = 2
+)
+
+// Enum value maps for AuditOutcome.
+var (
+	AuditOutcome_name = map[int32]string{
+		0: "AUDIT_OUTCOME_UNSPECIFIED",
+		1: "AUDIT_OUTCOME_SUCCESS",
+		2: "AUDIT_OUTCOME_FAILURE",
+	}
+	AuditOutcome_value = map[string]int32{
+		"AUDIT_OUTCOME_UNSPECIFIED": 0,
+		"AUDIT_OUTCOME_SUCCESS":     1,
+		"AUDIT_OUTCOME_FAILURE":     2,
+	}
+)
+
+func (x AuditOutcome) Enum() *AuditOutcome {
+	p := new(AuditOutcome)
+	*p = x
+	return p
+}
+
+func (x AuditOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuditOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_audit_proto_enumTypes[1].Descriptor()
+}
+
+func (AuditOutcome) Type() protoreflect.EnumType {
+	return &file_construct_v1_audit_proto_enumTypes[1]
+}
+
+func (x AuditOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuditOutcome.Descriptor instead.
+func (AuditOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+// AuditEvent is a single entry of the audit log.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the audit event (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// kind tells whether an API procedure or a tool was called.
+	Kind AuditEventKind `protobuf:"varint,2,opt,name=kind,proto3,enum=construct.v1.AuditEventKind" json:"kind,omitempty"`
+	// action is the procedure for API events, e.g. /construct.v1.AgentService/UpdateAgent,
+	// and the tool for tool events, e.g. execute_command.
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// subject is the identity that made the call. Tool calls are made on behalf of the owner of the task.
+	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	// auth_method is how the subject was authenticated, e.g. token or unix_socket.
+	AuthMethod string `protobuf:"bytes,5,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"`
+	// resource_ids are the identifiers of the resources the action touched.
+	ResourceIds []string `protobuf:"bytes,6,rep,name=resource_ids,json=resourceIds,proto3" json:"resource_ids,omitempty"`
+	// outcome tells whether the action succeeded.
+	Outcome AuditOutcome `protobuf:"varint,7,opt,name=outcome,proto3,enum=construct.v1.AuditOutcome" json:"outcome,omitempty"`
+	// error describes why the action failed.
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// task_id is the task the action belongs to (UUID format, optional).
+	TaskId *string `protobuf:"bytes,9,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// agent_id is the agent the action belongs to (UUID format, optional).
+	AgentId *string `protobuf:"bytes,10,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// details describe the side effect of a tool call, e.g. the command that was executed.
+	Details map[string]string `protobuf:"bytes,11,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// created_at is the timestamp when the action completed.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_construct_v1_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetKind() AuditEventKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAuthMethod() string {
+	if x != nil {
+		return x.AuthMethod
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResourceIds() []string {
+	if x != nil {
+		return x.ResourceIds
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetOutcome() AuditOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListAuditEventsRequest specifies which audit events to retrieve.
+type ListAuditEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter narrows down the audit events (optional).
+	Filter *ListAuditEventsRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size limits the number of events returned per page (1-1000, default 100).
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_construct_v1_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAuditEventsRequest) GetFilter() *ListAuditEventsRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAuditEventsResponse contains a page of audit events, most recent first.
+type ListAuditEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events is the page of audit events.
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// next_page_token is used to retrieve the next page of results (empty if no more pages).
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_construct_v1_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Filter narrows down the audit events. All conditions must match.
+type ListAuditEventsRequest_Filter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind filters by the kind of the event (optional).
+	Kind *AuditEventKind `protobuf:"varint,1,opt,name=kind,proto3,enum=construct.v1.AuditEventKind,oneof" json:"kind,omitempty"`
+	// subject filters by the identity that made the call (optional).
+	Subject *string `protobuf:"bytes,2,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	// action filters by procedure or tool (optional).
+	Action *string `protobuf:"bytes,3,opt,name=action,proto3,oneof" json:"action,omitempty"`
+	// task_id filters by task (UUID format, optional).
+	TaskId *string `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`
+	// agent_id filters by agent (UUID format, optional).
+	AgentId *string `protobuf:"bytes,5,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// outcome filters by outcome (optional).
+	Outcome *AuditOutcome `protobuf:"varint,6,opt,name=outcome,proto3,enum=construct.v1.AuditOutcome,oneof" json:"outcome,omitempty"`
+	// resource_id filters by a resource the action touched (optional).
+	ResourceId *string `protobuf:"bytes,7,opt,name=resource_id,json=resourceId,proto3,oneof" json:"resource_id,omitempty"`
+	// since only includes events that were recorded at or after this time (optional).
+	Since *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=since,proto3" json:"since,omitempty"`
+	// until only includes events that were recorded before this time (optional).
+	Until         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest_Filter) Reset() {
+	*x = ListAuditEventsRequest_Filter{}
+	mi := &file_construct_v1_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest_Filter) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest_Filter.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest_Filter) Descriptor() ([]byte, []int) {
+	return file_construct_v1_audit_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ListAuditEventsRequest_Filter) GetKind() AuditEventKind {
+	if x != nil && x.Kind != nil {
+		return *x.Kind
+	}
+	return AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest_Filter) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest_Filter) GetAction() string {
+	if x != nil && x.Action != nil {
+		return *x.Action
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest_Filter) GetTaskId() string {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest_Filter) GetAgentId() string {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest_Filter) GetOutcome() AuditOutcome {
+	if x != nil && x.Outcome != nil {
+		return *x.Outcome
+	}
+	return AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest_Filter) GetResourceId() string {
+	if x != nil && x.ResourceId != nil {
+		return *x.ResourceId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest_Filter) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest_Filter) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+var File_construct_v1_audit_proto protoreflect.FileDescriptor
+
+const file_construct_v1_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x18construct/v1/audit.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x04\n" +
+	"\n" +
+	"AuditEvent\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12:\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.construct.v1.AuditEventKindB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x1f\n" +
+	"\vauth_method\x18\x05 \x01(\tR\n" +
+	"authMethod\x12!\n" +
+	"\fresource_ids\x18\x06 \x03(\tR\vresourceIds\x12>\n" +
+	"\aoutcome\x18\a \x01(\x0e2\x1a.construct.v1.AuditOutcomeB\b\xbaH\x05\x82\x01\x02\x10\x01R\aoutcome\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12\x1c\n" +
+	"\atask_id\x18\t \x01(\tH\x00R\x06taskId\x88\x01\x01\x12\x1e\n" +
+	"\bagent_id\x18\n" +
+	" \x01(\tH\x01R\aagentId\x88\x01\x01\x12?\n" +
+	"\adetails\x18\v \x03(\v2%.construct.v1.AuditEvent.DetailsEntryR\adetails\x12A\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_task_idB\v\n" +
+	"\t_agent_id\"\xc0\x05\n" +
+	"\x16ListAuditEventsRequest\x12C\n" +
+	"\x06filter\x18\x01 \x01(\v2+.construct.v1.ListAuditEventsRequest.FilterR\x06filter\x12,\n" +
+	"\tpage_size\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\xe8\a(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01R\tpageToken\x1a\xfb\x03\n" +
+	"\x06Filter\x12?\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1c.construct.v1.AuditEventKindB\b\xbaH\x05\x82\x01\x02\x10\x01H\x00R\x04kind\x88\x01\x01\x12\x1d\n" +
+	"\asubject\x18\x02 \x01(\tH\x01R\asubject\x88\x01\x01\x12\x1b\n" +
+	"\x06action\x18\x03 \x01(\tH\x02R\x06action\x88\x01\x01\x12&\n" +
+	"\atask_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x03R\x06taskId\x88\x01\x01\x12(\n" +
+	"\bagent_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x04R\aagentId\x88\x01\x01\x12C\n" +
+	"\aoutcome\x18\x06 \x01(\x0e2\x1a.construct.v1.AuditOutcomeB\b\xbaH\x05\x82\x01\x02\x10\x01H\x05R\aoutcome\x88\x01\x01\x12$\n" +
+	"\vresource_id\x18\a \x01(\tH\x06R\n" +
+	"resourceId\x88\x01\x01\x120\n" +
+	"\x05since\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x05untilB\a\n" +
+	"\x05_kindB\n" +
+	"\n" +
+	"\b_subjectB\t\n" +
+	"\a_actionB\n" +
+	"\n" +
+	"\b_task_idB\v\n" +
+	"\t_agent_idB\n" +
+	"\n" +
+	"\b_outcomeB\x0e\n" +
+	"\f_resource_idB\f\n" +
+	"\n" +
+	"_page_size\"s\n" +
+	"\x17ListAuditEventsResponse\x120\n" +
+	"\x06events\x18\x01 \x03(\v2\x18.construct.v1.AuditEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*g\n" +
+	"\x0eAuditEventKind\x12 \n" +
+	"\x1cAUDIT_EVENT_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14AUDIT_EVENT_KIND_API\x10\x01\x12\x19\n" +
+	"\x15AUDIT_EVENT_KIND_TOOL\x10\x02*c\n" +
+	"\fAuditOutcome\x12\x1d\n" +
+	"\x19AUDIT_OUTCOME_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15AUDIT_OUTCOME_SUCCESS\x10\x01\x12\x19\n" +
+	"\x15AUDIT_OUTCOME_FAILURE\x10\x022s\n" +
+	"\fAuditService\x12c\n" +
+	"\x0fListAuditEvents\x12$.construct.v1.ListAuditEventsRequest\x1a%.construct.v1.ListAuditEventsResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_audit_proto_rawDescOnce sync.Once
+	file_construct_v1_audit_proto_rawDescData []byte
+)
+
+func file_construct_v1_audit_proto_rawDescGZIP() []byte {
+	file_construct_v1_audit_proto_rawDescOnce.Do(func() {
+		file_construct_v1_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_audit_proto_rawDesc), len(file_construct_v1_audit_proto_rawDesc)))
+	})
+	return file_construct_v1_audit_proto_rawDescData
+}
+
+var file_construct_v1_audit_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_construct_v1_audit_proto_goTypes = []any{
+	(AuditEventKind)(0),                   // 0: construct.v1.AuditEventKind
+	(AuditOutcome)(0),                     // 1: construct.v1.AuditOutcome
+	(*AuditEvent)(nil),                    // 2: construct.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),        // 3: construct.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),       // 4: construct.v1.ListAuditEventsResponse
+	nil,                                   // 5: construct.v1.AuditEvent.DetailsEntry
+	(*ListAuditEventsRequest_Filter)(nil), // 6: construct.v1.ListAuditEventsRequest.Filter
+	(*timestamppb.Timestamp)(nil),         // 7: google.protobuf.Timestamp
+}
+var file_construct_v1_audit_proto_depIdxs = []int32{
+	0,  // 0: construct.v1.AuditEvent.kind:type_name -> construct.v1.AuditEventKind
+	1,  // 1: construct.v1.AuditEvent.outcome:type_name -> construct.v1.AuditOutcome
+	5,  // 2: construct.v1.AuditEvent.details:type_name -> construct.v1.AuditEvent.DetailsEntry
+	7,  // 3: construct.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 4: construct.v1.ListAuditEventsRequest.filter:type_name -> construct.v1.ListAuditEventsRequest.Filter
+	2,  // 5: construct.v1.ListAuditEventsResponse.events:type_name -> construct.v1.AuditEvent
+	0,  // 6: construct.v1.ListAuditEventsRequest.Filter.kind:type_name -> construct.v1.AuditEventKind
+	1,  // 7: construct.v1.ListAuditEventsRequest.Filter.outcome:type_name -> construct.v1.AuditOutcome
+	7,  // 8: construct.v1.ListAuditEventsRequest.Filter.since:type_name -> google.protobuf.Timestamp
+	7,  // 9: construct.v1.ListAuditEventsRequest.Filter.until:type_name -> google.protobuf.Timestamp
+	3,  // 10: construct.v1.AuditService.ListAuditEvents:input_type -> construct.v1.ListAuditEventsRequest
+	4,  // 11: construct.v1.AuditService.ListAuditEvents:output_type -> construct.v1.ListAuditEventsResponse
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_audit_proto_init() }
+func file_construct_v1_audit_proto_init() {
+	if File_construct_v1_audit_proto != nil {
+		return
+	}
+	file_construct_v1_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_construct_v1_audit_proto_msgTypes[1].OneofWrappers = []any{}
+	file_construct_v1_audit_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_audit_proto_rawDesc), len(file_construct_v1_audit_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_audit_proto_goTypes,
+		DependencyIndexes: file_construct_v1_audit_proto_depIdxs,
+		EnumInfos:         file_construct_v1_audit_proto_enumTypes,
+		MessageInfos:      file_construct_v1_audit_proto_msgTypes,
+	}.Build()
+	File_construct_v1_audit_proto = out.File
+	file_construct_v1_audit_proto_goTypes = nil
+	file_construct_v1_audit_proto_depIdxs = nil
+}
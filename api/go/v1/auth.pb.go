@@ -35,7 +35,17 @@ type CreateTokenRequest struct {
 	// Maximum allowed value is 365 days.
 	ExpiresIn *durationpb.Duration `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
 	// Optional description for the token's intended use.
-	Description   *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Scopes that limit the operations the token can perform, e.g. "tasks:read",
+	// "tasks:write", "agents:admin", "providers:admin" or "events:subscribe".
+	// A token without scopes can perform all operations except token management.
+	Scopes []string `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Limits the token to these agents and their tasks (UUID format, optional).
+	AgentIds []string `protobuf:"bytes,5,rep,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`
+	// Limits the token to tasks in these project directories or below (absolute paths, optional).
+	Workspaces []string `protobuf:"bytes,6,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	// Limits the tasks and model usage of the subject of the token (optional).
+	Quota         *Quota `protobuf:"bytes,7,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -91,6 +101,34 @@ func (x *CreateTokenRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTokenRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateTokenRequest) GetAgentIds() []string {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
+}
+
+func (x *CreateTokenRequest) GetWorkspaces() []string {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *CreateTokenRequest) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
 // CreateTokenResponse contains the newly created token.
 type CreateTokenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -385,9 +423,26 @@ type TokenInfo struct {
 	// When the token expires.
 	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	// Whether the token is currently valid (not expired, not revoked).
-	IsActive      bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Scopes of the token. Empty if the token is not limited to scopes.
+	Scopes []string `protobuf:"bytes,8,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Agents the token is limited to.
+	AgentIds []string `protobuf:"bytes,9,rep,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`
+	// Project directories the token is limited to.
+	Workspaces []string `protobuf:"bytes,10,rep,name=workspaces,proto3" json:"workspaces,omitempty"`
+	// Quota of the subject of the token. Not set if the token has no quota.
+	Quota *Quota `protobuf:"bytes,11,opt,name=quota,proto3" json:"quota,omitempty"`
+	// When the token was last used. Updated every few minutes, not on every request.
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Address of the client that last used the token.
+	LastUsedAddress string `protobuf:"bytes,12,opt,name=last_used_address,json=lastUsedAddress,proto3" json:"last_used_address,omitempty"`
+	// When the token was last rotated. Not set if it was never rotated.
+	RotatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=rotated_at,json=rotatedAt,proto3" json:"rotated_at,omitempty"`
+	// Until when the token that was replaced by the last rotation stays valid.
+	// Not set if there is no such token or its overlap window has ended.
+	PreviousExpiresAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=previous_expires_at,json=previousExpiresAt,proto3" json:"previous_expires_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *TokenInfo) Reset() {
@@ -462,6 +517,62 @@ func (x *TokenInfo) GetIsActive() bool {
 	return false
 }
 
+func (x *TokenInfo) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetAgentIds() []string {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetWorkspaces() []string {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetLastUsedAddress() string {
+	if x != nil {
+		return x.LastUsedAddress
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return nil
+}
+
+func (x *TokenInfo) GetPreviousExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousExpiresAt
+	}
+	return nil
+}
+
 // RevokeTokenRequest identifies the token to revoke.
 type RevokeTokenRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -545,6 +656,157 @@ func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
 	return file_construct_v1_auth_proto_rawDescGZIP(), []int{8}
 }
 
+// RotateTokenRequest identifies the token to rotate.
+type RotateTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the token to rotate. Defaults to the token the request is
+	// authenticated with.
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// How long until the successor expires. Default: the lifetime of the
+	// rotated token. Must be positive, maximum allowed value is 365 days.
+	// Only admins may exceed the lifetime of the rotated token.
+	ExpiresIn *durationpb.Duration `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3,oneof" json:"expires_in,omitempty"`
+	// How long the replaced token stays valid. Default: 24 hours, at most
+	// 7 days and never beyond its expiry. Zero revokes it immediately.
+	Overlap       *durationpb.Duration `protobuf:"bytes,3,opt,name=overlap,proto3,oneof" json:"overlap,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTokenRequest) Reset() {
+	*x = RotateTokenRequest{}
+	mi := &file_construct_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTokenRequest) ProtoMessage() {}
+
+func (x *RotateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTokenRequest.ProtoReflect.Descriptor instead.
+func (*RotateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RotateTokenRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *RotateTokenRequest) GetExpiresIn() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return nil
+}
+
+func (x *RotateTokenRequest) GetOverlap() *durationpb.Duration {
+	if x != nil {
+		return x.Overlap
+	}
+	return nil
+}
+
+// RotateTokenResponse contains the successor token.
+type RotateTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plaintext successor token. This is the only time this value is
+	// available.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// When the successor expires.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Until when the replaced token stays valid.
+	PreviousExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=previous_expires_at,json=previousExpiresAt,proto3" json:"previous_expires_at,omitempty"`
+	// ID of the rotated token.
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Name of the rotated token.
+	Name          string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateTokenResponse) Reset() {
+	*x = RotateTokenResponse{}
+	mi := &file_construct_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateTokenResponse) ProtoMessage() {}
+
+func (x *RotateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateTokenResponse.ProtoReflect.Descriptor instead.
+func (*RotateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RotateTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RotateTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *RotateTokenResponse) GetPreviousExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousExpiresAt
+	}
+	return nil
+}
+
+func (x *RotateTokenResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotateTokenResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 // ExchangeSetupCodeRequest contains the setup code to exchange.
 type ExchangeSetupCodeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -556,7 +818,7 @@ type ExchangeSetupCodeRequest struct {
 
 func (x *ExchangeSetupCodeRequest) Reset() {
 	*x = ExchangeSetupCodeRequest{}
-	mi := &file_construct_v1_auth_proto_msgTypes[9]
+	mi := &file_construct_v1_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -568,7 +830,7 @@ func (x *ExchangeSetupCodeRequest) String() string {
 func (*ExchangeSetupCodeRequest) ProtoMessage() {}
 
 func (x *ExchangeSetupCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_auth_proto_msgTypes[9]
+	mi := &file_construct_v1_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -581,7 +843,7 @@ func (x *ExchangeSetupCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExchangeSetupCodeRequest.ProtoReflect.Descriptor instead.
 func (*ExchangeSetupCodeRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_auth_proto_rawDescGZIP(), []int{9}
+	return file_construct_v1_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ExchangeSetupCodeRequest) GetSetupCode() string {
@@ -606,7 +868,7 @@ type ExchangeSetupCodeResponse struct {
 
 func (x *ExchangeSetupCodeResponse) Reset() {
 	*x = ExchangeSetupCodeResponse{}
-	mi := &file_construct_v1_auth_proto_msgTypes[10]
+	mi := &file_construct_v1_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +880,7 @@ func (x *ExchangeSetupCodeResponse) String() string {
 func (*ExchangeSetupCodeResponse) ProtoMessage() {}
 
 func (x *ExchangeSetupCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_auth_proto_msgTypes[10]
+	mi := &file_construct_v1_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +893,7 @@ func (x *ExchangeSetupCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExchangeSetupCodeResponse.ProtoReflect.Descriptor instead.
 func (*ExchangeSetupCodeResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_auth_proto_rawDescGZIP(), []int{10}
+	return file_construct_v1_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ExchangeSetupCodeResponse) GetToken() string {
@@ -659,13 +921,19 @@ var File_construct_v1_auth_proto protoreflect.FileDescriptor
 
 const file_construct_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x17construct/v1/auth.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n" +
+	"\x17construct/v1/auth.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x18construct/v1/quota.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe8\x02\n" +
 	"\x12CreateTokenRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12=\n" +
 	"\n" +
 	"expires_in\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\texpiresIn\x88\x01\x01\x12/\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\vdescription\x88\x01\x01B\r\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10H\x01R\vdescription\x88\x01\x01\x12 \n" +
+	"\x06scopes\x18\x04 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10 R\x06scopes\x12,\n" +
+	"\tagent_ids\x18\x05 \x03(\tB\x0f\xbaH\f\x92\x01\t\x10@\"\x05r\x03\xb0\x01\x01R\bagentIds\x12(\n" +
+	"\n" +
+	"workspaces\x18\x06 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10@R\n" +
+	"workspaces\x12)\n" +
+	"\x05quota\x18\a \x01(\v2\x13.construct.v1.QuotaR\x05quotaB\r\n" +
 	"\v_expires_inB\x0e\n" +
 	"\f_description\"z\n" +
 	"\x13CreateTokenResponse\x12 \n" +
@@ -692,7 +960,7 @@ const file_construct_v1_auth_proto_rawDesc = "" +
 	"namePrefix\x12'\n" +
 	"\x0finclude_expired\x18\x02 \x01(\bR\x0eincludeExpired\"E\n" +
 	"\x12ListTokensResponse\x12/\n" +
-	"\x06tokens\x18\x01 \x03(\v2\x17.construct.v1.TokenInfoR\x06tokens\"\xb1\x02\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x17.construct.v1.TokenInfoR\x06tokens\"\xa2\x05\n" +
 	"\tTokenInfo\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
@@ -702,11 +970,42 @@ const file_construct_v1_auth_proto_rawDesc = "" +
 	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
 	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\texpiresAt\x12#\n" +
-	"\tis_active\x18\a \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\bisActiveB\x0e\n" +
+	"\tis_active\x18\a \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\bisActive\x12\x16\n" +
+	"\x06scopes\x18\b \x03(\tR\x06scopes\x12\x1b\n" +
+	"\tagent_ids\x18\t \x03(\tR\bagentIds\x12\x1e\n" +
+	"\n" +
+	"workspaces\x18\n" +
+	" \x03(\tR\n" +
+	"workspaces\x12)\n" +
+	"\x05quota\x18\v \x01(\v2\x13.construct.v1.QuotaR\x05quota\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12*\n" +
+	"\x11last_used_address\x18\f \x01(\tR\x0flastUsedAddress\x129\n" +
+	"\n" +
+	"rotated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\trotatedAt\x12J\n" +
+	"\x13previous_expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x11previousExpiresAtB\x0e\n" +
 	"\f_description\".\n" +
 	"\x12RevokeTokenRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x15\n" +
-	"\x13RevokeTokenResponse\"_\n" +
+	"\x13RevokeTokenResponse\"\xce\x01\n" +
+	"\x12RotateTokenRequest\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x02id\x88\x01\x01\x12=\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x01R\texpiresIn\x88\x01\x01\x128\n" +
+	"\aoverlap\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x02R\aoverlap\x88\x01\x01B\x05\n" +
+	"\x03_idB\r\n" +
+	"\v_expires_inB\n" +
+	"\n" +
+	"\b_overlap\"\x88\x02\n" +
+	"\x13RotateTokenResponse\x12 \n" +
+	"\x05token\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x05token\x12A\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\texpiresAt\x12R\n" +
+	"\x13previous_expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\x11previousExpiresAt\x12\x18\n" +
+	"\x02id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x1e\n" +
+	"\x04name\x18\x05 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\"_\n" +
 	"\x18ExchangeSetupCodeRequest\x12C\n" +
 	"\n" +
 	"setup_code\x18\x01 \x01(\tB$\xbaH!r\x1f\x10\x01\x18\t2\x19^[A-Z0-9]{4}-[A-Z0-9]{4}$R\tsetupCode\"\xa0\x01\n" +
@@ -716,13 +1015,14 @@ const file_construct_v1_auth_proto_rawDesc = "" +
 	"\n" +
 	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\texpiresAt\x12\x1e\n" +
 	"\x04name\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name2\xcc\x03\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name2\xa0\x04\n" +
 	"\vAuthService\x12R\n" +
 	"\vCreateToken\x12 .construct.v1.CreateTokenRequest\x1a!.construct.v1.CreateTokenResponse\x12^\n" +
 	"\x0fCreateSetupCode\x12$.construct.v1.CreateSetupCodeRequest\x1a%.construct.v1.CreateSetupCodeResponse\x12O\n" +
 	"\n" +
 	"ListTokens\x12\x1f.construct.v1.ListTokensRequest\x1a .construct.v1.ListTokensResponse\x12R\n" +
-	"\vRevokeToken\x12 .construct.v1.RevokeTokenRequest\x1a!.construct.v1.RevokeTokenResponse\x12d\n" +
+	"\vRevokeToken\x12 .construct.v1.RevokeTokenRequest\x1a!.construct.v1.RevokeTokenResponse\x12R\n" +
+	"\vRotateToken\x12 .construct.v1.RotateTokenRequest\x1a!.construct.v1.RotateTokenResponse\x12d\n" +
 	"\x11ExchangeSetupCode\x12&.construct.v1.ExchangeSetupCodeRequest\x1a'.construct.v1.ExchangeSetupCodeResponseB(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
@@ -737,7 +1037,7 @@ func file_construct_v1_auth_proto_rawDescGZIP() []byte {
 	return file_construct_v1_auth_proto_rawDescData
 }
 
-var file_construct_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_construct_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_construct_v1_auth_proto_goTypes = []any{
 	(*CreateTokenRequest)(nil),        // 0: construct.v1.CreateTokenRequest
 	(*CreateTokenResponse)(nil),       // 1: construct.v1.CreateTokenResponse
@@ -748,36 +1048,50 @@ var file_construct_v1_auth_proto_goTypes = []any{
 	(*TokenInfo)(nil),                 // 6: construct.v1.TokenInfo
 	(*RevokeTokenRequest)(nil),        // 7: construct.v1.RevokeTokenRequest
 	(*RevokeTokenResponse)(nil),       // 8: construct.v1.RevokeTokenResponse
-	(*ExchangeSetupCodeRequest)(nil),  // 9: construct.v1.ExchangeSetupCodeRequest
-	(*ExchangeSetupCodeResponse)(nil), // 10: construct.v1.ExchangeSetupCodeResponse
-	(*durationpb.Duration)(nil),       // 11: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),     // 12: google.protobuf.Timestamp
+	(*RotateTokenRequest)(nil),        // 9: construct.v1.RotateTokenRequest
+	(*RotateTokenResponse)(nil),       // 10: construct.v1.RotateTokenResponse
+	(*ExchangeSetupCodeRequest)(nil),  // 11: construct.v1.ExchangeSetupCodeRequest
+	(*ExchangeSetupCodeResponse)(nil), // 12: construct.v1.ExchangeSetupCodeResponse
+	(*durationpb.Duration)(nil),       // 13: google.protobuf.Duration
+	(*Quota)(nil),                     // 14: construct.v1.Quota
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
 }
 var file_construct_v1_auth_proto_depIdxs = []int32{
-	11, // 0: construct.v1.CreateTokenRequest.expires_in:type_name -> google.protobuf.Duration
-	12, // 1: construct.v1.CreateTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
-	11, // 2: construct.v1.CreateSetupCodeRequest.expires_in:type_name -> google.protobuf.Duration
-	11, // 3: construct.v1.CreateSetupCodeRequest.token_expires_in:type_name -> google.protobuf.Duration
-	12, // 4: construct.v1.CreateSetupCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
-	6,  // 5: construct.v1.ListTokensResponse.tokens:type_name -> construct.v1.TokenInfo
-	12, // 6: construct.v1.TokenInfo.created_at:type_name -> google.protobuf.Timestamp
-	12, // 7: construct.v1.TokenInfo.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 8: construct.v1.ExchangeSetupCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: construct.v1.AuthService.CreateToken:input_type -> construct.v1.CreateTokenRequest
-	2,  // 10: construct.v1.AuthService.CreateSetupCode:input_type -> construct.v1.CreateSetupCodeRequest
-	4,  // 11: construct.v1.AuthService.ListTokens:input_type -> construct.v1.ListTokensRequest
-	7,  // 12: construct.v1.AuthService.RevokeToken:input_type -> construct.v1.RevokeTokenRequest
-	9,  // 13: construct.v1.AuthService.ExchangeSetupCode:input_type -> construct.v1.ExchangeSetupCodeRequest
-	1,  // 14: construct.v1.AuthService.CreateToken:output_type -> construct.v1.CreateTokenResponse
-	3,  // 15: construct.v1.AuthService.CreateSetupCode:output_type -> construct.v1.CreateSetupCodeResponse
-	5,  // 16: construct.v1.AuthService.ListTokens:output_type -> construct.v1.ListTokensResponse
-	8,  // 17: construct.v1.AuthService.RevokeToken:output_type -> construct.v1.RevokeTokenResponse
-	10, // 18: construct.v1.AuthService.ExchangeSetupCode:output_type -> construct.v1.ExchangeSetupCodeResponse
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	13, // 0: construct.v1.CreateTokenRequest.expires_in:type_name -> google.protobuf.Duration
+	14, // 1: construct.v1.CreateTokenRequest.quota:type_name -> construct.v1.Quota
+	15, // 2: construct.v1.CreateTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	13, // 3: construct.v1.CreateSetupCodeRequest.expires_in:type_name -> google.protobuf.Duration
+	13, // 4: construct.v1.CreateSetupCodeRequest.token_expires_in:type_name -> google.protobuf.Duration
+	15, // 5: construct.v1.CreateSetupCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	6,  // 6: construct.v1.ListTokensResponse.tokens:type_name -> construct.v1.TokenInfo
+	15, // 7: construct.v1.TokenInfo.created_at:type_name -> google.protobuf.Timestamp
+	15, // 8: construct.v1.TokenInfo.expires_at:type_name -> google.protobuf.Timestamp
+	14, // 9: construct.v1.TokenInfo.quota:type_name -> construct.v1.Quota
+	15, // 10: construct.v1.TokenInfo.last_used_at:type_name -> google.protobuf.Timestamp
+	15, // 11: construct.v1.TokenInfo.rotated_at:type_name -> google.protobuf.Timestamp
+	15, // 12: construct.v1.TokenInfo.previous_expires_at:type_name -> google.protobuf.Timestamp
+	13, // 13: construct.v1.RotateTokenRequest.expires_in:type_name -> google.protobuf.Duration
+	13, // 14: construct.v1.RotateTokenRequest.overlap:type_name -> google.protobuf.Duration
+	15, // 15: construct.v1.RotateTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	15, // 16: construct.v1.RotateTokenResponse.previous_expires_at:type_name -> google.protobuf.Timestamp
+	15, // 17: construct.v1.ExchangeSetupCodeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 18: construct.v1.AuthService.CreateToken:input_type -> construct.v1.CreateTokenRequest
+	2,  // 19: construct.v1.AuthService.CreateSetupCode:input_type -> construct.v1.CreateSetupCodeRequest
+	4,  // 20: construct.v1.AuthService.ListTokens:input_type -> construct.v1.ListTokensRequest
+	7,  // 21: construct.v1.AuthService.RevokeToken:input_type -> construct.v1.RevokeTokenRequest
+	9,  // 22: construct.v1.AuthService.RotateToken:input_type -> construct.v1.RotateTokenRequest
+	11, // 23: construct.v1.AuthService.ExchangeSetupCode:input_type -> construct.v1.ExchangeSetupCodeRequest
+	1,  // 24: construct.v1.AuthService.CreateToken:output_type -> construct.v1.CreateTokenResponse
+	3,  // 25: construct.v1.AuthService.CreateSetupCode:output_type -> construct.v1.CreateSetupCodeResponse
+	5,  // 26: construct.v1.AuthService.ListTokens:output_type -> construct.v1.ListTokensResponse
+	8,  // 27: construct.v1.AuthService.RevokeToken:output_type -> construct.v1.RevokeTokenResponse
+	10, // 28: construct.v1.AuthService.RotateToken:output_type -> construct.v1.RotateTokenResponse
+	12, // 29: construct.v1.AuthService.ExchangeSetupCode:output_type -> construct.v1.ExchangeSetupCodeResponse
+	24, // [24:30] is the sub-list for method output_type
+	18, // [18:24] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_auth_proto_init() }
@@ -786,16 +1100,18 @@ func file_construct_v1_auth_proto_init() {
 		return
 	}
 	file_construct_v1_common_proto_init()
+	file_construct_v1_quota_proto_init()
 	file_construct_v1_auth_proto_msgTypes[0].OneofWrappers = []any{}
 	file_construct_v1_auth_proto_msgTypes[2].OneofWrappers = []any{}
 	file_construct_v1_auth_proto_msgTypes[6].OneofWrappers = []any{}
+	file_construct_v1_auth_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_auth_proto_rawDesc), len(file_construct_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,651 @@
+// Encryption API manages the keyset that the daemon encrypts stored secrets with, i.e. the API
+// keys of model providers and the signing secrets of webhooks.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/encryption.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EncryptionKeyStatus tells whether a key of the keyset is used.
+type EncryptionKeyStatus int32
+
+const (
+	// ENCRYPTION_KEY_STATUS_UNSPECIFIED indicates an unknown or unset status.
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNSPECIFIED EncryptionKeyStatus = 0
+	// ENCRYPTION_KEY_STATUS_ENABLED indicates that the key decrypts secrets. The primary key
+	// also encrypts them.
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ENABLED EncryptionKeyStatus = 1
+	// ENCRYPTION_KEY_STATUS_DISABLED indicates that the key is kept in the keyset, but not used.
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_DISABLED EncryptionKeyStatus = 2
+	// ENCRYPTION_KEY_STATUS_DESTROYED indicates that the key material was deleted.
+	EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_DESTROYED EncryptionKeyStatus = 3
+)
+
+// Enum value maps for EncryptionKeyStatus.
+var (
+	EncryptionKeyStatus_name = map[int32]string{
+		0: "ENCRYPTION_KEY_STATUS_UNSPECIFIED",
+		1: "ENCRYPTION_KEY_STATUS_ENABLED",
+		2: "ENCRYPTION_KEY_STATUS_DISABLED",
+		3: "ENCRYPTION_KEY_STATUS_DESTROYED",
+	}
+	EncryptionKeyStatus_value = map[string]int32{
+		"ENCRYPTION_KEY_STATUS_UNSPECIFIED": 0,
+		"ENCRYPTION_KEY_STATUS_ENABLED":     1,
+		"ENCRYPTION_KEY_STATUS_DISABLED":    2,
+		"ENCRYPTION_KEY_STATUS_DESTROYED":   3,
+	}
+)
+
+func (x EncryptionKeyStatus) Enum() *EncryptionKeyStatus {
+	p := new(EncryptionKeyStatus)
+	*p = x
+	return p
+}
+
+func (x EncryptionKeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncryptionKeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_encryption_proto_enumTypes[0].Descriptor()
+}
+
+func (EncryptionKeyStatus) Type() protoreflect.EnumType {
+	return &file_construct_v1_encryption_proto_enumTypes[0]
+}
+
+func (x EncryptionKeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncryptionKeyStatus.Descriptor instead.
+func (EncryptionKeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{0}
+}
+
+// EncryptionKeyRotationPhase is the step a key rotation is in.
+type EncryptionKeyRotationPhase int32
+
+const (
+	// ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED indicates an unknown or unset phase.
+	EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED EncryptionKeyRotationPhase = 0
+	// ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING indicates that stored secrets are re-encrypted
+	// with the new primary key.
+	EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING EncryptionKeyRotationPhase = 1
+	// ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING indicates that stored secrets are decrypted with the
+	// new primary key alone before the previous keys are disabled.
+	EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING EncryptionKeyRotationPhase = 2
+	// ENCRYPTION_KEY_ROTATION_PHASE_DONE indicates that the rotation is complete.
+	EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_DONE EncryptionKeyRotationPhase = 3
+)
+
+// Enum value maps for EncryptionKeyRotationPhase.
+var (
+	EncryptionKeyRotationPhase_name = map[int32]string{
+		0: "ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED",
+		1: "ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING",
+		2: "ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING",
+		3: "ENCRYPTION_KEY_ROTATION_PHASE_DONE",
+	}
+	EncryptionKeyRotationPhase_value = map[string]int32{
+		"ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED":  0,
+		"ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING": 1,
+		"ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING":    2,
+		"ENCRYPTION_KEY_ROTATION_PHASE_DONE":         3,
+	}
+)
+
+func (x EncryptionKeyRotationPhase) Enum() *EncryptionKeyRotationPhase {
+	p := new(EncryptionKeyRotationPhase)
+	*p = x
+	return p
+}
+
+func (x EncryptionKeyRotationPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncryptionKeyRotationPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_encryption_proto_enumTypes[1].Descriptor()
+}
+
+func (EncryptionKeyRotationPhase) Type() protoreflect.EnumType {
+	return &file_construct_v1_encryption_proto_enumTypes[1]
+}
+
+func (x EncryptionKeyRotationPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncryptionKeyRotationPhase.Descriptor instead.
+func (EncryptionKeyRotationPhase) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{1}
+}
+
+// EncryptionKey describes a key of the keyset. The key material is never returned.
+type EncryptionKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id identifies the key within the keyset.
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status tells whether the key is used.
+	Status EncryptionKeyStatus `protobuf:"varint,2,opt,name=status,proto3,enum=construct.v1.EncryptionKeyStatus" json:"status,omitempty"`
+	// primary is set for the key that encrypts new secrets.
+	Primary       bool `protobuf:"varint,3,opt,name=primary,proto3" json:"primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptionKey) Reset() {
+	*x = EncryptionKey{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EncryptionKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncryptionKey) ProtoMessage() {}
+
+func (x *EncryptionKey) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncryptionKey.ProtoReflect.Descriptor instead.
+func (*EncryptionKey) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EncryptionKey) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EncryptionKey) GetStatus() EncryptionKeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNSPECIFIED
+}
+
+func (x *EncryptionKey) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+// RotateEncryptionKeyRequest contains the options of a key rotation.
+type RotateEncryptionKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Disable the previous keys once every secret was verified to decrypt with the new primary
+	// key. Disabled keys are kept in the keyset, so that older backups of the database can still
+	// be read after enabling them again by importing an exported keyset.
+	DisablePreviousKeys bool `protobuf:"varint,1,opt,name=disable_previous_keys,json=disablePreviousKeys,proto3" json:"disable_previous_keys,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyRequest) Reset() {
+	*x = RotateEncryptionKeyRequest{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RotateEncryptionKeyRequest) GetDisablePreviousKeys() bool {
+	if x != nil {
+		return x.DisablePreviousKeys
+	}
+	return false
+}
+
+// RotateEncryptionKeyResponse reports the progress of a key rotation.
+type RotateEncryptionKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// phase is the step the rotation is in.
+	Phase EncryptionKeyRotationPhase `protobuf:"varint,1,opt,name=phase,proto3,enum=construct.v1.EncryptionKeyRotationPhase" json:"phase,omitempty"`
+	// processed is the number of secrets of the phase that were handled so far.
+	Processed int32 `protobuf:"varint,2,opt,name=processed,proto3" json:"processed,omitempty"`
+	// total is the number of secrets that the phase handles.
+	Total int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// primary_key_id is the ID of the new primary key.
+	PrimaryKeyId uint32 `protobuf:"varint,4,opt,name=primary_key_id,json=primaryKeyId,proto3" json:"primary_key_id,omitempty"`
+	// keys describes the keys of the keyset. Only set once the rotation is done.
+	Keys          []*EncryptionKey `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateEncryptionKeyResponse) Reset() {
+	*x = RotateEncryptionKeyResponse{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *RotateEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RotateEncryptionKeyResponse) GetPhase() EncryptionKeyRotationPhase {
+	if x != nil {
+		return x.Phase
+	}
+	return EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED
+}
+
+func (x *RotateEncryptionKeyResponse) GetProcessed() int32 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetPrimaryKeyId() uint32 {
+	if x != nil {
+		return x.PrimaryKeyId
+	}
+	return 0
+}
+
+func (x *RotateEncryptionKeyResponse) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// ExportEncryptionKeysetRequest is empty, the whole keyset is exported.
+type ExportEncryptionKeysetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEncryptionKeysetRequest) Reset() {
+	*x = ExportEncryptionKeysetRequest{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEncryptionKeysetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEncryptionKeysetRequest) ProtoMessage() {}
+
+func (x *ExportEncryptionKeysetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEncryptionKeysetRequest.ProtoReflect.Descriptor instead.
+func (*ExportEncryptionKeysetRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{3}
+}
+
+// ExportEncryptionKeysetResponse contains the exported keyset.
+type ExportEncryptionKeysetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// keyset is the keyset in Tink's JSON format. It contains the key material in cleartext and
+	// has to be stored as securely as the secrets it protects.
+	Keyset string `protobuf:"bytes,1,opt,name=keyset,proto3" json:"keyset,omitempty"`
+	// keys describes the keys of the keyset.
+	Keys          []*EncryptionKey `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportEncryptionKeysetResponse) Reset() {
+	*x = ExportEncryptionKeysetResponse{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportEncryptionKeysetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEncryptionKeysetResponse) ProtoMessage() {}
+
+func (x *ExportEncryptionKeysetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEncryptionKeysetResponse.ProtoReflect.Descriptor instead.
+func (*ExportEncryptionKeysetResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExportEncryptionKeysetResponse) GetKeyset() string {
+	if x != nil {
+		return x.Keyset
+	}
+	return ""
+}
+
+func (x *ExportEncryptionKeysetResponse) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// ImportEncryptionKeysetRequest contains the keyset to import.
+type ImportEncryptionKeysetRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// keyset is a keyset in Tink's JSON format, as returned by ExportEncryptionKeyset.
+	Keyset string `protobuf:"bytes,1,opt,name=keyset,proto3" json:"keyset,omitempty"`
+	// Import the keyset even if it does not decrypt every stored secret. Secrets that cannot be
+	// decrypted have to be set again.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEncryptionKeysetRequest) Reset() {
+	*x = ImportEncryptionKeysetRequest{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEncryptionKeysetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEncryptionKeysetRequest) ProtoMessage() {}
+
+func (x *ImportEncryptionKeysetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEncryptionKeysetRequest.ProtoReflect.Descriptor instead.
+func (*ImportEncryptionKeysetRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportEncryptionKeysetRequest) GetKeyset() string {
+	if x != nil {
+		return x.Keyset
+	}
+	return ""
+}
+
+func (x *ImportEncryptionKeysetRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// ImportEncryptionKeysetResponse describes the imported keyset.
+type ImportEncryptionKeysetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// keys describes the keys of the imported keyset.
+	Keys []*EncryptionKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// undecryptable is the number of stored secrets that the keyset does not decrypt.
+	Undecryptable int32 `protobuf:"varint,2,opt,name=undecryptable,proto3" json:"undecryptable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportEncryptionKeysetResponse) Reset() {
+	*x = ImportEncryptionKeysetResponse{}
+	mi := &file_construct_v1_encryption_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportEncryptionKeysetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEncryptionKeysetResponse) ProtoMessage() {}
+
+func (x *ImportEncryptionKeysetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_encryption_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEncryptionKeysetResponse.ProtoReflect.Descriptor instead.
+func (*ImportEncryptionKeysetResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_encryption_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImportEncryptionKeysetResponse) GetKeys() []*EncryptionKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ImportEncryptionKeysetResponse) GetUndecryptable() int32 {
+	if x != nil {
+		return x.Undecryptable
+	}
+	return 0
+}
+
+var File_construct_v1_encryption_proto protoreflect.FileDescriptor
+
+const file_construct_v1_encryption_proto_rawDesc = "" +
+	"\n" +
+	"\x1dconstruct/v1/encryption.proto\x12\fconstruct.v1\"t\n" +
+	"\rEncryptionKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.construct.v1.EncryptionKeyStatusR\x06status\x12\x18\n" +
+	"\aprimary\x18\x03 \x01(\bR\aprimary\"P\n" +
+	"\x1aRotateEncryptionKeyRequest\x122\n" +
+	"\x15disable_previous_keys\x18\x01 \x01(\bR\x13disablePreviousKeys\"\xe8\x01\n" +
+	"\x1bRotateEncryptionKeyResponse\x12>\n" +
+	"\x05phase\x18\x01 \x01(\x0e2(.construct.v1.EncryptionKeyRotationPhaseR\x05phase\x12\x1c\n" +
+	"\tprocessed\x18\x02 \x01(\x05R\tprocessed\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12$\n" +
+	"\x0eprimary_key_id\x18\x04 \x01(\rR\fprimaryKeyId\x12/\n" +
+	"\x04keys\x18\x05 \x03(\v2\x1b.construct.v1.EncryptionKeyR\x04keys\"\x1f\n" +
+	"\x1dExportEncryptionKeysetRequest\"i\n" +
+	"\x1eExportEncryptionKeysetResponse\x12\x16\n" +
+	"\x06keyset\x18\x01 \x01(\tR\x06keyset\x12/\n" +
+	"\x04keys\x18\x02 \x03(\v2\x1b.construct.v1.EncryptionKeyR\x04keys\"M\n" +
+	"\x1dImportEncryptionKeysetRequest\x12\x16\n" +
+	"\x06keyset\x18\x01 \x01(\tR\x06keyset\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"w\n" +
+	"\x1eImportEncryptionKeysetResponse\x12/\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1b.construct.v1.EncryptionKeyR\x04keys\x12$\n" +
+	"\rundecryptable\x18\x02 \x01(\x05R\rundecryptable*\xa8\x01\n" +
+	"\x13EncryptionKeyStatus\x12%\n" +
+	"!ENCRYPTION_KEY_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dENCRYPTION_KEY_STATUS_ENABLED\x10\x01\x12\"\n" +
+	"\x1eENCRYPTION_KEY_STATUS_DISABLED\x10\x02\x12#\n" +
+	"\x1fENCRYPTION_KEY_STATUS_DESTROYED\x10\x03*\xd0\x01\n" +
+	"\x1aEncryptionKeyRotationPhase\x12-\n" +
+	")ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED\x10\x00\x12.\n" +
+	"*ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING\x10\x01\x12+\n" +
+	"'ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING\x10\x02\x12&\n" +
+	"\"ENCRYPTION_KEY_ROTATION_PHASE_DONE\x10\x032\xeb\x02\n" +
+	"\x11EncryptionService\x12l\n" +
+	"\x13RotateEncryptionKey\x12(.construct.v1.RotateEncryptionKeyRequest\x1a).construct.v1.RotateEncryptionKeyResponse0\x01\x12s\n" +
+	"\x16ExportEncryptionKeyset\x12+.construct.v1.ExportEncryptionKeysetRequest\x1a,.construct.v1.ExportEncryptionKeysetResponse\x12s\n" +
+	"\x16ImportEncryptionKeyset\x12+.construct.v1.ImportEncryptionKeysetRequest\x1a,.construct.v1.ImportEncryptionKeysetResponseB(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_encryption_proto_rawDescOnce sync.Once
+	file_construct_v1_encryption_proto_rawDescData []byte
+)
+
+func file_construct_v1_encryption_proto_rawDescGZIP() []byte {
+	file_construct_v1_encryption_proto_rawDescOnce.Do(func() {
+		file_construct_v1_encryption_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_encryption_proto_rawDesc), len(file_construct_v1_encryption_proto_rawDesc)))
+	})
+	return file_construct_v1_encryption_proto_rawDescData
+}
+
+var file_construct_v1_encryption_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_encryption_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_construct_v1_encryption_proto_goTypes = []any{
+	(EncryptionKeyStatus)(0),               // 0: construct.v1.EncryptionKeyStatus
+	(EncryptionKeyRotationPhase)(0),        // 1: construct.v1.EncryptionKeyRotationPhase
+	(*EncryptionKey)(nil),                  // 2: construct.v1.EncryptionKey
+	(*RotateEncryptionKeyRequest)(nil),     // 3: construct.v1.RotateEncryptionKeyRequest
+	(*RotateEncryptionKeyResponse)(nil),    // 4: construct.v1.RotateEncryptionKeyResponse
+	(*ExportEncryptionKeysetRequest)(nil),  // 5: construct.v1.ExportEncryptionKeysetRequest
+	(*ExportEncryptionKeysetResponse)(nil), // 6: construct.v1.ExportEncryptionKeysetResponse
+	(*ImportEncryptionKeysetRequest)(nil),  // 7: construct.v1.ImportEncryptionKeysetRequest
+	(*ImportEncryptionKeysetResponse)(nil), // 8: construct.v1.ImportEncryptionKeysetResponse
+}
+var file_construct_v1_encryption_proto_depIdxs = []int32{
+	0, // 0: construct.v1.EncryptionKey.status:type_name -> construct.v1.EncryptionKeyStatus
+	1, // 1: construct.v1.RotateEncryptionKeyResponse.phase:type_name -> construct.v1.EncryptionKeyRotationPhase
+	2, // 2: construct.v1.RotateEncryptionKeyResponse.keys:type_name -> construct.v1.EncryptionKey
+	2, // 3: construct.v1.ExportEncryptionKeysetResponse.keys:type_name -> construct.v1.EncryptionKey
+	2, // 4: construct.v1.ImportEncryptionKeysetResponse.keys:type_name -> construct.v1.EncryptionKey
+	3, // 5: construct.v1.EncryptionService.RotateEncryptionKey:input_type -> construct.v1.RotateEncryptionKeyRequest
+	5, // 6: construct.v1.EncryptionService.ExportEncryptionKeyset:input_type -> construct.v1.ExportEncryptionKeysetRequest
+	7, // 7: construct.v1.EncryptionService.ImportEncryptionKeyset:input_type -> construct.v1.ImportEncryptionKeysetRequest
+	4, // 8: construct.v1.EncryptionService.RotateEncryptionKey:output_type -> construct.v1.RotateEncryptionKeyResponse
+	6, // 9: construct.v1.EncryptionService.ExportEncryptionKeyset:output_type -> construct.v1.ExportEncryptionKeysetResponse
+	8, // 10: construct.v1.EncryptionService.ImportEncryptionKeyset:output_type -> construct.v1.ImportEncryptionKeysetResponse
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_encryption_proto_init() }
+func file_construct_v1_encryption_proto_init() {
+	if File_construct_v1_encryption_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_encryption_proto_rawDesc), len(file_construct_v1_encryption_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_encryption_proto_goTypes,
+		DependencyIndexes: file_construct_v1_encryption_proto_depIdxs,
+		EnumInfos:         file_construct_v1_encryption_proto_enumTypes,
+		MessageInfos:      file_construct_v1_encryption_proto_msgTypes,
+	}.Build()
+	File_construct_v1_encryption_proto = out.File
+	file_construct_v1_encryption_proto_goTypes = nil
+	file_construct_v1_encryption_proto_depIdxs = nil
+}
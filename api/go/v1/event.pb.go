@@ -97,8 +97,13 @@ type EventSubscribeRequest struct {
 	// replay_after_message_id enables replay of message.created events after this message ID.
 	// Only applicable when task_id is also set. Only message.created events are replayed.
 	ReplayAfterMessageId *string `protobuf:"bytes,3,opt,name=replay_after_message_id,json=replayAfterMessageId,proto3,oneof" json:"replay_after_message_id,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// resume_after_sequence resumes a subscription after the event with this sequence number.
+	// All logged events after it that match the filter are delivered before live events.
+	// If some of these events were already deleted from the event log, a stream.gap event
+	// reports the missing range. Takes precedence over replay_after_message_id.
+	ResumeAfterSequence *int64 `protobuf:"varint,4,opt,name=resume_after_sequence,json=resumeAfterSequence,proto3,oneof" json:"resume_after_sequence,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *EventSubscribeRequest) Reset() {
@@ -152,6 +157,13 @@ func (x *EventSubscribeRequest) GetReplayAfterMessageId() string {
 	return ""
 }
 
+func (x *EventSubscribeRequest) GetResumeAfterSequence() int64 {
+	if x != nil && x.ResumeAfterSequence != nil {
+		return *x.ResumeAfterSequence
+	}
+	return 0
+}
+
 // EventSubscribeResponse wraps an event in the stream.
 type EventSubscribeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -207,6 +219,10 @@ type Event struct {
 	Action EventAction `protobuf:"varint,2,opt,name=action,proto3,enum=construct.v1.EventAction" json:"action,omitempty"`
 	// timestamp is when the change occurred (entity timestamp, e.g., created_at, updated_at).
 	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// sequence is the position of the event in the event log. Sequence numbers increase
+	// monotonically and can be used to resume a subscription. It is 0 for events that
+	// are not logged, such as replayed messages and stream.gap events.
+	Sequence int64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	// payload contains the event-specific data.
 	//
 	// Types that are valid to be assigned to Payload:
@@ -219,6 +235,8 @@ type Event struct {
 	//	*Event_ModelProvider
 	//	*Event_ToolCalled
 	//	*Event_ToolResult
+	//	*Event_TaskModelFallback
+	//	*Event_StreamGap
 	Payload       isEvent_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -275,6 +293,13 @@ func (x *Event) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Event) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 func (x *Event) GetPayload() isEvent_Payload {
 	if x != nil {
 		return x.Payload
@@ -354,6 +379,24 @@ func (x *Event) GetToolResult() *ToolResultEvent {
 	return nil
 }
 
+func (x *Event) GetTaskModelFallback() *TaskModelFallbackEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_TaskModelFallback); ok {
+			return x.TaskModelFallback
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetStreamGap() *StreamGapEvent {
+	if x != nil {
+		if x, ok := x.Payload.(*Event_StreamGap); ok {
+			return x.StreamGap
+		}
+	}
+	return nil
+}
+
 type isEvent_Payload interface {
 	isEvent_Payload()
 }
@@ -390,6 +433,14 @@ type Event_ToolResult struct {
 	ToolResult *ToolResultEvent `protobuf:"bytes,17,opt,name=tool_result,json=toolResult,proto3,oneof"`
 }
 
+type Event_TaskModelFallback struct {
+	TaskModelFallback *TaskModelFallbackEvent `protobuf:"bytes,18,opt,name=task_model_fallback,json=taskModelFallback,proto3,oneof"`
+}
+
+type Event_StreamGap struct {
+	StreamGap *StreamGapEvent `protobuf:"bytes,19,opt,name=stream_gap,json=streamGap,proto3,oneof"`
+}
+
 func (*Event_Task) isEvent_Payload() {}
 
 func (*Event_Message) isEvent_Payload() {}
@@ -406,6 +457,10 @@ func (*Event_ToolCalled) isEvent_Payload() {}
 
 func (*Event_ToolResult) isEvent_Payload() {}
 
+func (*Event_TaskModelFallback) isEvent_Payload() {}
+
+func (*Event_StreamGap) isEvent_Payload() {}
+
 // TaskEvent contains task event data.
 type TaskEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -832,25 +887,170 @@ func (x *ToolResultEvent) GetToolResult() *ToolResult {
 	return nil
 }
 
+// TaskModelFallbackEvent contains data about a switch to a fallback model.
+// Emitted when the current model of a task's agent fails with an overloaded,
+// rate limit or circuit open error, or the prompt does not fit into its context
+// window, and the next model in the fallback chain is tried.
+type TaskModelFallbackEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the task whose model invocation fell back.
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// from_model_id is the model that failed.
+	FromModelId string `protobuf:"bytes,2,opt,name=from_model_id,json=fromModelId,proto3" json:"from_model_id,omitempty"`
+	// to_model_id is the model that is tried next.
+	ToModelId string `protobuf:"bytes,3,opt,name=to_model_id,json=toModelId,proto3" json:"to_model_id,omitempty"`
+	// reason is the kind of provider error that triggered the fallback (e.g., "overloaded"),
+	// or "context_overflow" if the prompt did not fit into the context window of the model.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// error is the error message returned by the failed model.
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskModelFallbackEvent) Reset() {
+	*x = TaskModelFallbackEvent{}
+	mi := &file_construct_v1_event_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskModelFallbackEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskModelFallbackEvent) ProtoMessage() {}
+
+func (x *TaskModelFallbackEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskModelFallbackEvent.ProtoReflect.Descriptor instead.
+func (*TaskModelFallbackEvent) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TaskModelFallbackEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskModelFallbackEvent) GetFromModelId() string {
+	if x != nil {
+		return x.FromModelId
+	}
+	return ""
+}
+
+func (x *TaskModelFallbackEvent) GetToModelId() string {
+	if x != nil {
+		return x.ToModelId
+	}
+	return ""
+}
+
+func (x *TaskModelFallbackEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TaskModelFallbackEvent) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// StreamGapEvent reports events that a resumed subscription cannot deliver, because they
+// were already deleted from the event log. The range of sequence numbers is inclusive.
+type StreamGapEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// from_sequence is the sequence number of the first missing event.
+	FromSequence int64 `protobuf:"varint,1,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// to_sequence is the sequence number of the last missing event.
+	ToSequence    int64 `protobuf:"varint,2,opt,name=to_sequence,json=toSequence,proto3" json:"to_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamGapEvent) Reset() {
+	*x = StreamGapEvent{}
+	mi := &file_construct_v1_event_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamGapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamGapEvent) ProtoMessage() {}
+
+func (x *StreamGapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_event_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamGapEvent.ProtoReflect.Descriptor instead.
+func (*StreamGapEvent) Descriptor() ([]byte, []int) {
+	return file_construct_v1_event_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamGapEvent) GetFromSequence() int64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *StreamGapEvent) GetToSequence() int64 {
+	if x != nil {
+		return x.ToSequence
+	}
+	return 0
+}
+
 var File_construct_v1_event_proto protoreflect.FileDescriptor
 
 const file_construct_v1_event_proto_rawDesc = "" +
 	"\n" +
-	"\x18construct/v1/event.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x18construct/v1/agent.proto\x1a\x1aconstruct/v1/message.proto\x1a\x18construct/v1/model.proto\x1a construct/v1/modelprovider.proto\x1a\x17construct/v1/task.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xce\x01\n" +
+	"\x18construct/v1/event.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x18construct/v1/agent.proto\x1a\x1aconstruct/v1/message.proto\x1a\x18construct/v1/model.proto\x1a construct/v1/modelprovider.proto\x1a\x17construct/v1/task.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x02\n" +
 	"\x15EventSubscribeRequest\x12\x1f\n" +
 	"\vevent_types\x18\x01 \x03(\tR\n" +
 	"eventTypes\x12&\n" +
 	"\atask_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\x06taskId\x88\x01\x01\x12D\n" +
-	"\x17replay_after_message_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\x14replayAfterMessageId\x88\x01\x01B\n" +
+	"\x17replay_after_message_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\x14replayAfterMessageId\x88\x01\x01\x12@\n" +
+	"\x15resume_after_sequence\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00H\x02R\x13resumeAfterSequence\x88\x01\x01B\n" +
 	"\n" +
 	"\b_task_idB\x1a\n" +
-	"\x18_replay_after_message_id\"K\n" +
+	"\x18_replay_after_message_idB\x18\n" +
+	"\x16_resume_after_sequence\"K\n" +
 	"\x16EventSubscribeResponse\x121\n" +
-	"\x05event\x18\x01 \x01(\v2\x13.construct.v1.EventB\x06\xbaH\x03\xc8\x01\x01R\x05event\"\x8f\x05\n" +
+	"\x05event\x18\x01 \x01(\v2\x13.construct.v1.EventB\x06\xbaH\x03\xc8\x01\x01R\x05event\"\xc2\x06\n" +
 	"\x05Event\x12\x1a\n" +
 	"\x04type\x18\x01 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x04type\x12;\n" +
 	"\x06action\x18\x02 \x01(\x0e2\x19.construct.v1.EventActionB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06action\x12@\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\ttimestamp\x12-\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\ttimestamp\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\x03R\bsequence\x12-\n" +
 	"\x04task\x18\n" +
 	" \x01(\v2\x17.construct.v1.TaskEventH\x00R\x04task\x126\n" +
 	"\amessage\x18\v \x01(\v2\x1a.construct.v1.MessageEventH\x00R\amessage\x12F\n" +
@@ -861,7 +1061,10 @@ const file_construct_v1_event_proto_rawDesc = "" +
 	"\vtool_called\x18\x10 \x01(\v2\x1d.construct.v1.ToolCalledEventH\x00R\n" +
 	"toolCalled\x12@\n" +
 	"\vtool_result\x18\x11 \x01(\v2\x1d.construct.v1.ToolResultEventH\x00R\n" +
-	"toolResultB\t\n" +
+	"toolResult\x12V\n" +
+	"\x13task_model_fallback\x18\x12 \x01(\v2$.construct.v1.TaskModelFallbackEventH\x00R\x11taskModelFallback\x12=\n" +
+	"\n" +
+	"stream_gap\x18\x13 \x01(\v2\x1c.construct.v1.StreamGapEventH\x00R\tstreamGapB\t\n" +
 	"\apayload\"z\n" +
 	"\tTaskEvent\x12.\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\x12*\n" +
@@ -890,7 +1093,17 @@ const file_construct_v1_event_proto_rawDesc = "" +
 	"\x0fToolResultEvent\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12A\n" +
 	"\vtool_result\x18\x02 \x01(\v2\x18.construct.v1.ToolResultB\x06\xbaH\x03\xc8\x01\x01R\n" +
-	"toolResult*y\n" +
+	"toolResult\"\xc1\x01\n" +
+	"\x16TaskModelFallbackEvent\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12,\n" +
+	"\rfrom_model_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\vfromModelId\x12(\n" +
+	"\vto_model_id\x18\x03 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\ttoModelId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"V\n" +
+	"\x0eStreamGapEvent\x12#\n" +
+	"\rfrom_sequence\x18\x01 \x01(\x03R\ffromSequence\x12\x1f\n" +
+	"\vto_sequence\x18\x02 \x01(\x03R\n" +
+	"toSequence*y\n" +
 	"\vEventAction\x12\x1c\n" +
 	"\x18EVENT_ACTION_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14EVENT_ACTION_CREATED\x10\x01\x12\x18\n" +
@@ -912,7 +1125,7 @@ func file_construct_v1_event_proto_rawDescGZIP() []byte {
 }
 
 var file_construct_v1_event_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_construct_v1_event_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_construct_v1_event_proto_goTypes = []any{
 	(EventAction)(0),               // 0: construct.v1.EventAction
 	(*EventSubscribeRequest)(nil),  // 1: construct.v1.EventSubscribeRequest
@@ -926,19 +1139,21 @@ var file_construct_v1_event_proto_goTypes = []any{
 	(*ModelProviderEvent)(nil),     // 9: construct.v1.ModelProviderEvent
 	(*ToolCalledEvent)(nil),        // 10: construct.v1.ToolCalledEvent
 	(*ToolResultEvent)(nil),        // 11: construct.v1.ToolResultEvent
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
-	(*Task)(nil),                   // 13: construct.v1.Task
-	(*Message)(nil),                // 14: construct.v1.Message
-	(*Agent)(nil),                  // 15: construct.v1.Agent
-	(*Model)(nil),                  // 16: construct.v1.Model
-	(*ModelProvider)(nil),          // 17: construct.v1.ModelProvider
-	(*ToolCall)(nil),               // 18: construct.v1.ToolCall
-	(*ToolResult)(nil),             // 19: construct.v1.ToolResult
+	(*TaskModelFallbackEvent)(nil), // 12: construct.v1.TaskModelFallbackEvent
+	(*StreamGapEvent)(nil),         // 13: construct.v1.StreamGapEvent
+	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
+	(*Task)(nil),                   // 15: construct.v1.Task
+	(*Message)(nil),                // 16: construct.v1.Message
+	(*Agent)(nil),                  // 17: construct.v1.Agent
+	(*Model)(nil),                  // 18: construct.v1.Model
+	(*ModelProvider)(nil),          // 19: construct.v1.ModelProvider
+	(*ToolCall)(nil),               // 20: construct.v1.ToolCall
+	(*ToolResult)(nil),             // 21: construct.v1.ToolResult
 }
 var file_construct_v1_event_proto_depIdxs = []int32{
 	3,  // 0: construct.v1.EventSubscribeResponse.event:type_name -> construct.v1.Event
 	0,  // 1: construct.v1.Event.action:type_name -> construct.v1.EventAction
-	12, // 2: construct.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
+	14, // 2: construct.v1.Event.timestamp:type_name -> google.protobuf.Timestamp
 	4,  // 3: construct.v1.Event.task:type_name -> construct.v1.TaskEvent
 	5,  // 4: construct.v1.Event.message:type_name -> construct.v1.MessageEvent
 	6,  // 5: construct.v1.Event.message_chunk:type_name -> construct.v1.MessageChunkEvent
@@ -947,20 +1162,22 @@ var file_construct_v1_event_proto_depIdxs = []int32{
 	9,  // 8: construct.v1.Event.model_provider:type_name -> construct.v1.ModelProviderEvent
 	10, // 9: construct.v1.Event.tool_called:type_name -> construct.v1.ToolCalledEvent
 	11, // 10: construct.v1.Event.tool_result:type_name -> construct.v1.ToolResultEvent
-	13, // 11: construct.v1.TaskEvent.task:type_name -> construct.v1.Task
-	14, // 12: construct.v1.MessageEvent.message:type_name -> construct.v1.Message
-	15, // 13: construct.v1.AgentEvent.agent:type_name -> construct.v1.Agent
-	16, // 14: construct.v1.ModelEvent.model:type_name -> construct.v1.Model
-	17, // 15: construct.v1.ModelProviderEvent.model_provider:type_name -> construct.v1.ModelProvider
-	18, // 16: construct.v1.ToolCalledEvent.tool_call:type_name -> construct.v1.ToolCall
-	19, // 17: construct.v1.ToolResultEvent.tool_result:type_name -> construct.v1.ToolResult
-	1,  // 18: construct.v1.EventService.Subscribe:input_type -> construct.v1.EventSubscribeRequest
-	2,  // 19: construct.v1.EventService.Subscribe:output_type -> construct.v1.EventSubscribeResponse
-	19, // [19:20] is the sub-list for method output_type
-	18, // [18:19] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	12, // 11: construct.v1.Event.task_model_fallback:type_name -> construct.v1.TaskModelFallbackEvent
+	13, // 12: construct.v1.Event.stream_gap:type_name -> construct.v1.StreamGapEvent
+	15, // 13: construct.v1.TaskEvent.task:type_name -> construct.v1.Task
+	16, // 14: construct.v1.MessageEvent.message:type_name -> construct.v1.Message
+	17, // 15: construct.v1.AgentEvent.agent:type_name -> construct.v1.Agent
+	18, // 16: construct.v1.ModelEvent.model:type_name -> construct.v1.Model
+	19, // 17: construct.v1.ModelProviderEvent.model_provider:type_name -> construct.v1.ModelProvider
+	20, // 18: construct.v1.ToolCalledEvent.tool_call:type_name -> construct.v1.ToolCall
+	21, // 19: construct.v1.ToolResultEvent.tool_result:type_name -> construct.v1.ToolResult
+	1,  // 20: construct.v1.EventService.Subscribe:input_type -> construct.v1.EventSubscribeRequest
+	2,  // 21: construct.v1.EventService.Subscribe:output_type -> construct.v1.EventSubscribeResponse
+	21, // [21:22] is the sub-list for method output_type
+	20, // [20:21] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_event_proto_init() }
@@ -983,6 +1200,8 @@ func file_construct_v1_event_proto_init() {
 		(*Event_ModelProvider)(nil),
 		(*Event_ToolCalled)(nil),
 		(*Event_ToolResult)(nil),
+		(*Event_TaskModelFallback)(nil),
+		(*Event_StreamGap)(nil),
 	}
 	file_construct_v1_event_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
@@ -991,7 +1210,7 @@ func file_construct_v1_event_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_event_proto_rawDesc), len(file_construct_v1_event_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
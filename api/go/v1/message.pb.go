@@ -164,7 +164,9 @@ type MessageMetadata struct {
 	// model_id references the AI model used to generate this message, if applicable (UUID format, optional).
 	ModelId *string `protobuf:"bytes,6,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
 	// role indicates whether this message came from a user or assistant.
-	Role          MessageRole `protobuf:"varint,7,opt,name=role,proto3,enum=construct.v1.MessageRole" json:"role,omitempty"`
+	Role MessageRole `protobuf:"varint,7,opt,name=role,proto3,enum=construct.v1.MessageRole" json:"role,omitempty"`
+	// owner is the subject of the identity that created the message (empty for assistant messages).
+	Owner         string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -248,6 +250,13 @@ func (x *MessageMetadata) GetRole() MessageRole {
 	return MessageRole_MESSAGE_ROLE_UNSPECIFIED
 }
 
+func (x *MessageMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 // MessageSpec defines the user-configurable specification of a message.
 type MessageSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -301,8 +310,13 @@ type MessageStatus struct {
 	Usage *MessageUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
 	// is_final_response indicates whether this message is the final response to the user's request.
 	IsFinalResponse bool `protobuf:"varint,3,opt,name=is_final_response,json=isFinalResponse,proto3" json:"is_final_response,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// routing describes why the model that generated this message was chosen (assistant messages only).
+	Routing *MessageRouting `protobuf:"bytes,4,opt,name=routing,proto3" json:"routing,omitempty"`
+	// trace_id is the OpenTelemetry trace ID of the reconcile pass that generated this message
+	// (assistant messages only). It is empty if tracing was disabled.
+	TraceId       string `protobuf:"bytes,5,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MessageStatus) Reset() {
@@ -349,6 +363,111 @@ func (x *MessageStatus) GetIsFinalResponse() bool {
 	return false
 }
 
+func (x *MessageStatus) GetRouting() *MessageRouting {
+	if x != nil {
+		return x.Routing
+	}
+	return nil
+}
+
+func (x *MessageStatus) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+// MessageRouting records the routing decision for an assistant message.
+type MessageRouting struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rule is the name of the matching routing rule (empty if the agent's default model was used).
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// turn_type is the kind of turn that triggered the model invocation.
+	TurnType TurnType `protobuf:"varint,2,opt,name=turn_type,json=turnType,proto3,enum=construct.v1.TurnType" json:"turn_type,omitempty"`
+	// estimated_prompt_tokens is the estimated size of the prompt in tokens.
+	EstimatedPromptTokens int64 `protobuf:"varint,3,opt,name=estimated_prompt_tokens,json=estimatedPromptTokens,proto3" json:"estimated_prompt_tokens,omitempty"`
+	// recent_tool_failures is the number of failed tool calls in the recent history.
+	RecentToolFailures int64 `protobuf:"varint,4,opt,name=recent_tool_failures,json=recentToolFailures,proto3" json:"recent_tool_failures,omitempty"`
+	// default_model_id references the agent's default model (UUID format).
+	DefaultModelId string `protobuf:"bytes,5,opt,name=default_model_id,json=defaultModelId,proto3" json:"default_model_id,omitempty"`
+	// default_model_cost is what the message would have cost on the agent's default model.
+	DefaultModelCost float64 `protobuf:"fixed64,6,opt,name=default_model_cost,json=defaultModelCost,proto3" json:"default_model_cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MessageRouting) Reset() {
+	*x = MessageRouting{}
+	mi := &file_construct_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageRouting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRouting) ProtoMessage() {}
+
+func (x *MessageRouting) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRouting.ProtoReflect.Descriptor instead.
+func (*MessageRouting) Descriptor() ([]byte, []int) {
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageRouting) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *MessageRouting) GetTurnType() TurnType {
+	if x != nil {
+		return x.TurnType
+	}
+	return TurnType_TURN_TYPE_UNSPECIFIED
+}
+
+func (x *MessageRouting) GetEstimatedPromptTokens() int64 {
+	if x != nil {
+		return x.EstimatedPromptTokens
+	}
+	return 0
+}
+
+func (x *MessageRouting) GetRecentToolFailures() int64 {
+	if x != nil {
+		return x.RecentToolFailures
+	}
+	return 0
+}
+
+func (x *MessageRouting) GetDefaultModelId() string {
+	if x != nil {
+		return x.DefaultModelId
+	}
+	return ""
+}
+
+func (x *MessageRouting) GetDefaultModelCost() float64 {
+	if x != nil {
+		return x.DefaultModelCost
+	}
+	return 0
+}
+
 // MessagePart contains the actual content of a message, supporting different content types.
 type MessagePart struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -367,7 +486,7 @@ type MessagePart struct {
 
 func (x *MessagePart) Reset() {
 	*x = MessagePart{}
-	mi := &file_construct_v1_message_proto_msgTypes[4]
+	mi := &file_construct_v1_message_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -379,7 +498,7 @@ func (x *MessagePart) String() string {
 func (*MessagePart) ProtoMessage() {}
 
 func (x *MessagePart) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[4]
+	mi := &file_construct_v1_message_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +511,7 @@ func (x *MessagePart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessagePart.ProtoReflect.Descriptor instead.
 func (*MessagePart) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{4}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MessagePart) GetData() isMessagePart_Data {
@@ -481,14 +600,19 @@ type MessageUsage struct {
 	// cache_read_tokens is the number of tokens read from cache during message generation.
 	CacheReadTokens int64 `protobuf:"varint,4,opt,name=cache_read_tokens,json=cacheReadTokens,proto3" json:"cache_read_tokens,omitempty"`
 	// cost is the monetary cost associated with generating this message.
-	Cost          float64 `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Cost float64 `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	// estimated_input_tokens is the prompt size that was estimated before the model was invoked.
+	EstimatedInputTokens int64 `protobuf:"varint,6,opt,name=estimated_input_tokens,json=estimatedInputTokens,proto3" json:"estimated_input_tokens,omitempty"`
+	// estimate_source is how the prompt size was estimated: "provider" if the model provider
+	// counted the tokens, "approximation" if they were approximated locally.
+	EstimateSource string `protobuf:"bytes,7,opt,name=estimate_source,json=estimateSource,proto3" json:"estimate_source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MessageUsage) Reset() {
 	*x = MessageUsage{}
-	mi := &file_construct_v1_message_proto_msgTypes[5]
+	mi := &file_construct_v1_message_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -500,7 +624,7 @@ func (x *MessageUsage) String() string {
 func (*MessageUsage) ProtoMessage() {}
 
 func (x *MessageUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[5]
+	mi := &file_construct_v1_message_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -513,7 +637,7 @@ func (x *MessageUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageUsage.ProtoReflect.Descriptor instead.
 func (*MessageUsage) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{5}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MessageUsage) GetInputTokens() int64 {
@@ -551,6 +675,20 @@ func (x *MessageUsage) GetCost() float64 {
 	return 0
 }
 
+func (x *MessageUsage) GetEstimatedInputTokens() int64 {
+	if x != nil {
+		return x.EstimatedInputTokens
+	}
+	return 0
+}
+
+func (x *MessageUsage) GetEstimateSource() string {
+	if x != nil {
+		return x.EstimateSource
+	}
+	return ""
+}
+
 // CreateMessageRequest contains the parameters needed to create a new message.
 type CreateMessageRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -564,7 +702,7 @@ type CreateMessageRequest struct {
 
 func (x *CreateMessageRequest) Reset() {
 	*x = CreateMessageRequest{}
-	mi := &file_construct_v1_message_proto_msgTypes[6]
+	mi := &file_construct_v1_message_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -576,7 +714,7 @@ func (x *CreateMessageRequest) String() string {
 func (*CreateMessageRequest) ProtoMessage() {}
 
 func (x *CreateMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[6]
+	mi := &file_construct_v1_message_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +727,7 @@ func (x *CreateMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMessageRequest.ProtoReflect.Descriptor instead.
 func (*CreateMessageRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{6}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateMessageRequest) GetTaskId() string {
@@ -617,7 +755,7 @@ type CreateMessageResponse struct {
 
 func (x *CreateMessageResponse) Reset() {
 	*x = CreateMessageResponse{}
-	mi := &file_construct_v1_message_proto_msgTypes[7]
+	mi := &file_construct_v1_message_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -629,7 +767,7 @@ func (x *CreateMessageResponse) String() string {
 func (*CreateMessageResponse) ProtoMessage() {}
 
 func (x *CreateMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[7]
+	mi := &file_construct_v1_message_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,7 +780,7 @@ func (x *CreateMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateMessageResponse.ProtoReflect.Descriptor instead.
 func (*CreateMessageResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{7}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreateMessageResponse) GetMessage() *Message {
@@ -663,7 +801,7 @@ type GetMessageRequest struct {
 
 func (x *GetMessageRequest) Reset() {
 	*x = GetMessageRequest{}
-	mi := &file_construct_v1_message_proto_msgTypes[8]
+	mi := &file_construct_v1_message_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +813,7 @@ func (x *GetMessageRequest) String() string {
 func (*GetMessageRequest) ProtoMessage() {}
 
 func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[8]
+	mi := &file_construct_v1_message_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +826,7 @@ func (x *GetMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessageRequest.ProtoReflect.Descriptor instead.
 func (*GetMessageRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{8}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetMessageRequest) GetId() string {
@@ -709,7 +847,7 @@ type GetMessageResponse struct {
 
 func (x *GetMessageResponse) Reset() {
 	*x = GetMessageResponse{}
-	mi := &file_construct_v1_message_proto_msgTypes[9]
+	mi := &file_construct_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +859,7 @@ func (x *GetMessageResponse) String() string {
 func (*GetMessageResponse) ProtoMessage() {}
 
 func (x *GetMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[9]
+	mi := &file_construct_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +872,7 @@ func (x *GetMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMessageResponse.ProtoReflect.Descriptor instead.
 func (*GetMessageResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{9}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetMessageResponse) GetMessage() *Message {
@@ -763,7 +901,7 @@ type ListMessagesRequest struct {
 
 func (x *ListMessagesRequest) Reset() {
 	*x = ListMessagesRequest{}
-	mi := &file_construct_v1_message_proto_msgTypes[10]
+	mi := &file_construct_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -775,7 +913,7 @@ func (x *ListMessagesRequest) String() string {
 func (*ListMessagesRequest) ProtoMessage() {}
 
 func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[10]
+	mi := &file_construct_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,7 +926,7 @@ func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
 func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{10}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListMessagesRequest) GetFilter() *ListMessagesRequest_Filter {
@@ -839,7 +977,7 @@ type ListMessagesResponse struct {
 
 func (x *ListMessagesResponse) Reset() {
 	*x = ListMessagesResponse{}
-	mi := &file_construct_v1_message_proto_msgTypes[11]
+	mi := &file_construct_v1_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +989,7 @@ func (x *ListMessagesResponse) String() string {
 func (*ListMessagesResponse) ProtoMessage() {}
 
 func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[11]
+	mi := &file_construct_v1_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +1002,7 @@ func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
 func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{11}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListMessagesResponse) GetMessages() []*Message {
@@ -894,7 +1032,7 @@ type UpdateMessageRequest struct {
 
 func (x *UpdateMessageRequest) Reset() {
 	*x = UpdateMessageRequest{}
-	mi := &file_construct_v1_message_proto_msgTypes[12]
+	mi := &file_construct_v1_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1044,7 @@ func (x *UpdateMessageRequest) String() string {
 func (*UpdateMessageRequest) ProtoMessage() {}
 
 func (x *UpdateMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[12]
+	mi := &file_construct_v1_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1057,7 @@ func (x *UpdateMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMessageRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMessageRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{12}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateMessageRequest) GetId() string {
@@ -947,7 +1085,7 @@ type UpdateMessageResponse struct {
 
 func (x *UpdateMessageResponse) Reset() {
 	*x = UpdateMessageResponse{}
-	mi := &file_construct_v1_message_proto_msgTypes[13]
+	mi := &file_construct_v1_message_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -959,7 +1097,7 @@ func (x *UpdateMessageResponse) String() string {
 func (*UpdateMessageResponse) ProtoMessage() {}
 
 func (x *UpdateMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[13]
+	mi := &file_construct_v1_message_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -972,7 +1110,7 @@ func (x *UpdateMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMessageResponse.ProtoReflect.Descriptor instead.
 func (*UpdateMessageResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{13}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateMessageResponse) GetMessage() *Message {
@@ -993,7 +1131,7 @@ type DeleteMessageRequest struct {
 
 func (x *DeleteMessageRequest) Reset() {
 	*x = DeleteMessageRequest{}
-	mi := &file_construct_v1_message_proto_msgTypes[14]
+	mi := &file_construct_v1_message_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1143,7 @@ func (x *DeleteMessageRequest) String() string {
 func (*DeleteMessageRequest) ProtoMessage() {}
 
 func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[14]
+	mi := &file_construct_v1_message_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1156,7 @@ func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteMessageRequest) GetId() string {
@@ -1037,7 +1175,7 @@ type DeleteMessageResponse struct {
 
 func (x *DeleteMessageResponse) Reset() {
 	*x = DeleteMessageResponse{}
-	mi := &file_construct_v1_message_proto_msgTypes[15]
+	mi := &file_construct_v1_message_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1049,7 +1187,7 @@ func (x *DeleteMessageResponse) String() string {
 func (*DeleteMessageResponse) ProtoMessage() {}
 
 func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[15]
+	mi := &file_construct_v1_message_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1062,7 +1200,7 @@ func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{16}
 }
 
 type ToolCall struct {
@@ -1090,7 +1228,7 @@ type ToolCall struct {
 
 func (x *ToolCall) Reset() {
 	*x = ToolCall{}
-	mi := &file_construct_v1_message_proto_msgTypes[16]
+	mi := &file_construct_v1_message_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1102,7 +1240,7 @@ func (x *ToolCall) String() string {
 func (*ToolCall) ProtoMessage() {}
 
 func (x *ToolCall) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[16]
+	mi := &file_construct_v1_message_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,7 +1253,7 @@ func (x *ToolCall) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall.ProtoReflect.Descriptor instead.
 func (*ToolCall) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ToolCall) GetId() string {
@@ -1347,7 +1485,7 @@ type ToolResult struct {
 
 func (x *ToolResult) Reset() {
 	*x = ToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[17]
+	mi := &file_construct_v1_message_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1359,7 +1497,7 @@ func (x *ToolResult) String() string {
 func (*ToolResult) ProtoMessage() {}
 
 func (x *ToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[17]
+	mi := &file_construct_v1_message_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1372,7 +1510,7 @@ func (x *ToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult.ProtoReflect.Descriptor instead.
 func (*ToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ToolResult) GetId() string {
@@ -1567,7 +1705,7 @@ type CreateFileToolResult struct {
 
 func (x *CreateFileToolResult) Reset() {
 	*x = CreateFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[18]
+	mi := &file_construct_v1_message_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1579,7 +1717,7 @@ func (x *CreateFileToolResult) String() string {
 func (*CreateFileToolResult) ProtoMessage() {}
 
 func (x *CreateFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[18]
+	mi := &file_construct_v1_message_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,7 +1730,7 @@ func (x *CreateFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFileToolResult.ProtoReflect.Descriptor instead.
 func (*CreateFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{18}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateFileToolResult) GetInput() *CreateFileToolResult_Input {
@@ -1619,7 +1757,7 @@ type EditFileToolResult struct {
 
 func (x *EditFileToolResult) Reset() {
 	*x = EditFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[19]
+	mi := &file_construct_v1_message_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1631,7 +1769,7 @@ func (x *EditFileToolResult) String() string {
 func (*EditFileToolResult) ProtoMessage() {}
 
 func (x *EditFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[19]
+	mi := &file_construct_v1_message_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +1782,7 @@ func (x *EditFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EditFileToolResult.ProtoReflect.Descriptor instead.
 func (*EditFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{19}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *EditFileToolResult) GetFilePath() string {
@@ -1672,7 +1810,7 @@ type ExecuteCommandToolResult struct {
 
 func (x *ExecuteCommandToolResult) Reset() {
 	*x = ExecuteCommandToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[20]
+	mi := &file_construct_v1_message_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1684,7 +1822,7 @@ func (x *ExecuteCommandToolResult) String() string {
 func (*ExecuteCommandToolResult) ProtoMessage() {}
 
 func (x *ExecuteCommandToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[20]
+	mi := &file_construct_v1_message_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1697,7 +1835,7 @@ func (x *ExecuteCommandToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteCommandToolResult.ProtoReflect.Descriptor instead.
 func (*ExecuteCommandToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{20}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ExecuteCommandToolResult) GetCommand() string {
@@ -1730,7 +1868,7 @@ type FindFileToolResult struct {
 
 func (x *FindFileToolResult) Reset() {
 	*x = FindFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[21]
+	mi := &file_construct_v1_message_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1742,7 +1880,7 @@ func (x *FindFileToolResult) String() string {
 func (*FindFileToolResult) ProtoMessage() {}
 
 func (x *FindFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[21]
+	mi := &file_construct_v1_message_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,7 +1893,7 @@ func (x *FindFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FindFileToolResult.ProtoReflect.Descriptor instead.
 func (*FindFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{21}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FindFileToolResult) GetFilePath() string {
@@ -1775,7 +1913,7 @@ type GrepToolResult struct {
 
 func (x *GrepToolResult) Reset() {
 	*x = GrepToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[22]
+	mi := &file_construct_v1_message_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1787,7 +1925,7 @@ func (x *GrepToolResult) String() string {
 func (*GrepToolResult) ProtoMessage() {}
 
 func (x *GrepToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[22]
+	mi := &file_construct_v1_message_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1800,7 +1938,7 @@ func (x *GrepToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GrepToolResult.ProtoReflect.Descriptor instead.
 func (*GrepToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{22}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GrepToolResult) GetFilePath() string {
@@ -1825,7 +1963,7 @@ type HandoffToolResult struct {
 
 func (x *HandoffToolResult) Reset() {
 	*x = HandoffToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[23]
+	mi := &file_construct_v1_message_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1837,7 +1975,7 @@ func (x *HandoffToolResult) String() string {
 func (*HandoffToolResult) ProtoMessage() {}
 
 func (x *HandoffToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[23]
+	mi := &file_construct_v1_message_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,7 +1988,7 @@ func (x *HandoffToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HandoffToolResult.ProtoReflect.Descriptor instead.
 func (*HandoffToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{23}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{24}
 }
 
 type ListFilesToolResult struct {
@@ -1861,7 +1999,7 @@ type ListFilesToolResult struct {
 
 func (x *ListFilesToolResult) Reset() {
 	*x = ListFilesToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[24]
+	mi := &file_construct_v1_message_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1873,7 +2011,7 @@ func (x *ListFilesToolResult) String() string {
 func (*ListFilesToolResult) ProtoMessage() {}
 
 func (x *ListFilesToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[24]
+	mi := &file_construct_v1_message_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1886,7 +2024,7 @@ func (x *ListFilesToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFilesToolResult.ProtoReflect.Descriptor instead.
 func (*ListFilesToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{24}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{25}
 }
 
 type ReadFileToolResult struct {
@@ -1897,7 +2035,7 @@ type ReadFileToolResult struct {
 
 func (x *ReadFileToolResult) Reset() {
 	*x = ReadFileToolResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[25]
+	mi := &file_construct_v1_message_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1909,7 +2047,7 @@ func (x *ReadFileToolResult) String() string {
 func (*ReadFileToolResult) ProtoMessage() {}
 
 func (x *ReadFileToolResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[25]
+	mi := &file_construct_v1_message_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1922,7 +2060,7 @@ func (x *ReadFileToolResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReadFileToolResult.ProtoReflect.Descriptor instead.
 func (*ReadFileToolResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{25}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{26}
 }
 
 type SubmitReport struct {
@@ -1937,7 +2075,7 @@ type SubmitReport struct {
 
 func (x *SubmitReport) Reset() {
 	*x = SubmitReport{}
-	mi := &file_construct_v1_message_proto_msgTypes[26]
+	mi := &file_construct_v1_message_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1949,7 +2087,7 @@ func (x *SubmitReport) String() string {
 func (*SubmitReport) ProtoMessage() {}
 
 func (x *SubmitReport) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[26]
+	mi := &file_construct_v1_message_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,7 +2100,7 @@ func (x *SubmitReport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitReport.ProtoReflect.Descriptor instead.
 func (*SubmitReport) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{26}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SubmitReport) GetSummary() string {
@@ -2003,7 +2141,7 @@ type ToolError struct {
 
 func (x *ToolError) Reset() {
 	*x = ToolError{}
-	mi := &file_construct_v1_message_proto_msgTypes[27]
+	mi := &file_construct_v1_message_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2015,7 +2153,7 @@ func (x *ToolError) String() string {
 func (*ToolError) ProtoMessage() {}
 
 func (x *ToolError) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[27]
+	mi := &file_construct_v1_message_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2028,7 +2166,7 @@ func (x *ToolError) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolError.ProtoReflect.Descriptor instead.
 func (*ToolError) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{27}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ToolError) GetMessage() string {
@@ -2054,7 +2192,7 @@ type MessagePart_Text struct {
 
 func (x *MessagePart_Text) Reset() {
 	*x = MessagePart_Text{}
-	mi := &file_construct_v1_message_proto_msgTypes[28]
+	mi := &file_construct_v1_message_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2066,7 +2204,7 @@ func (x *MessagePart_Text) String() string {
 func (*MessagePart_Text) ProtoMessage() {}
 
 func (x *MessagePart_Text) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[28]
+	mi := &file_construct_v1_message_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2079,7 +2217,7 @@ func (x *MessagePart_Text) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessagePart_Text.ProtoReflect.Descriptor instead.
 func (*MessagePart_Text) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{4, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{5, 0}
 }
 
 func (x *MessagePart_Text) GetContent() string {
@@ -2098,7 +2236,7 @@ type MessagePart_Error struct {
 
 func (x *MessagePart_Error) Reset() {
 	*x = MessagePart_Error{}
-	mi := &file_construct_v1_message_proto_msgTypes[29]
+	mi := &file_construct_v1_message_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2110,7 +2248,7 @@ func (x *MessagePart_Error) String() string {
 func (*MessagePart_Error) ProtoMessage() {}
 
 func (x *MessagePart_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[29]
+	mi := &file_construct_v1_message_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,7 +2261,7 @@ func (x *MessagePart_Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessagePart_Error.ProtoReflect.Descriptor instead.
 func (*MessagePart_Error) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{4, 1}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{5, 1}
 }
 
 func (x *MessagePart_Error) GetMessage() string {
@@ -2148,7 +2286,7 @@ type ListMessagesRequest_Filter struct {
 
 func (x *ListMessagesRequest_Filter) Reset() {
 	*x = ListMessagesRequest_Filter{}
-	mi := &file_construct_v1_message_proto_msgTypes[30]
+	mi := &file_construct_v1_message_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2160,7 +2298,7 @@ func (x *ListMessagesRequest_Filter) String() string {
 func (*ListMessagesRequest_Filter) ProtoMessage() {}
 
 func (x *ListMessagesRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[30]
+	mi := &file_construct_v1_message_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,7 +2311,7 @@ func (x *ListMessagesRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMessagesRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListMessagesRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{10, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *ListMessagesRequest_Filter) GetTaskIds() string {
@@ -2206,7 +2344,7 @@ type ToolCall_CodeInterpreterInput struct {
 
 func (x *ToolCall_CodeInterpreterInput) Reset() {
 	*x = ToolCall_CodeInterpreterInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[31]
+	mi := &file_construct_v1_message_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2218,7 +2356,7 @@ func (x *ToolCall_CodeInterpreterInput) String() string {
 func (*ToolCall_CodeInterpreterInput) ProtoMessage() {}
 
 func (x *ToolCall_CodeInterpreterInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[31]
+	mi := &file_construct_v1_message_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2231,7 +2369,7 @@ func (x *ToolCall_CodeInterpreterInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_CodeInterpreterInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_CodeInterpreterInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *ToolCall_CodeInterpreterInput) GetCode() string {
@@ -2251,7 +2389,7 @@ type ToolCall_CreateFileInput struct {
 
 func (x *ToolCall_CreateFileInput) Reset() {
 	*x = ToolCall_CreateFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[32]
+	mi := &file_construct_v1_message_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2263,7 +2401,7 @@ func (x *ToolCall_CreateFileInput) String() string {
 func (*ToolCall_CreateFileInput) ProtoMessage() {}
 
 func (x *ToolCall_CreateFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[32]
+	mi := &file_construct_v1_message_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2276,7 +2414,7 @@ func (x *ToolCall_CreateFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_CreateFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_CreateFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 1}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 1}
 }
 
 func (x *ToolCall_CreateFileInput) GetPath() string {
@@ -2303,7 +2441,7 @@ type ToolCall_EditFileInput struct {
 
 func (x *ToolCall_EditFileInput) Reset() {
 	*x = ToolCall_EditFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[33]
+	mi := &file_construct_v1_message_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2315,7 +2453,7 @@ func (x *ToolCall_EditFileInput) String() string {
 func (*ToolCall_EditFileInput) ProtoMessage() {}
 
 func (x *ToolCall_EditFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[33]
+	mi := &file_construct_v1_message_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2328,7 +2466,7 @@ func (x *ToolCall_EditFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_EditFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_EditFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 2}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2}
 }
 
 func (x *ToolCall_EditFileInput) GetPath() string {
@@ -2354,7 +2492,7 @@ type ToolCall_ExecuteCommandInput struct {
 
 func (x *ToolCall_ExecuteCommandInput) Reset() {
 	*x = ToolCall_ExecuteCommandInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[34]
+	mi := &file_construct_v1_message_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2366,7 +2504,7 @@ func (x *ToolCall_ExecuteCommandInput) String() string {
 func (*ToolCall_ExecuteCommandInput) ProtoMessage() {}
 
 func (x *ToolCall_ExecuteCommandInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[34]
+	mi := &file_construct_v1_message_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2379,7 +2517,7 @@ func (x *ToolCall_ExecuteCommandInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_ExecuteCommandInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_ExecuteCommandInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 3}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 3}
 }
 
 func (x *ToolCall_ExecuteCommandInput) GetCommand() string {
@@ -2401,7 +2539,7 @@ type ToolCall_FindFileInput struct {
 
 func (x *ToolCall_FindFileInput) Reset() {
 	*x = ToolCall_FindFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[35]
+	mi := &file_construct_v1_message_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2413,7 +2551,7 @@ func (x *ToolCall_FindFileInput) String() string {
 func (*ToolCall_FindFileInput) ProtoMessage() {}
 
 func (x *ToolCall_FindFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[35]
+	mi := &file_construct_v1_message_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,7 +2564,7 @@ func (x *ToolCall_FindFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_FindFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_FindFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 4}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 4}
 }
 
 func (x *ToolCall_FindFileInput) GetPattern() string {
@@ -2471,7 +2609,7 @@ type ToolCall_GrepInput struct {
 
 func (x *ToolCall_GrepInput) Reset() {
 	*x = ToolCall_GrepInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[36]
+	mi := &file_construct_v1_message_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2483,7 +2621,7 @@ func (x *ToolCall_GrepInput) String() string {
 func (*ToolCall_GrepInput) ProtoMessage() {}
 
 func (x *ToolCall_GrepInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[36]
+	mi := &file_construct_v1_message_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2496,7 +2634,7 @@ func (x *ToolCall_GrepInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_GrepInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_GrepInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 5}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5}
 }
 
 func (x *ToolCall_GrepInput) GetQuery() string {
@@ -2551,7 +2689,7 @@ type ToolCall_HandoffInput struct {
 
 func (x *ToolCall_HandoffInput) Reset() {
 	*x = ToolCall_HandoffInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[37]
+	mi := &file_construct_v1_message_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2563,7 +2701,7 @@ func (x *ToolCall_HandoffInput) String() string {
 func (*ToolCall_HandoffInput) ProtoMessage() {}
 
 func (x *ToolCall_HandoffInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[37]
+	mi := &file_construct_v1_message_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2576,7 +2714,7 @@ func (x *ToolCall_HandoffInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_HandoffInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_HandoffInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 6}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6}
 }
 
 func (x *ToolCall_HandoffInput) GetRequestedAgent() string {
@@ -2603,7 +2741,7 @@ type ToolCall_AskUserInput struct {
 
 func (x *ToolCall_AskUserInput) Reset() {
 	*x = ToolCall_AskUserInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[38]
+	mi := &file_construct_v1_message_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2615,7 +2753,7 @@ func (x *ToolCall_AskUserInput) String() string {
 func (*ToolCall_AskUserInput) ProtoMessage() {}
 
 func (x *ToolCall_AskUserInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[38]
+	mi := &file_construct_v1_message_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,7 +2766,7 @@ func (x *ToolCall_AskUserInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_AskUserInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_AskUserInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 7}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 7}
 }
 
 func (x *ToolCall_AskUserInput) GetQuestion() string {
@@ -2655,7 +2793,7 @@ type ToolCall_ListFilesInput struct {
 
 func (x *ToolCall_ListFilesInput) Reset() {
 	*x = ToolCall_ListFilesInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[39]
+	mi := &file_construct_v1_message_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2667,7 +2805,7 @@ func (x *ToolCall_ListFilesInput) String() string {
 func (*ToolCall_ListFilesInput) ProtoMessage() {}
 
 func (x *ToolCall_ListFilesInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[39]
+	mi := &file_construct_v1_message_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2680,7 +2818,7 @@ func (x *ToolCall_ListFilesInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_ListFilesInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_ListFilesInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 8}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 8}
 }
 
 func (x *ToolCall_ListFilesInput) GetPath() string {
@@ -2708,7 +2846,7 @@ type ToolCall_ReadFileInput struct {
 
 func (x *ToolCall_ReadFileInput) Reset() {
 	*x = ToolCall_ReadFileInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[40]
+	mi := &file_construct_v1_message_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2720,7 +2858,7 @@ func (x *ToolCall_ReadFileInput) String() string {
 func (*ToolCall_ReadFileInput) ProtoMessage() {}
 
 func (x *ToolCall_ReadFileInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[40]
+	mi := &file_construct_v1_message_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,7 +2871,7 @@ func (x *ToolCall_ReadFileInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_ReadFileInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_ReadFileInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 9}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 9}
 }
 
 func (x *ToolCall_ReadFileInput) GetPath() string {
@@ -2769,7 +2907,7 @@ type ToolCall_SubmitReportInput struct {
 
 func (x *ToolCall_SubmitReportInput) Reset() {
 	*x = ToolCall_SubmitReportInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[41]
+	mi := &file_construct_v1_message_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2781,7 +2919,7 @@ func (x *ToolCall_SubmitReportInput) String() string {
 func (*ToolCall_SubmitReportInput) ProtoMessage() {}
 
 func (x *ToolCall_SubmitReportInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[41]
+	mi := &file_construct_v1_message_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2794,7 +2932,7 @@ func (x *ToolCall_SubmitReportInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_SubmitReportInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_SubmitReportInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 10}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 10}
 }
 
 func (x *ToolCall_SubmitReportInput) GetSummary() string {
@@ -2836,7 +2974,7 @@ type ToolCall_FetchInput struct {
 
 func (x *ToolCall_FetchInput) Reset() {
 	*x = ToolCall_FetchInput{}
-	mi := &file_construct_v1_message_proto_msgTypes[42]
+	mi := &file_construct_v1_message_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2848,7 +2986,7 @@ func (x *ToolCall_FetchInput) String() string {
 func (*ToolCall_FetchInput) ProtoMessage() {}
 
 func (x *ToolCall_FetchInput) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[42]
+	mi := &file_construct_v1_message_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,7 +2999,7 @@ func (x *ToolCall_FetchInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_FetchInput.ProtoReflect.Descriptor instead.
 func (*ToolCall_FetchInput) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 11}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 11}
 }
 
 func (x *ToolCall_FetchInput) GetUrl() string {
@@ -2895,7 +3033,7 @@ type ToolCall_EditFileInput_DiffPair struct {
 
 func (x *ToolCall_EditFileInput_DiffPair) Reset() {
 	*x = ToolCall_EditFileInput_DiffPair{}
-	mi := &file_construct_v1_message_proto_msgTypes[43]
+	mi := &file_construct_v1_message_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2907,7 +3045,7 @@ func (x *ToolCall_EditFileInput_DiffPair) String() string {
 func (*ToolCall_EditFileInput_DiffPair) ProtoMessage() {}
 
 func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[43]
+	mi := &file_construct_v1_message_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2920,7 +3058,7 @@ func (x *ToolCall_EditFileInput_DiffPair) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolCall_EditFileInput_DiffPair.ProtoReflect.Descriptor instead.
 func (*ToolCall_EditFileInput_DiffPair) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{16, 2, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2, 0}
 }
 
 func (x *ToolCall_EditFileInput_DiffPair) GetOld() string {
@@ -2946,7 +3084,7 @@ type ToolResult_CodeInterpreterResult struct {
 
 func (x *ToolResult_CodeInterpreterResult) Reset() {
 	*x = ToolResult_CodeInterpreterResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[45]
+	mi := &file_construct_v1_message_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2958,7 +3096,7 @@ func (x *ToolResult_CodeInterpreterResult) String() string {
 func (*ToolResult_CodeInterpreterResult) ProtoMessage() {}
 
 func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[45]
+	mi := &file_construct_v1_message_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2971,7 +3109,7 @@ func (x *ToolResult_CodeInterpreterResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_CodeInterpreterResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_CodeInterpreterResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 0}
 }
 
 func (x *ToolResult_CodeInterpreterResult) GetOutput() string {
@@ -2990,7 +3128,7 @@ type ToolResult_CreateFileResult struct {
 
 func (x *ToolResult_CreateFileResult) Reset() {
 	*x = ToolResult_CreateFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[46]
+	mi := &file_construct_v1_message_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3002,7 +3140,7 @@ func (x *ToolResult_CreateFileResult) String() string {
 func (*ToolResult_CreateFileResult) ProtoMessage() {}
 
 func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[46]
+	mi := &file_construct_v1_message_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3015,7 +3153,7 @@ func (x *ToolResult_CreateFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_CreateFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_CreateFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 1}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 1}
 }
 
 func (x *ToolResult_CreateFileResult) GetOverwritten() bool {
@@ -3035,7 +3173,7 @@ type ToolResult_EditFileResult struct {
 
 func (x *ToolResult_EditFileResult) Reset() {
 	*x = ToolResult_EditFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[47]
+	mi := &file_construct_v1_message_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3047,7 +3185,7 @@ func (x *ToolResult_EditFileResult) String() string {
 func (*ToolResult_EditFileResult) ProtoMessage() {}
 
 func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[47]
+	mi := &file_construct_v1_message_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3060,7 +3198,7 @@ func (x *ToolResult_EditFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_EditFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_EditFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 2}
 }
 
 func (x *ToolResult_EditFileResult) GetPath() string {
@@ -3089,7 +3227,7 @@ type ToolResult_ExecuteCommandResult struct {
 
 func (x *ToolResult_ExecuteCommandResult) Reset() {
 	*x = ToolResult_ExecuteCommandResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[48]
+	mi := &file_construct_v1_message_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3101,7 +3239,7 @@ func (x *ToolResult_ExecuteCommandResult) String() string {
 func (*ToolResult_ExecuteCommandResult) ProtoMessage() {}
 
 func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[48]
+	mi := &file_construct_v1_message_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3114,7 +3252,7 @@ func (x *ToolResult_ExecuteCommandResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_ExecuteCommandResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_ExecuteCommandResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 3}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 3}
 }
 
 func (x *ToolResult_ExecuteCommandResult) GetStdout() string {
@@ -3156,7 +3294,7 @@ type ToolResult_FindFileResult struct {
 
 func (x *ToolResult_FindFileResult) Reset() {
 	*x = ToolResult_FindFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[49]
+	mi := &file_construct_v1_message_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3168,7 +3306,7 @@ func (x *ToolResult_FindFileResult) String() string {
 func (*ToolResult_FindFileResult) ProtoMessage() {}
 
 func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[49]
+	mi := &file_construct_v1_message_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3181,7 +3319,7 @@ func (x *ToolResult_FindFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_FindFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_FindFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 4}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 4}
 }
 
 func (x *ToolResult_FindFileResult) GetFiles() []string {
@@ -3217,7 +3355,7 @@ type ToolResult_GrepResult struct {
 
 func (x *ToolResult_GrepResult) Reset() {
 	*x = ToolResult_GrepResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[50]
+	mi := &file_construct_v1_message_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3229,7 +3367,7 @@ func (x *ToolResult_GrepResult) String() string {
 func (*ToolResult_GrepResult) ProtoMessage() {}
 
 func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[50]
+	mi := &file_construct_v1_message_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3242,7 +3380,7 @@ func (x *ToolResult_GrepResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_GrepResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_GrepResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 5}
 }
 
 func (x *ToolResult_GrepResult) GetMatches() []*ToolResult_GrepResult_GrepMatch {
@@ -3283,7 +3421,7 @@ type ToolResult_ListFilesResult struct {
 
 func (x *ToolResult_ListFilesResult) Reset() {
 	*x = ToolResult_ListFilesResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[51]
+	mi := &file_construct_v1_message_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3295,7 +3433,7 @@ func (x *ToolResult_ListFilesResult) String() string {
 func (*ToolResult_ListFilesResult) ProtoMessage() {}
 
 func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[51]
+	mi := &file_construct_v1_message_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3308,7 +3446,7 @@ func (x *ToolResult_ListFilesResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_ListFilesResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_ListFilesResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 6}
 }
 
 func (x *ToolResult_ListFilesResult) GetPath() string {
@@ -3335,7 +3473,7 @@ type ToolResult_ReadFileResult struct {
 
 func (x *ToolResult_ReadFileResult) Reset() {
 	*x = ToolResult_ReadFileResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[52]
+	mi := &file_construct_v1_message_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3347,7 +3485,7 @@ func (x *ToolResult_ReadFileResult) String() string {
 func (*ToolResult_ReadFileResult) ProtoMessage() {}
 
 func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[52]
+	mi := &file_construct_v1_message_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,7 +3498,7 @@ func (x *ToolResult_ReadFileResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_ReadFileResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_ReadFileResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 7}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 7}
 }
 
 func (x *ToolResult_ReadFileResult) GetPath() string {
@@ -3389,7 +3527,7 @@ type ToolResult_SubmitReportResult struct {
 
 func (x *ToolResult_SubmitReportResult) Reset() {
 	*x = ToolResult_SubmitReportResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[53]
+	mi := &file_construct_v1_message_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3401,7 +3539,7 @@ func (x *ToolResult_SubmitReportResult) String() string {
 func (*ToolResult_SubmitReportResult) ProtoMessage() {}
 
 func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[53]
+	mi := &file_construct_v1_message_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,7 +3552,7 @@ func (x *ToolResult_SubmitReportResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_SubmitReportResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_SubmitReportResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 8}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 8}
 }
 
 func (x *ToolResult_SubmitReportResult) GetSummary() string {
@@ -3459,7 +3597,7 @@ type ToolResult_FetchResult struct {
 
 func (x *ToolResult_FetchResult) Reset() {
 	*x = ToolResult_FetchResult{}
-	mi := &file_construct_v1_message_proto_msgTypes[54]
+	mi := &file_construct_v1_message_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3471,7 +3609,7 @@ func (x *ToolResult_FetchResult) String() string {
 func (*ToolResult_FetchResult) ProtoMessage() {}
 
 func (x *ToolResult_FetchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[54]
+	mi := &file_construct_v1_message_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3484,7 +3622,7 @@ func (x *ToolResult_FetchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_FetchResult.ProtoReflect.Descriptor instead.
 func (*ToolResult_FetchResult) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 9}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 9}
 }
 
 func (x *ToolResult_FetchResult) GetUrl() string {
@@ -3540,7 +3678,7 @@ type ToolResult_EditFileResult_PatchInfo struct {
 
 func (x *ToolResult_EditFileResult_PatchInfo) Reset() {
 	*x = ToolResult_EditFileResult_PatchInfo{}
-	mi := &file_construct_v1_message_proto_msgTypes[55]
+	mi := &file_construct_v1_message_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3552,7 +3690,7 @@ func (x *ToolResult_EditFileResult_PatchInfo) String() string {
 func (*ToolResult_EditFileResult_PatchInfo) ProtoMessage() {}
 
 func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[55]
+	mi := &file_construct_v1_message_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3565,7 +3703,7 @@ func (x *ToolResult_EditFileResult_PatchInfo) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ToolResult_EditFileResult_PatchInfo.ProtoReflect.Descriptor instead.
 func (*ToolResult_EditFileResult_PatchInfo) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 2, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 2, 0}
 }
 
 func (x *ToolResult_EditFileResult_PatchInfo) GetPatch() string {
@@ -3599,7 +3737,7 @@ type ToolResult_GrepResult_GrepMatch struct {
 
 func (x *ToolResult_GrepResult_GrepMatch) Reset() {
 	*x = ToolResult_GrepResult_GrepMatch{}
-	mi := &file_construct_v1_message_proto_msgTypes[56]
+	mi := &file_construct_v1_message_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3611,7 +3749,7 @@ func (x *ToolResult_GrepResult_GrepMatch) String() string {
 func (*ToolResult_GrepResult_GrepMatch) ProtoMessage() {}
 
 func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[56]
+	mi := &file_construct_v1_message_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,7 +3762,7 @@ func (x *ToolResult_GrepResult_GrepMatch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToolResult_GrepResult_GrepMatch.ProtoReflect.Descriptor instead.
 func (*ToolResult_GrepResult_GrepMatch) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 5, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 5, 0}
 }
 
 func (x *ToolResult_GrepResult_GrepMatch) GetFilePath() string {
@@ -3652,7 +3790,7 @@ type ToolResult_ListFilesResult_DirectoryEntry struct {
 
 func (x *ToolResult_ListFilesResult_DirectoryEntry) Reset() {
 	*x = ToolResult_ListFilesResult_DirectoryEntry{}
-	mi := &file_construct_v1_message_proto_msgTypes[57]
+	mi := &file_construct_v1_message_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3664,7 +3802,7 @@ func (x *ToolResult_ListFilesResult_DirectoryEntry) String() string {
 func (*ToolResult_ListFilesResult_DirectoryEntry) ProtoMessage() {}
 
 func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[57]
+	mi := &file_construct_v1_message_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3677,7 +3815,7 @@ func (x *ToolResult_ListFilesResult_DirectoryEntry) ProtoReflect() protoreflect.
 
 // Deprecated: Use ToolResult_ListFilesResult_DirectoryEntry.ProtoReflect.Descriptor instead.
 func (*ToolResult_ListFilesResult_DirectoryEntry) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{17, 6, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 6, 0}
 }
 
 func (x *ToolResult_ListFilesResult_DirectoryEntry) GetName() string {
@@ -3711,7 +3849,7 @@ type CreateFileToolResult_Input struct {
 
 func (x *CreateFileToolResult_Input) Reset() {
 	*x = CreateFileToolResult_Input{}
-	mi := &file_construct_v1_message_proto_msgTypes[58]
+	mi := &file_construct_v1_message_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3723,7 +3861,7 @@ func (x *CreateFileToolResult_Input) String() string {
 func (*CreateFileToolResult_Input) ProtoMessage() {}
 
 func (x *CreateFileToolResult_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_message_proto_msgTypes[58]
+	mi := &file_construct_v1_message_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +3874,7 @@ func (x *CreateFileToolResult_Input) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateFileToolResult_Input.ProtoReflect.Descriptor instead.
 func (*CreateFileToolResult_Input) Descriptor() ([]byte, []int) {
-	return file_construct_v1_message_proto_rawDescGZIP(), []int{18, 0}
+	return file_construct_v1_message_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *CreateFileToolResult_Input) GetFilePath() string {
@@ -3757,11 +3895,11 @@ var File_construct_v1_message_proto protoreflect.FileDescriptor
 
 const file_construct_v1_message_proto_rawDesc = "" +
 	"\n" +
-	"\x1aconstruct/v1/message.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x01\n" +
+	"\x1aconstruct/v1/message.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x18construct/v1/agent.proto\x1a\x19construct/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa8\x01\n" +
 	"\aMessage\x129\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1d.construct.v1.MessageMetadataR\bmetadata\x12-\n" +
 	"\x04spec\x18\x02 \x01(\v2\x19.construct.v1.MessageSpecR\x04spec\x123\n" +
-	"\x06status\x18\x03 \x01(\v2\x1b.construct.v1.MessageStatusR\x06status\"\xf1\x02\n" +
+	"\x06status\x18\x03 \x01(\v2\x1b.construct.v1.MessageStatusR\x06status\"\x87\x03\n" +
 	"\x0fMessageMetadata\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
 	"\n" +
@@ -3771,14 +3909,24 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\atask_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12(\n" +
 	"\bagent_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12(\n" +
 	"\bmodel_id\x18\x06 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x01R\amodelId\x88\x01\x01\x12-\n" +
-	"\x04role\x18\a \x01(\x0e2\x19.construct.v1.MessageRoleR\x04roleB\v\n" +
+	"\x04role\x18\a \x01(\x0e2\x19.construct.v1.MessageRoleR\x04role\x12\x14\n" +
+	"\x05owner\x18\b \x01(\tR\x05ownerB\v\n" +
 	"\t_agent_idB\v\n" +
 	"\t_model_id\"B\n" +
 	"\vMessageSpec\x123\n" +
-	"\acontent\x18\x01 \x03(\v2\x19.construct.v1.MessagePartR\acontent\"m\n" +
+	"\acontent\x18\x01 \x03(\v2\x19.construct.v1.MessagePartR\acontent\"\xc0\x01\n" +
 	"\rMessageStatus\x120\n" +
 	"\x05usage\x18\x01 \x01(\v2\x1a.construct.v1.MessageUsageR\x05usage\x12*\n" +
-	"\x11is_final_response\x18\x03 \x01(\bR\x0fisFinalResponse\"\xca\x02\n" +
+	"\x11is_final_response\x18\x03 \x01(\bR\x0fisFinalResponse\x126\n" +
+	"\arouting\x18\x04 \x01(\v2\x1c.construct.v1.MessageRoutingR\arouting\x12\x19\n" +
+	"\btrace_id\x18\x05 \x01(\tR\atraceId\"\x9b\x02\n" +
+	"\x0eMessageRouting\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x123\n" +
+	"\tturn_type\x18\x02 \x01(\x0e2\x16.construct.v1.TurnTypeR\bturnType\x126\n" +
+	"\x17estimated_prompt_tokens\x18\x03 \x01(\x03R\x15estimatedPromptTokens\x120\n" +
+	"\x14recent_tool_failures\x18\x04 \x01(\x03R\x12recentToolFailures\x12(\n" +
+	"\x10default_model_id\x18\x05 \x01(\tR\x0edefaultModelId\x12,\n" +
+	"\x12default_model_cost\x18\x06 \x01(\x01R\x10defaultModelCost\"\xca\x02\n" +
 	"\vMessagePart\x124\n" +
 	"\x04text\x18\x01 \x01(\v2\x1e.construct.v1.MessagePart.TextH\x00R\x04text\x125\n" +
 	"\ttool_call\x18\x02 \x01(\v2\x16.construct.v1.ToolCallH\x00R\btoolCall\x12;\n" +
@@ -3789,13 +3937,15 @@ const file_construct_v1_message_proto_rawDesc = "" +
 	"\acontent\x18\x01 \x01(\tB\v\xbaH\br\x06\x10\x01\x18\x80\x80\x04R\acontent\x1a!\n" +
 	"\x05Error\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessageB\x06\n" +
-	"\x04data\"\xc4\x01\n" +
+	"\x04data\"\xa3\x02\n" +
 	"\fMessageUsage\x12!\n" +
 	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
 	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12,\n" +
 	"\x12cache_write_tokens\x18\x03 \x01(\x03R\x10cacheWriteTokens\x12*\n" +
 	"\x11cache_read_tokens\x18\x04 \x01(\x03R\x0fcacheReadTokens\x12\x12\n" +
-	"\x04cost\x18\x05 \x01(\x01R\x04cost\"z\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\x124\n" +
+	"\x16estimated_input_tokens\x18\x06 \x01(\x03R\x14estimatedInputTokens\x12'\n" +
+	"\x0festimate_source\x18\a \x01(\tR\x0eestimateSource\"z\n" +
 	"\x14CreateMessageRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12?\n" +
 	"\acontent\x18\x02 \x03(\v2\x19.construct.v1.MessagePartB\n" +
@@ -4049,141 +4199,145 @@ func file_construct_v1_message_proto_rawDescGZIP() []byte {
 }
 
 var file_construct_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_construct_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_construct_v1_message_proto_goTypes = []any{
 	(MessageRole)(0),                                  // 0: construct.v1.MessageRole
 	(*Message)(nil),                                   // 1: construct.v1.Message
 	(*MessageMetadata)(nil),                           // 2: construct.v1.MessageMetadata
 	(*MessageSpec)(nil),                               // 3: construct.v1.MessageSpec
 	(*MessageStatus)(nil),                             // 4: construct.v1.MessageStatus
-	(*MessagePart)(nil),                               // 5: construct.v1.MessagePart
-	(*MessageUsage)(nil),                              // 6: construct.v1.MessageUsage
-	(*CreateMessageRequest)(nil),                      // 7: construct.v1.CreateMessageRequest
-	(*CreateMessageResponse)(nil),                     // 8: construct.v1.CreateMessageResponse
-	(*GetMessageRequest)(nil),                         // 9: construct.v1.GetMessageRequest
-	(*GetMessageResponse)(nil),                        // 10: construct.v1.GetMessageResponse
-	(*ListMessagesRequest)(nil),                       // 11: construct.v1.ListMessagesRequest
-	(*ListMessagesResponse)(nil),                      // 12: construct.v1.ListMessagesResponse
-	(*UpdateMessageRequest)(nil),                      // 13: construct.v1.UpdateMessageRequest
-	(*UpdateMessageResponse)(nil),                     // 14: construct.v1.UpdateMessageResponse
-	(*DeleteMessageRequest)(nil),                      // 15: construct.v1.DeleteMessageRequest
-	(*DeleteMessageResponse)(nil),                     // 16: construct.v1.DeleteMessageResponse
-	(*ToolCall)(nil),                                  // 17: construct.v1.ToolCall
-	(*ToolResult)(nil),                                // 18: construct.v1.ToolResult
-	(*CreateFileToolResult)(nil),                      // 19: construct.v1.CreateFileToolResult
-	(*EditFileToolResult)(nil),                        // 20: construct.v1.EditFileToolResult
-	(*ExecuteCommandToolResult)(nil),                  // 21: construct.v1.ExecuteCommandToolResult
-	(*FindFileToolResult)(nil),                        // 22: construct.v1.FindFileToolResult
-	(*GrepToolResult)(nil),                            // 23: construct.v1.GrepToolResult
-	(*HandoffToolResult)(nil),                         // 24: construct.v1.HandoffToolResult
-	(*ListFilesToolResult)(nil),                       // 25: construct.v1.ListFilesToolResult
-	(*ReadFileToolResult)(nil),                        // 26: construct.v1.ReadFileToolResult
-	(*SubmitReport)(nil),                              // 27: construct.v1.SubmitReport
-	(*ToolError)(nil),                                 // 28: construct.v1.ToolError
-	(*MessagePart_Text)(nil),                          // 29: construct.v1.MessagePart.Text
-	(*MessagePart_Error)(nil),                         // 30: construct.v1.MessagePart.Error
-	(*ListMessagesRequest_Filter)(nil),                // 31: construct.v1.ListMessagesRequest.Filter
-	(*ToolCall_CodeInterpreterInput)(nil),             // 32: construct.v1.ToolCall.CodeInterpreterInput
-	(*ToolCall_CreateFileInput)(nil),                  // 33: construct.v1.ToolCall.CreateFileInput
-	(*ToolCall_EditFileInput)(nil),                    // 34: construct.v1.ToolCall.EditFileInput
-	(*ToolCall_ExecuteCommandInput)(nil),              // 35: construct.v1.ToolCall.ExecuteCommandInput
-	(*ToolCall_FindFileInput)(nil),                    // 36: construct.v1.ToolCall.FindFileInput
-	(*ToolCall_GrepInput)(nil),                        // 37: construct.v1.ToolCall.GrepInput
-	(*ToolCall_HandoffInput)(nil),                     // 38: construct.v1.ToolCall.HandoffInput
-	(*ToolCall_AskUserInput)(nil),                     // 39: construct.v1.ToolCall.AskUserInput
-	(*ToolCall_ListFilesInput)(nil),                   // 40: construct.v1.ToolCall.ListFilesInput
-	(*ToolCall_ReadFileInput)(nil),                    // 41: construct.v1.ToolCall.ReadFileInput
-	(*ToolCall_SubmitReportInput)(nil),                // 42: construct.v1.ToolCall.SubmitReportInput
-	(*ToolCall_FetchInput)(nil),                       // 43: construct.v1.ToolCall.FetchInput
-	(*ToolCall_EditFileInput_DiffPair)(nil),           // 44: construct.v1.ToolCall.EditFileInput.DiffPair
-	nil,                                               // 45: construct.v1.ToolCall.FetchInput.HeadersEntry
-	(*ToolResult_CodeInterpreterResult)(nil),          // 46: construct.v1.ToolResult.CodeInterpreterResult
-	(*ToolResult_CreateFileResult)(nil),               // 47: construct.v1.ToolResult.CreateFileResult
-	(*ToolResult_EditFileResult)(nil),                 // 48: construct.v1.ToolResult.EditFileResult
-	(*ToolResult_ExecuteCommandResult)(nil),           // 49: construct.v1.ToolResult.ExecuteCommandResult
-	(*ToolResult_FindFileResult)(nil),                 // 50: construct.v1.ToolResult.FindFileResult
-	(*ToolResult_GrepResult)(nil),                     // 51: construct.v1.ToolResult.GrepResult
-	(*ToolResult_ListFilesResult)(nil),                // 52: construct.v1.ToolResult.ListFilesResult
-	(*ToolResult_ReadFileResult)(nil),                 // 53: construct.v1.ToolResult.ReadFileResult
-	(*ToolResult_SubmitReportResult)(nil),             // 54: construct.v1.ToolResult.SubmitReportResult
-	(*ToolResult_FetchResult)(nil),                    // 55: construct.v1.ToolResult.FetchResult
-	(*ToolResult_EditFileResult_PatchInfo)(nil),       // 56: construct.v1.ToolResult.EditFileResult.PatchInfo
-	(*ToolResult_GrepResult_GrepMatch)(nil),           // 57: construct.v1.ToolResult.GrepResult.GrepMatch
-	(*ToolResult_ListFilesResult_DirectoryEntry)(nil), // 58: construct.v1.ToolResult.ListFilesResult.DirectoryEntry
-	(*CreateFileToolResult_Input)(nil),                // 59: construct.v1.CreateFileToolResult.Input
-	nil,                                               // 60: construct.v1.ToolError.DetailsEntry
-	(*timestamppb.Timestamp)(nil),                     // 61: google.protobuf.Timestamp
-	(SortField)(0),                                    // 62: construct.v1.SortField
-	(SortOrder)(0),                                    // 63: construct.v1.SortOrder
+	(*MessageRouting)(nil),                            // 5: construct.v1.MessageRouting
+	(*MessagePart)(nil),                               // 6: construct.v1.MessagePart
+	(*MessageUsage)(nil),                              // 7: construct.v1.MessageUsage
+	(*CreateMessageRequest)(nil),                      // 8: construct.v1.CreateMessageRequest
+	(*CreateMessageResponse)(nil),                     // 9: construct.v1.CreateMessageResponse
+	(*GetMessageRequest)(nil),                         // 10: construct.v1.GetMessageRequest
+	(*GetMessageResponse)(nil),                        // 11: construct.v1.GetMessageResponse
+	(*ListMessagesRequest)(nil),                       // 12: construct.v1.ListMessagesRequest
+	(*ListMessagesResponse)(nil),                      // 13: construct.v1.ListMessagesResponse
+	(*UpdateMessageRequest)(nil),                      // 14: construct.v1.UpdateMessageRequest
+	(*UpdateMessageResponse)(nil),                     // 15: construct.v1.UpdateMessageResponse
+	(*DeleteMessageRequest)(nil),                      // 16: construct.v1.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),                     // 17: construct.v1.DeleteMessageResponse
+	(*ToolCall)(nil),                                  // 18: construct.v1.ToolCall
+	(*ToolResult)(nil),                                // 19: construct.v1.ToolResult
+	(*CreateFileToolResult)(nil),                      // 20: construct.v1.CreateFileToolResult
+	(*EditFileToolResult)(nil),                        // 21: construct.v1.EditFileToolResult
+	(*ExecuteCommandToolResult)(nil),                  // 22: construct.v1.ExecuteCommandToolResult
+	(*FindFileToolResult)(nil),                        // 23: construct.v1.FindFileToolResult
+	(*GrepToolResult)(nil),                            // 24: construct.v1.GrepToolResult
+	(*HandoffToolResult)(nil),                         // 25: construct.v1.HandoffToolResult
+	(*ListFilesToolResult)(nil),                       // 26: construct.v1.ListFilesToolResult
+	(*ReadFileToolResult)(nil),                        // 27: construct.v1.ReadFileToolResult
+	(*SubmitReport)(nil),                              // 28: construct.v1.SubmitReport
+	(*ToolError)(nil),                                 // 29: construct.v1.ToolError
+	(*MessagePart_Text)(nil),                          // 30: construct.v1.MessagePart.Text
+	(*MessagePart_Error)(nil),                         // 31: construct.v1.MessagePart.Error
+	(*ListMessagesRequest_Filter)(nil),                // 32: construct.v1.ListMessagesRequest.Filter
+	(*ToolCall_CodeInterpreterInput)(nil),             // 33: construct.v1.ToolCall.CodeInterpreterInput
+	(*ToolCall_CreateFileInput)(nil),                  // 34: construct.v1.ToolCall.CreateFileInput
+	(*ToolCall_EditFileInput)(nil),                    // 35: construct.v1.ToolCall.EditFileInput
+	(*ToolCall_ExecuteCommandInput)(nil),              // 36: construct.v1.ToolCall.ExecuteCommandInput
+	(*ToolCall_FindFileInput)(nil),                    // 37: construct.v1.ToolCall.FindFileInput
+	(*ToolCall_GrepInput)(nil),                        // 38: construct.v1.ToolCall.GrepInput
+	(*ToolCall_HandoffInput)(nil),                     // 39: construct.v1.ToolCall.HandoffInput
+	(*ToolCall_AskUserInput)(nil),                     // 40: construct.v1.ToolCall.AskUserInput
+	(*ToolCall_ListFilesInput)(nil),                   // 41: construct.v1.ToolCall.ListFilesInput
+	(*ToolCall_ReadFileInput)(nil),                    // 42: construct.v1.ToolCall.ReadFileInput
+	(*ToolCall_SubmitReportInput)(nil),                // 43: construct.v1.ToolCall.SubmitReportInput
+	(*ToolCall_FetchInput)(nil),                       // 44: construct.v1.ToolCall.FetchInput
+	(*ToolCall_EditFileInput_DiffPair)(nil),           // 45: construct.v1.ToolCall.EditFileInput.DiffPair
+	nil,                                               // 46: construct.v1.ToolCall.FetchInput.HeadersEntry
+	(*ToolResult_CodeInterpreterResult)(nil),          // 47: construct.v1.ToolResult.CodeInterpreterResult
+	(*ToolResult_CreateFileResult)(nil),               // 48: construct.v1.ToolResult.CreateFileResult
+	(*ToolResult_EditFileResult)(nil),                 // 49: construct.v1.ToolResult.EditFileResult
+	(*ToolResult_ExecuteCommandResult)(nil),           // 50: construct.v1.ToolResult.ExecuteCommandResult
+	(*ToolResult_FindFileResult)(nil),                 // 51: construct.v1.ToolResult.FindFileResult
+	(*ToolResult_GrepResult)(nil),                     // 52: construct.v1.ToolResult.GrepResult
+	(*ToolResult_ListFilesResult)(nil),                // 53: construct.v1.ToolResult.ListFilesResult
+	(*ToolResult_ReadFileResult)(nil),                 // 54: construct.v1.ToolResult.ReadFileResult
+	(*ToolResult_SubmitReportResult)(nil),             // 55: construct.v1.ToolResult.SubmitReportResult
+	(*ToolResult_FetchResult)(nil),                    // 56: construct.v1.ToolResult.FetchResult
+	(*ToolResult_EditFileResult_PatchInfo)(nil),       // 57: construct.v1.ToolResult.EditFileResult.PatchInfo
+	(*ToolResult_GrepResult_GrepMatch)(nil),           // 58: construct.v1.ToolResult.GrepResult.GrepMatch
+	(*ToolResult_ListFilesResult_DirectoryEntry)(nil), // 59: construct.v1.ToolResult.ListFilesResult.DirectoryEntry
+	(*CreateFileToolResult_Input)(nil),                // 60: construct.v1.CreateFileToolResult.Input
+	nil,                                               // 61: construct.v1.ToolError.DetailsEntry
+	(*timestamppb.Timestamp)(nil),                     // 62: google.protobuf.Timestamp
+	(TurnType)(0),                                     // 63: construct.v1.TurnType
+	(SortField)(0),                                    // 64: construct.v1.SortField
+	(SortOrder)(0),                                    // 65: construct.v1.SortOrder
 }
 var file_construct_v1_message_proto_depIdxs = []int32{
 	2,  // 0: construct.v1.Message.metadata:type_name -> construct.v1.MessageMetadata
 	3,  // 1: construct.v1.Message.spec:type_name -> construct.v1.MessageSpec
 	4,  // 2: construct.v1.Message.status:type_name -> construct.v1.MessageStatus
-	61, // 3: construct.v1.MessageMetadata.created_at:type_name -> google.protobuf.Timestamp
-	61, // 4: construct.v1.MessageMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	62, // 3: construct.v1.MessageMetadata.created_at:type_name -> google.protobuf.Timestamp
+	62, // 4: construct.v1.MessageMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: construct.v1.MessageMetadata.role:type_name -> construct.v1.MessageRole
-	5,  // 6: construct.v1.MessageSpec.content:type_name -> construct.v1.MessagePart
-	6,  // 7: construct.v1.MessageStatus.usage:type_name -> construct.v1.MessageUsage
-	29, // 8: construct.v1.MessagePart.text:type_name -> construct.v1.MessagePart.Text
-	17, // 9: construct.v1.MessagePart.tool_call:type_name -> construct.v1.ToolCall
-	18, // 10: construct.v1.MessagePart.tool_result:type_name -> construct.v1.ToolResult
-	30, // 11: construct.v1.MessagePart.error:type_name -> construct.v1.MessagePart.Error
-	5,  // 12: construct.v1.CreateMessageRequest.content:type_name -> construct.v1.MessagePart
-	1,  // 13: construct.v1.CreateMessageResponse.message:type_name -> construct.v1.Message
-	1,  // 14: construct.v1.GetMessageResponse.message:type_name -> construct.v1.Message
-	31, // 15: construct.v1.ListMessagesRequest.filter:type_name -> construct.v1.ListMessagesRequest.Filter
-	62, // 16: construct.v1.ListMessagesRequest.sort_field:type_name -> construct.v1.SortField
-	63, // 17: construct.v1.ListMessagesRequest.sort_order:type_name -> construct.v1.SortOrder
-	1,  // 18: construct.v1.ListMessagesResponse.messages:type_name -> construct.v1.Message
-	5,  // 19: construct.v1.UpdateMessageRequest.content:type_name -> construct.v1.MessagePart
-	1,  // 20: construct.v1.UpdateMessageResponse.message:type_name -> construct.v1.Message
-	33, // 21: construct.v1.ToolCall.create_file:type_name -> construct.v1.ToolCall.CreateFileInput
-	34, // 22: construct.v1.ToolCall.edit_file:type_name -> construct.v1.ToolCall.EditFileInput
-	35, // 23: construct.v1.ToolCall.execute_command:type_name -> construct.v1.ToolCall.ExecuteCommandInput
-	36, // 24: construct.v1.ToolCall.find_file:type_name -> construct.v1.ToolCall.FindFileInput
-	37, // 25: construct.v1.ToolCall.grep:type_name -> construct.v1.ToolCall.GrepInput
-	38, // 26: construct.v1.ToolCall.handoff:type_name -> construct.v1.ToolCall.HandoffInput
-	39, // 27: construct.v1.ToolCall.ask_user:type_name -> construct.v1.ToolCall.AskUserInput
-	40, // 28: construct.v1.ToolCall.list_files:type_name -> construct.v1.ToolCall.ListFilesInput
-	41, // 29: construct.v1.ToolCall.read_file:type_name -> construct.v1.ToolCall.ReadFileInput
-	42, // 30: construct.v1.ToolCall.submit_report:type_name -> construct.v1.ToolCall.SubmitReportInput
-	32, // 31: construct.v1.ToolCall.code_interpreter:type_name -> construct.v1.ToolCall.CodeInterpreterInput
-	43, // 32: construct.v1.ToolCall.fetch:type_name -> construct.v1.ToolCall.FetchInput
-	47, // 33: construct.v1.ToolResult.create_file:type_name -> construct.v1.ToolResult.CreateFileResult
-	48, // 34: construct.v1.ToolResult.edit_file:type_name -> construct.v1.ToolResult.EditFileResult
-	49, // 35: construct.v1.ToolResult.execute_command:type_name -> construct.v1.ToolResult.ExecuteCommandResult
-	50, // 36: construct.v1.ToolResult.find_file:type_name -> construct.v1.ToolResult.FindFileResult
-	51, // 37: construct.v1.ToolResult.grep:type_name -> construct.v1.ToolResult.GrepResult
-	52, // 38: construct.v1.ToolResult.list_files:type_name -> construct.v1.ToolResult.ListFilesResult
-	53, // 39: construct.v1.ToolResult.read_file:type_name -> construct.v1.ToolResult.ReadFileResult
-	54, // 40: construct.v1.ToolResult.submit_report:type_name -> construct.v1.ToolResult.SubmitReportResult
-	46, // 41: construct.v1.ToolResult.code_interpreter:type_name -> construct.v1.ToolResult.CodeInterpreterResult
-	55, // 42: construct.v1.ToolResult.fetch:type_name -> construct.v1.ToolResult.FetchResult
-	28, // 43: construct.v1.ToolResult.error:type_name -> construct.v1.ToolError
-	59, // 44: construct.v1.CreateFileToolResult.input:type_name -> construct.v1.CreateFileToolResult.Input
-	60, // 45: construct.v1.ToolError.details:type_name -> construct.v1.ToolError.DetailsEntry
-	0,  // 46: construct.v1.ListMessagesRequest.Filter.roles:type_name -> construct.v1.MessageRole
-	44, // 47: construct.v1.ToolCall.EditFileInput.diffs:type_name -> construct.v1.ToolCall.EditFileInput.DiffPair
-	45, // 48: construct.v1.ToolCall.FetchInput.headers:type_name -> construct.v1.ToolCall.FetchInput.HeadersEntry
-	56, // 49: construct.v1.ToolResult.EditFileResult.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
-	57, // 50: construct.v1.ToolResult.GrepResult.matches:type_name -> construct.v1.ToolResult.GrepResult.GrepMatch
-	58, // 51: construct.v1.ToolResult.ListFilesResult.entries:type_name -> construct.v1.ToolResult.ListFilesResult.DirectoryEntry
-	7,  // 52: construct.v1.MessageService.CreateMessage:input_type -> construct.v1.CreateMessageRequest
-	9,  // 53: construct.v1.MessageService.GetMessage:input_type -> construct.v1.GetMessageRequest
-	11, // 54: construct.v1.MessageService.ListMessages:input_type -> construct.v1.ListMessagesRequest
-	13, // 55: construct.v1.MessageService.UpdateMessage:input_type -> construct.v1.UpdateMessageRequest
-	15, // 56: construct.v1.MessageService.DeleteMessage:input_type -> construct.v1.DeleteMessageRequest
-	8,  // 57: construct.v1.MessageService.CreateMessage:output_type -> construct.v1.CreateMessageResponse
-	10, // 58: construct.v1.MessageService.GetMessage:output_type -> construct.v1.GetMessageResponse
-	12, // 59: construct.v1.MessageService.ListMessages:output_type -> construct.v1.ListMessagesResponse
-	14, // 60: construct.v1.MessageService.UpdateMessage:output_type -> construct.v1.UpdateMessageResponse
-	16, // 61: construct.v1.MessageService.DeleteMessage:output_type -> construct.v1.DeleteMessageResponse
-	57, // [57:62] is the sub-list for method output_type
-	52, // [52:57] is the sub-list for method input_type
-	52, // [52:52] is the sub-list for extension type_name
-	52, // [52:52] is the sub-list for extension extendee
-	0,  // [0:52] is the sub-list for field type_name
+	6,  // 6: construct.v1.MessageSpec.content:type_name -> construct.v1.MessagePart
+	7,  // 7: construct.v1.MessageStatus.usage:type_name -> construct.v1.MessageUsage
+	5,  // 8: construct.v1.MessageStatus.routing:type_name -> construct.v1.MessageRouting
+	63, // 9: construct.v1.MessageRouting.turn_type:type_name -> construct.v1.TurnType
+	30, // 10: construct.v1.MessagePart.text:type_name -> construct.v1.MessagePart.Text
+	18, // 11: construct.v1.MessagePart.tool_call:type_name -> construct.v1.ToolCall
+	19, // 12: construct.v1.MessagePart.tool_result:type_name -> construct.v1.ToolResult
+	31, // 13: construct.v1.MessagePart.error:type_name -> construct.v1.MessagePart.Error
+	6,  // 14: construct.v1.CreateMessageRequest.content:type_name -> construct.v1.MessagePart
+	1,  // 15: construct.v1.CreateMessageResponse.message:type_name -> construct.v1.Message
+	1,  // 16: construct.v1.GetMessageResponse.message:type_name -> construct.v1.Message
+	32, // 17: construct.v1.ListMessagesRequest.filter:type_name -> construct.v1.ListMessagesRequest.Filter
+	64, // 18: construct.v1.ListMessagesRequest.sort_field:type_name -> construct.v1.SortField
+	65, // 19: construct.v1.ListMessagesRequest.sort_order:type_name -> construct.v1.SortOrder
+	1,  // 20: construct.v1.ListMessagesResponse.messages:type_name -> construct.v1.Message
+	6,  // 21: construct.v1.UpdateMessageRequest.content:type_name -> construct.v1.MessagePart
+	1,  // 22: construct.v1.UpdateMessageResponse.message:type_name -> construct.v1.Message
+	34, // 23: construct.v1.ToolCall.create_file:type_name -> construct.v1.ToolCall.CreateFileInput
+	35, // 24: construct.v1.ToolCall.edit_file:type_name -> construct.v1.ToolCall.EditFileInput
+	36, // 25: construct.v1.ToolCall.execute_command:type_name -> construct.v1.ToolCall.ExecuteCommandInput
+	37, // 26: construct.v1.ToolCall.find_file:type_name -> construct.v1.ToolCall.FindFileInput
+	38, // 27: construct.v1.ToolCall.grep:type_name -> construct.v1.ToolCall.GrepInput
+	39, // 28: construct.v1.ToolCall.handoff:type_name -> construct.v1.ToolCall.HandoffInput
+	40, // 29: construct.v1.ToolCall.ask_user:type_name -> construct.v1.ToolCall.AskUserInput
+	41, // 30: construct.v1.ToolCall.list_files:type_name -> construct.v1.ToolCall.ListFilesInput
+	42, // 31: construct.v1.ToolCall.read_file:type_name -> construct.v1.ToolCall.ReadFileInput
+	43, // 32: construct.v1.ToolCall.submit_report:type_name -> construct.v1.ToolCall.SubmitReportInput
+	33, // 33: construct.v1.ToolCall.code_interpreter:type_name -> construct.v1.ToolCall.CodeInterpreterInput
+	44, // 34: construct.v1.ToolCall.fetch:type_name -> construct.v1.ToolCall.FetchInput
+	48, // 35: construct.v1.ToolResult.create_file:type_name -> construct.v1.ToolResult.CreateFileResult
+	49, // 36: construct.v1.ToolResult.edit_file:type_name -> construct.v1.ToolResult.EditFileResult
+	50, // 37: construct.v1.ToolResult.execute_command:type_name -> construct.v1.ToolResult.ExecuteCommandResult
+	51, // 38: construct.v1.ToolResult.find_file:type_name -> construct.v1.ToolResult.FindFileResult
+	52, // 39: construct.v1.ToolResult.grep:type_name -> construct.v1.ToolResult.GrepResult
+	53, // 40: construct.v1.ToolResult.list_files:type_name -> construct.v1.ToolResult.ListFilesResult
+	54, // 41: construct.v1.ToolResult.read_file:type_name -> construct.v1.ToolResult.ReadFileResult
+	55, // 42: construct.v1.ToolResult.submit_report:type_name -> construct.v1.ToolResult.SubmitReportResult
+	47, // 43: construct.v1.ToolResult.code_interpreter:type_name -> construct.v1.ToolResult.CodeInterpreterResult
+	56, // 44: construct.v1.ToolResult.fetch:type_name -> construct.v1.ToolResult.FetchResult
+	29, // 45: construct.v1.ToolResult.error:type_name -> construct.v1.ToolError
+	60, // 46: construct.v1.CreateFileToolResult.input:type_name -> construct.v1.CreateFileToolResult.Input
+	61, // 47: construct.v1.ToolError.details:type_name -> construct.v1.ToolError.DetailsEntry
+	0,  // 48: construct.v1.ListMessagesRequest.Filter.roles:type_name -> construct.v1.MessageRole
+	45, // 49: construct.v1.ToolCall.EditFileInput.diffs:type_name -> construct.v1.ToolCall.EditFileInput.DiffPair
+	46, // 50: construct.v1.ToolCall.FetchInput.headers:type_name -> construct.v1.ToolCall.FetchInput.HeadersEntry
+	57, // 51: construct.v1.ToolResult.EditFileResult.patch_info:type_name -> construct.v1.ToolResult.EditFileResult.PatchInfo
+	58, // 52: construct.v1.ToolResult.GrepResult.matches:type_name -> construct.v1.ToolResult.GrepResult.GrepMatch
+	59, // 53: construct.v1.ToolResult.ListFilesResult.entries:type_name -> construct.v1.ToolResult.ListFilesResult.DirectoryEntry
+	8,  // 54: construct.v1.MessageService.CreateMessage:input_type -> construct.v1.CreateMessageRequest
+	10, // 55: construct.v1.MessageService.GetMessage:input_type -> construct.v1.GetMessageRequest
+	12, // 56: construct.v1.MessageService.ListMessages:input_type -> construct.v1.ListMessagesRequest
+	14, // 57: construct.v1.MessageService.UpdateMessage:input_type -> construct.v1.UpdateMessageRequest
+	16, // 58: construct.v1.MessageService.DeleteMessage:input_type -> construct.v1.DeleteMessageRequest
+	9,  // 59: construct.v1.MessageService.CreateMessage:output_type -> construct.v1.CreateMessageResponse
+	11, // 60: construct.v1.MessageService.GetMessage:output_type -> construct.v1.GetMessageResponse
+	13, // 61: construct.v1.MessageService.ListMessages:output_type -> construct.v1.ListMessagesResponse
+	15, // 62: construct.v1.MessageService.UpdateMessage:output_type -> construct.v1.UpdateMessageResponse
+	17, // 63: construct.v1.MessageService.DeleteMessage:output_type -> construct.v1.DeleteMessageResponse
+	59, // [59:64] is the sub-list for method output_type
+	54, // [54:59] is the sub-list for method input_type
+	54, // [54:54] is the sub-list for extension type_name
+	54, // [54:54] is the sub-list for extension extendee
+	0,  // [0:54] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_message_proto_init() }
@@ -4191,16 +4345,17 @@ func file_construct_v1_message_proto_init() {
 	if File_construct_v1_message_proto != nil {
 		return
 	}
+	file_construct_v1_agent_proto_init()
 	file_construct_v1_common_proto_init()
 	file_construct_v1_message_proto_msgTypes[1].OneofWrappers = []any{}
-	file_construct_v1_message_proto_msgTypes[4].OneofWrappers = []any{
+	file_construct_v1_message_proto_msgTypes[5].OneofWrappers = []any{
 		(*MessagePart_Text_)(nil),
 		(*MessagePart_ToolCall)(nil),
 		(*MessagePart_ToolResult)(nil),
 		(*MessagePart_Error_)(nil),
 	}
-	file_construct_v1_message_proto_msgTypes[10].OneofWrappers = []any{}
-	file_construct_v1_message_proto_msgTypes[16].OneofWrappers = []any{
+	file_construct_v1_message_proto_msgTypes[11].OneofWrappers = []any{}
+	file_construct_v1_message_proto_msgTypes[17].OneofWrappers = []any{
 		(*ToolCall_CreateFile)(nil),
 		(*ToolCall_EditFile)(nil),
 		(*ToolCall_ExecuteCommand)(nil),
@@ -4214,7 +4369,7 @@ func file_construct_v1_message_proto_init() {
 		(*ToolCall_CodeInterpreter)(nil),
 		(*ToolCall_Fetch)(nil),
 	}
-	file_construct_v1_message_proto_msgTypes[17].OneofWrappers = []any{
+	file_construct_v1_message_proto_msgTypes[18].OneofWrappers = []any{
 		(*ToolResult_CreateFile)(nil),
 		(*ToolResult_EditFile)(nil),
 		(*ToolResult_ExecuteCommand)(nil),
@@ -4226,14 +4381,14 @@ func file_construct_v1_message_proto_init() {
 		(*ToolResult_CodeInterpreter)(nil),
 		(*ToolResult_Fetch)(nil),
 	}
-	file_construct_v1_message_proto_msgTypes[30].OneofWrappers = []any{}
+	file_construct_v1_message_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_message_proto_rawDesc), len(file_construct_v1_message_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   60,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -94,6 +94,69 @@ func (ModelCapability) EnumDescriptor() ([]byte, []int) {
 	return file_construct_v1_model_proto_rawDescGZIP(), []int{0}
 }
 
+// ModelCatalogChangeKind tells how a model differs from the catalog.
+type ModelCatalogChangeKind int32
+
+const (
+	// MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED indicates an unset kind.
+	ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED ModelCatalogChangeKind = 0
+	// MODEL_CATALOG_CHANGE_KIND_NEW is a catalog model that the provider does not have yet.
+	ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_NEW ModelCatalogChangeKind = 1
+	// MODEL_CATALOG_CHANGE_KIND_RETIRED is a model that is no longer in the catalog. Applying the
+	// change disables the model.
+	ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_RETIRED ModelCatalogChangeKind = 2
+	// MODEL_CATALOG_CHANGE_KIND_REPRICED is a model whose pricing differs from the catalog.
+	ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_REPRICED ModelCatalogChangeKind = 3
+	// MODEL_CATALOG_CHANGE_KIND_UPDATED is a model whose context window or capabilities differ
+	// from the catalog.
+	ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UPDATED ModelCatalogChangeKind = 4
+)
+
+// Enum value maps for ModelCatalogChangeKind.
+var (
+	ModelCatalogChangeKind_name = map[int32]string{
+		0: "MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED",
+		1: "MODEL_CATALOG_CHANGE_KIND_NEW",
+		2: "MODEL_CATALOG_CHANGE_KIND_RETIRED",
+		3: "MODEL_CATALOG_CHANGE_KIND_REPRICED",
+		4: "MODEL_CATALOG_CHANGE_KIND_UPDATED",
+	}
+	ModelCatalogChangeKind_value = map[string]int32{
+		"MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED": 0,
+		"MODEL_CATALOG_CHANGE_KIND_NEW":         1,
+		"MODEL_CATALOG_CHANGE_KIND_RETIRED":     2,
+		"MODEL_CATALOG_CHANGE_KIND_REPRICED":    3,
+		"MODEL_CATALOG_CHANGE_KIND_UPDATED":     4,
+	}
+)
+
+func (x ModelCatalogChangeKind) Enum() *ModelCatalogChangeKind {
+	p := new(ModelCatalogChangeKind)
+	*p = x
+	return p
+}
+
+func (x ModelCatalogChangeKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModelCatalogChangeKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_model_proto_enumTypes[1].Descriptor()
+}
+
+func (ModelCatalogChangeKind) Type() protoreflect.EnumType {
+	return &file_construct_v1_model_proto_enumTypes[1]
+}
+
+func (x ModelCatalogChangeKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModelCatalogChangeKind.Descriptor instead.
+func (ModelCatalogChangeKind) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_model_proto_rawDescGZIP(), []int{1}
+}
+
 // ModelMetadata contains system-managed, immutable information about a model.
 type ModelMetadata struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -990,6 +1053,243 @@ func (*DeleteModelResponse) Descriptor() ([]byte, []int) {
 	return file_construct_v1_model_proto_rawDescGZIP(), []int{13}
 }
 
+// SyncModelsRequest specifies whether the differences to the catalog are applied.
+type SyncModelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// apply writes the changes to the database. Without it the changes are only reported.
+	Apply         bool `protobuf:"varint,2,opt,name=apply,proto3" json:"apply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncModelsRequest) Reset() {
+	*x = SyncModelsRequest{}
+	mi := &file_construct_v1_model_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncModelsRequest) ProtoMessage() {}
+
+func (x *SyncModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_model_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncModelsRequest.ProtoReflect.Descriptor instead.
+func (*SyncModelsRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_model_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SyncModelsRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+// SyncModelsResponse contains the differences between the catalog and the stored models.
+type SyncModelsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// catalog_version is the version of the catalog the models were compared with.
+	CatalogVersion string `protobuf:"bytes,1,opt,name=catalog_version,json=catalogVersion,proto3" json:"catalog_version,omitempty"`
+	// changes are the differences between the catalog and the stored models.
+	Changes []*ModelCatalogChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	// applied is true if the changes have been written to the database.
+	Applied       bool `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncModelsResponse) Reset() {
+	*x = SyncModelsResponse{}
+	mi := &file_construct_v1_model_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncModelsResponse) ProtoMessage() {}
+
+func (x *SyncModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_model_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncModelsResponse.ProtoReflect.Descriptor instead.
+func (*SyncModelsResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_model_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SyncModelsResponse) GetCatalogVersion() string {
+	if x != nil {
+		return x.CatalogVersion
+	}
+	return ""
+}
+
+func (x *SyncModelsResponse) GetChanges() []*ModelCatalogChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SyncModelsResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// ModelCatalogChange is a difference between the catalog and a model of a model provider.
+type ModelCatalogChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kind tells how the model differs from the catalog.
+	Kind ModelCatalogChangeKind `protobuf:"varint,1,opt,name=kind,proto3,enum=construct.v1.ModelCatalogChangeKind" json:"kind,omitempty"`
+	// model_provider_id references the provider of the model (UUID format).
+	ModelProviderId string `protobuf:"bytes,2,opt,name=model_provider_id,json=modelProviderId,proto3" json:"model_provider_id,omitempty"`
+	// model_provider_name is the name of the provider of the model.
+	ModelProviderName string `protobuf:"bytes,3,opt,name=model_provider_name,json=modelProviderName,proto3" json:"model_provider_name,omitempty"`
+	// model_name is the name of the model.
+	ModelName string `protobuf:"bytes,4,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	// model_id references the stored model (UUID format). It is not set for new models.
+	ModelId *string `protobuf:"bytes,5,opt,name=model_id,json=modelId,proto3,oneof" json:"model_id,omitempty"`
+	// fields are the fields of the model that differ from the catalog.
+	Fields []string `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty"`
+	// pricing is the pricing of the model in the catalog. It is not set for retired models.
+	Pricing *ModelPricing `protobuf:"bytes,7,opt,name=pricing,proto3" json:"pricing,omitempty"`
+	// previous_pricing is the stored pricing of the model. It is not set for new models.
+	PreviousPricing *ModelPricing `protobuf:"bytes,8,opt,name=previous_pricing,json=previousPricing,proto3" json:"previous_pricing,omitempty"`
+	// context_window is the context window of the model in the catalog.
+	ContextWindow int64 `protobuf:"varint,9,opt,name=context_window,json=contextWindow,proto3" json:"context_window,omitempty"`
+	// previous_context_window is the stored context window of the model.
+	PreviousContextWindow int64 `protobuf:"varint,10,opt,name=previous_context_window,json=previousContextWindow,proto3" json:"previous_context_window,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ModelCatalogChange) Reset() {
+	*x = ModelCatalogChange{}
+	mi := &file_construct_v1_model_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelCatalogChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelCatalogChange) ProtoMessage() {}
+
+func (x *ModelCatalogChange) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_model_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelCatalogChange.ProtoReflect.Descriptor instead.
+func (*ModelCatalogChange) Descriptor() ([]byte, []int) {
+	return file_construct_v1_model_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ModelCatalogChange) GetKind() ModelCatalogChangeKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED
+}
+
+func (x *ModelCatalogChange) GetModelProviderId() string {
+	if x != nil {
+		return x.ModelProviderId
+	}
+	return ""
+}
+
+func (x *ModelCatalogChange) GetModelProviderName() string {
+	if x != nil {
+		return x.ModelProviderName
+	}
+	return ""
+}
+
+func (x *ModelCatalogChange) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *ModelCatalogChange) GetModelId() string {
+	if x != nil && x.ModelId != nil {
+		return *x.ModelId
+	}
+	return ""
+}
+
+func (x *ModelCatalogChange) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ModelCatalogChange) GetPricing() *ModelPricing {
+	if x != nil {
+		return x.Pricing
+	}
+	return nil
+}
+
+func (x *ModelCatalogChange) GetPreviousPricing() *ModelPricing {
+	if x != nil {
+		return x.PreviousPricing
+	}
+	return nil
+}
+
+func (x *ModelCatalogChange) GetContextWindow() int64 {
+	if x != nil {
+		return x.ContextWindow
+	}
+	return 0
+}
+
+func (x *ModelCatalogChange) GetPreviousContextWindow() int64 {
+	if x != nil {
+		return x.PreviousContextWindow
+	}
+	return 0
+}
+
 // Filter specifies criteria for narrowing the list of returned models.
 type ListModelsRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1005,7 +1305,7 @@ type ListModelsRequest_Filter struct {
 
 func (x *ListModelsRequest_Filter) Reset() {
 	*x = ListModelsRequest_Filter{}
-	mi := &file_construct_v1_model_proto_msgTypes[14]
+	mi := &file_construct_v1_model_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1017,7 +1317,7 @@ func (x *ListModelsRequest_Filter) String() string {
 func (*ListModelsRequest_Filter) ProtoMessage() {}
 
 func (x *ListModelsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_model_proto_msgTypes[14]
+	mi := &file_construct_v1_model_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1147,21 +1447,49 @@ const file_construct_v1_model_proto_rawDesc = "" +
 	"\x05model\x18\x01 \x01(\v2\x13.construct.v1.ModelB\x06\xbaH\x03\xc8\x01\x01R\x05model\".\n" +
 	"\x12DeleteModelRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x15\n" +
-	"\x13DeleteModelResponse*\xd0\x01\n" +
+	"\x13DeleteModelResponse\")\n" +
+	"\x11SyncModelsRequest\x12\x14\n" +
+	"\x05apply\x18\x02 \x01(\bR\x05apply\"\x93\x01\n" +
+	"\x12SyncModelsResponse\x12'\n" +
+	"\x0fcatalog_version\x18\x01 \x01(\tR\x0ecatalogVersion\x12:\n" +
+	"\achanges\x18\x02 \x03(\v2 .construct.v1.ModelCatalogChangeR\achanges\x12\x18\n" +
+	"\aapplied\x18\x03 \x01(\bR\aapplied\"\x88\x04\n" +
+	"\x12ModelCatalogChange\x12B\n" +
+	"\x04kind\x18\x01 \x01(\x0e2$.construct.v1.ModelCatalogChangeKindB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04kind\x124\n" +
+	"\x11model_provider_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0fmodelProviderId\x12.\n" +
+	"\x13model_provider_name\x18\x03 \x01(\tR\x11modelProviderName\x12\x1d\n" +
+	"\n" +
+	"model_name\x18\x04 \x01(\tR\tmodelName\x12(\n" +
+	"\bmodel_id\x18\x05 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\amodelId\x88\x01\x01\x12\x16\n" +
+	"\x06fields\x18\x06 \x03(\tR\x06fields\x124\n" +
+	"\apricing\x18\a \x01(\v2\x1a.construct.v1.ModelPricingR\apricing\x12E\n" +
+	"\x10previous_pricing\x18\b \x01(\v2\x1a.construct.v1.ModelPricingR\x0fpreviousPricing\x12%\n" +
+	"\x0econtext_window\x18\t \x01(\x03R\rcontextWindow\x126\n" +
+	"\x17previous_context_window\x18\n" +
+	" \x01(\x03R\x15previousContextWindowB\v\n" +
+	"\t_model_id*\xd0\x01\n" +
 	"\x0fModelCapability\x12 \n" +
 	"\x1cMODEL_CAPABILITY_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16MODEL_CAPABILITY_IMAGE\x10\x01\x12!\n" +
 	"\x1dMODEL_CAPABILITY_COMPUTER_USE\x10\x02\x12!\n" +
 	"\x1dMODEL_CAPABILITY_PROMPT_CACHE\x10\x03\x12\x1d\n" +
 	"\x19MODEL_CAPABILITY_THINKING\x10\x04\x12\x1a\n" +
-	"\x16MODEL_CAPABILITY_AUDIO\x10\x052\xb6\x03\n" +
+	"\x16MODEL_CAPABILITY_AUDIO\x10\x05*\xdc\x01\n" +
+	"\x16ModelCatalogChangeKind\x12)\n" +
+	"%MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dMODEL_CATALOG_CHANGE_KIND_NEW\x10\x01\x12%\n" +
+	"!MODEL_CATALOG_CHANGE_KIND_RETIRED\x10\x02\x12&\n" +
+	"\"MODEL_CATALOG_CHANGE_KIND_REPRICED\x10\x03\x12%\n" +
+	"!MODEL_CATALOG_CHANGE_KIND_UPDATED\x10\x042\x89\x04\n" +
 	"\fModelService\x12T\n" +
 	"\vCreateModel\x12 .construct.v1.CreateModelRequest\x1a!.construct.v1.CreateModelResponse\"\x00\x12N\n" +
 	"\bGetModel\x12\x1d.construct.v1.GetModelRequest\x1a\x1e.construct.v1.GetModelResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\n" +
 	"ListModels\x12\x1f.construct.v1.ListModelsRequest\x1a .construct.v1.ListModelsResponse\"\x03\x90\x02\x01\x12T\n" +
 	"\vUpdateModel\x12 .construct.v1.UpdateModelRequest\x1a!.construct.v1.UpdateModelResponse\"\x00\x12T\n" +
-	"\vDeleteModel\x12 .construct.v1.DeleteModelRequest\x1a!.construct.v1.DeleteModelResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+	"\vDeleteModel\x12 .construct.v1.DeleteModelRequest\x1a!.construct.v1.DeleteModelResponse\"\x00\x12Q\n" +
+	"\n" +
+	"SyncModels\x12\x1f.construct.v1.SyncModelsRequest\x1a .construct.v1.SyncModelsResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
 	file_construct_v1_model_proto_rawDescOnce sync.Once
@@ -1175,67 +1503,77 @@ func file_construct_v1_model_proto_rawDescGZIP() []byte {
 	return file_construct_v1_model_proto_rawDescData
 }
 
-var file_construct_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_construct_v1_model_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_construct_v1_model_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_construct_v1_model_proto_goTypes = []any{
 	(ModelCapability)(0),             // 0: construct.v1.ModelCapability
-	(*ModelMetadata)(nil),            // 1: construct.v1.ModelMetadata
-	(*ModelSpec)(nil),                // 2: construct.v1.ModelSpec
-	(*ModelPricing)(nil),             // 3: construct.v1.ModelPricing
-	(*Model)(nil),                    // 4: construct.v1.Model
-	(*CreateModelRequest)(nil),       // 5: construct.v1.CreateModelRequest
-	(*CreateModelResponse)(nil),      // 6: construct.v1.CreateModelResponse
-	(*GetModelRequest)(nil),          // 7: construct.v1.GetModelRequest
-	(*GetModelResponse)(nil),         // 8: construct.v1.GetModelResponse
-	(*ListModelsRequest)(nil),        // 9: construct.v1.ListModelsRequest
-	(*ListModelsResponse)(nil),       // 10: construct.v1.ListModelsResponse
-	(*UpdateModelRequest)(nil),       // 11: construct.v1.UpdateModelRequest
-	(*UpdateModelResponse)(nil),      // 12: construct.v1.UpdateModelResponse
-	(*DeleteModelRequest)(nil),       // 13: construct.v1.DeleteModelRequest
-	(*DeleteModelResponse)(nil),      // 14: construct.v1.DeleteModelResponse
-	(*ListModelsRequest_Filter)(nil), // 15: construct.v1.ListModelsRequest.Filter
-	(*timestamppb.Timestamp)(nil),    // 16: google.protobuf.Timestamp
-	(*decimal.Decimal)(nil),          // 17: google.type.Decimal
-	(SortField)(0),                   // 18: construct.v1.SortField
-	(SortOrder)(0),                   // 19: construct.v1.SortOrder
+	(ModelCatalogChangeKind)(0),      // 1: construct.v1.ModelCatalogChangeKind
+	(*ModelMetadata)(nil),            // 2: construct.v1.ModelMetadata
+	(*ModelSpec)(nil),                // 3: construct.v1.ModelSpec
+	(*ModelPricing)(nil),             // 4: construct.v1.ModelPricing
+	(*Model)(nil),                    // 5: construct.v1.Model
+	(*CreateModelRequest)(nil),       // 6: construct.v1.CreateModelRequest
+	(*CreateModelResponse)(nil),      // 7: construct.v1.CreateModelResponse
+	(*GetModelRequest)(nil),          // 8: construct.v1.GetModelRequest
+	(*GetModelResponse)(nil),         // 9: construct.v1.GetModelResponse
+	(*ListModelsRequest)(nil),        // 10: construct.v1.ListModelsRequest
+	(*ListModelsResponse)(nil),       // 11: construct.v1.ListModelsResponse
+	(*UpdateModelRequest)(nil),       // 12: construct.v1.UpdateModelRequest
+	(*UpdateModelResponse)(nil),      // 13: construct.v1.UpdateModelResponse
+	(*DeleteModelRequest)(nil),       // 14: construct.v1.DeleteModelRequest
+	(*DeleteModelResponse)(nil),      // 15: construct.v1.DeleteModelResponse
+	(*SyncModelsRequest)(nil),        // 16: construct.v1.SyncModelsRequest
+	(*SyncModelsResponse)(nil),       // 17: construct.v1.SyncModelsResponse
+	(*ModelCatalogChange)(nil),       // 18: construct.v1.ModelCatalogChange
+	(*ListModelsRequest_Filter)(nil), // 19: construct.v1.ListModelsRequest.Filter
+	(*timestamppb.Timestamp)(nil),    // 20: google.protobuf.Timestamp
+	(*decimal.Decimal)(nil),          // 21: google.type.Decimal
+	(SortField)(0),                   // 22: construct.v1.SortField
+	(SortOrder)(0),                   // 23: construct.v1.SortOrder
 }
 var file_construct_v1_model_proto_depIdxs = []int32{
-	16, // 0: construct.v1.ModelMetadata.created_at:type_name -> google.protobuf.Timestamp
-	16, // 1: construct.v1.ModelMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 0: construct.v1.ModelMetadata.created_at:type_name -> google.protobuf.Timestamp
+	20, // 1: construct.v1.ModelMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 2: construct.v1.ModelSpec.capabilities:type_name -> construct.v1.ModelCapability
-	3,  // 3: construct.v1.ModelSpec.pricing:type_name -> construct.v1.ModelPricing
-	17, // 4: construct.v1.ModelPricing.input_cost:type_name -> google.type.Decimal
-	17, // 5: construct.v1.ModelPricing.output_cost:type_name -> google.type.Decimal
-	17, // 6: construct.v1.ModelPricing.cache_write_cost:type_name -> google.type.Decimal
-	17, // 7: construct.v1.ModelPricing.cache_read_cost:type_name -> google.type.Decimal
-	1,  // 8: construct.v1.Model.metadata:type_name -> construct.v1.ModelMetadata
-	2,  // 9: construct.v1.Model.spec:type_name -> construct.v1.ModelSpec
+	4,  // 3: construct.v1.ModelSpec.pricing:type_name -> construct.v1.ModelPricing
+	21, // 4: construct.v1.ModelPricing.input_cost:type_name -> google.type.Decimal
+	21, // 5: construct.v1.ModelPricing.output_cost:type_name -> google.type.Decimal
+	21, // 6: construct.v1.ModelPricing.cache_write_cost:type_name -> google.type.Decimal
+	21, // 7: construct.v1.ModelPricing.cache_read_cost:type_name -> google.type.Decimal
+	2,  // 8: construct.v1.Model.metadata:type_name -> construct.v1.ModelMetadata
+	3,  // 9: construct.v1.Model.spec:type_name -> construct.v1.ModelSpec
 	0,  // 10: construct.v1.CreateModelRequest.capabilities:type_name -> construct.v1.ModelCapability
-	3,  // 11: construct.v1.CreateModelRequest.pricing:type_name -> construct.v1.ModelPricing
-	4,  // 12: construct.v1.CreateModelResponse.model:type_name -> construct.v1.Model
-	4,  // 13: construct.v1.GetModelResponse.model:type_name -> construct.v1.Model
-	15, // 14: construct.v1.ListModelsRequest.filter:type_name -> construct.v1.ListModelsRequest.Filter
-	18, // 15: construct.v1.ListModelsRequest.sort_field:type_name -> construct.v1.SortField
-	19, // 16: construct.v1.ListModelsRequest.sort_order:type_name -> construct.v1.SortOrder
-	4,  // 17: construct.v1.ListModelsResponse.models:type_name -> construct.v1.Model
+	4,  // 11: construct.v1.CreateModelRequest.pricing:type_name -> construct.v1.ModelPricing
+	5,  // 12: construct.v1.CreateModelResponse.model:type_name -> construct.v1.Model
+	5,  // 13: construct.v1.GetModelResponse.model:type_name -> construct.v1.Model
+	19, // 14: construct.v1.ListModelsRequest.filter:type_name -> construct.v1.ListModelsRequest.Filter
+	22, // 15: construct.v1.ListModelsRequest.sort_field:type_name -> construct.v1.SortField
+	23, // 16: construct.v1.ListModelsRequest.sort_order:type_name -> construct.v1.SortOrder
+	5,  // 17: construct.v1.ListModelsResponse.models:type_name -> construct.v1.Model
 	0,  // 18: construct.v1.UpdateModelRequest.capabilities:type_name -> construct.v1.ModelCapability
-	3,  // 19: construct.v1.UpdateModelRequest.pricing:type_name -> construct.v1.ModelPricing
-	4,  // 20: construct.v1.UpdateModelResponse.model:type_name -> construct.v1.Model
-	5,  // 21: construct.v1.ModelService.CreateModel:input_type -> construct.v1.CreateModelRequest
-	7,  // 22: construct.v1.ModelService.GetModel:input_type -> construct.v1.GetModelRequest
-	9,  // 23: construct.v1.ModelService.ListModels:input_type -> construct.v1.ListModelsRequest
-	11, // 24: construct.v1.ModelService.UpdateModel:input_type -> construct.v1.UpdateModelRequest
-	13, // 25: construct.v1.ModelService.DeleteModel:input_type -> construct.v1.DeleteModelRequest
-	6,  // 26: construct.v1.ModelService.CreateModel:output_type -> construct.v1.CreateModelResponse
-	8,  // 27: construct.v1.ModelService.GetModel:output_type -> construct.v1.GetModelResponse
-	10, // 28: construct.v1.ModelService.ListModels:output_type -> construct.v1.ListModelsResponse
-	12, // 29: construct.v1.ModelService.UpdateModel:output_type -> construct.v1.UpdateModelResponse
-	14, // 30: construct.v1.ModelService.DeleteModel:output_type -> construct.v1.DeleteModelResponse
-	26, // [26:31] is the sub-list for method output_type
-	21, // [21:26] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	4,  // 19: construct.v1.UpdateModelRequest.pricing:type_name -> construct.v1.ModelPricing
+	5,  // 20: construct.v1.UpdateModelResponse.model:type_name -> construct.v1.Model
+	18, // 21: construct.v1.SyncModelsResponse.changes:type_name -> construct.v1.ModelCatalogChange
+	1,  // 22: construct.v1.ModelCatalogChange.kind:type_name -> construct.v1.ModelCatalogChangeKind
+	4,  // 23: construct.v1.ModelCatalogChange.pricing:type_name -> construct.v1.ModelPricing
+	4,  // 24: construct.v1.ModelCatalogChange.previous_pricing:type_name -> construct.v1.ModelPricing
+	6,  // 25: construct.v1.ModelService.CreateModel:input_type -> construct.v1.CreateModelRequest
+	8,  // 26: construct.v1.ModelService.GetModel:input_type -> construct.v1.GetModelRequest
+	10, // 27: construct.v1.ModelService.ListModels:input_type -> construct.v1.ListModelsRequest
+	12, // 28: construct.v1.ModelService.UpdateModel:input_type -> construct.v1.UpdateModelRequest
+	14, // 29: construct.v1.ModelService.DeleteModel:input_type -> construct.v1.DeleteModelRequest
+	16, // 30: construct.v1.ModelService.SyncModels:input_type -> construct.v1.SyncModelsRequest
+	7,  // 31: construct.v1.ModelService.CreateModel:output_type -> construct.v1.CreateModelResponse
+	9,  // 32: construct.v1.ModelService.GetModel:output_type -> construct.v1.GetModelResponse
+	11, // 33: construct.v1.ModelService.ListModels:output_type -> construct.v1.ListModelsResponse
+	13, // 34: construct.v1.ModelService.UpdateModel:output_type -> construct.v1.UpdateModelResponse
+	15, // 35: construct.v1.ModelService.DeleteModel:output_type -> construct.v1.DeleteModelResponse
+	17, // 36: construct.v1.ModelService.SyncModels:output_type -> construct.v1.SyncModelsResponse
+	31, // [31:37] is the sub-list for method output_type
+	25, // [25:31] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_model_proto_init() }
@@ -1247,14 +1585,15 @@ func file_construct_v1_model_proto_init() {
 	file_construct_v1_model_proto_msgTypes[4].OneofWrappers = []any{}
 	file_construct_v1_model_proto_msgTypes[8].OneofWrappers = []any{}
 	file_construct_v1_model_proto_msgTypes[10].OneofWrappers = []any{}
-	file_construct_v1_model_proto_msgTypes[14].OneofWrappers = []any{}
+	file_construct_v1_model_proto_msgTypes[16].OneofWrappers = []any{}
+	file_construct_v1_model_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_model_proto_rawDesc), len(file_construct_v1_model_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
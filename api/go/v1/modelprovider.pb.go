@@ -14,6 +14,7 @@ import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -27,6 +28,178 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ModelProviderAuthStatus tells whether a model provider accepted the credentials.
+type ModelProviderAuthStatus int32
+
+const (
+	// MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED indicates an unset status.
+	ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED ModelProviderAuthStatus = 0
+	// MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED means the provider accepted the credentials.
+	ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED ModelProviderAuthStatus = 1
+	// MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED means the provider rejected the credentials.
+	ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED ModelProviderAuthStatus = 2
+	// MODEL_PROVIDER_AUTH_STATUS_UNKNOWN means the provider could not be reached.
+	ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNKNOWN ModelProviderAuthStatus = 3
+)
+
+// Enum value maps for ModelProviderAuthStatus.
+var (
+	ModelProviderAuthStatus_name = map[int32]string{
+		0: "MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED",
+		1: "MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED",
+		2: "MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED",
+		3: "MODEL_PROVIDER_AUTH_STATUS_UNKNOWN",
+	}
+	ModelProviderAuthStatus_value = map[string]int32{
+		"MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED":     0,
+		"MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED":   1,
+		"MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED": 2,
+		"MODEL_PROVIDER_AUTH_STATUS_UNKNOWN":         3,
+	}
+)
+
+func (x ModelProviderAuthStatus) Enum() *ModelProviderAuthStatus {
+	p := new(ModelProviderAuthStatus)
+	*p = x
+	return p
+}
+
+func (x ModelProviderAuthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModelProviderAuthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_modelprovider_proto_enumTypes[0].Descriptor()
+}
+
+func (ModelProviderAuthStatus) Type() protoreflect.EnumType {
+	return &file_construct_v1_modelprovider_proto_enumTypes[0]
+}
+
+func (x ModelProviderAuthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModelProviderAuthStatus.Descriptor instead.
+func (ModelProviderAuthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{0}
+}
+
+// CircuitBreakerState is the state of the circuit breaker that guards a model provider.
+type CircuitBreakerState int32
+
+const (
+	// CIRCUIT_BREAKER_STATE_UNSPECIFIED indicates an unset state.
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED CircuitBreakerState = 0
+	// CIRCUIT_BREAKER_STATE_CLOSED lets all calls through.
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED CircuitBreakerState = 1
+	// CIRCUIT_BREAKER_STATE_OPEN rejects calls after too many consecutive failures.
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN CircuitBreakerState = 2
+	// CIRCUIT_BREAKER_STATE_HALF_OPEN lets a trial call through to find out if the provider recovered.
+	CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN CircuitBreakerState = 3
+)
+
+// Enum value maps for CircuitBreakerState.
+var (
+	CircuitBreakerState_name = map[int32]string{
+		0: "CIRCUIT_BREAKER_STATE_UNSPECIFIED",
+		1: "CIRCUIT_BREAKER_STATE_CLOSED",
+		2: "CIRCUIT_BREAKER_STATE_OPEN",
+		3: "CIRCUIT_BREAKER_STATE_HALF_OPEN",
+	}
+	CircuitBreakerState_value = map[string]int32{
+		"CIRCUIT_BREAKER_STATE_UNSPECIFIED": 0,
+		"CIRCUIT_BREAKER_STATE_CLOSED":      1,
+		"CIRCUIT_BREAKER_STATE_OPEN":        2,
+		"CIRCUIT_BREAKER_STATE_HALF_OPEN":   3,
+	}
+)
+
+func (x CircuitBreakerState) Enum() *CircuitBreakerState {
+	p := new(CircuitBreakerState)
+	*p = x
+	return p
+}
+
+func (x CircuitBreakerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CircuitBreakerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_modelprovider_proto_enumTypes[1].Descriptor()
+}
+
+func (CircuitBreakerState) Type() protoreflect.EnumType {
+	return &file_construct_v1_modelprovider_proto_enumTypes[1]
+}
+
+func (x CircuitBreakerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CircuitBreakerState.Descriptor instead.
+func (CircuitBreakerState) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{1}
+}
+
+// KeySelectionStrategy decides which API key of a model provider is used for a model invocation.
+type KeySelectionStrategy int32
+
+const (
+	// KEY_SELECTION_STRATEGY_UNSPECIFIED indicates an unset strategy.
+	KeySelectionStrategy_KEY_SELECTION_STRATEGY_UNSPECIFIED KeySelectionStrategy = 0
+	// KEY_SELECTION_STRATEGY_PRIMARY_BACKUP uses the preferred key and switches to the next key
+	// only while the preferred key is rate limited.
+	KeySelectionStrategy_KEY_SELECTION_STRATEGY_PRIMARY_BACKUP KeySelectionStrategy = 1
+	// KEY_SELECTION_STRATEGY_ROUND_ROBIN spreads model invocations evenly across all keys.
+	KeySelectionStrategy_KEY_SELECTION_STRATEGY_ROUND_ROBIN KeySelectionStrategy = 2
+	// KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED uses the key that was rate limited least recently.
+	KeySelectionStrategy_KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED KeySelectionStrategy = 3
+)
+
+// Enum value maps for KeySelectionStrategy.
+var (
+	KeySelectionStrategy_name = map[int32]string{
+		0: "KEY_SELECTION_STRATEGY_UNSPECIFIED",
+		1: "KEY_SELECTION_STRATEGY_PRIMARY_BACKUP",
+		2: "KEY_SELECTION_STRATEGY_ROUND_ROBIN",
+		3: "KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED",
+	}
+	KeySelectionStrategy_value = map[string]int32{
+		"KEY_SELECTION_STRATEGY_UNSPECIFIED":            0,
+		"KEY_SELECTION_STRATEGY_PRIMARY_BACKUP":         1,
+		"KEY_SELECTION_STRATEGY_ROUND_ROBIN":            2,
+		"KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED": 3,
+	}
+)
+
+func (x KeySelectionStrategy) Enum() *KeySelectionStrategy {
+	p := new(KeySelectionStrategy)
+	*p = x
+	return p
+}
+
+func (x KeySelectionStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeySelectionStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_construct_v1_modelprovider_proto_enumTypes[2].Descriptor()
+}
+
+func (KeySelectionStrategy) Type() protoreflect.EnumType {
+	return &file_construct_v1_modelprovider_proto_enumTypes[2]
+}
+
+func (x KeySelectionStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeySelectionStrategy.Descriptor instead.
+func (KeySelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{2}
+}
+
 // ModelProviderType represents the different AI service providers supported by the system.
 type ModelProviderType int32
 
@@ -41,6 +214,16 @@ const (
 	ModelProviderType_MODEL_PROVIDER_TYPE_GEMINI ModelProviderType = 3
 	// MODEL_PROVIDER_TYPE_XAI represents xAI's AI models (Grok, etc.).
 	ModelProviderType_MODEL_PROVIDER_TYPE_XAI ModelProviderType = 4
+	// MODEL_PROVIDER_TYPE_DEEPSEEK represents DeepSeek's AI models (DeepSeek-V3, DeepSeek-R1, etc.).
+	ModelProviderType_MODEL_PROVIDER_TYPE_DEEPSEEK ModelProviderType = 5
+	// MODEL_PROVIDER_TYPE_BEDROCK represents the models hosted on Amazon Bedrock.
+	ModelProviderType_MODEL_PROVIDER_TYPE_BEDROCK ModelProviderType = 6
+	// MODEL_PROVIDER_TYPE_REPLAY serves the model responses that were recorded to a cassette. The
+	// url of the model provider is the path of the cassette.
+	ModelProviderType_MODEL_PROVIDER_TYPE_REPLAY ModelProviderType = 7
+	// MODEL_PROVIDER_TYPE_MOCK answers with the turns of a script instead of calling a model. The
+	// url of the model provider is the path of the script.
+	ModelProviderType_MODEL_PROVIDER_TYPE_MOCK ModelProviderType = 8
 )
 
 // Enum value maps for ModelProviderType.
@@ -51,6 +234,10 @@ var (
 		2: "MODEL_PROVIDER_TYPE_OPENAI",
 		3: "MODEL_PROVIDER_TYPE_GEMINI",
 		4: "MODEL_PROVIDER_TYPE_XAI",
+		5: "MODEL_PROVIDER_TYPE_DEEPSEEK",
+		6: "MODEL_PROVIDER_TYPE_BEDROCK",
+		7: "MODEL_PROVIDER_TYPE_REPLAY",
+		8: "MODEL_PROVIDER_TYPE_MOCK",
 	}
 	ModelProviderType_value = map[string]int32{
 		"MODEL_PROVIDER_TYPE_UNSPECIFIED": 0,
@@ -58,6 +245,10 @@ var (
 		"MODEL_PROVIDER_TYPE_OPENAI":      2,
 		"MODEL_PROVIDER_TYPE_GEMINI":      3,
 		"MODEL_PROVIDER_TYPE_XAI":         4,
+		"MODEL_PROVIDER_TYPE_DEEPSEEK":    5,
+		"MODEL_PROVIDER_TYPE_BEDROCK":     6,
+		"MODEL_PROVIDER_TYPE_REPLAY":      7,
+		"MODEL_PROVIDER_TYPE_MOCK":        8,
 	}
 )
 
@@ -72,11 +263,11 @@ func (x ModelProviderType) String() string {
 }
 
 func (ModelProviderType) Descriptor() protoreflect.EnumDescriptor {
-	return file_construct_v1_modelprovider_proto_enumTypes[0].Descriptor()
+	return file_construct_v1_modelprovider_proto_enumTypes[3].Descriptor()
 }
 
 func (ModelProviderType) Type() protoreflect.EnumType {
-	return &file_construct_v1_modelprovider_proto_enumTypes[0]
+	return &file_construct_v1_modelprovider_proto_enumTypes[3]
 }
 
 func (x ModelProviderType) Number() protoreflect.EnumNumber {
@@ -85,7 +276,7 @@ func (x ModelProviderType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModelProviderType.Descriptor instead.
 func (ModelProviderType) EnumDescriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{0}
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{3}
 }
 
 // CreateModelProviderRequest contains the parameters needed to create a new model provider.
@@ -98,12 +289,20 @@ type CreateModelProviderRequest struct {
 	// Types that are valid to be assigned to Authentication:
 	//
 	//	*CreateModelProviderRequest_ApiKey
+	//	*CreateModelProviderRequest_AwsCredentials
 	Authentication isCreateModelProviderRequest_Authentication `protobuf_oneof:"authentication"`
 	// provider_type specifies which AI service this provider represents.
-	ProviderType  ModelProviderType `protobuf:"varint,30,opt,name=provider_type,json=providerType,proto3,enum=construct.v1.ModelProviderType" json:"provider_type,omitempty"`
-	Url           *string           `protobuf:"bytes,31,opt,name=url,proto3,oneof" json:"url,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ProviderType ModelProviderType `protobuf:"varint,30,opt,name=provider_type,json=providerType,proto3,enum=construct.v1.ModelProviderType" json:"provider_type,omitempty"`
+	Url          *string           `protobuf:"bytes,31,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	// rate_limit limits the load that all tasks together put on the provider (optional).
+	RateLimit *ModelProviderRateLimit `protobuf:"bytes,32,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// key_selection decides which API key is used if the provider has several keys (optional).
+	KeySelection *KeySelectionStrategy `protobuf:"varint,33,opt,name=key_selection,json=keySelection,proto3,enum=construct.v1.KeySelectionStrategy,oneof" json:"key_selection,omitempty"`
+	// test_connection verifies the credentials against the provider before the provider is saved.
+	// The provider is not created if the provider rejects the credentials or cannot be reached.
+	TestConnection bool `protobuf:"varint,34,opt,name=test_connection,json=testConnection,proto3" json:"test_connection,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateModelProviderRequest) Reset() {
@@ -159,6 +358,15 @@ func (x *CreateModelProviderRequest) GetApiKey() string {
 	return ""
 }
 
+func (x *CreateModelProviderRequest) GetAwsCredentials() *AWSCredentials {
+	if x != nil {
+		if x, ok := x.Authentication.(*CreateModelProviderRequest_AwsCredentials); ok {
+			return x.AwsCredentials
+		}
+	}
+	return nil
+}
+
 func (x *CreateModelProviderRequest) GetProviderType() ModelProviderType {
 	if x != nil {
 		return x.ProviderType
@@ -173,6 +381,27 @@ func (x *CreateModelProviderRequest) GetUrl() string {
 	return ""
 }
 
+func (x *CreateModelProviderRequest) GetRateLimit() *ModelProviderRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *CreateModelProviderRequest) GetKeySelection() KeySelectionStrategy {
+	if x != nil && x.KeySelection != nil {
+		return *x.KeySelection
+	}
+	return KeySelectionStrategy_KEY_SELECTION_STRATEGY_UNSPECIFIED
+}
+
+func (x *CreateModelProviderRequest) GetTestConnection() bool {
+	if x != nil {
+		return x.TestConnection
+	}
+	return false
+}
+
 type isCreateModelProviderRequest_Authentication interface {
 	isCreateModelProviderRequest_Authentication()
 }
@@ -182,8 +411,15 @@ type CreateModelProviderRequest_ApiKey struct {
 	ApiKey string `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3,oneof"`
 }
 
+type CreateModelProviderRequest_AwsCredentials struct {
+	// aws_credentials are the credentials for providers hosted on AWS, such as Amazon Bedrock.
+	AwsCredentials *AWSCredentials `protobuf:"bytes,3,opt,name=aws_credentials,json=awsCredentials,proto3,oneof"`
+}
+
 func (*CreateModelProviderRequest_ApiKey) isCreateModelProviderRequest_Authentication() {}
 
+func (*CreateModelProviderRequest_AwsCredentials) isCreateModelProviderRequest_Authentication() {}
+
 // CreateModelProviderResponse contains the newly created model provider.
 type CreateModelProviderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -309,7 +545,11 @@ type ModelProviderSpec struct {
 	// name is the human-readable name of the model provider (1-255 characters).
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// enabled indicates whether this model provider is currently active and available for use.
-	Enabled       bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// rate_limit limits the load that all tasks together put on the provider.
+	RateLimit *ModelProviderRateLimit `protobuf:"bytes,4,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// key_selection decides which API key is used if the provider has several keys.
+	KeySelection  KeySelectionStrategy `protobuf:"varint,5,opt,name=key_selection,json=keySelection,proto3,enum=construct.v1.KeySelectionStrategy" json:"key_selection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -358,31 +598,43 @@ func (x *ModelProviderSpec) GetEnabled() bool {
 	return false
 }
 
-// ModelProvider represents a complete model provider entity with metadata and specification.
-type ModelProvider struct {
+func (x *ModelProviderSpec) GetRateLimit() *ModelProviderRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *ModelProviderSpec) GetKeySelection() KeySelectionStrategy {
+	if x != nil {
+		return x.KeySelection
+	}
+	return KeySelectionStrategy_KEY_SELECTION_STRATEGY_UNSPECIFIED
+}
+
+// ModelProviderStatus contains the runtime state of a model provider.
+type ModelProviderStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// metadata contains system-managed, immutable information about the model provider.
-	Metadata *ModelProviderMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// spec contains the user-configurable specification of the model provider.
-	Spec          *ModelProviderSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// keys are the API keys of the provider, including retired keys.
+	Keys          []*ModelProviderKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModelProvider) Reset() {
-	*x = ModelProvider{}
+func (x *ModelProviderStatus) Reset() {
+	*x = ModelProviderStatus{}
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModelProvider) String() string {
+func (x *ModelProviderStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModelProvider) ProtoMessage() {}
+func (*ModelProviderStatus) ProtoMessage() {}
 
-func (x *ModelProvider) ProtoReflect() protoreflect.Message {
+func (x *ModelProviderStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -394,48 +646,55 @@ func (x *ModelProvider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModelProvider.ProtoReflect.Descriptor instead.
-func (*ModelProvider) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelProviderStatus.ProtoReflect.Descriptor instead.
+func (*ModelProviderStatus) Descriptor() ([]byte, []int) {
 	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ModelProvider) GetMetadata() *ModelProviderMetadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
-}
-
-func (x *ModelProvider) GetSpec() *ModelProviderSpec {
+func (x *ModelProviderStatus) GetKeys() []*ModelProviderKey {
 	if x != nil {
-		return x.Spec
+		return x.Keys
 	}
 	return nil
 }
 
-// GetModelProviderRequest specifies which model provider to retrieve.
-type GetModelProviderRequest struct {
+// ModelProviderKey is a single named API key of a model provider. The key itself is never returned.
+type ModelProviderKey struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the model provider to retrieve (UUID format).
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// id is the unique identifier of the key (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the human-readable name of the key, unique within the provider.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// priority orders the keys for the primary/backup strategy, lower values are preferred.
+	Priority int32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	// created_at is the timestamp when the key was added.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// retired_at is the timestamp when the key was retired, unset for active keys.
+	RetiredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"`
+	// last_used_at is the timestamp of the last successful model invocation with this key.
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// last_rate_limited_at is the timestamp when the provider last rate limited this key.
+	LastRateLimitedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_rate_limited_at,json=lastRateLimitedAt,proto3" json:"last_rate_limited_at,omitempty"`
+	// usage is the accumulated usage of the key.
+	Usage         *ModelProviderKeyUsage `protobuf:"bytes,8,opt,name=usage,proto3" json:"usage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModelProviderRequest) Reset() {
-	*x = GetModelProviderRequest{}
+func (x *ModelProviderKey) Reset() {
+	*x = ModelProviderKey{}
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModelProviderRequest) String() string {
+func (x *ModelProviderKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelProviderRequest) ProtoMessage() {}
+func (*ModelProviderKey) ProtoMessage() {}
 
-func (x *GetModelProviderRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelProviderKey) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,41 +706,100 @@ func (x *GetModelProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelProviderRequest.ProtoReflect.Descriptor instead.
-func (*GetModelProviderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelProviderKey.ProtoReflect.Descriptor instead.
+func (*ModelProviderKey) Descriptor() ([]byte, []int) {
 	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetModelProviderRequest) GetId() string {
+func (x *ModelProviderKey) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// GetModelProviderResponse contains the requested model provider.
-type GetModelProviderResponse struct {
+func (x *ModelProviderKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModelProviderKey) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *ModelProviderKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModelProviderKey) GetRetiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return nil
+}
+
+func (x *ModelProviderKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ModelProviderKey) GetLastRateLimitedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRateLimitedAt
+	}
+	return nil
+}
+
+func (x *ModelProviderKey) GetUsage() *ModelProviderKeyUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+// ModelProviderKeyUsage is the accumulated usage of a single API key.
+type ModelProviderKeyUsage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// model_provider is the requested model provider instance.
-	ModelProvider *ModelProvider `protobuf:"bytes,1,opt,name=model_provider,json=modelProvider,proto3" json:"model_provider,omitempty"`
+	// requests is the number of successful model invocations.
+	Requests int64 `protobuf:"varint,1,opt,name=requests,proto3" json:"requests,omitempty"`
+	// input_tokens is the number of prompt tokens that were sent.
+	InputTokens int64 `protobuf:"varint,2,opt,name=input_tokens,json=inputTokens,proto3" json:"input_tokens,omitempty"`
+	// output_tokens is the number of tokens that were generated.
+	OutputTokens int64 `protobuf:"varint,3,opt,name=output_tokens,json=outputTokens,proto3" json:"output_tokens,omitempty"`
+	// cache_write_tokens is the number of tokens that were written to the prompt cache.
+	CacheWriteTokens int64 `protobuf:"varint,4,opt,name=cache_write_tokens,json=cacheWriteTokens,proto3" json:"cache_write_tokens,omitempty"`
+	// cache_read_tokens is the number of tokens that were read from the prompt cache.
+	CacheReadTokens int64 `protobuf:"varint,5,opt,name=cache_read_tokens,json=cacheReadTokens,proto3" json:"cache_read_tokens,omitempty"`
+	// cost is the accumulated cost in USD.
+	Cost          float64 `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModelProviderResponse) Reset() {
-	*x = GetModelProviderResponse{}
+func (x *ModelProviderKeyUsage) Reset() {
+	*x = ModelProviderKeyUsage{}
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModelProviderResponse) String() string {
+func (x *ModelProviderKeyUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModelProviderResponse) ProtoMessage() {}
+func (*ModelProviderKeyUsage) ProtoMessage() {}
 
-func (x *GetModelProviderResponse) ProtoReflect() protoreflect.Message {
+func (x *ModelProviderKeyUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -493,49 +811,81 @@ func (x *GetModelProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModelProviderResponse.ProtoReflect.Descriptor instead.
-func (*GetModelProviderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelProviderKeyUsage.ProtoReflect.Descriptor instead.
+func (*ModelProviderKeyUsage) Descriptor() ([]byte, []int) {
 	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetModelProviderResponse) GetModelProvider() *ModelProvider {
+func (x *ModelProviderKeyUsage) GetRequests() int64 {
 	if x != nil {
-		return x.ModelProvider
+		return x.Requests
 	}
-	return nil
+	return 0
 }
 
-// ListModelProvidersRequest specifies parameters for listing model providers with optional filtering and pagination.
-type ListModelProvidersRequest struct {
+func (x *ModelProviderKeyUsage) GetInputTokens() int64 {
+	if x != nil {
+		return x.InputTokens
+	}
+	return 0
+}
+
+func (x *ModelProviderKeyUsage) GetOutputTokens() int64 {
+	if x != nil {
+		return x.OutputTokens
+	}
+	return 0
+}
+
+func (x *ModelProviderKeyUsage) GetCacheWriteTokens() int64 {
+	if x != nil {
+		return x.CacheWriteTokens
+	}
+	return 0
+}
+
+func (x *ModelProviderKeyUsage) GetCacheReadTokens() int64 {
+	if x != nil {
+		return x.CacheReadTokens
+	}
+	return 0
+}
+
+func (x *ModelProviderKeyUsage) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+// ModelProviderRateLimit is shared by all tasks that invoke models of a provider.
+// A limit of zero means that the dimension is not limited.
+type ModelProviderRateLimit struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// filter specifies criteria for narrowing the results.
-	Filter *ListModelProvidersRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	// page_size limits the number of model providers returned per page (1-100).
-	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
-	// page_token is used for pagination to retrieve subsequent pages.
-	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	// sort_field specifies which field to sort results by.
-	SortField *SortField `protobuf:"varint,4,opt,name=sort_field,json=sortField,proto3,enum=construct.v1.SortField,oneof" json:"sort_field,omitempty"`
-	// sort_order specifies the sorting direction (ascending or descending).
-	SortOrder     *SortOrder `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,enum=construct.v1.SortOrder,oneof" json:"sort_order,omitempty"`
+	// requests_per_minute is the maximum number of model invocations per minute.
+	RequestsPerMinute int64 `protobuf:"varint,1,opt,name=requests_per_minute,json=requestsPerMinute,proto3" json:"requests_per_minute,omitempty"`
+	// tokens_per_minute is the maximum number of prompt and completion tokens per minute.
+	TokensPerMinute int64 `protobuf:"varint,2,opt,name=tokens_per_minute,json=tokensPerMinute,proto3" json:"tokens_per_minute,omitempty"`
+	// max_in_flight is the maximum number of concurrent model invocations.
+	MaxInFlight   int64 `protobuf:"varint,3,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListModelProvidersRequest) Reset() {
-	*x = ListModelProvidersRequest{}
+func (x *ModelProviderRateLimit) Reset() {
+	*x = ModelProviderRateLimit{}
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListModelProvidersRequest) String() string {
+func (x *ModelProviderRateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelProvidersRequest) ProtoMessage() {}
+func (*ModelProviderRateLimit) ProtoMessage() {}
 
-func (x *ListModelProvidersRequest) ProtoReflect() protoreflect.Message {
+func (x *ModelProviderRateLimit) ProtoReflect() protoreflect.Message {
 	mi := &file_construct_v1_modelprovider_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,72 +897,727 @@ func (x *ListModelProvidersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelProvidersRequest.ProtoReflect.Descriptor instead.
-func (*ListModelProvidersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModelProviderRateLimit.ProtoReflect.Descriptor instead.
+func (*ModelProviderRateLimit) Descriptor() ([]byte, []int) {
 	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListModelProvidersRequest) GetFilter() *ListModelProvidersRequest_Filter {
+func (x *ModelProviderRateLimit) GetRequestsPerMinute() int64 {
 	if x != nil {
-		return x.Filter
+		return x.RequestsPerMinute
 	}
-	return nil
+	return 0
 }
 
-func (x *ListModelProvidersRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *ModelProviderRateLimit) GetTokensPerMinute() int64 {
+	if x != nil {
+		return x.TokensPerMinute
+	}
+	return 0
+}
+
+func (x *ModelProviderRateLimit) GetMaxInFlight() int64 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+// ModelProvider represents a complete model provider entity with metadata and specification.
+type ModelProvider struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// metadata contains system-managed, immutable information about the model provider.
+	Metadata *ModelProviderMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// spec contains the user-configurable specification of the model provider.
+	Spec *ModelProviderSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	// status contains the runtime state of the model provider.
+	Status        *ModelProviderStatus `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelProvider) Reset() {
+	*x = ModelProvider{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelProvider) ProtoMessage() {}
+
+func (x *ModelProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelProvider.ProtoReflect.Descriptor instead.
+func (*ModelProvider) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ModelProvider) GetMetadata() *ModelProviderMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ModelProvider) GetSpec() *ModelProviderSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ModelProvider) GetStatus() *ModelProviderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// GetModelProviderRequest specifies which model provider to retrieve.
+type GetModelProviderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the model provider to retrieve (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelProviderRequest) Reset() {
+	*x = GetModelProviderRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelProviderRequest) ProtoMessage() {}
+
+func (x *GetModelProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelProviderRequest.ProtoReflect.Descriptor instead.
+func (*GetModelProviderRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetModelProviderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetModelProviderResponse contains the requested model provider.
+type GetModelProviderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// model_provider is the requested model provider instance.
+	ModelProvider *ModelProvider `protobuf:"bytes,1,opt,name=model_provider,json=modelProvider,proto3" json:"model_provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelProviderResponse) Reset() {
+	*x = GetModelProviderResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelProviderResponse) ProtoMessage() {}
+
+func (x *GetModelProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelProviderResponse.ProtoReflect.Descriptor instead.
+func (*GetModelProviderResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetModelProviderResponse) GetModelProvider() *ModelProvider {
+	if x != nil {
+		return x.ModelProvider
+	}
+	return nil
+}
+
+// ListModelProvidersRequest specifies parameters for listing model providers with optional filtering and pagination.
+type ListModelProvidersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter specifies criteria for narrowing the results.
+	Filter *ListModelProvidersRequest_Filter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// page_size limits the number of model providers returned per page (1-100).
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// page_token is used for pagination to retrieve subsequent pages.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// sort_field specifies which field to sort results by.
+	SortField *SortField `protobuf:"varint,4,opt,name=sort_field,json=sortField,proto3,enum=construct.v1.SortField,oneof" json:"sort_field,omitempty"`
+	// sort_order specifies the sorting direction (ascending or descending).
+	SortOrder     *SortOrder `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,enum=construct.v1.SortOrder,oneof" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModelProvidersRequest) Reset() {
+	*x = ListModelProvidersRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModelProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelProvidersRequest) ProtoMessage() {}
+
+func (x *ListModelProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListModelProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListModelProvidersRequest) GetFilter() *ListModelProvidersRequest_Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListModelProvidersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
 	}
 	return 0
 }
 
 func (x *ListModelProvidersRequest) GetPageToken() string {
 	if x != nil {
-		return x.PageToken
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListModelProvidersRequest) GetSortField() SortField {
+	if x != nil && x.SortField != nil {
+		return *x.SortField
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListModelProvidersRequest) GetSortOrder() SortOrder {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+// ListModelProvidersResponse contains the list of model providers matching the request criteria.
+type ListModelProvidersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// model_providers is the list of model providers matching the filter criteria.
+	ModelProviders []*ModelProvider `protobuf:"bytes,1,rep,name=model_providers,json=modelProviders,proto3" json:"model_providers,omitempty"`
+	// next_page_token is used to retrieve the next page of results (empty if no more pages).
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListModelProvidersResponse) Reset() {
+	*x = ListModelProvidersResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListModelProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListModelProvidersResponse) ProtoMessage() {}
+
+func (x *ListModelProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListModelProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListModelProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListModelProvidersResponse) GetModelProviders() []*ModelProvider {
+	if x != nil {
+		return x.ModelProviders
+	}
+	return nil
+}
+
+func (x *ListModelProvidersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// UpdateModelProviderRequest specifies which model provider to update and the new values for its fields.
+type UpdateModelProviderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the model provider to update (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name is the new name for the model provider (1-255 characters, optional).
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// authentication contains updated credentials for the provider. The key replaces the
+	// provider's active key with the highest priority.
+	//
+	// Types that are valid to be assigned to Authentication:
+	//
+	//	*UpdateModelProviderRequest_ApiKey
+	//	*UpdateModelProviderRequest_AwsCredentials
+	Authentication isUpdateModelProviderRequest_Authentication `protobuf_oneof:"authentication"`
+	// enabled is the new enabled status for the model provider (optional).
+	Enabled *bool `protobuf:"varint,30,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	// rate_limit replaces the rate limit of the provider (optional). A rate limit where all
+	// limits are zero removes the rate limit.
+	RateLimit *ModelProviderRateLimit `protobuf:"bytes,31,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	// key_selection is the new key selection strategy for the model provider (optional).
+	KeySelection  *KeySelectionStrategy `protobuf:"varint,32,opt,name=key_selection,json=keySelection,proto3,enum=construct.v1.KeySelectionStrategy,oneof" json:"key_selection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateModelProviderRequest) Reset() {
+	*x = UpdateModelProviderRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateModelProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelProviderRequest) ProtoMessage() {}
+
+func (x *UpdateModelProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelProviderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModelProviderRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateModelProviderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateModelProviderRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateModelProviderRequest) GetAuthentication() isUpdateModelProviderRequest_Authentication {
+	if x != nil {
+		return x.Authentication
+	}
+	return nil
+}
+
+func (x *UpdateModelProviderRequest) GetApiKey() string {
+	if x != nil {
+		if x, ok := x.Authentication.(*UpdateModelProviderRequest_ApiKey); ok {
+			return x.ApiKey
+		}
+	}
+	return ""
+}
+
+func (x *UpdateModelProviderRequest) GetAwsCredentials() *AWSCredentials {
+	if x != nil {
+		if x, ok := x.Authentication.(*UpdateModelProviderRequest_AwsCredentials); ok {
+			return x.AwsCredentials
+		}
+	}
+	return nil
+}
+
+func (x *UpdateModelProviderRequest) GetEnabled() bool {
+	if x != nil && x.Enabled != nil {
+		return *x.Enabled
+	}
+	return false
+}
+
+func (x *UpdateModelProviderRequest) GetRateLimit() *ModelProviderRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+func (x *UpdateModelProviderRequest) GetKeySelection() KeySelectionStrategy {
+	if x != nil && x.KeySelection != nil {
+		return *x.KeySelection
+	}
+	return KeySelectionStrategy_KEY_SELECTION_STRATEGY_UNSPECIFIED
+}
+
+type isUpdateModelProviderRequest_Authentication interface {
+	isUpdateModelProviderRequest_Authentication()
+}
+
+type UpdateModelProviderRequest_ApiKey struct {
+	// api_key is the updated API key for authenticating with the model provider (1-255 characters).
+	ApiKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3,oneof"`
+}
+
+type UpdateModelProviderRequest_AwsCredentials struct {
+	// aws_credentials are the updated credentials for providers hosted on AWS.
+	AwsCredentials *AWSCredentials `protobuf:"bytes,4,opt,name=aws_credentials,json=awsCredentials,proto3,oneof"`
+}
+
+func (*UpdateModelProviderRequest_ApiKey) isUpdateModelProviderRequest_Authentication() {}
+
+func (*UpdateModelProviderRequest_AwsCredentials) isUpdateModelProviderRequest_Authentication() {}
+
+// UpdateModelProviderResponse contains the updated model provider.
+type UpdateModelProviderResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// model_provider is the updated model provider instance.
+	ModelProvider *ModelProvider `protobuf:"bytes,1,opt,name=model_provider,json=modelProvider,proto3" json:"model_provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateModelProviderResponse) Reset() {
+	*x = UpdateModelProviderResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateModelProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModelProviderResponse) ProtoMessage() {}
+
+func (x *UpdateModelProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModelProviderResponse.ProtoReflect.Descriptor instead.
+func (*UpdateModelProviderResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateModelProviderResponse) GetModelProvider() *ModelProvider {
+	if x != nil {
+		return x.ModelProvider
+	}
+	return nil
+}
+
+// DeleteModelProviderRequest specifies which model provider to delete.
+type DeleteModelProviderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the model provider to delete (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteModelProviderRequest) Reset() {
+	*x = DeleteModelProviderRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModelProviderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModelProviderRequest) ProtoMessage() {}
+
+func (x *DeleteModelProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModelProviderRequest.ProtoReflect.Descriptor instead.
+func (*DeleteModelProviderRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteModelProviderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteModelProviderResponse confirms the model provider deletion (empty response).
+type DeleteModelProviderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteModelProviderResponse) Reset() {
+	*x = DeleteModelProviderResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteModelProviderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteModelProviderResponse) ProtoMessage() {}
+
+func (x *DeleteModelProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteModelProviderResponse.ProtoReflect.Descriptor instead.
+func (*DeleteModelProviderResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{16}
+}
+
+// AddModelProviderKeyRequest contains the API key that is added to a model provider.
+type AddModelProviderKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// model_provider_id is the unique identifier of the model provider (UUID format).
+	ModelProviderId string `protobuf:"bytes,1,opt,name=model_provider_id,json=modelProviderId,proto3" json:"model_provider_id,omitempty"`
+	// name is the human-readable name of the key, unique within the provider (1-255 characters).
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// authentication contains the credentials of the key.
+	//
+	// Types that are valid to be assigned to Authentication:
+	//
+	//	*AddModelProviderKeyRequest_ApiKey
+	//	*AddModelProviderKeyRequest_AwsCredentials
+	Authentication isAddModelProviderKeyRequest_Authentication `protobuf_oneof:"authentication"`
+	// priority orders the keys for the primary/backup strategy, lower values are preferred (optional).
+	// By default the key is added after all existing keys.
+	Priority      *int32 `protobuf:"varint,4,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddModelProviderKeyRequest) Reset() {
+	*x = AddModelProviderKeyRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddModelProviderKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddModelProviderKeyRequest) ProtoMessage() {}
+
+func (x *AddModelProviderKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddModelProviderKeyRequest.ProtoReflect.Descriptor instead.
+func (*AddModelProviderKeyRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AddModelProviderKeyRequest) GetModelProviderId() string {
+	if x != nil {
+		return x.ModelProviderId
 	}
 	return ""
 }
 
-func (x *ListModelProvidersRequest) GetSortField() SortField {
-	if x != nil && x.SortField != nil {
-		return *x.SortField
+func (x *AddModelProviderKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return SortField_SORT_FIELD_UNSPECIFIED
+	return ""
 }
 
-func (x *ListModelProvidersRequest) GetSortOrder() SortOrder {
-	if x != nil && x.SortOrder != nil {
-		return *x.SortOrder
+func (x *AddModelProviderKeyRequest) GetAuthentication() isAddModelProviderKeyRequest_Authentication {
+	if x != nil {
+		return x.Authentication
 	}
-	return SortOrder_SORT_ORDER_UNSPECIFIED
+	return nil
 }
 
-// ListModelProvidersResponse contains the list of model providers matching the request criteria.
-type ListModelProvidersResponse struct {
+func (x *AddModelProviderKeyRequest) GetApiKey() string {
+	if x != nil {
+		if x, ok := x.Authentication.(*AddModelProviderKeyRequest_ApiKey); ok {
+			return x.ApiKey
+		}
+	}
+	return ""
+}
+
+func (x *AddModelProviderKeyRequest) GetAwsCredentials() *AWSCredentials {
+	if x != nil {
+		if x, ok := x.Authentication.(*AddModelProviderKeyRequest_AwsCredentials); ok {
+			return x.AwsCredentials
+		}
+	}
+	return nil
+}
+
+func (x *AddModelProviderKeyRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+type isAddModelProviderKeyRequest_Authentication interface {
+	isAddModelProviderKeyRequest_Authentication()
+}
+
+type AddModelProviderKeyRequest_ApiKey struct {
+	// api_key is the API key for authenticating with the model provider (1-255 characters).
+	ApiKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3,oneof"`
+}
+
+type AddModelProviderKeyRequest_AwsCredentials struct {
+	// aws_credentials are the credentials for providers hosted on AWS.
+	AwsCredentials *AWSCredentials `protobuf:"bytes,5,opt,name=aws_credentials,json=awsCredentials,proto3,oneof"`
+}
+
+func (*AddModelProviderKeyRequest_ApiKey) isAddModelProviderKeyRequest_Authentication() {}
+
+func (*AddModelProviderKeyRequest_AwsCredentials) isAddModelProviderKeyRequest_Authentication() {}
+
+// AddModelProviderKeyResponse contains the model provider with the added key.
+type AddModelProviderKeyResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// model_providers is the list of model providers matching the filter criteria.
-	ModelProviders []*ModelProvider `protobuf:"bytes,1,rep,name=model_providers,json=modelProviders,proto3" json:"model_providers,omitempty"`
-	// next_page_token is used to retrieve the next page of results (empty if no more pages).
-	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// model_provider is the updated model provider instance.
+	ModelProvider *ModelProvider `protobuf:"bytes,1,opt,name=model_provider,json=modelProvider,proto3" json:"model_provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListModelProvidersResponse) Reset() {
-	*x = ListModelProvidersResponse{}
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[8]
+func (x *AddModelProviderKeyResponse) Reset() {
+	*x = AddModelProviderKeyResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListModelProvidersResponse) String() string {
+func (x *AddModelProviderKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListModelProvidersResponse) ProtoMessage() {}
+func (*AddModelProviderKeyResponse) ProtoMessage() {}
 
-func (x *ListModelProvidersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[8]
+func (x *AddModelProviderKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,59 +1628,44 @@ func (x *ListModelProvidersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListModelProvidersResponse.ProtoReflect.Descriptor instead.
-func (*ListModelProvidersResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AddModelProviderKeyResponse.ProtoReflect.Descriptor instead.
+func (*AddModelProviderKeyResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListModelProvidersResponse) GetModelProviders() []*ModelProvider {
+func (x *AddModelProviderKeyResponse) GetModelProvider() *ModelProvider {
 	if x != nil {
-		return x.ModelProviders
+		return x.ModelProvider
 	}
 	return nil
 }
 
-func (x *ListModelProvidersResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-// UpdateModelProviderRequest specifies which model provider to update and the new values for its fields.
-type UpdateModelProviderRequest struct {
+// RetireModelProviderKeyRequest specifies which API key of a model provider to retire.
+type RetireModelProviderKeyRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the model provider to update (UUID format).
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// name is the new name for the model provider (1-255 characters, optional).
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// authentication contains updated credentials for the provider.
-	//
-	// Types that are valid to be assigned to Authentication:
-	//
-	//	*UpdateModelProviderRequest_ApiKey
-	Authentication isUpdateModelProviderRequest_Authentication `protobuf_oneof:"authentication"`
-	// enabled is the new enabled status for the model provider (optional).
-	Enabled       *bool `protobuf:"varint,30,opt,name=enabled,proto3,oneof" json:"enabled,omitempty"`
+	// model_provider_id is the unique identifier of the model provider (UUID format).
+	ModelProviderId string `protobuf:"bytes,1,opt,name=model_provider_id,json=modelProviderId,proto3" json:"model_provider_id,omitempty"`
+	// key_id is the unique identifier of the key to retire (UUID format).
+	KeyId         string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateModelProviderRequest) Reset() {
-	*x = UpdateModelProviderRequest{}
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[9]
+func (x *RetireModelProviderKeyRequest) Reset() {
+	*x = RetireModelProviderKeyRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateModelProviderRequest) String() string {
+func (x *RetireModelProviderKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateModelProviderRequest) ProtoMessage() {}
+func (*RetireModelProviderKeyRequest) ProtoMessage() {}
 
-func (x *UpdateModelProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[9]
+func (x *RetireModelProviderKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,83 +1676,95 @@ func (x *UpdateModelProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateModelProviderRequest.ProtoReflect.Descriptor instead.
-func (*UpdateModelProviderRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RetireModelProviderKeyRequest.ProtoReflect.Descriptor instead.
+func (*RetireModelProviderKeyRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateModelProviderRequest) GetId() string {
+func (x *RetireModelProviderKeyRequest) GetModelProviderId() string {
 	if x != nil {
-		return x.Id
+		return x.ModelProviderId
 	}
 	return ""
 }
 
-func (x *UpdateModelProviderRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *RetireModelProviderKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
 	}
 	return ""
 }
 
-func (x *UpdateModelProviderRequest) GetAuthentication() isUpdateModelProviderRequest_Authentication {
-	if x != nil {
-		return x.Authentication
-	}
-	return nil
+// RetireModelProviderKeyResponse contains the model provider with the retired key.
+type RetireModelProviderKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// model_provider is the updated model provider instance.
+	ModelProvider *ModelProvider `protobuf:"bytes,1,opt,name=model_provider,json=modelProvider,proto3" json:"model_provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateModelProviderRequest) GetApiKey() string {
-	if x != nil {
-		if x, ok := x.Authentication.(*UpdateModelProviderRequest_ApiKey); ok {
-			return x.ApiKey
-		}
-	}
-	return ""
+func (x *RetireModelProviderKeyResponse) Reset() {
+	*x = RetireModelProviderKeyResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateModelProviderRequest) GetEnabled() bool {
-	if x != nil && x.Enabled != nil {
-		return *x.Enabled
-	}
-	return false
+func (x *RetireModelProviderKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type isUpdateModelProviderRequest_Authentication interface {
-	isUpdateModelProviderRequest_Authentication()
+func (*RetireModelProviderKeyResponse) ProtoMessage() {}
+
+func (x *RetireModelProviderKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type UpdateModelProviderRequest_ApiKey struct {
-	// api_key is the updated API key for authenticating with the model provider (1-255 characters).
-	ApiKey string `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3,oneof"`
+// Deprecated: Use RetireModelProviderKeyResponse.ProtoReflect.Descriptor instead.
+func (*RetireModelProviderKeyResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{20}
 }
 
-func (*UpdateModelProviderRequest_ApiKey) isUpdateModelProviderRequest_Authentication() {}
+func (x *RetireModelProviderKeyResponse) GetModelProvider() *ModelProvider {
+	if x != nil {
+		return x.ModelProvider
+	}
+	return nil
+}
 
-// UpdateModelProviderResponse contains the updated model provider.
-type UpdateModelProviderResponse struct {
+// TestModelProviderRequest specifies which model provider to test.
+type TestModelProviderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// model_provider is the updated model provider instance.
-	ModelProvider *ModelProvider `protobuf:"bytes,1,opt,name=model_provider,json=modelProvider,proto3" json:"model_provider,omitempty"`
+	// id is the unique identifier of the model provider (UUID format).
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateModelProviderResponse) Reset() {
-	*x = UpdateModelProviderResponse{}
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[10]
+func (x *TestModelProviderRequest) Reset() {
+	*x = TestModelProviderRequest{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateModelProviderResponse) String() string {
+func (x *TestModelProviderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateModelProviderResponse) ProtoMessage() {}
+func (*TestModelProviderRequest) ProtoMessage() {}
 
-func (x *UpdateModelProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[10]
+func (x *TestModelProviderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -773,42 +1775,58 @@ func (x *UpdateModelProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateModelProviderResponse.ProtoReflect.Descriptor instead.
-func (*UpdateModelProviderResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TestModelProviderRequest.ProtoReflect.Descriptor instead.
+func (*TestModelProviderRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateModelProviderResponse) GetModelProvider() *ModelProvider {
+func (x *TestModelProviderRequest) GetId() string {
 	if x != nil {
-		return x.ModelProvider
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// DeleteModelProviderRequest specifies which model provider to delete.
-type DeleteModelProviderRequest struct {
+// TestModelProviderResponse contains the outcome of the connectivity test.
+type TestModelProviderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// id is the unique identifier of the model provider to delete (UUID format).
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// auth_status tells whether the provider accepted the credentials.
+	AuthStatus ModelProviderAuthStatus `protobuf:"varint,1,opt,name=auth_status,json=authStatus,proto3,enum=construct.v1.ModelProviderAuthStatus" json:"auth_status,omitempty"`
+	// latency is how long the test call took.
+	Latency *durationpb.Duration `protobuf:"bytes,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	// error describes why the test call failed (optional).
+	Error *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// models_listed is true if the provider returned the models that are available to the
+	// credentials. Providers that cannot list their models are tested with a completion instead.
+	ModelsListed bool `protobuf:"varint,4,opt,name=models_listed,json=modelsListed,proto3" json:"models_listed,omitempty"`
+	// available_models are the models the provider offers to the credentials.
+	AvailableModels []string `protobuf:"bytes,5,rep,name=available_models,json=availableModels,proto3" json:"available_models,omitempty"`
+	// configured_models are the models of the provider that are stored in Construct.
+	ConfiguredModels []string `protobuf:"bytes,6,rep,name=configured_models,json=configuredModels,proto3" json:"configured_models,omitempty"`
+	// missing_models are configured models that the provider does not offer. It is only set if
+	// the provider listed its models.
+	MissingModels []string `protobuf:"bytes,7,rep,name=missing_models,json=missingModels,proto3" json:"missing_models,omitempty"`
+	// circuit_state is the current state of the circuit breaker that guards the provider.
+	CircuitState  CircuitBreakerState `protobuf:"varint,8,opt,name=circuit_state,json=circuitState,proto3,enum=construct.v1.CircuitBreakerState" json:"circuit_state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteModelProviderRequest) Reset() {
-	*x = DeleteModelProviderRequest{}
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[11]
+func (x *TestModelProviderResponse) Reset() {
+	*x = TestModelProviderResponse{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteModelProviderRequest) String() string {
+func (x *TestModelProviderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteModelProviderRequest) ProtoMessage() {}
+func (*TestModelProviderResponse) ProtoMessage() {}
 
-func (x *DeleteModelProviderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[11]
+func (x *TestModelProviderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,40 +1837,97 @@ func (x *DeleteModelProviderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteModelProviderRequest.ProtoReflect.Descriptor instead.
-func (*DeleteModelProviderRequest) Descriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TestModelProviderResponse.ProtoReflect.Descriptor instead.
+func (*TestModelProviderResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *DeleteModelProviderRequest) GetId() string {
+func (x *TestModelProviderResponse) GetAuthStatus() ModelProviderAuthStatus {
 	if x != nil {
-		return x.Id
+		return x.AuthStatus
+	}
+	return ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED
+}
+
+func (x *TestModelProviderResponse) GetLatency() *durationpb.Duration {
+	if x != nil {
+		return x.Latency
+	}
+	return nil
+}
+
+func (x *TestModelProviderResponse) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-// DeleteModelProviderResponse confirms the model provider deletion (empty response).
-type DeleteModelProviderResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *TestModelProviderResponse) GetModelsListed() bool {
+	if x != nil {
+		return x.ModelsListed
+	}
+	return false
+}
+
+func (x *TestModelProviderResponse) GetAvailableModels() []string {
+	if x != nil {
+		return x.AvailableModels
+	}
+	return nil
+}
+
+func (x *TestModelProviderResponse) GetConfiguredModels() []string {
+	if x != nil {
+		return x.ConfiguredModels
+	}
+	return nil
+}
+
+func (x *TestModelProviderResponse) GetMissingModels() []string {
+	if x != nil {
+		return x.MissingModels
+	}
+	return nil
+}
+
+func (x *TestModelProviderResponse) GetCircuitState() CircuitBreakerState {
+	if x != nil {
+		return x.CircuitState
+	}
+	return CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+}
+
+// AWSCredentials are the credentials that requests to AWS are signed with (SigV4).
+type AWSCredentials struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// access_key_id is the ID of the AWS access key.
+	AccessKeyId string `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// secret_access_key is the secret of the AWS access key.
+	SecretAccessKey string `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	// session_token is required for temporary credentials (optional).
+	SessionToken *string `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3,oneof" json:"session_token,omitempty"`
+	// region is the AWS region the requests are sent to, e.g. us-east-1.
+	Region        string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteModelProviderResponse) Reset() {
-	*x = DeleteModelProviderResponse{}
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[12]
+func (x *AWSCredentials) Reset() {
+	*x = AWSCredentials{}
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteModelProviderResponse) String() string {
+func (x *AWSCredentials) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteModelProviderResponse) ProtoMessage() {}
+func (*AWSCredentials) ProtoMessage() {}
 
-func (x *DeleteModelProviderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[12]
+func (x *AWSCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,9 +1938,37 @@ func (x *DeleteModelProviderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteModelProviderResponse.ProtoReflect.Descriptor instead.
-func (*DeleteModelProviderResponse) Descriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use AWSCredentials.ProtoReflect.Descriptor instead.
+func (*AWSCredentials) Descriptor() ([]byte, []int) {
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AWSCredentials) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
+}
+
+func (x *AWSCredentials) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
+}
+
+func (x *AWSCredentials) GetSessionToken() string {
+	if x != nil && x.SessionToken != nil {
+		return *x.SessionToken
+	}
+	return ""
+}
+
+func (x *AWSCredentials) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
 }
 
 // Filter specifies criteria for narrowing the list of returned model providers.
@@ -881,7 +1984,7 @@ type ListModelProvidersRequest_Filter struct {
 
 func (x *ListModelProvidersRequest_Filter) Reset() {
 	*x = ListModelProvidersRequest_Filter{}
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[13]
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -893,7 +1996,7 @@ func (x *ListModelProvidersRequest_Filter) String() string {
 func (*ListModelProvidersRequest_Filter) ProtoMessage() {}
 
 func (x *ListModelProvidersRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_modelprovider_proto_msgTypes[13]
+	mi := &file_construct_v1_modelprovider_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +2009,7 @@ func (x *ListModelProvidersRequest_Filter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListModelProvidersRequest_Filter.ProtoReflect.Descriptor instead.
 func (*ListModelProvidersRequest_Filter) Descriptor() ([]byte, []int) {
-	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{7, 0}
+	return file_construct_v1_modelprovider_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *ListModelProvidersRequest_Filter) GetEnabled() bool {
@@ -927,16 +2030,22 @@ var File_construct_v1_modelprovider_proto protoreflect.FileDescriptor
 
 const file_construct_v1_modelprovider_proto_rawDesc = "" +
 	"\n" +
-	" construct/v1/modelprovider.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xee\x01\n" +
+	" construct/v1/modelprovider.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x19construct/v1/common.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x04\n" +
 	"\x1aCreateModelProviderRequest\x12\x1e\n" +
 	"\x04name\x18\x01 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12%\n" +
 	"\aapi_key\x18\x02 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06apiKey\x12N\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06apiKey\x12G\n" +
+	"\x0faws_credentials\x18\x03 \x01(\v2\x1c.construct.v1.AWSCredentialsH\x00R\x0eawsCredentials\x12N\n" +
 	"\rprovider_type\x18\x1e \x01(\x0e2\x1f.construct.v1.ModelProviderTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\fproviderType\x12\x1f\n" +
-	"\x03url\x18\x1f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x01R\x03url\x88\x01\x01B\x10\n" +
+	"\x03url\x18\x1f \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x01R\x03url\x88\x01\x01\x12C\n" +
+	"\n" +
+	"rate_limit\x18  \x01(\v2$.construct.v1.ModelProviderRateLimitR\trateLimit\x12V\n" +
+	"\rkey_selection\x18! \x01(\x0e2\".construct.v1.KeySelectionStrategyB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\fkeySelection\x88\x01\x01\x12'\n" +
+	"\x0ftest_connection\x18\" \x01(\bR\x0etestConnectionB\x10\n" +
 	"\x0eauthenticationB\x06\n" +
-	"\x04_url\"i\n" +
+	"\x04_urlB\x10\n" +
+	"\x0e_key_selection\"i\n" +
 	"\x1bCreateModelProviderResponse\x12J\n" +
 	"\x0emodel_provider\x18\x01 \x01(\v2\x1b.construct.v1.ModelProviderB\x06\xbaH\x03\xc8\x01\x01R\rmodelProvider\"\x87\x02\n" +
 	"\x15ModelProviderMetadata\x12\x18\n" +
@@ -945,14 +2054,43 @@ const file_construct_v1_modelprovider_proto_rawDesc = "" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
 	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\x12N\n" +
-	"\rprovider_type\x18\x04 \x01(\x0e2\x1f.construct.v1.ModelProviderTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\fproviderType\"U\n" +
+	"\rprovider_type\x18\x04 \x01(\x0e2\x1f.construct.v1.ModelProviderTypeB\b\xbaH\x05\x82\x01\x02\x10\x01R\fproviderType\"\xed\x01\n" +
 	"\x11ModelProviderSpec\x12\x1e\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12 \n" +
-	"\aenabled\x18\x03 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\"\x85\x01\n" +
+	"\aenabled\x18\x03 \x01(\bB\x06\xbaH\x03\xc8\x01\x01R\aenabled\x12C\n" +
+	"\n" +
+	"rate_limit\x18\x04 \x01(\v2$.construct.v1.ModelProviderRateLimitR\trateLimit\x12Q\n" +
+	"\rkey_selection\x18\x05 \x01(\x0e2\".construct.v1.KeySelectionStrategyB\b\xbaH\x05\x82\x01\x02\x10\x01R\fkeySelection\"I\n" +
+	"\x13ModelProviderStatus\x122\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1e.construct.v1.ModelProviderKeyR\x04keys\"\x98\x03\n" +
+	"\x10ModelProviderKey\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"retired_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tretiredAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12K\n" +
+	"\x14last_rate_limited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x11lastRateLimitedAt\x129\n" +
+	"\x05usage\x18\b \x01(\v2#.construct.v1.ModelProviderKeyUsageR\x05usage\"\xe9\x01\n" +
+	"\x15ModelProviderKeyUsage\x12\x1a\n" +
+	"\brequests\x18\x01 \x01(\x03R\brequests\x12!\n" +
+	"\finput_tokens\x18\x02 \x01(\x03R\vinputTokens\x12#\n" +
+	"\routput_tokens\x18\x03 \x01(\x03R\foutputTokens\x12,\n" +
+	"\x12cache_write_tokens\x18\x04 \x01(\x03R\x10cacheWriteTokens\x12*\n" +
+	"\x11cache_read_tokens\x18\x05 \x01(\x03R\x0fcacheReadTokens\x12\x12\n" +
+	"\x04cost\x18\x06 \x01(\x01R\x04cost\"\xb3\x01\n" +
+	"\x16ModelProviderRateLimit\x127\n" +
+	"\x13requests_per_minute\x18\x01 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x11requestsPerMinute\x123\n" +
+	"\x11tokens_per_minute\x18\x02 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\x0ftokensPerMinute\x12+\n" +
+	"\rmax_in_flight\x18\x03 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vmaxInFlight\"\xc0\x01\n" +
 	"\rModelProvider\x12?\n" +
 	"\bmetadata\x18\x01 \x01(\v2#.construct.v1.ModelProviderMetadataR\bmetadata\x123\n" +
-	"\x04spec\x18\x02 \x01(\v2\x1f.construct.v1.ModelProviderSpecR\x04spec\"3\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1f.construct.v1.ModelProviderSpecR\x04spec\x129\n" +
+	"\x06status\x18\x03 \x01(\v2!.construct.v1.ModelProviderStatusR\x06status\"3\n" +
 	"\x17GetModelProviderRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"f\n" +
 	"\x18GetModelProviderResponse\x12J\n" +
@@ -979,35 +2117,100 @@ const file_construct_v1_modelprovider_proto_rawDesc = "" +
 	"\v_sort_order\"\x8a\x01\n" +
 	"\x1aListModelProvidersResponse\x12D\n" +
 	"\x0fmodel_providers\x18\x01 \x03(\v2\x1b.construct.v1.ModelProviderR\x0emodelProviders\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc8\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xc0\x03\n" +
 	"\x1aUpdateModelProviderRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12#\n" +
 	"\x04name\x18\x02 \x01(\tB\n" +
 	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x01R\x04name\x88\x01\x01\x12%\n" +
 	"\aapi_key\x18\x03 \x01(\tB\n" +
-	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06apiKey\x12\x1d\n" +
-	"\aenabled\x18\x1e \x01(\bH\x02R\aenabled\x88\x01\x01B\x10\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06apiKey\x12G\n" +
+	"\x0faws_credentials\x18\x04 \x01(\v2\x1c.construct.v1.AWSCredentialsH\x00R\x0eawsCredentials\x12\x1d\n" +
+	"\aenabled\x18\x1e \x01(\bH\x02R\aenabled\x88\x01\x01\x12C\n" +
+	"\n" +
+	"rate_limit\x18\x1f \x01(\v2$.construct.v1.ModelProviderRateLimitR\trateLimit\x12V\n" +
+	"\rkey_selection\x18  \x01(\x0e2\".construct.v1.KeySelectionStrategyB\b\xbaH\x05\x82\x01\x02\x10\x01H\x03R\fkeySelection\x88\x01\x01B\x10\n" +
 	"\x0eauthenticationB\a\n" +
 	"\x05_nameB\n" +
 	"\n" +
-	"\b_enabled\"i\n" +
+	"\b_enabledB\x10\n" +
+	"\x0e_key_selection\"i\n" +
 	"\x1bUpdateModelProviderResponse\x12J\n" +
 	"\x0emodel_provider\x18\x01 \x01(\v2\x1b.construct.v1.ModelProviderB\x06\xbaH\x03\xc8\x01\x01R\rmodelProvider\"6\n" +
 	"\x1aDeleteModelProviderRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\x1d\n" +
-	"\x1bDeleteModelProviderResponse*\xb8\x01\n" +
+	"\x1bDeleteModelProviderResponse\"\xab\x02\n" +
+	"\x1aAddModelProviderKeyRequest\x124\n" +
+	"\x11model_provider_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0fmodelProviderId\x12\x1e\n" +
+	"\x04name\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x04name\x12%\n" +
+	"\aapi_key\x18\x03 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01H\x00R\x06apiKey\x12G\n" +
+	"\x0faws_credentials\x18\x05 \x01(\v2\x1c.construct.v1.AWSCredentialsH\x00R\x0eawsCredentials\x12(\n" +
+	"\bpriority\x18\x04 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00H\x01R\bpriority\x88\x01\x01B\x10\n" +
+	"\x0eauthenticationB\v\n" +
+	"\t_priority\"i\n" +
+	"\x1bAddModelProviderKeyResponse\x12J\n" +
+	"\x0emodel_provider\x18\x01 \x01(\v2\x1b.construct.v1.ModelProviderB\x06\xbaH\x03\xc8\x01\x01R\rmodelProvider\"v\n" +
+	"\x1dRetireModelProviderKeyRequest\x124\n" +
+	"\x11model_provider_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x0fmodelProviderId\x12\x1f\n" +
+	"\x06key_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05keyId\"l\n" +
+	"\x1eRetireModelProviderKeyResponse\x12J\n" +
+	"\x0emodel_provider\x18\x01 \x01(\v2\x1b.construct.v1.ModelProviderB\x06\xbaH\x03\xc8\x01\x01R\rmodelProvider\"4\n" +
+	"\x18TestModelProviderRequest\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"\xbd\x03\n" +
+	"\x19TestModelProviderResponse\x12P\n" +
+	"\vauth_status\x18\x01 \x01(\x0e2%.construct.v1.ModelProviderAuthStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\n" +
+	"authStatus\x123\n" +
+	"\alatency\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\alatency\x12\x19\n" +
+	"\x05error\x18\x03 \x01(\tH\x00R\x05error\x88\x01\x01\x12#\n" +
+	"\rmodels_listed\x18\x04 \x01(\bR\fmodelsListed\x12)\n" +
+	"\x10available_models\x18\x05 \x03(\tR\x0favailableModels\x12+\n" +
+	"\x11configured_models\x18\x06 \x03(\tR\x10configuredModels\x12%\n" +
+	"\x0emissing_models\x18\a \x03(\tR\rmissingModels\x12P\n" +
+	"\rcircuit_state\x18\b \x01(\x0e2!.construct.v1.CircuitBreakerStateB\b\xbaH\x05\x82\x01\x02\x10\x01R\fcircuitStateB\b\n" +
+	"\x06_error\"\xe1\x01\n" +
+	"\x0eAWSCredentials\x12.\n" +
+	"\raccess_key_id\x18\x01 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\vaccessKeyId\x126\n" +
+	"\x11secret_access_key\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\x0fsecretAccessKey\x122\n" +
+	"\rsession_token\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80 H\x00R\fsessionToken\x88\x01\x01\x12!\n" +
+	"\x06region\x18\x04 \x01(\tB\t\xbaH\x06r\x04\x10\x01\x18@R\x06regionB\x10\n" +
+	"\x0e_session_token*\xcb\x01\n" +
+	"\x17ModelProviderAuthStatus\x12*\n" +
+	"&MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED\x10\x00\x12,\n" +
+	"(MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED\x10\x01\x12.\n" +
+	"*MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED\x10\x02\x12&\n" +
+	"\"MODEL_PROVIDER_AUTH_STATUS_UNKNOWN\x10\x03*\xa3\x01\n" +
+	"\x13CircuitBreakerState\x12%\n" +
+	"!CIRCUIT_BREAKER_STATE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cCIRCUIT_BREAKER_STATE_CLOSED\x10\x01\x12\x1e\n" +
+	"\x1aCIRCUIT_BREAKER_STATE_OPEN\x10\x02\x12#\n" +
+	"\x1fCIRCUIT_BREAKER_STATE_HALF_OPEN\x10\x03*\xc4\x01\n" +
+	"\x14KeySelectionStrategy\x12&\n" +
+	"\"KEY_SELECTION_STRATEGY_UNSPECIFIED\x10\x00\x12)\n" +
+	"%KEY_SELECTION_STRATEGY_PRIMARY_BACKUP\x10\x01\x12&\n" +
+	"\"KEY_SELECTION_STRATEGY_ROUND_ROBIN\x10\x02\x121\n" +
+	"-KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED\x10\x03*\xb9\x02\n" +
 	"\x11ModelProviderType\x12#\n" +
 	"\x1fMODEL_PROVIDER_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dMODEL_PROVIDER_TYPE_ANTHROPIC\x10\x01\x12\x1e\n" +
 	"\x1aMODEL_PROVIDER_TYPE_OPENAI\x10\x02\x12\x1e\n" +
 	"\x1aMODEL_PROVIDER_TYPE_GEMINI\x10\x03\x12\x1b\n" +
-	"\x17MODEL_PROVIDER_TYPE_XAI\x10\x042\xb6\x04\n" +
+	"\x17MODEL_PROVIDER_TYPE_XAI\x10\x04\x12 \n" +
+	"\x1cMODEL_PROVIDER_TYPE_DEEPSEEK\x10\x05\x12\x1f\n" +
+	"\x1bMODEL_PROVIDER_TYPE_BEDROCK\x10\x06\x12\x1e\n" +
+	"\x1aMODEL_PROVIDER_TYPE_REPLAY\x10\a\x12\x1c\n" +
+	"\x18MODEL_PROVIDER_TYPE_MOCK\x10\b2\x83\a\n" +
 	"\x14ModelProviderService\x12l\n" +
 	"\x13CreateModelProvider\x12(.construct.v1.CreateModelProviderRequest\x1a).construct.v1.CreateModelProviderResponse\"\x00\x12f\n" +
 	"\x10GetModelProvider\x12%.construct.v1.GetModelProviderRequest\x1a&.construct.v1.GetModelProviderResponse\"\x03\x90\x02\x01\x12l\n" +
 	"\x12ListModelProviders\x12'.construct.v1.ListModelProvidersRequest\x1a(.construct.v1.ListModelProvidersResponse\"\x03\x90\x02\x01\x12l\n" +
 	"\x13UpdateModelProvider\x12(.construct.v1.UpdateModelProviderRequest\x1a).construct.v1.UpdateModelProviderResponse\"\x00\x12l\n" +
-	"\x13DeleteModelProvider\x12(.construct.v1.DeleteModelProviderRequest\x1a).construct.v1.DeleteModelProviderResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+	"\x13DeleteModelProvider\x12(.construct.v1.DeleteModelProviderRequest\x1a).construct.v1.DeleteModelProviderResponse\"\x00\x12l\n" +
+	"\x13AddModelProviderKey\x12(.construct.v1.AddModelProviderKeyRequest\x1a).construct.v1.AddModelProviderKeyResponse\"\x00\x12u\n" +
+	"\x16RetireModelProviderKey\x12+.construct.v1.RetireModelProviderKeyRequest\x1a,.construct.v1.RetireModelProviderKeyResponse\"\x00\x12f\n" +
+	"\x11TestModelProvider\x12&.construct.v1.TestModelProviderRequest\x1a'.construct.v1.TestModelProviderResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
 	file_construct_v1_modelprovider_proto_rawDescOnce sync.Once
@@ -1021,58 +2224,100 @@ func file_construct_v1_modelprovider_proto_rawDescGZIP() []byte {
 	return file_construct_v1_modelprovider_proto_rawDescData
 }
 
-var file_construct_v1_modelprovider_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_modelprovider_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_construct_v1_modelprovider_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_construct_v1_modelprovider_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_construct_v1_modelprovider_proto_goTypes = []any{
-	(ModelProviderType)(0),                   // 0: construct.v1.ModelProviderType
-	(*CreateModelProviderRequest)(nil),       // 1: construct.v1.CreateModelProviderRequest
-	(*CreateModelProviderResponse)(nil),      // 2: construct.v1.CreateModelProviderResponse
-	(*ModelProviderMetadata)(nil),            // 3: construct.v1.ModelProviderMetadata
-	(*ModelProviderSpec)(nil),                // 4: construct.v1.ModelProviderSpec
-	(*ModelProvider)(nil),                    // 5: construct.v1.ModelProvider
-	(*GetModelProviderRequest)(nil),          // 6: construct.v1.GetModelProviderRequest
-	(*GetModelProviderResponse)(nil),         // 7: construct.v1.GetModelProviderResponse
-	(*ListModelProvidersRequest)(nil),        // 8: construct.v1.ListModelProvidersRequest
-	(*ListModelProvidersResponse)(nil),       // 9: construct.v1.ListModelProvidersResponse
-	(*UpdateModelProviderRequest)(nil),       // 10: construct.v1.UpdateModelProviderRequest
-	(*UpdateModelProviderResponse)(nil),      // 11: construct.v1.UpdateModelProviderResponse
-	(*DeleteModelProviderRequest)(nil),       // 12: construct.v1.DeleteModelProviderRequest
-	(*DeleteModelProviderResponse)(nil),      // 13: construct.v1.DeleteModelProviderResponse
-	(*ListModelProvidersRequest_Filter)(nil), // 14: construct.v1.ListModelProvidersRequest.Filter
-	(*timestamppb.Timestamp)(nil),            // 15: google.protobuf.Timestamp
-	(SortField)(0),                           // 16: construct.v1.SortField
-	(SortOrder)(0),                           // 17: construct.v1.SortOrder
+	(ModelProviderAuthStatus)(0),             // 0: construct.v1.ModelProviderAuthStatus
+	(CircuitBreakerState)(0),                 // 1: construct.v1.CircuitBreakerState
+	(KeySelectionStrategy)(0),                // 2: construct.v1.KeySelectionStrategy
+	(ModelProviderType)(0),                   // 3: construct.v1.ModelProviderType
+	(*CreateModelProviderRequest)(nil),       // 4: construct.v1.CreateModelProviderRequest
+	(*CreateModelProviderResponse)(nil),      // 5: construct.v1.CreateModelProviderResponse
+	(*ModelProviderMetadata)(nil),            // 6: construct.v1.ModelProviderMetadata
+	(*ModelProviderSpec)(nil),                // 7: construct.v1.ModelProviderSpec
+	(*ModelProviderStatus)(nil),              // 8: construct.v1.ModelProviderStatus
+	(*ModelProviderKey)(nil),                 // 9: construct.v1.ModelProviderKey
+	(*ModelProviderKeyUsage)(nil),            // 10: construct.v1.ModelProviderKeyUsage
+	(*ModelProviderRateLimit)(nil),           // 11: construct.v1.ModelProviderRateLimit
+	(*ModelProvider)(nil),                    // 12: construct.v1.ModelProvider
+	(*GetModelProviderRequest)(nil),          // 13: construct.v1.GetModelProviderRequest
+	(*GetModelProviderResponse)(nil),         // 14: construct.v1.GetModelProviderResponse
+	(*ListModelProvidersRequest)(nil),        // 15: construct.v1.ListModelProvidersRequest
+	(*ListModelProvidersResponse)(nil),       // 16: construct.v1.ListModelProvidersResponse
+	(*UpdateModelProviderRequest)(nil),       // 17: construct.v1.UpdateModelProviderRequest
+	(*UpdateModelProviderResponse)(nil),      // 18: construct.v1.UpdateModelProviderResponse
+	(*DeleteModelProviderRequest)(nil),       // 19: construct.v1.DeleteModelProviderRequest
+	(*DeleteModelProviderResponse)(nil),      // 20: construct.v1.DeleteModelProviderResponse
+	(*AddModelProviderKeyRequest)(nil),       // 21: construct.v1.AddModelProviderKeyRequest
+	(*AddModelProviderKeyResponse)(nil),      // 22: construct.v1.AddModelProviderKeyResponse
+	(*RetireModelProviderKeyRequest)(nil),    // 23: construct.v1.RetireModelProviderKeyRequest
+	(*RetireModelProviderKeyResponse)(nil),   // 24: construct.v1.RetireModelProviderKeyResponse
+	(*TestModelProviderRequest)(nil),         // 25: construct.v1.TestModelProviderRequest
+	(*TestModelProviderResponse)(nil),        // 26: construct.v1.TestModelProviderResponse
+	(*AWSCredentials)(nil),                   // 27: construct.v1.AWSCredentials
+	(*ListModelProvidersRequest_Filter)(nil), // 28: construct.v1.ListModelProvidersRequest.Filter
+	(*timestamppb.Timestamp)(nil),            // 29: google.protobuf.Timestamp
+	(SortField)(0),                           // 30: construct.v1.SortField
+	(SortOrder)(0),                           // 31: construct.v1.SortOrder
+	(*durationpb.Duration)(nil),              // 32: google.protobuf.Duration
 }
 var file_construct_v1_modelprovider_proto_depIdxs = []int32{
-	0,  // 0: construct.v1.CreateModelProviderRequest.provider_type:type_name -> construct.v1.ModelProviderType
-	5,  // 1: construct.v1.CreateModelProviderResponse.model_provider:type_name -> construct.v1.ModelProvider
-	15, // 2: construct.v1.ModelProviderMetadata.created_at:type_name -> google.protobuf.Timestamp
-	15, // 3: construct.v1.ModelProviderMetadata.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: construct.v1.ModelProviderMetadata.provider_type:type_name -> construct.v1.ModelProviderType
-	3,  // 5: construct.v1.ModelProvider.metadata:type_name -> construct.v1.ModelProviderMetadata
-	4,  // 6: construct.v1.ModelProvider.spec:type_name -> construct.v1.ModelProviderSpec
-	5,  // 7: construct.v1.GetModelProviderResponse.model_provider:type_name -> construct.v1.ModelProvider
-	14, // 8: construct.v1.ListModelProvidersRequest.filter:type_name -> construct.v1.ListModelProvidersRequest.Filter
-	16, // 9: construct.v1.ListModelProvidersRequest.sort_field:type_name -> construct.v1.SortField
-	17, // 10: construct.v1.ListModelProvidersRequest.sort_order:type_name -> construct.v1.SortOrder
-	5,  // 11: construct.v1.ListModelProvidersResponse.model_providers:type_name -> construct.v1.ModelProvider
-	5,  // 12: construct.v1.UpdateModelProviderResponse.model_provider:type_name -> construct.v1.ModelProvider
-	0,  // 13: construct.v1.ListModelProvidersRequest.Filter.provider_types:type_name -> construct.v1.ModelProviderType
-	1,  // 14: construct.v1.ModelProviderService.CreateModelProvider:input_type -> construct.v1.CreateModelProviderRequest
-	6,  // 15: construct.v1.ModelProviderService.GetModelProvider:input_type -> construct.v1.GetModelProviderRequest
-	8,  // 16: construct.v1.ModelProviderService.ListModelProviders:input_type -> construct.v1.ListModelProvidersRequest
-	10, // 17: construct.v1.ModelProviderService.UpdateModelProvider:input_type -> construct.v1.UpdateModelProviderRequest
-	12, // 18: construct.v1.ModelProviderService.DeleteModelProvider:input_type -> construct.v1.DeleteModelProviderRequest
-	2,  // 19: construct.v1.ModelProviderService.CreateModelProvider:output_type -> construct.v1.CreateModelProviderResponse
-	7,  // 20: construct.v1.ModelProviderService.GetModelProvider:output_type -> construct.v1.GetModelProviderResponse
-	9,  // 21: construct.v1.ModelProviderService.ListModelProviders:output_type -> construct.v1.ListModelProvidersResponse
-	11, // 22: construct.v1.ModelProviderService.UpdateModelProvider:output_type -> construct.v1.UpdateModelProviderResponse
-	13, // 23: construct.v1.ModelProviderService.DeleteModelProvider:output_type -> construct.v1.DeleteModelProviderResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	27, // 0: construct.v1.CreateModelProviderRequest.aws_credentials:type_name -> construct.v1.AWSCredentials
+	3,  // 1: construct.v1.CreateModelProviderRequest.provider_type:type_name -> construct.v1.ModelProviderType
+	11, // 2: construct.v1.CreateModelProviderRequest.rate_limit:type_name -> construct.v1.ModelProviderRateLimit
+	2,  // 3: construct.v1.CreateModelProviderRequest.key_selection:type_name -> construct.v1.KeySelectionStrategy
+	12, // 4: construct.v1.CreateModelProviderResponse.model_provider:type_name -> construct.v1.ModelProvider
+	29, // 5: construct.v1.ModelProviderMetadata.created_at:type_name -> google.protobuf.Timestamp
+	29, // 6: construct.v1.ModelProviderMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 7: construct.v1.ModelProviderMetadata.provider_type:type_name -> construct.v1.ModelProviderType
+	11, // 8: construct.v1.ModelProviderSpec.rate_limit:type_name -> construct.v1.ModelProviderRateLimit
+	2,  // 9: construct.v1.ModelProviderSpec.key_selection:type_name -> construct.v1.KeySelectionStrategy
+	9,  // 10: construct.v1.ModelProviderStatus.keys:type_name -> construct.v1.ModelProviderKey
+	29, // 11: construct.v1.ModelProviderKey.created_at:type_name -> google.protobuf.Timestamp
+	29, // 12: construct.v1.ModelProviderKey.retired_at:type_name -> google.protobuf.Timestamp
+	29, // 13: construct.v1.ModelProviderKey.last_used_at:type_name -> google.protobuf.Timestamp
+	29, // 14: construct.v1.ModelProviderKey.last_rate_limited_at:type_name -> google.protobuf.Timestamp
+	10, // 15: construct.v1.ModelProviderKey.usage:type_name -> construct.v1.ModelProviderKeyUsage
+	6,  // 16: construct.v1.ModelProvider.metadata:type_name -> construct.v1.ModelProviderMetadata
+	7,  // 17: construct.v1.ModelProvider.spec:type_name -> construct.v1.ModelProviderSpec
+	8,  // 18: construct.v1.ModelProvider.status:type_name -> construct.v1.ModelProviderStatus
+	12, // 19: construct.v1.GetModelProviderResponse.model_provider:type_name -> construct.v1.ModelProvider
+	28, // 20: construct.v1.ListModelProvidersRequest.filter:type_name -> construct.v1.ListModelProvidersRequest.Filter
+	30, // 21: construct.v1.ListModelProvidersRequest.sort_field:type_name -> construct.v1.SortField
+	31, // 22: construct.v1.ListModelProvidersRequest.sort_order:type_name -> construct.v1.SortOrder
+	12, // 23: construct.v1.ListModelProvidersResponse.model_providers:type_name -> construct.v1.ModelProvider
+	27, // 24: construct.v1.UpdateModelProviderRequest.aws_credentials:type_name -> construct.v1.AWSCredentials
+	11, // 25: construct.v1.UpdateModelProviderRequest.rate_limit:type_name -> construct.v1.ModelProviderRateLimit
+	2,  // 26: construct.v1.UpdateModelProviderRequest.key_selection:type_name -> construct.v1.KeySelectionStrategy
+	12, // 27: construct.v1.UpdateModelProviderResponse.model_provider:type_name -> construct.v1.ModelProvider
+	27, // 28: construct.v1.AddModelProviderKeyRequest.aws_credentials:type_name -> construct.v1.AWSCredentials
+	12, // 29: construct.v1.AddModelProviderKeyResponse.model_provider:type_name -> construct.v1.ModelProvider
+	12, // 30: construct.v1.RetireModelProviderKeyResponse.model_provider:type_name -> construct.v1.ModelProvider
+	0,  // 31: construct.v1.TestModelProviderResponse.auth_status:type_name -> construct.v1.ModelProviderAuthStatus
+	32, // 32: construct.v1.TestModelProviderResponse.latency:type_name -> google.protobuf.Duration
+	1,  // 33: construct.v1.TestModelProviderResponse.circuit_state:type_name -> construct.v1.CircuitBreakerState
+	3,  // 34: construct.v1.ListModelProvidersRequest.Filter.provider_types:type_name -> construct.v1.ModelProviderType
+	4,  // 35: construct.v1.ModelProviderService.CreateModelProvider:input_type -> construct.v1.CreateModelProviderRequest
+	13, // 36: construct.v1.ModelProviderService.GetModelProvider:input_type -> construct.v1.GetModelProviderRequest
+	15, // 37: construct.v1.ModelProviderService.ListModelProviders:input_type -> construct.v1.ListModelProvidersRequest
+	17, // 38: construct.v1.ModelProviderService.UpdateModelProvider:input_type -> construct.v1.UpdateModelProviderRequest
+	19, // 39: construct.v1.ModelProviderService.DeleteModelProvider:input_type -> construct.v1.DeleteModelProviderRequest
+	21, // 40: construct.v1.ModelProviderService.AddModelProviderKey:input_type -> construct.v1.AddModelProviderKeyRequest
+	23, // 41: construct.v1.ModelProviderService.RetireModelProviderKey:input_type -> construct.v1.RetireModelProviderKeyRequest
+	25, // 42: construct.v1.ModelProviderService.TestModelProvider:input_type -> construct.v1.TestModelProviderRequest
+	5,  // 43: construct.v1.ModelProviderService.CreateModelProvider:output_type -> construct.v1.CreateModelProviderResponse
+	14, // 44: construct.v1.ModelProviderService.GetModelProvider:output_type -> construct.v1.GetModelProviderResponse
+	16, // 45: construct.v1.ModelProviderService.ListModelProviders:output_type -> construct.v1.ListModelProvidersResponse
+	18, // 46: construct.v1.ModelProviderService.UpdateModelProvider:output_type -> construct.v1.UpdateModelProviderResponse
+	20, // 47: construct.v1.ModelProviderService.DeleteModelProvider:output_type -> construct.v1.DeleteModelProviderResponse
+	22, // 48: construct.v1.ModelProviderService.AddModelProviderKey:output_type -> construct.v1.AddModelProviderKeyResponse
+	24, // 49: construct.v1.ModelProviderService.RetireModelProviderKey:output_type -> construct.v1.RetireModelProviderKeyResponse
+	26, // 50: construct.v1.ModelProviderService.TestModelProvider:output_type -> construct.v1.TestModelProviderResponse
+	43, // [43:51] is the sub-list for method output_type
+	35, // [35:43] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_modelprovider_proto_init() }
@@ -1083,19 +2328,27 @@ func file_construct_v1_modelprovider_proto_init() {
 	file_construct_v1_common_proto_init()
 	file_construct_v1_modelprovider_proto_msgTypes[0].OneofWrappers = []any{
 		(*CreateModelProviderRequest_ApiKey)(nil),
+		(*CreateModelProviderRequest_AwsCredentials)(nil),
 	}
-	file_construct_v1_modelprovider_proto_msgTypes[7].OneofWrappers = []any{}
-	file_construct_v1_modelprovider_proto_msgTypes[9].OneofWrappers = []any{
+	file_construct_v1_modelprovider_proto_msgTypes[11].OneofWrappers = []any{}
+	file_construct_v1_modelprovider_proto_msgTypes[13].OneofWrappers = []any{
 		(*UpdateModelProviderRequest_ApiKey)(nil),
+		(*UpdateModelProviderRequest_AwsCredentials)(nil),
+	}
+	file_construct_v1_modelprovider_proto_msgTypes[17].OneofWrappers = []any{
+		(*AddModelProviderKeyRequest_ApiKey)(nil),
+		(*AddModelProviderKeyRequest_AwsCredentials)(nil),
 	}
-	file_construct_v1_modelprovider_proto_msgTypes[13].OneofWrappers = []any{}
+	file_construct_v1_modelprovider_proto_msgTypes[22].OneofWrappers = []any{}
+	file_construct_v1_modelprovider_proto_msgTypes[23].OneofWrappers = []any{}
+	file_construct_v1_modelprovider_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_modelprovider_proto_rawDesc), len(file_construct_v1_modelprovider_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   14,
+			NumEnums:      4,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
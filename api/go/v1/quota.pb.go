@@ -0,0 +1,391 @@
+// Quota API reports how much of their quota a subject has used.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: construct/v1/quota.proto
+
+package v1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Quota limits the resources a subject may use. A limit of zero means that the resource is not limited.
+type Quota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// max_concurrent_tasks is the number of tasks that may run at the same time.
+	MaxConcurrentTasks int32 `protobuf:"varint,1,opt,name=max_concurrent_tasks,json=maxConcurrentTasks,proto3" json:"max_concurrent_tasks,omitempty"`
+	// daily_spend limits the cost of model calls per day in USD.
+	DailySpend float64 `protobuf:"fixed64,2,opt,name=daily_spend,json=dailySpend,proto3" json:"daily_spend,omitempty"`
+	// monthly_spend limits the cost of model calls per month in USD.
+	MonthlySpend float64 `protobuf:"fixed64,3,opt,name=monthly_spend,json=monthlySpend,proto3" json:"monthly_spend,omitempty"`
+	// daily_tokens limits the input, cache write and output tokens of model calls per day.
+	DailyTokens   int64 `protobuf:"varint,4,opt,name=daily_tokens,json=dailyTokens,proto3" json:"daily_tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_construct_v1_quota_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_quota_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_construct_v1_quota_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Quota) GetMaxConcurrentTasks() int32 {
+	if x != nil {
+		return x.MaxConcurrentTasks
+	}
+	return 0
+}
+
+func (x *Quota) GetDailySpend() float64 {
+	if x != nil {
+		return x.DailySpend
+	}
+	return 0
+}
+
+func (x *Quota) GetMonthlySpend() float64 {
+	if x != nil {
+		return x.MonthlySpend
+	}
+	return 0
+}
+
+func (x *Quota) GetDailyTokens() int64 {
+	if x != nil {
+		return x.DailyTokens
+	}
+	return 0
+}
+
+// QuotaUsage is the usage that counts against a quota. Days and months are in UTC.
+type QuotaUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// running_tasks is the number of tasks that are running right now.
+	RunningTasks int32 `protobuf:"varint,1,opt,name=running_tasks,json=runningTasks,proto3" json:"running_tasks,omitempty"`
+	// daily_spend is the cost of the model calls of the current day in USD.
+	DailySpend float64 `protobuf:"fixed64,2,opt,name=daily_spend,json=dailySpend,proto3" json:"daily_spend,omitempty"`
+	// monthly_spend is the cost of the model calls of the current month in USD.
+	MonthlySpend float64 `protobuf:"fixed64,3,opt,name=monthly_spend,json=monthlySpend,proto3" json:"monthly_spend,omitempty"`
+	// daily_tokens is the number of tokens of the model calls of the current day.
+	DailyTokens int64 `protobuf:"varint,4,opt,name=daily_tokens,json=dailyTokens,proto3" json:"daily_tokens,omitempty"`
+	// daily_reset_at is when the daily usage starts over.
+	DailyResetAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=daily_reset_at,json=dailyResetAt,proto3" json:"daily_reset_at,omitempty"`
+	// monthly_reset_at is when the monthly usage starts over.
+	MonthlyResetAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=monthly_reset_at,json=monthlyResetAt,proto3" json:"monthly_reset_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_construct_v1_quota_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_quota_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_construct_v1_quota_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QuotaUsage) GetRunningTasks() int32 {
+	if x != nil {
+		return x.RunningTasks
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetDailySpend() float64 {
+	if x != nil {
+		return x.DailySpend
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMonthlySpend() float64 {
+	if x != nil {
+		return x.MonthlySpend
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetDailyTokens() int64 {
+	if x != nil {
+		return x.DailyTokens
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetDailyResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DailyResetAt
+	}
+	return nil
+}
+
+func (x *QuotaUsage) GetMonthlyResetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MonthlyResetAt
+	}
+	return nil
+}
+
+// GetQuotaUsageRequest identifies the subject.
+type GetQuotaUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subject is the identity whose usage is reported. Defaults to the caller. Only admins may
+	// ask for other subjects (optional).
+	Subject       *string `protobuf:"bytes,1,opt,name=subject,proto3,oneof" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageRequest) Reset() {
+	*x = GetQuotaUsageRequest{}
+	mi := &file_construct_v1_quota_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageRequest) ProtoMessage() {}
+
+func (x *GetQuotaUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_quota_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_quota_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetQuotaUsageRequest) GetSubject() string {
+	if x != nil && x.Subject != nil {
+		return *x.Subject
+	}
+	return ""
+}
+
+// GetQuotaUsageResponse contains the quota and the usage of the subject.
+type GetQuotaUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subject is the identity whose usage is reported.
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	// quota is the quota of the subject. It is not set if the subject has no quota.
+	Quota *Quota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	// usage is the current usage of the subject.
+	Usage         *QuotaUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuotaUsageResponse) Reset() {
+	*x = GetQuotaUsageResponse{}
+	mi := &file_construct_v1_quota_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuotaUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuotaUsageResponse) ProtoMessage() {}
+
+func (x *GetQuotaUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_quota_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuotaUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetQuotaUsageResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_quota_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetQuotaUsageResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *GetQuotaUsageResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *GetQuotaUsageResponse) GetUsage() *QuotaUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+var File_construct_v1_quota_proto protoreflect.FileDescriptor
+
+const file_construct_v1_quota_proto_rawDesc = "" +
+	"\n" +
+	"\x18construct/v1/quota.proto\x12\fconstruct.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd4\x01\n" +
+	"\x05Quota\x129\n" +
+	"\x14max_concurrent_tasks\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(\x00R\x12maxConcurrentTasks\x12/\n" +
+	"\vdaily_spend\x18\x02 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\n" +
+	"dailySpend\x123\n" +
+	"\rmonthly_spend\x18\x03 \x01(\x01B\x0e\xbaH\v\x12\t)\x00\x00\x00\x00\x00\x00\x00\x00R\fmonthlySpend\x12*\n" +
+	"\fdaily_tokens\x18\x04 \x01(\x03B\a\xbaH\x04\"\x02(\x00R\vdailyTokens\"\xa2\x02\n" +
+	"\n" +
+	"QuotaUsage\x12#\n" +
+	"\rrunning_tasks\x18\x01 \x01(\x05R\frunningTasks\x12\x1f\n" +
+	"\vdaily_spend\x18\x02 \x01(\x01R\n" +
+	"dailySpend\x12#\n" +
+	"\rmonthly_spend\x18\x03 \x01(\x01R\fmonthlySpend\x12!\n" +
+	"\fdaily_tokens\x18\x04 \x01(\x03R\vdailyTokens\x12@\n" +
+	"\x0edaily_reset_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fdailyResetAt\x12D\n" +
+	"\x10monthly_reset_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0emonthlyResetAt\"K\n" +
+	"\x14GetQuotaUsageRequest\x12'\n" +
+	"\asubject\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x00R\asubject\x88\x01\x01B\n" +
+	"\n" +
+	"\b_subject\"\x94\x01\n" +
+	"\x15GetQuotaUsageResponse\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12)\n" +
+	"\x05quota\x18\x02 \x01(\v2\x13.construct.v1.QuotaR\x05quota\x126\n" +
+	"\x05usage\x18\x03 \x01(\v2\x18.construct.v1.QuotaUsageB\x06\xbaH\x03\xc8\x01\x01R\x05usage2m\n" +
+	"\fQuotaService\x12]\n" +
+	"\rGetQuotaUsage\x12\".construct.v1.GetQuotaUsageRequest\x1a#.construct.v1.GetQuotaUsageResponse\"\x03\x90\x02\x01B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+
+var (
+	file_construct_v1_quota_proto_rawDescOnce sync.Once
+	file_construct_v1_quota_proto_rawDescData []byte
+)
+
+func file_construct_v1_quota_proto_rawDescGZIP() []byte {
+	file_construct_v1_quota_proto_rawDescOnce.Do(func() {
+		file_construct_v1_quota_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_construct_v1_quota_proto_rawDesc), len(file_construct_v1_quota_proto_rawDesc)))
+	})
+	return file_construct_v1_quota_proto_rawDescData
+}
+
+var file_construct_v1_quota_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_construct_v1_quota_proto_goTypes = []any{
+	(*Quota)(nil),                 // 0: construct.v1.Quota
+	(*QuotaUsage)(nil),            // 1: construct.v1.QuotaUsage
+	(*GetQuotaUsageRequest)(nil),  // 2: construct.v1.GetQuotaUsageRequest
+	(*GetQuotaUsageResponse)(nil), // 3: construct.v1.GetQuotaUsageResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_construct_v1_quota_proto_depIdxs = []int32{
+	4, // 0: construct.v1.QuotaUsage.daily_reset_at:type_name -> google.protobuf.Timestamp
+	4, // 1: construct.v1.QuotaUsage.monthly_reset_at:type_name -> google.protobuf.Timestamp
+	0, // 2: construct.v1.GetQuotaUsageResponse.quota:type_name -> construct.v1.Quota
+	1, // 3: construct.v1.GetQuotaUsageResponse.usage:type_name -> construct.v1.QuotaUsage
+	2, // 4: construct.v1.QuotaService.GetQuotaUsage:input_type -> construct.v1.GetQuotaUsageRequest
+	3, // 5: construct.v1.QuotaService.GetQuotaUsage:output_type -> construct.v1.GetQuotaUsageResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_construct_v1_quota_proto_init() }
+func file_construct_v1_quota_proto_init() {
+	if File_construct_v1_quota_proto != nil {
+		return
+	}
+	file_construct_v1_quota_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_quota_proto_rawDesc), len(file_construct_v1_quota_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_construct_v1_quota_proto_goTypes,
+		DependencyIndexes: file_construct_v1_quota_proto_depIdxs,
+		MessageInfos:      file_construct_v1_quota_proto_msgTypes,
+	}.Build()
+	File_construct_v1_quota_proto = out.File
+	file_construct_v1_quota_proto_goTypes = nil
+	file_construct_v1_quota_proto_depIdxs = nil
+}
@@ -156,7 +156,10 @@ type TaskMetadata struct {
 	// created_at is the timestamp when the task was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// updated_at is the timestamp when the task was last modified.
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// owner is the subject of the identity that created the task (empty for tasks that were
+	// created before ownership was recorded).
+	Owner         string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -212,6 +215,13 @@ func (x *TaskMetadata) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *TaskMetadata) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
 // TaskSpec defines the user-configurable specification of a task.
 type TaskSpec struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -222,7 +232,11 @@ type TaskSpec struct {
 	// phase is the desired operational state of the task.
 	DesiredPhase TaskPhase `protobuf:"varint,3,opt,name=desired_phase,json=desiredPhase,proto3,enum=construct.v1.TaskPhase" json:"desired_phase,omitempty"`
 	// description is a brief description of the task.
-	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// trace_model_calls captures the raw requests and responses of the task's model calls.
+	TraceModelCalls bool `protobuf:"varint,5,opt,name=trace_model_calls,json=traceModelCalls,proto3" json:"trace_model_calls,omitempty"`
+	// shared_with lists the subjects besides the owner that may access the task.
+	SharedWith    []string `protobuf:"bytes,6,rep,name=shared_with,json=sharedWith,proto3" json:"shared_with,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -285,6 +299,20 @@ func (x *TaskSpec) GetDescription() string {
 	return ""
 }
 
+func (x *TaskSpec) GetTraceModelCalls() bool {
+	if x != nil {
+		return x.TraceModelCalls
+	}
+	return false
+}
+
+func (x *TaskSpec) GetSharedWith() []string {
+	if x != nil {
+		return x.SharedWith
+	}
+	return nil
+}
+
 // TaskStatus contains the observed state and usage information of the task.
 type TaskStatus struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -372,9 +400,13 @@ type TaskUsage struct {
 	// cost is the total monetary cost associated with the task execution.
 	Cost float64 `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
 	// tool_uses tracks the number of times each tool was used during the task.
-	ToolUses      map[string]int64 `protobuf:"bytes,6,rep,name=tool_uses,json=toolUses,proto3" json:"tool_uses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ToolUses map[string]int64 `protobuf:"bytes,6,rep,name=tool_uses,json=toolUses,proto3" json:"tool_uses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// routed_turns is the number of model invocations served by a model chosen by the agent's router.
+	RoutedTurns int64 `protobuf:"varint,7,opt,name=routed_turns,json=routedTurns,proto3" json:"routed_turns,omitempty"`
+	// routing_savings is the cost saved by routing compared to using the agent's default model.
+	RoutingSavings float64 `protobuf:"fixed64,8,opt,name=routing_savings,json=routingSavings,proto3" json:"routing_savings,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TaskUsage) Reset() {
@@ -449,6 +481,20 @@ func (x *TaskUsage) GetToolUses() map[string]int64 {
 	return nil
 }
 
+func (x *TaskUsage) GetRoutedTurns() int64 {
+	if x != nil {
+		return x.RoutedTurns
+	}
+	return 0
+}
+
+func (x *TaskUsage) GetRoutingSavings() float64 {
+	if x != nil {
+		return x.RoutingSavings
+	}
+	return 0
+}
+
 // CreateTaskRequest contains the parameters needed to create a new task.
 type CreateTaskRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -457,9 +503,11 @@ type CreateTaskRequest struct {
 	// project_directory is the file system path where the task will be executed.
 	ProjectDirectory string `protobuf:"bytes,2,opt,name=project_directory,json=projectDirectory,proto3" json:"project_directory,omitempty"`
 	// description is a brief description of the task.
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// trace_model_calls captures the raw requests and responses of the task's model calls.
+	TraceModelCalls bool `protobuf:"varint,4,opt,name=trace_model_calls,json=traceModelCalls,proto3" json:"trace_model_calls,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateTaskRequest) Reset() {
@@ -513,6 +561,13 @@ func (x *CreateTaskRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateTaskRequest) GetTraceModelCalls() bool {
+	if x != nil {
+		return x.TraceModelCalls
+	}
+	return false
+}
+
 // CreateTaskResponse contains the newly created task.
 type CreateTaskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -794,9 +849,11 @@ type UpdateTaskRequest struct {
 	// id is the unique identifier of the task to update (UUID format).
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// agent_id is the new agent assignment for the task (UUID format, optional).
-	AgentId       *string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	AgentId *string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+	// trace_model_calls enables or disables the capture of the task's model calls (optional).
+	TraceModelCalls *bool `protobuf:"varint,3,opt,name=trace_model_calls,json=traceModelCalls,proto3,oneof" json:"trace_model_calls,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateTaskRequest) Reset() {
@@ -843,6 +900,13 @@ func (x *UpdateTaskRequest) GetAgentId() string {
 	return ""
 }
 
+func (x *UpdateTaskRequest) GetTraceModelCalls() bool {
+	if x != nil && x.TraceModelCalls != nil {
+		return *x.TraceModelCalls
+	}
+	return false
+}
+
 // UpdateTaskResponse contains the updated task.
 type UpdateTaskResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1052,6 +1116,471 @@ func (*SuspendTaskResponse) Descriptor() ([]byte, []int) {
 	return file_construct_v1_task_proto_rawDescGZIP(), []int{16}
 }
 
+// ShareTaskRequest specifies the task to share and the subject to share it with.
+type ShareTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the unique identifier of the task (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// subject is the identity subject, e.g. a token name, that gets access to the task.
+	Subject       string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskRequest) Reset() {
+	*x = ShareTaskRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskRequest) ProtoMessage() {}
+
+func (x *ShareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskRequest.ProtoReflect.Descriptor instead.
+func (*ShareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// ShareTaskResponse contains the shared task.
+type ShareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskResponse) Reset() {
+	*x = ShareTaskResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskResponse) ProtoMessage() {}
+
+func (x *ShareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskResponse.ProtoReflect.Descriptor instead.
+func (*ShareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ShareTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UnshareTaskRequest specifies the task and the subject whose access is revoked.
+type UnshareTaskRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the unique identifier of the task (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// subject is the identity subject that loses access to the task.
+	Subject       string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskRequest) Reset() {
+	*x = UnshareTaskRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskRequest) ProtoMessage() {}
+
+func (x *UnshareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnshareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UnshareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UnshareTaskRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+// UnshareTaskResponse contains the task after its access was revoked.
+type UnshareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskResponse) Reset() {
+	*x = UnshareTaskResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskResponse) ProtoMessage() {}
+
+func (x *UnshareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnshareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UnshareTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// ModelCallTrace is the raw HTTP exchange of a single request to a model provider. Credentials
+// in the headers are redacted.
+type ModelCallTrace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the unique identifier of the trace (UUID format).
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the timestamp when the request was sent.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// model is the name of the model that was invoked.
+	Model string `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	// model_provider_id references the model provider that served the request (UUID format).
+	ModelProviderId string `protobuf:"bytes,4,opt,name=model_provider_id,json=modelProviderId,proto3" json:"model_provider_id,omitempty"`
+	// method is the HTTP method of the request.
+	Method string `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	// url is the URL the request was sent to.
+	Url string `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	// request_headers are the headers of the request.
+	RequestHeaders map[string]string `protobuf:"bytes,7,rep,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// request_body is the body of the request as it was sent to the provider.
+	RequestBody []byte `protobuf:"bytes,8,opt,name=request_body,json=requestBody,proto3" json:"request_body,omitempty"`
+	// status_code is the HTTP status of the response. It is 0 if no response was received.
+	StatusCode int32 `protobuf:"varint,9,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// response_headers are the headers of the response.
+	ResponseHeaders map[string]string `protobuf:"bytes,10,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// response_body is the body of the response, including streamed events.
+	ResponseBody []byte `protobuf:"bytes,11,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`
+	// truncated is set if a body exceeded the capture limit of the daemon.
+	Truncated bool `protobuf:"varint,12,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// error describes why the request failed without a response.
+	Error string `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	// duration_ms is the time from sending the request until the response was read.
+	DurationMs    int64 `protobuf:"varint,14,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModelCallTrace) Reset() {
+	*x = ModelCallTrace{}
+	mi := &file_construct_v1_task_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModelCallTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModelCallTrace) ProtoMessage() {}
+
+func (x *ModelCallTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModelCallTrace.ProtoReflect.Descriptor instead.
+func (*ModelCallTrace) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ModelCallTrace) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModelCallTrace) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ModelCallTrace) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *ModelCallTrace) GetModelProviderId() string {
+	if x != nil {
+		return x.ModelProviderId
+	}
+	return ""
+}
+
+func (x *ModelCallTrace) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ModelCallTrace) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ModelCallTrace) GetRequestHeaders() map[string]string {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+func (x *ModelCallTrace) GetRequestBody() []byte {
+	if x != nil {
+		return x.RequestBody
+	}
+	return nil
+}
+
+func (x *ModelCallTrace) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ModelCallTrace) GetResponseHeaders() map[string]string {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *ModelCallTrace) GetResponseBody() []byte {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return nil
+}
+
+func (x *ModelCallTrace) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ModelCallTrace) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ModelCallTrace) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// GetModelCallTraceRequest specifies the task whose model calls to retrieve.
+type GetModelCallTraceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// task_id is the unique identifier of the task (UUID format).
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// limit restricts the result to the most recent calls (1-100, optional).
+	Limit         *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelCallTraceRequest) Reset() {
+	*x = GetModelCallTraceRequest{}
+	mi := &file_construct_v1_task_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelCallTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelCallTraceRequest) ProtoMessage() {}
+
+func (x *GetModelCallTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelCallTraceRequest.ProtoReflect.Descriptor instead.
+func (*GetModelCallTraceRequest) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetModelCallTraceRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetModelCallTraceRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// GetModelCallTraceResponse contains the captured model calls, oldest first.
+type GetModelCallTraceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// calls are the captured model calls of the task.
+	Calls         []*ModelCallTrace `protobuf:"bytes,1,rep,name=calls,proto3" json:"calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModelCallTraceResponse) Reset() {
+	*x = GetModelCallTraceResponse{}
+	mi := &file_construct_v1_task_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModelCallTraceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModelCallTraceResponse) ProtoMessage() {}
+
+func (x *GetModelCallTraceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_construct_v1_task_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModelCallTraceResponse.ProtoReflect.Descriptor instead.
+func (*GetModelCallTraceResponse) Descriptor() ([]byte, []int) {
+	return file_construct_v1_task_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetModelCallTraceResponse) GetCalls() []*ModelCallTrace {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
 // Filter specifies criteria for narrowing the list of returned tasks.
 type ListTasksRequest_Filter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1063,14 +1592,16 @@ type ListTasksRequest_Filter struct {
 	// - if set to true: only tasks with at least one message
 	// - if set to false: only tasks with zero messages
 	// - if unset: no filtering by message presence
-	HasMessages   *bool `protobuf:"varint,3,opt,name=has_messages,json=hasMessages,proto3,oneof" json:"has_messages,omitempty"`
+	HasMessages *bool `protobuf:"varint,3,opt,name=has_messages,json=hasMessages,proto3,oneof" json:"has_messages,omitempty"`
+	// owner filters tasks by the subject that created them.
+	Owner         *string `protobuf:"bytes,4,opt,name=owner,proto3,oneof" json:"owner,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTasksRequest_Filter) Reset() {
 	*x = ListTasksRequest_Filter{}
-	mi := &file_construct_v1_task_proto_msgTypes[18]
+	mi := &file_construct_v1_task_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1082,7 +1613,7 @@ func (x *ListTasksRequest_Filter) String() string {
 func (*ListTasksRequest_Filter) ProtoMessage() {}
 
 func (x *ListTasksRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_construct_v1_task_proto_msgTypes[18]
+	mi := &file_construct_v1_task_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,6 +1650,13 @@ func (x *ListTasksRequest_Filter) GetHasMessages() bool {
 	return false
 }
 
+func (x *ListTasksRequest_Filter) GetOwner() string {
+	if x != nil && x.Owner != nil {
+		return *x.Owner
+	}
+	return ""
+}
+
 var File_construct_v1_task_proto protoreflect.FileDescriptor
 
 const file_construct_v1_task_proto_rawDesc = "" +
@@ -1127,45 +1665,52 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\x04Task\x126\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x1a.construct.v1.TaskMetadataR\bmetadata\x12*\n" +
 	"\x04spec\x18\x02 \x01(\v2\x16.construct.v1.TaskSpecR\x04spec\x120\n" +
-	"\x06status\x18\x03 \x01(\v2\x18.construct.v1.TaskStatusR\x06status\"\xae\x01\n" +
+	"\x06status\x18\x03 \x01(\v2\x18.construct.v1.TaskStatusR\x06status\"\xc4\x01\n" +
 	"\fTaskMetadata\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12A\n" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tcreatedAt\x12A\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\"\xdb\x01\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\tupdatedAt\x12\x14\n" +
+	"\x05owner\x18\x04 \x01(\tR\x05owner\"\xa8\x02\n" +
 	"\bTaskSpec\x12(\n" +
 	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12$\n" +
 	"\tworkspace\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\tworkspace\x12F\n" +
 	"\rdesired_phase\x18\x03 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\fdesiredPhase\x12*\n" +
-	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescriptionB\v\n" +
+	"\vdescription\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12*\n" +
+	"\x11trace_model_calls\x18\x05 \x01(\bR\x0ftraceModelCalls\x12\x1f\n" +
+	"\vshared_with\x18\x06 \x03(\tR\n" +
+	"sharedWithB\v\n" +
 	"\t_agent_id\"\xad\x01\n" +
 	"\n" +
 	"TaskStatus\x12-\n" +
 	"\x05usage\x18\x01 \x01(\v2\x17.construct.v1.TaskUsageR\x05usage\x127\n" +
 	"\x05phase\x18\x02 \x01(\x0e2\x17.construct.v1.TaskPhaseB\b\xbaH\x05\x82\x01\x02\x10\x01R\x05phase\x12\x12\n" +
 	"\x04turn\x18\x03 \x01(\x03R\x04turn\x12#\n" +
-	"\rmessage_count\x18\x04 \x01(\x03R\fmessageCount\"\xc2\x02\n" +
+	"\rmessage_count\x18\x04 \x01(\x03R\fmessageCount\"\x8e\x03\n" +
 	"\tTaskUsage\x12!\n" +
 	"\finput_tokens\x18\x01 \x01(\x03R\vinputTokens\x12#\n" +
 	"\routput_tokens\x18\x02 \x01(\x03R\foutputTokens\x12,\n" +
 	"\x12cache_write_tokens\x18\x03 \x01(\x03R\x10cacheWriteTokens\x12*\n" +
 	"\x11cache_read_tokens\x18\x04 \x01(\x03R\x0fcacheReadTokens\x12\x12\n" +
 	"\x04cost\x18\x05 \x01(\x01R\x04cost\x12B\n" +
-	"\ttool_uses\x18\x06 \x03(\v2%.construct.v1.TaskUsage.ToolUsesEntryR\btoolUses\x1a;\n" +
+	"\ttool_uses\x18\x06 \x03(\v2%.construct.v1.TaskUsage.ToolUsesEntryR\btoolUses\x12!\n" +
+	"\frouted_turns\x18\a \x01(\x03R\vroutedTurns\x12'\n" +
+	"\x0frouting_savings\x18\b \x01(\x01R\x0eroutingSavings\x1a;\n" +
 	"\rToolUsesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x99\x01\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xc5\x01\n" +
 	"\x11CreateTaskRequest\x12#\n" +
 	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\aagentId\x123\n" +
 	"\x11project_directory\x18\x02 \x01(\tB\x06\xbaH\x03\xc8\x01\x01R\x10projectDirectory\x12*\n" +
-	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\"D\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\vdescription\x12*\n" +
+	"\x11trace_model_calls\x18\x04 \x01(\bR\x0ftraceModelCalls\"D\n" +
 	"\x12CreateTaskResponse\x12.\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"*\n" +
 	"\x0eGetTaskRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\"A\n" +
 	"\x0fGetTaskResponse\x12.\n" +
-	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"\x9a\x04\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"\xc9\x04\n" +
 	"\x10ListTasksRequest\x12=\n" +
 	"\x06filter\x18\x01 \x01(\v2%.construct.v1.ListTasksRequest.FilterR\x06filter\x12+\n" +
 	"\tpage_size\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\bpageSize\x88\x01\x01\x12'\n" +
@@ -1174,25 +1719,29 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\n" +
 	"sort_field\x18\x04 \x01(\x0e2\x17.construct.v1.SortFieldB\b\xbaH\x05\x82\x01\x02\x10\x01H\x01R\tsortField\x88\x01\x01\x12E\n" +
 	"\n" +
-	"sort_order\x18\x05 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\tsortOrder\x88\x01\x01\x1a\xb6\x01\n" +
+	"sort_order\x18\x05 \x01(\x0e2\x17.construct.v1.SortOrderB\b\xbaH\x05\x82\x01\x02\x10\x01H\x02R\tsortOrder\x88\x01\x01\x1a\xe5\x01\n" +
 	"\x06Filter\x12(\n" +
 	"\bagent_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12)\n" +
 	"\x0etask_id_prefix\x18\x02 \x01(\tH\x01R\ftaskIdPrefix\x88\x01\x01\x12&\n" +
-	"\fhas_messages\x18\x03 \x01(\bH\x02R\vhasMessages\x88\x01\x01B\v\n" +
+	"\fhas_messages\x18\x03 \x01(\bH\x02R\vhasMessages\x88\x01\x01\x12#\n" +
+	"\x05owner\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xff\x01H\x03R\x05owner\x88\x01\x01B\v\n" +
 	"\t_agent_idB\x11\n" +
 	"\x0f_task_id_prefixB\x0f\n" +
-	"\r_has_messagesB\f\n" +
+	"\r_has_messagesB\b\n" +
+	"\x06_ownerB\f\n" +
 	"\n" +
 	"_page_sizeB\r\n" +
 	"\v_sort_fieldB\r\n" +
 	"\v_sort_order\"e\n" +
 	"\x11ListTasksResponse\x12(\n" +
 	"\x05tasks\x18\x01 \x03(\v2\x12.construct.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xab\x01\n" +
 	"\x11UpdateTaskRequest\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x12(\n" +
-	"\bagent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01B\v\n" +
-	"\t_agent_id\"D\n" +
+	"\bagent_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01H\x00R\aagentId\x88\x01\x01\x12/\n" +
+	"\x11trace_model_calls\x18\x03 \x01(\bH\x01R\x0ftraceModelCalls\x88\x01\x01B\v\n" +
+	"\t_agent_idB\x14\n" +
+	"\x12_trace_model_calls\"D\n" +
 	"\x12UpdateTaskResponse\x12.\n" +
 	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskB\x06\xbaH\x03\xc8\x01\x01R\x04task\"-\n" +
 	"\x11DeleteTaskRequest\x12\x18\n" +
@@ -1200,12 +1749,55 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"\x12DeleteTaskResponse\"7\n" +
 	"\x12SuspendTaskRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\"\x15\n" +
-	"\x13SuspendTaskResponse*r\n" +
+	"\x13SuspendTaskResponse\"[\n" +
+	"\x10ShareTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12$\n" +
+	"\asubject\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\asubject\";\n" +
+	"\x11ShareTaskResponse\x12&\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskR\x04task\"]\n" +
+	"\x12UnshareTaskRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12$\n" +
+	"\asubject\x18\x02 \x01(\tB\n" +
+	"\xbaH\ar\x05\x10\x01\x18\xff\x01R\asubject\"=\n" +
+	"\x13UnshareTaskResponse\x12&\n" +
+	"\x04task\x18\x01 \x01(\v2\x12.construct.v1.TaskR\x04task\"\xcf\x05\n" +
+	"\x0eModelCallTrace\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x02id\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05model\x18\x03 \x01(\tR\x05model\x12*\n" +
+	"\x11model_provider_id\x18\x04 \x01(\tR\x0fmodelProviderId\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x10\n" +
+	"\x03url\x18\x06 \x01(\tR\x03url\x12Y\n" +
+	"\x0frequest_headers\x18\a \x03(\v20.construct.v1.ModelCallTrace.RequestHeadersEntryR\x0erequestHeaders\x12!\n" +
+	"\frequest_body\x18\b \x01(\fR\vrequestBody\x12\x1f\n" +
+	"\vstatus_code\x18\t \x01(\x05R\n" +
+	"statusCode\x12\\\n" +
+	"\x10response_headers\x18\n" +
+	" \x03(\v21.construct.v1.ModelCallTrace.ResponseHeadersEntryR\x0fresponseHeaders\x12#\n" +
+	"\rresponse_body\x18\v \x01(\fR\fresponseBody\x12\x1c\n" +
+	"\ttruncated\x18\f \x01(\bR\ttruncated\x12\x14\n" +
+	"\x05error\x18\r \x01(\tR\x05error\x12\x1f\n" +
+	"\vduration_ms\x18\x0e \x01(\x03R\n" +
+	"durationMs\x1aA\n" +
+	"\x13RequestHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14ResponseHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"m\n" +
+	"\x18GetModelCallTraceRequest\x12!\n" +
+	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12$\n" +
+	"\x05limit\x18\x02 \x01(\x05B\t\xbaH\x06\x1a\x04\x18d(\x01H\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"O\n" +
+	"\x19GetModelCallTraceResponse\x122\n" +
+	"\x05calls\x18\x01 \x03(\v2\x1c.construct.v1.ModelCallTraceR\x05calls*r\n" +
 	"\tTaskPhase\x12\x1a\n" +
 	"\x16TASK_PHASE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13TASK_PHASE_AWAITING\x10\x01\x12\x16\n" +
 	"\x12TASK_PHASE_RUNNING\x10\x02\x12\x18\n" +
-	"\x14TASK_PHASE_SUSPENDED\x10\x032\xfc\x03\n" +
+	"\x14TASK_PHASE_SUSPENDED\x10\x032\x8d\x06\n" +
 	"\vTaskService\x12Q\n" +
 	"\n" +
 	"CreateTask\x12\x1f.construct.v1.CreateTaskRequest\x1a .construct.v1.CreateTaskResponse\"\x00\x12K\n" +
@@ -1215,7 +1807,10 @@ const file_construct_v1_task_proto_rawDesc = "" +
 	"UpdateTask\x12\x1f.construct.v1.UpdateTaskRequest\x1a .construct.v1.UpdateTaskResponse\"\x00\x12Q\n" +
 	"\n" +
 	"DeleteTask\x12\x1f.construct.v1.DeleteTaskRequest\x1a .construct.v1.DeleteTaskResponse\"\x00\x12T\n" +
-	"\vSuspendTask\x12 .construct.v1.SuspendTaskRequest\x1a!.construct.v1.SuspendTaskResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
+	"\vSuspendTask\x12 .construct.v1.SuspendTaskRequest\x1a!.construct.v1.SuspendTaskResponse\"\x00\x12i\n" +
+	"\x11GetModelCallTrace\x12&.construct.v1.GetModelCallTraceRequest\x1a'.construct.v1.GetModelCallTraceResponse\"\x03\x90\x02\x01\x12N\n" +
+	"\tShareTask\x12\x1e.construct.v1.ShareTaskRequest\x1a\x1f.construct.v1.ShareTaskResponse\"\x00\x12T\n" +
+	"\vUnshareTask\x12 .construct.v1.UnshareTaskRequest\x1a!.construct.v1.UnshareTaskResponse\"\x00B(Z&github.com/furisto/construct/api/go/v1b\x06proto3"
 
 var (
 	file_construct_v1_task_proto_rawDescOnce sync.Once
@@ -1230,66 +1825,87 @@ func file_construct_v1_task_proto_rawDescGZIP() []byte {
 }
 
 var file_construct_v1_task_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_construct_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_construct_v1_task_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_construct_v1_task_proto_goTypes = []any{
-	(TaskPhase)(0),                  // 0: construct.v1.TaskPhase
-	(*Task)(nil),                    // 1: construct.v1.Task
-	(*TaskMetadata)(nil),            // 2: construct.v1.TaskMetadata
-	(*TaskSpec)(nil),                // 3: construct.v1.TaskSpec
-	(*TaskStatus)(nil),              // 4: construct.v1.TaskStatus
-	(*TaskUsage)(nil),               // 5: construct.v1.TaskUsage
-	(*CreateTaskRequest)(nil),       // 6: construct.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),      // 7: construct.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),          // 8: construct.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),         // 9: construct.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),        // 10: construct.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),       // 11: construct.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),       // 12: construct.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),      // 13: construct.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),       // 14: construct.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),      // 15: construct.v1.DeleteTaskResponse
-	(*SuspendTaskRequest)(nil),      // 16: construct.v1.SuspendTaskRequest
-	(*SuspendTaskResponse)(nil),     // 17: construct.v1.SuspendTaskResponse
-	nil,                             // 18: construct.v1.TaskUsage.ToolUsesEntry
-	(*ListTasksRequest_Filter)(nil), // 19: construct.v1.ListTasksRequest.Filter
-	(*timestamppb.Timestamp)(nil),   // 20: google.protobuf.Timestamp
-	(SortField)(0),                  // 21: construct.v1.SortField
-	(SortOrder)(0),                  // 22: construct.v1.SortOrder
+	(TaskPhase)(0),                    // 0: construct.v1.TaskPhase
+	(*Task)(nil),                      // 1: construct.v1.Task
+	(*TaskMetadata)(nil),              // 2: construct.v1.TaskMetadata
+	(*TaskSpec)(nil),                  // 3: construct.v1.TaskSpec
+	(*TaskStatus)(nil),                // 4: construct.v1.TaskStatus
+	(*TaskUsage)(nil),                 // 5: construct.v1.TaskUsage
+	(*CreateTaskRequest)(nil),         // 6: construct.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),        // 7: construct.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),            // 8: construct.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),           // 9: construct.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),          // 10: construct.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),         // 11: construct.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),         // 12: construct.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),        // 13: construct.v1.UpdateTaskResponse
+	(*DeleteTaskRequest)(nil),         // 14: construct.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),        // 15: construct.v1.DeleteTaskResponse
+	(*SuspendTaskRequest)(nil),        // 16: construct.v1.SuspendTaskRequest
+	(*SuspendTaskResponse)(nil),       // 17: construct.v1.SuspendTaskResponse
+	(*ShareTaskRequest)(nil),          // 18: construct.v1.ShareTaskRequest
+	(*ShareTaskResponse)(nil),         // 19: construct.v1.ShareTaskResponse
+	(*UnshareTaskRequest)(nil),        // 20: construct.v1.UnshareTaskRequest
+	(*UnshareTaskResponse)(nil),       // 21: construct.v1.UnshareTaskResponse
+	(*ModelCallTrace)(nil),            // 22: construct.v1.ModelCallTrace
+	(*GetModelCallTraceRequest)(nil),  // 23: construct.v1.GetModelCallTraceRequest
+	(*GetModelCallTraceResponse)(nil), // 24: construct.v1.GetModelCallTraceResponse
+	nil,                               // 25: construct.v1.TaskUsage.ToolUsesEntry
+	(*ListTasksRequest_Filter)(nil),   // 26: construct.v1.ListTasksRequest.Filter
+	nil,                               // 27: construct.v1.ModelCallTrace.RequestHeadersEntry
+	nil,                               // 28: construct.v1.ModelCallTrace.ResponseHeadersEntry
+	(*timestamppb.Timestamp)(nil),     // 29: google.protobuf.Timestamp
+	(SortField)(0),                    // 30: construct.v1.SortField
+	(SortOrder)(0),                    // 31: construct.v1.SortOrder
 }
 var file_construct_v1_task_proto_depIdxs = []int32{
 	2,  // 0: construct.v1.Task.metadata:type_name -> construct.v1.TaskMetadata
 	3,  // 1: construct.v1.Task.spec:type_name -> construct.v1.TaskSpec
 	4,  // 2: construct.v1.Task.status:type_name -> construct.v1.TaskStatus
-	20, // 3: construct.v1.TaskMetadata.created_at:type_name -> google.protobuf.Timestamp
-	20, // 4: construct.v1.TaskMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	29, // 3: construct.v1.TaskMetadata.created_at:type_name -> google.protobuf.Timestamp
+	29, // 4: construct.v1.TaskMetadata.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 5: construct.v1.TaskSpec.desired_phase:type_name -> construct.v1.TaskPhase
 	5,  // 6: construct.v1.TaskStatus.usage:type_name -> construct.v1.TaskUsage
 	0,  // 7: construct.v1.TaskStatus.phase:type_name -> construct.v1.TaskPhase
-	18, // 8: construct.v1.TaskUsage.tool_uses:type_name -> construct.v1.TaskUsage.ToolUsesEntry
+	25, // 8: construct.v1.TaskUsage.tool_uses:type_name -> construct.v1.TaskUsage.ToolUsesEntry
 	1,  // 9: construct.v1.CreateTaskResponse.task:type_name -> construct.v1.Task
 	1,  // 10: construct.v1.GetTaskResponse.task:type_name -> construct.v1.Task
-	19, // 11: construct.v1.ListTasksRequest.filter:type_name -> construct.v1.ListTasksRequest.Filter
-	21, // 12: construct.v1.ListTasksRequest.sort_field:type_name -> construct.v1.SortField
-	22, // 13: construct.v1.ListTasksRequest.sort_order:type_name -> construct.v1.SortOrder
+	26, // 11: construct.v1.ListTasksRequest.filter:type_name -> construct.v1.ListTasksRequest.Filter
+	30, // 12: construct.v1.ListTasksRequest.sort_field:type_name -> construct.v1.SortField
+	31, // 13: construct.v1.ListTasksRequest.sort_order:type_name -> construct.v1.SortOrder
 	1,  // 14: construct.v1.ListTasksResponse.tasks:type_name -> construct.v1.Task
 	1,  // 15: construct.v1.UpdateTaskResponse.task:type_name -> construct.v1.Task
-	6,  // 16: construct.v1.TaskService.CreateTask:input_type -> construct.v1.CreateTaskRequest
-	8,  // 17: construct.v1.TaskService.GetTask:input_type -> construct.v1.GetTaskRequest
-	10, // 18: construct.v1.TaskService.ListTasks:input_type -> construct.v1.ListTasksRequest
-	12, // 19: construct.v1.TaskService.UpdateTask:input_type -> construct.v1.UpdateTaskRequest
-	14, // 20: construct.v1.TaskService.DeleteTask:input_type -> construct.v1.DeleteTaskRequest
-	16, // 21: construct.v1.TaskService.SuspendTask:input_type -> construct.v1.SuspendTaskRequest
-	7,  // 22: construct.v1.TaskService.CreateTask:output_type -> construct.v1.CreateTaskResponse
-	9,  // 23: construct.v1.TaskService.GetTask:output_type -> construct.v1.GetTaskResponse
-	11, // 24: construct.v1.TaskService.ListTasks:output_type -> construct.v1.ListTasksResponse
-	13, // 25: construct.v1.TaskService.UpdateTask:output_type -> construct.v1.UpdateTaskResponse
-	15, // 26: construct.v1.TaskService.DeleteTask:output_type -> construct.v1.DeleteTaskResponse
-	17, // 27: construct.v1.TaskService.SuspendTask:output_type -> construct.v1.SuspendTaskResponse
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	1,  // 16: construct.v1.ShareTaskResponse.task:type_name -> construct.v1.Task
+	1,  // 17: construct.v1.UnshareTaskResponse.task:type_name -> construct.v1.Task
+	29, // 18: construct.v1.ModelCallTrace.created_at:type_name -> google.protobuf.Timestamp
+	27, // 19: construct.v1.ModelCallTrace.request_headers:type_name -> construct.v1.ModelCallTrace.RequestHeadersEntry
+	28, // 20: construct.v1.ModelCallTrace.response_headers:type_name -> construct.v1.ModelCallTrace.ResponseHeadersEntry
+	22, // 21: construct.v1.GetModelCallTraceResponse.calls:type_name -> construct.v1.ModelCallTrace
+	6,  // 22: construct.v1.TaskService.CreateTask:input_type -> construct.v1.CreateTaskRequest
+	8,  // 23: construct.v1.TaskService.GetTask:input_type -> construct.v1.GetTaskRequest
+	10, // 24: construct.v1.TaskService.ListTasks:input_type -> construct.v1.ListTasksRequest
+	12, // 25: construct.v1.TaskService.UpdateTask:input_type -> construct.v1.UpdateTaskRequest
+	14, // 26: construct.v1.TaskService.DeleteTask:input_type -> construct.v1.DeleteTaskRequest
+	16, // 27: construct.v1.TaskService.SuspendTask:input_type -> construct.v1.SuspendTaskRequest
+	23, // 28: construct.v1.TaskService.GetModelCallTrace:input_type -> construct.v1.GetModelCallTraceRequest
+	18, // 29: construct.v1.TaskService.ShareTask:input_type -> construct.v1.ShareTaskRequest
+	20, // 30: construct.v1.TaskService.UnshareTask:input_type -> construct.v1.UnshareTaskRequest
+	7,  // 31: construct.v1.TaskService.CreateTask:output_type -> construct.v1.CreateTaskResponse
+	9,  // 32: construct.v1.TaskService.GetTask:output_type -> construct.v1.GetTaskResponse
+	11, // 33: construct.v1.TaskService.ListTasks:output_type -> construct.v1.ListTasksResponse
+	13, // 34: construct.v1.TaskService.UpdateTask:output_type -> construct.v1.UpdateTaskResponse
+	15, // 35: construct.v1.TaskService.DeleteTask:output_type -> construct.v1.DeleteTaskResponse
+	17, // 36: construct.v1.TaskService.SuspendTask:output_type -> construct.v1.SuspendTaskResponse
+	24, // 37: construct.v1.TaskService.GetModelCallTrace:output_type -> construct.v1.GetModelCallTraceResponse
+	19, // 38: construct.v1.TaskService.ShareTask:output_type -> construct.v1.ShareTaskResponse
+	21, // 39: construct.v1.TaskService.UnshareTask:output_type -> construct.v1.UnshareTaskResponse
+	31, // [31:40] is the sub-list for method output_type
+	22, // [22:31] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_construct_v1_task_proto_init() }
@@ -1301,14 +1917,15 @@ func file_construct_v1_task_proto_init() {
 	file_construct_v1_task_proto_msgTypes[2].OneofWrappers = []any{}
 	file_construct_v1_task_proto_msgTypes[9].OneofWrappers = []any{}
 	file_construct_v1_task_proto_msgTypes[11].OneofWrappers = []any{}
-	file_construct_v1_task_proto_msgTypes[18].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[22].OneofWrappers = []any{}
+	file_construct_v1_task_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_construct_v1_task_proto_rawDesc), len(file_construct_v1_task_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -42,6 +42,8 @@ const (
 	AuthServiceListTokensProcedure = "/construct.v1.AuthService/ListTokens"
 	// AuthServiceRevokeTokenProcedure is the fully-qualified name of the AuthService's RevokeToken RPC.
 	AuthServiceRevokeTokenProcedure = "/construct.v1.AuthService/RevokeToken"
+	// AuthServiceRotateTokenProcedure is the fully-qualified name of the AuthService's RotateToken RPC.
+	AuthServiceRotateTokenProcedure = "/construct.v1.AuthService/RotateToken"
 	// AuthServiceExchangeSetupCodeProcedure is the fully-qualified name of the AuthService's
 	// ExchangeSetupCode RPC.
 	AuthServiceExchangeSetupCodeProcedure = "/construct.v1.AuthService/ExchangeSetupCode"
@@ -66,6 +68,14 @@ type AuthServiceClient interface {
 	ListTokens(context.Context, *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error)
 	// RevokeToken invalidates a token by name, preventing further use.
 	RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error)
+	// RotateToken issues a successor for a token. The successor keeps the name,
+	// scopes and limits of the token, the replaced token stays valid for an
+	// overlap window so that clients can switch over without downtime. A token
+	// cannot be rotated again while its replaced token is still valid.
+	//
+	// Admins can rotate any token, other callers only the token they
+	// authenticated with.
+	RotateToken(context.Context, *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error)
 	// ExchangeSetupCode exchanges a setup code for an authentication token.
 	//
 	// This is the only unauthenticated endpoint in the auth service. It allows
@@ -112,6 +122,12 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(authServiceMethods.ByName("RevokeToken")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateToken: connect.NewClient[v1.RotateTokenRequest, v1.RotateTokenResponse](
+			httpClient,
+			baseURL+AuthServiceRotateTokenProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RotateToken")),
+			connect.WithClientOptions(opts...),
+		),
 		exchangeSetupCode: connect.NewClient[v1.ExchangeSetupCodeRequest, v1.ExchangeSetupCodeResponse](
 			httpClient,
 			baseURL+AuthServiceExchangeSetupCodeProcedure,
@@ -127,6 +143,7 @@ type authServiceClient struct {
 	createSetupCode   *connect.Client[v1.CreateSetupCodeRequest, v1.CreateSetupCodeResponse]
 	listTokens        *connect.Client[v1.ListTokensRequest, v1.ListTokensResponse]
 	revokeToken       *connect.Client[v1.RevokeTokenRequest, v1.RevokeTokenResponse]
+	rotateToken       *connect.Client[v1.RotateTokenRequest, v1.RotateTokenResponse]
 	exchangeSetupCode *connect.Client[v1.ExchangeSetupCodeRequest, v1.ExchangeSetupCodeResponse]
 }
 
@@ -150,6 +167,11 @@ func (c *authServiceClient) RevokeToken(ctx context.Context, req *connect.Reques
 	return c.revokeToken.CallUnary(ctx, req)
 }
 
+// RotateToken calls construct.v1.AuthService.RotateToken.
+func (c *authServiceClient) RotateToken(ctx context.Context, req *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error) {
+	return c.rotateToken.CallUnary(ctx, req)
+}
+
 // ExchangeSetupCode calls construct.v1.AuthService.ExchangeSetupCode.
 func (c *authServiceClient) ExchangeSetupCode(ctx context.Context, req *connect.Request[v1.ExchangeSetupCodeRequest]) (*connect.Response[v1.ExchangeSetupCodeResponse], error) {
 	return c.exchangeSetupCode.CallUnary(ctx, req)
@@ -174,6 +196,14 @@ type AuthServiceHandler interface {
 	ListTokens(context.Context, *connect.Request[v1.ListTokensRequest]) (*connect.Response[v1.ListTokensResponse], error)
 	// RevokeToken invalidates a token by name, preventing further use.
 	RevokeToken(context.Context, *connect.Request[v1.RevokeTokenRequest]) (*connect.Response[v1.RevokeTokenResponse], error)
+	// RotateToken issues a successor for a token. The successor keeps the name,
+	// scopes and limits of the token, the replaced token stays valid for an
+	// overlap window so that clients can switch over without downtime. A token
+	// cannot be rotated again while its replaced token is still valid.
+	//
+	// Admins can rotate any token, other callers only the token they
+	// authenticated with.
+	RotateToken(context.Context, *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error)
 	// ExchangeSetupCode exchanges a setup code for an authentication token.
 	//
 	// This is the only unauthenticated endpoint in the auth service. It allows
@@ -216,6 +246,12 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(authServiceMethods.ByName("RevokeToken")),
 		connect.WithHandlerOptions(opts...),
 	)
+	authServiceRotateTokenHandler := connect.NewUnaryHandler(
+		AuthServiceRotateTokenProcedure,
+		svc.RotateToken,
+		connect.WithSchema(authServiceMethods.ByName("RotateToken")),
+		connect.WithHandlerOptions(opts...),
+	)
 	authServiceExchangeSetupCodeHandler := connect.NewUnaryHandler(
 		AuthServiceExchangeSetupCodeProcedure,
 		svc.ExchangeSetupCode,
@@ -232,6 +268,8 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceListTokensHandler.ServeHTTP(w, r)
 		case AuthServiceRevokeTokenProcedure:
 			authServiceRevokeTokenHandler.ServeHTTP(w, r)
+		case AuthServiceRotateTokenProcedure:
+			authServiceRotateTokenHandler.ServeHTTP(w, r)
 		case AuthServiceExchangeSetupCodeProcedure:
 			authServiceExchangeSetupCodeHandler.ServeHTTP(w, r)
 		default:
@@ -259,6 +297,10 @@ func (UnimplementedAuthServiceHandler) RevokeToken(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.AuthService.RevokeToken is not implemented"))
 }
 
+func (UnimplementedAuthServiceHandler) RotateToken(context.Context, *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.AuthService.RotateToken is not implemented"))
+}
+
 func (UnimplementedAuthServiceHandler) ExchangeSetupCode(context.Context, *connect.Request[v1.ExchangeSetupCodeRequest]) (*connect.Response[v1.ExchangeSetupCodeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.AuthService.ExchangeSetupCode is not implemented"))
 }
@@ -0,0 +1,190 @@
+// Encryption API manages the keyset that the daemon encrypts stored secrets with, i.e. the API
+// keys of model providers and the signing secrets of webhooks.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/encryption.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EncryptionServiceName is the fully-qualified name of the EncryptionService service.
+	EncryptionServiceName = "construct.v1.EncryptionService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EncryptionServiceRotateEncryptionKeyProcedure is the fully-qualified name of the
+	// EncryptionService's RotateEncryptionKey RPC.
+	EncryptionServiceRotateEncryptionKeyProcedure = "/construct.v1.EncryptionService/RotateEncryptionKey"
+	// EncryptionServiceExportEncryptionKeysetProcedure is the fully-qualified name of the
+	// EncryptionService's ExportEncryptionKeyset RPC.
+	EncryptionServiceExportEncryptionKeysetProcedure = "/construct.v1.EncryptionService/ExportEncryptionKeyset"
+	// EncryptionServiceImportEncryptionKeysetProcedure is the fully-qualified name of the
+	// EncryptionService's ImportEncryptionKeyset RPC.
+	EncryptionServiceImportEncryptionKeysetProcedure = "/construct.v1.EncryptionService/ImportEncryptionKeyset"
+)
+
+// EncryptionServiceClient is a client for the construct.v1.EncryptionService service.
+type EncryptionServiceClient interface {
+	// RotateEncryptionKey adds a new primary key to the keyset and re-encrypts every stored
+	// secret with it in a single transaction. The previous keys stay enabled, so that secrets
+	// can still be decrypted if the transaction fails, unless disable_previous_keys is set.
+	//
+	// The progress of the rotation is streamed, the last message has the phase
+	// ENCRYPTION_KEY_ROTATION_PHASE_DONE.
+	RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.ServerStreamForClient[v1.RotateEncryptionKeyResponse], error)
+	// ExportEncryptionKeyset returns the keyset in cleartext, so that it can be backed up
+	// together with the database.
+	ExportEncryptionKeyset(context.Context, *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error)
+	// ImportEncryptionKeyset replaces the keyset of the daemon, e.g. to restore a backup. The
+	// keyset is only imported if it decrypts every stored secret, unless force is set.
+	ImportEncryptionKeyset(context.Context, *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error)
+}
+
+// NewEncryptionServiceClient constructs a client for the construct.v1.EncryptionService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEncryptionServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EncryptionServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	encryptionServiceMethods := v1.File_construct_v1_encryption_proto.Services().ByName("EncryptionService").Methods()
+	return &encryptionServiceClient{
+		rotateEncryptionKey: connect.NewClient[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse](
+			httpClient,
+			baseURL+EncryptionServiceRotateEncryptionKeyProcedure,
+			connect.WithSchema(encryptionServiceMethods.ByName("RotateEncryptionKey")),
+			connect.WithClientOptions(opts...),
+		),
+		exportEncryptionKeyset: connect.NewClient[v1.ExportEncryptionKeysetRequest, v1.ExportEncryptionKeysetResponse](
+			httpClient,
+			baseURL+EncryptionServiceExportEncryptionKeysetProcedure,
+			connect.WithSchema(encryptionServiceMethods.ByName("ExportEncryptionKeyset")),
+			connect.WithClientOptions(opts...),
+		),
+		importEncryptionKeyset: connect.NewClient[v1.ImportEncryptionKeysetRequest, v1.ImportEncryptionKeysetResponse](
+			httpClient,
+			baseURL+EncryptionServiceImportEncryptionKeysetProcedure,
+			connect.WithSchema(encryptionServiceMethods.ByName("ImportEncryptionKeyset")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// encryptionServiceClient implements EncryptionServiceClient.
+type encryptionServiceClient struct {
+	rotateEncryptionKey    *connect.Client[v1.RotateEncryptionKeyRequest, v1.RotateEncryptionKeyResponse]
+	exportEncryptionKeyset *connect.Client[v1.ExportEncryptionKeysetRequest, v1.ExportEncryptionKeysetResponse]
+	importEncryptionKeyset *connect.Client[v1.ImportEncryptionKeysetRequest, v1.ImportEncryptionKeysetResponse]
+}
+
+// RotateEncryptionKey calls construct.v1.EncryptionService.RotateEncryptionKey.
+func (c *encryptionServiceClient) RotateEncryptionKey(ctx context.Context, req *connect.Request[v1.RotateEncryptionKeyRequest]) (*connect.ServerStreamForClient[v1.RotateEncryptionKeyResponse], error) {
+	return c.rotateEncryptionKey.CallServerStream(ctx, req)
+}
+
+// ExportEncryptionKeyset calls construct.v1.EncryptionService.ExportEncryptionKeyset.
+func (c *encryptionServiceClient) ExportEncryptionKeyset(ctx context.Context, req *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error) {
+	return c.exportEncryptionKeyset.CallUnary(ctx, req)
+}
+
+// ImportEncryptionKeyset calls construct.v1.EncryptionService.ImportEncryptionKeyset.
+func (c *encryptionServiceClient) ImportEncryptionKeyset(ctx context.Context, req *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error) {
+	return c.importEncryptionKeyset.CallUnary(ctx, req)
+}
+
+// EncryptionServiceHandler is an implementation of the construct.v1.EncryptionService service.
+type EncryptionServiceHandler interface {
+	// RotateEncryptionKey adds a new primary key to the keyset and re-encrypts every stored
+	// secret with it in a single transaction. The previous keys stay enabled, so that secrets
+	// can still be decrypted if the transaction fails, unless disable_previous_keys is set.
+	//
+	// The progress of the rotation is streamed, the last message has the phase
+	// ENCRYPTION_KEY_ROTATION_PHASE_DONE.
+	RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest], *connect.ServerStream[v1.RotateEncryptionKeyResponse]) error
+	// ExportEncryptionKeyset returns the keyset in cleartext, so that it can be backed up
+	// together with the database.
+	ExportEncryptionKeyset(context.Context, *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error)
+	// ImportEncryptionKeyset replaces the keyset of the daemon, e.g. to restore a backup. The
+	// keyset is only imported if it decrypts every stored secret, unless force is set.
+	ImportEncryptionKeyset(context.Context, *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error)
+}
+
+// NewEncryptionServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEncryptionServiceHandler(svc EncryptionServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	encryptionServiceMethods := v1.File_construct_v1_encryption_proto.Services().ByName("EncryptionService").Methods()
+	encryptionServiceRotateEncryptionKeyHandler := connect.NewServerStreamHandler(
+		EncryptionServiceRotateEncryptionKeyProcedure,
+		svc.RotateEncryptionKey,
+		connect.WithSchema(encryptionServiceMethods.ByName("RotateEncryptionKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	encryptionServiceExportEncryptionKeysetHandler := connect.NewUnaryHandler(
+		EncryptionServiceExportEncryptionKeysetProcedure,
+		svc.ExportEncryptionKeyset,
+		connect.WithSchema(encryptionServiceMethods.ByName("ExportEncryptionKeyset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	encryptionServiceImportEncryptionKeysetHandler := connect.NewUnaryHandler(
+		EncryptionServiceImportEncryptionKeysetProcedure,
+		svc.ImportEncryptionKeyset,
+		connect.WithSchema(encryptionServiceMethods.ByName("ImportEncryptionKeyset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.EncryptionService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EncryptionServiceRotateEncryptionKeyProcedure:
+			encryptionServiceRotateEncryptionKeyHandler.ServeHTTP(w, r)
+		case EncryptionServiceExportEncryptionKeysetProcedure:
+			encryptionServiceExportEncryptionKeysetHandler.ServeHTTP(w, r)
+		case EncryptionServiceImportEncryptionKeysetProcedure:
+			encryptionServiceImportEncryptionKeysetHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEncryptionServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEncryptionServiceHandler struct{}
+
+func (UnimplementedEncryptionServiceHandler) RotateEncryptionKey(context.Context, *connect.Request[v1.RotateEncryptionKeyRequest], *connect.ServerStream[v1.RotateEncryptionKeyResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.EncryptionService.RotateEncryptionKey is not implemented"))
+}
+
+func (UnimplementedEncryptionServiceHandler) ExportEncryptionKeyset(context.Context, *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.EncryptionService.ExportEncryptionKeyset is not implemented"))
+}
+
+func (UnimplementedEncryptionServiceHandler) ImportEncryptionKeyset(context.Context, *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.EncryptionService.ImportEncryptionKeyset is not implemented"))
+}
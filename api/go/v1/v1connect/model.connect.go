@@ -51,6 +51,8 @@ const (
 	// ModelServiceDeleteModelProcedure is the fully-qualified name of the ModelService's DeleteModel
 	// RPC.
 	ModelServiceDeleteModelProcedure = "/construct.v1.ModelService/DeleteModel"
+	// ModelServiceSyncModelsProcedure is the fully-qualified name of the ModelService's SyncModels RPC.
+	ModelServiceSyncModelsProcedure = "/construct.v1.ModelService/SyncModels"
 )
 
 // ModelServiceClient is a client for the construct.v1.ModelService service.
@@ -65,6 +67,11 @@ type ModelServiceClient interface {
 	UpdateModel(context.Context, *connect.Request[v1.UpdateModelRequest]) (*connect.Response[v1.UpdateModelResponse], error)
 	// DeleteModel removes a model from the system.
 	DeleteModel(context.Context, *connect.Request[v1.DeleteModelRequest]) (*connect.Response[v1.DeleteModelResponse], error)
+	// SyncModels compares the models of all model providers with the model catalog and reports
+	// new, retired and repriced models. The catalog is the one the daemon is configured with. The
+	// changes are only written if requested. Fields that the user has changed are never
+	// overwritten.
+	SyncModels(context.Context, *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error)
 }
 
 // NewModelServiceClient constructs a client for the construct.v1.ModelService service. By default,
@@ -110,6 +117,12 @@ func NewModelServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(modelServiceMethods.ByName("DeleteModel")),
 			connect.WithClientOptions(opts...),
 		),
+		syncModels: connect.NewClient[v1.SyncModelsRequest, v1.SyncModelsResponse](
+			httpClient,
+			baseURL+ModelServiceSyncModelsProcedure,
+			connect.WithSchema(modelServiceMethods.ByName("SyncModels")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -120,6 +133,7 @@ type modelServiceClient struct {
 	listModels  *connect.Client[v1.ListModelsRequest, v1.ListModelsResponse]
 	updateModel *connect.Client[v1.UpdateModelRequest, v1.UpdateModelResponse]
 	deleteModel *connect.Client[v1.DeleteModelRequest, v1.DeleteModelResponse]
+	syncModels  *connect.Client[v1.SyncModelsRequest, v1.SyncModelsResponse]
 }
 
 // CreateModel calls construct.v1.ModelService.CreateModel.
@@ -147,6 +161,11 @@ func (c *modelServiceClient) DeleteModel(ctx context.Context, req *connect.Reque
 	return c.deleteModel.CallUnary(ctx, req)
 }
 
+// SyncModels calls construct.v1.ModelService.SyncModels.
+func (c *modelServiceClient) SyncModels(ctx context.Context, req *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error) {
+	return c.syncModels.CallUnary(ctx, req)
+}
+
 // ModelServiceHandler is an implementation of the construct.v1.ModelService service.
 type ModelServiceHandler interface {
 	// CreateModel creates a new AI model with specified capabilities and pricing.
@@ -159,6 +178,11 @@ type ModelServiceHandler interface {
 	UpdateModel(context.Context, *connect.Request[v1.UpdateModelRequest]) (*connect.Response[v1.UpdateModelResponse], error)
 	// DeleteModel removes a model from the system.
 	DeleteModel(context.Context, *connect.Request[v1.DeleteModelRequest]) (*connect.Response[v1.DeleteModelResponse], error)
+	// SyncModels compares the models of all model providers with the model catalog and reports
+	// new, retired and repriced models. The catalog is the one the daemon is configured with. The
+	// changes are only written if requested. Fields that the user has changed are never
+	// overwritten.
+	SyncModels(context.Context, *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error)
 }
 
 // NewModelServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -200,6 +224,12 @@ func NewModelServiceHandler(svc ModelServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(modelServiceMethods.ByName("DeleteModel")),
 		connect.WithHandlerOptions(opts...),
 	)
+	modelServiceSyncModelsHandler := connect.NewUnaryHandler(
+		ModelServiceSyncModelsProcedure,
+		svc.SyncModels,
+		connect.WithSchema(modelServiceMethods.ByName("SyncModels")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/construct.v1.ModelService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ModelServiceCreateModelProcedure:
@@ -212,6 +242,8 @@ func NewModelServiceHandler(svc ModelServiceHandler, opts ...connect.HandlerOpti
 			modelServiceUpdateModelHandler.ServeHTTP(w, r)
 		case ModelServiceDeleteModelProcedure:
 			modelServiceDeleteModelHandler.ServeHTTP(w, r)
+		case ModelServiceSyncModelsProcedure:
+			modelServiceSyncModelsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -240,3 +272,7 @@ func (UnimplementedModelServiceHandler) UpdateModel(context.Context, *connect.Re
 func (UnimplementedModelServiceHandler) DeleteModel(context.Context, *connect.Request[v1.DeleteModelRequest]) (*connect.Response[v1.DeleteModelResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ModelService.DeleteModel is not implemented"))
 }
+
+func (UnimplementedModelServiceHandler) SyncModels(context.Context, *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ModelService.SyncModels is not implemented"))
+}
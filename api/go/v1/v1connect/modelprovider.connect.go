@@ -52,6 +52,15 @@ const (
 	// ModelProviderServiceDeleteModelProviderProcedure is the fully-qualified name of the
 	// ModelProviderService's DeleteModelProvider RPC.
 	ModelProviderServiceDeleteModelProviderProcedure = "/construct.v1.ModelProviderService/DeleteModelProvider"
+	// ModelProviderServiceAddModelProviderKeyProcedure is the fully-qualified name of the
+	// ModelProviderService's AddModelProviderKey RPC.
+	ModelProviderServiceAddModelProviderKeyProcedure = "/construct.v1.ModelProviderService/AddModelProviderKey"
+	// ModelProviderServiceRetireModelProviderKeyProcedure is the fully-qualified name of the
+	// ModelProviderService's RetireModelProviderKey RPC.
+	ModelProviderServiceRetireModelProviderKeyProcedure = "/construct.v1.ModelProviderService/RetireModelProviderKey"
+	// ModelProviderServiceTestModelProviderProcedure is the fully-qualified name of the
+	// ModelProviderService's TestModelProvider RPC.
+	ModelProviderServiceTestModelProviderProcedure = "/construct.v1.ModelProviderService/TestModelProvider"
 )
 
 // ModelProviderServiceClient is a client for the construct.v1.ModelProviderService service.
@@ -66,6 +75,14 @@ type ModelProviderServiceClient interface {
 	UpdateModelProvider(context.Context, *connect.Request[v1.UpdateModelProviderRequest]) (*connect.Response[v1.UpdateModelProviderResponse], error)
 	// DeleteModelProvider removes a model provider from the system.
 	DeleteModelProvider(context.Context, *connect.Request[v1.DeleteModelProviderRequest]) (*connect.Response[v1.DeleteModelProviderResponse], error)
+	// AddModelProviderKey adds another API key to a model provider.
+	AddModelProviderKey(context.Context, *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error)
+	// RetireModelProviderKey stops a model provider from using an API key. Retired keys are kept
+	// so that their usage can still be attributed.
+	RetireModelProviderKey(context.Context, *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error)
+	// TestModelProvider makes a minimal authenticated call against the model provider and reports
+	// whether the provider is reachable and which of the configured models it offers.
+	TestModelProvider(context.Context, *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error)
 }
 
 // NewModelProviderServiceClient constructs a client for the construct.v1.ModelProviderService
@@ -111,16 +128,37 @@ func NewModelProviderServiceClient(httpClient connect.HTTPClient, baseURL string
 			connect.WithSchema(modelProviderServiceMethods.ByName("DeleteModelProvider")),
 			connect.WithClientOptions(opts...),
 		),
+		addModelProviderKey: connect.NewClient[v1.AddModelProviderKeyRequest, v1.AddModelProviderKeyResponse](
+			httpClient,
+			baseURL+ModelProviderServiceAddModelProviderKeyProcedure,
+			connect.WithSchema(modelProviderServiceMethods.ByName("AddModelProviderKey")),
+			connect.WithClientOptions(opts...),
+		),
+		retireModelProviderKey: connect.NewClient[v1.RetireModelProviderKeyRequest, v1.RetireModelProviderKeyResponse](
+			httpClient,
+			baseURL+ModelProviderServiceRetireModelProviderKeyProcedure,
+			connect.WithSchema(modelProviderServiceMethods.ByName("RetireModelProviderKey")),
+			connect.WithClientOptions(opts...),
+		),
+		testModelProvider: connect.NewClient[v1.TestModelProviderRequest, v1.TestModelProviderResponse](
+			httpClient,
+			baseURL+ModelProviderServiceTestModelProviderProcedure,
+			connect.WithSchema(modelProviderServiceMethods.ByName("TestModelProvider")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // modelProviderServiceClient implements ModelProviderServiceClient.
 type modelProviderServiceClient struct {
-	createModelProvider *connect.Client[v1.CreateModelProviderRequest, v1.CreateModelProviderResponse]
-	getModelProvider    *connect.Client[v1.GetModelProviderRequest, v1.GetModelProviderResponse]
-	listModelProviders  *connect.Client[v1.ListModelProvidersRequest, v1.ListModelProvidersResponse]
-	updateModelProvider *connect.Client[v1.UpdateModelProviderRequest, v1.UpdateModelProviderResponse]
-	deleteModelProvider *connect.Client[v1.DeleteModelProviderRequest, v1.DeleteModelProviderResponse]
+	createModelProvider    *connect.Client[v1.CreateModelProviderRequest, v1.CreateModelProviderResponse]
+	getModelProvider       *connect.Client[v1.GetModelProviderRequest, v1.GetModelProviderResponse]
+	listModelProviders     *connect.Client[v1.ListModelProvidersRequest, v1.ListModelProvidersResponse]
+	updateModelProvider    *connect.Client[v1.UpdateModelProviderRequest, v1.UpdateModelProviderResponse]
+	deleteModelProvider    *connect.Client[v1.DeleteModelProviderRequest, v1.DeleteModelProviderResponse]
+	addModelProviderKey    *connect.Client[v1.AddModelProviderKeyRequest, v1.AddModelProviderKeyResponse]
+	retireModelProviderKey *connect.Client[v1.RetireModelProviderKeyRequest, v1.RetireModelProviderKeyResponse]
+	testModelProvider      *connect.Client[v1.TestModelProviderRequest, v1.TestModelProviderResponse]
 }
 
 // CreateModelProvider calls construct.v1.ModelProviderService.CreateModelProvider.
@@ -148,6 +186,21 @@ func (c *modelProviderServiceClient) DeleteModelProvider(ctx context.Context, re
 	return c.deleteModelProvider.CallUnary(ctx, req)
 }
 
+// AddModelProviderKey calls construct.v1.ModelProviderService.AddModelProviderKey.
+func (c *modelProviderServiceClient) AddModelProviderKey(ctx context.Context, req *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error) {
+	return c.addModelProviderKey.CallUnary(ctx, req)
+}
+
+// RetireModelProviderKey calls construct.v1.ModelProviderService.RetireModelProviderKey.
+func (c *modelProviderServiceClient) RetireModelProviderKey(ctx context.Context, req *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error) {
+	return c.retireModelProviderKey.CallUnary(ctx, req)
+}
+
+// TestModelProvider calls construct.v1.ModelProviderService.TestModelProvider.
+func (c *modelProviderServiceClient) TestModelProvider(ctx context.Context, req *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error) {
+	return c.testModelProvider.CallUnary(ctx, req)
+}
+
 // ModelProviderServiceHandler is an implementation of the construct.v1.ModelProviderService
 // service.
 type ModelProviderServiceHandler interface {
@@ -161,6 +214,14 @@ type ModelProviderServiceHandler interface {
 	UpdateModelProvider(context.Context, *connect.Request[v1.UpdateModelProviderRequest]) (*connect.Response[v1.UpdateModelProviderResponse], error)
 	// DeleteModelProvider removes a model provider from the system.
 	DeleteModelProvider(context.Context, *connect.Request[v1.DeleteModelProviderRequest]) (*connect.Response[v1.DeleteModelProviderResponse], error)
+	// AddModelProviderKey adds another API key to a model provider.
+	AddModelProviderKey(context.Context, *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error)
+	// RetireModelProviderKey stops a model provider from using an API key. Retired keys are kept
+	// so that their usage can still be attributed.
+	RetireModelProviderKey(context.Context, *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error)
+	// TestModelProvider makes a minimal authenticated call against the model provider and reports
+	// whether the provider is reachable and which of the configured models it offers.
+	TestModelProvider(context.Context, *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error)
 }
 
 // NewModelProviderServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -202,6 +263,24 @@ func NewModelProviderServiceHandler(svc ModelProviderServiceHandler, opts ...con
 		connect.WithSchema(modelProviderServiceMethods.ByName("DeleteModelProvider")),
 		connect.WithHandlerOptions(opts...),
 	)
+	modelProviderServiceAddModelProviderKeyHandler := connect.NewUnaryHandler(
+		ModelProviderServiceAddModelProviderKeyProcedure,
+		svc.AddModelProviderKey,
+		connect.WithSchema(modelProviderServiceMethods.ByName("AddModelProviderKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modelProviderServiceRetireModelProviderKeyHandler := connect.NewUnaryHandler(
+		ModelProviderServiceRetireModelProviderKeyProcedure,
+		svc.RetireModelProviderKey,
+		connect.WithSchema(modelProviderServiceMethods.ByName("RetireModelProviderKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	modelProviderServiceTestModelProviderHandler := connect.NewUnaryHandler(
+		ModelProviderServiceTestModelProviderProcedure,
+		svc.TestModelProvider,
+		connect.WithSchema(modelProviderServiceMethods.ByName("TestModelProvider")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/construct.v1.ModelProviderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ModelProviderServiceCreateModelProviderProcedure:
@@ -214,6 +293,12 @@ func NewModelProviderServiceHandler(svc ModelProviderServiceHandler, opts ...con
 			modelProviderServiceUpdateModelProviderHandler.ServeHTTP(w, r)
 		case ModelProviderServiceDeleteModelProviderProcedure:
 			modelProviderServiceDeleteModelProviderHandler.ServeHTTP(w, r)
+		case ModelProviderServiceAddModelProviderKeyProcedure:
+			modelProviderServiceAddModelProviderKeyHandler.ServeHTTP(w, r)
+		case ModelProviderServiceRetireModelProviderKeyProcedure:
+			modelProviderServiceRetireModelProviderKeyHandler.ServeHTTP(w, r)
+		case ModelProviderServiceTestModelProviderProcedure:
+			modelProviderServiceTestModelProviderHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -242,3 +327,15 @@ func (UnimplementedModelProviderServiceHandler) UpdateModelProvider(context.Cont
 func (UnimplementedModelProviderServiceHandler) DeleteModelProvider(context.Context, *connect.Request[v1.DeleteModelProviderRequest]) (*connect.Response[v1.DeleteModelProviderResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ModelProviderService.DeleteModelProvider is not implemented"))
 }
+
+func (UnimplementedModelProviderServiceHandler) AddModelProviderKey(context.Context, *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ModelProviderService.AddModelProviderKey is not implemented"))
+}
+
+func (UnimplementedModelProviderServiceHandler) RetireModelProviderKey(context.Context, *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ModelProviderService.RetireModelProviderKey is not implemented"))
+}
+
+func (UnimplementedModelProviderServiceHandler) TestModelProvider(context.Context, *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.ModelProviderService.TestModelProvider is not implemented"))
+}
@@ -0,0 +1,115 @@
+// Quota API reports how much of their quota a subject has used.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: construct/v1/quota.proto
+
+package v1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/furisto/construct/api/go/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// QuotaServiceName is the fully-qualified name of the QuotaService service.
+	QuotaServiceName = "construct.v1.QuotaService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// QuotaServiceGetQuotaUsageProcedure is the fully-qualified name of the QuotaService's
+	// GetQuotaUsage RPC.
+	QuotaServiceGetQuotaUsageProcedure = "/construct.v1.QuotaService/GetQuotaUsage"
+)
+
+// QuotaServiceClient is a client for the construct.v1.QuotaService service.
+type QuotaServiceClient interface {
+	// GetQuotaUsage retrieves the quota and the current usage of a subject.
+	GetQuotaUsage(context.Context, *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error)
+}
+
+// NewQuotaServiceClient constructs a client for the construct.v1.QuotaService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewQuotaServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) QuotaServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	quotaServiceMethods := v1.File_construct_v1_quota_proto.Services().ByName("QuotaService").Methods()
+	return &quotaServiceClient{
+		getQuotaUsage: connect.NewClient[v1.GetQuotaUsageRequest, v1.GetQuotaUsageResponse](
+			httpClient,
+			baseURL+QuotaServiceGetQuotaUsageProcedure,
+			connect.WithSchema(quotaServiceMethods.ByName("GetQuotaUsage")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// quotaServiceClient implements QuotaServiceClient.
+type quotaServiceClient struct {
+	getQuotaUsage *connect.Client[v1.GetQuotaUsageRequest, v1.GetQuotaUsageResponse]
+}
+
+// GetQuotaUsage calls construct.v1.QuotaService.GetQuotaUsage.
+func (c *quotaServiceClient) GetQuotaUsage(ctx context.Context, req *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	return c.getQuotaUsage.CallUnary(ctx, req)
+}
+
+// QuotaServiceHandler is an implementation of the construct.v1.QuotaService service.
+type QuotaServiceHandler interface {
+	// GetQuotaUsage retrieves the quota and the current usage of a subject.
+	GetQuotaUsage(context.Context, *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error)
+}
+
+// NewQuotaServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewQuotaServiceHandler(svc QuotaServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	quotaServiceMethods := v1.File_construct_v1_quota_proto.Services().ByName("QuotaService").Methods()
+	quotaServiceGetQuotaUsageHandler := connect.NewUnaryHandler(
+		QuotaServiceGetQuotaUsageProcedure,
+		svc.GetQuotaUsage,
+		connect.WithSchema(quotaServiceMethods.ByName("GetQuotaUsage")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/construct.v1.QuotaService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case QuotaServiceGetQuotaUsageProcedure:
+			quotaServiceGetQuotaUsageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedQuotaServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedQuotaServiceHandler struct{}
+
+func (UnimplementedQuotaServiceHandler) GetQuotaUsage(context.Context, *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.QuotaService.GetQuotaUsage is not implemented"))
+}
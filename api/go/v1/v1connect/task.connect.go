@@ -49,6 +49,13 @@ const (
 	TaskServiceDeleteTaskProcedure = "/construct.v1.TaskService/DeleteTask"
 	// TaskServiceSuspendTaskProcedure is the fully-qualified name of the TaskService's SuspendTask RPC.
 	TaskServiceSuspendTaskProcedure = "/construct.v1.TaskService/SuspendTask"
+	// TaskServiceGetModelCallTraceProcedure is the fully-qualified name of the TaskService's
+	// GetModelCallTrace RPC.
+	TaskServiceGetModelCallTraceProcedure = "/construct.v1.TaskService/GetModelCallTrace"
+	// TaskServiceShareTaskProcedure is the fully-qualified name of the TaskService's ShareTask RPC.
+	TaskServiceShareTaskProcedure = "/construct.v1.TaskService/ShareTask"
+	// TaskServiceUnshareTaskProcedure is the fully-qualified name of the TaskService's UnshareTask RPC.
+	TaskServiceUnshareTaskProcedure = "/construct.v1.TaskService/UnshareTask"
 )
 
 // TaskServiceClient is a client for the construct.v1.TaskService service.
@@ -65,6 +72,13 @@ type TaskServiceClient interface {
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
 	// SuspendTask suspends a task.
 	SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error)
+	// GetModelCallTrace retrieves the raw requests that were sent to model providers for a task.
+	// Calls are only captured while tracing is enabled for the task or for the whole daemon.
+	GetModelCallTrace(context.Context, *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error)
+	// ShareTask grants another subject access to a task. Only the owner and admins can share.
+	ShareTask(context.Context, *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error)
+	// UnshareTask revokes the access of a subject that the task was shared with.
+	UnshareTask(context.Context, *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error)
 }
 
 // NewTaskServiceClient constructs a client for the construct.v1.TaskService service. By default, it
@@ -116,17 +130,39 @@ func NewTaskServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(taskServiceMethods.ByName("SuspendTask")),
 			connect.WithClientOptions(opts...),
 		),
+		getModelCallTrace: connect.NewClient[v1.GetModelCallTraceRequest, v1.GetModelCallTraceResponse](
+			httpClient,
+			baseURL+TaskServiceGetModelCallTraceProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("GetModelCallTrace")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		shareTask: connect.NewClient[v1.ShareTaskRequest, v1.ShareTaskResponse](
+			httpClient,
+			baseURL+TaskServiceShareTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("ShareTask")),
+			connect.WithClientOptions(opts...),
+		),
+		unshareTask: connect.NewClient[v1.UnshareTaskRequest, v1.UnshareTaskResponse](
+			httpClient,
+			baseURL+TaskServiceUnshareTaskProcedure,
+			connect.WithSchema(taskServiceMethods.ByName("UnshareTask")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // taskServiceClient implements TaskServiceClient.
 type taskServiceClient struct {
-	createTask  *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	getTask     *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	listTasks   *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
-	updateTask  *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	deleteTask  *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
-	suspendTask *connect.Client[v1.SuspendTaskRequest, v1.SuspendTaskResponse]
+	createTask        *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask           *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listTasks         *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	updateTask        *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	deleteTask        *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	suspendTask       *connect.Client[v1.SuspendTaskRequest, v1.SuspendTaskResponse]
+	getModelCallTrace *connect.Client[v1.GetModelCallTraceRequest, v1.GetModelCallTraceResponse]
+	shareTask         *connect.Client[v1.ShareTaskRequest, v1.ShareTaskResponse]
+	unshareTask       *connect.Client[v1.UnshareTaskRequest, v1.UnshareTaskResponse]
 }
 
 // CreateTask calls construct.v1.TaskService.CreateTask.
@@ -159,6 +195,21 @@ func (c *taskServiceClient) SuspendTask(ctx context.Context, req *connect.Reques
 	return c.suspendTask.CallUnary(ctx, req)
 }
 
+// GetModelCallTrace calls construct.v1.TaskService.GetModelCallTrace.
+func (c *taskServiceClient) GetModelCallTrace(ctx context.Context, req *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error) {
+	return c.getModelCallTrace.CallUnary(ctx, req)
+}
+
+// ShareTask calls construct.v1.TaskService.ShareTask.
+func (c *taskServiceClient) ShareTask(ctx context.Context, req *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	return c.shareTask.CallUnary(ctx, req)
+}
+
+// UnshareTask calls construct.v1.TaskService.UnshareTask.
+func (c *taskServiceClient) UnshareTask(ctx context.Context, req *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	return c.unshareTask.CallUnary(ctx, req)
+}
+
 // TaskServiceHandler is an implementation of the construct.v1.TaskService service.
 type TaskServiceHandler interface {
 	// CreateTask creates a new task for an agent to execute in a specified project directory.
@@ -173,6 +224,13 @@ type TaskServiceHandler interface {
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
 	// SuspendTask suspends a task.
 	SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error)
+	// GetModelCallTrace retrieves the raw requests that were sent to model providers for a task.
+	// Calls are only captured while tracing is enabled for the task or for the whole daemon.
+	GetModelCallTrace(context.Context, *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error)
+	// ShareTask grants another subject access to a task. Only the owner and admins can share.
+	ShareTask(context.Context, *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error)
+	// UnshareTask revokes the access of a subject that the task was shared with.
+	UnshareTask(context.Context, *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error)
 }
 
 // NewTaskServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -220,6 +278,25 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(taskServiceMethods.ByName("SuspendTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	taskServiceGetModelCallTraceHandler := connect.NewUnaryHandler(
+		TaskServiceGetModelCallTraceProcedure,
+		svc.GetModelCallTrace,
+		connect.WithSchema(taskServiceMethods.ByName("GetModelCallTrace")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceShareTaskHandler := connect.NewUnaryHandler(
+		TaskServiceShareTaskProcedure,
+		svc.ShareTask,
+		connect.WithSchema(taskServiceMethods.ByName("ShareTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	taskServiceUnshareTaskHandler := connect.NewUnaryHandler(
+		TaskServiceUnshareTaskProcedure,
+		svc.UnshareTask,
+		connect.WithSchema(taskServiceMethods.ByName("UnshareTask")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/construct.v1.TaskService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TaskServiceCreateTaskProcedure:
@@ -234,6 +311,12 @@ func NewTaskServiceHandler(svc TaskServiceHandler, opts ...connect.HandlerOption
 			taskServiceDeleteTaskHandler.ServeHTTP(w, r)
 		case TaskServiceSuspendTaskProcedure:
 			taskServiceSuspendTaskHandler.ServeHTTP(w, r)
+		case TaskServiceGetModelCallTraceProcedure:
+			taskServiceGetModelCallTraceHandler.ServeHTTP(w, r)
+		case TaskServiceShareTaskProcedure:
+			taskServiceShareTaskHandler.ServeHTTP(w, r)
+		case TaskServiceUnshareTaskProcedure:
+			taskServiceUnshareTaskHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -266,3 +349,15 @@ func (UnimplementedTaskServiceHandler) DeleteTask(context.Context, *connect.Requ
 func (UnimplementedTaskServiceHandler) SuspendTask(context.Context, *connect.Request[v1.SuspendTaskRequest]) (*connect.Response[v1.SuspendTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.SuspendTask is not implemented"))
 }
+
+func (UnimplementedTaskServiceHandler) GetModelCallTrace(context.Context, *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.GetModelCallTrace is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) ShareTask(context.Context, *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.ShareTask is not implemented"))
+}
+
+func (UnimplementedTaskServiceHandler) UnshareTask(context.Context, *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("construct.v1.TaskService.UnshareTask is not implemented"))
+}
@@ -5,84 +5,383 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/furisto/construct/backend/memory"
+	memory_model "github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/secret"
+	"github.com/furisto/construct/shared/resilience"
 	"github.com/google/uuid"
 )
 
 type ModelProviderFactory struct {
-	encryption *secret.Encryption
-	memory     *memory.Client
+	encryption  *secret.Encryption
+	memory      *memory.Client
+	keySelector *KeySelector
+
+	// recorder records all model invocations to a cassette if set.
+	recorder *model.CassetteRecorder
+
+	mu       sync.Mutex
+	breakers map[uuid.UUID]*resilience.CircuitBreaker
+	replays  map[uuid.UUID]*model.ReplayProvider
+
+	// secrets caches the decrypted provider credentials for redaction. They are valid as long as
+	// secretsGeneration, which is increased on every change to providers and keys, equals
+	// cachedGeneration.
+	secrets           []string
+	secretsCached     bool
+	cachedGeneration  uint64
+	secretsGeneration atomic.Uint64
 }
 
 func NewModelProviderFactory(encryption *secret.Encryption, memory *memory.Client) *ModelProviderFactory {
-	return &ModelProviderFactory{
-		encryption: encryption,
-		memory:     memory,
+	factory := &ModelProviderFactory{
+		encryption:  encryption,
+		memory:      memory,
+		keySelector: NewKeySelector(),
+		breakers:    make(map[uuid.UUID]*resilience.CircuitBreaker),
+		replays:     make(map[uuid.UUID]*model.ReplayProvider),
 	}
+	memory.ModelProvider.Use(factory.invalidateSecretsHook())
+	memory.ModelProviderKey.Use(factory.invalidateSecretsHook())
+
+	return factory
+}
+
+// ProviderClient is a model provider client that is bound to one API key of the provider.
+type ProviderClient struct {
+	model.ModelProvider
+
+	// KeyID is the key that authenticates the client. It is uuid.Nil for providers that have
+	// no individually managed keys.
+	KeyID uuid.UUID
+	// ActiveKeys is the number of keys the provider could have chosen from.
+	ActiveKeys int
 }
 
 func (f *ModelProviderFactory) CreateClient(
 	ctx context.Context,
 	modelProviderID uuid.UUID,
-) (providerClient model.ModelProvider, err error) {
+) (*ProviderClient, error) {
 	logger := slog.With(
 		KeyComponent, "model_provider_factory",
 		KeyModelProvider, modelProviderID,
 	)
 
-	provider, err := f.memory.ModelProvider.Get(ctx, modelProviderID)
+	provider, err := f.memory.ModelProvider.Query().
+		Where(modelprovider.ID(modelProviderID)).
+		WithKeys(func(q *memory.ModelProviderKeyQuery) {
+			q.Where(modelproviderkey.RetiredTimeIsNil()).
+				Order(modelproviderkey.ByPriority(), modelproviderkey.ByCreateTime())
+		}).
+		Only(ctx)
 	if err != nil {
 		LogError(logger, "fetch model provider", err)
 		return nil, fmt.Errorf("failed to fetch model provider: %w", err)
 	}
 	logger = logger.With(KeyProvider, string(provider.ProviderType))
 
-	providerAuth, err := f.encryption.Decrypt(provider.Secret, []byte(secret.ModelProviderAssociated(provider.ID)))
+	if provider.ProviderType == types.ModelProviderTypeReplay {
+		replay, err := f.replayProvider(provider.ID, provider.URL)
+		if err != nil {
+			LogError(logger, "create replay provider", err)
+			return nil, err
+		}
+		return &ProviderClient{ModelProvider: replay}, nil
+	}
+
+	if provider.ProviderType == types.ModelProviderTypeMock {
+		// The script is loaded for every client, so that edits apply without restarting the daemon.
+		mock, err := model.NewMockProvider(provider.URL)
+		if err != nil {
+			LogError(logger, "create mock provider", err)
+			return nil, fmt.Errorf("failed to create mock provider: %w", err)
+		}
+		return &ProviderClient{ModelProvider: mock}, nil
+	}
+
+	encryptedSecret := provider.Secret
+	key := f.keySelector.Select(provider.ID, provider.KeySelection, provider.Edges.Keys)
+	if key != nil {
+		encryptedSecret = key.Secret
+		logger = logger.With(KeyProviderKey, key.Name)
+	}
+
+	providerAuth, err := f.encryption.Decrypt(encryptedSecret, []byte(secret.ModelProviderAssociated(provider.ID)))
 	if err != nil {
 		LogError(logger, "decrypt model provider secret", err)
 		return nil, fmt.Errorf("failed to decrypt model provider secret: %w", err)
 	}
 	logger.Debug("model provider secret decrypted")
 
-	var auth struct {
-		APIKey string `json:"apiKey"`
-	}
-
-	err = json.Unmarshal(providerAuth, &auth)
+	var credentials model.Credentials
+	err = json.Unmarshal(providerAuth, &credentials)
 	if err != nil {
 		LogError(logger, "unmarshal model provider auth", err)
 		return nil, fmt.Errorf("failed to unmarshal model provider auth: %w", err)
 	}
 
 	logger.Debug("creating model provider client")
-	switch provider.ProviderType {
+	providerClient, err := f.newProviderClient(provider.ID, provider.ProviderType, credentials)
+	if err != nil {
+		LogError(logger, "create provider", err)
+		return nil, err
+	}
+
+	// Recorded clients are not Anthropic providers, so no titles are generated while recording.
+	// This keeps invocations out of the cassette that a replay would not make in the same order.
+	if f.recorder != nil {
+		providerClient = model.NewRecordingProvider(providerClient, f.recorder)
+	}
+
+	client := &ProviderClient{
+		ModelProvider: providerClient,
+		ActiveKeys:    len(provider.Edges.Keys),
+	}
+	if key != nil {
+		client.KeyID = key.ID
+	}
+
+	return client, nil
+}
+
+// ProviderSecrets returns the decrypted credentials of all model providers and their keys,
+// including retired keys, so that they can be redacted from the output of tools. Secrets that
+// cannot be decrypted are skipped. The credentials are cached until a model provider or one of its
+// keys changes.
+func (f *ModelProviderFactory) ProviderSecrets(ctx context.Context) ([]string, error) {
+	generation := f.secretsGeneration.Load()
+
+	f.mu.Lock()
+	if f.secretsCached && f.cachedGeneration == generation {
+		secrets := f.secrets
+		f.mu.Unlock()
+		return secrets, nil
+	}
+	f.mu.Unlock()
+
+	providers, err := f.memory.ModelProvider.Query().WithKeys().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch model providers: %w", err)
+	}
+
+	var secrets []string
+	decrypt := func(logger *slog.Logger, providerID uuid.UUID, encryptedSecret []byte) {
+		if len(encryptedSecret) == 0 {
+			return
+		}
+
+		providerAuth, err := f.encryption.Decrypt(encryptedSecret, []byte(secret.ModelProviderAssociated(providerID)))
+		if err != nil {
+			LogError(logger, "decrypt model provider secret", err)
+			return
+		}
+
+		var credentials model.Credentials
+		if err := json.Unmarshal(providerAuth, &credentials); err != nil {
+			LogError(logger, "unmarshal model provider auth", err)
+			return
+		}
+
+		secrets = append(secrets, credentials.APIKey)
+		if credentials.AWS != nil {
+			secrets = append(secrets, credentials.AWS.AccessKeyID, credentials.AWS.SecretAccessKey, credentials.AWS.SessionToken)
+		}
+	}
+
+	for _, provider := range providers {
+		logger := slog.With(
+			KeyComponent, "model_provider_factory",
+			KeyModelProvider, provider.ID,
+		)
+		decrypt(logger, provider.ID, provider.Secret)
+		for _, key := range provider.Edges.Keys {
+			decrypt(logger.With(KeyProviderKey, key.Name), provider.ID, key.Secret)
+		}
+	}
+
+	f.mu.Lock()
+	f.secrets = secrets
+	f.secretsCached = true
+	f.cachedGeneration = generation
+	f.mu.Unlock()
+
+	return secrets, nil
+}
+
+// invalidateSecretsHook drops the cached provider secrets when a model provider or one of its keys
+// changes. Changes in a transaction drop them again when it commits, so that secrets that were
+// loaded in between are not kept.
+func (f *ModelProviderFactory) invalidateSecretsHook() memory.Hook {
+	return func(next memory.Mutator) memory.Mutator {
+		return memory.MutateFunc(func(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+			value, err := next.Mutate(ctx, m)
+			f.secretsGeneration.Add(1)
+
+			var tx *memory.Tx
+			switch mutation := m.(type) {
+			case *memory.ModelProviderMutation:
+				tx, _ = mutation.Tx()
+			case *memory.ModelProviderKeyMutation:
+				tx, _ = mutation.Tx()
+			}
+			if tx != nil {
+				tx.OnCommit(func(next memory.Committer) memory.Committer {
+					return memory.CommitFunc(func(ctx context.Context, tx *memory.Tx) error {
+						err := next.Commit(ctx, tx)
+						f.secretsGeneration.Add(1)
+						return err
+					})
+				})
+			}
+
+			return value, err
+		})
+	}
+}
+
+// TestModelProvider makes a minimal authenticated call with the key that would serve the next
+// invocation of the model provider.
+func (f *ModelProviderFactory) TestModelProvider(ctx context.Context, modelProviderID uuid.UUID) (*model.ConnectivityReport, error) {
+	client, err := f.CreateClient(ctx, modelProviderID)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := f.memory.ModelProvider.Query().
+		Where(modelprovider.ID(modelProviderID)).
+		WithModels(func(q *memory.ModelQuery) {
+			q.Where(memory_model.Enabled(true)).Order(memory_model.ByName())
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch model provider: %w", err)
+	}
+
+	probeModel := probeModelName(provider.ProviderType)
+	if len(provider.Edges.Models) > 0 {
+		probeModel = provider.Edges.Models[0].Name
+	}
+
+	report := model.CheckConnectivity(ctx, client.ModelProvider, probeModel)
+	report.CircuitState = f.circuitBreaker(modelProviderID).State()
+	return report, nil
+}
+
+// TestModelProviderCredentials makes a minimal authenticated call with credentials that have
+// not been stored yet.
+func (f *ModelProviderFactory) TestModelProviderCredentials(ctx context.Context, providerType types.ModelProviderType, credentials model.Credentials) (*model.ConnectivityReport, error) {
+	client, err := newModelProvider(providerType, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CheckConnectivity(ctx, client, probeModelName(providerType)), nil
+}
+
+// newProviderClient creates a client whose circuit breaker is shared with all other clients
+// of the same model provider.
+func (f *ModelProviderFactory) newProviderClient(modelProviderID uuid.UUID, providerType types.ModelProviderType, credentials model.Credentials) (model.ModelProvider, error) {
+	return newModelProvider(providerType, credentials, model.WithCircuitBreaker(f.circuitBreaker(modelProviderID)))
+}
+
+func (f *ModelProviderFactory) circuitBreaker(modelProviderID uuid.UUID) *resilience.CircuitBreaker {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	breaker, ok := f.breakers[modelProviderID]
+	if !ok {
+		breaker = resilience.NewCircuitBreaker(modelProviderID.String(), 5, 10*time.Second)
+		f.breakers[modelProviderID] = breaker
+	}
+
+	return breaker
+}
+
+// replayProvider returns the replay provider of the model provider. It is shared by all
+// invocations, because it keeps track of the interactions of the cassette that were served.
+func (f *ModelProviderFactory) replayProvider(modelProviderID uuid.UUID, cassette string) (*model.ReplayProvider, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	replay, ok := f.replays[modelProviderID]
+	if ok && replay.Path() == cassette {
+		return replay, nil
+	}
+
+	replay, err := model.NewReplayProvider(cassette)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replay provider: %w", err)
+	}
+	f.replays[modelProviderID] = replay
+
+	return replay, nil
+}
+
+func newModelProvider(providerType types.ModelProviderType, credentials model.Credentials, opts ...model.ProviderOption) (model.ModelProvider, error) {
+	apiKey := credentials.APIKey
+
+	var (
+		providerClient model.ModelProvider
+		err            error
+	)
+
+	switch providerType {
 	case types.ModelProviderTypeAnthropic:
-		providerClient, err = model.NewAnthropicProvider(auth.APIKey)
+		providerClient, err = model.NewAnthropicProvider(apiKey, opts...)
 
 	case types.ModelProviderTypeOpenAI:
-		providerClient, err = model.NewOpenAICompletionProvider(auth.APIKey)
+		providerClient, err = model.NewOpenAICompletionProvider(apiKey, opts...)
 
 	case types.ModelProviderTypeGemini:
-		providerClient, err = model.NewGeminiProvider(auth.APIKey)
+		providerClient, err = model.NewGeminiProvider(apiKey, opts...)
 
 	case types.ModelProviderTypeXAI:
-		providerClient, err = model.NewOpenAICompletionProvider(auth.APIKey, model.WithURL("https://api.xai.com/v1"))
+		providerClient, err = model.NewOpenAICompletionProvider(apiKey, append(opts, model.WithURL("https://api.xai.com/v1"))...)
+
+	case types.ModelProviderTypeDeepSeek:
+		providerClient, err = model.NewDeepSeekProvider(apiKey, opts...)
+
+	case types.ModelProviderTypeBedrock:
+		if credentials.AWS == nil {
+			return nil, fmt.Errorf("bedrock provider requires AWS credentials")
+		}
+		providerClient, err = model.NewBedrockProvider(*credentials.AWS, opts...)
+
+	case types.ModelProviderTypeReplay:
+		return nil, fmt.Errorf("replay provider is created from its cassette")
+
+	case types.ModelProviderTypeMock:
+		return nil, fmt.Errorf("mock provider is created from its script")
 
 	default:
-		logger.Error("unknown model provider type",
-			KeyProvider, string(provider.ProviderType),
-		)
-		return nil, fmt.Errorf("unknown model provider type: %s", provider.ProviderType)
+		return nil, fmt.Errorf("unknown model provider type: %s", providerType)
 	}
 
 	if err != nil {
-		LogError(logger, "create provider", err)
-		return nil, fmt.Errorf("failed to create Anthropic provider: %w", err)
+		return nil, fmt.Errorf("failed to create %s provider: %w", providerType, err)
 	}
 
 	return providerClient, nil
 }
+
+// probeModelName returns the model that is used to probe providers which cannot list their
+// models.
+func probeModelName(providerType types.ModelProviderType) string {
+	if defaultModel, err := model.DefaultModel(model.ProviderKind(providerType)); err == nil {
+		return defaultModel.Name
+	}
+
+	if supported := model.SupportedModels(model.ProviderKind(providerType)); len(supported) > 0 {
+		return supported[0].Name
+	}
+
+	return ""
+}
@@ -0,0 +1,97 @@
+package agent
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+func TestProviderSecrets(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:provider_secrets_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	keyset, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed generating keyset: %v", err)
+	}
+	encryption, err := secret.NewClient(keyset)
+	if err != nil {
+		t.Fatalf("failed creating encryption client: %v", err)
+	}
+
+	providerID := uuid.New()
+	encrypt := func(credentials string) []byte {
+		ciphertext, err := encryption.Encrypt([]byte(credentials), secret.ModelProviderAssociated(providerID))
+		if err != nil {
+			t.Fatalf("failed encrypting secret: %v", err)
+		}
+		return ciphertext
+	}
+
+	db.ModelProvider.Create().
+		SetID(providerID).
+		SetName("bedrock").
+		SetProviderType(types.ModelProviderTypeAnthropic).
+		SetSecret(encrypt(`{"apiKey":"sk-provider-secret"}`)).
+		SetEnabled(true).
+		SaveX(ctx)
+	db.ModelProviderKey.Create().
+		SetModelProviderID(providerID).
+		SetName("aws").
+		SetSecret(encrypt(`{"aws":{"accessKeyId":"AKIAEXAMPLEKEY","secretAccessKey":"aws-secret-access-key","region":"us-east-1"}}`)).
+		SaveX(ctx)
+	// A secret that no longer decrypts, e.g. after a partial key rotation, must not hide the
+	// secrets that still do.
+	db.ModelProviderKey.Create().
+		SetModelProviderID(providerID).
+		SetName("stale").
+		SetSecret([]byte("not a ciphertext")).
+		SaveX(ctx)
+
+	factory := NewModelProviderFactory(encryption, db)
+
+	secrets, err := factory.ProviderSecrets(ctx)
+	if err != nil {
+		t.Fatalf("ProviderSecrets() error = %v", err)
+	}
+	for _, want := range []string{"sk-provider-secret", "AKIAEXAMPLEKEY", "aws-secret-access-key"} {
+		if !slices.Contains(secrets, want) {
+			t.Errorf("ProviderSecrets() = %v, missing %q", secrets, want)
+		}
+	}
+
+	_, err = memory.Transaction(ctx, db, func(tx *memory.Client) (*memory.ModelProviderKey, error) {
+		return tx.ModelProviderKey.Create().
+			SetModelProviderID(providerID).
+			SetName("added").
+			SetSecret(encrypt(`{"apiKey":"sk-added-secret"}`)).
+			Save(ctx)
+	})
+	if err != nil {
+		t.Fatalf("failed adding key: %v", err)
+	}
+
+	secrets, err = factory.ProviderSecrets(ctx)
+	if err != nil {
+		t.Fatalf("ProviderSecrets() error = %v", err)
+	}
+	if !slices.Contains(secrets, "sk-added-secret") {
+		t.Errorf("ProviderSecrets() = %v, the cache was not invalidated by the added key", secrets)
+	}
+}
@@ -0,0 +1,214 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/model"
+	"github.com/furisto/construct/backend/tool/native"
+)
+
+const (
+	// promptBudgetRatio is the share of the context window a prompt may take up. The rest is kept
+	// free for the response and for errors of the estimate.
+	promptBudgetRatio = 0.9
+	// toolResultBudgetRatio is the share of the prompt budget a single tool result may take up
+	// before it is truncated.
+	toolResultBudgetRatio = 0.125
+	// minToolResultTokens is the size below which tool results are never truncated.
+	minToolResultTokens = 1024
+	// preservedMessages is the number of most recent messages that are never dropped.
+	preservedMessages = 4
+	// providerCountRatio is the share of the prompt budget above which the provider is asked to
+	// count the tokens of a prompt. Below it the local approximation, which tends to overestimate,
+	// is trusted, so that prompts that clearly fit do not pay for the round trip.
+	providerCountRatio = 0.75
+)
+
+// fallbackReasonContextOverflow is the reason of task.model_fallback events for prompts that did
+// not fit into the context window of a model.
+const fallbackReasonContextOverflow = "context_overflow"
+
+// ContextOverflowError is returned if a prompt does not fit into the context window of a model,
+// even after its tool results were truncated and older messages were dropped.
+type ContextOverflowError struct {
+	Model           string
+	EstimatedTokens int64
+	PromptBudget    int64
+	ContextWindow   int64
+}
+
+func (e *ContextOverflowError) Error() string {
+	return fmt.Sprintf("prompt of about %d tokens does not fit into the context window of model %s (%d tokens, %d of them for the prompt), even after truncating tool results and dropping older messages",
+		e.EstimatedTokens, e.Model, e.ContextWindow, e.PromptBudget)
+}
+
+// promptPreflight is the outcome of checking a prompt against the context window of a model.
+type promptPreflight struct {
+	// Messages are the messages to send. They differ from the conversation if it had to be shrunk.
+	Messages []*model.Message
+	// Estimate is the estimated size of the prompt that is sent.
+	Estimate             model.TokenEstimate
+	TruncatedToolResults int
+	DroppedMessages      int
+}
+
+// preflightPrompt estimates the size of a prompt before the model is invoked. If the prompt would
+// not fit into the context window of the model, oversized tool results are truncated first and the
+// oldest exchanges of the conversation are dropped next. The conversation itself is not modified.
+func (r *TaskReconciler) preflightPrompt(ctx context.Context, provider model.ModelProvider, m *memory.Model, systemPrompt string, messages []*model.Message, tools ...native.Tool) (*promptPreflight, error) {
+	budget := int64(float64(m.ContextWindow) * promptBudgetRatio)
+	approximated := model.ApproximateTokens(systemPrompt, messages, tools)
+	estimate := estimatePrompt(ctx, provider, m, systemPrompt, messages, tools, approximated, budget)
+	preflight := &promptPreflight{Messages: messages, Estimate: estimate}
+	if m.ContextWindow <= 0 || estimate.Tokens <= budget {
+		return preflight, nil
+	}
+
+	// The local approximation is used while shrinking the prompt, scaled to match the estimate,
+	// so that the provider is asked to count at most once more at the end.
+	scale := 1.0
+	if approximated > 0 {
+		scale = float64(estimate.Tokens) / float64(approximated)
+	}
+	fixed := approximated
+	for _, message := range messages {
+		fixed -= model.ApproximateMessageTokens(message)
+	}
+	project := func(messages []*model.Message) int64 {
+		tokens := fixed
+		for _, message := range messages {
+			tokens += model.ApproximateMessageTokens(message)
+		}
+		return int64(float64(tokens) * scale)
+	}
+
+	maxToolResultTokens := max(int64(float64(budget)*toolResultBudgetRatio/scale), minToolResultTokens)
+	messages, preflight.TruncatedToolResults = truncateToolResults(messages, maxToolResultTokens)
+
+	if project(messages) > budget {
+		for len(messages) > preservedMessages+1 && project(messages) > budget {
+			shrunk, dropped := dropOldestExchange(messages)
+			if dropped == 0 {
+				break
+			}
+			messages = shrunk
+			preflight.DroppedMessages += dropped
+		}
+
+		if preflight.DroppedMessages > 0 {
+			messages = withOmissionNotice(messages, preflight.DroppedMessages)
+		}
+	}
+
+	preflight.Messages = messages
+	preflight.Estimate = estimatePrompt(ctx, provider, m, systemPrompt, messages, tools, project(messages), budget)
+	if preflight.Estimate.Tokens > budget {
+		return nil, &ContextOverflowError{
+			Model:           m.Name,
+			EstimatedTokens: preflight.Estimate.Tokens,
+			PromptBudget:    budget,
+			ContextWindow:   m.ContextWindow,
+		}
+	}
+
+	return preflight, nil
+}
+
+// estimatePrompt estimates the size of a prompt. The provider is only asked to count its tokens if
+// the approximation comes close to the budget. Without a budget, the approximation is enough.
+func estimatePrompt(ctx context.Context, provider model.ModelProvider, m *memory.Model, systemPrompt string, messages []*model.Message, tools []native.Tool, approximated, budget int64) model.TokenEstimate {
+	if budget <= 0 || float64(approximated) < float64(budget)*providerCountRatio {
+		return model.TokenEstimate{Tokens: approximated, Source: model.TokenEstimateSourceApproximation}
+	}
+
+	return model.EstimateTokens(ctx, provider, m.Name, systemPrompt, messages, model.WithTools(tools...))
+}
+
+// truncateToolResults shortens tool results with more than maxTokens tokens. The beginning and the
+// end of a result are kept, because they usually carry the command and its outcome.
+func truncateToolResults(messages []*model.Message, maxTokens int64) ([]*model.Message, int) {
+	truncatedCount := 0
+	truncated := make([]*model.Message, len(messages))
+	for i, message := range messages {
+		truncated[i] = message
+
+		for j, block := range message.Content {
+			result, ok := block.(*model.ToolResultBlock)
+			if !ok {
+				continue
+			}
+
+			tokens := model.ApproximateTextTokens(result.Result)
+			if tokens <= maxTokens {
+				continue
+			}
+
+			if truncated[i] == message {
+				truncated[i] = &model.Message{
+					Source:  message.Source,
+					Content: append([]model.ContentBlock(nil), message.Content...),
+					Usage:   message.Usage,
+				}
+			}
+
+			shortened := *result
+			shortened.Result = truncateMiddle(result.Result, int(int64(len(result.Result))*maxTokens/tokens))
+			truncated[i].Content[j] = &shortened
+			truncatedCount++
+		}
+	}
+
+	return truncated, truncatedCount
+}
+
+// truncateMiddle keeps about keep bytes of text, split between its beginning and its end.
+func truncateMiddle(text string, keep int) string {
+	head := keep / 2
+	for head > 0 && !utf8.RuneStart(text[head]) {
+		head--
+	}
+	tail := len(text) - (keep - head)
+	for tail < len(text) && !utf8.RuneStart(text[tail]) {
+		tail++
+	}
+
+	return fmt.Sprintf("%s\n\n[... %d characters were truncated to fit into the context window ...]\n\n%s",
+		text[:head], utf8.RuneCountInString(text[head:tail]), text[tail:])
+}
+
+// dropOldestExchange removes the oldest model message after the first message together with the
+// messages that followed it up to the next model message, so that tool calls are never separated
+// from their results. The most recent messages are preserved.
+func dropOldestExchange(messages []*model.Message) ([]*model.Message, int) {
+	if len(messages) < 2 || messages[1].Source != model.MessageSourceModel {
+		return messages, 0
+	}
+
+	end := 2
+	for end < len(messages) && messages[end].Source != model.MessageSourceModel {
+		end++
+	}
+	if end > len(messages)-preservedMessages {
+		return messages, 0
+	}
+
+	shrunk := make([]*model.Message, 0, len(messages)-(end-1))
+	shrunk = append(shrunk, messages[0])
+	shrunk = append(shrunk, messages[end:]...)
+	return shrunk, end - 1
+}
+
+// withOmissionNotice tells the model that the conversation it sees is incomplete.
+func withOmissionNotice(messages []*model.Message, dropped int) []*model.Message {
+	first := messages[0]
+	notice := &model.Message{
+		Source:  first.Source,
+		Content: append(append([]model.ContentBlock(nil), first.Content...), &model.TextBlock{Text: fmt.Sprintf("[%d earlier messages of this conversation were omitted to fit into the context window]", dropped)}),
+		Usage:   first.Usage,
+	}
+
+	return append([]*model.Message{notice}, messages[1:]...)
+}
@@ -0,0 +1,185 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/model"
+)
+
+func TestPreflightPrompt(t *testing.T) {
+	ctx := context.Background()
+	r := &TaskReconciler{}
+	provider := &model.MockProvider{}
+
+	t.Run("prompt fits", func(t *testing.T) {
+		messages := conversation(3, "ok")
+
+		preflight, err := r.preflightPrompt(ctx, provider, &memory.Model{Name: "test", ContextWindow: 10_000}, "system", messages)
+		if err != nil {
+			t.Fatalf("preflightPrompt() error = %v", err)
+		}
+
+		if preflight.TruncatedToolResults != 0 || preflight.DroppedMessages != 0 || len(preflight.Messages) != len(messages) {
+			t.Errorf("prompt that fits was changed: %+v", preflight)
+		}
+		if preflight.Estimate.Source != model.TokenEstimateSourceApproximation || preflight.Estimate.Tokens == 0 {
+			t.Errorf("unexpected estimate: %+v", preflight.Estimate)
+		}
+	})
+
+	t.Run("oversized tool result is truncated", func(t *testing.T) {
+		messages := conversation(1, strings.Repeat("line of output\n", 10_000))
+
+		preflight, err := r.preflightPrompt(ctx, provider, &memory.Model{Name: "test", ContextWindow: 20_000}, "system", messages)
+		if err != nil {
+			t.Fatalf("preflightPrompt() error = %v", err)
+		}
+
+		if preflight.TruncatedToolResults != 1 || preflight.DroppedMessages != 0 {
+			t.Errorf("expected only the tool result to be truncated: %+v", preflight)
+		}
+		result := preflight.Messages[2].Content[0].(*model.ToolResultBlock).Result
+		if !strings.Contains(result, "characters were truncated to fit into the context window") {
+			t.Errorf("truncated tool result does not say so: %q", result[:100])
+		}
+		if !strings.HasPrefix(result, "line of output") || !strings.HasSuffix(result, "line of output\n") {
+			t.Errorf("truncated tool result lost its beginning or end")
+		}
+
+		original := messages[2].Content[0].(*model.ToolResultBlock).Result
+		if len(original) != len("line of output\n")*10_000 {
+			t.Errorf("the conversation must not be modified")
+		}
+	})
+
+	t.Run("oldest exchanges are dropped", func(t *testing.T) {
+		messages := conversation(20, strings.Repeat("output ", 400))
+
+		preflight, err := r.preflightPrompt(ctx, provider, &memory.Model{Name: "test", ContextWindow: 8_000}, "system", messages)
+		if err != nil {
+			t.Fatalf("preflightPrompt() error = %v", err)
+		}
+
+		if preflight.DroppedMessages == 0 || preflight.DroppedMessages%2 != 0 {
+			t.Fatalf("expected whole exchanges to be dropped: %+v", preflight)
+		}
+		if len(preflight.Messages) != len(messages)-preflight.DroppedMessages {
+			t.Errorf("got %d messages, want %d", len(preflight.Messages), len(messages)-preflight.DroppedMessages)
+		}
+		if preflight.Estimate.Tokens > 7_200 {
+			t.Errorf("estimate %d exceeds the prompt budget", preflight.Estimate.Tokens)
+		}
+
+		first := preflight.Messages[0]
+		notice := first.Content[len(first.Content)-1].(*model.TextBlock).Text
+		if notice != fmt.Sprintf("[%d earlier messages of this conversation were omitted to fit into the context window]", preflight.DroppedMessages) {
+			t.Errorf("unexpected omission notice: %q", notice)
+		}
+		if len(messages[0].Content) != 1 {
+			t.Errorf("the conversation must not be modified")
+		}
+
+		for i, message := range preflight.Messages[1:] {
+			want := model.MessageSourceModel
+			if i%2 == 1 {
+				want = model.MessageSourceSystem
+			}
+			if message.Source != want {
+				t.Fatalf("message %d is from %s, want %s: tool calls must stay with their results", i+1, message.Source, want)
+			}
+		}
+		if preflight.Messages[len(preflight.Messages)-1] != messages[len(messages)-1] {
+			t.Errorf("the most recent messages must be preserved")
+		}
+	})
+
+	t.Run("prompt does not fit", func(t *testing.T) {
+		messages := []*model.Message{
+			{Source: model.MessageSourceUser, Content: []model.ContentBlock{&model.TextBlock{Text: strings.Repeat("word ", 5_000)}}},
+		}
+
+		_, err := r.preflightPrompt(ctx, provider, &memory.Model{Name: "small", ContextWindow: 1_000}, "system", messages)
+
+		var overflowError *ContextOverflowError
+		if !errors.As(err, &overflowError) {
+			t.Fatalf("preflightPrompt() error = %v, want a ContextOverflowError", err)
+		}
+		if overflowError.Model != "small" || overflowError.ContextWindow != 1_000 || overflowError.PromptBudget != 900 {
+			t.Errorf("unexpected error: %+v", overflowError)
+		}
+	})
+}
+
+func TestPreflightPromptCountsOnlyNearBudget(t *testing.T) {
+	ctx := context.Background()
+	r := &TaskReconciler{}
+	messages := conversation(2, strings.Repeat("line of output\n", 100))
+	approximated := model.ApproximateTokens("system", messages, nil)
+
+	tests := []struct {
+		name          string
+		contextWindow int64
+		wantCalls     int
+		wantSource    model.TokenEstimateSource
+	}{
+		{name: "prompt far below budget", contextWindow: approximated * 10, wantCalls: 0, wantSource: model.TokenEstimateSourceApproximation},
+		{name: "prompt close to budget", contextWindow: int64(float64(approximated) / promptBudgetRatio / 0.8), wantCalls: 1, wantSource: model.TokenEstimateSourceProvider},
+		{name: "no context window", contextWindow: 0, wantCalls: 0, wantSource: model.TokenEstimateSourceApproximation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &countingProvider{}
+
+			preflight, err := r.preflightPrompt(ctx, provider, &memory.Model{Name: "test", ContextWindow: tt.contextWindow}, "system", messages)
+			if err != nil {
+				t.Fatalf("preflightPrompt() error = %v", err)
+			}
+
+			if provider.calls != tt.wantCalls {
+				t.Errorf("provider counted tokens %d times, want %d", provider.calls, tt.wantCalls)
+			}
+			if preflight.Estimate.Source != tt.wantSource {
+				t.Errorf("estimate source = %s, want %s", preflight.Estimate.Source, tt.wantSource)
+			}
+		})
+	}
+}
+
+// countingProvider counts the tokens of prompts with the local approximation and records how often
+// it was asked to.
+type countingProvider struct {
+	model.MockProvider
+	calls int
+}
+
+func (p *countingProvider) CountTokens(ctx context.Context, modelName, systemPrompt string, messages []*model.Message, opts ...model.InvokeModelOption) (int64, error) {
+	p.calls++
+	return model.ApproximateTokens(systemPrompt, messages, nil), nil
+}
+
+// conversation builds a user message followed by the given number of tool call exchanges.
+func conversation(exchanges int, toolResult string) []*model.Message {
+	messages := []*model.Message{
+		{Source: model.MessageSourceUser, Content: []model.ContentBlock{&model.TextBlock{Text: "Fix the failing tests"}}},
+	}
+
+	for i := range exchanges {
+		id := fmt.Sprintf("call_%d", i)
+		messages = append(messages,
+			&model.Message{Source: model.MessageSourceModel, Content: []model.ContentBlock{
+				&model.ToolCallBlock{ID: id, Tool: "code_interpreter", Args: []byte(`{"script":"print(run_tests())"}`)},
+			}},
+			&model.Message{Source: model.MessageSourceSystem, Content: []model.ContentBlock{
+				&model.ToolResultBlock{ID: id, Name: "code_interpreter", Result: toolResult, Succeeded: true},
+			}},
+		)
+	}
+
+	return messages
+}
@@ -47,6 +47,10 @@ func ConvertMemoryMessageBlocksToModel(blocks []types.MessageBlock) ([]model.Con
 			contentBlocks = append(contentBlocks, &model.TextBlock{
 				Text: block.Payload,
 			})
+		case types.MessageBlockKindReasoning:
+			contentBlocks = append(contentBlocks, &model.ReasoningBlock{
+				Text: block.Payload,
+			})
 		case types.MessageBlockKindToolCall:
 			var toolCall tooltypes.ToolCall
 			err := json.Unmarshal([]byte(block.Payload), &toolCall)
@@ -54,8 +58,9 @@ func ConvertMemoryMessageBlocksToModel(blocks []types.MessageBlock) ([]model.Con
 				return nil, fmt.Errorf("failed to unmarshal tool call block: %w", err)
 			}
 
-			// Serialize Input back to raw JSON for model layer
-			var args json.RawMessage
+			// Serialize Input back to raw JSON for model layer. Providers differ in how they
+			// treat missing arguments, so always hand them a JSON object.
+			args := json.RawMessage("{}")
 			if toolCall.Input != nil && toolCall.Input.Interpreter != nil {
 				args, _ = json.Marshal(toolCall.Input.Interpreter)
 			}
@@ -163,6 +168,11 @@ func ConvertModelContentBlocksToMemoryWithProvider(blocks []model.ContentBlock,
 				Kind:    types.MessageBlockKindText,
 				Payload: b.Text,
 			})
+		case *model.ReasoningBlock:
+			messageBlocks = append(messageBlocks, types.MessageBlock{
+				Kind:    types.MessageBlockKindReasoning,
+				Payload: b.Text,
+			})
 		case *model.ToolCallBlock:
 			// Convert to unified ToolCall format
 			toolCall := &tooltypes.ToolCall{
@@ -0,0 +1,67 @@
+package agent
+
+import (
+	"sync"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// keyRateLimitCooldown is how long a rate limited key is avoided by the primary/backup strategy.
+const keyRateLimitCooldown = time.Minute
+
+// KeySelector picks the API key of a model provider that serves the next model invocation.
+type KeySelector struct {
+	mu   sync.Mutex
+	next map[uuid.UUID]int
+	now  func() time.Time
+}
+
+func NewKeySelector() *KeySelector {
+	return &KeySelector{
+		next: make(map[uuid.UUID]int),
+		now:  time.Now,
+	}
+}
+
+// Select returns one of the active keys according to the strategy. The keys have to be ordered
+// by priority. It returns nil if no key is given.
+func (s *KeySelector) Select(providerID uuid.UUID, strategy types.KeySelectionStrategy, keys []*memory.ModelProviderKey) *memory.ModelProviderKey {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	switch strategy {
+	case types.KeySelectionStrategyRoundRobin:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		key := keys[s.next[providerID]%len(keys)]
+		s.next[providerID]++
+		return key
+
+	case types.KeySelectionStrategyLeastRecentlyLimited:
+		return leastRecentlyLimited(keys)
+
+	default:
+		cutoff := s.now().Add(-keyRateLimitCooldown)
+		for _, key := range keys {
+			if key.LastRateLimitedTime.Before(cutoff) {
+				return key
+			}
+		}
+		return leastRecentlyLimited(keys)
+	}
+}
+
+func leastRecentlyLimited(keys []*memory.ModelProviderKey) *memory.ModelProviderKey {
+	selected := keys[0]
+	for _, key := range keys[1:] {
+		if key.LastRateLimitedTime.Before(selected.LastRateLimitedTime) {
+			selected = key
+		}
+	}
+	return selected
+}
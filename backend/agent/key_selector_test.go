@@ -0,0 +1,94 @@
+package agent
+
+import (
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+func TestKeySelector(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	primary := &memory.ModelProviderKey{ID: uuid.New(), Name: "primary"}
+	backup := &memory.ModelProviderKey{ID: uuid.New(), Name: "backup"}
+	limitedPrimary := &memory.ModelProviderKey{ID: uuid.New(), Name: "primary", LastRateLimitedTime: now.Add(-10 * time.Second)}
+	limitedBackup := &memory.ModelProviderKey{ID: uuid.New(), Name: "backup", LastRateLimitedTime: now.Add(-20 * time.Second)}
+	recoveredPrimary := &memory.ModelProviderKey{ID: uuid.New(), Name: "primary", LastRateLimitedTime: now.Add(-2 * keyRateLimitCooldown)}
+
+	tests := []struct {
+		name     string
+		strategy types.KeySelectionStrategy
+		keys     []*memory.ModelProviderKey
+		expected []string
+	}{
+		{
+			name:     "no keys",
+			strategy: types.KeySelectionStrategyPrimaryBackup,
+			expected: []string{""},
+		},
+		{
+			name:     "primary backup prefers primary",
+			strategy: types.KeySelectionStrategyPrimaryBackup,
+			keys:     []*memory.ModelProviderKey{primary, backup},
+			expected: []string{"primary", "primary"},
+		},
+		{
+			name:     "primary backup skips rate limited primary",
+			strategy: types.KeySelectionStrategyPrimaryBackup,
+			keys:     []*memory.ModelProviderKey{limitedPrimary, backup},
+			expected: []string{"backup"},
+		},
+		{
+			name:     "primary backup returns to primary after cooldown",
+			strategy: types.KeySelectionStrategyPrimaryBackup,
+			keys:     []*memory.ModelProviderKey{recoveredPrimary, backup},
+			expected: []string{"primary"},
+		},
+		{
+			name:     "primary backup with all keys rate limited",
+			strategy: types.KeySelectionStrategyPrimaryBackup,
+			keys:     []*memory.ModelProviderKey{limitedPrimary, limitedBackup},
+			expected: []string{"backup"},
+		},
+		{
+			name:     "round robin",
+			strategy: types.KeySelectionStrategyRoundRobin,
+			keys:     []*memory.ModelProviderKey{primary, backup},
+			expected: []string{"primary", "backup", "primary"},
+		},
+		{
+			name:     "least recently limited",
+			strategy: types.KeySelectionStrategyLeastRecentlyLimited,
+			keys:     []*memory.ModelProviderKey{limitedPrimary, limitedBackup},
+			expected: []string{"backup"},
+		},
+		{
+			name:     "least recently limited prefers keys that were never limited",
+			strategy: types.KeySelectionStrategyLeastRecentlyLimited,
+			keys:     []*memory.ModelProviderKey{recoveredPrimary, backup},
+			expected: []string{"backup"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector := NewKeySelector()
+			selector.now = func() time.Time { return now }
+			providerID := uuid.New()
+
+			for i, expected := range tt.expected {
+				var got string
+				if key := selector.Select(providerID, tt.strategy, tt.keys); key != nil {
+					got = key.Name
+				}
+
+				if got != expected {
+					t.Errorf("selection %d = %q, want %q", i, got, expected)
+				}
+			}
+		})
+	}
+}
@@ -15,8 +15,18 @@ const (
 
 	// Model and provider information
 	KeyModel         = "model"
+	KeyFailedModel   = "failed_model"
+	KeyFallbackModel = "fallback_model"
+	KeyRoutedModel   = "routed_model"
 	KeyProvider      = "provider"
 	KeyModelProvider = "model_provider"
+	KeyProviderKey   = "provider_key"
+
+	// Model routing
+	KeyRoutingRule     = "routing_rule"
+	KeyTurnType        = "turn_type"
+	KeyEstimatedTokens = "estimated_prompt_tokens"
+	KeyToolFailures    = "recent_tool_failures"
 
 	// Timing and performance
 	KeyDuration   = "duration_ms"
@@ -59,11 +69,12 @@ const (
 	KeyListenerType    = "listener_type"
 
 	// Error and retry information
-	KeyRetryable  = "retryable"
-	KeyRetryAfter = "retry_after_ms"
-	KeyRetryCount = "retry_count"
-	KeyErrorType  = "error_type"
-	KeyError      = "error"
+	KeyRetryable      = "retryable"
+	KeyRetryAfter     = "retry_after_ms"
+	KeyRetryCount     = "retry_count"
+	KeyThrottleReason = "throttle_reason"
+	KeyErrorType      = "error_type"
+	KeyError          = "error"
 
 	// Operations and components
 	KeyOperation = "operation"
@@ -0,0 +1,101 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// taskMetrics exposes what tasks do with models: how long model calls take, how often they fail,
+// the tokens and cost they incur and how many tasks are in which phase.
+type taskMetrics struct {
+	modelCallDuration *prometheus.HistogramVec
+	modelCallErrors   *prometheus.CounterVec
+	tokens            *prometheus.CounterVec
+	cost              *prometheus.CounterVec
+	tasksInPhase      *prometheus.GaugeVec
+}
+
+func newTaskMetrics(registry prometheus.Registerer) *taskMetrics {
+	m := &taskMetrics{
+		modelCallDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: "model",
+			Name:      "call_duration_seconds",
+			Help:      "How long in seconds a model invocation took, including streaming the response",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+		}, []string{"model_provider", "model"}),
+		modelCallErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: "model",
+			Name:      "call_errors_total",
+			Help:      "Total number of failed model invocations by kind of error",
+		}, []string{"model_provider", "model", "kind"}),
+		tokens: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: "model",
+			Name:      "tokens_total",
+			Help:      "Total number of tokens processed by models by type of token",
+		}, []string{"agent", "model", "type"}),
+		cost: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: "model",
+			Name:      "cost_total",
+			Help:      "Total cost of model invocations in US dollars",
+		}, []string{"agent", "model"}),
+		tasksInPhase: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Subsystem: "task",
+			Name:      "reconciling",
+			Help:      "Number of tasks that are currently being reconciled by phase",
+		}, []string{"phase"}),
+	}
+
+	registry.MustRegister(m.modelCallDuration, m.modelCallErrors, m.tokens, m.cost, m.tasksInPhase)
+	return m
+}
+
+// observeModelCall records the duration of a model invocation and, if it failed, its error.
+func (m *taskMetrics) observeModelCall(provider *memory.ModelProvider, modelName string, duration time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	m.modelCallDuration.WithLabelValues(provider.Name, modelName).Observe(duration.Seconds())
+	if err == nil {
+		return
+	}
+
+	kind := "other"
+	var providerError *model.ProviderError
+	switch {
+	case errors.As(err, &providerError):
+		kind = string(providerError.Kind)
+	case errors.Is(err, context.Canceled):
+		kind = "canceled"
+	}
+	m.modelCallErrors.WithLabelValues(provider.Name, modelName, kind).Inc()
+}
+
+// observeUsage records the tokens and the cost of a model invocation.
+func (m *taskMetrics) observeUsage(agent *memory.Agent, modelName string, usage model.Usage, cost float64) {
+	if m == nil {
+		return
+	}
+
+	m.tokens.WithLabelValues(agent.Name, modelName, "input").Add(float64(usage.InputTokens))
+	m.tokens.WithLabelValues(agent.Name, modelName, "output").Add(float64(usage.OutputTokens))
+	m.tokens.WithLabelValues(agent.Name, modelName, "cache_write").Add(float64(usage.CacheWriteTokens))
+	m.tokens.WithLabelValues(agent.Name, modelName, "cache_read").Add(float64(usage.CacheReadTokens))
+	m.cost.WithLabelValues(agent.Name, modelName).Add(cost)
+}
+
+// enterPhase counts a task as being in phase until the returned function is called.
+func (m *taskMetrics) enterPhase(phase TaskPhase) func() {
+	if m == nil {
+		return func() {}
+	}
+
+	gauge := m.tasksInPhase.WithLabelValues(string(phase))
+	gauge.Inc()
+	return gauge.Dec
+}
@@ -0,0 +1,53 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/model"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestTaskMetrics(t *testing.T) {
+	metrics := newTaskMetrics(prometheus.NewRegistry())
+	provider := &memory.ModelProvider{Name: "anthropic"}
+
+	metrics.observeModelCall(provider, "claude", time.Second, nil)
+	metrics.observeModelCall(provider, "claude", time.Second, fmt.Errorf("invoke: %w", model.NewProviderError("anthropic", model.ProviderErrorKindRateLimitExceeded, errors.New("slow down"))))
+	metrics.observeModelCall(provider, "claude", time.Second, context.Canceled)
+
+	if got := testutil.CollectAndCount(metrics.modelCallDuration); got != 1 {
+		t.Errorf("got %d duration series, want 1", got)
+	}
+	for _, kind := range []string{string(model.ProviderErrorKindRateLimitExceeded), "canceled"} {
+		if got := testutil.ToFloat64(metrics.modelCallErrors.WithLabelValues("anthropic", "claude", kind)); got != 1 {
+			t.Errorf("errors of kind %s = %v, want 1", kind, got)
+		}
+	}
+
+	metrics.observeUsage(&memory.Agent{Name: "coder"}, "claude", model.Usage{InputTokens: 100, OutputTokens: 20}, 0.5)
+	if got := testutil.ToFloat64(metrics.tokens.WithLabelValues("coder", "claude", "input")); got != 100 {
+		t.Errorf("input tokens = %v, want 100", got)
+	}
+	if got := testutil.ToFloat64(metrics.cost.WithLabelValues("coder", "claude")); got != 0.5 {
+		t.Errorf("cost = %v, want 0.5", got)
+	}
+
+	leave := metrics.enterPhase(TaskPhaseInvokeModel)
+	if got := testutil.ToFloat64(metrics.tasksInPhase.WithLabelValues(string(TaskPhaseInvokeModel))); got != 1 {
+		t.Errorf("tasks invoking models = %v, want 1", got)
+	}
+	leave()
+	if got := testutil.ToFloat64(metrics.tasksInPhase.WithLabelValues(string(TaskPhaseInvokeModel))); got != 0 {
+		t.Errorf("tasks invoking models = %v after leaving the phase, want 0", got)
+	}
+
+	var nilMetrics *taskMetrics
+	nilMetrics.observeModelCall(provider, "claude", time.Second, nil)
+	nilMetrics.enterPhase(TaskPhaseInvokeModel)()
+}
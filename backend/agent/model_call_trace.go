@@ -0,0 +1,126 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/model"
+	"github.com/google/uuid"
+)
+
+// ModelCallTraceConfig controls the capture of the raw requests that are sent to model providers.
+type ModelCallTraceConfig struct {
+	// AllTasks captures the model calls of every task. Otherwise only tasks that enabled tracing
+	// are captured.
+	AllTasks bool
+	// MaxCallsPerTask is the number of traces that are kept per task. Older traces are deleted.
+	MaxCallsPerTask int
+	// Retention is how long traces are kept.
+	Retention time.Duration
+	// MaxBodyBytes cuts off request and response bodies that are larger.
+	MaxBodyBytes int
+}
+
+func DefaultModelCallTraceConfig() ModelCallTraceConfig {
+	return ModelCallTraceConfig{
+		MaxCallsPerTask: 50,
+		Retention:       7 * 24 * time.Hour,
+		MaxBodyBytes:    1 << 20,
+	}
+}
+
+// captureModelCalls returns a context that captures the HTTP exchanges of a model invocation
+// if tracing is enabled for the task. The capture is nil otherwise.
+func (r *TaskReconciler) captureModelCalls(ctx context.Context, task *memory.Task) (context.Context, *model.CallCapture) {
+	if !task.TraceModelCalls && !r.traceConfig.AllTasks {
+		return ctx, nil
+	}
+
+	capture := model.NewCallCapture(r.traceConfig.MaxBodyBytes)
+	return model.WithCallCapture(ctx, capture), capture
+}
+
+// persistModelCallTraces stores the captured exchanges of a model invocation and enforces the
+// retention limits. Failures are logged, because tracing must never fail a task.
+func (r *TaskReconciler) persistModelCallTraces(ctx context.Context, taskID uuid.UUID, m *memory.Model, capture *model.CallCapture) {
+	if capture == nil {
+		return
+	}
+
+	exchanges := capture.Exchanges()
+	if len(exchanges) == 0 {
+		return
+	}
+
+	logger := r.logger.With(KeyTaskID, taskID, KeyModel, m.Name)
+
+	creates := make([]*memory.ModelCallTraceCreate, 0, len(exchanges))
+	for _, exchange := range exchanges {
+		create := r.memory.ModelCallTrace.Create().
+			SetTaskID(taskID).
+			SetModelName(m.Name).
+			SetModelProviderID(m.ModelProviderID).
+			SetMethod(exchange.Method).
+			SetURL(exchange.URL).
+			SetRequestHeaders(exchange.RequestHeaders).
+			SetRequestBody(exchange.RequestBody).
+			SetResponseHeaders(exchange.ResponseHeaders).
+			SetResponseBody(exchange.ResponseBody).
+			SetTruncated(exchange.Truncated).
+			SetDurationMs(exchange.Duration.Milliseconds())
+		if exchange.StatusCode != 0 {
+			create.SetStatusCode(exchange.StatusCode)
+		}
+		if exchange.Error != "" {
+			create.SetError(exchange.Error)
+		}
+		creates = append(creates, create)
+	}
+
+	if _, err := r.memory.ModelCallTrace.CreateBulk(creates...).Save(ctx); err != nil {
+		LogError(logger, "failed to persist model call traces", err)
+		return
+	}
+
+	if err := r.pruneModelCallTraces(ctx, taskID); err != nil {
+		LogError(logger, "failed to prune model call traces", err)
+	}
+}
+
+func (r *TaskReconciler) pruneModelCallTraces(ctx context.Context, taskID uuid.UUID) error {
+	if r.traceConfig.Retention > 0 {
+		_, err := r.memory.ModelCallTrace.Delete().
+			Where(modelcalltrace.CreateTimeLT(time.Now().Add(-r.traceConfig.Retention))).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired traces: %w", err)
+		}
+	}
+
+	if r.traceConfig.MaxCallsPerTask <= 0 {
+		return nil
+	}
+
+	excess, err := r.memory.ModelCallTrace.Query().
+		Where(modelcalltrace.TaskID(taskID)).
+		Order(memory.Desc(modelcalltrace.FieldCreateTime), memory.Desc(modelcalltrace.FieldID)).
+		Offset(r.traceConfig.MaxCallsPerTask).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query excess traces: %w", err)
+	}
+
+	if len(excess) == 0 {
+		return nil
+	}
+
+	_, err = r.memory.ModelCallTrace.Delete().Where(modelcalltrace.IDIn(excess...)).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete excess traces: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package agent
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/model"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+func TestPersistModelCallTraces(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:model_call_trace_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+	m := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, m).Build(ctx)
+	task := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+
+	expired := db.ModelCallTrace.Create().
+		SetTaskID(task.ID).
+		SetModelName(m.Name).
+		SetMethod(http.MethodPost).
+		SetURL("https://api.anthropic.com/v1/messages").
+		SetCreateTime(time.Now().Add(-48 * time.Hour)).
+		SaveX(ctx)
+
+	r := &TaskReconciler{
+		memory: db,
+		logger: slog.Default(),
+		traceConfig: ModelCallTraceConfig{
+			MaxCallsPerTask: 2,
+			Retention:       24 * time.Hour,
+		},
+	}
+
+	invokeCtx, capture := r.captureModelCalls(ctx, task)
+	if capture != nil {
+		t.Fatalf("model calls of tasks without tracing must not be captured")
+	}
+	if invokeCtx != ctx {
+		t.Errorf("context must not change without tracing")
+	}
+
+	task.TraceModelCalls = true
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusOK, http.StatusOK} {
+		_, capture := r.captureModelCalls(ctx, task)
+		if capture == nil {
+			t.Fatalf("model calls of tasks with tracing must be captured")
+		}
+		recordExchange(t, capture, status)
+
+		r.persistModelCallTraces(ctx, task.ID, m, capture)
+	}
+
+	traces := db.ModelCallTrace.Query().
+		Where(modelcalltrace.TaskID(task.ID)).
+		AllX(ctx)
+	if len(traces) != 2 {
+		t.Fatalf("expected 2 traces to be kept, got %d", len(traces))
+	}
+
+	for _, trace := range traces {
+		if trace.ID == expired.ID {
+			t.Errorf("expired trace was not deleted")
+		}
+		if trace.StatusCode != http.StatusOK {
+			t.Errorf("the oldest trace should have been deleted, found status %d", trace.StatusCode)
+		}
+		if trace.ModelProviderID != modelProvider.ID {
+			t.Errorf("trace model provider = %s, want %s", trace.ModelProviderID, modelProvider.ID)
+		}
+	}
+}
+
+// recordExchange sends a request through a capture transport to a stub round tripper.
+func recordExchange(t *testing.T, capture *model.CallCapture, status int) {
+	t.Helper()
+
+	transport := model.NewCaptureTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Header: http.Header{}, Body: http.NoBody}, nil
+	}))
+
+	req, err := http.NewRequestWithContext(model.WithCallCapture(context.Background(), capture), http.MethodPost, "https://api.anthropic.com/v1/messages", nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip failed: %v", err)
+	}
+	resp.Body.Close()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
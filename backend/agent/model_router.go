@@ -0,0 +1,136 @@
+package agent
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	tooltypes "github.com/furisto/construct/backend/tool/types"
+	"github.com/google/uuid"
+)
+
+// recentToolFailureWindow is the number of most recent tool result messages that are
+// inspected when counting tool failures for routing.
+const recentToolFailureWindow = 5
+
+// RoutingInput describes the turn that a model router has to find a model for.
+type RoutingInput struct {
+	TurnType              types.TurnType
+	EstimatedPromptTokens int64
+	RecentToolFailures    int64
+}
+
+// RoutingDecision is the outcome of routing a single turn.
+type RoutingDecision struct {
+	RoutingInput
+	Rule    string
+	ModelID uuid.UUID
+}
+
+// RouteModel evaluates the router rules in order and returns the first match. If the agent has
+// no router or no rule matches, the default model is chosen.
+func RouteModel(router *types.ModelRouter, defaultModelID uuid.UUID, input RoutingInput) RoutingDecision {
+	decision := RoutingDecision{
+		RoutingInput: input,
+		ModelID:      defaultModelID,
+	}
+
+	if router == nil {
+		return decision
+	}
+
+	for _, rule := range router.Rules {
+		if ruleMatches(rule, input) {
+			decision.Rule = rule.Name
+			decision.ModelID = rule.ModelID
+			return decision
+		}
+	}
+
+	return decision
+}
+
+func ruleMatches(rule types.ModelRouteRule, input RoutingInput) bool {
+	if len(rule.TurnTypes) > 0 && !slices.Contains(rule.TurnTypes, input.TurnType) {
+		return false
+	}
+
+	if rule.MinPromptTokens > 0 && input.EstimatedPromptTokens < rule.MinPromptTokens {
+		return false
+	}
+
+	if rule.MaxPromptTokens > 0 && input.EstimatedPromptTokens > rule.MaxPromptTokens {
+		return false
+	}
+
+	if input.RecentToolFailures < rule.MinRecentToolFailures {
+		return false
+	}
+
+	if rule.MaxRecentToolFailures != nil && input.RecentToolFailures > *rule.MaxRecentToolFailures {
+		return false
+	}
+
+	return true
+}
+
+// ClassifyTurn determines what triggered the upcoming model invocation.
+func ClassifyTurn(processedMessages []*memory.Message, nextMessage *memory.Message) types.TurnType {
+	if !slices.ContainsFunc(processedMessages, func(m *memory.Message) bool {
+		return m.Source == types.MessageSourceAssistant
+	}) {
+		return types.TurnTypeFirst
+	}
+
+	if nextMessage.Source != types.MessageSourceSystem {
+		return types.TurnTypeUserMessage
+	}
+
+	if countToolFailures(nextMessage) > 0 {
+		return types.TurnTypeToolError
+	}
+
+	return types.TurnTypeToolResult
+}
+
+// CountRecentToolFailures counts the failed tool calls in the most recent tool result messages.
+func CountRecentToolFailures(processedMessages []*memory.Message, nextMessage *memory.Message) int64 {
+	messages := append(slices.Clone(processedMessages), nextMessage)
+
+	var failures int64
+	inspected := 0
+	for i := len(messages) - 1; i >= 0 && inspected < recentToolFailureWindow; i-- {
+		if messages[i].Source != types.MessageSourceSystem {
+			continue
+		}
+		failures += countToolFailures(messages[i])
+		inspected++
+	}
+
+	return failures
+}
+
+func countToolFailures(message *memory.Message) int64 {
+	if message.Content == nil {
+		return 0
+	}
+
+	var failures int64
+	for _, block := range message.Content.Blocks {
+		if block.Kind != types.MessageBlockKindToolResult {
+			continue
+		}
+
+		var toolResult tooltypes.ToolResult
+		if err := json.Unmarshal([]byte(block.Payload), &toolResult); err != nil {
+			continue
+		}
+
+		if !toolResult.Succeeded {
+			failures++
+		}
+	}
+
+	return failures
+}
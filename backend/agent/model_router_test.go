@@ -0,0 +1,211 @@
+package agent
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
+	tooltypes "github.com/furisto/construct/backend/tool/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestRouteModel(t *testing.T) {
+	defaultModelID := uuid.New()
+	cheapModelID := uuid.New()
+	strongModelID := uuid.New()
+	zero := int64(0)
+
+	router := &types.ModelRouter{
+		Rules: []types.ModelRouteRule{
+			{
+				Name:                  "recover-from-failures",
+				ModelID:               strongModelID,
+				MinRecentToolFailures: 2,
+			},
+			{
+				Name:                  "summarize-tool-output",
+				ModelID:               cheapModelID,
+				TurnTypes:             []types.TurnType{types.TurnTypeToolResult},
+				MaxPromptTokens:       50_000,
+				MaxRecentToolFailures: &zero,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		router   *types.ModelRouter
+		input    RoutingInput
+		expected RoutingDecision
+	}{
+		{
+			name:   "no router",
+			router: nil,
+			input:  RoutingInput{TurnType: types.TurnTypeToolResult},
+			expected: RoutingDecision{
+				RoutingInput: RoutingInput{TurnType: types.TurnTypeToolResult},
+				ModelID:      defaultModelID,
+			},
+		},
+		{
+			name:   "cheap model for small tool result",
+			router: router,
+			input:  RoutingInput{TurnType: types.TurnTypeToolResult, EstimatedPromptTokens: 10_000},
+			expected: RoutingDecision{
+				RoutingInput: RoutingInput{TurnType: types.TurnTypeToolResult, EstimatedPromptTokens: 10_000},
+				Rule:         "summarize-tool-output",
+				ModelID:      cheapModelID,
+			},
+		},
+		{
+			name:   "prompt too large for cheap model",
+			router: router,
+			input:  RoutingInput{TurnType: types.TurnTypeToolResult, EstimatedPromptTokens: 80_000},
+			expected: RoutingDecision{
+				RoutingInput: RoutingInput{TurnType: types.TurnTypeToolResult, EstimatedPromptTokens: 80_000},
+				ModelID:      defaultModelID,
+			},
+		},
+		{
+			name:   "first matching rule wins",
+			router: router,
+			input:  RoutingInput{TurnType: types.TurnTypeToolError, RecentToolFailures: 3},
+			expected: RoutingDecision{
+				RoutingInput: RoutingInput{TurnType: types.TurnTypeToolError, RecentToolFailures: 3},
+				Rule:         "recover-from-failures",
+				ModelID:      strongModelID,
+			},
+		},
+		{
+			name:   "turn type does not match",
+			router: router,
+			input:  RoutingInput{TurnType: types.TurnTypeUserMessage},
+			expected: RoutingDecision{
+				RoutingInput: RoutingInput{TurnType: types.TurnTypeUserMessage},
+				ModelID:      defaultModelID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RouteModel(tt.router, defaultModelID, tt.input)
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("RouteModel() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRouteModelWithApproximatedPrompt(t *testing.T) {
+	defaultModelID := uuid.New()
+	cheapModelID := uuid.New()
+
+	router := &types.ModelRouter{
+		Rules: []types.ModelRouteRule{
+			{
+				Name:            "small-prompt",
+				ModelID:         cheapModelID,
+				MaxPromptTokens: 1_000,
+			},
+		},
+	}
+
+	prompt := func(words int) []*model.Message {
+		return []*model.Message{
+			{Source: model.MessageSourceUser, Content: []model.ContentBlock{&model.TextBlock{Text: "List the files"}}},
+			{Source: model.MessageSourceUser, Content: []model.ContentBlock{&model.ToolResultBlock{Name: "list_files", Result: strings.Repeat("main.go ", words)}}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		messages []*model.Message
+		expected uuid.UUID
+	}{
+		{name: "prompt within limit", messages: prompt(100), expected: cheapModelID},
+		{name: "prompt over limit", messages: prompt(2_000), expected: defaultModelID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RouteModel(router, defaultModelID, RoutingInput{
+				TurnType:              types.TurnTypeToolResult,
+				EstimatedPromptTokens: model.ApproximateTokens("You are a coding agent.", tt.messages, nil),
+			})
+			if got.ModelID != tt.expected {
+				t.Errorf("RouteModel() routed a prompt of %d tokens to %s, want %s", got.EstimatedPromptTokens, got.ModelID, tt.expected)
+			}
+		})
+	}
+}
+
+func TestClassifyTurn(t *testing.T) {
+	userMessage := &memory.Message{Source: types.MessageSourceUser, Content: &types.MessageContent{}}
+	assistantMessage := &memory.Message{Source: types.MessageSourceAssistant, Content: &types.MessageContent{}}
+
+	tests := []struct {
+		name      string
+		processed []*memory.Message
+		next      *memory.Message
+		expected  types.TurnType
+		failures  int64
+	}{
+		{
+			name:     "first turn",
+			next:     userMessage,
+			expected: types.TurnTypeFirst,
+		},
+		{
+			name:      "user message",
+			processed: []*memory.Message{userMessage, assistantMessage},
+			next:      userMessage,
+			expected:  types.TurnTypeUserMessage,
+		},
+		{
+			name:      "tool result",
+			processed: []*memory.Message{userMessage, assistantMessage},
+			next:      toolResultMessage(t, true, true),
+			expected:  types.TurnTypeToolResult,
+		},
+		{
+			name:      "tool error",
+			processed: []*memory.Message{userMessage, assistantMessage, toolResultMessage(t, false), assistantMessage},
+			next:      toolResultMessage(t, true, false),
+			expected:  types.TurnTypeToolError,
+			failures:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyTurn(tt.processed, tt.next); got != tt.expected {
+				t.Errorf("ClassifyTurn() = %s, want %s", got, tt.expected)
+			}
+
+			if got := CountRecentToolFailures(tt.processed, tt.next); got != tt.failures {
+				t.Errorf("CountRecentToolFailures() = %d, want %d", got, tt.failures)
+			}
+		})
+	}
+}
+
+func toolResultMessage(t *testing.T, succeeded ...bool) *memory.Message {
+	content := &types.MessageContent{}
+	for _, s := range succeeded {
+		payload, err := json.Marshal(&tooltypes.ToolResult{Tool: "code_interpreter", Succeeded: s})
+		if err != nil {
+			t.Fatalf("failed to marshal tool result: %v", err)
+		}
+		content.Blocks = append(content.Blocks, types.MessageBlock{
+			Kind:    types.MessageBlockKindToolResult,
+			Payload: string(payload),
+		})
+	}
+
+	return &memory.Message{Source: types.MessageSourceSystem, Content: content}
+}
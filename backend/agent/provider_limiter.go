@@ -0,0 +1,221 @@
+package agent
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+// inFlightRetryInterval is how long a task waits before it asks again for a free in-flight
+// slot. Slots are freed when running invocations finish, which cannot be predicted.
+const inFlightRetryInterval = time.Second
+
+type ThrottleReason string
+
+const (
+	ThrottleReasonRetryAfter        ThrottleReason = "retry_after"
+	ThrottleReasonMaxInFlight       ThrottleReason = "max_in_flight"
+	ThrottleReasonRequestsPerMinute ThrottleReason = "requests_per_minute"
+	ThrottleReasonTokensPerMinute   ThrottleReason = "tokens_per_minute"
+)
+
+// fallbackReasonNoCapacity is the reason of task.model_fallback events for models whose provider
+// had no capacity left.
+const fallbackReasonNoCapacity = "no_capacity"
+
+// ProviderCapacityError is returned if a model provider has no capacity left for another
+// model invocation. The task should be retried after Wait.
+type ProviderCapacityError struct {
+	ProviderID uuid.UUID
+	Reason     ThrottleReason
+	Wait       time.Duration
+}
+
+func (e *ProviderCapacityError) Error() string {
+	return fmt.Sprintf("model provider %s has no capacity (%s), retry in %s", e.ProviderID, e.Reason, e.Wait)
+}
+
+// ProviderLimiter enforces the rate limits of model providers across all tasks. Next to the
+// configured limits it honors the Retry-After hints returned by providers, so that a single
+// rate limited response holds back every task that uses the same provider.
+type ProviderLimiter struct {
+	mu        sync.Mutex
+	providers map[uuid.UUID]*providerLimit
+	now       func() time.Time
+}
+
+type providerLimit struct {
+	config       types.ModelProviderRateLimit
+	configured   bool
+	requests     *rate.Limiter
+	tokens       *rate.Limiter
+	inFlight     int64
+	blockedUntil time.Time
+}
+
+func NewProviderLimiter() *ProviderLimiter {
+	return &ProviderLimiter{
+		providers: make(map[uuid.UUID]*providerLimit),
+		now:       time.Now,
+	}
+}
+
+// Acquire reserves capacity for a single model invocation without blocking. If the provider
+// has no capacity left a ProviderCapacityError is returned. The returned permit has to be
+// released once the invocation has finished.
+func (l *ProviderLimiter) Acquire(providerID uuid.UUID, config *types.ModelProviderRateLimit, estimatedTokens int64) (*ProviderPermit, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	limit := l.limitFor(providerID, config)
+
+	if now.Before(limit.blockedUntil) {
+		return nil, &ProviderCapacityError{ProviderID: providerID, Reason: ThrottleReasonRetryAfter, Wait: limit.blockedUntil.Sub(now)}
+	}
+
+	if limit.config.MaxInFlight > 0 && limit.inFlight >= limit.config.MaxInFlight {
+		return nil, &ProviderCapacityError{ProviderID: providerID, Reason: ThrottleReasonMaxInFlight, Wait: inFlightRetryInterval}
+	}
+
+	var reservations []*rate.Reservation
+	cancel := func() {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+
+	if limit.requests != nil {
+		r := limit.requests.ReserveN(now, 1)
+		reservations = append(reservations, r)
+		if delay := r.DelayFrom(now); delay > 0 {
+			cancel()
+			return nil, &ProviderCapacityError{ProviderID: providerID, Reason: ThrottleReasonRequestsPerMinute, Wait: delay}
+		}
+	}
+
+	if limit.tokens != nil && estimatedTokens > 0 {
+		r := limit.tokens.ReserveN(now, int(min(estimatedTokens, int64(limit.tokens.Burst()))))
+		reservations = append(reservations, r)
+		if delay := r.DelayFrom(now); delay > 0 {
+			cancel()
+			return nil, &ProviderCapacityError{ProviderID: providerID, Reason: ThrottleReasonTokensPerMinute, Wait: delay}
+		}
+	}
+
+	limit.inFlight++
+	return &ProviderPermit{
+		limiter:         l,
+		providerID:      providerID,
+		estimatedTokens: estimatedTokens,
+	}, nil
+}
+
+// Backoff holds back all invocations of the provider until the retry after duration has
+// passed. It is called when the provider itself signals that it is rate limited.
+func (l *ProviderLimiter) Backoff(providerID uuid.UUID, retryAfter time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit, ok := l.providers[providerID]
+	if !ok {
+		limit = &providerLimit{}
+		l.providers[providerID] = limit
+	}
+
+	if until := l.now().Add(retryAfter); until.After(limit.blockedUntil) {
+		limit.blockedUntil = until
+	}
+}
+
+// limitFor returns the state of the provider and applies configuration changes. Changing
+// the configuration resets the request and token budgets.
+func (l *ProviderLimiter) limitFor(providerID uuid.UUID, config *types.ModelProviderRateLimit) *providerLimit {
+	var desired types.ModelProviderRateLimit
+	if config != nil {
+		desired = *config
+	}
+
+	limit, ok := l.providers[providerID]
+	if !ok {
+		limit = &providerLimit{}
+		l.providers[providerID] = limit
+	} else if limit.configured && limit.config == desired {
+		return limit
+	}
+
+	limit.config = desired
+	limit.configured = true
+	limit.requests = perMinuteLimiter(desired.RequestsPerMinute)
+	limit.tokens = perMinuteLimiter(desired.TokensPerMinute)
+	return limit
+}
+
+func perMinuteLimiter(perMinute int64) *rate.Limiter {
+	if perMinute <= 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(float64(perMinute)/60), int(perMinute))
+}
+
+// ProviderPermit is the capacity that has been reserved for a single model invocation.
+type ProviderPermit struct {
+	limiter         *ProviderLimiter
+	providerID      uuid.UUID
+	estimatedTokens int64
+	released        bool
+}
+
+// Release frees the in-flight slot of the invocation. Tokens that were used on top of the
+// estimate are charged to the provider's token budget.
+func (p *ProviderPermit) Release(usedTokens int64) {
+	l := p.limiter
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if p.released {
+		return
+	}
+	p.released = true
+
+	limit, ok := l.providers[p.providerID]
+	if !ok {
+		return
+	}
+
+	limit.inFlight--
+	if limit.tokens != nil && usedTokens > p.estimatedTokens {
+		limit.tokens.ReserveN(l.now(), int(min(usedTokens-p.estimatedTokens, int64(limit.tokens.Burst()))))
+	}
+}
+
+// providerLimiterMetrics exposes how long tasks wait for provider capacity.
+type providerLimiterMetrics struct {
+	waitDuration *prometheus.HistogramVec
+	throttled    *prometheus.CounterVec
+}
+
+func newProviderLimiterMetrics(registry prometheus.Registerer) *providerLimiterMetrics {
+	m := &providerLimiterMetrics{
+		waitDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: "model_provider",
+			Name:      "queue_wait_seconds",
+			Help:      "How long in seconds a task waited for model provider capacity before the model was invoked",
+			Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+		}, []string{"model_provider"}),
+		throttled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: "model_provider",
+			Name:      "throttled_total",
+			Help:      "Total number of model invocations that were deferred because the model provider had no capacity",
+		}, []string{"model_provider", "reason"}),
+	}
+
+	registry.MustRegister(m.waitDuration, m.throttled)
+	return m
+}
@@ -0,0 +1,210 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+	"k8s.io/client-go/util/workqueue"
+	_ "modernc.org/sqlite"
+)
+
+func TestProviderLimiter(t *testing.T) {
+	providerID := uuid.New()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		run      func(t *testing.T, l *ProviderLimiter, clock *time.Time) error
+		expected ThrottleReason
+		wait     time.Duration
+	}{
+		{
+			name: "no limits",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				for range 100 {
+					if _, err := l.Acquire(providerID, nil, 100_000); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			name: "max in flight",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				mustAcquire(t, l, providerID, &types.ModelProviderRateLimit{MaxInFlight: 1}, 0)
+				_, err := l.Acquire(providerID, &types.ModelProviderRateLimit{MaxInFlight: 1}, 0)
+				return err
+			},
+			expected: ThrottleReasonMaxInFlight,
+			wait:     inFlightRetryInterval,
+		},
+		{
+			name: "released permit frees in flight slot",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				permit := mustAcquire(t, l, providerID, &types.ModelProviderRateLimit{MaxInFlight: 1}, 0)
+				permit.Release(0)
+				permit.Release(0)
+				mustAcquire(t, l, providerID, &types.ModelProviderRateLimit{MaxInFlight: 1}, 0)
+				_, err := l.Acquire(providerID, &types.ModelProviderRateLimit{MaxInFlight: 1}, 0)
+				return err
+			},
+			expected: ThrottleReasonMaxInFlight,
+			wait:     inFlightRetryInterval,
+		},
+		{
+			name: "requests per minute",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				config := &types.ModelProviderRateLimit{RequestsPerMinute: 2}
+				mustAcquire(t, l, providerID, config, 0)
+				mustAcquire(t, l, providerID, config, 0)
+				_, err := l.Acquire(providerID, config, 0)
+				return err
+			},
+			expected: ThrottleReasonRequestsPerMinute,
+			wait:     30 * time.Second,
+		},
+		{
+			name: "tokens per minute",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				config := &types.ModelProviderRateLimit{TokensPerMinute: 60_000}
+				mustAcquire(t, l, providerID, config, 50_000)
+				_, err := l.Acquire(providerID, config, 20_000)
+				return err
+			},
+			expected: ThrottleReasonTokensPerMinute,
+			wait:     10 * time.Second,
+		},
+		{
+			name: "used tokens beyond estimate are charged",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				config := &types.ModelProviderRateLimit{TokensPerMinute: 60_000}
+				permit := mustAcquire(t, l, providerID, config, 10_000)
+				permit.Release(60_000)
+				_, err := l.Acquire(providerID, config, 10_000)
+				return err
+			},
+			expected: ThrottleReasonTokensPerMinute,
+			wait:     10 * time.Second,
+		},
+		{
+			name: "budget refills over time",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				config := &types.ModelProviderRateLimit{RequestsPerMinute: 1}
+				mustAcquire(t, l, providerID, config, 0)
+				*clock = clock.Add(time.Minute)
+				_, err := l.Acquire(providerID, config, 0)
+				return err
+			},
+		},
+		{
+			name: "retry after blocks provider",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				l.Backoff(providerID, 15*time.Second)
+				l.Backoff(providerID, 5*time.Second)
+				*clock = clock.Add(5 * time.Second)
+				_, err := l.Acquire(providerID, nil, 0)
+				return err
+			},
+			expected: ThrottleReasonRetryAfter,
+			wait:     10 * time.Second,
+		},
+		{
+			name: "retry after expires",
+			run: func(t *testing.T, l *ProviderLimiter, clock *time.Time) error {
+				l.Backoff(providerID, 15*time.Second)
+				*clock = clock.Add(15 * time.Second)
+				_, err := l.Acquire(providerID, nil, 0)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := start
+			limiter := NewProviderLimiter()
+			limiter.now = func() time.Time { return clock }
+
+			err := tt.run(t, limiter, &clock)
+			if tt.expected == "" {
+				if err != nil {
+					t.Fatalf("expected capacity, got error: %v", err)
+				}
+				return
+			}
+
+			var capacityError *ProviderCapacityError
+			if !errors.As(err, &capacityError) {
+				t.Fatalf("expected ProviderCapacityError, got %v", err)
+			}
+
+			if capacityError.Reason != tt.expected {
+				t.Errorf("reason = %s, want %s", capacityError.Reason, tt.expected)
+			}
+
+			if capacityError.Wait != tt.wait {
+				t.Errorf("wait = %s, want %s", capacityError.Wait, tt.wait)
+			}
+		})
+	}
+}
+
+func mustAcquire(t *testing.T, l *ProviderLimiter, providerID uuid.UUID, config *types.ModelProviderRateLimit, estimatedTokens int64) *ProviderPermit {
+	t.Helper()
+
+	permit, err := l.Acquire(providerID, config, estimatedTokens)
+	if err != nil {
+		t.Fatalf("failed to acquire capacity: %v", err)
+	}
+
+	return permit
+}
+
+func TestCapacityWaitEndsWhenTaskLeavesQueue(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:capacity_wait_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	r := &TaskReconciler{
+		memory:        db,
+		queue:         workqueue.NewTypedDelayingQueue[uuid.UUID](),
+		capacityWaits: NewSyncMap[uuid.UUID, time.Time](),
+		runningTasks:  NewSyncMap[uuid.UUID, context.CancelFunc](),
+		logger:        slog.Default(),
+	}
+
+	// The task was deleted while it was waiting for provider capacity.
+	taskID := uuid.New()
+	r.capacityWaits.Set(taskID, time.Now())
+	r.queue.Add(taskID)
+
+	r.wg.Add(1)
+	go r.worker(ctx)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for r.capacityWaits.Len() > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	r.queue.ShutDown()
+	r.wg.Wait()
+
+	if _, waiting := r.capacityWaits.Get(taskID); waiting {
+		t.Error("the deleted task is still waiting for provider capacity")
+	}
+}
@@ -12,12 +12,18 @@ import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/analytics"
 	"github.com/furisto/construct/backend/api"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/audit"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/furisto/construct/backend/skill"
 	"github.com/furisto/construct/backend/tool/codeact"
 	tooltypes "github.com/furisto/construct/backend/tool/types"
+	"github.com/furisto/construct/backend/webhook"
 	"github.com/furisto/construct/shared"
 	"github.com/google/uuid"
 	"github.com/spf13/afero"
@@ -25,6 +31,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.opentelemetry.io/otel"
 )
 
 const DefaultServerPort = 29333
@@ -34,13 +41,38 @@ type RuntimeOptions struct {
 	Concurrency  int
 	Analytics    analytics.Client
 	LoggerConfig *LoggerConfig
+	// CassetteRecorder records all model invocations if set.
+	CassetteRecorder *model.CassetteRecorder
+	ModelCallTrace   ModelCallTraceConfig
+	// MetricsAuth requires a token to scrape /metrics over TCP.
+	MetricsAuth bool
+	Tracing     TracingConfig
+	EventLog    event.EventLogOptions
+	Webhooks    webhook.Options
+	Audit       audit.Options
+	// SocketPolicy decides which local users other than the owner may use the Unix socket.
+	SocketPolicy auth.UnixSocketPolicy
+	// OIDC lets users of an OpenID Connect issuer authenticate with their tokens, if an issuer is
+	// configured.
+	OIDC auth.OIDCOptions
+	// KeysetStore keeps the encryption keyset. The key cannot be rotated through the API if it is
+	// nil.
+	KeysetStore *secret.KeysetStore
+	// CatalogSource is the URL or file the model catalog is loaded from. The catalog built into
+	// the binary is used if it is empty.
+	CatalogSource string
 }
 
 func DefaultRuntimeOptions() *RuntimeOptions {
 	return &RuntimeOptions{
-		Tools:        []codeact.Tool{},
-		Concurrency:  50,
-		LoggerConfig: DefaultLoggerConfig(),
+		Tools:          []codeact.Tool{},
+		Concurrency:    50,
+		LoggerConfig:   DefaultLoggerConfig(),
+		ModelCallTrace: DefaultModelCallTraceConfig(),
+		MetricsAuth:    true,
+		EventLog:       event.DefaultEventLogOptions(),
+		Webhooks:       webhook.DefaultOptions(),
+		Audit:          audit.DefaultOptions(),
 	}
 }
 
@@ -64,6 +96,72 @@ func WithAnalytics(analytics analytics.Client) RuntimeOption {
 	}
 }
 
+func WithCassetteRecorder(recorder *model.CassetteRecorder) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.CassetteRecorder = recorder
+	}
+}
+
+func WithModelCallTrace(config ModelCallTraceConfig) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.ModelCallTrace = config
+	}
+}
+
+func WithMetricsAuth(required bool) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.MetricsAuth = required
+	}
+}
+
+func WithTracing(config TracingConfig) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.Tracing = config
+	}
+}
+
+func WithEventLog(options event.EventLogOptions) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.EventLog = options
+	}
+}
+
+func WithWebhooks(options webhook.Options) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.Webhooks = options
+	}
+}
+
+func WithAudit(options audit.Options) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.Audit = options
+	}
+}
+
+func WithSocketPolicy(policy auth.UnixSocketPolicy) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.SocketPolicy = policy
+	}
+}
+
+func WithOIDC(options auth.OIDCOptions) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.OIDC = options
+	}
+}
+
+func WithKeysetStore(store *secret.KeysetStore) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.KeysetStore = store
+	}
+}
+
+func WithCatalogSource(source string) RuntimeOption {
+	return func(o *RuntimeOptions) {
+		o.CatalogSource = source
+	}
+}
+
 func WithLoggerConfig(config *LoggerConfig) RuntimeOption {
 	return func(o *RuntimeOptions) {
 		o.LoggerConfig = config
@@ -75,12 +173,18 @@ type Runtime struct {
 	memory         *memory.Client
 	encryption     *secret.Encryption
 	eventRouter    *event.EventRouter
+	eventLog       *event.EventLog
+	webhooks       *webhook.Dispatcher
+	auditLog       *audit.Log
 	taskReconciler *TaskReconciler
+	clientFactory  *ModelProviderFactory
 	logger         *slog.Logger
 
 	wg        sync.WaitGroup
 	analytics analytics.Client
 	metrics   *prometheus.Registry
+	// shutdownTracing flushes the spans that were not exported yet. It is nil if tracing is disabled.
+	shutdownTracing func(context.Context) error
 }
 
 func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener net.Listener, opts ...RuntimeOption) (*Runtime, error) {
@@ -104,7 +208,23 @@ func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener n
 	metricsRegistry.MustRegister(collectors.NewBuildInfoCollector())
 	metricsRegistry.MustRegister(collectors.NewDBStatsCollector(memory.MustDB(), "construct"))
 
+	var shutdownTracing func(context.Context) error
+	if options.Tracing.Exporter != TracingExporterNone {
+		tracerProvider, shutdown, err := newTracerProvider(context.Background(), options.Tracing)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up tracing: %w", err)
+		}
+		otel.SetTracerProvider(tracerProvider)
+		shutdownTracing = shutdown
+	}
+
 	eventRouter := event.NewEventRouter(event.DefaultChannelBufferSize)
+	eventRouter.RegisterMetrics(metricsRegistry)
+
+	eventLog := event.NewEventLog(memory, conv.EncodeStreamEvent, options.EventLog)
+	if err := eventRouter.EnableLog(context.Background(), eventLog); err != nil {
+		return nil, fmt.Errorf("failed to open event log: %w", err)
+	}
 
 	// Register ent hooks to emit CRUD events
 	event.RegisterHooks(memory, eventRouter)
@@ -112,30 +232,55 @@ func NewRuntime(memory *memory.Client, encryption *secret.Encryption, listener n
 	// Create tool event publisher that publishes to EventRouter
 	toolEventPublisher := newEventRouterToolPublisher(eventRouter)
 
+	auditLog := audit.NewLog(memory, options.Audit)
+
 	interceptors := []codeact.Interceptor{
 		codeact.InterceptorFunc(codeact.ToolStatisticsInterceptor),
+		codeact.NewToolMetricsInterceptor(metricsRegistry),
+		codeact.InterceptorFunc(codeact.ToolTracingInterceptor),
 		codeact.InterceptorFunc(codeact.DurableFunctionInterceptor),
 		codeact.NewToolEventPublisher(toolEventPublisher),
+		audit.NewToolInterceptor(auditLog),
 		codeact.InterceptorFunc(codeact.ResetTemporarySessionValuesInterceptor),
 	}
 
 	clientFactory := NewModelProviderFactory(encryption, memory)
+	clientFactory.recorder = options.CassetteRecorder
 	fs := afero.NewOsFs()
 
 	runtime := &Runtime{
 		memory:         memory,
 		encryption:     encryption,
 		eventRouter:    eventRouter,
+		eventLog:       eventLog,
+		webhooks:       webhook.NewDispatcher(memory, encryption, eventRouter, options.Webhooks),
+		auditLog:       auditLog,
 		taskReconciler: NewTaskReconciler(memory, codeact.NewInterpreter(options.Tools, interceptors), options.Concurrency, eventRouter, clientFactory, metricsRegistry),
+		clientFactory:  clientFactory,
 		analytics:      options.Analytics,
 		logger:         logger,
 		metrics:        metricsRegistry,
+
+		shutdownTracing: shutdownTracing,
 	}
+	runtime.taskReconciler.traceConfig = options.ModelCallTrace
 
 	userInfo := shared.NewDefaultUserInfo(fs)
 	skills := skill.NewSkillManager(fs, userInfo)
 
-	api := api.NewServer(runtime, listener, runtime.eventRouter, runtime.analytics, skills)
+	var oidcVerifier *auth.OIDCVerifier
+	if options.OIDC.Enabled() {
+		verifier, err := auth.NewOIDCVerifier(options.OIDC)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up oidc authentication: %w", err)
+		}
+		oidcVerifier = verifier
+	}
+
+	api := api.NewServer(runtime, listener, runtime.eventRouter, runtime.analytics, skills, options.SocketPolicy, oidcVerifier, auditLog, options.KeysetStore, options.CatalogSource, api.MetricsOptions{
+		Gatherer:    metricsRegistry,
+		RequireAuth: options.MetricsAuth,
+	})
 	runtime.api = api
 
 	listenerAddr := listener.Addr().String()
@@ -161,6 +306,27 @@ func (rt *Runtime) Run(ctx context.Context) error {
 		}
 	}()
 
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		LogComponentStartup(rt.logger, "event log")
+		rt.eventLog.Run(ctx)
+	}()
+
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		LogComponentStartup(rt.logger, "webhook dispatcher")
+		rt.webhooks.Run(ctx)
+	}()
+
+	rt.wg.Add(1)
+	go func() {
+		defer rt.wg.Done()
+		LogComponentStartup(rt.logger, "audit log")
+		rt.auditLog.Run(ctx)
+	}()
+
 	rt.wg.Add(1)
 	go func() {
 		defer rt.wg.Done()
@@ -195,6 +361,11 @@ func (rt *Runtime) Run(ctx context.Context) error {
 
 	select {
 	case <-stop:
+		if rt.shutdownTracing != nil {
+			if err := rt.shutdownTracing(shutdownCtx); err != nil {
+				LogError(rt.logger, "tracing shutdown", err)
+			}
+		}
 		rt.logger.Info("agent runtime shutdown complete")
 		return nil
 	case <-shutdownCtx.Done():
@@ -211,6 +382,14 @@ func (rt *Runtime) Memory() *memory.Client {
 	return rt.memory
 }
 
+func (rt *Runtime) TestModelProvider(ctx context.Context, modelProviderID uuid.UUID) (*model.ConnectivityReport, error) {
+	return rt.clientFactory.TestModelProvider(ctx, modelProviderID)
+}
+
+func (rt *Runtime) TestModelProviderCredentials(ctx context.Context, providerType types.ModelProviderType, credentials model.Credentials) (*model.ConnectivityReport, error) {
+	return rt.clientFactory.TestModelProviderCredentials(ctx, providerType, credentials)
+}
+
 func WithRole(role v1.MessageRole) func(*v1.Message) {
 	return func(msg *v1.Message) {
 		msg.Metadata.Role = role
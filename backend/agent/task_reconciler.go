@@ -16,23 +16,33 @@ import (
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
 	memory_message "github.com/furisto/construct/backend/memory/message"
+	memory_model "github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	memory_task "github.com/furisto/construct/backend/memory/task"
 	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/prompt"
+	"github.com/furisto/construct/backend/quota"
+	"github.com/furisto/construct/backend/redact"
 	"github.com/furisto/construct/backend/skill"
 	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/tool/native"
 	tooltypes "github.com/furisto/construct/backend/tool/types"
 	"github.com/furisto/construct/shared"
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/afero"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/singleflight"
 	"k8s.io/client-go/util/workqueue"
 )
 
 const ToolExecutionInProgressMarker = "__TOOL_EXECUTION_IN_PROGRESS__"
 
+// quotaRetryInterval is how long a turn waits when its owner already runs as many tasks as their
+// quota allows.
+const quotaRetryInterval = 10 * time.Second
+
 type Result struct {
 	RetryAfter time.Duration
 	Retry      bool
@@ -60,9 +70,15 @@ type TaskReconciler struct {
 	eventRouter     *event.EventRouter
 	queue           workqueue.TypedDelayingInterface[uuid.UUID]
 	providerFactory *ModelProviderFactory
+	providerLimiter *ProviderLimiter
+	limiterMetrics  *providerLimiterMetrics
+	metrics         *taskMetrics
+	capacityWaits   *SyncMap[uuid.UUID, time.Time]
+	quotas          *quota.Enforcer
 	concurrency     int
 	runningTasks    *SyncMap[uuid.UUID, context.CancelFunc]
 	titleGenGroup   singleflight.Group
+	traceConfig     ModelCallTraceConfig
 	wg              sync.WaitGroup
 	logger          *slog.Logger
 }
@@ -87,9 +103,15 @@ func NewTaskReconciler(
 		interpreter:     interpreter,
 		eventRouter:     eventRouter,
 		providerFactory: providerFactory,
+		providerLimiter: NewProviderLimiter(),
+		limiterMetrics:  newProviderLimiterMetrics(metricsRegistry),
+		metrics:         newTaskMetrics(metricsRegistry),
+		capacityWaits:   NewSyncMap[uuid.UUID, time.Time](),
+		quotas:          quota.NewEnforcer(memory),
 		queue:           queue,
 		concurrency:     concurrency,
 		runningTasks:    NewSyncMap[uuid.UUID, context.CancelFunc](),
+		traceConfig:     DefaultModelCallTraceConfig(),
 		logger:          slog.With(KeyComponent, "task_reconciler"),
 	}
 }
@@ -110,12 +132,14 @@ func (r *TaskReconciler) Run(ctx context.Context) error {
 	taskTriggerCh, cancelTaskTrigger := r.eventRouter.Subscribe(ctx, event.SubscribeOptions{
 		EventTypes: []string{event.EventTypeInternalTaskTrigger},
 		Internal:   true,
+		Subscriber: "task_reconciler",
 	})
 
 	// Subscribe to internal task suspend events
 	taskSuspendCh, cancelTaskSuspend := r.eventRouter.Subscribe(ctx, event.SubscribeOptions{
 		EventTypes: []string{event.EventTypeInternalTaskSuspend},
 		Internal:   true,
+		Subscriber: "task_reconciler",
 	})
 
 	// Process task trigger events
@@ -186,7 +210,13 @@ func (r *TaskReconciler) worker(ctx context.Context) {
 			return
 		}
 
-		result, err := r.reconcile(ctx, taskID)
+		// Every reconcile pass is a trace of its own.
+		reconcileCtx, span := tracer.Start(ctx, "reconcile",
+			trace.WithNewRoot(),
+			trace.WithAttributes(attribute.String("task.id", taskID.String())),
+		)
+		result, err := r.reconcile(reconcileCtx, taskID)
+		endSpan(span, err)
 		if err != nil {
 			r.logger.ErrorContext(ctx, "task reconciliation failed",
 				KeyTaskID, taskID,
@@ -207,6 +237,10 @@ func (r *TaskReconciler) worker(ctx context.Context) {
 				KeyTaskID, taskID,
 			)
 			r.queue.Add(taskID)
+		default:
+			// The task leaves the queue, e.g. because it was cancelled or deleted while it was
+			// waiting for provider capacity, so it no longer waits.
+			r.capacityWaits.Delete(taskID)
 		}
 
 		r.queue.Done(taskID)
@@ -249,8 +283,10 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 	defer r.runningTasks.Delete(taskID)
 	defer cancel()
 
-	task, agent, err := r.fetchTaskWithAgent(ctx, taskID)
+	historyCtx, historySpan := tracer.Start(ctx, "load_history")
+	task, agent, err := r.fetchTaskWithAgent(historyCtx, taskID)
 	if err != nil {
+		endSpan(historySpan, err)
 		LogError(logger, "failed to fetch task with agent", err)
 		return Result{}, fmt.Errorf("failed to fetch task: %w", err)
 	}
@@ -262,7 +298,9 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 	messages, err := r.memory.Message.Query().
 		Where(memory_message.TaskIDEQ(taskID)).
 		Order(memory_message.ByCreateTime()).
-		All(ctx)
+		All(historyCtx)
+	historySpan.SetAttributes(attribute.Int("message_count", len(messages)))
+	endSpan(historySpan, err)
 	if err != nil {
 		LogError(logger, "failed to fetch messages", err)
 		return Result{}, fmt.Errorf("failed to fetch messages: %w", err)
@@ -287,8 +325,11 @@ func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Resul
 		KeyProcessedCount, len(status.ProcessedMessages),
 	)
 
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("task.phase", string(status.Phase)))
+
 	r.setTaskPhaseAndPublish(ctx, taskID, status.Phase)
 	defer r.setTaskPhaseAndPublish(ctx, taskID, TaskPhaseAwaitInput)
+	defer r.metrics.enterPhase(status.Phase)()
 
 	switch status.Phase {
 	case TaskPhaseAwaitInput:
@@ -405,14 +446,20 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	reconcileStart := time.Now()
 	logger.InfoContext(ctx, "model invocation phase started")
 
+	if err := r.quotas.Check(ctx, task.Owner, taskID); err != nil {
+		return r.handleQuotaExceeded(ctx, logger, taskID, err)
+	}
+
 	// Publish user message event so frontend can display it
 	if status.NextMessage.Source == types.MessageSourceUser {
 		logger.DebugContext(ctx, "processing user message")
 		r.publishMessageCreated(status.NextMessage)
 	}
 
+	promptCtx, promptSpan := tracer.Start(ctx, "assemble_prompt")
 	modelMessages, err := r.buildMessageHistory(status.ProcessedMessages, status.NextMessage)
 	if err != nil {
+		endSpan(promptSpan, err)
 		LogError(logger, "failed to build message history", err)
 		return Result{}, fmt.Errorf("failed to prepare model messages: %w", err)
 	}
@@ -420,41 +467,117 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		"history_length", len(modelMessages),
 	)
 
-	modelProvider, err := r.providerFactory.CreateClient(ctx, agent.Edges.Model.ModelProviderID)
+	systemPrompt, err := r.assembleSystemPrompt(promptCtx, agent.Instructions, task.ProjectDirectory)
+	endSpan(promptSpan, err)
 	if err != nil {
-		LogError(logger, "failed to create model provider", err)
-		return Result{}, fmt.Errorf("failed to create model provider: %w", err)
+		LogError(logger, "failed to assemble system prompt", err)
+		return Result{}, fmt.Errorf("failed to assemble system prompt: %w", err)
+	}
+
+	// Routing, the provider limiter and the preflight check all use the same approximation, so
+	// that they agree about the size of the prompt.
+	estimatedTokens := model.ApproximateTokens(systemPrompt, modelMessages, []native.Tool{r.interpreter})
+	routing := RouteModel(agent.ModelRouter, agent.ModelID, RoutingInput{
+		TurnType:              ClassifyTurn(status.ProcessedMessages, status.NextMessage),
+		EstimatedPromptTokens: estimatedTokens,
+		RecentToolFailures:    CountRecentToolFailures(status.ProcessedMessages, status.NextMessage),
+	})
+	if agent.ModelRouter != nil {
+		logger.InfoContext(ctx, "model routing decision",
+			KeyRoutingRule, routing.Rule,
+			KeyTurnType, string(routing.TurnType),
+			KeyEstimatedTokens, routing.EstimatedPromptTokens,
+			KeyToolFailures, routing.RecentToolFailures,
+			KeyRoutedModel, routing.ModelID,
+		)
 	}
 
-	systemPrompt, err := r.assembleSystemPrompt(ctx, agent.Instructions, task.ProjectDirectory)
+	modelChain, err := r.resolveModelChain(ctx, agent, routing.ModelID)
 	if err != nil {
-		LogError(logger, "failed to assemble system prompt", err)
-		return Result{}, fmt.Errorf("failed to assemble system prompt: %w", err)
+		LogError(logger, "failed to resolve model chain", err)
+		return Result{}, fmt.Errorf("failed to resolve model chain: %w", err)
 	}
 
 	LogOperationStart(logger, "invoke model")
 	invokeStart := time.Now()
 
-	// Initialize streaming state for message chunks
-	streamState := &streamingState{
-		messageID:  uuid.New(), // Pre-generate ID for streaming chunks
-		chunkIndex: 0,
-	}
-
-	message, err := modelProvider.InvokeModel(
-		ctx,
-		agent.Edges.Model.Name,
-		systemPrompt,
-		modelMessages,
-		model.WithTools(r.interpreter),
-		model.WithStreamHandler(func(ctx context.Context, chunk string) {
-			r.publishMessageChunk(taskID, streamState, chunk)
-		}),
+	var (
+		message      *model.Message
+		servingModel *memory.Model
+		estimate     model.TokenEstimate
+		capacityWait time.Duration
 	)
+	for i, candidate := range modelChain {
+		servingModel = candidate
+		invokeCtx, span := tracer.Start(ctx, "invoke_model", trace.WithAttributes(attribute.String("model", candidate.Name)))
+		message, estimate, err = r.invokeModel(invokeCtx, task, candidate, systemPrompt, modelMessages, estimatedTokens)
+		endSpan(span, err)
+		if err == nil {
+			break
+		}
+
+		// If the task has to be requeued, it is retried once the first provider has capacity again.
+		var capacityError *ProviderCapacityError
+		if errors.As(err, &capacityError) && (capacityWait == 0 || capacityError.Wait < capacityWait) {
+			capacityWait = capacityError.Wait
+		}
+
+		if i == len(modelChain)-1 {
+			break
+		}
+		next := modelChain[i+1]
+
+		if capacityError != nil {
+			logger.InfoContext(ctx, "model provider has no capacity, falling back to next model",
+				KeyModelProvider, capacityError.ProviderID,
+				KeyThrottleReason, string(capacityError.Reason),
+				KeyFailedModel, candidate.Name,
+				KeyFallbackModel, next.Name,
+			)
+			r.publishModelFallback(taskID, candidate, next, fallbackReasonNoCapacity, err)
+			continue
+		}
+
+		// A model with a larger context window might still fit the prompt.
+		var overflowError *ContextOverflowError
+		if errors.As(err, &overflowError) {
+			logger.WarnContext(ctx, "prompt does not fit into the context window, falling back to next model",
+				KeyError, err.Error(),
+				KeyFailedModel, candidate.Name,
+				KeyFallbackModel, next.Name,
+			)
+			r.publishModelFallback(taskID, candidate, next, fallbackReasonContextOverflow, err)
+			continue
+		}
+
+		var providerError *model.ProviderError
+		if !errors.As(err, &providerError) || !providerError.ShouldFallback() {
+			break
+		}
+
+		logger.WarnContext(ctx, "model unavailable, falling back to next model",
+			KeyError, err.Error(),
+			KeyErrorType, string(providerError.Kind),
+			KeyFailedModel, candidate.Name,
+			KeyFallbackModel, next.Name,
+		)
+		r.publishModelFallback(taskID, candidate, next, string(providerError.Kind), providerError)
+	}
 	LogOperationEnd(logger, "invoke model", invokeStart)
 
 	if err != nil {
+		var capacityError *ProviderCapacityError
+		if errors.As(err, &capacityError) {
+			logger.InfoContext(ctx, "no model of the chain has capacity, requeueing task",
+				KeyModelProvider, capacityError.ProviderID,
+				KeyThrottleReason, string(capacityError.Reason),
+				KeyRetryAfter, capacityWait.Milliseconds(),
+			)
+			return Result{RetryAfter: capacityWait}, nil
+		}
+
 		if errors.Is(err, context.Canceled) {
+			r.capacityWaits.Delete(taskID)
 			logger.InfoContext(ctx, "model invocation cancelled by user")
 			_, err = memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
 				err := r.markMessageAsProcessed(ctx, status.NextMessage)
@@ -481,7 +604,8 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 		return Result{}, err
 	}
 
-	cost := calculateCost(message.Usage, agent.Edges.Model)
+	cost := calculateCost(message.Usage, servingModel)
+	r.metrics.observeUsage(agent, servingModel.Name, message.Usage, cost)
 
 	LogTokenUsage(logger, slog.LevelInfo,
 		message.Usage.InputTokens,
@@ -498,7 +622,19 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 			return nil, fmt.Errorf("failed to mark message as processed: %w", err)
 		}
 
-		modelMessage, err := r.persistModelResponse(ctx, taskID, message, cost)
+		var messageRouting *types.MessageRouting
+		if agent.ModelRouter != nil {
+			messageRouting = &types.MessageRouting{
+				Rule:                  routing.Rule,
+				TurnType:              routing.TurnType,
+				EstimatedPromptTokens: routing.EstimatedPromptTokens,
+				RecentToolFailures:    routing.RecentToolFailures,
+				DefaultModelID:        agent.ModelID,
+				DefaultModelCost:      calculateCost(message.Usage, agent.Edges.Model),
+			}
+		}
+
+		modelMessage, err := r.persistModelResponse(ctx, taskID, agent.ID, servingModel, message, estimate, cost, messageRouting)
 		if err != nil {
 			return nil, fmt.Errorf("failed to persist model response: %w", err)
 		}
@@ -518,6 +654,220 @@ func (r *TaskReconciler) reconcileInvokeModel(ctx context.Context, taskID uuid.U
 	return Result{Retry: true}, nil
 }
 
+// handleQuotaExceeded holds back the turn of a task whose owner used up their quota. A turn that
+// waits for another task of the owner to finish is retried, a task that ran out of spend or
+// tokens is suspended until a new message is sent to it.
+func (r *TaskReconciler) handleQuotaExceeded(ctx context.Context, logger *slog.Logger, taskID uuid.UUID, err error) (Result, error) {
+	var exceeded *quota.ExceededError
+	if !errors.As(err, &exceeded) {
+		return Result{}, fmt.Errorf("failed to check quota: %w", err)
+	}
+
+	if exceeded.Limit == quota.LimitConcurrentTasks {
+		logger.InfoContext(ctx, "owner has too many running tasks, requeueing task",
+			KeyError, exceeded.Error(),
+			KeyRetryAfter, quotaRetryInterval.Milliseconds(),
+		)
+		return Result{RetryAfter: quotaRetryInterval}, nil
+	}
+
+	logger.WarnContext(ctx, "owner exceeded their quota, suspending task", KeyError, exceeded.Error())
+	if err := r.memory.Task.UpdateOneID(taskID).SetDesiredPhase(types.TaskPhaseSuspended).Exec(ctx); err != nil {
+		return Result{}, fmt.Errorf("failed to suspend task: %w", err)
+	}
+	return Result{}, nil
+}
+
+// resolveModelChain returns the models that are tried in order for a single model invocation:
+// the model chosen by the router, the agent's default model and its enabled fallback models.
+func (r *TaskReconciler) resolveModelChain(ctx context.Context, agent *memory.Agent, routedModelID uuid.UUID) ([]*memory.Model, error) {
+	candidateIDs := append([]uuid.UUID{routedModelID, agent.ModelID}, agent.FallbackModelIds...)
+	if routedModelID == agent.ModelID && len(agent.FallbackModelIds) == 0 {
+		return []*memory.Model{agent.Edges.Model}, nil
+	}
+
+	models, err := r.memory.Model.Query().
+		Where(
+			memory_model.IDIn(candidateIDs...),
+			memory_model.Enabled(true),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	modelsByID := make(map[uuid.UUID]*memory.Model, len(models)+1)
+	for _, m := range models {
+		modelsByID[m.ID] = m
+	}
+	// The default model is always part of the chain, just like before routing or fallbacks were configured.
+	modelsByID[agent.ModelID] = agent.Edges.Model
+
+	var chain []*memory.Model
+	seen := make(map[uuid.UUID]bool, len(candidateIDs))
+	for _, id := range candidateIDs {
+		m, ok := modelsByID[id]
+		if !ok || seen[id] {
+			continue
+		}
+		seen[id] = true
+		chain = append(chain, m)
+	}
+
+	return chain, nil
+}
+
+// invokeModel sends the conversation to a single model of the agent's model chain. If the
+// model's provider has no capacity left, a ProviderCapacityError is returned instead of waiting.
+func (r *TaskReconciler) invokeModel(ctx context.Context, task *memory.Task, m *memory.Model, systemPrompt string, modelMessages []*model.Message, estimatedTokens int64) (*model.Message, model.TokenEstimate, error) {
+	taskID := task.ID
+	provider, err := r.memory.ModelProvider.Get(ctx, m.ModelProviderID)
+	if err != nil {
+		return nil, model.TokenEstimate{}, fmt.Errorf("failed to fetch model provider: %w", err)
+	}
+
+	permit, err := r.acquireProviderCapacity(taskID, provider, estimatedTokens)
+	if err != nil {
+		return nil, model.TokenEstimate{}, err
+	}
+
+	modelProvider, err := r.providerFactory.CreateClient(ctx, m.ModelProviderID)
+	if err != nil {
+		permit.Release(0)
+		return nil, model.TokenEstimate{}, fmt.Errorf("failed to create model provider: %w", err)
+	}
+
+	preflight, err := r.preflightPrompt(ctx, modelProvider, m, systemPrompt, modelMessages, r.interpreter)
+	if err != nil {
+		permit.Release(0)
+		return nil, model.TokenEstimate{}, err
+	}
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("model_provider", provider.Name),
+		attribute.Int64("estimated_input_tokens", preflight.Estimate.Tokens),
+		attribute.String("estimate_source", string(preflight.Estimate.Source)),
+	)
+	if preflight.TruncatedToolResults > 0 || preflight.DroppedMessages > 0 {
+		r.logger.WarnContext(ctx, "prompt shrunk to fit into the context window",
+			KeyTaskID, taskID,
+			KeyModel, m.Name,
+			KeyEstimatedTokens, preflight.Estimate.Tokens,
+			"truncated_tool_results", preflight.TruncatedToolResults,
+			"dropped_messages", preflight.DroppedMessages,
+		)
+	}
+
+	// Each attempt streams under its own message ID so that chunks of a failed attempt
+	// are not mixed with the chunks of the model that takes over.
+	streamState := &streamingState{
+		messageID:  uuid.New(),
+		chunkIndex: 0,
+	}
+
+	invokeCtx, capture := r.captureModelCalls(ctx, task)
+	attempts := startAttemptSpans(ctx)
+	invokeStart := time.Now()
+	message, err := modelProvider.InvokeModel(
+		invokeCtx,
+		m.Name,
+		systemPrompt,
+		preflight.Messages,
+		model.WithTools(r.interpreter),
+		model.WithStreamHandler(func(ctx context.Context, chunk string) {
+			r.publishMessageChunk(taskID, streamState, chunk)
+		}),
+		model.WithRetryCallback(attempts.retry),
+	)
+	attempts.end(err)
+	r.metrics.observeModelCall(provider, m.Name, time.Since(invokeStart), err)
+	r.persistModelCallTraces(ctx, taskID, m, capture)
+	if err != nil {
+		permit.Release(0)
+
+		var providerError *model.ProviderError
+		if errors.As(err, &providerError) && providerError.Kind == model.ProviderErrorKindRateLimitExceeded {
+			r.markKeyRateLimited(ctx, modelProvider.KeyID)
+		}
+
+		// Retry-After applies to the whole provider, so every task that uses it has to wait. Providers
+		// with several keys can switch to a different key instead.
+		if providerError != nil && providerError.RetryAfter > 0 && modelProvider.ActiveKeys <= 1 &&
+			(providerError.Kind == model.ProviderErrorKindRateLimitExceeded || providerError.Kind == model.ProviderErrorKindOverloaded) {
+			r.providerLimiter.Backoff(provider.ID, providerError.RetryAfter)
+		}
+		return nil, model.TokenEstimate{}, err
+	}
+
+	span.SetAttributes(
+		attribute.Int64("input_tokens", message.Usage.InputTokens),
+		attribute.Int64("output_tokens", message.Usage.OutputTokens),
+		attribute.Int64("cache_write_tokens", message.Usage.CacheWriteTokens),
+		attribute.Int64("cache_read_tokens", message.Usage.CacheReadTokens),
+	)
+	permit.Release(message.Usage.InputTokens + message.Usage.CacheWriteTokens + message.Usage.OutputTokens)
+	r.recordKeyUsage(ctx, modelProvider.KeyID, message.Usage, calculateCost(message.Usage, m))
+	return message, preflight.Estimate, nil
+}
+
+// recordKeyUsage attributes the usage of a model invocation to the API key that served it.
+func (r *TaskReconciler) recordKeyUsage(ctx context.Context, keyID uuid.UUID, usage model.Usage, cost float64) {
+	if keyID == uuid.Nil {
+		return
+	}
+
+	err := r.memory.ModelProviderKey.UpdateOneID(keyID).
+		AddRequestCount(1).
+		AddInputTokens(usage.InputTokens).
+		AddOutputTokens(usage.OutputTokens).
+		AddCacheWriteTokens(usage.CacheWriteTokens).
+		AddCacheReadTokens(usage.CacheReadTokens).
+		AddCost(cost).
+		SetLastUsedTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		LogError(r.logger, "record model provider key usage", err, KeyProviderKey, keyID)
+	}
+}
+
+func (r *TaskReconciler) markKeyRateLimited(ctx context.Context, keyID uuid.UUID) {
+	if keyID == uuid.Nil {
+		return
+	}
+
+	err := r.memory.ModelProviderKey.UpdateOneID(keyID).
+		SetLastRateLimitedTime(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		LogError(r.logger, "mark model provider key as rate limited", err, KeyProviderKey, keyID)
+	}
+}
+
+// acquireProviderCapacity reserves capacity for a model invocation and records how long the
+// task had to wait for it.
+func (r *TaskReconciler) acquireProviderCapacity(taskID uuid.UUID, provider *memory.ModelProvider, estimatedTokens int64) (*ProviderPermit, error) {
+	permit, err := r.providerLimiter.Acquire(provider.ID, provider.RateLimit, estimatedTokens)
+	if err != nil {
+		var capacityError *ProviderCapacityError
+		if errors.As(err, &capacityError) {
+			r.limiterMetrics.throttled.WithLabelValues(provider.Name, string(capacityError.Reason)).Inc()
+			if _, waiting := r.capacityWaits.Get(taskID); !waiting {
+				r.capacityWaits.Set(taskID, time.Now())
+			}
+		}
+		return nil, err
+	}
+
+	var waited time.Duration
+	if since, ok := r.capacityWaits.Get(taskID); ok {
+		waited = time.Since(since)
+		r.capacityWaits.Delete(taskID)
+	}
+	r.limiterMetrics.waitDuration.WithLabelValues(provider.Name).Observe(waited.Seconds())
+
+	return permit, nil
+}
+
 func (r *TaskReconciler) buildMessageHistory(processedMessages []*memory.Message, nextMessage *memory.Message) ([]*model.Message, error) {
 	modelMessages := make([]*model.Message, 0, len(processedMessages)+1)
 
@@ -610,7 +960,7 @@ func (r *TaskReconciler) discoverSkills(ctx context.Context, cwd string) string
 	return formatSkills(skills)
 }
 
-func (r *TaskReconciler) persistModelResponse(ctx context.Context, taskID uuid.UUID, modelResponse *model.Message, cost float64) (*memory.Message, error) {
+func (r *TaskReconciler) persistModelResponse(ctx context.Context, taskID, agentID uuid.UUID, servingModel *memory.Model, modelResponse *model.Message, estimate model.TokenEstimate, cost float64, routing *types.MessageRouting) (*memory.Message, error) {
 	message, err := memory.Transaction(ctx, r.memory, func(tx *memory.Client) (*memory.Message, error) {
 		memoryContent, err := ConvertModelContentBlocksToMemory(modelResponse.Content)
 		if err != nil {
@@ -619,16 +969,28 @@ func (r *TaskReconciler) persistModelResponse(ctx context.Context, taskID uuid.U
 
 		assistantMsg := tx.Message.Create().
 			SetTaskID(taskID).
+			SetAgentID(agentID).
+			SetModelID(servingModel.ID).
 			SetSource(types.MessageSourceAssistant).
 			SetContent(memoryContent).
 			SetUsage(&types.MessageUsage{
-				InputTokens:      modelResponse.Usage.InputTokens,
-				OutputTokens:     modelResponse.Usage.OutputTokens,
-				CacheWriteTokens: modelResponse.Usage.CacheWriteTokens,
-				CacheReadTokens:  modelResponse.Usage.CacheReadTokens,
-				Cost:             cost,
+				InputTokens:          modelResponse.Usage.InputTokens,
+				OutputTokens:         modelResponse.Usage.OutputTokens,
+				CacheWriteTokens:     modelResponse.Usage.CacheWriteTokens,
+				CacheReadTokens:      modelResponse.Usage.CacheReadTokens,
+				Cost:                 cost,
+				EstimatedInputTokens: estimate.Tokens,
+				EstimateSource:       string(estimate.Source),
 			})
 
+		if routing != nil {
+			assistantMsg = assistantMsg.SetRouting(routing)
+		}
+
+		if id := traceID(ctx); id != "" {
+			assistantMsg = assistantMsg.SetTraceID(id)
+		}
+
 		// If no tool calls, mark as processed immediately
 		if !hasToolCalls(modelResponse.Content) {
 			assistantMsg = assistantMsg.SetProcessedTime(time.Now())
@@ -639,13 +1001,20 @@ func (r *TaskReconciler) persistModelResponse(ctx context.Context, taskID uuid.U
 			return nil, err
 		}
 
-		_, err = tx.Task.UpdateOneID(taskID).
+		taskUpdate := tx.Task.UpdateOneID(taskID).
 			AddInputTokens(modelResponse.Usage.InputTokens).
 			AddOutputTokens(modelResponse.Usage.OutputTokens).
 			AddCacheWriteTokens(modelResponse.Usage.CacheWriteTokens).
 			AddCacheReadTokens(modelResponse.Usage.CacheReadTokens).
-			AddCost(cost).
-			Save(ctx)
+			AddCost(cost)
+
+		if routing != nil && routing.Rule != "" {
+			taskUpdate = taskUpdate.
+				AddRoutedTurns(1).
+				AddRoutingSavings(routing.DefaultModelCost - cost)
+		}
+
+		_, err = taskUpdate.Save(ctx)
 
 		if err != nil {
 			return nil, err
@@ -726,6 +1095,7 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 
 	var toolResults []*tooltypes.ToolResult
 	toolStats := make(map[string]int64)
+	redactor := r.redactor(ctx, logger, task)
 
 	for _, block := range message.Content.Blocks {
 		switch block.Kind {
@@ -743,10 +1113,13 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 				logInterpreterArgs(ctx, task.ID, toolCall.Provider.ID, inputJSON)
 
 				toolStart := time.Now()
-				result, err := r.interpreter.Interpret(ctx, afero.NewOsFs(), toolCall.Input.Interpreter, &codeact.Task{
+				interpretCtx, span := tracer.Start(ctx, "interpret")
+				result, err := r.interpreter.Interpret(interpretCtx, afero.NewOsFs(), toolCall.Input.Interpreter, &codeact.Task{
 					ID:               task.ID,
 					ProjectDirectory: task.ProjectDirectory,
+					Redactor:         redactor,
 				})
+				endSpan(span, err)
 				toolDuration := time.Since(toolStart)
 
 				if errors.Is(ctx.Err(), context.Canceled) {
@@ -779,6 +1152,11 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 						ID:   toolCall.Provider.ID,
 					},
 				}
+				// Secrets are removed before the result is logged, stored and sent to the model.
+				if err := redactor.Value(toolResult); err != nil {
+					LogError(logger, "redact tool result", err)
+					return nil, nil, fmt.Errorf("failed to redact tool result: %w", err)
+				}
 				toolResults = append(toolResults, toolResult)
 
 				for tool, count := range result.ToolStats {
@@ -801,6 +1179,41 @@ func (r *TaskReconciler) callTools(ctx context.Context, task *memory.Task, messa
 	return toolResults, toolStats, nil
 }
 
+// redactor returns the redactor for the tool results of the task, as configured by its agent. The
+// credentials of the model providers are always redacted, if they can be decrypted.
+func (r *TaskReconciler) redactor(ctx context.Context, logger *slog.Logger, task *memory.Task) *redact.Redactor {
+	agent := task.Edges.Agent
+	if agent == nil {
+		var err error
+		agent, err = r.memory.Agent.Get(ctx, task.AgentID)
+		if err != nil {
+			LogError(logger, "fetch agent for redaction", err)
+		}
+	}
+
+	var opts redact.Options
+	if agent != nil && agent.Redaction != nil {
+		if agent.Redaction.Disabled {
+			return nil
+		}
+
+		patterns, err := redact.CompilePatterns(agent.Redaction.Patterns)
+		if err != nil {
+			LogError(logger, "compile redaction patterns", err)
+		}
+		opts.HighEntropyStrings = agent.Redaction.HighEntropyStrings
+		opts.Patterns = patterns
+	}
+
+	secrets, err := r.providerFactory.ProviderSecrets(ctx)
+	if err != nil {
+		LogError(logger, "load model provider secrets", err)
+	}
+	opts.Secrets = secrets
+
+	return redact.New(opts)
+}
+
 func (r *TaskReconciler) persistToolResults(ctx context.Context, taskID uuid.UUID, toolResults []*tooltypes.ToolResult, tx *memory.Client) (*memory.Message, error) {
 	toolBlocks := make([]types.MessageBlock, 0, len(toolResults))
 	for _, toolResult := range toolResults {
@@ -925,6 +1338,15 @@ func (r *TaskReconciler) publishMessageChunk(taskID uuid.UUID, state *streamingS
 	state.chunkIndex++
 }
 
+// publishModelFallback publishes a task.model_fallback event when a model of the chain is skipped.
+func (r *TaskReconciler) publishModelFallback(taskID uuid.UUID, from, to *memory.Model, reason string, err error) {
+	if r.eventRouter == nil {
+		return
+	}
+
+	r.eventRouter.Publish(event.NewTaskModelFallbackEvent(taskID, from.ID, to.ID, reason, err))
+}
+
 // publishMessageCreated publishes a message.created event for a persisted message.
 func (r *TaskReconciler) publishMessageCreated(message *memory.Message) {
 	if r.eventRouter == nil {
@@ -0,0 +1,215 @@
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	memory_message "github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/model"
+	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	_ "modernc.org/sqlite"
+)
+
+const fallbackAnswer = "Hello from the fallback model."
+
+// fallbackSetup is a task with an unprocessed user message whose agent falls back from the model
+// of the primary provider to the model of the fallback provider. Both providers are mock providers
+// that answer with the given scripts.
+type fallbackSetup struct {
+	db         *memory.Client
+	reconciler *TaskReconciler
+	events     <-chan *event.StreamEvent
+	primary    *memory.Model
+	fallback   *memory.Model
+	task       *memory.Task
+}
+
+func setupFallback(t *testing.T, name, primaryScript, fallbackScript string) *fallbackSetup {
+	t.Helper()
+	ctx := context.Background()
+
+	// The reconciler queries the database while it holds a transaction, so every connection has to
+	// see the same in-memory database.
+	db, err := memory.Open(dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	mockModel := func(providerName, script string) *memory.Model {
+		scriptPath := filepath.Join(t.TempDir(), providerName+".yaml")
+		if err := os.WriteFile(scriptPath, []byte(script), 0600); err != nil {
+			t.Fatalf("failed to write mock script: %v", err)
+		}
+
+		provider := test.NewModelProviderBuilder(t, uuid.New(), db).
+			WithName(providerName).
+			WithProviderType(types.ModelProviderTypeMock).
+			Build(ctx)
+		provider = db.ModelProvider.UpdateOne(provider).SetURL(scriptPath).SaveX(ctx)
+
+		return test.NewModelBuilder(t, uuid.New(), db, provider).WithName(model.MockModel).Build(ctx)
+	}
+
+	primary := mockModel("primary", primaryScript)
+	fallback := mockModel("fallback", fallbackScript)
+
+	agent := test.NewAgentBuilder(t, uuid.New(), db, primary).Build(ctx)
+	agent = db.Agent.UpdateOne(agent).SetFallbackModelIds([]uuid.UUID{fallback.ID}).SaveX(ctx)
+
+	task := test.NewTaskBuilder(t, uuid.New(), db, agent).WithProjectDirectory(t.TempDir()).Build(ctx)
+	// Tasks with a description do not get a generated title.
+	task = db.Task.UpdateOne(task).SetDescription(name).SaveX(ctx)
+	test.NewMessageBuilder(t, uuid.New(), db, task).Build(ctx)
+
+	router := event.NewEventRouter(event.DefaultChannelBufferSize)
+	events, unsubscribe := router.Subscribe(ctx, event.SubscribeOptions{
+		EventTypes: []string{event.EventTypeTaskModelFallback},
+		TaskID:     task.ID.String(),
+	})
+	t.Cleanup(unsubscribe)
+
+	reconciler := NewTaskReconciler(db, codeact.NewInterpreter(nil, nil), 1, router, NewModelProviderFactory(nil, db), prometheus.NewRegistry())
+
+	return &fallbackSetup{
+		db:         db,
+		reconciler: reconciler,
+		events:     events,
+		primary:    primary,
+		fallback:   fallback,
+		task:       task,
+	}
+}
+
+// fallbackEvents returns the task.model_fallback events that were published so far.
+func (s *fallbackSetup) fallbackEvents() []*event.TaskModelFallbackPayload {
+	var payloads []*event.TaskModelFallbackPayload
+	for {
+		select {
+		case e := <-s.events:
+			payloads = append(payloads, e.Payload.(*event.TaskModelFallbackPayload))
+		default:
+			return payloads
+		}
+	}
+}
+
+// modelMessages returns the messages of the task that were created by a model.
+func (s *fallbackSetup) modelMessages(t *testing.T) []*memory.Message {
+	t.Helper()
+
+	return s.db.Message.Query().
+		Where(
+			memory_message.TaskID(s.task.ID),
+			memory_message.SourceEQ(types.MessageSourceAssistant),
+		).
+		AllX(context.Background())
+}
+
+func TestModelFallback(t *testing.T) {
+	ctx := context.Background()
+	script := "turns:\n  - text: " + fallbackAnswer + "\nchunk_delay: 0s\n"
+
+	for _, kind := range []model.ProviderErrorKind{
+		model.ProviderErrorKindOverloaded,
+		model.ProviderErrorKindRateLimitExceeded,
+		model.ProviderErrorKindCircuitOpen,
+	} {
+		t.Run(string(kind), func(t *testing.T) {
+			s := setupFallback(t, "model_fallback_"+string(kind)+"_test", "turns:\n  - error: "+string(kind)+"\n", script)
+
+			result, err := s.reconciler.reconcile(ctx, s.task.ID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.RetryAfter != 0 {
+				t.Errorf("task was requeued after %s although the fallback model served the turn", result.RetryAfter)
+			}
+
+			messages := s.modelMessages(t)
+			if len(messages) != 1 {
+				t.Fatalf("expected 1 model message, got %d", len(messages))
+			}
+			if messages[0].ModelID != s.fallback.ID {
+				t.Errorf("message was served by model %s, want fallback model %s", messages[0].ModelID, s.fallback.ID)
+			}
+			if len(messages[0].Content.Blocks) != 1 || messages[0].Content.Blocks[0].Payload != fallbackAnswer {
+				t.Errorf("message does not contain the answer of the fallback model: %+v", messages[0].Content)
+			}
+
+			fallbacks := s.fallbackEvents()
+			if len(fallbacks) != 1 {
+				t.Fatalf("expected 1 fallback event, got %d", len(fallbacks))
+			}
+			if fallbacks[0].FromModelID != s.primary.ID || fallbacks[0].ToModelID != s.fallback.ID || fallbacks[0].Reason != string(kind) {
+				t.Errorf("unexpected fallback event: %+v", fallbacks[0])
+			}
+		})
+	}
+}
+
+func TestModelFallbackOnMissingCapacity(t *testing.T) {
+	ctx := context.Background()
+	script := "turns:\n  - text: " + fallbackAnswer + "\nchunk_delay: 0s\n"
+
+	t.Run("fallback model serves the turn", func(t *testing.T) {
+		s := setupFallback(t, "capacity_fallback_test", script, script)
+		s.reconciler.providerLimiter.Backoff(s.primary.ModelProviderID, time.Minute)
+
+		result, err := s.reconciler.reconcile(ctx, s.task.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.RetryAfter != 0 {
+			t.Errorf("task was requeued after %s although the fallback model has capacity", result.RetryAfter)
+		}
+
+		messages := s.modelMessages(t)
+		if len(messages) != 1 {
+			t.Fatalf("expected 1 model message, got %d", len(messages))
+		}
+		if messages[0].ModelID != s.fallback.ID {
+			t.Errorf("message was served by model %s, want fallback model %s", messages[0].ModelID, s.fallback.ID)
+		}
+
+		fallbacks := s.fallbackEvents()
+		if len(fallbacks) != 1 {
+			t.Fatalf("expected 1 fallback event, got %d", len(fallbacks))
+		}
+		if fallbacks[0].FromModelID != s.primary.ID || fallbacks[0].ToModelID != s.fallback.ID || fallbacks[0].Reason != fallbackReasonNoCapacity {
+			t.Errorf("unexpected fallback event: %+v", fallbacks[0])
+		}
+	})
+
+	t.Run("task is requeued when no model has capacity", func(t *testing.T) {
+		s := setupFallback(t, "capacity_exhausted_test", script, script)
+		s.reconciler.providerLimiter.Backoff(s.primary.ModelProviderID, time.Minute)
+		s.reconciler.providerLimiter.Backoff(s.fallback.ModelProviderID, 30*time.Second)
+
+		result, err := s.reconciler.reconcile(ctx, s.task.ID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.RetryAfter <= 0 || result.RetryAfter > 30*time.Second {
+			t.Errorf("task should be retried once the fallback provider has capacity, got %s", result.RetryAfter)
+		}
+
+		if messages := s.modelMessages(t); len(messages) != 0 {
+			t.Errorf("expected no model message, got %d", len(messages))
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/furisto/construct/backend/agent")
+
+type TracingExporter string
+
+const (
+	TracingExporterNone TracingExporter = ""
+	// TracingExporterOTLP sends traces to an OpenTelemetry collector over OTLP/HTTP.
+	TracingExporterOTLP TracingExporter = "otlp"
+	// TracingExporterStdout prints traces to stdout, which is mostly useful for testing.
+	TracingExporterStdout TracingExporter = "stdout"
+	// TracingExporterFile writes traces to a file, one JSON document per span.
+	TracingExporterFile TracingExporter = "file"
+)
+
+func SupportedTracingExporters() []TracingExporter {
+	return []TracingExporter{TracingExporterOTLP, TracingExporterStdout, TracingExporterFile}
+}
+
+// TracingConfig controls the export of OpenTelemetry traces. Tracing is disabled if no exporter
+// is set.
+type TracingConfig struct {
+	Exporter TracingExporter
+	// Endpoint is the address of the collector for the OTLP exporter. It defaults to the local
+	// collector at localhost:4318.
+	Endpoint string
+	// File is the path the file exporter writes to.
+	File string
+}
+
+// newTracerProvider creates a tracer provider that exports spans as configured. The returned
+// function flushes the remaining spans and releases the exporter.
+func newTracerProvider(ctx context.Context, config TracingConfig) (*sdktrace.TracerProvider, func(context.Context) error, error) {
+	var (
+		exporter sdktrace.SpanExporter
+		closer   io.Closer
+		err      error
+	)
+
+	switch config.Exporter {
+	case TracingExporterOTLP:
+		exporter, err = otlptracehttp.New(ctx, otlpEndpointOptions(config.Endpoint)...)
+	case TracingExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case TracingExporterFile:
+		if config.File == "" {
+			return nil, nil, fmt.Errorf("the file exporter requires a file")
+		}
+		var file *os.File
+		file, err = os.OpenFile(config.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		closer = file
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	default:
+		return nil, nil, fmt.Errorf("unsupported tracing exporter %q", config.Exporter)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s trace exporter: %w", config.Exporter, err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", "construct"))),
+	)
+
+	shutdown := func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closer != nil {
+			if closeErr := closer.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		return err
+	}
+
+	return provider, shutdown, nil
+}
+
+func otlpEndpointOptions(endpoint string) []otlptracehttp.Option {
+	switch {
+	case endpoint == "":
+		return []otlptracehttp.Option{otlptracehttp.WithEndpoint("localhost:4318"), otlptracehttp.WithInsecure()}
+	case strings.Contains(endpoint, "://"):
+		return []otlptracehttp.Option{otlptracehttp.WithEndpointURL(endpoint)}
+	default:
+		// Collectors without a scheme are expected to run locally without TLS.
+		return []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint), otlptracehttp.WithInsecure()}
+	}
+}
+
+// endSpan marks the span as failed if err is set and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// traceID returns the ID of the trace that ctx belongs to, or an empty string if it is not traced.
+func traceID(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// attemptSpans traces every attempt of a model invocation, so that retries of the provider show
+// up as spans of their own.
+type attemptSpans struct {
+	ctx     context.Context
+	span    trace.Span
+	attempt int
+}
+
+func startAttemptSpans(ctx context.Context) *attemptSpans {
+	a := &attemptSpans{ctx: ctx}
+	a.next()
+	return a
+}
+
+func (a *attemptSpans) next() {
+	a.attempt++
+	_, a.span = tracer.Start(a.ctx, "invoke_model.attempt", trace.WithAttributes(attribute.Int("attempt", a.attempt)))
+}
+
+// retry ends the failed attempt and starts the next one. It is a model.WithRetryCallback handler.
+func (a *attemptSpans) retry(ctx context.Context, err error, nextRetry time.Duration) {
+	a.span.SetAttributes(attribute.Int64("retry_after_ms", nextRetry.Milliseconds()))
+	endSpan(a.span, err)
+	a.next()
+}
+
+func (a *attemptSpans) end(err error) {
+	endSpan(a.span, err)
+}
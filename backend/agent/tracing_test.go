@@ -0,0 +1,79 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestAttemptSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx, span := tracer.Start(context.Background(), "invoke_model")
+	attempts := startAttemptSpans(ctx)
+	attempts.retry(ctx, errors.New("overloaded"), 2*time.Second)
+	attempts.end(nil)
+	span.End()
+
+	if traceID(ctx) != span.SpanContext().TraceID().String() {
+		t.Errorf("traceID() = %q, want the ID of the invoke_model trace", traceID(ctx))
+	}
+	if traceID(context.Background()) != "" {
+		t.Errorf("traceID() of an untraced context = %q, want none", traceID(context.Background()))
+	}
+
+	ended := recorder.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("got %d spans, want two attempts and the invocation", len(ended))
+	}
+
+	first, second := ended[0], ended[1]
+	if first.Name() != "invoke_model.attempt" || second.Name() != "invoke_model.attempt" {
+		t.Fatalf("unexpected spans %s and %s", first.Name(), second.Name())
+	}
+	if first.Status().Code != codes.Error || second.Status().Code == codes.Error {
+		t.Errorf("only the retried attempt must fail, got %v and %v", first.Status(), second.Status())
+	}
+	for _, attempt := range []sdktrace.ReadOnlySpan{first, second} {
+		if attempt.Parent().SpanID() != span.SpanContext().SpanID() {
+			t.Errorf("attempt is not a child of the invocation")
+		}
+	}
+}
+
+func TestNewTracerProvider_File(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "traces.json")
+
+	provider, shutdown, err := newTracerProvider(context.Background(), TracingConfig{Exporter: TracingExporterFile, File: file})
+	if err != nil {
+		t.Fatalf("newTracerProvider() error = %v", err)
+	}
+
+	_, span := provider.Tracer("test").Start(context.Background(), "reconcile")
+	span.End()
+	if err := shutdown(context.Background()); err != nil {
+		t.Fatalf("shutdown() error = %v", err)
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("failed to read trace file: %v", err)
+	}
+	if !strings.Contains(string(content), `"Name":"reconcile"`) {
+		t.Errorf("trace file does not contain the span: %s", content)
+	}
+
+	if _, _, err := newTracerProvider(context.Background(), TracingConfig{Exporter: TracingExporterFile}); err == nil {
+		t.Errorf("newTracerProvider() without a file succeeded, want an error")
+	}
+}
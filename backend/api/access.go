@@ -0,0 +1,132 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+// Tokens can be limited to agents and workspaces, and tasks are only visible to their owner and
+// the subjects they are shared with. The scope check in the auth interceptor only knows the
+// procedure, so the handlers enforce these rules once they have loaded the resource.
+
+func checkAgentAccess(ctx context.Context, agentID uuid.UUID) error {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.CanAccessAgent(agentID) {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token is not allowed to access agent %s", agentID))
+}
+
+// checkNewAgentAccess denies identities that are limited to agents from creating agents, as
+// these would be outside of their limits.
+func checkNewAgentAccess(ctx context.Context) error {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin || len(identity.AgentIDs) == 0 {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("tokens that are limited to agents cannot create agents"))
+}
+
+func checkWorkspaceAccess(ctx context.Context, directory string) error {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.CanAccessWorkspace(directory) {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token is not allowed to access workspace %s", directory))
+}
+
+func checkTaskAccess(ctx context.Context, t *memory.Task) error {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin {
+		return nil
+	}
+
+	// Tasks of other subjects are hidden, just like in ListTasks.
+	if t.Owner != identity.Subject && !slices.Contains(t.SharedWith, identity.Subject) {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("task not found"))
+	}
+
+	if !identity.CanAccessAgent(t.AgentID) || !identity.CanAccessWorkspace(t.ProjectDirectory) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token is not allowed to access task %s", t.ID))
+	}
+
+	return nil
+}
+
+// checkTaskOwnership makes sure that the identity owns the task. Subjects the task is shared
+// with can work on it, but cannot delete it or change who it is shared with.
+func checkTaskOwnership(ctx context.Context, t *memory.Task) error {
+	if err := checkTaskAccess(ctx, t); err != nil {
+		return err
+	}
+
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin || t.Owner == identity.Subject {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the owner of task %s can do this", t.ID))
+}
+
+// owner returns the subject that is recorded as the owner of new resources.
+func owner(ctx context.Context) string {
+	identity := auth.FromContext(ctx)
+	if identity == nil {
+		return ""
+	}
+	return identity.Subject
+}
+
+// taskAccessPredicates restricts a task query to the tasks the identity may access.
+func taskAccessPredicates(ctx context.Context) []predicate.Task {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin {
+		return nil
+	}
+
+	predicates := []predicate.Task{
+		task.Or(
+			task.Owner(identity.Subject),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(task.FieldSharedWith, identity.Subject))
+			},
+		),
+	}
+	if len(identity.AgentIDs) > 0 {
+		predicates = append(predicates, task.AgentIDIn(identity.AgentIDs...))
+	}
+
+	if len(identity.Workspaces) > 0 {
+		workspaces := make([]predicate.Task, 0, len(identity.Workspaces)*2)
+		for _, workspace := range identity.Workspaces {
+			workspace = filepath.Clean(workspace)
+			workspaces = append(workspaces,
+				task.ProjectDirectoryEQ(workspace),
+				task.ProjectDirectoryHasPrefix(workspace+string(filepath.Separator)),
+			)
+		}
+		predicates = append(predicates, task.Or(workspaces...))
+	}
+
+	return predicates
+}
+
+// agentAccessPredicates restricts an agent query to the agents the identity may access.
+func agentAccessPredicates(ctx context.Context) []predicate.Agent {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin || len(identity.AgentIDs) == 0 {
+		return nil
+	}
+	return []predicate.Agent{agent.IDIn(identity.AgentIDs...)}
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestTaskAccessLimits(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	allowedAgent := test.NewAgentBuilder(t, uuid.New(), db, model).WithName("allowed").Build(ctx)
+	otherAgent := test.NewAgentBuilder(t, uuid.New(), db, model).WithName("other").Build(ctx)
+
+	allowed := test.NewTaskBuilder(t, uuid.New(), db, allowedAgent).WithOwner("limited").WithProjectDirectory("/src/project/backend").Build(ctx)
+	otherWorkspace := test.NewTaskBuilder(t, uuid.New(), db, allowedAgent).WithOwner("limited").WithProjectDirectory("/src/other").Build(ctx)
+	otherAgentTask := test.NewTaskBuilder(t, uuid.New(), db, otherAgent).WithOwner("limited").WithProjectDirectory("/src/project").Build(ctx)
+
+	handler := NewTaskHandler(db, options.EventRouter, options.AgentRuntime, options.Analytics)
+	ctx = auth.WithIdentity(ctx, &auth.Identity{
+		Subject:    "limited",
+		AuthMethod: auth.AuthMethodToken,
+		AgentIDs:   []uuid.UUID{allowedAgent.ID},
+		Workspaces: []string{"/src/project"},
+	})
+
+	resp, err := handler.ListTasks(ctx, connect.NewRequest(&v1.ListTasksRequest{}))
+	if err != nil {
+		t.Fatalf("ListTasks() failed: %v", err)
+	}
+	if len(resp.Msg.Tasks) != 1 || resp.Msg.Tasks[0].Metadata.Id != allowed.ID.String() {
+		t.Errorf("ListTasks() = %v, want only task %s", resp.Msg.Tasks, allowed.ID)
+	}
+
+	for _, id := range []uuid.UUID{otherWorkspace.ID, otherAgentTask.ID} {
+		_, err := handler.GetTask(ctx, connect.NewRequest(&v1.GetTaskRequest{Id: id.String()}))
+		if connect.CodeOf(err) != connect.CodePermissionDenied {
+			t.Errorf("GetTask(%s) error = %v, want permission denied", id, err)
+		}
+	}
+
+	_, err = handler.CreateTask(ctx, connect.NewRequest(&v1.CreateTaskRequest{
+		AgentId:          allowedAgent.ID.String(),
+		ProjectDirectory: "/src/other",
+	}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("CreateTask() error = %v, want permission denied", err)
+	}
+}
+
+func TestTaskOwnership(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+
+	own := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("alice").Build(ctx)
+	shared := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("bob").WithSharedWith("alice").Build(ctx)
+	foreign := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("bob").Build(ctx)
+
+	handler := NewTaskHandler(db, options.EventRouter, options.AgentRuntime, options.Analytics)
+	alice := auth.WithIdentity(ctx, &auth.Identity{Subject: "alice", AuthMethod: auth.AuthMethodToken})
+	bob := auth.WithIdentity(ctx, &auth.Identity{Subject: "bob", AuthMethod: auth.AuthMethodToken})
+
+	resp, err := handler.ListTasks(alice, connect.NewRequest(&v1.ListTasksRequest{}))
+	if err != nil {
+		t.Fatalf("ListTasks() failed: %v", err)
+	}
+	got := map[string]bool{}
+	for _, task := range resp.Msg.Tasks {
+		got[task.Metadata.Id] = true
+	}
+	if len(got) != 2 || !got[own.ID.String()] || !got[shared.ID.String()] {
+		t.Errorf("ListTasks() = %v, want tasks %s and %s", resp.Msg.Tasks, own.ID, shared.ID)
+	}
+
+	_, err = handler.GetTask(alice, connect.NewRequest(&v1.GetTaskRequest{Id: foreign.ID.String()}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GetTask() of foreign task error = %v, want not found", err)
+	}
+
+	_, err = handler.DeleteTask(alice, connect.NewRequest(&v1.DeleteTaskRequest{Id: shared.ID.String()}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("DeleteTask() of shared task error = %v, want permission denied", err)
+	}
+
+	_, err = handler.ShareTask(alice, connect.NewRequest(&v1.ShareTaskRequest{TaskId: shared.ID.String(), Subject: "carol"}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("ShareTask() of shared task error = %v, want permission denied", err)
+	}
+
+	shareResp, err := handler.ShareTask(alice, connect.NewRequest(&v1.ShareTaskRequest{TaskId: own.ID.String(), Subject: "bob"}))
+	if err != nil {
+		t.Fatalf("ShareTask() failed: %v", err)
+	}
+	if diff := cmp.Diff([]string{"bob"}, shareResp.Msg.Task.Spec.SharedWith); diff != "" {
+		t.Errorf("ShareTask() shared with mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := handler.GetTask(bob, connect.NewRequest(&v1.GetTaskRequest{Id: own.ID.String()})); err != nil {
+		t.Errorf("GetTask() of task shared with bob failed: %v", err)
+	}
+
+	_, err = handler.ShareTask(alice, connect.NewRequest(&v1.ShareTaskRequest{TaskId: own.ID.String(), Subject: "alice"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ShareTask() with owner error = %v, want invalid argument", err)
+	}
+
+	unshareResp, err := handler.UnshareTask(alice, connect.NewRequest(&v1.UnshareTaskRequest{TaskId: own.ID.String(), Subject: "bob"}))
+	if err != nil {
+		t.Fatalf("UnshareTask() failed: %v", err)
+	}
+	if len(unshareResp.Msg.Task.Spec.SharedWith) != 0 {
+		t.Errorf("UnshareTask() shared with = %v, want none", unshareResp.Msg.Task.Spec.SharedWith)
+	}
+
+	_, err = handler.GetTask(bob, connect.NewRequest(&v1.GetTaskRequest{Id: own.ID.String()}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GetTask() of unshared task error = %v, want not found", err)
+	}
+
+	_, err = handler.UnshareTask(alice, connect.NewRequest(&v1.UnshareTaskRequest{TaskId: own.ID.String(), Subject: "bob"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("UnshareTask() of unshared subject error = %v, want not found", err)
+	}
+}
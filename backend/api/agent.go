@@ -11,6 +11,8 @@ import (
 	"github.com/furisto/construct/backend/api/conv"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/redact"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +32,10 @@ type AgentHandler struct {
 }
 
 func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.CreateAgentRequest]) (*connect.Response[v1.CreateAgentResponse], error) {
+	if err := checkNewAgentAccess(ctx); err != nil {
+		return nil, apiError(err)
+	}
+
 	modelID, err := uuid.Parse(req.Msg.ModelId)
 	if err != nil {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid model ID format: %w", err)))
@@ -45,6 +51,10 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 			SetName(req.Msg.Name).
 			SetInstructions(req.Msg.Instructions)
 
+		if subject := owner(ctx); subject != "" {
+			create = create.SetOwner(subject)
+		}
+
 		model, err := tx.Model.Get(ctx, modelID)
 		if err != nil {
 			return nil, err
@@ -55,6 +65,30 @@ func (h *AgentHandler) CreateAgent(ctx context.Context, req *connect.Request[v1.
 		}
 		create.SetModel(model)
 
+		fallbackModelIDs, err := validateFallbackModels(ctx, tx, modelID, req.Msg.FallbackModelIds)
+		if err != nil {
+			return nil, err
+		}
+		if len(fallbackModelIDs) > 0 {
+			create.SetFallbackModelIds(fallbackModelIDs)
+		}
+
+		modelRouter, err := validateModelRouter(ctx, tx, req.Msg.ModelRouter)
+		if err != nil {
+			return nil, err
+		}
+		if modelRouter != nil {
+			create.SetModelRouter(modelRouter)
+		}
+
+		redaction, err := validateRedaction(req.Msg.Redaction)
+		if err != nil {
+			return nil, err
+		}
+		if redaction != nil {
+			create.SetRedaction(redaction)
+		}
+
 		if req.Msg.Description != "" {
 			create = create.SetDescription(req.Msg.Description)
 		}
@@ -92,6 +126,10 @@ func (h *AgentHandler) GetAgent(ctx context.Context, req *connect.Request[v1.Get
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := checkAgentAccess(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	agent, err := h.db.Agent.Query().
 		Where(agent.ID(id)).
 		WithModel().
@@ -111,7 +149,7 @@ func (h *AgentHandler) GetAgent(ctx context.Context, req *connect.Request[v1.Get
 }
 
 func (h *AgentHandler) ListAgents(ctx context.Context, req *connect.Request[v1.ListAgentsRequest]) (*connect.Response[v1.ListAgentsResponse], error) {
-	query := h.db.Agent.Query().WithModel()
+	query := h.db.Agent.Query().Where(agentAccessPredicates(ctx)...).WithModel()
 
 	if req.Msg.Filter != nil && len(req.Msg.Filter.Names) > 0 {
 		query = query.Where(agent.NameIn(req.Msg.Filter.Names...))
@@ -142,6 +180,10 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
+	if err := checkAgentAccess(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	update := h.db.Agent.UpdateOneID(id)
 
 	var updatedFields []string
@@ -160,6 +202,7 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		updatedFields = append(updatedFields, "instructions")
 	}
 
+	var primaryModelID uuid.UUID
 	if req.Msg.ModelId != nil {
 		modelID, err := uuid.Parse(*req.Msg.ModelId)
 		if err != nil {
@@ -171,6 +214,49 @@ func (h *AgentHandler) UpdateAgent(ctx context.Context, req *connect.Request[v1.
 		}
 		update = update.SetModelID(modelID)
 		updatedFields = append(updatedFields, "model_id")
+		primaryModelID = modelID
+	}
+
+	if req.Msg.ClearFallbackModelIds {
+		update = update.ClearFallbackModelIds()
+		updatedFields = append(updatedFields, "fallback_model_ids")
+	} else if len(req.Msg.FallbackModelIds) > 0 {
+		if primaryModelID == uuid.Nil {
+			existing, err := h.db.Agent.Get(ctx, id)
+			if err != nil {
+				return nil, apiError(err)
+			}
+			primaryModelID = existing.ModelID
+		}
+
+		fallbackModelIDs, err := validateFallbackModels(ctx, h.db, primaryModelID, req.Msg.FallbackModelIds)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		update = update.SetFallbackModelIds(fallbackModelIDs)
+		updatedFields = append(updatedFields, "fallback_model_ids")
+	}
+
+	if req.Msg.ModelRouter != nil {
+		modelRouter, err := validateModelRouter(ctx, h.db, req.Msg.ModelRouter)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		if modelRouter != nil {
+			update = update.SetModelRouter(modelRouter)
+		} else {
+			update = update.ClearModelRouter()
+		}
+		updatedFields = append(updatedFields, "model_router")
+	}
+
+	if req.Msg.Redaction != nil {
+		redaction, err := validateRedaction(req.Msg.Redaction)
+		if err != nil {
+			return nil, apiError(err)
+		}
+		update = update.SetRedaction(redaction)
+		updatedFields = append(updatedFields, "redaction")
 	}
 
 	updatedAgent, err := update.Save(ctx)
@@ -204,6 +290,10 @@ func (h *AgentHandler) DeleteAgent(ctx context.Context, req *connect.Request[v1.
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
+	if err := checkAgentAccess(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	agent, err := h.db.Agent.Get(ctx, id)
 	if err != nil {
 		return nil, apiError(err)
@@ -217,3 +307,71 @@ func (h *AgentHandler) DeleteAgent(ctx context.Context, req *connect.Request[v1.
 
 	return connect.NewResponse(&v1.DeleteAgentResponse{}), nil
 }
+
+// validateFallbackModels parses the fallback model IDs and makes sure that every model exists,
+// is enabled and differs from the agent's primary model.
+func validateFallbackModels(ctx context.Context, db *memory.Client, primaryModelID uuid.UUID, ids []string) ([]uuid.UUID, error) {
+	fallbackModelIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		modelID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid fallback model ID format: %w", err))
+		}
+
+		if modelID == primaryModelID {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("fallback model %s is the agent's primary model", modelID))
+		}
+
+		model, err := db.Model.Get(ctx, modelID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !model.Enabled {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("fallback model %s is disabled", model.Name))
+		}
+
+		fallbackModelIDs = append(fallbackModelIDs, modelID)
+	}
+
+	return fallbackModelIDs, nil
+}
+
+// validateModelRouter converts the router and makes sure that every routing rule references an
+// enabled model. A router without rules is treated as no router.
+func validateModelRouter(ctx context.Context, db *memory.Client, router *v1.ModelRouter) (*types.ModelRouter, error) {
+	if router == nil || len(router.Rules) == 0 {
+		return nil, nil
+	}
+
+	modelRouter, err := conv.ConvertModelRouterToMemory(router)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	for _, rule := range modelRouter.Rules {
+		model, err := db.Model.Get(ctx, rule.ModelID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !model.Enabled {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("model %s of routing rule %s is disabled", model.Name, rule.Name))
+		}
+	}
+
+	return modelRouter, nil
+}
+
+// validateRedaction converts the redaction settings and makes sure that every pattern compiles.
+func validateRedaction(redaction *v1.Redaction) (*types.Redaction, error) {
+	if redaction == nil {
+		return nil, nil
+	}
+
+	if _, err := redact.CompilePatterns(redaction.Patterns); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return conv.ConvertRedactionToMemory(redaction), nil
+}
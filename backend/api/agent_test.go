@@ -33,6 +33,7 @@ func TestCreateAgent(t *testing.T) {
 	}
 
 	modelID := uuid.New()
+	fallbackModelID := uuid.New()
 
 	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateAgentRequest, v1.CreateAgentResponse]{
 		{
@@ -77,6 +78,92 @@ func TestCreateAgent(t *testing.T) {
 				Error: "invalid_argument: default model is disabled",
 			},
 		},
+		{
+			Name: "fallback model is primary model",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:             "architect-agent",
+				Instructions:     "Instructions for architect agent",
+				ModelId:          modelID.String(),
+				FallbackModelIds: []string{modelID.String()},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: "invalid_argument: fallback model " + modelID.String() + " is the agent's primary model",
+			},
+		},
+		{
+			Name: "routing rule model is disabled",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+				test.NewModelBuilder(t, fallbackModelID, db, modelProvider).
+					WithName("claude-haiku-4-5").
+					WithEnabled(false).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:         "architect-agent",
+				Instructions: "Instructions for architect agent",
+				ModelId:      modelID.String(),
+				ModelRouter: &v1.ModelRouter{
+					Rules: []*v1.ModelRouteRule{
+						{
+							Name:      "summarize",
+							ModelId:   fallbackModelID.String(),
+							TurnTypes: []v1.TurnType{v1.TurnType_TURN_TYPE_TOOL_RESULT},
+						},
+					},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: "invalid_argument: model claude-haiku-4-5 of routing rule summarize is disabled",
+			},
+		},
+		{
+			Name: "fallback model is disabled",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+				test.NewModelBuilder(t, fallbackModelID, db, modelProvider).
+					WithName("gpt-4o").
+					WithEnabled(false).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:             "architect-agent",
+				Instructions:     "Instructions for architect agent",
+				ModelId:          modelID.String(),
+				FallbackModelIds: []string{fallbackModelID.String()},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: "invalid_argument: fallback model gpt-4o is disabled",
+			},
+		},
+		{
+			Name: "invalid redaction pattern",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:         "architect-agent",
+				Instructions: "Instructions for architect agent",
+				ModelId:      modelID.String(),
+				Redaction: &v1.Redaction{
+					Patterns: []string{`license=(\w+`},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Error: "invalid_argument: invalid redaction pattern \"license=(\\\\w+\": error parsing regexp: missing closing ): `license=(\\w+`",
+			},
+		},
 		{
 			Name: "success",
 			SeedDatabase: func(ctx context.Context, db *memory.Client) {
@@ -93,7 +180,7 @@ func TestCreateAgent(t *testing.T) {
 			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
 				Response: v1.CreateAgentResponse{
 					Agent: &v1.Agent{
-						Metadata: &v1.AgentMetadata{},
+						Metadata: &v1.AgentMetadata{Owner: "local-admin"},
 						Spec: &v1.AgentSpec{
 							Name:         "architect-agent",
 							Description:  "Architect agent",
@@ -115,6 +202,69 @@ func TestCreateAgent(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success with fallback models",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+				test.NewModelBuilder(t, fallbackModelID, db, modelProvider).
+					WithName("gpt-4o").
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:             "architect-agent",
+				Instructions:     "Instructions for architect agent",
+				ModelId:          modelID.String(),
+				FallbackModelIds: []string{fallbackModelID.String()},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Response: v1.CreateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{Owner: "local-admin"},
+						Spec: &v1.AgentSpec{
+							Name:             "architect-agent",
+							Instructions:     "Instructions for architect agent",
+							ModelId:          modelID.String(),
+							FallbackModelIds: []string{fallbackModelID.String()},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "success with redaction",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+			},
+			Request: &v1.CreateAgentRequest{
+				Name:         "architect-agent",
+				Instructions: "Instructions for architect agent",
+				ModelId:      modelID.String(),
+				Redaction: &v1.Redaction{
+					HighEntropyStrings: true,
+					Patterns:           []string{`license=(\w+)`},
+				},
+			},
+			Expected: ServiceTestExpectation[v1.CreateAgentResponse]{
+				Response: v1.CreateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{Owner: "local-admin"},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Instructions: "Instructions for architect agent",
+							ModelId:      modelID.String(),
+							Redaction: &v1.Redaction{
+								HighEntropyStrings: true,
+								Patterns:           []string{`license=(\w+)`},
+							},
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -467,6 +617,40 @@ func TestUpdateAgent(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success - disable redaction",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).
+					Build(ctx)
+
+				test.NewAgentBuilder(t, agentID, db, model).
+					WithName("architect-agent").
+					WithDescription("Architect agent description").
+					WithInstructions("Architect agent instructions").
+					Build(ctx)
+			},
+			Request: &v1.UpdateAgentRequest{
+				Id:        agentID.String(),
+				Redaction: &v1.Redaction{Disabled: true},
+			},
+			Expected: ServiceTestExpectation[v1.UpdateAgentResponse]{
+				Response: v1.UpdateAgentResponse{
+					Agent: &v1.Agent{
+						Metadata: &v1.AgentMetadata{
+							Id: agentID.String(),
+						},
+						Spec: &v1.AgentSpec{
+							Name:         "architect-agent",
+							Description:  "Architect agent description",
+							Instructions: "Architect agent instructions",
+							ModelId:      modelID.String(),
+							Redaction:    &v1.Redaction{Disabled: true},
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -14,15 +14,23 @@ import (
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/analytics"
 	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/audit"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/furisto/construct/backend/skill"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 type AgentRuntime interface {
 	Memory() *memory.Client
 	Encryption() *secret.Encryption
+	TestModelProvider(ctx context.Context, modelProviderID uuid.UUID) (*model.ConnectivityReport, error)
+	TestModelProviderCredentials(ctx context.Context, providerType types.ModelProviderType, credentials model.Credentials) (*model.ConnectivityReport, error)
 }
 
 type Server struct {
@@ -31,7 +39,16 @@ type Server struct {
 	listener net.Listener
 }
 
-func NewServer(runtime AgentRuntime, listener net.Listener, eventRouter *event.EventRouter, analyticsClient analytics.Client, skillInstaller *skill.SkillManager) *Server {
+// MetricsOptions configures the /metrics endpoint.
+type MetricsOptions struct {
+	// Gatherer collects the metrics that are served. The endpoint is not served if it is nil.
+	Gatherer prometheus.Gatherer
+	// RequireAuth requires a token for scraping over TCP. Requests over the Unix socket are
+	// always allowed, just like API requests.
+	RequireAuth bool
+}
+
+func NewServer(runtime AgentRuntime, listener net.Listener, eventRouter *event.EventRouter, analyticsClient analytics.Client, skillInstaller *skill.SkillManager, socketPolicy auth.UnixSocketPolicy, oidc *auth.OIDCVerifier, auditLog *audit.Log, keysetStore *secret.KeysetStore, catalogSource string, metrics MetricsOptions) *Server {
 	tokenProvider := auth.NewTokenProvider()
 
 	apiHandler := NewHandler(
@@ -43,6 +60,11 @@ func NewServer(runtime AgentRuntime, listener net.Listener, eventRouter *event.E
 			Analytics:     analyticsClient,
 			TokenProvider: tokenProvider,
 			Skills:        skillInstaller,
+			SocketPolicy:  socketPolicy,
+			OIDC:          oidc,
+			AuditLog:      auditLog,
+			KeysetStore:   keysetStore,
+			CatalogSource: catalogSource,
 		},
 	)
 
@@ -52,6 +74,14 @@ func NewServer(runtime AgentRuntime, listener net.Listener, eventRouter *event.E
 		w.Write([]byte("{\"status\":\"ok\"}"))
 	}))
 
+	if metrics.Gatherer != nil {
+		var metricsHandler http.Handler = promhttp.HandlerFor(metrics.Gatherer, promhttp.HandlerOpts{})
+		if metrics.RequireAuth {
+			metricsHandler = auth.NewAuthInterceptor(runtime.Memory(), tokenProvider, socketPolicy, oidc).Middleware(metricsHandler)
+		}
+		mux.Handle(auth.MetricsPath, metricsHandler)
+	}
+
 	return &Server{
 		mux:      mux,
 		listener: listener,
@@ -64,13 +94,7 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 		BaseContext: func(l net.Listener) context.Context {
 			return ctx
 		},
-		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
-			transport := auth.TransportTCP
-			if _, ok := c.(*net.UnixConn); ok {
-				transport = auth.TransportUnix
-			}
-			return auth.WithTransport(ctx, transport)
-		},
+		ConnContext: auth.ConnContext,
 	}
 
 	return s.server.Serve(s.listener)
@@ -86,6 +110,17 @@ type HandlerOptions struct {
 	AgentRuntime  AgentRuntime
 	TokenProvider *auth.TokenProvider
 	Skills        *skill.SkillManager
+	// SocketPolicy decides which local users other than the owner may use the Unix socket.
+	SocketPolicy auth.UnixSocketPolicy
+	// OIDC verifies tokens of an OpenID Connect issuer. Only API tokens are accepted if it is nil.
+	OIDC *auth.OIDCVerifier
+	// AuditLog records the calls of mutating procedures. Nothing is audited if it is nil.
+	AuditLog *audit.Log
+	// KeysetStore keeps the encryption keyset. The key cannot be rotated or imported if it is nil.
+	KeysetStore *secret.KeysetStore
+	// CatalogSource is the URL or file the model catalog is loaded from. The catalog built into
+	// the binary is used if it is empty.
+	CatalogSource string
 
 	EventRouter *event.EventRouter
 	Analytics   analytics.Client
@@ -102,15 +137,22 @@ func NewHandler(opts HandlerOptions) *Handler {
 		mux: http.NewServeMux(),
 	}
 
-	connectOpts := append([]connect.HandlerOption{connect.WithInterceptors(auth.NewAuthInterceptor(opts.DB, opts.TokenProvider))}, opts.RequestOptions...)
+	// The audit interceptor runs first, so that calls that fail authentication or authorization
+	// are recorded too.
+	var interceptors []connect.Interceptor
+	if opts.AuditLog != nil {
+		interceptors = append(interceptors, audit.NewInterceptor(opts.AuditLog))
+	}
+	interceptors = append(interceptors, auth.NewAuthInterceptor(opts.DB, opts.TokenProvider, opts.SocketPolicy, opts.OIDC))
+	connectOpts := append([]connect.HandlerOption{connect.WithInterceptors(interceptors...)}, opts.RequestOptions...)
 
 	authHandler := NewAuthHandler(opts.DB, opts.TokenProvider)
 	handler.mux.Handle(v1connect.NewAuthServiceHandler(authHandler, connectOpts...))
 
-	modelProviderHandler := NewModelProviderHandler(opts.DB, opts.Encryption)
+	modelProviderHandler := NewModelProviderHandler(opts.DB, opts.Encryption, opts.AgentRuntime)
 	handler.mux.Handle(v1connect.NewModelProviderServiceHandler(modelProviderHandler, connectOpts...))
 
-	modelHandler := NewModelHandler(opts.DB)
+	modelHandler := NewModelHandler(opts.DB, opts.CatalogSource)
 	handler.mux.Handle(v1connect.NewModelServiceHandler(modelHandler, connectOpts...))
 
 	agentHandler := NewAgentHandler(opts.DB, opts.Analytics)
@@ -128,6 +170,18 @@ func NewHandler(opts HandlerOptions) *Handler {
 	eventHandler := NewEventHandler(opts.DB, opts.EventRouter)
 	handler.mux.Handle(v1connect.NewEventServiceHandler(eventHandler, connectOpts...))
 
+	webhookHandler := NewWebhookHandler(opts.DB, opts.Encryption)
+	handler.mux.Handle(v1connect.NewWebhookServiceHandler(webhookHandler, connectOpts...))
+
+	auditHandler := NewAuditHandler(opts.DB)
+	handler.mux.Handle(v1connect.NewAuditServiceHandler(auditHandler, connectOpts...))
+
+	quotaHandler := NewQuotaHandler(opts.DB)
+	handler.mux.Handle(v1connect.NewQuotaServiceHandler(quotaHandler, connectOpts...))
+
+	encryptionHandler := NewEncryptionHandler(opts.DB, opts.Encryption, opts.KeysetStore)
+	handler.mux.Handle(v1connect.NewEncryptionServiceHandler(encryptionHandler, connectOpts...))
+
 	return handler
 }
 
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"entgo.io/ent/dialect"
@@ -18,8 +19,12 @@ import (
 	"github.com/furisto/construct/backend/api/auth"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/secret"
+	"github.com/furisto/construct/shared/resilience"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 )
 
 type ClientServiceCall[Request any, Response any] func(ctx context.Context, client *api_client.Client, req *connect.Request[Request]) (*connect.Response[Response], error)
@@ -29,6 +34,8 @@ type ServiceTestSetup[Request any, Response any] struct {
 	CmpOptions    []cmp.Option
 	Debug         bool
 	QueryDatabase func(ctx context.Context, db *memory.Client) (any, error)
+	// ConfigureHandler adjusts the options of the handler under test, if set.
+	ConfigureHandler func(options *HandlerOptions)
 }
 
 type ServiceTestExpectation[Response any] struct {
@@ -56,6 +63,9 @@ func (s *ServiceTestSetup[Request, Response]) RunServiceTests(t *testing.T, scen
 
 	ctx := context.Background()
 	handlerOptions := DefaultTestHandlerOptions(t)
+	if s.ConfigureHandler != nil {
+		s.ConfigureHandler(&handlerOptions)
+	}
 	server := NewTestServer(t, handlerOptions)
 
 	server.Start(ctx)
@@ -187,7 +197,22 @@ func (s *TestServer) ClearDatabase(ctx context.Context, t *testing.T) error {
 	t.Helper()
 
 	_, err := memory.Transaction(ctx, s.Options.DB, func(tx *memory.Client) (*any, error) {
-		_, err := tx.Message.Delete().Exec(ctx)
+		_, err := tx.AuditEvent.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete audit events: %w", err)
+		}
+
+		_, err = tx.WebhookDelivery.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete webhook deliveries: %w", err)
+		}
+
+		_, err = tx.Webhook.Delete().Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete webhooks: %w", err)
+		}
+
+		_, err = tx.Message.Delete().Exec(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to delete messages: %w", err)
 		}
@@ -308,3 +333,30 @@ func (m *MockAgentRuntime) Memory() *memory.Client {
 func (m *MockAgentRuntime) Encryption() *secret.Encryption {
 	return nil
 }
+
+// mockAvailableModels are the models that every model provider offers in tests.
+var mockAvailableModels = []string{"claude-3-7-sonnet-20250219", "claude-sonnet-4-20250514"}
+
+// mockRejectedAPIKey is the API key that model providers reject in tests.
+const mockRejectedAPIKey = "sk-ant-rejected"
+
+func (m *MockAgentRuntime) TestModelProvider(ctx context.Context, modelProviderID uuid.UUID) (*model.ConnectivityReport, error) {
+	return &model.ConnectivityReport{
+		Latency:         250 * time.Millisecond,
+		AuthStatus:      model.AuthStatusAuthenticated,
+		ModelsListed:    true,
+		AvailableModels: mockAvailableModels,
+		CircuitState:    resilience.CircuitClosed,
+	}, nil
+}
+
+func (m *MockAgentRuntime) TestModelProviderCredentials(ctx context.Context, providerType types.ModelProviderType, credentials model.Credentials) (*model.ConnectivityReport, error) {
+	if credentials.APIKey == mockRejectedAPIKey {
+		return &model.ConnectivityReport{
+			AuthStatus: model.AuthStatusUnauthenticated,
+			Err:        model.NewProviderError(string(providerType), model.ProviderErrorKindAuthentication, nil),
+		}, nil
+	}
+
+	return &model.ConnectivityReport{AuthStatus: model.AuthStatusAuthenticated}, nil
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.AuditServiceHandler = (*AuditHandler)(nil)
+
+const (
+	defaultAuditEventPageSize = 100
+	maxAuditEventPageSize     = 1000
+)
+
+func NewAuditHandler(db *memory.Client) *AuditHandler {
+	return &AuditHandler{
+		db: db,
+	}
+}
+
+type AuditHandler struct {
+	db *memory.Client
+	v1connect.UnimplementedAuditServiceHandler
+}
+
+func (h *AuditHandler) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	query := h.db.AuditEvent.Query()
+
+	if filter := req.Msg.Filter; filter != nil {
+		if filter.Kind != nil {
+			kind, err := conv.ConvertAuditEventKindToMemory(*filter.Kind)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+			}
+			query = query.Where(auditevent.KindEQ(kind))
+		}
+
+		if filter.Subject != nil {
+			query = query.Where(auditevent.SubjectEQ(*filter.Subject))
+		}
+
+		if filter.Action != nil {
+			query = query.Where(auditevent.ActionEQ(*filter.Action))
+		}
+
+		taskID, err := parseOptionalID(filter.TaskId, "task")
+		if err != nil {
+			return nil, apiError(err)
+		}
+		if taskID != nil {
+			query = query.Where(auditevent.TaskIDEQ(*taskID))
+		}
+
+		agentID, err := parseOptionalID(filter.AgentId, "agent")
+		if err != nil {
+			return nil, apiError(err)
+		}
+		if agentID != nil {
+			query = query.Where(auditevent.AgentIDEQ(*agentID))
+		}
+
+		if filter.Outcome != nil {
+			outcome, err := conv.ConvertAuditOutcomeToMemory(*filter.Outcome)
+			if err != nil {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+			}
+			query = query.Where(auditevent.OutcomeEQ(outcome))
+		}
+
+		if filter.ResourceId != nil {
+			resourceID := *filter.ResourceId
+			query = query.Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(auditevent.FieldResourceIds, resourceID))
+			})
+		}
+
+		if filter.Since != nil {
+			query = query.Where(auditevent.CreateTimeGTE(filter.Since.AsTime()))
+		}
+
+		if filter.Until != nil {
+			query = query.Where(auditevent.CreateTimeLT(filter.Until.AsTime()))
+		}
+	}
+
+	// The page token is the ID of the last event of the previous page. Events are ordered by
+	// their creation time and ID, so the next page starts right after it.
+	if req.Msg.PageToken != "" {
+		lastID, err := uuid.Parse(req.Msg.PageToken)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token")))
+		}
+		last, err := h.db.AuditEvent.Get(ctx, lastID)
+		if err != nil {
+			if memory.IsNotFound(err) {
+				return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token")))
+			}
+			return nil, apiError(err)
+		}
+		query = query.Where(auditevent.Or(
+			auditevent.CreateTimeLT(last.CreateTime),
+			auditevent.And(auditevent.CreateTimeEQ(last.CreateTime), auditevent.IDLT(last.ID)),
+		))
+	}
+
+	pageSize := defaultAuditEventPageSize
+	if req.Msg.PageSize != nil {
+		pageSize = int(*req.Msg.PageSize)
+	}
+	if pageSize < 1 || pageSize > maxAuditEventPageSize {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxAuditEventPageSize)))
+	}
+
+	events, err := query.
+		Order(memory.Desc(auditevent.FieldCreateTime), memory.Desc(auditevent.FieldID)).
+		Limit(pageSize + 1).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	var nextPageToken string
+	if len(events) > pageSize {
+		events = events[:pageSize]
+		nextPageToken = events[pageSize-1].ID.String()
+	}
+
+	protoEvents := make([]*v1.AuditEvent, 0, len(events))
+	for _, e := range events {
+		protoEvents = append(protoEvents, conv.ConvertAuditEventToProto(e))
+	}
+
+	return connect.NewResponse(&v1.ListAuditEventsResponse{
+		Events:        protoEvents,
+		NextPageToken: nextPageToken,
+	}), nil
+}
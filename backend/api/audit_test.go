@@ -0,0 +1,333 @@
+package api
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/audit"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestListAuditEvents(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]{
+		Call: func(ctx context.Context, client *api_client.Client, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+			return client.Audit().ListAuditEvents(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListAuditEventsResponse{}, v1.AuditEvent{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.AuditEvent{}, "created_at"),
+		},
+	}
+
+	taskID := uuid.New()
+	createAgentID := uuid.New()
+	deleteTaskID := uuid.New()
+	commandID := uuid.New()
+	now := time.Now()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		test.NewAuditEventBuilder(t, createAgentID, db).
+			WithCreateTime(now.Add(-2 * time.Hour)).
+			Build(ctx)
+		test.NewAuditEventBuilder(t, deleteTaskID, db).
+			WithAction("/construct.v1.TaskService/DeleteTask").
+			WithSubject("ci").
+			WithResourceIDs(taskID.String()).
+			WithOutcome(types.AuditOutcomeFailure).
+			WithCreateTime(now.Add(-time.Hour)).
+			Build(ctx)
+		test.NewAuditEventBuilder(t, commandID, db).
+			WithKind(types.AuditEventKindTool).
+			WithAction("execute_command").
+			WithSubject("ci").
+			WithResourceIDs(taskID.String()).
+			WithTaskID(taskID).
+			WithCreateTime(now).
+			Build(ctx)
+	}
+
+	createAgent := &v1.AuditEvent{
+		Id:      createAgentID.String(),
+		Kind:    v1.AuditEventKind_AUDIT_EVENT_KIND_API,
+		Action:  "/construct.v1.AgentService/CreateAgent",
+		Subject: "local-admin",
+		Outcome: v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS,
+	}
+	deleteTask := &v1.AuditEvent{
+		Id:          deleteTaskID.String(),
+		Kind:        v1.AuditEventKind_AUDIT_EVENT_KIND_API,
+		Action:      "/construct.v1.TaskService/DeleteTask",
+		Subject:     "ci",
+		ResourceIds: []string{taskID.String()},
+		Outcome:     v1.AuditOutcome_AUDIT_OUTCOME_FAILURE,
+	}
+	command := &v1.AuditEvent{
+		Id:          commandID.String(),
+		Kind:        v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL,
+		Action:      "execute_command",
+		Subject:     "ci",
+		ResourceIds: []string{taskID.String()},
+		Outcome:     v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS,
+		TaskId:      strPtr(taskID.String()),
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]{
+		{
+			Name:         "newest first",
+			SeedDatabase: seed,
+			Request:      &v1.ListAuditEventsRequest{},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Response: v1.ListAuditEventsResponse{
+					Events: []*v1.AuditEvent{command, deleteTask, createAgent},
+				},
+			},
+		},
+		{
+			Name:         "filter by subject and kind",
+			SeedDatabase: seed,
+			Request: &v1.ListAuditEventsRequest{
+				Filter: &v1.ListAuditEventsRequest_Filter{
+					Subject: strPtr("ci"),
+					Kind:    ptr(v1.AuditEventKind_AUDIT_EVENT_KIND_API),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Response: v1.ListAuditEventsResponse{
+					Events: []*v1.AuditEvent{deleteTask},
+				},
+			},
+		},
+		{
+			Name:         "filter by resource and outcome",
+			SeedDatabase: seed,
+			Request: &v1.ListAuditEventsRequest{
+				Filter: &v1.ListAuditEventsRequest_Filter{
+					ResourceId: strPtr(taskID.String()),
+					Outcome:    ptr(v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Response: v1.ListAuditEventsResponse{
+					Events: []*v1.AuditEvent{command},
+				},
+			},
+		},
+		{
+			Name:         "filter by task",
+			SeedDatabase: seed,
+			Request: &v1.ListAuditEventsRequest{
+				Filter: &v1.ListAuditEventsRequest_Filter{
+					TaskId: strPtr(taskID.String()),
+				},
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Response: v1.ListAuditEventsResponse{
+					Events: []*v1.AuditEvent{command},
+				},
+			},
+		},
+		{
+			Name:         "first page",
+			SeedDatabase: seed,
+			Request: &v1.ListAuditEventsRequest{
+				PageSize: ptr(int32(2)),
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Response: v1.ListAuditEventsResponse{
+					Events:        []*v1.AuditEvent{command, deleteTask},
+					NextPageToken: deleteTaskID.String(),
+				},
+			},
+		},
+		{
+			Name:         "last page",
+			SeedDatabase: seed,
+			Request: &v1.ListAuditEventsRequest{
+				PageSize:  ptr(int32(2)),
+				PageToken: deleteTaskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Response: v1.ListAuditEventsResponse{
+					Events: []*v1.AuditEvent{createAgent},
+				},
+			},
+		},
+		{
+			Name: "invalid page token",
+			Request: &v1.ListAuditEventsRequest{
+				PageToken: "not-a-token",
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Error: "invalid_argument: invalid page token",
+			},
+		},
+		{
+			Name: "invalid page size",
+			Request: &v1.ListAuditEventsRequest{
+				PageSize: ptr(int32(0)),
+			},
+			Expected: ServiceTestExpectation[v1.ListAuditEventsResponse]{
+				Error: "invalid_argument: page size must be between 1 and 1000",
+			},
+		},
+	})
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	options.AuditLog = audit.NewLog(options.DB, audit.DefaultOptions())
+
+	server := NewTestServer(t, options)
+	server.Start(ctx)
+	defer server.Close()
+
+	apiClient, err := api_client.NewClient(api_client.EndpointContext{
+		Address: server.API.URL,
+		Kind:    "http",
+	})
+	if err != nil {
+		t.Fatalf("failed to create api client: %v", err)
+	}
+
+	db := options.DB
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	task := test.NewTaskBuilder(t, uuid.New(), db, agent).Build(ctx)
+	missingID := uuid.New()
+
+	if _, err := apiClient.Task().GetTask(ctx, connect.NewRequest(&v1.GetTaskRequest{Id: task.ID.String()})); err != nil {
+		t.Fatalf("GetTask() failed: %v", err)
+	}
+	if _, err := apiClient.Task().DeleteTask(ctx, connect.NewRequest(&v1.DeleteTaskRequest{Id: task.ID.String()})); err != nil {
+		t.Fatalf("DeleteTask() failed: %v", err)
+	}
+	if _, err := apiClient.Task().DeleteTask(ctx, connect.NewRequest(&v1.DeleteTaskRequest{Id: missingID.String()})); err == nil {
+		t.Fatalf("DeleteTask() of a missing task succeeded")
+	}
+
+	events, err := db.AuditEvent.Query().Order(memory.Asc("create_time")).All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query audit events: %v", err)
+	}
+
+	want := []*memory.AuditEvent{
+		{
+			Kind:        types.AuditEventKindAPI,
+			Action:      "/construct.v1.TaskService/DeleteTask",
+			Subject:     "local-admin",
+			AuthMethod:  "unix_socket",
+			ResourceIds: []string{task.ID.String()},
+			Outcome:     types.AuditOutcomeSuccess,
+		},
+		{
+			Kind:        types.AuditEventKindAPI,
+			Action:      "/construct.v1.TaskService/DeleteTask",
+			Subject:     "local-admin",
+			AuthMethod:  "unix_socket",
+			ResourceIds: []string{missingID.String()},
+			Outcome:     types.AuditOutcomeFailure,
+			Error:       "not_found: task not found",
+		},
+	}
+
+	diff := cmp.Diff(want, events,
+		cmpopts.IgnoreUnexported(memory.AuditEvent{}),
+		cmpopts.IgnoreFields(memory.AuditEvent{}, "ID", "CreateTime", "UpdateTime"),
+		cmpopts.EquateEmpty(),
+	)
+	if diff != "" {
+		t.Errorf("audit events mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAuditInterceptorRecordsDeniedCalls(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	options.AuditLog = audit.NewLog(options.DB, audit.DefaultOptions())
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", http.StripPrefix("/api", NewHandler(options)))
+	server := httptest.NewUnstartedServer(mux)
+	server.Config.BaseContext = func(net.Listener) context.Context {
+		return auth.WithTransport(context.Background(), auth.TransportTCP)
+	}
+	server.Start()
+	defer server.Close()
+
+	readOnly, hash, err := options.TokenProvider.GenerateToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	db.Token.Create().
+		SetName("reader").
+		SetTokenHash(hash).
+		SetScopes([]string{string(auth.ScopeTasksRead)}).
+		SetExpiresAt(time.Now().Add(time.Hour)).
+		SaveX(ctx)
+
+	taskID := uuid.New()
+	for _, token := range []string{readOnly, auth.TokenPrefix + "invalid"} {
+		apiClient, err := api_client.NewClient(api_client.EndpointContext{Address: server.URL, Kind: "http"}, api_client.WithAuthToken(token))
+		if err != nil {
+			t.Fatalf("failed to create api client: %v", err)
+		}
+		if _, err := apiClient.Task().DeleteTask(ctx, connect.NewRequest(&v1.DeleteTaskRequest{Id: taskID.String()})); err == nil {
+			t.Fatalf("DeleteTask() succeeded, want it to be denied")
+		}
+	}
+
+	events, err := db.AuditEvent.Query().Order(memory.Asc("create_time")).All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query audit events: %v", err)
+	}
+
+	want := []*memory.AuditEvent{
+		{
+			Kind:        types.AuditEventKindAPI,
+			Action:      "/construct.v1.TaskService/DeleteTask",
+			Subject:     "reader",
+			AuthMethod:  "token",
+			ResourceIds: []string{taskID.String()},
+			Outcome:     types.AuditOutcomeFailure,
+			Error:       "permission_denied: token lacks scope tasks:write",
+		},
+		{
+			Kind:        types.AuditEventKindAPI,
+			Action:      "/construct.v1.TaskService/DeleteTask",
+			ResourceIds: []string{taskID.String()},
+			Outcome:     types.AuditOutcomeFailure,
+			Error:       "unauthenticated: unauthenticated: invalid or expired token",
+		},
+	}
+
+	diff := cmp.Diff(want, events,
+		cmpopts.IgnoreUnexported(memory.AuditEvent{}),
+		cmpopts.IgnoreFields(memory.AuditEvent{}, "ID", "CreateTime", "UpdateTime"),
+		cmpopts.EquateEmpty(),
+	)
+	if diff != "" {
+		t.Errorf("audit events mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/api/conv"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/token"
@@ -38,8 +40,8 @@ func (h *AuthHandler) CreateToken(ctx context.Context, req *connect.Request[v1.C
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("admin privileges required"))
 	}
 
-	if req.Msg.Name == "" {
-		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required")))
+	if err := auth.ValidateTokenName(req.Msg.Name); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
 	}
 
 	expiresIn := auth.DefaultTokenExpiry
@@ -50,6 +52,25 @@ func (h *AuthHandler) CreateToken(ctx context.Context, req *connect.Request[v1.C
 		}
 	}
 
+	scopes, err := auth.ParseScopes(req.Msg.Scopes)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	agentIDs, err := h.parseTokenAgents(ctx, req.Msg.AgentIds)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	workspaces, err := parseTokenWorkspaces(req.Msg.Workspaces)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if q := req.Msg.Quota; q != nil && (q.MaxConcurrentTasks < 0 || q.DailySpend < 0 || q.MonthlySpend < 0 || q.DailyTokens < 0) {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("quota limits must not be negative")))
+	}
+
 	plaintext, hash, err := h.tokenProvider.GenerateToken()
 	if err != nil {
 		return nil, apiError(fmt.Errorf("failed to generate token: %w", err))
@@ -67,6 +88,26 @@ func (h *AuthHandler) CreateToken(ctx context.Context, req *connect.Request[v1.C
 		create = create.SetDescription(*req.Msg.Description)
 	}
 
+	if len(scopes) > 0 {
+		names := make([]string, len(scopes))
+		for i, scope := range scopes {
+			names[i] = string(scope)
+		}
+		create = create.SetScopes(names)
+	}
+
+	if len(agentIDs) > 0 {
+		create = create.SetAgentIds(agentIDs)
+	}
+
+	if len(workspaces) > 0 {
+		create = create.SetWorkspaces(workspaces)
+	}
+
+	if quota := conv.ConvertQuotaToMemory(req.Msg.Quota); quota != nil {
+		create = create.SetQuota(quota)
+	}
+
 	_, err = create.Save(ctx)
 	if err != nil {
 		if memory.IsConstraintError(err) {
@@ -90,6 +131,9 @@ func (h *AuthHandler) CreateSetupCode(ctx context.Context, req *connect.Request[
 	if req.Msg.TokenName == "" {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("token_name is required")))
 	}
+	if err := auth.ValidateTokenName(req.Msg.TokenName); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid token_name: %w", err)))
+	}
 
 	codeExpiry := auth.DefaultSetupExpiry
 	if req.Msg.ExpiresIn != nil {
@@ -147,17 +191,37 @@ func (h *AuthHandler) ListTokens(ctx context.Context, req *connect.Request[v1.Li
 		isActive := tok.ExpiresAt.After(now)
 
 		protoToken := &v1.TokenInfo{
-			Id:        tok.ID.String(),
-			Name:      tok.Name,
-			CreatedAt: timestamppb.New(tok.CreateTime),
-			ExpiresAt: timestamppb.New(tok.ExpiresAt),
-			IsActive:  isActive,
+			Id:         tok.ID.String(),
+			Name:       tok.Name,
+			CreatedAt:  timestamppb.New(tok.CreateTime),
+			ExpiresAt:  timestamppb.New(tok.ExpiresAt),
+			IsActive:   isActive,
+			Scopes:     tok.Scopes,
+			Workspaces: tok.Workspaces,
+			Quota:      conv.ConvertQuotaToProto(tok.Quota),
+		}
+
+		for _, agentID := range tok.AgentIds {
+			protoToken.AgentIds = append(protoToken.AgentIds, agentID.String())
 		}
 
 		if tok.Description != "" {
 			protoToken.Description = &tok.Description
 		}
 
+		if tok.LastUsedAt != nil {
+			protoToken.LastUsedAt = timestamppb.New(*tok.LastUsedAt)
+			protoToken.LastUsedAddress = tok.LastUsedAddress
+		}
+
+		if tok.RotatedAt != nil {
+			protoToken.RotatedAt = timestamppb.New(*tok.RotatedAt)
+		}
+
+		if tok.PreviousExpiresAt != nil && tok.PreviousExpiresAt.After(now) {
+			protoToken.PreviousExpiresAt = timestamppb.New(*tok.PreviousExpiresAt)
+		}
+
 		protoTokens = append(protoTokens, protoToken)
 	}
 
@@ -193,6 +257,125 @@ func (h *AuthHandler) RevokeToken(ctx context.Context, req *connect.Request[v1.R
 	return connect.NewResponse(&v1.RevokeTokenResponse{}), nil
 }
 
+func (h *AuthHandler) RotateToken(ctx context.Context, req *connect.Request[v1.RotateTokenRequest]) (*connect.Response[v1.RotateTokenResponse], error) {
+	identity := auth.FromContext(ctx)
+	if identity == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("authentication required"))
+	}
+
+	// Without admin privileges a caller may only rotate the token it authenticated with, and not
+	// with a token that was already replaced, which would hand out a successor to whoever still
+	// holds the replaced token.
+	var id uuid.UUID
+	switch {
+	case req.Msg.Id != nil:
+		parsed, err := uuid.Parse(req.Msg.GetId())
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+		}
+		id = parsed
+	case identity.AuthMethod == auth.AuthMethodToken:
+		id = identity.TokenID
+	default:
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("id is required")))
+	}
+
+	if !identity.IsAdmin {
+		if identity.AuthMethod != auth.AuthMethodToken || identity.TokenID != id {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only the token the request is authenticated with can be rotated"))
+		}
+		if identity.IssuedAt.IsZero() {
+			return nil, apiError(connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("token was already rotated, use its successor")))
+		}
+	}
+
+	if req.Msg.ExpiresIn != nil {
+		expiresIn := req.Msg.ExpiresIn.AsDuration()
+		if expiresIn <= 0 {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_in must be positive")))
+		}
+		if expiresIn > auth.MaxTokenExpiry {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_in exceeds maximum of %v", auth.MaxTokenExpiry)))
+		}
+	}
+
+	overlap := auth.DefaultRotationOverlap
+	if req.Msg.Overlap != nil {
+		overlap = req.Msg.Overlap.AsDuration()
+		if overlap < 0 || overlap > auth.MaxRotationOverlap {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("overlap must be between 0 and %v", auth.MaxRotationOverlap)))
+		}
+	}
+
+	plaintext, hash, err := h.tokenProvider.GenerateToken()
+	if err != nil {
+		return nil, apiError(fmt.Errorf("failed to generate token: %w", err))
+	}
+
+	resp, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*v1.RotateTokenResponse, error) {
+		tok, err := tx.Token.Query().
+			Where(token.IDEQ(id), token.TypeEQ(types.TokenTypeAPIToken)).
+			Only(ctx)
+		if err != nil {
+			if memory.IsNotFound(err) {
+				return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("token not found"))
+			}
+			return nil, fmt.Errorf("failed to get token: %w", err)
+		}
+
+		now := time.Now()
+		if !tok.ExpiresAt.After(now) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("token has expired"))
+		}
+		// A rotation keeps only one replaced token valid. Rotating again before it expires would
+		// cut off clients that still use it, before the end of the overlap they were promised.
+		if tok.PreviousExpiresAt != nil && tok.PreviousExpiresAt.After(now) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("rotation already in progress, the previous token is valid until %s", tok.PreviousExpiresAt.Format(time.RFC3339)))
+		}
+
+		// The successor keeps the lifetime of the token unless another one is requested. Only admins
+		// may extend it, otherwise a token could keep itself valid forever by rotating.
+		lifetime := min(tok.ExpiresAt.Sub(auth.TokenIssuedAt(tok)), auth.MaxTokenExpiry)
+		expiresIn := lifetime
+		if req.Msg.ExpiresIn != nil {
+			expiresIn = req.Msg.ExpiresIn.AsDuration()
+			if !identity.IsAdmin && expiresIn > lifetime {
+				return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("expires_in exceeds the lifetime of the token of %v", lifetime.Round(time.Second)))
+			}
+		}
+		expiresAt := now.Add(expiresIn)
+
+		previousExpiresAt := now.Add(overlap)
+		if previousExpiresAt.After(tok.ExpiresAt) {
+			previousExpiresAt = tok.ExpiresAt
+		}
+
+		err = tx.Token.UpdateOne(tok).
+			SetTokenHash(hash).
+			SetExpiresAt(expiresAt).
+			SetRotatedAt(now).
+			SetPreviousTokenHash(tok.TokenHash).
+			SetPreviousExpiresAt(previousExpiresAt).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rotate token: %w", err)
+		}
+
+		return &v1.RotateTokenResponse{
+			Token:             plaintext,
+			ExpiresAt:         timestamppb.New(expiresAt),
+			PreviousExpiresAt: timestamppb.New(previousExpiresAt),
+			Id:                tok.ID.String(),
+			Name:              tok.Name,
+		}, nil
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 func (h *AuthHandler) ExchangeSetupCode(ctx context.Context, req *connect.Request[v1.ExchangeSetupCodeRequest]) (*connect.Response[v1.ExchangeSetupCodeResponse], error) {
 	if req.Msg.SetupCode == "" {
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("setup_code is required")))
@@ -203,6 +386,10 @@ func (h *AuthHandler) ExchangeSetupCode(ctx context.Context, req *connect.Reques
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("invalid or expired setup code"))
 	}
 
+	if err := auth.ValidateTokenName(setupCode.TokenName); err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
 	exists, err := h.db.Token.Query().Where(token.NameEQ(setupCode.TokenName)).Exist(ctx)
 	if err != nil {
 		return nil, apiError(fmt.Errorf("failed to check if token exists: %w", err))
@@ -235,3 +422,40 @@ func (h *AuthHandler) ExchangeSetupCode(ctx context.Context, req *connect.Reques
 		Name:      setupCode.TokenName,
 	}), nil
 }
+
+func (h *AuthHandler) parseTokenAgents(ctx context.Context, ids []string) ([]uuid.UUID, error) {
+	agentIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		agentID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
+		}
+
+		if _, err := h.db.Agent.Get(ctx, agentID); err != nil {
+			if memory.IsNotFound(err) {
+				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent %s not found", agentID))
+			}
+			return nil, err
+		}
+
+		if !slices.Contains(agentIDs, agentID) {
+			agentIDs = append(agentIDs, agentID)
+		}
+	}
+	return agentIDs, nil
+}
+
+func parseTokenWorkspaces(directories []string) ([]string, error) {
+	workspaces := make([]string, 0, len(directories))
+	for _, directory := range directories {
+		if !filepath.IsAbs(directory) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("workspace %q must be an absolute path", directory))
+		}
+
+		workspace := filepath.Clean(directory)
+		if !slices.Contains(workspaces, workspace) {
+			workspaces = append(workspaces, workspace)
+		}
+	}
+	return workspaces, nil
+}
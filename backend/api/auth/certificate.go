@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+)
+
+type clientCertificate struct {
+	conn        *tls.Conn
+	certificate *x509.Certificate
+}
+
+type clientCertificateKey struct{}
+
+func withTLSConn(ctx context.Context, conn *tls.Conn) context.Context {
+	return context.WithValue(ctx, clientCertificateKey{}, &clientCertificate{conn: conn})
+}
+
+// WithClientCertificate records a verified client certificate of the connection.
+func WithClientCertificate(ctx context.Context, certificate *x509.Certificate) context.Context {
+	return context.WithValue(ctx, clientCertificateKey{}, &clientCertificate{certificate: certificate})
+}
+
+// ClientCertificateFromContext returns the client certificate of a TLS connection. Only
+// certificates that were verified against the client certificate authorities are returned.
+func ClientCertificateFromContext(ctx context.Context) *x509.Certificate {
+	c, ok := ctx.Value(clientCertificateKey{}).(*clientCertificate)
+	if !ok {
+		return nil
+	}
+	if c.certificate != nil {
+		return c.certificate
+	}
+
+	// The handshake has completed by the time a request is read from the connection.
+	state := c.conn.ConnectionState()
+	if len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return state.VerifiedChains[0][0]
+}
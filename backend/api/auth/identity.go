@@ -2,7 +2,12 @@ package auth
 
 import (
 	"context"
+	"path/filepath"
+	"slices"
+	"strings"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type AuthMethod int
@@ -11,6 +16,8 @@ const (
 	AuthMethodUnspecified AuthMethod = iota
 	AuthMethodUnixSocket
 	AuthMethodToken
+	AuthMethodCertificate
+	AuthMethodOIDC
 )
 
 func (a AuthMethod) String() string {
@@ -19,16 +26,90 @@ func (a AuthMethod) String() string {
 		return "unix_socket"
 	case AuthMethodToken:
 		return "token"
+	case AuthMethodCertificate:
+		return "certificate"
+	case AuthMethodOIDC:
+		return "oidc"
 	default:
 		return "unspecified"
 	}
 }
 
+const (
+	// LocalAdminSubject is the subject of the owner of the daemon and root, when they connect over
+	// the Unix socket.
+	LocalAdminSubject = "local-admin"
+	// UnixSubjectPrefix is prepended to the user name of other users that connect over the Unix
+	// socket.
+	UnixSubjectPrefix = "unix:"
+)
+
 type Identity struct {
 	Subject    string
 	AuthMethod AuthMethod
 	IsAdmin    bool
 	ExpiresAt  time.Time
+
+	// Scopes restricts the procedures the identity may call. Nil means that the identity is not
+	// restricted, which is the case for tokens that were created without scopes. Only the audit
+	// log has to be granted explicitly.
+	Scopes []Scope
+	// AgentIDs limits access to tasks of these agents, if set.
+	AgentIDs []uuid.UUID
+	// Workspaces limits access to tasks in these directories, if set.
+	Workspaces []string
+	// Peer identifies the connecting process for requests over the Unix socket, if known.
+	Peer *PeerCredentials
+	// TokenID is the token the identity authenticated with, directly or through a client
+	// certificate.
+	TokenID uuid.UUID
+	// IssuedAt is when the token was created or last rotated. It is zero if the identity
+	// authenticated with a token that was replaced by a rotation.
+	IssuedAt time.Time
+}
+
+// HasScope reports whether the identity was granted the scope, either directly or through a
+// broader scope.
+func (i *Identity) HasScope(scope Scope) bool {
+	if i.IsAdmin || i.Scopes == nil {
+		return true
+	}
+
+	for _, granted := range i.Scopes {
+		if granted == scope || slices.Contains(impliedScopes[granted], scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsLimited reports whether the identity is limited to specific agents or workspaces.
+func (i *Identity) IsLimited() bool {
+	return !i.IsAdmin && (len(i.AgentIDs) > 0 || len(i.Workspaces) > 0)
+}
+
+func (i *Identity) CanAccessAgent(agentID uuid.UUID) bool {
+	if i.IsAdmin || len(i.AgentIDs) == 0 {
+		return true
+	}
+	return slices.Contains(i.AgentIDs, agentID)
+}
+
+// CanAccessWorkspace reports whether the directory is one of the workspaces of the identity or
+// lies below one of them.
+func (i *Identity) CanAccessWorkspace(directory string) bool {
+	if i.IsAdmin || len(i.Workspaces) == 0 {
+		return true
+	}
+
+	directory = filepath.Clean(directory)
+	for _, workspace := range i.Workspaces {
+		workspace = filepath.Clean(workspace)
+		if directory == workspace || strings.HasPrefix(directory, workspace+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
 }
 
 type identityKey struct{}
@@ -44,3 +125,26 @@ func FromContext(ctx context.Context) *Identity {
 func WithIdentity(ctx context.Context, identity *Identity) context.Context {
 	return context.WithValue(ctx, identityKey{}, identity)
 }
+
+type authenticatedKey struct{}
+
+// authenticated holds the identity the auth interceptor authenticated.
+type authenticated struct {
+	identity *Identity
+}
+
+// WithAuthenticated returns a context in which the auth interceptor records the identity it
+// authenticated, and a function that returns it. Interceptors that run before the auth
+// interceptor use it to learn who made a call, even if the call was denied.
+func WithAuthenticated(ctx context.Context) (context.Context, func() *Identity) {
+	holder := &authenticated{}
+	return context.WithValue(ctx, authenticatedKey{}, holder), func() *Identity {
+		return holder.identity
+	}
+}
+
+func recordAuthenticated(ctx context.Context, identity *Identity) {
+	if holder, ok := ctx.Value(authenticatedKey{}).(*authenticated); ok {
+		holder.identity = identity
+	}
+}
@@ -1,9 +1,15 @@
 package auth
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -13,16 +19,28 @@ import (
 	"github.com/furisto/construct/backend/memory/token"
 )
 
+// usageInterval is how often the last use of a token is recorded. Recording every use would turn
+// every request into a write.
+const usageInterval = 5 * time.Minute
+
 type AuthInterceptor struct {
 	db                   *memory.Client
 	tokenProvider        *TokenProvider
+	socketPolicy         UnixSocketPolicy
+	oidc                 *OIDCVerifier
+	ownerUID             uint32
 	unauthenticatedPaths map[string]bool
 }
 
-func NewAuthInterceptor(db *memory.Client, tokenProvider *TokenProvider) *AuthInterceptor {
+// NewAuthInterceptor creates an interceptor that authenticates requests. Bearer tokens that are not
+// API tokens are verified with oidc, if it is not nil.
+func NewAuthInterceptor(db *memory.Client, tokenProvider *TokenProvider, socketPolicy UnixSocketPolicy, oidc *OIDCVerifier) *AuthInterceptor {
 	return &AuthInterceptor{
 		db:            db,
 		tokenProvider: tokenProvider,
+		socketPolicy:  socketPolicy,
+		oidc:          oidc,
+		ownerUID:      uint32(os.Getuid()),
 		unauthenticatedPaths: map[string]bool{
 			v1connect.AuthServiceExchangeSetupCodeProcedure: true,
 		},
@@ -31,27 +49,40 @@ func NewAuthInterceptor(db *memory.Client, tokenProvider *TokenProvider) *AuthIn
 
 func (a *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		identity, err := a.authenticate(ctx, req.Spec(), req.Header())
+		identity, err := a.authenticate(ctx, req.Spec().Procedure, req.Peer().Addr, req.Header())
 		if err != nil {
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		recordAuthenticated(ctx, identity)
+		if err := Authorize(identity, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
 		if identity != nil {
 			ctx = WithIdentity(ctx, identity)
 		}
 
-		return next(ctx, req)
+		resp, err := next(ctx, req)
+		if err == nil {
+			setRotateAfterHeader(resp.Header(), identity)
+		}
+		return resp, err
 	}
 }
 
 func (a *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, shc connect.StreamingHandlerConn) error {
-		identity, err := a.authenticate(ctx, shc.Spec(), shc.RequestHeader())
+		identity, err := a.authenticate(ctx, shc.Spec().Procedure, shc.Peer().Addr, shc.RequestHeader())
 		if err != nil {
 			return connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		recordAuthenticated(ctx, identity)
+		if err := Authorize(identity, shc.Spec().Procedure); err != nil {
+			return err
+		}
 		if identity != nil {
 			ctx = WithIdentity(ctx, identity)
 		}
+		setRotateAfterHeader(shc.ResponseHeader(), identity)
 
 		return next(ctx, shc)
 	}
@@ -61,25 +92,59 @@ func (a *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 	return next
 }
 
-func (a *AuthInterceptor) authenticate(ctx context.Context, spec connect.Spec, header http.Header) (*Identity, error) {
-	procedure := spec.Procedure
+// Middleware authenticates plain HTTP requests the same way as API requests.
+func (a *AuthInterceptor) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		identity, err := a.authenticate(ctx, r.URL.Path, r.RemoteAddr, r.Header)
+		if err != nil {
+			http.Error(w, connectMessage(err), http.StatusUnauthorized)
+			return
+		}
+		if err := Authorize(identity, r.URL.Path); err != nil {
+			http.Error(w, connectMessage(err), http.StatusForbidden)
+			return
+		}
+		if identity != nil {
+			ctx = WithIdentity(ctx, identity)
+		}
 
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// setRotateAfterHeader tells clients that authenticated with a token when to rotate it, so they
+// can do so before it expires.
+func setRotateAfterHeader(header http.Header, identity *Identity) {
+	if identity == nil || identity.AuthMethod != AuthMethodToken || identity.IssuedAt.IsZero() {
+		return
+	}
+	header.Set(TokenRotateAfterHeader, RotateAfter(identity.IssuedAt, identity.ExpiresAt).UTC().Format(time.RFC3339))
+}
+
+func connectMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
+func (a *AuthInterceptor) authenticate(ctx context.Context, procedure, address string, header http.Header) (*Identity, error) {
 	if a.unauthenticatedPaths[procedure] {
 		return nil, nil
 	}
 
 	transport := TransportFromContext(ctx)
 	if transport == TransportUnix {
-		identity := &Identity{
-			Subject:    "local-admin",
-			AuthMethod: AuthMethodUnixSocket,
-			IsAdmin:    true,
-		}
-		return identity, nil
+		return a.authenticatePeer(ctx, procedure)
 	}
 
 	authHeader := header.Get("Authorization")
 	if authHeader == "" {
+		if certificate := ClientCertificateFromContext(ctx); certificate != nil {
+			return a.authenticateCertificate(ctx, procedure, address, certificate)
+		}
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("missing authorization header"))
 	}
 
@@ -91,14 +156,21 @@ func (a *AuthInterceptor) authenticate(ctx context.Context, spec connect.Spec, h
 	tokenValue := parts[1]
 
 	if !strings.HasPrefix(tokenValue, TokenPrefix) {
+		if a.oidc != nil {
+			return a.oidc.Verify(ctx, tokenValue)
+		}
 		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid token format"))
 	}
 
 	tokenHash := a.tokenProvider.HashToken(tokenValue)
+	now := time.Now()
 
+	// A token that was replaced by a rotation stays valid until its overlap window ends.
 	tok, err := a.db.Token.Query().
-		Where(token.TokenHashEQ(tokenHash)).
-		Where(token.ExpiresAtGT(time.Now())).
+		Where(token.Or(
+			token.And(token.TokenHashEQ(tokenHash), token.ExpiresAtGT(now)),
+			token.And(token.PreviousTokenHashEQ(tokenHash), token.PreviousExpiresAtGT(now)),
+		)).
 		First(ctx)
 
 	if err != nil {
@@ -108,12 +180,141 @@ func (a *AuthInterceptor) authenticate(ctx context.Context, spec connect.Spec, h
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to validate token: %w", err))
 	}
 
+	a.recordUsage(ctx, tok, address)
+
+	identity, err := tokenIdentity(tok, AuthMethodToken)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(tok.TokenHash, tokenHash) {
+		identity.ExpiresAt = *tok.PreviousExpiresAt
+		identity.IssuedAt = time.Time{}
+	}
+	return identity, nil
+}
+
+// recordUsage records when and from where the token was used, unless that was already recorded
+// recently. Failing to record it does not fail the request.
+func (a *AuthInterceptor) recordUsage(ctx context.Context, tok *memory.Token, address string) {
+	now := time.Now()
+	if tok.LastUsedAt != nil && now.Sub(*tok.LastUsedAt) < usageInterval {
+		return
+	}
+
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+
+	// The condition keeps concurrent requests from recording the same use.
+	err := a.db.Token.Update().
+		Where(token.IDEQ(tok.ID)).
+		Where(token.Or(token.LastUsedAtIsNil(), token.LastUsedAtLT(now.Add(-usageInterval)))).
+		SetLastUsedAt(now).
+		SetLastUsedAddress(address).
+		Exec(ctx)
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record token usage", "token", tok.Name, "error", err)
+	}
+}
+
+// authenticateCertificate maps a verified client certificate to the token whose name matches the
+// common name of the certificate. The certificate gets the scopes and limits of that token, so
+// access is managed the same way for both, and revoking the token locks out the certificate.
+func (a *AuthInterceptor) authenticateCertificate(ctx context.Context, procedure, address string, certificate *x509.Certificate) (*Identity, error) {
+	name := certificate.Subject.CommonName
+	if name == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("client certificate has no common name"))
+	}
+
+	tok, err := a.db.Token.Query().
+		Where(token.NameEQ(name)).
+		Where(token.ExpiresAtGT(time.Now())).
+		First(ctx)
+
+	if err != nil {
+		if memory.IsNotFound(err) {
+			slog.WarnContext(ctx, "rejected client certificate", "procedure", procedure, "subject", name, "serial", certificate.SerialNumber)
+			return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("no valid token named %s for client certificate", name))
+		}
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to validate client certificate: %w", err))
+	}
+
+	slog.DebugContext(ctx, "authenticated client certificate", "procedure", procedure, "subject", name, "serial", certificate.SerialNumber)
+	a.recordUsage(ctx, tok, address)
+	return tokenIdentity(tok, AuthMethodCertificate)
+}
+
+func tokenIdentity(tok *memory.Token, method AuthMethod) (*Identity, error) {
 	identity := &Identity{
 		Subject:    tok.Name,
-		AuthMethod: AuthMethodToken,
+		AuthMethod: method,
 		IsAdmin:    false,
 		ExpiresAt:  tok.ExpiresAt,
+		AgentIDs:   tok.AgentIds,
+		Workspaces: tok.Workspaces,
+		TokenID:    tok.ID,
+		IssuedAt:   TokenIssuedAt(tok),
+	}
+
+	if len(tok.Scopes) > 0 {
+		scopes, err := ParseScopes(tok.Scopes)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to parse token scopes: %w", err))
+		}
+		identity.Scopes = scopes
 	}
 
 	return identity, nil
 }
+
+// TokenIssuedAt returns when the current value of the token was issued.
+func TokenIssuedAt(tok *memory.Token) time.Time {
+	if tok.RotatedAt != nil {
+		return *tok.RotatedAt
+	}
+	return tok.CreateTime
+}
+
+// authenticatePeer maps the process on the other end of the Unix socket to an identity. The owner
+// of the daemon and root are admins, other users need to be allowed by the socket policy.
+func (a *AuthInterceptor) authenticatePeer(ctx context.Context, procedure string) (*Identity, error) {
+	credentials, err := PeerCredentialsFromContext(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to identify the connecting process"))
+	}
+
+	// Without peer credentials everyone who can reach the socket is trusted, so the socket's
+	// file permissions are the only protection.
+	if credentials == nil {
+		return &Identity{
+			Subject:    LocalAdminSubject,
+			AuthMethod: AuthMethodUnixSocket,
+			IsAdmin:    true,
+		}, nil
+	}
+
+	if credentials.UID == a.ownerUID || credentials.UID == 0 {
+		slog.DebugContext(ctx, "authenticated unix socket peer", "procedure", procedure, "subject", LocalAdminSubject, "peer", credentials)
+		return &Identity{
+			Subject:    LocalAdminSubject,
+			AuthMethod: AuthMethodUnixSocket,
+			IsAdmin:    true,
+			Peer:       credentials,
+		}, nil
+	}
+
+	username := credentials.Username()
+	if !a.socketPolicy.allows(credentials) {
+		slog.WarnContext(ctx, "rejected unix socket peer", "procedure", procedure, "user", username, "peer", credentials)
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("user %s is not allowed to use the daemon socket", username))
+	}
+
+	subject := UnixSubjectPrefix + username
+	slog.DebugContext(ctx, "authenticated unix socket peer", "procedure", procedure, "subject", subject, "peer", credentials)
+	return &Identity{
+		Subject:    subject,
+		AuthMethod: AuthMethodUnixSocket,
+		Scopes:     a.socketPolicy.scopes(),
+		Peer:       credentials,
+	}, nil
+}
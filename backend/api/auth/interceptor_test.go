@@ -0,0 +1,333 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	_ "modernc.org/sqlite"
+)
+
+func TestAuthInterceptor_Middleware(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:auth_middleware_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	provider := NewTokenProvider()
+	token, hash, err := provider.GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken() failed: %v", err)
+	}
+	db.Token.Create().SetName("prometheus").SetTokenHash(hash).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+
+	scopedToken, scopedHash, err := provider.GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken() failed: %v", err)
+	}
+	db.Token.Create().SetName("scraper").SetTokenHash(scopedHash).SetScopes([]string{"metrics:read"}).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+
+	readerToken, readerHash, err := provider.GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken() failed: %v", err)
+	}
+	db.Token.Create().SetName("reader").SetTokenHash(readerHash).SetScopes([]string{"tasks:read"}).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+
+	var subject string
+	handler := NewAuthInterceptor(db, provider, UnixSocketPolicy{}, nil).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject = FromContext(r.Context()).Subject
+	}))
+
+	tests := []struct {
+		name          string
+		transport     TransportType
+		authorization string
+		wantStatus    int
+		wantSubject   string
+	}{
+		{name: "unix socket", transport: TransportUnix, wantStatus: http.StatusOK, wantSubject: "local-admin"},
+		{name: "tcp without token", transport: TransportTCP, wantStatus: http.StatusUnauthorized},
+		{name: "tcp with invalid token", transport: TransportTCP, authorization: "Bearer " + TokenPrefix + "invalid", wantStatus: http.StatusUnauthorized},
+		{name: "tcp with token", transport: TransportTCP, authorization: "Bearer " + token, wantStatus: http.StatusOK, wantSubject: "prometheus"},
+		{name: "tcp with metrics scope", transport: TransportTCP, authorization: "Bearer " + scopedToken, wantStatus: http.StatusOK, wantSubject: "scraper"},
+		{name: "tcp without metrics scope", transport: TransportTCP, authorization: "Bearer " + readerToken, wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subject = ""
+			req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+			req = req.WithContext(WithTransport(req.Context(), tt.transport))
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if subject != tt.wantSubject {
+				t.Errorf("subject = %q, want %q", subject, tt.wantSubject)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_UnixSocketPeer(t *testing.T) {
+	interceptor := NewAuthInterceptor(nil, NewTokenProvider(), UnixSocketPolicy{
+		Users:  []string{"4001"},
+		Groups: []string{"5001"},
+		Scopes: []Scope{ScopeTasksRead},
+	}, nil)
+	interceptor.ownerUID = 1000
+
+	tests := []struct {
+		name        string
+		peer        *PeerCredentials
+		wantErr     bool
+		wantSubject string
+		wantAdmin   bool
+		wantScopes  []Scope
+	}{
+		{name: "unknown peer", peer: nil, wantSubject: "local-admin", wantAdmin: true},
+		{name: "owner", peer: &PeerCredentials{UID: 1000, GID: 1000, PID: 42}, wantSubject: "local-admin", wantAdmin: true},
+		{name: "root", peer: &PeerCredentials{UID: 0, GID: 0, PID: 42}, wantSubject: "local-admin", wantAdmin: true},
+		{name: "allowed user", peer: &PeerCredentials{UID: 4001, GID: 4001, PID: 42}, wantSubject: "unix:4001", wantScopes: []Scope{ScopeTasksRead}},
+		{name: "allowed group", peer: &PeerCredentials{UID: 4002, GID: 5001, PID: 42}, wantSubject: "unix:4002", wantScopes: []Scope{ScopeTasksRead}},
+		{name: "other user", peer: &PeerCredentials{UID: 4003, GID: 4003, PID: 42}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := WithTransport(context.Background(), TransportUnix)
+			if tt.peer != nil {
+				ctx = WithPeerCredentials(ctx, tt.peer)
+			}
+
+			identity, err := interceptor.authenticate(ctx, "/construct.v1.TaskService/ListTasks", "", http.Header{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("authenticate() expected error, got identity %+v", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate() failed: %v", err)
+			}
+
+			if identity.Subject != tt.wantSubject || identity.IsAdmin != tt.wantAdmin || identity.Peer != tt.peer {
+				t.Errorf("authenticate() = %+v, want subject %q, admin %v and peer %+v", identity, tt.wantSubject, tt.wantAdmin, tt.peer)
+			}
+			if !slices.Equal(identity.Scopes, tt.wantScopes) {
+				t.Errorf("scopes = %v, want %v", identity.Scopes, tt.wantScopes)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_ClientCertificate(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:auth_certificate_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	provider := NewTokenProvider()
+	token, hash, err := provider.GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken() failed: %v", err)
+	}
+	db.Token.Create().SetName("ci").SetTokenHash(hash).SetScopes([]string{"tasks:read"}).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+	_, expiredHash, err := provider.GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken() failed: %v", err)
+	}
+	db.Token.Create().SetName("expired").SetTokenHash(expiredHash).SetExpiresAt(time.Now().Add(-time.Hour)).SaveX(ctx)
+
+	interceptor := NewAuthInterceptor(db, provider, UnixSocketPolicy{}, nil)
+
+	tests := []struct {
+		name          string
+		commonName    string
+		authorization string
+		wantErr       bool
+		wantMethod    AuthMethod
+	}{
+		{name: "certificate of token", commonName: "ci", wantMethod: AuthMethodCertificate},
+		{name: "certificate without token", commonName: "unknown", wantErr: true},
+		{name: "certificate of expired token", commonName: "expired", wantErr: true},
+		{name: "certificate without common name", wantErr: true},
+		{name: "token takes precedence", commonName: "unknown", authorization: "Bearer " + token, wantMethod: AuthMethodToken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := WithTransport(context.Background(), TransportTCP)
+			ctx = WithClientCertificate(ctx, &x509.Certificate{
+				Subject:      pkix.Name{CommonName: tt.commonName},
+				SerialNumber: big.NewInt(1),
+			})
+
+			header := http.Header{}
+			if tt.authorization != "" {
+				header.Set("Authorization", tt.authorization)
+			}
+
+			identity, err := interceptor.authenticate(ctx, "/construct.v1.TaskService/ListTasks", "192.0.2.1:52000", header)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("authenticate() expected error, got identity %+v", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate() failed: %v", err)
+			}
+
+			if identity.Subject != "ci" {
+				t.Errorf("Subject = %q, want %q", identity.Subject, "ci")
+			}
+			if identity.AuthMethod != tt.wantMethod {
+				t.Errorf("AuthMethod = %v, want %v", identity.AuthMethod, tt.wantMethod)
+			}
+			if !slices.Equal(identity.Scopes, []Scope{ScopeTasksRead}) {
+				t.Errorf("Scopes = %v, want [%s]", identity.Scopes, ScopeTasksRead)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_RotatedToken(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:auth_rotated_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	provider := NewTokenProvider()
+	current, currentHash, _ := provider.GenerateToken()
+	previous, previousHash, _ := provider.GenerateToken()
+	expired, expiredHash, _ := provider.GenerateToken()
+
+	rotatedAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	expiresAt := rotatedAt.Add(30 * 24 * time.Hour)
+	previousExpiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	db.Token.Create().SetName("ci").SetTokenHash(currentHash).SetExpiresAt(expiresAt).
+		SetRotatedAt(rotatedAt).SetPreviousTokenHash(previousHash).SetPreviousExpiresAt(previousExpiresAt).SaveX(ctx)
+	db.Token.Create().SetName("build").SetTokenHash(provider.HashToken("ct_other")).SetExpiresAt(expiresAt).
+		SetPreviousTokenHash(expiredHash).SetPreviousExpiresAt(time.Now().Add(-time.Minute)).SaveX(ctx)
+
+	interceptor := NewAuthInterceptor(db, provider, UnixSocketPolicy{}, nil)
+
+	tests := []struct {
+		name         string
+		token        string
+		wantErr      bool
+		wantExpires  time.Time
+		wantIssuedAt time.Time
+	}{
+		{name: "current token", token: current, wantExpires: expiresAt, wantIssuedAt: rotatedAt},
+		{name: "replaced token within overlap", token: previous, wantExpires: previousExpiresAt},
+		{name: "replaced token after overlap", token: expired, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set("Authorization", "Bearer "+tt.token)
+
+			identity, err := interceptor.authenticate(WithTransport(ctx, TransportTCP), "/construct.v1.TaskService/ListTasks", "192.0.2.1:52000", header)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("authenticate() expected error, got identity %+v", identity)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate() failed: %v", err)
+			}
+
+			if identity.Subject != "ci" || !identity.ExpiresAt.Equal(tt.wantExpires) || !identity.IssuedAt.Equal(tt.wantIssuedAt) {
+				t.Errorf("authenticate() = %+v, want subject ci, expiry %v and issued at %v", identity, tt.wantExpires, tt.wantIssuedAt)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_RecordUsage(t *testing.T) {
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:auth_usage_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed opening connection to sqlite: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	provider := NewTokenProvider()
+	token, hash, _ := provider.GenerateToken()
+	tok := db.Token.Create().SetName("ci").SetTokenHash(hash).SetExpiresAt(time.Now().Add(time.Hour)).SaveX(ctx)
+
+	interceptor := NewAuthInterceptor(db, provider, UnixSocketPolicy{}, nil)
+	authenticate := func(address string) {
+		t.Helper()
+		header := http.Header{}
+		header.Set("Authorization", "Bearer "+token)
+		if _, err := interceptor.authenticate(WithTransport(ctx, TransportTCP), "/construct.v1.TaskService/ListTasks", address, header); err != nil {
+			t.Fatalf("authenticate() failed: %v", err)
+		}
+	}
+
+	authenticate("192.0.2.1:52000")
+	tok = db.Token.GetX(ctx, tok.ID)
+	if tok.LastUsedAt == nil || tok.LastUsedAddress != "192.0.2.1" {
+		t.Fatalf("usage = %v from %q, want recorded from 192.0.2.1", tok.LastUsedAt, tok.LastUsedAddress)
+	}
+	firstUse := *tok.LastUsedAt
+
+	// Uses within the interval are not recorded.
+	authenticate("192.0.2.2:52000")
+	tok = db.Token.GetX(ctx, tok.ID)
+	if !tok.LastUsedAt.Equal(firstUse) || tok.LastUsedAddress != "192.0.2.1" {
+		t.Errorf("usage = %v from %q, want unchanged %v from 192.0.2.1", tok.LastUsedAt, tok.LastUsedAddress, firstUse)
+	}
+
+	db.Token.UpdateOneID(tok.ID).SetLastUsedAt(time.Now().Add(-2 * usageInterval)).ExecX(ctx)
+	authenticate("192.0.2.2:52000")
+	tok = db.Token.GetX(ctx, tok.ID)
+	if !tok.LastUsedAt.After(firstUse) || tok.LastUsedAddress != "192.0.2.2" {
+		t.Errorf("usage = %v from %q, want recorded from 192.0.2.2", tok.LastUsedAt, tok.LastUsedAddress)
+	}
+}
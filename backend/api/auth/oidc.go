@@ -0,0 +1,214 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+// OIDCSubjectPrefix is prepended to the subject of identities that authenticated with a token of
+// the OIDC issuer, so they cannot be confused with API tokens of the same name.
+const OIDCSubjectPrefix = "oidc:"
+
+// discoveryTimeout bounds the request for the discovery document of the issuer.
+const discoveryTimeout = 10 * time.Second
+
+// OIDCOptions configure authentication with ID tokens and other JWTs of an OpenID Connect issuer.
+type OIDCOptions struct {
+	// Issuer is the URL of the issuer. It must match the iss claim of the tokens.
+	Issuer string
+	// Audience must be contained in the aud claim of the tokens. For ID tokens this is the client
+	// ID of the CLI.
+	Audience string
+	// JWKSURL is where the signing keys of the issuer are fetched from. If empty, it is taken from
+	// the discovery document of the issuer.
+	JWKSURL string
+	// SubjectClaim is the claim that identifies the user, "sub" if empty.
+	SubjectClaim string
+	// GroupsClaim is the claim that lists the groups of the user, "groups" if empty.
+	GroupsClaim string
+	// GroupScopes grants scopes to the members of groups.
+	GroupScopes map[string][]Scope
+	// DefaultScopes are granted to every user of the issuer.
+	DefaultScopes []Scope
+}
+
+// Enabled reports whether an issuer is configured.
+func (o OIDCOptions) Enabled() bool {
+	return o.Issuer != ""
+}
+
+// Validate checks that tokens can be verified with the options.
+func (o OIDCOptions) Validate() error {
+	if !o.Enabled() {
+		return nil
+	}
+	if o.Audience == "" {
+		return fmt.Errorf("an audience is required for the issuer %s", o.Issuer)
+	}
+	return nil
+}
+
+func (o OIDCOptions) subjectClaim() string {
+	if o.SubjectClaim == "" {
+		return "sub"
+	}
+	return o.SubjectClaim
+}
+
+func (o OIDCOptions) groupsClaim() string {
+	if o.GroupsClaim == "" {
+		return "groups"
+	}
+	return o.GroupsClaim
+}
+
+// OIDCVerifier maps tokens of an OpenID Connect issuer to identities. The identity gets the
+// scopes that the token grants in its scope claim, the scopes of its groups and the default
+// scopes. Tokens that grant no scope at all are rejected, since an identity without scopes would
+// be unrestricted. Users of the issuer are never admins.
+//
+// The signing keys of the issuer are cached and only fetched again when a token is signed with an
+// unknown key, so the issuer is not contacted for every request.
+type OIDCVerifier struct {
+	options OIDCOptions
+	now     func() time.Time
+
+	mu       sync.Mutex
+	verifier *oidc.IDTokenVerifier
+}
+
+func NewOIDCVerifier(options OIDCOptions) (*OIDCVerifier, error) {
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &OIDCVerifier{
+		options: options,
+		now:     time.Now,
+	}, nil
+}
+
+// Verify checks the signature, issuer, audience and expiry of the token and returns the identity
+// of its user.
+func (v *OIDCVerifier) Verify(ctx context.Context, rawToken string) (*Identity, error) {
+	verifier, err := v.idTokenVerifier(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to set up oidc token verification", "issuer", v.options.Issuer, "error", err)
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("identity provider is unavailable"))
+	}
+
+	token, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		slog.DebugContext(ctx, "rejected oidc token", "issuer", v.options.Issuer, "error", err)
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("invalid or expired token"))
+	}
+
+	var claims map[string]any
+	if err := token.Claims(&claims); err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("failed to parse token claims: %w", err))
+	}
+
+	subject, ok := claims[v.options.subjectClaim()].(string)
+	if !ok || subject == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token has no %s claim", v.options.subjectClaim()))
+	}
+
+	scopes := v.scopes(claims)
+	if len(scopes) == 0 {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("token grants no scopes"))
+	}
+
+	return &Identity{
+		Subject:    OIDCSubjectPrefix + subject,
+		AuthMethod: AuthMethodOIDC,
+		ExpiresAt:  token.Expiry,
+		Scopes:     scopes,
+	}, nil
+}
+
+// scopes collects the scopes that the claims grant. Scopes that construct does not know, like
+// openid or email, are ignored.
+func (v *OIDCVerifier) scopes(claims map[string]any) []Scope {
+	var scopes []Scope
+	grant := func(scope Scope) {
+		if slices.Contains(Scopes(), scope) && !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	// Access tokens carry their scopes as a space separated scope claim or as an scp list.
+	for _, name := range append(claimStrings(claims["scope"]), claimStrings(claims["scp"])...) {
+		grant(Scope(name))
+	}
+
+	for _, group := range claimStrings(claims[v.options.groupsClaim()]) {
+		for _, scope := range v.options.GroupScopes[group] {
+			grant(scope)
+		}
+	}
+
+	for _, scope := range v.options.DefaultScopes {
+		grant(scope)
+	}
+
+	return scopes
+}
+
+func claimStrings(claim any) []string {
+	switch value := claim.(type) {
+	case string:
+		return strings.Fields(value)
+	case []any:
+		var values []string
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+// idTokenVerifier sets up the verifier on first use, so that the daemon starts even if the
+// issuer cannot be reached. A failed discovery is retried with the next token.
+func (v *OIDCVerifier) idTokenVerifier(ctx context.Context) (*oidc.IDTokenVerifier, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if v.verifier != nil {
+		return v.verifier, nil
+	}
+
+	config := &oidc.Config{
+		ClientID: v.options.Audience,
+		Now:      v.now,
+	}
+
+	if v.options.JWKSURL != "" {
+		// The key set only uses the context for configuration, so it outlives the request.
+		keySet := oidc.NewRemoteKeySet(context.Background(), v.options.JWKSURL)
+		v.verifier = oidc.NewVerifier(v.options.Issuer, keySet, config)
+		return v.verifier, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, discoveryTimeout)
+	defer cancel()
+
+	provider, err := oidc.NewProvider(ctx, v.options.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	v.verifier = provider.Verifier(config)
+	return v.verifier, nil
+}
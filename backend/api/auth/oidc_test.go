@@ -0,0 +1,296 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/go-cmp/cmp"
+)
+
+// testIssuer is a stand-in for an OpenID Connect issuer that serves its discovery document and
+// signing keys.
+type testIssuer struct {
+	*httptest.Server
+	key         *rsa.PrivateKey
+	keyFetches  atomic.Int32
+	discoveries atomic.Int32
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	issuer := &testIssuer{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer.discoveries.Add(1)
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                issuer.URL,
+			"jwks_uri":                              issuer.URL + "/keys",
+			"authorization_endpoint":                issuer.URL + "/authorize",
+			"token_endpoint":                        issuer.URL + "/token",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.keyFetches.Add(1)
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+
+	return issuer
+}
+
+func (i *testIssuer) sign(t *testing.T, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create signer: %v", err)
+	}
+
+	token, err := jwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return token
+}
+
+func (i *testIssuer) claims(overrides map[string]any) map[string]any {
+	claims := map[string]any{
+		"iss": i.URL,
+		"aud": "construct-cli",
+		"sub": "alice",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"iat": time.Now().Unix(),
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+func TestOIDCVerifier_Verify(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+
+	verifier, err := NewOIDCVerifier(OIDCOptions{
+		Issuer:        issuer.URL,
+		Audience:      "construct-cli",
+		SubjectClaim:  "email",
+		GroupScopes:   map[string][]Scope{"platform": {ScopeAgentsAdmin}},
+		DefaultScopes: []Scope{ScopeEventsSubscribe},
+	})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		key      *rsa.PrivateKey
+		claims   map[string]any
+		want     *Identity
+		wantCode connect.Code
+	}{
+		{
+			name:   "scopes from scope claim, groups and defaults",
+			claims: map[string]any{"email": "alice@example.com", "scope": "openid email tasks:write", "groups": []string{"platform", "staff"}},
+			want: &Identity{
+				Subject:    "oidc:alice@example.com",
+				AuthMethod: AuthMethodOIDC,
+				Scopes:     []Scope{ScopeTasksWrite, ScopeAgentsAdmin, ScopeEventsSubscribe},
+			},
+		},
+		{
+			name:   "scopes from scp list",
+			claims: map[string]any{"email": "bob@example.com", "scp": []string{"audit:read"}},
+			want: &Identity{
+				Subject:    "oidc:bob@example.com",
+				AuthMethod: AuthMethodOIDC,
+				Scopes:     []Scope{ScopeAuditRead, ScopeEventsSubscribe},
+			},
+		},
+		{
+			name:     "missing subject claim",
+			claims:   map[string]any{"scope": "tasks:read"},
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "wrong audience",
+			claims:   map[string]any{"email": "alice@example.com", "aud": "other-app"},
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "wrong issuer",
+			claims:   map[string]any{"email": "alice@example.com", "iss": "https://evil.example.com"},
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "expired",
+			claims:   map[string]any{"email": "alice@example.com", "exp": time.Now().Add(-time.Minute).Unix()},
+			wantCode: connect.CodeUnauthenticated,
+		},
+		{
+			name:     "signed with unknown key",
+			key:      otherKey,
+			claims:   map[string]any{"email": "alice@example.com"},
+			wantCode: connect.CodeUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := issuer.key
+			if tt.key != nil {
+				key = tt.key
+			}
+			token := issuer.sign(t, key, issuer.claims(tt.claims))
+
+			identity, err := verifier.Verify(context.Background(), token)
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("Verify() error = %v, want code %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+
+			if diff := cmp.Diff(tt.want, identity, cmp.FilterPath(func(p cmp.Path) bool {
+				return p.String() == "ExpiresAt"
+			}, cmp.Ignore())); diff != "" {
+				t.Errorf("Verify() mismatch (-want +got):\n%s", diff)
+			}
+			if identity.ExpiresAt.IsZero() {
+				t.Error("Verify() identity has no expiry")
+			}
+		})
+	}
+
+	if discoveries := issuer.discoveries.Load(); discoveries != 1 {
+		t.Errorf("discovery document fetched %d times, want 1", discoveries)
+	}
+	// The keys are fetched once and again for the token with the unknown key.
+	if fetches := issuer.keyFetches.Load(); fetches != 2 {
+		t.Errorf("keys fetched %d times, want 2", fetches)
+	}
+}
+
+func TestOIDCVerifier_NoScopes(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	verifier, err := NewOIDCVerifier(OIDCOptions{Issuer: issuer.URL, Audience: "construct-cli"})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() error = %v", err)
+	}
+
+	token := issuer.sign(t, issuer.key, issuer.claims(map[string]any{"scope": "openid profile"}))
+	if _, err := verifier.Verify(context.Background(), token); connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Errorf("Verify() error = %v, want unauthenticated", err)
+	}
+}
+
+func TestOIDCVerifier_JWKSURL(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	verifier, err := NewOIDCVerifier(OIDCOptions{
+		Issuer:        issuer.URL,
+		Audience:      "construct-cli",
+		JWKSURL:       issuer.URL + "/keys",
+		DefaultScopes: []Scope{ScopeTasksRead},
+	})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() error = %v", err)
+	}
+
+	token := issuer.sign(t, issuer.key, issuer.claims(nil))
+	identity, err := verifier.Verify(context.Background(), token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if identity.Subject != "oidc:alice" {
+		t.Errorf("Verify() subject = %q, want %q", identity.Subject, "oidc:alice")
+	}
+	if discoveries := issuer.discoveries.Load(); discoveries != 0 {
+		t.Errorf("discovery document fetched %d times, want 0", discoveries)
+	}
+}
+
+func TestOIDCVerifier_UnreachableIssuer(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	verifier, err := NewOIDCVerifier(OIDCOptions{Issuer: server.URL, Audience: "construct-cli"})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() error = %v", err)
+	}
+
+	if _, err := verifier.Verify(context.Background(), "header.payload.signature"); connect.CodeOf(err) != connect.CodeUnavailable {
+		t.Errorf("Verify() error = %v, want unavailable", err)
+	}
+}
+
+func TestOIDCOptions_Validate(t *testing.T) {
+	if err := (OIDCOptions{}).Validate(); err != nil {
+		t.Errorf("Validate() of disabled options error = %v", err)
+	}
+	if err := (OIDCOptions{Issuer: "https://issuer.example.com"}).Validate(); err == nil {
+		t.Error("Validate() without audience error = nil, want error")
+	}
+}
+
+func TestAuthInterceptor_OIDC(t *testing.T) {
+	issuer := newTestIssuer(t)
+
+	verifier, err := NewOIDCVerifier(OIDCOptions{Issuer: issuer.URL, Audience: "construct-cli"})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() error = %v", err)
+	}
+
+	var identity *Identity
+	handler := NewAuthInterceptor(nil, NewTokenProvider(), UnixSocketPolicy{}, verifier).Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity = FromContext(r.Context())
+	}))
+
+	token := issuer.sign(t, issuer.key, issuer.claims(map[string]any{"scope": "metrics:read"}))
+	req := httptest.NewRequest(http.MethodGet, MetricsPath, nil).WithContext(WithTransport(context.Background(), TransportTCP))
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+	if identity == nil || identity.Subject != "oidc:alice" || identity.AuthMethod != AuthMethodOIDC {
+		t.Errorf("identity = %+v, want oidc:alice authenticated with oidc", identity)
+	}
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os/user"
+	"slices"
+	"strconv"
+)
+
+// ErrPeerCredentialsUnsupported is returned on platforms where the credentials of the process on
+// the other end of a Unix socket cannot be determined.
+var ErrPeerCredentialsUnsupported = errors.New("peer credentials are not supported on this platform")
+
+// PeerCredentials identify the process on the other end of a Unix socket connection.
+type PeerCredentials struct {
+	UID uint32
+	GID uint32
+	PID int32
+}
+
+func (p *PeerCredentials) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any("uid", p.UID),
+		slog.Any("gid", p.GID),
+		slog.Any("pid", p.PID),
+	)
+}
+
+// Username resolves the name of the peer's user, falling back to the numeric ID.
+func (p *PeerCredentials) Username() string {
+	u, err := user.LookupId(strconv.FormatUint(uint64(p.UID), 10))
+	if err != nil {
+		return strconv.FormatUint(uint64(p.UID), 10)
+	}
+	return u.Username
+}
+
+type peer struct {
+	credentials *PeerCredentials
+	err         error
+}
+
+type peerKey struct{}
+
+// ConnContext records the transport of a connection and, for Unix sockets, the credentials of
+// the connecting process. For TLS connections, the client certificate can be retrieved later
+// with ClientCertificateFromContext. It is meant to be used as http.Server.ConnContext.
+func ConnContext(ctx context.Context, c net.Conn) context.Context {
+	if conn, ok := c.(*tls.Conn); ok {
+		return withTLSConn(WithTransport(ctx, TransportTCP), conn)
+	}
+
+	conn, ok := c.(*net.UnixConn)
+	if !ok {
+		return WithTransport(ctx, TransportTCP)
+	}
+
+	ctx = WithTransport(ctx, TransportUnix)
+	credentials, err := ReadPeerCredentials(conn)
+	if errors.Is(err, ErrPeerCredentialsUnsupported) {
+		return ctx
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "failed to read peer credentials", "error", err)
+	} else {
+		slog.DebugContext(ctx, "unix socket connection accepted", "peer", credentials)
+	}
+
+	return context.WithValue(ctx, peerKey{}, &peer{credentials: credentials, err: err})
+}
+
+// WithPeerCredentials records the credentials of the process on the other end of a Unix socket.
+func WithPeerCredentials(ctx context.Context, credentials *PeerCredentials) context.Context {
+	return context.WithValue(ctx, peerKey{}, &peer{credentials: credentials})
+}
+
+// PeerCredentialsFromContext returns the credentials of the connecting process. It returns nil
+// without an error if they are not known, e.g. because the platform does not support them.
+func PeerCredentialsFromContext(ctx context.Context) (*PeerCredentials, error) {
+	p, ok := ctx.Value(peerKey{}).(*peer)
+	if !ok {
+		return nil, nil
+	}
+	return p.credentials, p.err
+}
+
+// UnixSocketPolicy decides how local users that connect over the Unix socket are authenticated.
+// The owner of the daemon and root are always admins. Other users are rejected unless they are
+// allowed by name, UID, group name or GID, in which case they get a scoped identity.
+type UnixSocketPolicy struct {
+	Users  []string
+	Groups []string
+	// Scopes are granted to allowed users. DefaultUnixSocketScopes is used if empty.
+	Scopes []Scope
+}
+
+// DefaultUnixSocketScopes lets allowed local users work with tasks, but not manage the daemon.
+func DefaultUnixSocketScopes() []Scope {
+	return []Scope{ScopeTasksWrite, ScopeAgentsRead, ScopeProvidersRead, ScopeEventsSubscribe}
+}
+
+func (p UnixSocketPolicy) scopes() []Scope {
+	if len(p.Scopes) == 0 {
+		return DefaultUnixSocketScopes()
+	}
+	return p.Scopes
+}
+
+func (p UnixSocketPolicy) allows(credentials *PeerCredentials) bool {
+	uid := strconv.FormatUint(uint64(credentials.UID), 10)
+	u, err := user.LookupId(uid)
+	if err != nil {
+		u = nil
+	}
+
+	for _, entry := range p.Users {
+		if entry == uid || (u != nil && entry == u.Username) {
+			return true
+		}
+	}
+
+	if len(p.Groups) == 0 {
+		return false
+	}
+
+	gids := []string{strconv.FormatUint(uint64(credentials.GID), 10)}
+	if u != nil {
+		if groupIDs, err := u.GroupIds(); err == nil {
+			gids = append(gids, groupIDs...)
+		}
+	}
+
+	for _, entry := range p.Groups {
+		gid := entry
+		if _, err := strconv.ParseUint(entry, 10, 32); err != nil {
+			group, err := user.LookupGroup(entry)
+			if err != nil {
+				continue
+			}
+			gid = group.Gid
+		}
+		if slices.Contains(gids, gid) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate makes sure that the users and groups of the policy exist.
+func (p UnixSocketPolicy) Validate() error {
+	for _, entry := range p.Users {
+		if _, err := strconv.ParseUint(entry, 10, 32); err == nil {
+			continue
+		}
+		if _, err := user.Lookup(entry); err != nil {
+			return fmt.Errorf("unknown user %q: %w", entry, err)
+		}
+	}
+
+	for _, entry := range p.Groups {
+		if _, err := strconv.ParseUint(entry, 10, 32); err == nil {
+			continue
+		}
+		if _, err := user.LookupGroup(entry); err != nil {
+			return fmt.Errorf("unknown group %q: %w", entry, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+//go:build darwin
+
+package auth
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+// ReadPeerCredentials reads the credentials of the connecting process with LOCAL_PEERCRED and
+// LOCAL_PEERPID.
+func ReadPeerCredentials(conn *net.UnixConn) (*PeerCredentials, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var xucred *unix.Xucred
+	var pid int
+	var sockErr error
+	err = raw.Control(func(fd uintptr) {
+		xucred, sockErr = unix.GetsockoptXucred(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERCRED)
+		if sockErr != nil {
+			return
+		}
+		pid, sockErr = unix.GetsockoptInt(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERPID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if sockErr != nil {
+		return nil, fmt.Errorf("failed to read LOCAL_PEERCRED: %w", sockErr)
+	}
+
+	credentials := &PeerCredentials{UID: xucred.Uid, PID: int32(pid)}
+	if xucred.Ngroups > 0 {
+		credentials.GID = xucred.Groups[0]
+	}
+	return credentials, nil
+}
@@ -0,0 +1,39 @@
+//go:build linux
+
+package auth
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadPeerCredentials(t *testing.T) {
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: filepath.Join(t.TempDir(), "construct.sock"), Net: "unix"})
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	client, err := net.Dial("unix", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer client.Close()
+
+	conn, err := listener.AcceptUnix()
+	if err != nil {
+		t.Fatalf("failed to accept: %v", err)
+	}
+	defer conn.Close()
+
+	credentials, err := ReadPeerCredentials(conn)
+	if err != nil {
+		t.Fatalf("ReadPeerCredentials() failed: %v", err)
+	}
+
+	if credentials.UID != uint32(os.Getuid()) || credentials.GID != uint32(os.Getgid()) || credentials.PID != int32(os.Getpid()) {
+		t.Errorf("ReadPeerCredentials() = %+v, want uid %d, gid %d and pid %d", credentials, os.Getuid(), os.Getgid(), os.Getpid())
+	}
+}
@@ -0,0 +1,9 @@
+//go:build !linux && !darwin
+
+package auth
+
+import "net"
+
+func ReadPeerCredentials(conn *net.UnixConn) (*PeerCredentials, error) {
+	return nil, ErrPeerCredentialsUnsupported
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1connect "github.com/furisto/construct/api/go/v1/v1connect"
+)
+
+// Scope grants access to a group of procedures.
+type Scope string
+
+const (
+	ScopeTasksRead       Scope = "tasks:read"
+	ScopeTasksWrite      Scope = "tasks:write"
+	ScopeAgentsRead      Scope = "agents:read"
+	ScopeAgentsAdmin     Scope = "agents:admin"
+	ScopeProvidersRead   Scope = "providers:read"
+	ScopeProvidersAdmin  Scope = "providers:admin"
+	ScopeEventsSubscribe Scope = "events:subscribe"
+	ScopeWebhooksAdmin   Scope = "webhooks:admin"
+	ScopeMetricsRead     Scope = "metrics:read"
+	ScopeAuditRead       Scope = "audit:read"
+)
+
+// scopeAdmin marks procedures that are reserved for admin identities, whatever their scopes.
+const scopeAdmin Scope = "admin"
+
+// scopeAuthenticated marks procedures that every authenticated identity may call. Their handlers
+// decide what the identity may do.
+const scopeAuthenticated Scope = "authenticated"
+
+// MetricsPath is the path of the Prometheus endpoint.
+const MetricsPath = "/metrics"
+
+// Scopes returns all scopes in the order they are documented.
+func Scopes() []Scope {
+	return []Scope{
+		ScopeTasksRead,
+		ScopeTasksWrite,
+		ScopeAgentsRead,
+		ScopeAgentsAdmin,
+		ScopeProvidersRead,
+		ScopeProvidersAdmin,
+		ScopeEventsSubscribe,
+		ScopeWebhooksAdmin,
+		ScopeMetricsRead,
+		ScopeAuditRead,
+	}
+}
+
+// impliedScopes lists the scopes that are included in a broader scope.
+var impliedScopes = map[Scope][]Scope{
+	ScopeTasksWrite:     {ScopeTasksRead},
+	ScopeAgentsAdmin:    {ScopeAgentsRead},
+	ScopeProvidersAdmin: {ScopeProvidersRead},
+}
+
+// procedureScopes maps every procedure and HTTP path that requires authentication to the scope
+// that grants access to it. Procedures that are missing are denied to scoped identities.
+var procedureScopes = map[string]Scope{
+	v1connect.AuthServiceCreateTokenProcedure:     scopeAdmin,
+	v1connect.AuthServiceCreateSetupCodeProcedure: scopeAdmin,
+	v1connect.AuthServiceListTokensProcedure:      scopeAdmin,
+	v1connect.AuthServiceRevokeTokenProcedure:     scopeAdmin,
+	v1connect.AuthServiceRotateTokenProcedure:     scopeAuthenticated,
+
+	v1connect.EncryptionServiceRotateEncryptionKeyProcedure:    scopeAdmin,
+	v1connect.EncryptionServiceExportEncryptionKeysetProcedure: scopeAdmin,
+	v1connect.EncryptionServiceImportEncryptionKeysetProcedure: scopeAdmin,
+
+	v1connect.TaskServiceGetTaskProcedure:           ScopeTasksRead,
+	v1connect.TaskServiceListTasksProcedure:         ScopeTasksRead,
+	v1connect.TaskServiceGetModelCallTraceProcedure: ScopeTasksRead,
+	v1connect.QuotaServiceGetQuotaUsageProcedure:    ScopeTasksRead,
+	v1connect.MessageServiceGetMessageProcedure:     ScopeTasksRead,
+	v1connect.MessageServiceListMessagesProcedure:   ScopeTasksRead,
+
+	v1connect.TaskServiceCreateTaskProcedure:       ScopeTasksWrite,
+	v1connect.TaskServiceUpdateTaskProcedure:       ScopeTasksWrite,
+	v1connect.TaskServiceDeleteTaskProcedure:       ScopeTasksWrite,
+	v1connect.TaskServiceSuspendTaskProcedure:      ScopeTasksWrite,
+	v1connect.TaskServiceShareTaskProcedure:        ScopeTasksWrite,
+	v1connect.TaskServiceUnshareTaskProcedure:      ScopeTasksWrite,
+	v1connect.MessageServiceCreateMessageProcedure: ScopeTasksWrite,
+	v1connect.MessageServiceUpdateMessageProcedure: ScopeTasksWrite,
+	v1connect.MessageServiceDeleteMessageProcedure: ScopeTasksWrite,
+
+	v1connect.AgentServiceGetAgentProcedure:   ScopeAgentsRead,
+	v1connect.AgentServiceListAgentsProcedure: ScopeAgentsRead,
+	v1connect.ModelServiceGetModelProcedure:   ScopeAgentsRead,
+	v1connect.ModelServiceListModelsProcedure: ScopeAgentsRead,
+	v1connect.SkillServiceListSkillsProcedure: ScopeAgentsRead,
+
+	v1connect.AgentServiceCreateAgentProcedure:  ScopeAgentsAdmin,
+	v1connect.AgentServiceUpdateAgentProcedure:  ScopeAgentsAdmin,
+	v1connect.AgentServiceDeleteAgentProcedure:  ScopeAgentsAdmin,
+	v1connect.SkillServiceInstallSkillProcedure: ScopeAgentsAdmin,
+	v1connect.SkillServiceUpdateSkillProcedure:  ScopeAgentsAdmin,
+	v1connect.SkillServiceDeleteSkillProcedure:  ScopeAgentsAdmin,
+
+	v1connect.ModelProviderServiceGetModelProviderProcedure:   ScopeProvidersRead,
+	v1connect.ModelProviderServiceListModelProvidersProcedure: ScopeProvidersRead,
+
+	v1connect.ModelProviderServiceCreateModelProviderProcedure:    ScopeProvidersAdmin,
+	v1connect.ModelProviderServiceUpdateModelProviderProcedure:    ScopeProvidersAdmin,
+	v1connect.ModelProviderServiceDeleteModelProviderProcedure:    ScopeProvidersAdmin,
+	v1connect.ModelProviderServiceTestModelProviderProcedure:      ScopeProvidersAdmin,
+	v1connect.ModelProviderServiceAddModelProviderKeyProcedure:    ScopeProvidersAdmin,
+	v1connect.ModelProviderServiceRetireModelProviderKeyProcedure: ScopeProvidersAdmin,
+	v1connect.ModelServiceCreateModelProcedure:                    ScopeProvidersAdmin,
+	v1connect.ModelServiceUpdateModelProcedure:                    ScopeProvidersAdmin,
+	v1connect.ModelServiceDeleteModelProcedure:                    ScopeProvidersAdmin,
+	v1connect.ModelServiceSyncModelsProcedure:                     ScopeProvidersAdmin,
+
+	v1connect.EventServiceSubscribeProcedure: ScopeEventsSubscribe,
+
+	v1connect.WebhookServiceCreateWebhookProcedure:            ScopeWebhooksAdmin,
+	v1connect.WebhookServiceGetWebhookProcedure:               ScopeWebhooksAdmin,
+	v1connect.WebhookServiceListWebhooksProcedure:             ScopeWebhooksAdmin,
+	v1connect.WebhookServiceUpdateWebhookProcedure:            ScopeWebhooksAdmin,
+	v1connect.WebhookServiceDeleteWebhookProcedure:            ScopeWebhooksAdmin,
+	v1connect.WebhookServiceListWebhookDeliveriesProcedure:    ScopeWebhooksAdmin,
+	v1connect.WebhookServiceRedeliverWebhookDeliveryProcedure: ScopeWebhooksAdmin,
+
+	v1connect.AuditServiceListAuditEventsProcedure: ScopeAuditRead,
+
+	MetricsPath: ScopeMetricsRead,
+}
+
+// unlimitedOnlyScopes cannot be used by identities that are limited to agents or workspaces,
+// because they reach resources outside of these limits.
+var unlimitedOnlyScopes = []Scope{ScopeWebhooksAdmin, ScopeAuditRead}
+
+// explicitScopes have to be granted explicitly. Identities without scopes do not get them, because
+// they reach the resources of other subjects.
+var explicitScopes = []Scope{ScopeAuditRead}
+
+// ParseScopes validates scope names.
+func ParseScopes(names []string) ([]Scope, error) {
+	scopes := make([]Scope, 0, len(names))
+	for _, name := range names {
+		scope := Scope(strings.ToLower(strings.TrimSpace(name)))
+		if !slices.Contains(Scopes(), scope) {
+			return nil, fmt.Errorf("unknown scope %q", name)
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// Authorize checks that the identity may call the procedure. A nil identity is only passed for
+// procedures that do not require authentication.
+func Authorize(identity *Identity, procedure string) error {
+	if identity == nil || identity.IsAdmin {
+		return nil
+	}
+
+	scope, ok := procedureScopes[procedure]
+	switch scope {
+	case scopeAdmin:
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("admin privileges required"))
+	case scopeAuthenticated:
+		return nil
+	}
+
+	if identity.IsLimited() && slices.Contains(unlimitedOnlyScopes, scope) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not available to tokens that are limited to agents or workspaces", procedure))
+	}
+
+	if identity.Scopes == nil {
+		if slices.Contains(explicitScopes, scope) {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s requires a token with scope %s", procedure, scope))
+		}
+		return nil
+	}
+
+	if !ok {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s is not available to scoped tokens", procedure))
+	}
+
+	if !identity.HasScope(scope) {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("token lacks scope %s", scope))
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	v1connect "github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/google/uuid"
+)
+
+func TestAuthorize(t *testing.T) {
+	agentID := uuid.New()
+
+	tests := []struct {
+		name      string
+		identity  *Identity
+		procedure string
+		wantErr   bool
+	}{
+		{name: "unauthenticated procedure", identity: nil, procedure: v1connect.AuthServiceExchangeSetupCodeProcedure},
+		{name: "admin", identity: &Identity{IsAdmin: true}, procedure: v1connect.AuthServiceCreateTokenProcedure},
+		{name: "unscoped token", identity: &Identity{}, procedure: v1connect.ModelProviderServiceDeleteModelProviderProcedure},
+		{name: "unscoped token on admin procedure", identity: &Identity{}, procedure: v1connect.AuthServiceCreateTokenProcedure, wantErr: true},
+		{name: "scoped token on admin procedure", identity: &Identity{Scopes: Scopes()}, procedure: v1connect.AuthServiceListTokensProcedure, wantErr: true},
+		{name: "granted scope", identity: &Identity{Scopes: []Scope{ScopeTasksRead}}, procedure: v1connect.TaskServiceListTasksProcedure},
+		{name: "missing scope", identity: &Identity{Scopes: []Scope{ScopeTasksRead}}, procedure: v1connect.TaskServiceCreateTaskProcedure, wantErr: true},
+		{name: "implied scope", identity: &Identity{Scopes: []Scope{ScopeTasksWrite}}, procedure: v1connect.MessageServiceListMessagesProcedure},
+		{name: "read scope does not imply admin", identity: &Identity{Scopes: []Scope{ScopeProvidersRead}}, procedure: v1connect.ModelServiceSyncModelsProcedure, wantErr: true},
+		{name: "empty scopes", identity: &Identity{Scopes: []Scope{}}, procedure: v1connect.TaskServiceGetTaskProcedure, wantErr: true},
+		{name: "unmapped procedure", identity: &Identity{Scopes: Scopes()}, procedure: "/construct.v1.UnknownService/Call", wantErr: true},
+		{name: "metrics", identity: &Identity{Scopes: []Scope{ScopeMetricsRead}}, procedure: MetricsPath},
+		{name: "webhooks", identity: &Identity{Scopes: []Scope{ScopeWebhooksAdmin}}, procedure: v1connect.WebhookServiceListWebhooksProcedure},
+		{name: "webhooks with agent limit", identity: &Identity{Scopes: []Scope{ScopeWebhooksAdmin}, AgentIDs: []uuid.UUID{agentID}}, procedure: v1connect.WebhookServiceListWebhooksProcedure, wantErr: true},
+		{name: "unscoped token on audit log", identity: &Identity{}, procedure: v1connect.AuditServiceListAuditEventsProcedure, wantErr: true},
+		{name: "audit log", identity: &Identity{Scopes: []Scope{ScopeAuditRead}}, procedure: v1connect.AuditServiceListAuditEventsProcedure},
+		{name: "unscoped webhooks with workspace limit", identity: &Identity{Workspaces: []string{"/src"}}, procedure: v1connect.WebhookServiceCreateWebhookProcedure, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Authorize(tt.identity, tt.procedure)
+			if tt.wantErr {
+				if connect.CodeOf(err) != connect.CodePermissionDenied {
+					t.Errorf("Authorize() error = %v, want permission denied", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Authorize() unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseScopes(t *testing.T) {
+	scopes, err := ParseScopes([]string{"tasks:read", " Agents:Admin ", "tasks:read"})
+	if err != nil {
+		t.Fatalf("ParseScopes() failed: %v", err)
+	}
+	if len(scopes) != 2 || scopes[0] != ScopeTasksRead || scopes[1] != ScopeAgentsAdmin {
+		t.Errorf("ParseScopes() = %v, want [tasks:read agents:admin]", scopes)
+	}
+
+	if _, err := ParseScopes([]string{"tasks:delete"}); err == nil {
+		t.Error("ParseScopes() expected error for unknown scope")
+	}
+}
+
+func TestIdentity_CanAccessWorkspace(t *testing.T) {
+	identity := &Identity{Workspaces: []string{"/home/user/project"}}
+
+	tests := []struct {
+		directory string
+		want      bool
+	}{
+		{directory: "/home/user/project", want: true},
+		{directory: "/home/user/project/", want: true},
+		{directory: "/home/user/project/backend", want: true},
+		{directory: "/home/user/project/../other", want: false},
+		{directory: "/home/user/project-other", want: false},
+		{directory: "/home/user", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := identity.CanAccessWorkspace(tt.directory); got != tt.want {
+			t.Errorf("CanAccessWorkspace(%q) = %v, want %v", tt.directory, got, tt.want)
+		}
+	}
+}
@@ -19,8 +19,42 @@ const (
 	MaxSetupExpiry     = 72 * time.Hour
 	DefaultTokenExpiry = 90 * 24 * time.Hour
 	MaxTokenExpiry     = 365 * 24 * time.Hour
+
+	// DefaultRotationOverlap and MaxRotationOverlap bound how long a token stays valid after it
+	// was replaced by a rotation.
+	DefaultRotationOverlap = 24 * time.Hour
+	MaxRotationOverlap     = 7 * 24 * time.Hour
+
+	// TokenRotateAfterHeader tells clients of a token when they should rotate it. It is sent with
+	// the responses to requests that are authenticated with a token.
+	TokenRotateAfterHeader = "Construct-Token-Rotate-After"
 )
 
+// RotateAfter returns when a token that was issued at issuedAt and expires at expiresAt should be
+// rotated: once two thirds of its lifetime have passed, but at least a week before it expires.
+func RotateAfter(issuedAt, expiresAt time.Time) time.Time {
+	window := min(expiresAt.Sub(issuedAt)/3, 7*24*time.Hour)
+	return expiresAt.Add(-window)
+}
+
+// ValidateTokenName checks that a token can be given the name. The name of a token is the subject
+// of its identity, so it must not take the form of a subject that identities of the other
+// authentication methods have.
+func ValidateTokenName(name string) error {
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if name == LocalAdminSubject {
+		return fmt.Errorf("name %q is reserved", name)
+	}
+	for _, prefix := range []string{OIDCSubjectPrefix, UnixSubjectPrefix} {
+		if strings.HasPrefix(name, prefix) {
+			return fmt.Errorf("name must not start with %q", prefix)
+		}
+	}
+	return nil
+}
+
 type TokenProvider struct {
 	mu           sync.RWMutex
 	pendingCodes map[string]*PendingSetupCode
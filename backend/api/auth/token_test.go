@@ -232,3 +232,24 @@ func mustGenerateToken(t *testing.T, provider *TokenProvider) string {
 	}
 	return plaintext
 }
+
+func TestRotateAfter(t *testing.T) {
+	issuedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt time.Time
+		want      time.Time
+	}{
+		{name: "short lived token after two thirds", expiresAt: issuedAt.Add(3 * time.Hour), want: issuedAt.Add(2 * time.Hour)},
+		{name: "long lived token a week before expiry", expiresAt: issuedAt.Add(90 * 24 * time.Hour), want: issuedAt.Add(83 * 24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RotateAfter(issuedAt, tt.expiresAt); !got.Equal(tt.want) {
+				t.Errorf("RotateAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
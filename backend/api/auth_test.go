@@ -1,19 +1,25 @@
 package api
 
 import (
+	"bytes"
 	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/auth"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestCreateToken(t *testing.T) {
@@ -69,6 +75,80 @@ func TestCreateToken(t *testing.T) {
 				Error: "already_exists: token with name \"duplicate-token\" already exists",
 			},
 		},
+		{
+			Name: "success with scopes and limits",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				model := test.NewModelBuilder(t, uuid.New(), db,
+					test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+				test.NewAgentBuilder(t, uuid.MustParse("8b9f3c6e-4f0a-4d2a-9c1e-5a7b3d2e1f00"), db, model).Build(ctx)
+			},
+			Request: &v1.CreateTokenRequest{
+				Name:       "scoped-token",
+				Scopes:     []string{"tasks:write", "events:subscribe"},
+				AgentIds:   []string{"8b9f3c6e-4f0a-4d2a-9c1e-5a7b3d2e1f00"},
+				Workspaces: []string{"/home/user/project"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Response: v1.CreateTokenResponse{},
+			},
+		},
+		{
+			Name: "unknown scope",
+			Request: &v1.CreateTokenRequest{
+				Name:   "scoped-token",
+				Scopes: []string{"tasks:delete"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Error: "invalid_argument: unknown scope \"tasks:delete\"",
+			},
+		},
+		{
+			Name: "unknown agent",
+			Request: &v1.CreateTokenRequest{
+				Name:     "scoped-token",
+				AgentIds: []string{"8b9f3c6e-4f0a-4d2a-9c1e-5a7b3d2e1f00"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Error: "invalid_argument: agent 8b9f3c6e-4f0a-4d2a-9c1e-5a7b3d2e1f00 not found",
+			},
+		},
+		{
+			Name: "reserved name of local admin",
+			Request: &v1.CreateTokenRequest{
+				Name: "local-admin",
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Error: "invalid_argument: name \"local-admin\" is reserved",
+			},
+		},
+		{
+			Name: "reserved name of oidc subject",
+			Request: &v1.CreateTokenRequest{
+				Name: "oidc:alice",
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Error: "invalid_argument: name must not start with \"oidc:\"",
+			},
+		},
+		{
+			Name: "reserved name of unix subject",
+			Request: &v1.CreateTokenRequest{
+				Name: "unix:alice",
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Error: "invalid_argument: name must not start with \"unix:\"",
+			},
+		},
+		{
+			Name: "relative workspace",
+			Request: &v1.CreateTokenRequest{
+				Name:       "scoped-token",
+				Workspaces: []string{"project"},
+			},
+			Expected: ServiceTestExpectation[v1.CreateTokenResponse]{
+				Error: "invalid_argument: workspace \"project\" must be an absolute path",
+			},
+		},
 	})
 }
 
@@ -94,6 +174,33 @@ func TestCreateSetupCode(t *testing.T) {
 				Error: "invalid_argument: token_name is required",
 			},
 		},
+		{
+			Name: "reserved token name of local admin",
+			Request: &v1.CreateSetupCodeRequest{
+				TokenName: "local-admin",
+			},
+			Expected: ServiceTestExpectation[v1.CreateSetupCodeResponse]{
+				Error: "invalid_argument: invalid token_name: name \"local-admin\" is reserved",
+			},
+		},
+		{
+			Name: "reserved token name of oidc subject",
+			Request: &v1.CreateSetupCodeRequest{
+				TokenName: "oidc:alice",
+			},
+			Expected: ServiceTestExpectation[v1.CreateSetupCodeResponse]{
+				Error: "invalid_argument: invalid token_name: name must not start with \"oidc:\"",
+			},
+		},
+		{
+			Name: "reserved token name of unix subject",
+			Request: &v1.CreateSetupCodeRequest{
+				TokenName: "unix:alice",
+			},
+			Expected: ServiceTestExpectation[v1.CreateSetupCodeResponse]{
+				Error: "invalid_argument: invalid token_name: name must not start with \"unix:\"",
+			},
+		},
 		{
 			Name: "success with defaults",
 			Request: &v1.CreateSetupCodeRequest{
@@ -149,6 +256,31 @@ func TestListTokens(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "list scoped token",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				test.NewTokenBuilder(t, uuid.New(), db).
+					WithName("scoped-token").
+					WithScopes("tasks:read", "events:subscribe").
+					WithAgentIDs(uuid.MustParse("8b9f3c6e-4f0a-4d2a-9c1e-5a7b3d2e1f00")).
+					WithWorkspaces("/home/user/project").
+					Build(ctx)
+			},
+			Request: &v1.ListTokensRequest{},
+			Expected: ServiceTestExpectation[v1.ListTokensResponse]{
+				Response: v1.ListTokensResponse{
+					Tokens: []*v1.TokenInfo{
+						{
+							Name:       "scoped-token",
+							IsActive:   true,
+							Scopes:     []string{"tasks:read", "events:subscribe"},
+							AgentIds:   []string{"8b9f3c6e-4f0a-4d2a-9c1e-5a7b3d2e1f00"},
+							Workspaces: []string{"/home/user/project"},
+						},
+					},
+				},
+			},
+		},
 		{
 			Name: "filter by name prefix",
 			SeedDatabase: func(ctx context.Context, db *memory.Client) {
@@ -283,3 +415,265 @@ func TestExchangeSetupCode(t *testing.T) {
 		},
 	})
 }
+
+func TestExchangeSetupCodeReservedName(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	// Setup codes for reserved names cannot be created through the API. The exchange checks the
+	// name again, so that a setup code never bypasses the check.
+	tokenProvider := auth.NewTokenProvider()
+	handler := NewAuthHandler(db, tokenProvider)
+
+	for _, name := range []string{"local-admin", "oidc:alice", "unix:alice"} {
+		t.Run(name, func(t *testing.T) {
+			setupCode, err := tokenProvider.CreateSetupCode(name, time.Minute, time.Hour)
+			if err != nil {
+				t.Fatalf("failed creating setup code: %v", err)
+			}
+
+			_, err = handler.ExchangeSetupCode(ctx, connect.NewRequest(&v1.ExchangeSetupCodeRequest{SetupCode: setupCode.Code}))
+			if connect.CodeOf(err) != connect.CodeInvalidArgument {
+				t.Fatalf("ExchangeSetupCode() error = %v, want invalid_argument", err)
+			}
+			if db.Token.Query().CountX(ctx) != 0 {
+				t.Error("token with a reserved name was created")
+			}
+		})
+	}
+}
+
+func TestRotateToken(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	handler := NewAuthHandler(db, auth.NewTokenProvider())
+	admin := auth.WithIdentity(ctx, &auth.Identity{Subject: "local-admin", AuthMethod: auth.AuthMethodUnixSocket, IsAdmin: true})
+
+	tests := []struct {
+		name              string
+		expiresAt         time.Duration
+		identity          func(tok *memory.Token) *auth.Identity
+		request           func(tok *memory.Token) *v1.RotateTokenRequest
+		wantCode          connect.Code
+		wantExpiresIn     time.Duration
+		wantPreviousUntil time.Duration
+	}{
+		{
+			name:      "admin keeps lifetime and default overlap",
+			expiresAt: 30 * 24 * time.Hour,
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String())}
+			},
+			wantExpiresIn:     30 * 24 * time.Hour,
+			wantPreviousUntil: auth.DefaultRotationOverlap,
+		},
+		{
+			name:      "token rotates itself with explicit expiry and overlap",
+			expiresAt: 30 * 24 * time.Hour,
+			identity: func(tok *memory.Token) *auth.Identity {
+				return &auth.Identity{Subject: tok.Name, AuthMethod: auth.AuthMethodToken, TokenID: tok.ID, IssuedAt: tok.CreateTime}
+			},
+			request: func(*memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{ExpiresIn: durationpb.New(7 * 24 * time.Hour), Overlap: durationpb.New(time.Hour)}
+			},
+			wantExpiresIn:     7 * 24 * time.Hour,
+			wantPreviousUntil: time.Hour,
+		},
+		{
+			name:      "token cannot extend its lifetime",
+			expiresAt: 7 * 24 * time.Hour,
+			identity: func(tok *memory.Token) *auth.Identity {
+				return &auth.Identity{Subject: tok.Name, AuthMethod: auth.AuthMethodToken, TokenID: tok.ID, IssuedAt: tok.CreateTime}
+			},
+			request: func(*memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{ExpiresIn: durationpb.New(30 * 24 * time.Hour)}
+			},
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:      "admin extends lifetime",
+			expiresAt: 7 * 24 * time.Hour,
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String()), ExpiresIn: durationpb.New(30 * 24 * time.Hour)}
+			},
+			wantExpiresIn:     30 * 24 * time.Hour,
+			wantPreviousUntil: auth.DefaultRotationOverlap,
+		},
+		{
+			name:      "zero expiry",
+			expiresAt: time.Hour,
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String()), ExpiresIn: durationpb.New(0)}
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "negative expiry",
+			expiresAt: time.Hour,
+			identity: func(tok *memory.Token) *auth.Identity {
+				return &auth.Identity{Subject: tok.Name, AuthMethod: auth.AuthMethodToken, TokenID: tok.ID, IssuedAt: tok.CreateTime}
+			},
+			request: func(*memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{ExpiresIn: durationpb.New(-time.Hour)}
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "overlap ends with the expiry of the token",
+			expiresAt: time.Hour,
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String())}
+			},
+			wantExpiresIn:     time.Hour,
+			wantPreviousUntil: time.Hour,
+		},
+		{
+			name:      "token cannot rotate another token",
+			expiresAt: time.Hour,
+			identity: func(tok *memory.Token) *auth.Identity {
+				return &auth.Identity{Subject: "other", AuthMethod: auth.AuthMethodToken, TokenID: uuid.New(), IssuedAt: time.Now()}
+			},
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String())}
+			},
+			wantCode: connect.CodePermissionDenied,
+		},
+		{
+			name:      "replaced token cannot rotate",
+			expiresAt: time.Hour,
+			identity: func(tok *memory.Token) *auth.Identity {
+				return &auth.Identity{Subject: tok.Name, AuthMethod: auth.AuthMethodToken, TokenID: tok.ID}
+			},
+			request:  func(*memory.Token) *v1.RotateTokenRequest { return &v1.RotateTokenRequest{} },
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name:      "overlap exceeds maximum",
+			expiresAt: time.Hour,
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String()), Overlap: durationpb.New(30 * 24 * time.Hour)}
+			},
+			wantCode: connect.CodeInvalidArgument,
+		},
+		{
+			name:      "expired token",
+			expiresAt: -time.Hour,
+			request: func(tok *memory.Token) *v1.RotateTokenRequest {
+				return &v1.RotateTokenRequest{Id: strPtr(tok.ID.String())}
+			},
+			wantCode: connect.CodeFailedPrecondition,
+		},
+		{
+			name:     "admin without id",
+			request:  func(*memory.Token) *v1.RotateTokenRequest { return &v1.RotateTokenRequest{} },
+			wantCode: connect.CodeInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tok := test.NewTokenBuilder(t, uuid.New(), db).WithName("token-" + uuid.NewString()).WithExpiresAt(time.Now().Add(tt.expiresAt)).Build(ctx)
+
+			callCtx := admin
+			if tt.identity != nil {
+				callCtx = auth.WithIdentity(ctx, tt.identity(tok))
+			}
+
+			resp, err := handler.RotateToken(callCtx, connect.NewRequest(tt.request(tok)))
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Fatalf("RotateToken() error = %v, want code %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RotateToken() error = %v", err)
+			}
+
+			rotated := db.Token.GetX(ctx, tok.ID)
+			if resp.Msg.Id != tok.ID.String() || resp.Msg.Name != tok.Name {
+				t.Errorf("RotateToken() rotated %s (%s), want %s (%s)", resp.Msg.Id, resp.Msg.Name, tok.ID, tok.Name)
+			}
+			if !bytes.Equal(rotated.TokenHash, auth.NewTokenProvider().HashToken(resp.Msg.Token)) {
+				t.Error("token hash does not match the successor")
+			}
+			if !bytes.Equal(rotated.PreviousTokenHash, tok.TokenHash) {
+				t.Error("previous token hash does not match the rotated token")
+			}
+
+			approx := cmpopts.EquateApproxTime(time.Minute)
+			if diff := cmp.Diff(time.Now().Add(tt.wantExpiresIn), rotated.ExpiresAt, approx); diff != "" {
+				t.Errorf("expires at mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(time.Now().Add(tt.wantPreviousUntil), *rotated.PreviousExpiresAt, approx); diff != "" {
+				t.Errorf("previous expires at mismatch (-want +got):\n%s", diff)
+			}
+			if rotated.RotatedAt == nil {
+				t.Error("rotated at is not set")
+			}
+		})
+	}
+}
+
+func TestRotateTokenTwiceKeepsOriginalValid(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", http.StripPrefix("/api", NewHandler(options)))
+	server := httptest.NewUnstartedServer(mux)
+	server.Config.BaseContext = func(net.Listener) context.Context {
+		return auth.WithTransport(context.Background(), auth.TransportTCP)
+	}
+	server.Start()
+	defer server.Close()
+
+	original, hash, err := options.TokenProvider.GenerateToken()
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	db.Token.Create().SetName("ci").SetTokenHash(hash).SetExpiresAt(time.Now().Add(30 * 24 * time.Hour)).SaveX(ctx)
+
+	clientFor := func(token string) *client.Client {
+		apiClient, err := client.NewClient(client.EndpointContext{Address: server.URL, Kind: "http"}, client.WithAuthToken(token))
+		if err != nil {
+			t.Fatalf("failed to create api client: %v", err)
+		}
+		return apiClient
+	}
+
+	first, err := clientFor(original).Auth().RotateToken(ctx, connect.NewRequest(&v1.RotateTokenRequest{}))
+	if err != nil {
+		t.Fatalf("first RotateToken() error = %v", err)
+	}
+
+	_, err = clientFor(first.Msg.Token).Auth().RotateToken(ctx, connect.NewRequest(&v1.RotateTokenRequest{}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("second RotateToken() error = %v, want failed_precondition", err)
+	}
+
+	for name, token := range map[string]string{"original": original, "successor": first.Msg.Token} {
+		if _, err := clientFor(token).Agent().ListAgents(ctx, connect.NewRequest(&v1.ListAgentsRequest{})); err != nil {
+			t.Errorf("%s token does not authenticate after the second rotation: %v", name, err)
+		}
+	}
+}
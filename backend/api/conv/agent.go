@@ -1,8 +1,12 @@
 package conv
 
 import (
+	"fmt"
+
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
 )
 
 func ConvertAgentToProto(a *memory.Agent) (*v1.Agent, error) {
@@ -22,14 +26,133 @@ func ConvertAgentMetadataToProto(a *memory.Agent) *v1.AgentMetadata {
 		Id:        a.ID.String(),
 		CreatedAt: ConvertTimeToTimestamp(a.CreateTime),
 		UpdatedAt: ConvertTimeToTimestamp(a.UpdateTime),
+		Owner:     a.Owner,
 	}
 }
 
 func ConvertAgentSpecToProto(a *memory.Agent) (*v1.AgentSpec, error) {
 	return &v1.AgentSpec{
-		Name:         a.Name,
-		Description:  a.Description,
-		Instructions: a.Instructions,
-		ModelId:      ConvertUUIDToString(a.ModelID),
+		Name:             a.Name,
+		Description:      a.Description,
+		Instructions:     a.Instructions,
+		ModelId:          ConvertUUIDToString(a.ModelID),
+		FallbackModelIds: ConvertUUIDsToStrings(a.FallbackModelIds),
+		ModelRouter:      ConvertModelRouterToProto(a.ModelRouter),
+		Redaction:        ConvertRedactionToProto(a.Redaction),
 	}, nil
 }
+
+func ConvertRedactionToProto(r *types.Redaction) *v1.Redaction {
+	if r == nil {
+		return nil
+	}
+
+	return &v1.Redaction{
+		Disabled:           r.Disabled,
+		HighEntropyStrings: r.HighEntropyStrings,
+		Patterns:           r.Patterns,
+	}
+}
+
+func ConvertRedactionToMemory(r *v1.Redaction) *types.Redaction {
+	if r == nil {
+		return nil
+	}
+
+	return &types.Redaction{
+		Disabled:           r.Disabled,
+		HighEntropyStrings: r.HighEntropyStrings,
+		Patterns:           r.Patterns,
+	}
+}
+
+func ConvertModelRouterToProto(r *types.ModelRouter) *v1.ModelRouter {
+	if r == nil {
+		return nil
+	}
+
+	rules := make([]*v1.ModelRouteRule, 0, len(r.Rules))
+	for _, rule := range r.Rules {
+		turnTypes := make([]v1.TurnType, 0, len(rule.TurnTypes))
+		for _, turnType := range rule.TurnTypes {
+			turnTypes = append(turnTypes, ConvertTurnTypeToProto(turnType))
+		}
+
+		rules = append(rules, &v1.ModelRouteRule{
+			Name:                  rule.Name,
+			ModelId:               rule.ModelID.String(),
+			TurnTypes:             turnTypes,
+			MinPromptTokens:       rule.MinPromptTokens,
+			MaxPromptTokens:       rule.MaxPromptTokens,
+			MinRecentToolFailures: rule.MinRecentToolFailures,
+			MaxRecentToolFailures: rule.MaxRecentToolFailures,
+		})
+	}
+
+	return &v1.ModelRouter{Rules: rules}
+}
+
+func ConvertModelRouterToMemory(r *v1.ModelRouter) (*types.ModelRouter, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	rules := make([]types.ModelRouteRule, 0, len(r.Rules))
+	for _, rule := range r.Rules {
+		modelID, err := uuid.Parse(rule.ModelId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid model ID format in routing rule %s: %w", rule.Name, err)
+		}
+
+		var turnTypes []types.TurnType
+		for _, turnType := range rule.TurnTypes {
+			converted, err := ConvertTurnTypeToMemory(turnType)
+			if err != nil {
+				return nil, err
+			}
+			turnTypes = append(turnTypes, converted)
+		}
+
+		rules = append(rules, types.ModelRouteRule{
+			Name:                  rule.Name,
+			ModelID:               modelID,
+			TurnTypes:             turnTypes,
+			MinPromptTokens:       rule.MinPromptTokens,
+			MaxPromptTokens:       rule.MaxPromptTokens,
+			MinRecentToolFailures: rule.MinRecentToolFailures,
+			MaxRecentToolFailures: rule.MaxRecentToolFailures,
+		})
+	}
+
+	return &types.ModelRouter{Rules: rules}, nil
+}
+
+func ConvertTurnTypeToProto(t types.TurnType) v1.TurnType {
+	switch t {
+	case types.TurnTypeFirst:
+		return v1.TurnType_TURN_TYPE_FIRST
+	case types.TurnTypeUserMessage:
+		return v1.TurnType_TURN_TYPE_USER_MESSAGE
+	case types.TurnTypeToolResult:
+		return v1.TurnType_TURN_TYPE_TOOL_RESULT
+	case types.TurnTypeToolError:
+		return v1.TurnType_TURN_TYPE_TOOL_ERROR
+	default:
+		return v1.TurnType_TURN_TYPE_UNSPECIFIED
+	}
+}
+
+func ConvertTurnTypeToMemory(t v1.TurnType) (types.TurnType, error) {
+	switch t {
+	case v1.TurnType_TURN_TYPE_FIRST:
+		return types.TurnTypeFirst, nil
+	case v1.TurnType_TURN_TYPE_USER_MESSAGE:
+		return types.TurnTypeUserMessage, nil
+	case v1.TurnType_TURN_TYPE_TOOL_RESULT:
+		return types.TurnTypeToolResult, nil
+	case v1.TurnType_TURN_TYPE_TOOL_ERROR:
+		return types.TurnTypeToolError, nil
+	default:
+		return "", fmt.Errorf("invalid turn type: %v", t)
+	}
+}
@@ -0,0 +1,76 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+func ConvertAuditEventToProto(e *memory.AuditEvent) *v1.AuditEvent {
+	event := &v1.AuditEvent{
+		Id:          e.ID.String(),
+		Kind:        ConvertAuditEventKindToProto(e.Kind),
+		Action:      e.Action,
+		Subject:     e.Subject,
+		AuthMethod:  e.AuthMethod,
+		ResourceIds: e.ResourceIds,
+		Outcome:     ConvertAuditOutcomeToProto(e.Outcome),
+		Error:       e.Error,
+		Details:     e.Details,
+		CreatedAt:   ConvertTimeToTimestamp(e.CreateTime),
+	}
+	if e.TaskID != nil {
+		event.TaskId = strPtr(e.TaskID.String())
+	}
+	if e.AgentID != nil {
+		event.AgentId = strPtr(e.AgentID.String())
+	}
+
+	return event
+}
+
+func ConvertAuditEventKindToProto(kind types.AuditEventKind) v1.AuditEventKind {
+	switch kind {
+	case types.AuditEventKindAPI:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_API
+	case types.AuditEventKindTool:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL
+	default:
+		return v1.AuditEventKind_AUDIT_EVENT_KIND_UNSPECIFIED
+	}
+}
+
+func ConvertAuditEventKindToMemory(kind v1.AuditEventKind) (types.AuditEventKind, error) {
+	switch kind {
+	case v1.AuditEventKind_AUDIT_EVENT_KIND_API:
+		return types.AuditEventKindAPI, nil
+	case v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL:
+		return types.AuditEventKindTool, nil
+	default:
+		return "", fmt.Errorf("unsupported audit event kind: %s", kind)
+	}
+}
+
+func ConvertAuditOutcomeToProto(outcome types.AuditOutcome) v1.AuditOutcome {
+	switch outcome {
+	case types.AuditOutcomeSuccess:
+		return v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS
+	case types.AuditOutcomeFailure:
+		return v1.AuditOutcome_AUDIT_OUTCOME_FAILURE
+	default:
+		return v1.AuditOutcome_AUDIT_OUTCOME_UNSPECIFIED
+	}
+}
+
+func ConvertAuditOutcomeToMemory(outcome v1.AuditOutcome) (types.AuditOutcome, error) {
+	switch outcome {
+	case v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS:
+		return types.AuditOutcomeSuccess, nil
+	case v1.AuditOutcome_AUDIT_OUTCOME_FAILURE:
+		return types.AuditOutcomeFailure, nil
+	default:
+		return "", fmt.Errorf("unsupported audit outcome: %s", outcome)
+	}
+}
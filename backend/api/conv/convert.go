@@ -38,6 +38,17 @@ func ConvertUUIDPtrToString(id *uuid.UUID) string {
 	return id.String()
 }
 
+func ConvertUUIDsToStrings(ids []uuid.UUID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+	return result
+}
+
 func ConvertStringToUUID(id string) (uuid.UUID, error) {
 	if id == "" {
 		return uuid.Nil, nil
@@ -56,6 +67,14 @@ func ConvertTimeToTimestamp(t time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(t)
 }
 
+// ConvertOptionalTimeToTimestamp returns nil for the zero time, which ent uses for unset optional time fields.
+func ConvertOptionalTimeToTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
 func ConvertTimestampToTime(t *timestamppb.Timestamp) time.Time {
 	return t.AsTime()
 }
@@ -0,0 +1,31 @@
+package conv
+
+import (
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/secret"
+)
+
+func ConvertEncryptionKeysToProto(keys []secret.KeyInfo) []*v1.EncryptionKey {
+	converted := make([]*v1.EncryptionKey, 0, len(keys))
+	for _, key := range keys {
+		converted = append(converted, &v1.EncryptionKey{
+			Id:      key.ID,
+			Status:  convertEncryptionKeyStatusToProto(key.Status),
+			Primary: key.Primary,
+		})
+	}
+	return converted
+}
+
+func convertEncryptionKeyStatusToProto(status secret.KeyStatus) v1.EncryptionKeyStatus {
+	switch status {
+	case secret.KeyStatusEnabled:
+		return v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ENABLED
+	case secret.KeyStatusDisabled:
+		return v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_DISABLED
+	case secret.KeyStatusDestroyed:
+		return v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_DESTROYED
+	default:
+		return v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_UNSPECIFIED
+	}
+}
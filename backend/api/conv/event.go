@@ -6,6 +6,7 @@ import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/event"
 	tooltypes "github.com/furisto/construct/backend/tool/types"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,10 +16,21 @@ func ConvertStreamEventToProto(e *event.StreamEvent) (*v1.Event, error) {
 		return nil, fmt.Errorf("event is nil")
 	}
 
+	// Events read back from the event log are already encoded by EncodeStreamEvent.
+	if encoded, ok := e.Payload.(event.EncodedPayload); ok {
+		protoEvent := &v1.Event{}
+		if err := proto.Unmarshal(encoded, protoEvent); err != nil {
+			return nil, fmt.Errorf("failed to decode logged event: %w", err)
+		}
+		protoEvent.Sequence = e.Sequence
+		return protoEvent, nil
+	}
+
 	protoEvent := &v1.Event{
 		Type:      e.Type,
 		Action:    convertActionToProto(e.Action),
 		Timestamp: timestamppb.New(e.Timestamp),
+		Sequence:  e.Sequence,
 	}
 
 	// Convert payload based on event type
@@ -30,6 +42,13 @@ func ConvertStreamEventToProto(e *event.StreamEvent) (*v1.Event, error) {
 		}
 		protoEvent.Payload = payload
 
+	case event.EventTypeTaskModelFallback:
+		payload, err := convertTaskModelFallbackPayload(e)
+		if err != nil {
+			return nil, err
+		}
+		protoEvent.Payload = payload
+
 	case event.EventTypeMessageCreated, event.EventTypeMessageUpdated, event.EventTypeMessageDeleted:
 		payload, err := convertMessageEventPayload(e)
 		if err != nil {
@@ -79,6 +98,18 @@ func ConvertStreamEventToProto(e *event.StreamEvent) (*v1.Event, error) {
 		}
 		protoEvent.Payload = payload
 
+	case event.EventTypeStreamGap:
+		payload, ok := e.Payload.(*event.StreamGapPayload)
+		if !ok {
+			return nil, fmt.Errorf("unexpected stream gap payload type: %T", e.Payload)
+		}
+		protoEvent.Payload = &v1.Event_StreamGap{
+			StreamGap: &v1.StreamGapEvent{
+				FromSequence: payload.FromSequence,
+				ToSequence:   payload.ToSequence,
+			},
+		}
+
 	default:
 		return nil, fmt.Errorf("unknown event type: %s", e.Type)
 	}
@@ -86,6 +117,15 @@ func ConvertStreamEventToProto(e *event.StreamEvent) (*v1.Event, error) {
 	return protoEvent, nil
 }
 
+// EncodeStreamEvent encodes an event for the event log in the wire format of the event API.
+func EncodeStreamEvent(e *event.StreamEvent) ([]byte, error) {
+	protoEvent, err := ConvertStreamEventToProto(e)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(protoEvent)
+}
+
 func convertActionToProto(action string) v1.EventAction {
 	switch action {
 	case event.ActionCreated:
@@ -128,6 +168,23 @@ func convertTaskEventPayload(e *event.StreamEvent) (*v1.Event_Task, error) {
 	}
 }
 
+func convertTaskModelFallbackPayload(e *event.StreamEvent) (*v1.Event_TaskModelFallback, error) {
+	payload, ok := e.Payload.(*event.TaskModelFallbackPayload)
+	if !ok {
+		return nil, fmt.Errorf("unexpected task model fallback payload type: %T", e.Payload)
+	}
+
+	return &v1.Event_TaskModelFallback{
+		TaskModelFallback: &v1.TaskModelFallbackEvent{
+			TaskId:      payload.TaskID.String(),
+			FromModelId: payload.FromModelID.String(),
+			ToModelId:   payload.ToModelID.String(),
+			Reason:      payload.Reason,
+			Error:       payload.Error,
+		},
+	}, nil
+}
+
 func convertMessageEventPayload(e *event.StreamEvent) (*v1.Event_Message, error) {
 	switch payload := e.Payload.(type) {
 	case *event.MessageEventPayload:
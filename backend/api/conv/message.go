@@ -538,11 +538,13 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 	var messageUsage *v1.MessageUsage
 	if m.Usage != nil {
 		messageUsage = &v1.MessageUsage{
-			InputTokens:      m.Usage.InputTokens,
-			OutputTokens:     m.Usage.OutputTokens,
-			CacheWriteTokens: m.Usage.CacheWriteTokens,
-			CacheReadTokens:  m.Usage.CacheReadTokens,
-			Cost:             m.Usage.Cost,
+			InputTokens:          m.Usage.InputTokens,
+			OutputTokens:         m.Usage.OutputTokens,
+			CacheWriteTokens:     m.Usage.CacheWriteTokens,
+			CacheReadTokens:      m.Usage.CacheReadTokens,
+			Cost:                 m.Usage.Cost,
+			EstimatedInputTokens: m.Usage.EstimatedInputTokens,
+			EstimateSource:       m.Usage.EstimateSource,
 		}
 	}
 
@@ -566,17 +568,35 @@ func ConvertMemoryMessageToProto(m *memory.Message) (*v1.Message, error) {
 				}
 				return nil
 			}(),
-			Role: role,
+			Role:  role,
+			Owner: m.Owner,
 		},
 		Spec: &v1.MessageSpec{
 			Content: contentParts,
 		},
 		Status: &v1.MessageStatus{
-			Usage: messageUsage,
+			Usage:   messageUsage,
+			Routing: ConvertMessageRoutingToProto(m.Routing),
+			TraceId: m.TraceID,
 		},
 	}, nil
 }
 
+func ConvertMessageRoutingToProto(r *types.MessageRouting) *v1.MessageRouting {
+	if r == nil {
+		return nil
+	}
+
+	return &v1.MessageRouting{
+		Rule:                  r.Rule,
+		TurnType:              ConvertTurnTypeToProto(r.TurnType),
+		EstimatedPromptTokens: r.EstimatedPromptTokens,
+		RecentToolFailures:    r.RecentToolFailures,
+		DefaultModelId:        ConvertUUIDToString(r.DefaultModelID),
+		DefaultModelCost:      r.DefaultModelCost,
+	}
+}
+
 func ConvertProtoMessageToMemory(m *v1.Message) (*memory.Message, error) {
 	if m == nil {
 		return nil, fmt.Errorf("message is nil")
@@ -662,10 +682,12 @@ func ConvertProtoUsageToMemoryUsage(usage *v1.MessageUsage) *types.MessageUsage
 	}
 
 	return &types.MessageUsage{
-		InputTokens:      usage.InputTokens,
-		OutputTokens:     usage.OutputTokens,
-		CacheWriteTokens: usage.CacheWriteTokens,
-		CacheReadTokens:  usage.CacheReadTokens,
-		Cost:             usage.Cost,
+		InputTokens:          usage.InputTokens,
+		OutputTokens:         usage.OutputTokens,
+		CacheWriteTokens:     usage.CacheWriteTokens,
+		CacheReadTokens:      usage.CacheReadTokens,
+		Cost:                 usage.Cost,
+		EstimatedInputTokens: usage.EstimatedInputTokens,
+		EstimateSource:       usage.EstimateSource,
 	}
 }
@@ -4,9 +4,9 @@ import (
 	"fmt"
 
 	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/catalog"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/schema/types"
-	"github.com/furisto/construct/backend/model"
 	"github.com/googleapis/go-type-adapters/adapters"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -104,24 +104,53 @@ func ProtoModelPricingToMemory(pricing *v1.ModelPricing) (float64, float64, floa
 	return inputCost, outputCost, cacheWriteCost, cacheReadCost, nil
 }
 
-func LLMModelCapabilitiesToMemory(caps []model.Capability) ([]types.ModelCapability, error) {
-	capabilities := make([]types.ModelCapability, 0, len(caps))
-	for _, cap := range caps {
-		switch cap {
-		case model.CapabilityImage:
-			capabilities = append(capabilities, types.ModelCapabilityImage)
-		case model.CapabilityComputerUse:
-			capabilities = append(capabilities, types.ModelCapabilityComputerUse)
-		case model.CapabilityPromptCache:
-			capabilities = append(capabilities, types.ModelCapabilityPromptCache)
-		case model.CapabilityExtendedThinking:
-			capabilities = append(capabilities, types.ModelCapabilityExtendedThinking)
-		case model.CapabilityAudio:
-			capabilities = append(capabilities, types.ModelCapabilityAudio)
-		default:
-			return nil, fmt.Errorf("unknown model capability: %v", cap)
+func ConvertCatalogChangeToProto(change catalog.Change) *v1.ModelCatalogChange {
+	protoChange := &v1.ModelCatalogChange{
+		Kind:              ConvertCatalogChangeKindToProto(change.Kind),
+		ModelProviderId:   change.ModelProvider.ID.String(),
+		ModelProviderName: change.ModelProvider.Name,
+		ModelName:         change.ModelName(),
+	}
+
+	for _, field := range change.Fields {
+		protoChange.Fields = append(protoChange.Fields, string(field))
+	}
+
+	if change.Entry != nil {
+		protoChange.Pricing = &v1.ModelPricing{
+			InputCost:      adapters.Float64ToProtoDecimal(change.Entry.Pricing.Input),
+			OutputCost:     adapters.Float64ToProtoDecimal(change.Entry.Pricing.Output),
+			CacheWriteCost: adapters.Float64ToProtoDecimal(change.Entry.Pricing.CacheWrite),
+			CacheReadCost:  adapters.Float64ToProtoDecimal(change.Entry.Pricing.CacheRead),
 		}
+		protoChange.ContextWindow = change.Entry.ContextWindow
 	}
 
-	return capabilities, nil
+	if change.Model != nil {
+		protoChange.ModelId = strPtr(change.Model.ID.String())
+		protoChange.PreviousPricing = &v1.ModelPricing{
+			InputCost:      adapters.Float64ToProtoDecimal(change.Model.InputCost),
+			OutputCost:     adapters.Float64ToProtoDecimal(change.Model.OutputCost),
+			CacheWriteCost: adapters.Float64ToProtoDecimal(change.Model.CacheWriteCost),
+			CacheReadCost:  adapters.Float64ToProtoDecimal(change.Model.CacheReadCost),
+		}
+		protoChange.PreviousContextWindow = change.Model.ContextWindow
+	}
+
+	return protoChange
+}
+
+func ConvertCatalogChangeKindToProto(kind catalog.ChangeKind) v1.ModelCatalogChangeKind {
+	switch kind {
+	case catalog.ChangeKindNew:
+		return v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_NEW
+	case catalog.ChangeKindRetired:
+		return v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_RETIRED
+	case catalog.ChangeKindRepriced:
+		return v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_REPRICED
+	case catalog.ChangeKindUpdated:
+		return v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UPDATED
+	default:
+		return v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UNSPECIFIED
+	}
 }
@@ -0,0 +1,46 @@
+package conv
+
+import (
+	"strings"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/google/uuid"
+)
+
+func ConvertModelCallTraceToProto(t *memory.ModelCallTrace) *v1.ModelCallTrace {
+	trace := &v1.ModelCallTrace{
+		Id:              t.ID.String(),
+		CreatedAt:       ConvertTimeToTimestamp(t.CreateTime),
+		Model:           t.ModelName,
+		Method:          t.Method,
+		Url:             t.URL,
+		RequestHeaders:  convertHeadersToProto(t.RequestHeaders),
+		RequestBody:     t.RequestBody,
+		StatusCode:      int32(t.StatusCode),
+		ResponseHeaders: convertHeadersToProto(t.ResponseHeaders),
+		ResponseBody:    t.ResponseBody,
+		Truncated:       t.Truncated,
+		Error:           t.Error,
+		DurationMs:      t.DurationMs,
+	}
+
+	if t.ModelProviderID != uuid.Nil {
+		trace.ModelProviderId = t.ModelProviderID.String()
+	}
+
+	return trace
+}
+
+func convertHeadersToProto(headers map[string][]string) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	converted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		converted[name] = strings.Join(values, ", ")
+	}
+
+	return converted
+}
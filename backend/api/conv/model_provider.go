@@ -6,6 +6,9 @@ import (
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
+	"github.com/furisto/construct/shared/resilience"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -27,12 +30,104 @@ func ConvertModelProviderIntoProto(mp *memory.ModelProvider) (*v1.ModelProvider,
 			ProviderType: protoType,
 		},
 		Spec: &v1.ModelProviderSpec{
-			Name:    mp.Name,
-			Enabled: mp.Enabled,
+			Name:         mp.Name,
+			Enabled:      mp.Enabled,
+			RateLimit:    ConvertModelProviderRateLimitToProto(mp.RateLimit),
+			KeySelection: ConvertKeySelectionStrategyToProto(mp.KeySelection),
 		},
+		Status: ConvertModelProviderStatusToProto(mp.Edges.Keys),
 	}, nil
 }
 
+// ConvertModelProviderStatusToProto returns nil if the keys of the provider have not been loaded.
+func ConvertModelProviderStatusToProto(keys []*memory.ModelProviderKey) *v1.ModelProviderStatus {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	protoKeys := make([]*v1.ModelProviderKey, 0, len(keys))
+	for _, key := range keys {
+		protoKeys = append(protoKeys, ConvertModelProviderKeyToProto(key))
+	}
+
+	return &v1.ModelProviderStatus{Keys: protoKeys}
+}
+
+func ConvertModelProviderKeyToProto(key *memory.ModelProviderKey) *v1.ModelProviderKey {
+	return &v1.ModelProviderKey{
+		Id:                key.ID.String(),
+		Name:              key.Name,
+		Priority:          int32(key.Priority),
+		CreatedAt:         ConvertTimeToTimestamp(key.CreateTime),
+		RetiredAt:         ConvertOptionalTimeToTimestamp(key.RetiredTime),
+		LastUsedAt:        ConvertOptionalTimeToTimestamp(key.LastUsedTime),
+		LastRateLimitedAt: ConvertOptionalTimeToTimestamp(key.LastRateLimitedTime),
+		Usage: &v1.ModelProviderKeyUsage{
+			Requests:         key.RequestCount,
+			InputTokens:      key.InputTokens,
+			OutputTokens:     key.OutputTokens,
+			CacheWriteTokens: key.CacheWriteTokens,
+			CacheReadTokens:  key.CacheReadTokens,
+			Cost:             key.Cost,
+		},
+	}
+}
+
+func ConvertKeySelectionStrategyToProto(strategy types.KeySelectionStrategy) v1.KeySelectionStrategy {
+	switch strategy {
+	case types.KeySelectionStrategyPrimaryBackup:
+		return v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_PRIMARY_BACKUP
+	case types.KeySelectionStrategyRoundRobin:
+		return v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_ROUND_ROBIN
+	case types.KeySelectionStrategyLeastRecentlyLimited:
+		return v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED
+	default:
+		return v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_UNSPECIFIED
+	}
+}
+
+func ConvertKeySelectionStrategyToMemory(strategy v1.KeySelectionStrategy) (types.KeySelectionStrategy, error) {
+	switch strategy {
+	case v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_PRIMARY_BACKUP:
+		return types.KeySelectionStrategyPrimaryBackup, nil
+	case v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_ROUND_ROBIN:
+		return types.KeySelectionStrategyRoundRobin, nil
+	case v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED:
+		return types.KeySelectionStrategyLeastRecentlyLimited, nil
+	default:
+		return "", fmt.Errorf("unsupported key selection strategy: %v", strategy)
+	}
+}
+
+func ConvertModelProviderRateLimitToProto(l *types.ModelProviderRateLimit) *v1.ModelProviderRateLimit {
+	if l.IsZero() {
+		return nil
+	}
+
+	return &v1.ModelProviderRateLimit{
+		RequestsPerMinute: l.RequestsPerMinute,
+		TokensPerMinute:   l.TokensPerMinute,
+		MaxInFlight:       l.MaxInFlight,
+	}
+}
+
+func ConvertModelProviderRateLimitToMemory(l *v1.ModelProviderRateLimit) *types.ModelProviderRateLimit {
+	if l == nil {
+		return nil
+	}
+
+	rateLimit := &types.ModelProviderRateLimit{
+		RequestsPerMinute: l.RequestsPerMinute,
+		TokensPerMinute:   l.TokensPerMinute,
+		MaxInFlight:       l.MaxInFlight,
+	}
+	if rateLimit.IsZero() {
+		return nil
+	}
+
+	return rateLimit
+}
+
 func ConvertModelProviderTypeToProto(dbType types.ModelProviderType) (v1.ModelProviderType, error) {
 	switch dbType {
 	case types.ModelProviderTypeAnthropic:
@@ -43,6 +138,14 @@ func ConvertModelProviderTypeToProto(dbType types.ModelProviderType) (v1.ModelPr
 		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_GEMINI, nil
 	case types.ModelProviderTypeXAI:
 		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_XAI, nil
+	case types.ModelProviderTypeDeepSeek:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_DEEPSEEK, nil
+	case types.ModelProviderTypeBedrock:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_BEDROCK, nil
+	case types.ModelProviderTypeReplay:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_REPLAY, nil
+	case types.ModelProviderTypeMock:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_MOCK, nil
 	default:
 		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_UNSPECIFIED, fmt.Errorf("unsupported provider type: %v", dbType)
 	}
@@ -58,7 +161,57 @@ func ConvertModelProviderTypeToMemory(protoType v1.ModelProviderType) (types.Mod
 		return types.ModelProviderTypeGemini, nil
 	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_XAI:
 		return types.ModelProviderTypeXAI, nil
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_DEEPSEEK:
+		return types.ModelProviderTypeDeepSeek, nil
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_BEDROCK:
+		return types.ModelProviderTypeBedrock, nil
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_REPLAY:
+		return types.ModelProviderTypeReplay, nil
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_MOCK:
+		return types.ModelProviderTypeMock, nil
 	default:
 		return "", fmt.Errorf("unsupported provider type: %v", protoType)
 	}
 }
+
+func ConvertConnectivityReportToProto(report *model.ConnectivityReport) *v1.TestModelProviderResponse {
+	resp := &v1.TestModelProviderResponse{
+		AuthStatus:      ConvertAuthStatusToProto(report.AuthStatus),
+		Latency:         durationpb.New(report.Latency),
+		ModelsListed:    report.ModelsListed,
+		AvailableModels: report.AvailableModels,
+		CircuitState:    ConvertCircuitStateToProto(report.CircuitState),
+	}
+
+	if report.Err != nil {
+		resp.Error = strPtr(report.Err.Error())
+	}
+
+	return resp
+}
+
+func ConvertAuthStatusToProto(status model.AuthStatus) v1.ModelProviderAuthStatus {
+	switch status {
+	case model.AuthStatusAuthenticated:
+		return v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED
+	case model.AuthStatusUnauthenticated:
+		return v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED
+	case model.AuthStatusUnknown:
+		return v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNKNOWN
+	default:
+		return v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNSPECIFIED
+	}
+}
+
+func ConvertCircuitStateToProto(state resilience.CircuitState) v1.CircuitBreakerState {
+	switch state {
+	case resilience.CircuitClosed:
+		return v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED
+	case resilience.CircuitOpen:
+		return v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN
+	case resilience.CircuitHalfOpen:
+		return v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN
+	default:
+		return v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,38 @@
+package conv
+
+import (
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+// ConvertQuotaToProto returns nil if there is no quota.
+func ConvertQuotaToProto(quota *types.Quota) *v1.Quota {
+	if quota == nil || quota.IsZero() {
+		return nil
+	}
+
+	return &v1.Quota{
+		MaxConcurrentTasks: int32(quota.MaxConcurrentTasks),
+		DailySpend:         quota.DailySpend,
+		MonthlySpend:       quota.MonthlySpend,
+		DailyTokens:        quota.DailyTokens,
+	}
+}
+
+// ConvertQuotaToMemory returns nil if the quota does not limit anything.
+func ConvertQuotaToMemory(quota *v1.Quota) *types.Quota {
+	if quota == nil {
+		return nil
+	}
+
+	converted := &types.Quota{
+		MaxConcurrentTasks: int(quota.MaxConcurrentTasks),
+		DailySpend:         quota.DailySpend,
+		MonthlySpend:       quota.MonthlySpend,
+		DailyTokens:        quota.DailyTokens,
+	}
+	if converted.IsZero() {
+		return nil
+	}
+	return converted
+}
@@ -24,15 +24,18 @@ func ConvertTaskMetadataToProto(t *memory.Task) *v1.TaskMetadata {
 		Id:        t.ID.String(),
 		CreatedAt: ConvertTimeToTimestamp(t.CreateTime),
 		UpdatedAt: ConvertTimeToTimestamp(t.UpdateTime),
+		Owner:     t.Owner,
 	}
 }
 
 func ConvertTaskSpecToProto(t *memory.Task) (*v1.TaskSpec, error) {
 	return &v1.TaskSpec{
-		AgentId:      strPtr(t.AgentID.String()),
-		Workspace:    t.ProjectDirectory,
-		DesiredPhase: ConvertTaskPhaseToProto(t.DesiredPhase),
-		Description:  t.Description,
+		AgentId:         strPtr(t.AgentID.String()),
+		Workspace:       t.ProjectDirectory,
+		DesiredPhase:    ConvertTaskPhaseToProto(t.DesiredPhase),
+		Description:     t.Description,
+		TraceModelCalls: t.TraceModelCalls,
+		SharedWith:      t.SharedWith,
 	}, nil
 }
 
@@ -44,6 +47,8 @@ func ConvertTaskStatusToProto(t *memory.Task) *v1.TaskStatus {
 		CacheReadTokens:  t.CacheReadTokens,
 		Cost:             float64(t.Cost),
 		ToolUses:         t.ToolUses,
+		RoutedTurns:      t.RoutedTurns,
+		RoutingSavings:   t.RoutingSavings,
 	}
 
 	return &v1.TaskStatus{
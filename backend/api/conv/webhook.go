@@ -0,0 +1,82 @@
+package conv
+
+import (
+	"fmt"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ConvertWebhookToProto(w *memory.Webhook) *v1.Webhook {
+	spec := &v1.WebhookSpec{
+		Url:         w.URL,
+		EventTypes:  w.EventTypes,
+		Enabled:     w.Enabled,
+		Description: w.Description,
+	}
+	if w.TaskID != nil {
+		spec.TaskId = strPtr(w.TaskID.String())
+	}
+	if w.AgentID != nil {
+		spec.AgentId = strPtr(w.AgentID.String())
+	}
+
+	return &v1.Webhook{
+		Metadata: &v1.WebhookMetadata{
+			Id:        w.ID.String(),
+			CreatedAt: ConvertTimeToTimestamp(w.CreateTime),
+			UpdatedAt: ConvertTimeToTimestamp(w.UpdateTime),
+		},
+		Spec: spec,
+	}
+}
+
+func ConvertWebhookDeliveryToProto(d *memory.WebhookDelivery) *v1.WebhookDelivery {
+	delivery := &v1.WebhookDelivery{
+		Id:                 d.ID.String(),
+		WebhookId:          d.WebhookID.String(),
+		EventType:          d.EventType,
+		EventSequence:      d.EventSequence,
+		State:              ConvertWebhookDeliveryStateToProto(d.State),
+		Attempts:           int32(d.Attempts),
+		CreatedAt:          ConvertTimeToTimestamp(d.CreateTime),
+		ResponseStatusCode: int32(d.ResponseStatusCode),
+		LastError:          d.LastError,
+	}
+	if d.LastAttemptTime != nil {
+		delivery.LastAttemptAt = timestamppb.New(*d.LastAttemptTime)
+	}
+	if d.State == types.WebhookDeliveryStatePending {
+		delivery.NextAttemptAt = timestamppb.New(d.NextAttemptTime)
+	}
+
+	return delivery
+}
+
+func ConvertWebhookDeliveryStateToProto(state types.WebhookDeliveryState) v1.WebhookDeliveryState {
+	switch state {
+	case types.WebhookDeliveryStatePending:
+		return v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_PENDING
+	case types.WebhookDeliveryStateSucceeded:
+		return v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_SUCCEEDED
+	case types.WebhookDeliveryStateDeadLetter:
+		return v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER
+	default:
+		return v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_UNSPECIFIED
+	}
+}
+
+func ConvertWebhookDeliveryStateToMemory(state v1.WebhookDeliveryState) (types.WebhookDeliveryState, error) {
+	switch state {
+	case v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_PENDING:
+		return types.WebhookDeliveryStatePending, nil
+	case v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_SUCCEEDED:
+		return types.WebhookDeliveryStateSucceeded, nil
+	case v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER:
+		return types.WebhookDeliveryStateDeadLetter, nil
+	default:
+		return "", fmt.Errorf("unsupported webhook delivery state: %s", state)
+	}
+}
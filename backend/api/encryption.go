@@ -0,0 +1,282 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/tink-crypto/tink-go/keyset"
+)
+
+var _ v1connect.EncryptionServiceHandler = (*EncryptionHandler)(nil)
+
+func NewEncryptionHandler(db *memory.Client, encryption *secret.Encryption, keysetStore *secret.KeysetStore) *EncryptionHandler {
+	return &EncryptionHandler{
+		db:          db,
+		encryption:  encryption,
+		keysetStore: keysetStore,
+	}
+}
+
+type EncryptionHandler struct {
+	db          *memory.Client
+	encryption  *secret.Encryption
+	keysetStore *secret.KeysetStore
+	// mu serializes rotations and imports, so that they do not replace each other's keyset.
+	mu sync.Mutex
+	v1connect.UnimplementedEncryptionServiceHandler
+}
+
+// storedSecret is an encrypted secret in the database.
+type storedSecret struct {
+	// name identifies the secret in errors, e.g. "model provider <id>".
+	name       string
+	ciphertext []byte
+	associated []byte
+	// update replaces the ciphertext of the secret.
+	update func(ctx context.Context, tx *memory.Client, ciphertext []byte) error
+}
+
+func (h *EncryptionHandler) RotateEncryptionKey(ctx context.Context, req *connect.Request[v1.RotateEncryptionKeyRequest], stream *connect.ServerStream[v1.RotateEncryptionKeyResponse]) error {
+	if err := h.checkKeysetStore(); err != nil {
+		return apiError(err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	rotated, err := secret.AddPrimaryKey(h.encryption.Keyset())
+	if err != nil {
+		return apiError(err)
+	}
+	primaryKeyID := secret.PrimaryKeyID(rotated)
+
+	// The rotated keyset still decrypts every secret, so it is stored and used before the secrets
+	// are re-encrypted. Secrets stay readable if the re-encryption fails halfway.
+	if err := h.replaceKeyset(rotated); err != nil {
+		return apiError(err)
+	}
+
+	total, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*int, error) {
+		secrets, err := loadStoredSecrets(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, stored := range secrets {
+			plaintext, err := h.encryption.Decrypt(stored.ciphertext, stored.associated)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt secret of %s: %w", stored.name, err)
+			}
+
+			ciphertext, err := h.encryption.Encrypt(plaintext, stored.associated)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt secret of %s: %w", stored.name, err)
+			}
+
+			if err := stored.update(ctx, tx, ciphertext); err != nil {
+				return nil, fmt.Errorf("failed to update secret of %s: %w", stored.name, err)
+			}
+
+			err = stream.Send(&v1.RotateEncryptionKeyResponse{
+				Phase:        v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING,
+				Processed:    int32(i + 1),
+				Total:        int32(len(secrets)),
+				PrimaryKeyId: primaryKeyID,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		total := len(secrets)
+		return &total, nil
+	})
+	if err != nil {
+		return apiError(err)
+	}
+
+	if req.Msg.DisablePreviousKeys {
+		if err := h.disablePreviousKeys(ctx, stream, rotated); err != nil {
+			return apiError(err)
+		}
+	}
+
+	return stream.Send(&v1.RotateEncryptionKeyResponse{
+		Phase:        v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_DONE,
+		Processed:    int32(*total),
+		Total:        int32(*total),
+		PrimaryKeyId: primaryKeyID,
+		Keys:         conv.ConvertEncryptionKeysToProto(secret.Keys(h.encryption.Keyset())),
+	})
+}
+
+// disablePreviousKeys disables every key but the primary key, after checking that the primary key
+// alone decrypts every secret.
+func (h *EncryptionHandler) disablePreviousKeys(ctx context.Context, stream *connect.ServerStream[v1.RotateEncryptionKeyResponse], rotated *keyset.Handle) error {
+	primaryOnly, err := secret.DisableSecondaryKeys(rotated)
+	if err != nil {
+		return err
+	}
+
+	verification, err := secret.NewClient(primaryOnly)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := loadStoredSecrets(ctx, h.db)
+	if err != nil {
+		return err
+	}
+
+	for i, stored := range secrets {
+		if _, err := verification.Decrypt(stored.ciphertext, stored.associated); err != nil {
+			return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the secret of %s is not encrypted with the new key, the previous keys were kept", stored.name))
+		}
+
+		err = stream.Send(&v1.RotateEncryptionKeyResponse{
+			Phase:        v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING,
+			Processed:    int32(i + 1),
+			Total:        int32(len(secrets)),
+			PrimaryKeyId: secret.PrimaryKeyID(primaryOnly),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return h.replaceKeyset(primaryOnly)
+}
+
+func (h *EncryptionHandler) ExportEncryptionKeyset(ctx context.Context, req *connect.Request[v1.ExportEncryptionKeysetRequest]) (*connect.Response[v1.ExportEncryptionKeysetResponse], error) {
+	current := h.encryption.Keyset()
+
+	keysetJSON, err := secret.KeysetToJSON(current)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportEncryptionKeysetResponse{
+		Keyset: keysetJSON,
+		Keys:   conv.ConvertEncryptionKeysToProto(secret.Keys(current)),
+	}), nil
+}
+
+func (h *EncryptionHandler) ImportEncryptionKeyset(ctx context.Context, req *connect.Request[v1.ImportEncryptionKeysetRequest]) (*connect.Response[v1.ImportEncryptionKeysetResponse], error) {
+	if err := h.checkKeysetStore(); err != nil {
+		return nil, apiError(err)
+	}
+
+	imported, err := secret.KeysetFromJSON(req.Msg.Keyset)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	importedEncryption, err := secret.NewClient(imported)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	secrets, err := loadStoredSecrets(ctx, h.db)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	undecryptable := 0
+	for _, stored := range secrets {
+		if _, err := importedEncryption.Decrypt(stored.ciphertext, stored.associated); err != nil {
+			undecryptable++
+		}
+	}
+
+	if undecryptable > 0 && !req.Msg.Force {
+		return nil, apiError(connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("the keyset does not decrypt %d of %d stored secrets", undecryptable, len(secrets))))
+	}
+
+	if err := h.replaceKeyset(imported); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.ImportEncryptionKeysetResponse{
+		Keys:          conv.ConvertEncryptionKeysToProto(secret.Keys(imported)),
+		Undecryptable: int32(undecryptable),
+	}), nil
+}
+
+func (h *EncryptionHandler) checkKeysetStore() error {
+	if h.keysetStore == nil {
+		return connect.NewError(connect.CodeUnimplemented, fmt.Errorf("the encryption keyset cannot be changed on this daemon"))
+	}
+	return nil
+}
+
+// replaceKeyset stores the keyset before using it, so that secrets are never encrypted with a key
+// that is lost on restart.
+func (h *EncryptionHandler) replaceKeyset(keysetHandle *keyset.Handle) error {
+	if err := h.keysetStore.Save(keysetHandle); err != nil {
+		return err
+	}
+	return h.encryption.SetKeyset(keysetHandle)
+}
+
+// loadStoredSecrets returns every encrypted secret in the database: the credentials of model
+// providers and their keys, and the signing secrets of webhooks.
+func loadStoredSecrets(ctx context.Context, db *memory.Client) ([]storedSecret, error) {
+	var secrets []storedSecret
+
+	providers, err := db.ModelProvider.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, provider := range providers {
+		secrets = append(secrets, storedSecret{
+			name:       fmt.Sprintf("model provider %s", provider.ID),
+			ciphertext: provider.Secret,
+			associated: secret.ModelProviderAssociated(provider.ID),
+			update: func(ctx context.Context, tx *memory.Client, ciphertext []byte) error {
+				return tx.ModelProvider.UpdateOneID(provider.ID).SetSecret(ciphertext).Exec(ctx)
+			},
+		})
+	}
+
+	keys, err := db.ModelProviderKey.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		secrets = append(secrets, storedSecret{
+			name:       fmt.Sprintf("model provider key %s", key.ID),
+			ciphertext: key.Secret,
+			associated: secret.ModelProviderAssociated(key.ModelProviderID),
+			update: func(ctx context.Context, tx *memory.Client, ciphertext []byte) error {
+				return tx.ModelProviderKey.UpdateOneID(key.ID).SetSecret(ciphertext).Exec(ctx)
+			},
+		})
+	}
+
+	webhooks, err := db.Webhook.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		secrets = append(secrets, storedSecret{
+			name:       fmt.Sprintf("webhook %s", webhook.ID),
+			ciphertext: webhook.Secret,
+			associated: secret.WebhookAssociated(webhook.ID),
+			update: func(ctx context.Context, tx *memory.Client, ciphertext []byte) error {
+				return tx.Webhook.UpdateOneID(webhook.ID).SetSecret(ciphertext).Exec(ctx)
+			},
+		})
+	}
+
+	return secrets, nil
+}
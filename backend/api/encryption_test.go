@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+	"github.com/spf13/afero"
+	"github.com/tink-crypto/tink-go/keyset"
+)
+
+func TestRotateEncryptionKey(t *testing.T) {
+	tests := []struct {
+		name                string
+		disablePreviousKeys bool
+		wantPhases          []v1.EncryptionKeyRotationPhase
+		wantEnabledKeys     int
+	}{
+		{
+			name: "previous keys stay enabled",
+			wantPhases: []v1.EncryptionKeyRotationPhase{
+				v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING,
+				v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_DONE,
+			},
+			wantEnabledKeys: 2,
+		},
+		{
+			name:                "previous keys are disabled",
+			disablePreviousKeys: true,
+			wantPhases: []v1.EncryptionKeyRotationPhase{
+				v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_REENCRYPTING,
+				v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING,
+				v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_DONE,
+			},
+			wantEnabledKeys: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			server, apiClient, store := startEncryptionTestServer(t)
+			seedEncryptedSecrets(ctx, t, server.Options.DB, server.Options.Encryption)
+			previousKeyID := secret.PrimaryKeyID(server.Options.Encryption.Keyset())
+
+			stream, err := apiClient.Encryption().RotateEncryptionKey(ctx, connect.NewRequest(&v1.RotateEncryptionKeyRequest{
+				DisablePreviousKeys: tt.disablePreviousKeys,
+			}))
+			if err != nil {
+				t.Fatalf("RotateEncryptionKey() error = %v", err)
+			}
+
+			var phases []v1.EncryptionKeyRotationPhase
+			var last *v1.RotateEncryptionKeyResponse
+			for stream.Receive() {
+				last = stream.Msg()
+				if len(phases) == 0 || phases[len(phases)-1] != last.Phase {
+					phases = append(phases, last.Phase)
+				}
+			}
+			if err := stream.Err(); err != nil {
+				t.Fatalf("RotateEncryptionKey() stream error = %v", err)
+			}
+
+			if len(phases) != len(tt.wantPhases) {
+				t.Fatalf("phases = %v, want %v", phases, tt.wantPhases)
+			}
+			for i := range phases {
+				if phases[i] != tt.wantPhases[i] {
+					t.Fatalf("phases = %v, want %v", phases, tt.wantPhases)
+				}
+			}
+
+			if last.Processed != 3 || last.Total != 3 {
+				t.Errorf("processed %d of %d secrets, want 3 of 3", last.Processed, last.Total)
+			}
+			if last.PrimaryKeyId == previousKeyID {
+				t.Errorf("primary key was not replaced")
+			}
+
+			enabled := 0
+			for _, key := range last.Keys {
+				if key.Status == v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ENABLED {
+					enabled++
+				}
+			}
+			if enabled != tt.wantEnabledKeys {
+				t.Errorf("enabled keys = %d, want %d", enabled, tt.wantEnabledKeys)
+			}
+
+			// Only the new primary key is needed to decrypt the secrets after the rotation, and the
+			// keyset that does so was stored.
+			stored, err := store.LoadOrGenerate()
+			if err != nil {
+				t.Fatalf("failed to load stored keyset: %v", err)
+			}
+			primaryOnly, err := secret.DisableSecondaryKeys(stored)
+			if err != nil {
+				t.Fatalf("failed to disable secondary keys: %v", err)
+			}
+			if undecryptable := countUndecryptable(ctx, t, server.Options.DB, primaryOnly); undecryptable != 0 {
+				t.Errorf("%d secrets are not encrypted with the new primary key", undecryptable)
+			}
+		})
+	}
+}
+
+func TestImportEncryptionKeyset(t *testing.T) {
+	ctx := context.Background()
+	server, apiClient, _ := startEncryptionTestServer(t)
+	seedEncryptedSecrets(ctx, t, server.Options.DB, server.Options.Encryption)
+
+	exported, err := apiClient.Encryption().ExportEncryptionKeyset(ctx, connect.NewRequest(&v1.ExportEncryptionKeysetRequest{}))
+	if err != nil {
+		t.Fatalf("ExportEncryptionKeyset() error = %v", err)
+	}
+
+	unrelated, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed generating keyset: %v", err)
+	}
+	unrelatedJSON, err := secret.KeysetToJSON(unrelated)
+	if err != nil {
+		t.Fatalf("failed serializing keyset: %v", err)
+	}
+
+	_, err = apiClient.Encryption().ImportEncryptionKeyset(ctx, connect.NewRequest(&v1.ImportEncryptionKeysetRequest{Keyset: "{}"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("ImportEncryptionKeyset() of an invalid keyset error = %v, want invalid_argument", err)
+	}
+
+	_, err = apiClient.Encryption().ImportEncryptionKeyset(ctx, connect.NewRequest(&v1.ImportEncryptionKeysetRequest{Keyset: unrelatedJSON}))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("ImportEncryptionKeyset() of an unrelated keyset error = %v, want failed_precondition", err)
+	}
+
+	resp, err := apiClient.Encryption().ImportEncryptionKeyset(ctx, connect.NewRequest(&v1.ImportEncryptionKeysetRequest{Keyset: unrelatedJSON, Force: true}))
+	if err != nil {
+		t.Fatalf("ImportEncryptionKeyset() with force error = %v", err)
+	}
+	if resp.Msg.Undecryptable != 3 {
+		t.Errorf("undecryptable = %d, want 3", resp.Msg.Undecryptable)
+	}
+
+	resp, err = apiClient.Encryption().ImportEncryptionKeyset(ctx, connect.NewRequest(&v1.ImportEncryptionKeysetRequest{Keyset: exported.Msg.Keyset}))
+	if err != nil {
+		t.Fatalf("ImportEncryptionKeyset() of the exported keyset error = %v", err)
+	}
+	if resp.Msg.Undecryptable != 0 {
+		t.Errorf("undecryptable = %d, want 0", resp.Msg.Undecryptable)
+	}
+	if undecryptable := countUndecryptable(ctx, t, server.Options.DB, server.Options.Encryption.Keyset()); undecryptable != 0 {
+		t.Errorf("%d secrets cannot be decrypted after restoring the keyset", undecryptable)
+	}
+}
+
+func startEncryptionTestServer(t *testing.T) (*TestServer, *api_client.Client, *secret.KeysetStore) {
+	t.Helper()
+
+	provider, err := secret.NewFileProvider("/secrets", afero.NewMemMapFs())
+	if err != nil {
+		t.Fatalf("failed creating secret provider: %v", err)
+	}
+	store := secret.NewKeysetStore(provider)
+
+	options := DefaultTestHandlerOptions(t)
+	if err := store.Save(options.Encryption.Keyset()); err != nil {
+		t.Fatalf("failed storing keyset: %v", err)
+	}
+	options.KeysetStore = store
+
+	server := NewTestServer(t, options)
+	server.Start(context.Background())
+	t.Cleanup(server.Close)
+
+	apiClient, err := api_client.NewClient(api_client.EndpointContext{
+		Address: server.API.URL,
+		Kind:    "http",
+	})
+	if err != nil {
+		t.Fatalf("failed to create api client: %v", err)
+	}
+
+	return server, apiClient, store
+}
+
+// seedEncryptedSecrets creates a model provider with a key and a webhook, whose secrets are
+// encrypted with the current keyset.
+func seedEncryptedSecrets(ctx context.Context, t *testing.T, db *memory.Client, encryption *secret.Encryption) {
+	t.Helper()
+
+	encrypt := func(plaintext string, associated []byte) []byte {
+		ciphertext, err := encryption.Encrypt([]byte(plaintext), associated)
+		if err != nil {
+			t.Fatalf("failed encrypting secret: %v", err)
+		}
+		return ciphertext
+	}
+
+	providerID := uuid.New()
+	providerSecret := encrypt(`{"api_key":"sk-ant-primary"}`, secret.ModelProviderAssociated(providerID))
+	_, err := db.ModelProvider.Create().
+		SetID(providerID).
+		SetName("anthropic").
+		SetProviderType(types.ModelProviderTypeAnthropic).
+		SetSecret(providerSecret).
+		SetEnabled(true).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed creating model provider: %v", err)
+	}
+
+	_, err = db.ModelProviderKey.Create().
+		SetModelProviderID(providerID).
+		SetName("default").
+		SetSecret(providerSecret).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed creating model provider key: %v", err)
+	}
+
+	webhookID := uuid.New()
+	_, err = db.Webhook.Create().
+		SetID(webhookID).
+		SetURL("https://example.com/hooks").
+		SetEnabled(true).
+		SetSecret(encrypt("whsec_secret", secret.WebhookAssociated(webhookID))).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed creating webhook: %v", err)
+	}
+}
+
+func countUndecryptable(ctx context.Context, t *testing.T, db *memory.Client, keysetHandle *keyset.Handle) int {
+	t.Helper()
+
+	encryption, err := secret.NewClient(keysetHandle)
+	if err != nil {
+		t.Fatalf("failed creating encryption client: %v", err)
+	}
+
+	secrets, err := loadStoredSecrets(ctx, db)
+	if err != nil {
+		t.Fatalf("failed loading secrets: %v", err)
+	}
+
+	undecryptable := 0
+	for _, stored := range secrets {
+		if _, err := encryption.Decrypt(stored.ciphertext, stored.associated); err != nil {
+			undecryptable++
+		}
+	}
+	return undecryptable
+}
@@ -2,11 +2,13 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 
 	"connectrpc.com/connect"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/auth"
 	"github.com/furisto/construct/backend/api/conv"
 	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
@@ -41,18 +43,30 @@ func (h *EventHandler) Subscribe(
 		EventTypes:           req.Msg.EventTypes,
 		TaskID:               ptrToString(req.Msg.TaskId),
 		ReplayAfterMessageID: ptrToString(req.Msg.ReplayAfterMessageId),
+		ResumeAfterSequence:  req.Msg.ResumeAfterSequence,
+	}
+	if err := h.checkSubscriptionAccess(ctx, opts.TaskID); err != nil {
+		return apiError(err)
+	}
+
+	// Clients are told apart by their identity, so that slow consumers show up in the metrics.
+	opts.Subscriber = "api"
+	if identity := auth.FromContext(ctx); identity != nil {
+		opts.Subscriber = "api:" + identity.Subject
 	}
 
 	slog.DebugContext(ctx, "event subscription started",
 		"event_types", opts.EventTypes,
 		"task_id", opts.TaskID,
 		"replay_after_message_id", opts.ReplayAfterMessageID,
+		"resume_after_sequence", req.Msg.GetResumeAfterSequence(),
 	)
 
 	// Handle replay if task ID is specified
 	// If ReplayAfterMessageID is set, replay messages after that ID
 	// If ReplayAfterMessageID is empty, replay all messages for the task
-	if opts.TaskID != "" {
+	// Resumed subscriptions are replayed from the event log by the router instead.
+	if opts.TaskID != "" && opts.ResumeAfterSequence == nil {
 		if err := h.replayMessages(ctx, stream, opts.TaskID, opts.ReplayAfterMessageID); err != nil {
 			slog.ErrorContext(ctx, "failed to replay messages", "error", err)
 			// Continue with live subscription even if replay fails
@@ -63,6 +77,8 @@ func (h *EventHandler) Subscribe(
 	eventCh, cancel := h.eventRouter.Subscribe(ctx, opts)
 	defer cancel()
 
+	visible := h.visibilityFilter(ctx)
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -74,6 +90,10 @@ func (h *EventHandler) Subscribe(
 				return nil
 			}
 
+			if !visible(domainEvent) {
+				continue
+			}
+
 			protoEvent, err := conv.ConvertStreamEventToProto(domainEvent)
 			if err != nil {
 				slog.ErrorContext(ctx, "failed to convert event to proto",
@@ -91,6 +111,59 @@ func (h *EventHandler) Subscribe(
 	}
 }
 
+// checkSubscriptionAccess makes sure that subscriptions to the events of a task are only made
+// by identities that may access the task. Identities that are limited to agents or workspaces
+// must subscribe to a task.
+func (h *EventHandler) checkSubscriptionAccess(ctx context.Context, taskIDStr string) error {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin {
+		return nil
+	}
+
+	if taskIDStr == "" {
+		if identity.IsLimited() {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("tokens that are limited to agents or workspaces must subscribe to a task"))
+		}
+		return nil
+	}
+
+	taskID, err := uuid.Parse(taskIDStr)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err))
+	}
+
+	t, err := h.db.Task.Get(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return checkTaskAccess(ctx, t)
+}
+
+// visibilityFilter hides the events of tasks that the identity may not access. Whether a task is
+// visible is decided once per subscription, so tasks that are shared later only show up in new
+// subscriptions.
+func (h *EventHandler) visibilityFilter(ctx context.Context) func(*event.StreamEvent) bool {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin {
+		return func(*event.StreamEvent) bool { return true }
+	}
+
+	visibleTasks := make(map[uuid.UUID]bool)
+	return func(e *event.StreamEvent) bool {
+		if e.TaskID == nil {
+			return true
+		}
+
+		visible, ok := visibleTasks[*e.TaskID]
+		if !ok {
+			t, err := h.db.Task.Get(ctx, *e.TaskID)
+			visible = err == nil && checkTaskAccess(ctx, t) == nil
+			visibleTasks[*e.TaskID] = visible
+		}
+		return visible
+	}
+}
+
 // replayMessages replays message.created events for a task.
 // If afterMessageIDStr is empty, replays all messages for the task.
 // If afterMessageIDStr is set, replays only messages created after that message.
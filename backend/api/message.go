@@ -13,6 +13,7 @@ import (
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/quota"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +24,7 @@ func NewMessageHandler(db *memory.Client, runtime AgentRuntime, eventRouter *eve
 		db:          db,
 		runtime:     runtime,
 		eventRouter: eventRouter,
+		quotas:      quota.NewEnforcer(db),
 	}
 }
 
@@ -30,6 +32,7 @@ type MessageHandler struct {
 	db          *memory.Client
 	runtime     AgentRuntime
 	eventRouter *event.EventRouter
+	quotas      *quota.Enforcer
 	v1connect.UnimplementedMessageServiceHandler
 }
 
@@ -39,6 +42,18 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
 	}
 
+	t, err := h.db.Task.Get(ctx, taskID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+	if err := checkTaskAccess(ctx, t); err != nil {
+		return nil, apiError(err)
+	}
+	// The turn runs on behalf of the owner of the task, so it counts against their quota.
+	if err := h.quotas.Check(ctx, t.Owner, t.ID); err != nil {
+		return nil, apiError(quotaError(err))
+	}
+
 	msg, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Message, error) {
 		task, err := tx.Task.Get(ctx, taskID)
 		if err != nil {
@@ -52,11 +67,16 @@ func (h *MessageHandler) CreateMessage(ctx context.Context, req *connect.Request
 			}
 		}
 
-		return tx.Message.Create().
+		create := tx.Message.Create().
 			SetTask(task).
 			SetContent(conv.ConvertProtoContentToMemory(req.Msg.Content)).
-			SetSource(types.MessageSourceUser).
-			Save(ctx)
+			SetSource(types.MessageSourceUser)
+
+		if subject := owner(ctx); subject != "" {
+			create = create.SetOwner(subject)
+		}
+
+		return create.Save(ctx)
 	})
 
 	if err != nil {
@@ -89,6 +109,10 @@ func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1
 		return nil, apiError(err)
 	}
 
+	if err := h.checkMessageAccess(ctx, msg); err != nil {
+		return nil, apiError(err)
+	}
+
 	protoMsg, err := conv.ConvertMemoryMessageToProto(msg)
 	if err != nil {
 		return nil, apiError(err)
@@ -101,6 +125,9 @@ func (h *MessageHandler) GetMessage(ctx context.Context, req *connect.Request[v1
 
 func (h *MessageHandler) ListMessages(ctx context.Context, req *connect.Request[v1.ListMessagesRequest]) (*connect.Response[v1.ListMessagesResponse], error) {
 	query := h.db.Message.Query().WithTask()
+	if predicates := taskAccessPredicates(ctx); len(predicates) > 0 {
+		query = query.Where(message.HasTaskWith(predicates...))
+	}
 
 	if req.Msg.Filter != nil {
 		if req.Msg.Filter.TaskIds != nil {
@@ -156,6 +183,10 @@ func (h *MessageHandler) UpdateMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := h.checkMessageIDAccess(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	msg, err := h.db.Message.UpdateOneID(id).
 		SetContent(conv.ConvertProtoContentToMemory(req.Msg.Content)).
 		Save(ctx)
@@ -179,6 +210,10 @@ func (h *MessageHandler) DeleteMessage(ctx context.Context, req *connect.Request
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
+	if err := h.checkMessageIDAccess(ctx, id); err != nil {
+		return nil, apiError(err)
+	}
+
 	err = h.db.Message.DeleteOneID(id).Exec(ctx)
 	if err != nil {
 		return nil, apiError(err)
@@ -186,3 +221,19 @@ func (h *MessageHandler) DeleteMessage(ctx context.Context, req *connect.Request
 
 	return connect.NewResponse(&v1.DeleteMessageResponse{}), nil
 }
+
+func (h *MessageHandler) checkMessageIDAccess(ctx context.Context, id uuid.UUID) error {
+	msg, err := h.db.Message.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return h.checkMessageAccess(ctx, msg)
+}
+
+func (h *MessageHandler) checkMessageAccess(ctx context.Context, msg *memory.Message) error {
+	t, err := h.db.Task.Get(ctx, msg.TaskID)
+	if err != nil {
+		return err
+	}
+	return checkTaskAccess(ctx, t)
+}
@@ -96,6 +96,7 @@ func TestCreateMessage(t *testing.T) {
 						Metadata: &v1.MessageMetadata{
 							TaskId: taskID.String(),
 							Role:   v1.MessageRole_MESSAGE_ROLE_USER,
+							Owner:  "local-admin",
 						},
 						Spec: &v1.MessageSpec{
 							Content: []*v1.MessagePart{
@@ -3,25 +3,30 @@ package api
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/catalog"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelprovider"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
 
 type ModelHandler struct {
-	db *memory.Client
+	db            *memory.Client
+	catalogSource string
 	v1connect.UnimplementedModelServiceHandler
 }
 
-func NewModelHandler(db *memory.Client) *ModelHandler {
+func NewModelHandler(db *memory.Client, catalogSource string) *ModelHandler {
 	return &ModelHandler{
-		db: db,
+		db:            db,
+		catalogSource: catalogSource,
 	}
 }
 
@@ -52,6 +57,7 @@ func (h *ModelHandler) CreateModel(ctx context.Context, req *connect.Request[v1.
 			SetName(req.Msg.Name).
 			SetModelProvider(modelProvider).
 			SetContextWindow(req.Msg.ContextWindow).
+			SetSource(types.ModelSourceUser).
 			SetEnabled(true)
 
 		if len(capabilities) > 0 {
@@ -159,12 +165,18 @@ func (h *ModelHandler) UpdateModel(ctx context.Context, req *connect.Request[v1.
 	}
 
 	model, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Model, error) {
-		_, err = tx.Model.Get(ctx, id)
+		existing, err := tx.Model.Get(ctx, id)
 		if err != nil {
 			return nil, apiError(err)
 		}
 
 		update := tx.Model.UpdateOneID(id)
+		overrides := existing.Overrides
+		override := func(field types.ModelField) {
+			if !slices.Contains(overrides, field) {
+				overrides = append(overrides, field)
+			}
+		}
 
 		if req.Msg.Name != nil {
 			update = update.SetName(*req.Msg.Name)
@@ -185,6 +197,7 @@ func (h *ModelHandler) UpdateModel(ctx context.Context, req *connect.Request[v1.
 				}
 			}
 			update = update.SetCapabilities(capabilities)
+			override(types.ModelFieldCapabilities)
 		}
 
 		if req.Msg.Pricing != nil {
@@ -197,20 +210,28 @@ func (h *ModelHandler) UpdateModel(ctx context.Context, req *connect.Request[v1.
 				SetOutputCost(outputCost).
 				SetCacheWriteCost(cacheWriteCost).
 				SetCacheReadCost(cacheReadCost)
+			override(types.ModelFieldPricing)
 		}
 
 		if req.Msg.ContextWindow != nil {
 			update = update.SetContextWindow(*req.Msg.ContextWindow)
+			override(types.ModelFieldContextWindow)
 		}
 
 		if req.Msg.Enabled != nil {
 			update = update.SetEnabled(*req.Msg.Enabled)
+			override(types.ModelFieldEnabled)
 		}
 
 		if req.Msg.Alias != nil {
 			update = update.SetAlias(*req.Msg.Alias)
 		}
 
+		// Fields set by the user are kept by catalog syncs.
+		if len(overrides) > 0 {
+			update = update.SetOverrides(overrides)
+		}
+
 		return update.Save(ctx)
 	})
 
@@ -241,3 +262,42 @@ func (h *ModelHandler) DeleteModel(ctx context.Context, req *connect.Request[v1.
 
 	return connect.NewResponse(&v1.DeleteModelResponse{}), nil
 }
+
+func (h *ModelHandler) SyncModels(ctx context.Context, req *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error) {
+	modelCatalog, err := catalog.Load(ctx, h.catalogSource)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeFailedPrecondition, err))
+	}
+
+	changes, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*[]catalog.Change, error) {
+		providers, err := tx.ModelProvider.Query().
+			WithModels().
+			Order(modelprovider.ByName()).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		changes := catalog.Diff(modelCatalog, providers)
+		if req.Msg.Apply {
+			if err := catalog.Apply(ctx, tx, changes); err != nil {
+				return nil, err
+			}
+		}
+
+		return &changes, nil
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	resp := &v1.SyncModelsResponse{
+		CatalogVersion: modelCatalog.Version,
+		Applied:        req.Msg.Apply,
+	}
+	for _, change := range *changes {
+		resp.Changes = append(resp.Changes, conv.ConvertCatalogChangeToProto(change))
+	}
+
+	return connect.NewResponse(resp), nil
+}
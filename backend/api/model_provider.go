@@ -4,15 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
 	"connectrpc.com/connect"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/api/go/v1/v1connect"
 	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/catalog"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/agent"
 	modeldb "github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/prompt"
@@ -22,16 +26,21 @@ import (
 
 var _ v1connect.ModelProviderServiceHandler = (*ModelProviderHandler)(nil)
 
-func NewModelProviderHandler(db *memory.Client, encryption *secret.Encryption) *ModelProviderHandler {
+// defaultModelProviderKeyName is the name of the key that is created together with the provider.
+const defaultModelProviderKeyName = "default"
+
+func NewModelProviderHandler(db *memory.Client, encryption *secret.Encryption, runtime AgentRuntime) *ModelProviderHandler {
 	return &ModelProviderHandler{
 		db:         db,
 		encryption: encryption,
+		runtime:    runtime,
 	}
 }
 
 type ModelProviderHandler struct {
 	db         *memory.Client
 	encryption *secret.Encryption
+	runtime    AgentRuntime
 	v1connect.UnimplementedModelProviderServiceHandler
 }
 
@@ -41,11 +50,36 @@ func (h *ModelProviderHandler) CreateModelProvider(ctx context.Context, req *con
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
 	}
 
-	if providerType != types.ModelProviderTypeAnthropic {
-		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("only Anthropic is supported for now")))
+	modelCatalog, err := catalog.Embedded()
+	if err != nil {
+		return nil, apiError(fmt.Errorf("failed to load model catalog: %w", err))
+	}
+
+	supportedModels := modelCatalog.ProviderModels(providerType)
+	var builtinAgentModels *model.BuiltinAgentModels
+	if providerType == types.ModelProviderTypeReplay {
+		supportedModels, builtinAgentModels, err = replayModels(modelCatalog, req.Msg.GetUrl())
+	} else if providerType == types.ModelProviderTypeMock {
+		builtinAgentModels, err = mockModels(req.Msg.GetUrl())
+	} else {
+		builtinAgentModels, err = model.DefaultBuiltinAgentModels(model.ProviderKind(providerType))
+	}
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+	}
+
+	credentials, err := credentialsFromAuth(providerType, req.Msg.Authentication)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if req.Msg.TestConnection {
+		if err := h.testModelProviderCredentials(ctx, providerType, credentials); err != nil {
+			return nil, apiError(err)
+		}
 	}
 
-	jsonSecret, err := marshalAuthToJson(req.Msg.Authentication)
+	jsonSecret, err := json.Marshal(credentials)
 	if err != nil {
 		return nil, apiError(fmt.Errorf("failed to marshal authentication config: %w", err))
 	}
@@ -68,23 +102,40 @@ func (h *ModelProviderHandler) CreateModelProvider(ctx context.Context, req *con
 			create = create.SetURL(*req.Msg.Url)
 		}
 
+		if rateLimit := conv.ConvertModelProviderRateLimitToMemory(req.Msg.RateLimit); rateLimit != nil {
+			create = create.SetRateLimit(rateLimit)
+		}
+
+		if req.Msg.KeySelection != nil {
+			keySelection, err := conv.ConvertKeySelectionStrategyToMemory(*req.Msg.KeySelection)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			create = create.SetKeySelection(keySelection)
+		}
+
 		modelProvider, err := create.Save(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to insert model provider: %w", err)
 		}
 
-		supportedModels := model.SupportedModels(model.ProviderKind(providerType))
+		key, err := tx.ModelProviderKey.Create().
+			SetModelProvider(modelProvider).
+			SetName(defaultModelProviderKeyName).
+			SetSecret(encryptedSecret).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert model provider key: %w", err)
+		}
+		modelProvider.Edges.Keys = []*memory.ModelProviderKey{key}
+
 		models := make([]*memory.ModelCreate, 0, len(supportedModels))
 		for _, m := range supportedModels {
-			capabilities, err := conv.LLMModelCapabilitiesToMemory(m.Capabilities)
-			if err != nil {
-				return nil, err
-			}
 			models = append(models, h.db.Model.Create().
 				SetModelProvider(modelProvider).
 				SetName(m.Name).
 				SetContextWindow(m.ContextWindow).
-				SetCapabilities(capabilities).
+				SetCapabilities(m.Capabilities).
 				SetInputCost(m.Pricing.Input).
 				SetOutputCost(m.Pricing.Output).
 				SetCacheWriteCost(m.Pricing.CacheWrite).
@@ -105,7 +156,7 @@ func (h *ModelProviderHandler) CreateModelProvider(ctx context.Context, req *con
 	}
 
 	_, err = memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Agent, error) {
-		err := createBuiltinAgents(ctx, tx, modelProvider)
+		err := createBuiltinAgents(ctx, tx, modelProvider, builtinAgentModels)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create builtin agents: %w", err)
 		}
@@ -132,7 +183,7 @@ func (h *ModelProviderHandler) GetModelProvider(ctx context.Context, req *connec
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
 	}
 
-	modelProvider, err := h.db.ModelProvider.Get(ctx, id)
+	modelProvider, err := fetchModelProviderWithKeys(ctx, h.db, id)
 	if err != nil {
 		return nil, apiError(err)
 	}
@@ -148,7 +199,7 @@ func (h *ModelProviderHandler) GetModelProvider(ctx context.Context, req *connec
 }
 
 func (h *ModelProviderHandler) ListModelProviders(ctx context.Context, req *connect.Request[v1.ListModelProvidersRequest]) (*connect.Response[v1.ListModelProvidersResponse], error) {
-	query := h.db.ModelProvider.Query()
+	query := h.db.ModelProvider.Query().WithKeys(orderModelProviderKeys)
 
 	if req.Msg.Filter != nil {
 		if req.Msg.Filter.Enabled != nil {
@@ -194,12 +245,12 @@ func (h *ModelProviderHandler) UpdateModelProvider(ctx context.Context, req *con
 	}
 
 	modelProvider, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.ModelProvider, error) {
-		modelProvider, err := h.db.ModelProvider.Get(ctx, id)
+		modelProvider, err := tx.ModelProvider.Get(ctx, id)
 		if err != nil {
 			return nil, apiError(err)
 		}
 
-		update := h.db.ModelProvider.UpdateOne(modelProvider)
+		update := tx.ModelProvider.UpdateOne(modelProvider)
 
 		if req.Msg.Name != nil {
 			update = update.SetName(*req.Msg.Name)
@@ -209,8 +260,29 @@ func (h *ModelProviderHandler) UpdateModelProvider(ctx context.Context, req *con
 			update = update.SetEnabled(*req.Msg.Enabled)
 		}
 
+		if req.Msg.RateLimit != nil {
+			if rateLimit := conv.ConvertModelProviderRateLimitToMemory(req.Msg.RateLimit); rateLimit != nil {
+				update = update.SetRateLimit(rateLimit)
+			} else {
+				update = update.ClearRateLimit()
+			}
+		}
+
+		if req.Msg.KeySelection != nil {
+			keySelection, err := conv.ConvertKeySelectionStrategyToMemory(*req.Msg.KeySelection)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			update = update.SetKeySelection(keySelection)
+		}
+
 		if req.Msg.Authentication != nil {
-			jsonSecret, err := marshalAuthToJson(req.Msg.Authentication)
+			credentials, err := credentialsFromAuth(modelProvider.ProviderType, req.Msg.Authentication)
+			if err != nil {
+				return nil, err
+			}
+
+			jsonSecret, err := json.Marshal(credentials)
 			if err != nil {
 				return nil, apiError(fmt.Errorf("failed to marshal API key: %w", err))
 			}
@@ -221,9 +293,17 @@ func (h *ModelProviderHandler) UpdateModelProvider(ctx context.Context, req *con
 			}
 
 			update = update.SetSecret(encryptedSecret)
+
+			if err := replacePrimaryModelProviderKey(ctx, tx, id, encryptedSecret); err != nil {
+				return nil, err
+			}
 		}
 
-		return update.Save(ctx)
+		if _, err := update.Save(ctx); err != nil {
+			return nil, err
+		}
+
+		return fetchModelProviderWithKeys(ctx, tx, id)
 	})
 
 	if err != nil {
@@ -258,22 +338,162 @@ func (h *ModelProviderHandler) DeleteModelProvider(ctx context.Context, req *con
 	return connect.NewResponse(&v1.DeleteModelProviderResponse{}), nil
 }
 
-func marshalAuthToJson(config any) ([]byte, error) {
-	switch config := config.(type) {
+func (h *ModelProviderHandler) TestModelProvider(ctx context.Context, req *connect.Request[v1.TestModelProviderRequest]) (*connect.Response[v1.TestModelProviderResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+	}
+
+	modelProvider, err := h.db.ModelProvider.Query().
+		Where(modelprovider.ID(id)).
+		WithModels(func(q *memory.ModelQuery) {
+			q.Where(modeldb.Enabled(true)).Order(modeldb.ByName())
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	report, err := h.runtime.TestModelProvider(ctx, id)
+	if err != nil {
+		return nil, apiError(fmt.Errorf("failed to test model provider: %w", err))
+	}
+
+	resp := conv.ConvertConnectivityReportToProto(report)
+	for _, m := range modelProvider.Edges.Models {
+		resp.ConfiguredModels = append(resp.ConfiguredModels, m.Name)
+		if report.ModelsListed && !slices.Contains(report.AvailableModels, m.Name) {
+			resp.MissingModels = append(resp.MissingModels, m.Name)
+		}
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// testModelProviderCredentials verifies credentials before they are stored.
+func (h *ModelProviderHandler) testModelProviderCredentials(ctx context.Context, providerType types.ModelProviderType, credentials model.Credentials) error {
+	report, err := h.runtime.TestModelProviderCredentials(ctx, providerType, credentials)
+	if err != nil {
+		return fmt.Errorf("failed to test model provider: %w", err)
+	}
+
+	switch report.AuthStatus {
+	case model.AuthStatusAuthenticated:
+		return nil
+	case model.AuthStatusUnauthenticated:
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("model provider rejected the credentials: %w", report.Err))
+	default:
+		return connect.NewError(connect.CodeUnavailable, fmt.Errorf("model provider could not be reached: %w", report.Err))
+	}
+}
+
+// replayModels returns the models that were invoked in the cassette of a replay provider.
+// replayModels returns a model for every model that was invoked in the cassette. Models that are in
+// the catalog keep their capabilities and context window, but are free of charge.
+func replayModels(modelCatalog *catalog.Catalog, cassettePath string) ([]catalog.Entry, *model.BuiltinAgentModels, error) {
+	if cassettePath == "" {
+		return nil, nil, fmt.Errorf("provider replay requires the path of a cassette as url")
+	}
+
+	cassette, err := model.LoadCassette(cassettePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builtinAgentModels, err := cassette.BuiltinAgentModels()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	known := make(map[string]catalog.Entry, len(modelCatalog.Models))
+	for _, entry := range modelCatalog.Models {
+		known[entry.Name] = entry
+	}
+
+	var models []catalog.Entry
+	for _, m := range cassette.Models() {
+		entry := catalog.Entry{
+			Provider:      types.ModelProviderTypeReplay,
+			Name:          m.Name,
+			ContextWindow: replayContextWindow,
+		}
+		if k, ok := known[m.Name]; ok {
+			entry.Capabilities = k.Capabilities
+			entry.ContextWindow = k.ContextWindow
+		}
+		models = append(models, entry)
+	}
+
+	return models, builtinAgentModels, nil
+}
+
+// replayContextWindow is used for recorded models that are not in the catalog.
+const replayContextWindow = 200000
+
+// mockModels validates the script of a mock provider and returns its builtin agent models.
+func mockModels(scriptPath string) (*model.BuiltinAgentModels, error) {
+	if scriptPath == "" {
+		return nil, fmt.Errorf("provider mock requires the path of a script as url")
+	}
+
+	if _, err := model.LoadMockScript(scriptPath); err != nil {
+		return nil, err
+	}
+
+	return model.DefaultBuiltinAgentModels(model.ProviderKindMock)
+}
+
+// credentialsFromAuth converts the authentication of a request into the credentials that are
+// stored for the model provider. Bedrock authenticates with AWS credentials, every other
+// provider with an API key.
+func credentialsFromAuth(providerType types.ModelProviderType, auth any) (model.Credentials, error) {
+	var credentials model.Credentials
+	if (providerType == types.ModelProviderTypeReplay || providerType == types.ModelProviderTypeMock) && auth == nil {
+		return credentials, nil
+	}
+
+	switch auth := auth.(type) {
 	case *v1.CreateModelProviderRequest_ApiKey:
-		return json.Marshal(map[string]interface{}{
-			"apiKey": config.ApiKey,
-		})
+		credentials.APIKey = auth.ApiKey
 	case *v1.UpdateModelProviderRequest_ApiKey:
-		return json.Marshal(map[string]interface{}{
-			"apiKey": config.ApiKey,
-		})
+		credentials.APIKey = auth.ApiKey
+	case *v1.AddModelProviderKeyRequest_ApiKey:
+		credentials.APIKey = auth.ApiKey
+	case *v1.CreateModelProviderRequest_AwsCredentials:
+		credentials.AWS = convertAWSCredentials(auth.AwsCredentials)
+	case *v1.UpdateModelProviderRequest_AwsCredentials:
+		credentials.AWS = convertAWSCredentials(auth.AwsCredentials)
+	case *v1.AddModelProviderKeyRequest_AwsCredentials:
+		credentials.AWS = convertAWSCredentials(auth.AwsCredentials)
 	default:
-		return nil, fmt.Errorf("unsupported authentication config type: %T", config)
+		return credentials, fmt.Errorf("unsupported authentication config type: %T", auth)
+	}
+
+	if providerType == types.ModelProviderTypeBedrock {
+		if credentials.AWS == nil {
+			return credentials, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider %s requires AWS credentials", providerType))
+		}
+	} else if credentials.APIKey == "" {
+		return credentials, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("provider %s requires an API key", providerType))
+	}
+
+	return credentials, nil
+}
+
+func convertAWSCredentials(creds *v1.AWSCredentials) *model.AWSCredentials {
+	if creds == nil {
+		return nil
+	}
+
+	return &model.AWSCredentials{
+		AccessKeyID:     creds.AccessKeyId,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.GetSessionToken(),
+		Region:          creds.Region,
 	}
 }
 
-func createBuiltinAgents(ctx context.Context, tx *memory.Client, modelProvider *memory.ModelProvider) error {
+func createBuiltinAgents(ctx context.Context, tx *memory.Client, modelProvider *memory.ModelProvider, agentModels *model.BuiltinAgentModels) error {
 	builtinAgents, err := tx.Agent.Query().Where(agent.Builtin(true)).WithModel().All(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get system agents: %w", err)
@@ -284,19 +504,19 @@ func createBuiltinAgents(ctx context.Context, tx *memory.Client, modelProvider *
 	}
 
 	defaultModel, err := tx.Model.Query().Where(modeldb.ModelProviderID(modelProvider.ID)).
-		Where(modeldb.Name(model.AnthropicDefaultModel)).First(ctx)
+		Where(modeldb.Name(agentModels.Default)).First(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get default model: %w", err)
 	}
 
 	budgetModel, err := tx.Model.Query().Where(modeldb.ModelProviderID(modelProvider.ID)).
-		Where(modeldb.Name(model.AnthropicBudgetModel)).First(ctx)
+		Where(modeldb.Name(agentModels.Budget)).First(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get budget model: %w", err)
 	}
 
 	planModel, err := tx.Model.Query().Where(modeldb.ModelProviderID(modelProvider.ID)).
-		Where(modeldb.Name(model.AnthropicPlanModel)).First(ctx)
+		Where(modeldb.Name(agentModels.Plan)).First(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get plan model: %w", err)
 	}
@@ -338,3 +558,164 @@ func createBuiltinAgent(ctx context.Context, tx *memory.Client, agentID uuid.UUI
 
 	return nil
 }
+
+func (h *ModelProviderHandler) AddModelProviderKey(ctx context.Context, req *connect.Request[v1.AddModelProviderKeyRequest]) (*connect.Response[v1.AddModelProviderKeyResponse], error) {
+	modelProviderID, err := uuid.Parse(req.Msg.ModelProviderId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid model provider ID format: %w", err)))
+	}
+
+	modelProvider, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.ModelProvider, error) {
+		modelProvider, err := fetchModelProviderWithKeys(ctx, tx, modelProviderID)
+		if err != nil {
+			return nil, err
+		}
+
+		credentials, err := credentialsFromAuth(modelProvider.ProviderType, req.Msg.Authentication)
+		if err != nil {
+			return nil, err
+		}
+
+		jsonSecret, err := json.Marshal(credentials)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal authentication config: %w", err)
+		}
+
+		encryptedSecret, err := h.encryption.Encrypt(jsonSecret, []byte(secret.ModelProviderAssociated(modelProviderID)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to encrypt API key")
+		}
+
+		priority := 0
+		for _, key := range modelProvider.Edges.Keys {
+			if key.Name == req.Msg.Name {
+				return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("model provider already has a key named %s", req.Msg.Name))
+			}
+			priority = max(priority, key.Priority+1)
+		}
+
+		if req.Msg.Priority != nil {
+			priority = int(*req.Msg.Priority)
+		}
+
+		_, err = tx.ModelProviderKey.Create().
+			SetModelProviderID(modelProviderID).
+			SetName(req.Msg.Name).
+			SetSecret(encryptedSecret).
+			SetPriority(priority).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert model provider key: %w", err)
+		}
+
+		return fetchModelProviderWithKeys(ctx, tx, modelProviderID)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoModelProvider, err := conv.ConvertModelProviderIntoProto(modelProvider)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.AddModelProviderKeyResponse{
+		ModelProvider: protoModelProvider,
+	}), nil
+}
+
+func (h *ModelProviderHandler) RetireModelProviderKey(ctx context.Context, req *connect.Request[v1.RetireModelProviderKeyRequest]) (*connect.Response[v1.RetireModelProviderKeyResponse], error) {
+	modelProviderID, err := uuid.Parse(req.Msg.ModelProviderId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid model provider ID format: %w", err)))
+	}
+
+	keyID, err := uuid.Parse(req.Msg.KeyId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid key ID format: %w", err)))
+	}
+
+	modelProvider, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.ModelProvider, error) {
+		key, err := tx.ModelProviderKey.Query().
+			Where(
+				modelproviderkey.ID(keyID),
+				modelproviderkey.ModelProviderID(modelProviderID),
+			).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !key.RetiredTime.IsZero() {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("key %s is already retired", key.Name))
+		}
+
+		activeKeys, err := tx.ModelProviderKey.Query().
+			Where(
+				modelproviderkey.ModelProviderID(modelProviderID),
+				modelproviderkey.RetiredTimeIsNil(),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if activeKeys <= 1 {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("cannot retire the last active key of the model provider"))
+		}
+
+		if err := tx.ModelProviderKey.UpdateOne(key).SetRetiredTime(time.Now()).Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to retire model provider key: %w", err)
+		}
+
+		return fetchModelProviderWithKeys(ctx, tx, modelProviderID)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoModelProvider, err := conv.ConvertModelProviderIntoProto(modelProvider)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.RetireModelProviderKeyResponse{
+		ModelProvider: protoModelProvider,
+	}), nil
+}
+
+func fetchModelProviderWithKeys(ctx context.Context, db *memory.Client, id uuid.UUID) (*memory.ModelProvider, error) {
+	return db.ModelProvider.Query().
+		Where(modelprovider.ID(id)).
+		WithKeys(orderModelProviderKeys).
+		Only(ctx)
+}
+
+func orderModelProviderKeys(q *memory.ModelProviderKeyQuery) {
+	q.Order(modelproviderkey.ByPriority(), modelproviderkey.ByCreateTime())
+}
+
+// replacePrimaryModelProviderKey replaces the secret of the active key with the highest priority.
+// Providers that were created before keys were managed individually get their first key.
+func replacePrimaryModelProviderKey(ctx context.Context, tx *memory.Client, modelProviderID uuid.UUID, encryptedSecret []byte) error {
+	primary, err := tx.ModelProviderKey.Query().
+		Where(
+			modelproviderkey.ModelProviderID(modelProviderID),
+			modelproviderkey.RetiredTimeIsNil(),
+		).
+		Order(modelproviderkey.ByPriority(), modelproviderkey.ByCreateTime()).
+		First(ctx)
+	if err != nil && !memory.IsNotFound(err) {
+		return err
+	}
+
+	if primary == nil {
+		return tx.ModelProviderKey.Create().
+			SetModelProviderID(modelProviderID).
+			SetName(defaultModelProviderKeyName).
+			SetSecret(encryptedSecret).
+			Exec(ctx)
+	}
+
+	return tx.ModelProviderKey.UpdateOne(primary).SetSecret(encryptedSecret).Exec(ctx)
+}
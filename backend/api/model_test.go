@@ -2,12 +2,16 @@ package api
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client"
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -572,3 +576,185 @@ func TestDeleteModel(t *testing.T) {
 		},
 	})
 }
+
+func TestSyncModels(t *testing.T) {
+	type syncedModel struct {
+		Name      string
+		Enabled   bool
+		InputCost float64
+		Source    types.ModelSource
+	}
+
+	setup := ServiceTestSetup[v1.SyncModelsRequest, v1.SyncModelsResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.SyncModelsRequest]) (*connect.Response[v1.SyncModelsResponse], error) {
+			return client.Model().SyncModels(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.SyncModelsResponse{}, v1.ModelCatalogChange{}, v1.ModelPricing{}),
+			protocmp.Transform(),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			models, err := db.Model.Query().Order(model.ByName()).All(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			var synced []syncedModel
+			for _, m := range models {
+				synced = append(synced, syncedModel{Name: m.Name, Enabled: m.Enabled, InputCost: m.InputCost, Source: m.Source})
+			}
+			return synced, nil
+		},
+	}
+
+	catalogFile := filepath.Join(t.TempDir(), "catalog.json")
+	err := os.WriteFile(catalogFile, []byte(`{
+		"version": "2025.12.01",
+		"models": [
+			{
+				"provider": "anthropic",
+				"name": "claude-3-7-sonnet-20250219",
+				"context_window": 200000,
+				"capabilities": ["prompt_cache"],
+				"pricing": {"input": 2, "output": 15, "cache_write": 3.75, "cache_read": 0.3}
+			},
+			{
+				"provider": "anthropic",
+				"name": "claude-next",
+				"context_window": 400000,
+				"pricing": {"input": 5, "output": 25, "cache_write": 6.25, "cache_read": 0.5}
+			}
+		]
+	}`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	invalidCatalogFile := filepath.Join(t.TempDir(), "invalid.json")
+	if err := os.WriteFile(invalidCatalogFile, []byte(`{"models": []}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	modelProviderID := uuid.New()
+	sonnetID := uuid.New()
+	retiredID := uuid.New()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, modelProviderID, db).
+			Build(ctx)
+
+		test.NewModelBuilder(t, sonnetID, db, modelProvider).
+			Build(ctx)
+
+		test.NewModelBuilder(t, retiredID, db, modelProvider).
+			WithName("claude-old").
+			Build(ctx)
+	}
+
+	changes := []*v1.ModelCatalogChange{
+		{
+			Kind:              v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_REPRICED,
+			ModelProviderId:   modelProviderID.String(),
+			ModelProviderName: "anthropic",
+			ModelName:         "claude-3-7-sonnet-20250219",
+			ModelId:           strPtr(sonnetID.String()),
+			Fields:            []string{"pricing"},
+			Pricing: &v1.ModelPricing{
+				InputCost:      adapters.Float64ToProtoDecimal(2),
+				OutputCost:     adapters.Float64ToProtoDecimal(15),
+				CacheWriteCost: adapters.Float64ToProtoDecimal(3.75),
+				CacheReadCost:  adapters.Float64ToProtoDecimal(0.3),
+			},
+			PreviousPricing: &v1.ModelPricing{
+				InputCost:      adapters.Float64ToProtoDecimal(3),
+				OutputCost:     adapters.Float64ToProtoDecimal(15),
+				CacheWriteCost: adapters.Float64ToProtoDecimal(3.75),
+				CacheReadCost:  adapters.Float64ToProtoDecimal(0.3),
+			},
+			ContextWindow:         200000,
+			PreviousContextWindow: 200000,
+		},
+		{
+			Kind:              v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_NEW,
+			ModelProviderId:   modelProviderID.String(),
+			ModelProviderName: "anthropic",
+			ModelName:         "claude-next",
+			Pricing: &v1.ModelPricing{
+				InputCost:      adapters.Float64ToProtoDecimal(5),
+				OutputCost:     adapters.Float64ToProtoDecimal(25),
+				CacheWriteCost: adapters.Float64ToProtoDecimal(6.25),
+				CacheReadCost:  adapters.Float64ToProtoDecimal(0.5),
+			},
+			ContextWindow: 400000,
+		},
+		{
+			Kind:              v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_RETIRED,
+			ModelProviderId:   modelProviderID.String(),
+			ModelProviderName: "anthropic",
+			ModelName:         "claude-old",
+			ModelId:           strPtr(retiredID.String()),
+			Fields:            []string{"enabled"},
+			PreviousPricing: &v1.ModelPricing{
+				InputCost:      adapters.Float64ToProtoDecimal(3),
+				OutputCost:     adapters.Float64ToProtoDecimal(15),
+				CacheWriteCost: adapters.Float64ToProtoDecimal(3.75),
+				CacheReadCost:  adapters.Float64ToProtoDecimal(0.3),
+			},
+			PreviousContextWindow: 200000,
+		},
+	}
+
+	invalidSetup := setup
+	invalidSetup.ConfigureHandler = func(options *HandlerOptions) {
+		options.CatalogSource = invalidCatalogFile
+	}
+	invalidSetup.RunServiceTests(t, []ServiceTestScenario[v1.SyncModelsRequest, v1.SyncModelsResponse]{
+		{
+			Name:    "invalid catalog",
+			Request: &v1.SyncModelsRequest{},
+			Expected: ServiceTestExpectation[v1.SyncModelsResponse]{
+				Error: "failed_precondition: invalid model catalog: version is required",
+			},
+		},
+	})
+
+	setup.ConfigureHandler = func(options *HandlerOptions) {
+		options.CatalogSource = catalogFile
+	}
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.SyncModelsRequest, v1.SyncModelsResponse]{
+		{
+			Name:         "dry run",
+			SeedDatabase: seed,
+			Request:      &v1.SyncModelsRequest{},
+			Expected: ServiceTestExpectation[v1.SyncModelsResponse]{
+				Response: v1.SyncModelsResponse{
+					CatalogVersion: "2025.12.01",
+					Changes:        changes,
+				},
+				Database: []syncedModel{
+					{Name: "claude-3-7-sonnet-20250219", Enabled: true, InputCost: 3, Source: types.ModelSourceCatalog},
+					{Name: "claude-old", Enabled: true, InputCost: 3, Source: types.ModelSourceCatalog},
+				},
+			},
+		},
+		{
+			Name:         "apply",
+			SeedDatabase: seed,
+			Request: &v1.SyncModelsRequest{
+				Apply: true,
+			},
+			Expected: ServiceTestExpectation[v1.SyncModelsResponse]{
+				Response: v1.SyncModelsResponse{
+					CatalogVersion: "2025.12.01",
+					Changes:        changes,
+					Applied:        true,
+				},
+				Database: []syncedModel{
+					{Name: "claude-3-7-sonnet-20250219", Enabled: true, InputCost: 2, Source: types.ModelSourceCatalog},
+					{Name: "claude-next", Enabled: true, InputCost: 5, Source: types.ModelSourceCatalog},
+					{Name: "claude-old", Enabled: false, InputCost: 3, Source: types.ModelSourceCatalog},
+				},
+			},
+		},
+	})
+}
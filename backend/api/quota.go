@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/quota"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ v1connect.QuotaServiceHandler = (*QuotaHandler)(nil)
+
+func NewQuotaHandler(db *memory.Client) *QuotaHandler {
+	return &QuotaHandler{
+		quotas: quota.NewEnforcer(db),
+	}
+}
+
+type QuotaHandler struct {
+	quotas *quota.Enforcer
+	v1connect.UnimplementedQuotaServiceHandler
+}
+
+func (h *QuotaHandler) GetQuotaUsage(ctx context.Context, req *connect.Request[v1.GetQuotaUsageRequest]) (*connect.Response[v1.GetQuotaUsageResponse], error) {
+	subject := owner(ctx)
+	if req.Msg.Subject != nil && *req.Msg.Subject != subject {
+		identity := auth.FromContext(ctx)
+		if identity != nil && !identity.IsAdmin {
+			return nil, apiError(connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only admins can see the quota usage of other subjects")))
+		}
+		subject = *req.Msg.Subject
+	}
+	if subject == "" {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("subject is required")))
+	}
+
+	limits, err := h.quotas.Quota(ctx, subject)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	usage, err := h.quotas.Usage(ctx, subject)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetQuotaUsageResponse{
+		Subject: subject,
+		Quota:   conv.ConvertQuotaToProto(limits),
+		Usage: &v1.QuotaUsage{
+			RunningTasks:   int32(usage.RunningTasks),
+			DailySpend:     usage.DailySpend,
+			MonthlySpend:   usage.MonthlySpend,
+			DailyTokens:    usage.DailyTokens,
+			DailyResetAt:   timestamppb.New(usage.DailyResetAt),
+			MonthlyResetAt: timestamppb.New(usage.MonthlyResetAt),
+		},
+	}), nil
+}
+
+// quotaError turns an exceeded quota into a ResourceExhausted error.
+func quotaError(err error) error {
+	var exceeded *quota.ExceededError
+	if errors.As(err, &exceeded) {
+		return connect.NewError(connect.CodeResourceExhausted, exceeded)
+	}
+	return err
+}
@@ -0,0 +1,121 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestQuotaEnforcement(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	test.NewTokenBuilder(t, uuid.New(), db).WithName("ci").WithQuota(types.Quota{MaxConcurrentTasks: 1, DailySpend: 5}).Build(ctx)
+
+	running := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("ci").WithPhase(types.TaskPhaseRunning).Build(ctx)
+	idle := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("ci").Build(ctx)
+
+	ci := auth.WithIdentity(ctx, &auth.Identity{Subject: "ci", AuthMethod: auth.AuthMethodToken})
+	taskHandler := NewTaskHandler(db, options.EventRouter, options.AgentRuntime, options.Analytics)
+	messageHandler := NewMessageHandler(db, options.AgentRuntime, options.EventRouter)
+	message := func(taskID uuid.UUID) *connect.Request[v1.CreateMessageRequest] {
+		return connect.NewRequest(&v1.CreateMessageRequest{
+			TaskId:  taskID.String(),
+			Content: []*v1.MessagePart{{Data: &v1.MessagePart_Text_{Text: &v1.MessagePart_Text{Content: "continue"}}}},
+		})
+	}
+
+	_, err := taskHandler.CreateTask(ci, connect.NewRequest(&v1.CreateTaskRequest{AgentId: agent.ID.String()}))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Errorf("CreateTask() error = %v, want resource exhausted", err)
+	}
+
+	_, err = messageHandler.CreateMessage(ci, message(idle.ID))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Errorf("CreateMessage() on idle task error = %v, want resource exhausted", err)
+	}
+
+	_, err = messageHandler.CreateMessage(ci, message(running.ID))
+	if err != nil {
+		t.Errorf("CreateMessage() on running task error = %v, want nil", err)
+	}
+
+	test.NewMessageBuilder(t, uuid.New(), db, running).WithAgent(agent).WithUsage(&types.MessageUsage{Cost: 5}).Build(ctx)
+
+	_, err = messageHandler.CreateMessage(ci, message(running.ID))
+	if connect.CodeOf(err) != connect.CodeResourceExhausted {
+		t.Errorf("CreateMessage() over spend error = %v, want resource exhausted", err)
+	}
+}
+
+func TestGetQuotaUsage(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	test.NewTokenBuilder(t, uuid.New(), db).WithName("ci").WithQuota(types.Quota{DailyTokens: 1000}).Build(ctx)
+	task := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("ci").WithPhase(types.TaskPhaseRunning).Build(ctx)
+	test.NewMessageBuilder(t, uuid.New(), db, task).WithAgent(agent).WithUsage(&types.MessageUsage{InputTokens: 300, OutputTokens: 50, Cost: 0.25}).Build(ctx)
+
+	handler := NewQuotaHandler(db)
+	ci := auth.WithIdentity(ctx, &auth.Identity{Subject: "ci", AuthMethod: auth.AuthMethodToken})
+	admin := auth.WithIdentity(ctx, &auth.Identity{Subject: "local-admin", AuthMethod: auth.AuthMethodUnixSocket, IsAdmin: true})
+
+	want := &v1.GetQuotaUsageResponse{
+		Subject: "ci",
+		Quota:   &v1.Quota{DailyTokens: 1000},
+		Usage: &v1.QuotaUsage{
+			RunningTasks: 1,
+			DailySpend:   0.25,
+			MonthlySpend: 0.25,
+			DailyTokens:  350,
+		},
+	}
+	cmpOptions := []cmp.Option{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(&v1.QuotaUsage{}, "daily_reset_at", "monthly_reset_at"),
+	}
+
+	for name, ctx := range map[string]context.Context{"own": ci, "admin": admin} {
+		req := &v1.GetQuotaUsageRequest{}
+		if name == "admin" {
+			req.Subject = strPtr("ci")
+		}
+
+		resp, err := handler.GetQuotaUsage(ctx, connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("GetQuotaUsage() as %s error = %v", name, err)
+		}
+		if diff := cmp.Diff(want, resp.Msg, cmpOptions...); diff != "" {
+			t.Errorf("GetQuotaUsage() as %s mismatch (-want +got):\n%s", name, diff)
+		}
+	}
+
+	_, err := handler.GetQuotaUsage(ci, connect.NewRequest(&v1.GetQuotaUsageRequest{Subject: strPtr("alice")}))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("GetQuotaUsage() of another subject error = %v, want permission denied", err)
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
 	"entgo.io/ent/dialect/sql"
@@ -15,8 +16,10 @@ import (
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/extension"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/quota"
 	"github.com/google/uuid"
 )
 
@@ -28,6 +31,7 @@ func NewTaskHandler(db *memory.Client, eventRouter *event.EventRouter, runtime A
 		eventRouter: eventRouter,
 		runtime:     runtime,
 		analytics:   analytics,
+		quotas:      quota.NewEnforcer(db),
 	}
 }
 
@@ -36,6 +40,7 @@ type TaskHandler struct {
 	eventRouter *event.EventRouter
 	runtime     AgentRuntime
 	analytics   analytics.Client
+	quotas      *quota.Enforcer
 	v1connect.UnimplementedTaskServiceHandler
 }
 
@@ -45,6 +50,16 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[v1.Cr
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err)))
 	}
 
+	if err := checkAgentAccess(ctx, agentID); err != nil {
+		return nil, apiError(err)
+	}
+	if err := checkWorkspaceAccess(ctx, req.Msg.ProjectDirectory); err != nil {
+		return nil, apiError(err)
+	}
+	if err := h.quotas.Check(ctx, owner(ctx), uuid.Nil); err != nil {
+		return nil, apiError(quotaError(err))
+	}
+
 	createdTask, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
 		_, err := tx.Agent.Get(ctx, agentID)
 		if err != nil {
@@ -53,7 +68,12 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[v1.Cr
 
 		taskCreate := tx.Task.Create().
 			SetAgentID(agentID).
-			SetProjectDirectory(req.Msg.ProjectDirectory)
+			SetProjectDirectory(req.Msg.ProjectDirectory).
+			SetTraceModelCalls(req.Msg.TraceModelCalls)
+
+		if subject := owner(ctx); subject != "" {
+			taskCreate = taskCreate.SetOwner(subject)
+		}
 
 		if req.Msg.Description != "" {
 			taskCreate = taskCreate.SetDescription(req.Msg.Description)
@@ -89,6 +109,10 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *connect.Request[v1.GetTa
 		return nil, apiError(err)
 	}
 
+	if err := checkTaskAccess(ctx, task); err != nil {
+		return nil, apiError(err)
+	}
+
 	protoTask, err := conv.ConvertTaskToProto(task)
 	if err != nil {
 		return nil, apiError(err)
@@ -100,7 +124,7 @@ func (h *TaskHandler) GetTask(ctx context.Context, req *connect.Request[v1.GetTa
 }
 
 func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.ListTasksRequest]) (*connect.Response[v1.ListTasksResponse], error) {
-	query := h.db.Task.Query()
+	query := h.db.Task.Query().Where(taskAccessPredicates(ctx)...)
 
 	if req.Msg.Filter != nil && req.Msg.Filter.AgentId != nil {
 		agentID, err := uuid.Parse(*req.Msg.Filter.AgentId)
@@ -110,6 +134,10 @@ func (h *TaskHandler) ListTasks(ctx context.Context, req *connect.Request[v1.Lis
 		query = query.Where(task.HasAgentWith(agent.ID(agentID)))
 	}
 
+	if req.Msg.Filter != nil && req.Msg.Filter.Owner != nil {
+		query = query.Where(task.Owner(*req.Msg.Filter.Owner))
+	}
+
 	if req.Msg.Filter != nil && req.Msg.Filter.TaskIdPrefix != nil {
 		query = query.Where(extension.UUIDHasPrefix(task.Table, task.FieldID, *req.Msg.Filter.TaskIdPrefix))
 	}
@@ -198,6 +226,9 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 		if err != nil {
 			return nil, err
 		}
+		if err := checkTaskAccess(ctx, t); err != nil {
+			return nil, err
+		}
 		update := t.Update()
 
 		if req.Msg.AgentId != nil {
@@ -206,6 +237,10 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 				return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid agent ID format: %w", err))
 			}
 
+			if err := checkAgentAccess(ctx, agentID); err != nil {
+				return nil, err
+			}
+
 			_, err = tx.Agent.Get(ctx, agentID)
 			if err != nil {
 				return nil, err
@@ -215,6 +250,11 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[v1.Up
 			updatedFields = append(updatedFields, "agent_id")
 		}
 
+		if req.Msg.TraceModelCalls != nil {
+			update = update.SetTraceModelCalls(*req.Msg.TraceModelCalls)
+			updatedFields = append(updatedFields, "trace_model_calls")
+		}
+
 		return update.Save(ctx)
 	})
 
@@ -242,6 +282,15 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *connect.Request[v1.De
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
 	}
 
+	t, err := h.db.Task.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := checkTaskOwnership(ctx, t); err != nil {
+		return nil, apiError(err)
+	}
+
 	if err := h.db.Task.DeleteOneID(id).Exec(ctx); err != nil {
 		return nil, apiError(err)
 	}
@@ -255,6 +304,10 @@ func (h *TaskHandler) SuspendTask(ctx context.Context, req *connect.Request[v1.S
 		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
 	}
 
+	if err := h.checkTaskAccess(ctx, taskID); err != nil {
+		return nil, apiError(err)
+	}
+
 	_, err = memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
 		_, err = h.db.Task.UpdateOneID(taskID).SetPhase(types.TaskPhaseSuspended).Save(ctx)
 		if err != nil {
@@ -270,3 +323,126 @@ func (h *TaskHandler) SuspendTask(ctx context.Context, req *connect.Request[v1.S
 	h.eventRouter.Publish(event.NewInternalTaskSuspendEvent(taskID))
 	return connect.NewResponse(&v1.SuspendTaskResponse{}), nil
 }
+
+func (h *TaskHandler) GetModelCallTrace(ctx context.Context, req *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.TaskId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	if err := h.checkTaskAccess(ctx, taskID); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.ModelCallTrace.Query().
+		Where(modelcalltrace.TaskID(taskID)).
+		Order(memory.Desc(modelcalltrace.FieldCreateTime), memory.Desc(modelcalltrace.FieldID))
+	if req.Msg.Limit != nil {
+		query = query.Limit(int(*req.Msg.Limit))
+	}
+
+	traces, err := query.All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	calls := make([]*v1.ModelCallTrace, 0, len(traces))
+	for i := len(traces) - 1; i >= 0; i-- {
+		calls = append(calls, conv.ConvertModelCallTraceToProto(traces[i]))
+	}
+
+	return connect.NewResponse(&v1.GetModelCallTraceResponse{
+		Calls: calls,
+	}), nil
+}
+
+func (h *TaskHandler) ShareTask(ctx context.Context, req *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.TaskId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	if req.Msg.Subject == "" {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("subject is required")))
+	}
+
+	sharedTask, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
+		t, err := tx.Task.Get(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkTaskOwnership(ctx, t); err != nil {
+			return nil, err
+		}
+
+		if req.Msg.Subject == t.Owner {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("task %s is owned by %s", taskID, t.Owner))
+		}
+		if slices.Contains(t.SharedWith, req.Msg.Subject) {
+			return t, nil
+		}
+
+		return t.Update().AppendSharedWith([]string{req.Msg.Subject}).Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTask, err := conv.ConvertTaskToProto(sharedTask)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.ShareTaskResponse{
+		Task: protoTask,
+	}), nil
+}
+
+func (h *TaskHandler) UnshareTask(ctx context.Context, req *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	taskID, err := uuid.Parse(req.Msg.TaskId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid task ID format: %w", err)))
+	}
+
+	unsharedTask, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Task, error) {
+		t, err := tx.Task.Get(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkTaskOwnership(ctx, t); err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(t.SharedWith, req.Msg.Subject) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("task %s is not shared with %s", taskID, req.Msg.Subject))
+		}
+
+		sharedWith := slices.DeleteFunc(slices.Clone(t.SharedWith), func(subject string) bool {
+			return subject == req.Msg.Subject
+		})
+		if len(sharedWith) == 0 {
+			return t.Update().ClearSharedWith().Save(ctx)
+		}
+		return t.Update().SetSharedWith(sharedWith).Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoTask, err := conv.ConvertTaskToProto(unsharedTask)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UnshareTaskResponse{
+		Task: protoTask,
+	}), nil
+}
+
+func (h *TaskHandler) checkTaskAccess(ctx context.Context, id uuid.UUID) error {
+	t, err := h.db.Task.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return checkTaskAccess(ctx, t)
+}
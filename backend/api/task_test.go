@@ -2,7 +2,9 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/furisto/construct/api/go/client"
@@ -422,6 +424,38 @@ func TestUpdateTask(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "success - enable model call tracing",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+			},
+			Request: &v1.UpdateTaskRequest{
+				Id:              taskID.String(),
+				TraceModelCalls: boolPtr(true),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateTaskResponse]{
+				Response: v1.UpdateTaskResponse{
+					Task: &v1.Task{
+						Metadata: &v1.TaskMetadata{
+							Id: taskID.String(),
+						},
+						Spec: &v1.TaskSpec{
+							AgentId:         strPtr(agentID.String()),
+							DesiredPhase:    v1.TaskPhase_TASK_PHASE_RUNNING,
+							TraceModelCalls: true,
+						},
+						Status: &v1.TaskStatus{
+							Usage: &v1.TaskUsage{},
+							Phase: v1.TaskPhase_TASK_PHASE_AWAITING,
+						},
+					},
+				},
+			},
+		},
 	})
 }
 
@@ -482,3 +516,111 @@ func TestDeleteTask(t *testing.T) {
 		},
 	})
 }
+
+func TestGetModelCallTrace(t *testing.T) {
+	setup := ServiceTestSetup[v1.GetModelCallTraceRequest, v1.GetModelCallTraceResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.GetModelCallTraceRequest]) (*connect.Response[v1.GetModelCallTraceResponse], error) {
+			return client.Task().GetModelCallTrace(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.GetModelCallTraceResponse{}, v1.ModelCallTrace{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.ModelCallTrace{}, "id", "created_at"),
+		},
+	}
+
+	taskID := uuid.New()
+	agentID := uuid.New()
+	modelID := uuid.New()
+	modelProviderID := uuid.New()
+	limit := int32(1)
+
+	seedTraces := func(ctx context.Context, db *memory.Client) {
+		modelProvider := test.NewModelProviderBuilder(t, modelProviderID, db).Build(ctx)
+		model := test.NewModelBuilder(t, modelID, db, modelProvider).Build(ctx)
+		agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+		task := test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+
+		start := time.Now().Add(-time.Minute)
+		for i, status := range []int{429, 200} {
+			db.ModelCallTrace.Create().
+				SetTaskID(task.ID).
+				SetModelName("claude-sonnet-4-5").
+				SetModelProviderID(modelProviderID).
+				SetMethod("POST").
+				SetURL("https://api.anthropic.com/v1/messages").
+				SetRequestHeaders(map[string][]string{"X-Api-Key": {"[REDACTED]"}}).
+				SetRequestBody([]byte(`{"model":"claude-sonnet-4-5"}`)).
+				SetStatusCode(status).
+				SetResponseBody([]byte(fmt.Sprintf("response %d", i+1))).
+				SetDurationMs(int64(100 * (i + 1))).
+				SetCreateTime(start.Add(time.Duration(i) * time.Second)).
+				SaveX(ctx)
+		}
+	}
+
+	expectedCall := func(status int32, body string, duration int64) *v1.ModelCallTrace {
+		return &v1.ModelCallTrace{
+			Model:           "claude-sonnet-4-5",
+			ModelProviderId: modelProviderID.String(),
+			Method:          "POST",
+			Url:             "https://api.anthropic.com/v1/messages",
+			RequestHeaders:  map[string]string{"X-Api-Key": "[REDACTED]"},
+			RequestBody:     []byte(`{"model":"claude-sonnet-4-5"}`),
+			StatusCode:      status,
+			ResponseBody:    []byte(body),
+			DurationMs:      duration,
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.GetModelCallTraceRequest, v1.GetModelCallTraceResponse]{
+		{
+			Name: "invalid task ID format",
+			Request: &v1.GetModelCallTraceRequest{
+				TaskId: "not-a-valid-uuid",
+			},
+			Expected: ServiceTestExpectation[v1.GetModelCallTraceResponse]{
+				Error: "invalid_argument: invalid task ID format: invalid UUID length: 16",
+			},
+		},
+		{
+			Name: "task not found",
+			Request: &v1.GetModelCallTraceRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetModelCallTraceResponse]{
+				Error: "not_found: task not found",
+			},
+		},
+		{
+			Name:         "success - oldest first",
+			SeedDatabase: seedTraces,
+			Request: &v1.GetModelCallTraceRequest{
+				TaskId: taskID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.GetModelCallTraceResponse]{
+				Response: v1.GetModelCallTraceResponse{
+					Calls: []*v1.ModelCallTrace{
+						expectedCall(429, "response 1", 100),
+						expectedCall(200, "response 2", 200),
+					},
+				},
+			},
+		},
+		{
+			Name:         "success - limit to most recent",
+			SeedDatabase: seedTraces,
+			Request: &v1.GetModelCallTraceRequest{
+				TaskId: taskID.String(),
+				Limit:  &limit,
+			},
+			Expected: ServiceTestExpectation[v1.GetModelCallTraceResponse]{
+				Response: v1.GetModelCallTraceResponse{
+					Calls: []*v1.ModelCallTrace{
+						expectedCall(200, "response 2", 200),
+					},
+				},
+			},
+		},
+	})
+}
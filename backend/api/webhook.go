@@ -0,0 +1,431 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/api/go/v1/v1connect"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_webhook "github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+var _ v1connect.WebhookServiceHandler = (*WebhookHandler)(nil)
+
+const (
+	// webhookSecretPrefix marks generated webhook secrets, so that they are recognizable in configuration files.
+	webhookSecretPrefix = "whsec_"
+
+	defaultWebhookDeliveryPageSize = 50
+	maxWebhookDeliveryPageSize     = 100
+)
+
+func NewWebhookHandler(db *memory.Client, encryption *secret.Encryption) *WebhookHandler {
+	return &WebhookHandler{
+		db:         db,
+		encryption: encryption,
+	}
+}
+
+type WebhookHandler struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	v1connect.UnimplementedWebhookServiceHandler
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+	if err := validateWebhookURL(req.Msg.Url); err != nil {
+		return nil, apiError(err)
+	}
+
+	taskID, err := parseOptionalID(req.Msg.TaskId, "task")
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	agentID, err := parseOptionalID(req.Msg.AgentId, "agent")
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	signingSecret := req.Msg.GetSecret()
+	if signingSecret == "" {
+		signingSecret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, apiError(fmt.Errorf("failed to generate webhook secret: %w", err))
+		}
+	}
+
+	webhookID := uuid.New()
+	encryptedSecret, err := h.encryption.Encrypt([]byte(signingSecret), secret.WebhookAssociated(webhookID))
+	if err != nil {
+		return nil, apiError(fmt.Errorf("failed to encrypt webhook secret: %w", err))
+	}
+
+	webhook, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Webhook, error) {
+		if err := checkWebhookFilters(ctx, tx, taskID, agentID); err != nil {
+			return nil, err
+		}
+
+		create := tx.Webhook.Create().
+			SetID(webhookID).
+			SetURL(req.Msg.Url).
+			SetNillableTaskID(taskID).
+			SetNillableAgentID(agentID).
+			SetSecret(encryptedSecret).
+			SetEnabled(true)
+
+		if subject := owner(ctx); subject != "" {
+			create = create.SetOwner(subject)
+		}
+		if identity := auth.FromContext(ctx); identity != nil && identity.IsAdmin {
+			create = create.SetAdmin(true)
+		}
+
+		if len(req.Msg.EventTypes) > 0 {
+			create = create.SetEventTypes(req.Msg.EventTypes)
+		}
+
+		if req.Msg.Description != "" {
+			create = create.SetDescription(req.Msg.Description)
+		}
+
+		return create.Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.CreateWebhookResponse{
+		Webhook: conv.ConvertWebhookToProto(webhook),
+		Secret:  signingSecret,
+	}), nil
+}
+
+func (h *WebhookHandler) GetWebhook(ctx context.Context, req *connect.Request[v1.GetWebhookRequest]) (*connect.Response[v1.GetWebhookResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+	}
+
+	webhook, err := h.db.Webhook.Get(ctx, id)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := checkWebhookAccess(ctx, webhook); err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.GetWebhookResponse{
+		Webhook: conv.ConvertWebhookToProto(webhook),
+	}), nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, req *connect.Request[v1.ListWebhooksRequest]) (*connect.Response[v1.ListWebhooksResponse], error) {
+	webhooks, err := h.db.Webhook.Query().
+		Where(webhookAccessPredicates(ctx)...).
+		Order(memory_webhook.ByCreateTime()).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoWebhooks := make([]*v1.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		protoWebhooks = append(protoWebhooks, conv.ConvertWebhookToProto(w))
+	}
+
+	return connect.NewResponse(&v1.ListWebhooksResponse{
+		Webhooks: protoWebhooks,
+	}), nil
+}
+
+func (h *WebhookHandler) UpdateWebhook(ctx context.Context, req *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+	}
+
+	if req.Msg.Url != nil {
+		if err := validateWebhookURL(*req.Msg.Url); err != nil {
+			return nil, apiError(err)
+		}
+	}
+
+	webhook, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Webhook, error) {
+		webhook, err := tx.Webhook.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkWebhookAccess(ctx, webhook); err != nil {
+			return nil, err
+		}
+
+		update := tx.Webhook.UpdateOne(webhook).
+			SetNillableURL(req.Msg.Url).
+			SetNillableEnabled(req.Msg.Enabled).
+			SetNillableDescription(req.Msg.Description)
+
+		if len(req.Msg.EventTypes) > 0 {
+			update = update.SetEventTypes(req.Msg.EventTypes)
+		}
+
+		if req.Msg.TaskId != nil {
+			if *req.Msg.TaskId == "" {
+				update = update.ClearTaskID()
+			} else {
+				taskID, err := parseOptionalID(req.Msg.TaskId, "task")
+				if err != nil {
+					return nil, err
+				}
+				if err := checkWebhookFilters(ctx, tx, taskID, nil); err != nil {
+					return nil, err
+				}
+				update = update.SetTaskID(*taskID)
+			}
+		}
+
+		if req.Msg.AgentId != nil {
+			if *req.Msg.AgentId == "" {
+				update = update.ClearAgentID()
+			} else {
+				agentID, err := parseOptionalID(req.Msg.AgentId, "agent")
+				if err != nil {
+					return nil, err
+				}
+				if err := checkWebhookFilters(ctx, tx, nil, agentID); err != nil {
+					return nil, err
+				}
+				update = update.SetAgentID(*agentID)
+			}
+		}
+
+		if req.Msg.Secret != nil {
+			encryptedSecret, err := h.encryption.Encrypt([]byte(*req.Msg.Secret), secret.WebhookAssociated(id))
+			if err != nil {
+				return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+			}
+			update = update.SetSecret(encryptedSecret)
+		}
+
+		return update.Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.UpdateWebhookResponse{
+		Webhook: conv.ConvertWebhookToProto(webhook),
+	}), nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, req *connect.Request[v1.DeleteWebhookRequest]) (*connect.Response[v1.DeleteWebhookResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+	}
+
+	_, err = memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.Webhook, error) {
+		webhook, err := tx.Webhook.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkWebhookAccess(ctx, webhook); err != nil {
+			return nil, err
+		}
+
+		return nil, tx.Webhook.DeleteOne(webhook).Exec(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteWebhookResponse{}), nil
+}
+
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	webhookID, err := uuid.Parse(req.Msg.WebhookId)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook ID format: %w", err)))
+	}
+
+	webhook, err := h.db.Webhook.Get(ctx, webhookID)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	if err := checkWebhookAccess(ctx, webhook); err != nil {
+		return nil, apiError(err)
+	}
+
+	query := h.db.WebhookDelivery.Query().Where(webhookdelivery.WebhookID(webhookID))
+	if req.Msg.State != nil {
+		state, err := conv.ConvertWebhookDeliveryStateToMemory(*req.Msg.State)
+		if err != nil {
+			return nil, apiError(connect.NewError(connect.CodeInvalidArgument, err))
+		}
+		query = query.Where(webhookdelivery.StateEQ(state))
+	}
+
+	pageSize := defaultWebhookDeliveryPageSize
+	if req.Msg.PageSize != nil {
+		pageSize = int(*req.Msg.PageSize)
+	}
+	if pageSize < 1 || pageSize > maxWebhookDeliveryPageSize {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("page size must be between 1 and %d", maxWebhookDeliveryPageSize)))
+	}
+
+	deliveries, err := query.
+		Order(memory.Desc(webhookdelivery.FieldCreateTime), memory.Desc(webhookdelivery.FieldEventSequence)).
+		Limit(pageSize).
+		All(ctx)
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	protoDeliveries := make([]*v1.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		protoDeliveries = append(protoDeliveries, conv.ConvertWebhookDeliveryToProto(d))
+	}
+
+	return connect.NewResponse(&v1.ListWebhookDeliveriesResponse{
+		Deliveries: protoDeliveries,
+	}), nil
+}
+
+func (h *WebhookHandler) RedeliverWebhookDelivery(ctx context.Context, req *connect.Request[v1.RedeliverWebhookDeliveryRequest]) (*connect.Response[v1.RedeliverWebhookDeliveryResponse], error) {
+	id, err := uuid.Parse(req.Msg.Id)
+	if err != nil {
+		return nil, apiError(connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid ID format: %w", err)))
+	}
+
+	delivery, err := memory.Transaction(ctx, h.db, func(tx *memory.Client) (*memory.WebhookDelivery, error) {
+		delivery, err := tx.WebhookDelivery.Query().
+			Where(webhookdelivery.ID(id)).
+			WithWebhook().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := checkWebhookAccess(ctx, delivery.Edges.Webhook); err != nil {
+			return nil, err
+		}
+
+		return tx.WebhookDelivery.UpdateOne(delivery).
+			SetState(types.WebhookDeliveryStatePending).
+			SetAttempts(0).
+			SetNextAttemptTime(time.Now()).
+			Save(ctx)
+	})
+	if err != nil {
+		return nil, apiError(err)
+	}
+
+	return connect.NewResponse(&v1.RedeliverWebhookDeliveryResponse{
+		Delivery: conv.ConvertWebhookDeliveryToProto(delivery),
+	}), nil
+}
+
+// validateWebhookURL ensures that events are only posted to absolute HTTP(S) URLs.
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook URL: %w", err))
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("webhook URL must be an absolute http or https URL: %s", rawURL))
+	}
+
+	return nil
+}
+
+func parseOptionalID(id *string, kind string) (*uuid.UUID, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s ID format: %w", kind, err))
+	}
+	return &parsed, nil
+}
+
+// checkWebhookFilters verifies that the task and agent a webhook is restricted to exist and that
+// the identity may access them.
+func checkWebhookFilters(ctx context.Context, tx *memory.Client, taskID, agentID *uuid.UUID) error {
+	if taskID != nil {
+		t, err := tx.Task.Get(ctx, *taskID)
+		if err != nil {
+			if memory.IsNotFound(err) {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("task %s not found", taskID))
+			}
+			return err
+		}
+
+		if err := checkTaskAccess(ctx, t); err != nil {
+			if connect.CodeOf(err) == connect.CodeNotFound {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("task %s not found", taskID))
+			}
+			return err
+		}
+	}
+
+	if agentID != nil {
+		if _, err := tx.Agent.Get(ctx, *agentID); err != nil {
+			if memory.IsNotFound(err) {
+				return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("agent %s not found", agentID))
+			}
+			return err
+		}
+
+		if err := checkAgentAccess(ctx, *agentID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkWebhookAccess hides the webhooks of other subjects from identities that are not admins.
+func checkWebhookAccess(ctx context.Context, w *memory.Webhook) error {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin || w.Owner == identity.Subject {
+		return nil
+	}
+	return connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook not found"))
+}
+
+// webhookAccessPredicates restricts a webhook query to the webhooks the identity may access.
+func webhookAccessPredicates(ctx context.Context) []predicate.Webhook {
+	identity := auth.FromContext(ctx)
+	if identity == nil || identity.IsAdmin {
+		return nil
+	}
+	return []predicate.Webhook{memory_webhook.Owner(identity.Subject)}
+}
+
+func generateWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return webhookSecretPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,420 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	setup := ServiceTestSetup[v1.CreateWebhookRequest, v1.CreateWebhookResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.CreateWebhookRequest]) (*connect.Response[v1.CreateWebhookResponse], error) {
+			return client.Webhook().CreateWebhook(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.CreateWebhookResponse{}, v1.Webhook{}, v1.WebhookMetadata{}, v1.WebhookSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookMetadata{}, "id", "created_at", "updated_at"),
+			cmpopts.IgnoreUnexported(memory.Webhook{}, memory.WebhookEdges{}),
+			cmpopts.IgnoreFields(memory.Webhook{}, "ID", "CreateTime", "UpdateTime", "Secret"),
+		},
+		QueryDatabase: func(ctx context.Context, db *memory.Client) (any, error) {
+			return db.Webhook.Query().All(ctx)
+		},
+	}
+
+	agentID := uuid.New()
+	taskID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.CreateWebhookRequest, v1.CreateWebhookResponse]{
+		{
+			Name: "invalid URL scheme",
+			Request: &v1.CreateWebhookRequest{
+				Url: "ftp://example.com/hooks",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Error: "invalid_argument: webhook URL must be an absolute http or https URL: ftp://example.com/hooks",
+			},
+		},
+		{
+			Name: "unknown task",
+			Request: &v1.CreateWebhookRequest{
+				Url:    "https://example.com/hooks",
+				TaskId: strPtr(taskID.String()),
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Error: "invalid_argument: task " + taskID.String() + " not found",
+			},
+		},
+		{
+			Name: "success",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				modelProvider := test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)
+				model := test.NewModelBuilder(t, uuid.New(), db, modelProvider).Build(ctx)
+				agent := test.NewAgentBuilder(t, agentID, db, model).Build(ctx)
+				test.NewTaskBuilder(t, taskID, db, agent).Build(ctx)
+			},
+			Request: &v1.CreateWebhookRequest{
+				Url:         "https://example.com/hooks",
+				EventTypes:  []string{"task.*", "message.created"},
+				TaskId:      strPtr(taskID.String()),
+				AgentId:     strPtr(agentID.String()),
+				Secret:      strPtr("0123456789abcdef"),
+				Description: "CI gate",
+			},
+			Expected: ServiceTestExpectation[v1.CreateWebhookResponse]{
+				Response: v1.CreateWebhookResponse{
+					Webhook: &v1.Webhook{
+						Metadata: &v1.WebhookMetadata{},
+						Spec: &v1.WebhookSpec{
+							Url:         "https://example.com/hooks",
+							EventTypes:  []string{"task.*", "message.created"},
+							TaskId:      strPtr(taskID.String()),
+							AgentId:     strPtr(agentID.String()),
+							Enabled:     true,
+							Description: "CI gate",
+						},
+					},
+					Secret: "0123456789abcdef",
+				},
+				Database: []*memory.Webhook{
+					{
+						URL:         "https://example.com/hooks",
+						EventTypes:  []string{"task.*", "message.created"},
+						TaskID:      &taskID,
+						AgentID:     &agentID,
+						Enabled:     true,
+						Description: "CI gate",
+						Owner:       auth.LocalAdminSubject,
+						Admin:       true,
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestCreateWebhook_GeneratesSecret(t *testing.T) {
+	ctx := context.Background()
+	server := NewTestServer(t, DefaultTestHandlerOptions(t))
+	server.Start(ctx)
+	defer server.Close()
+
+	apiClient, err := client.NewClient(client.EndpointContext{Address: server.API.URL, Kind: "http"})
+	if err != nil {
+		t.Fatalf("failed to create api client: %v", err)
+	}
+
+	resp, err := apiClient.Webhook().CreateWebhook(ctx, connect.NewRequest(&v1.CreateWebhookRequest{
+		Url: "http://localhost:8080/hooks",
+	}))
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+
+	if len(resp.Msg.Secret) <= len(webhookSecretPrefix) || resp.Msg.Secret[:len(webhookSecretPrefix)] != webhookSecretPrefix {
+		t.Errorf("generated secret %q does not have the %s prefix", resp.Msg.Secret, webhookSecretPrefix)
+	}
+
+	webhook, err := server.Options.DB.Webhook.Get(ctx, uuid.MustParse(resp.Msg.Webhook.Metadata.Id))
+	if err != nil {
+		t.Fatalf("failed to get webhook: %v", err)
+	}
+	if string(webhook.Secret) == resp.Msg.Secret {
+		t.Errorf("webhook secret is stored in plain text")
+	}
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	setup := ServiceTestSetup[v1.UpdateWebhookRequest, v1.UpdateWebhookResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.UpdateWebhookRequest]) (*connect.Response[v1.UpdateWebhookResponse], error) {
+			return client.Webhook().UpdateWebhook(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.UpdateWebhookResponse{}, v1.Webhook{}, v1.WebhookMetadata{}, v1.WebhookSpec{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookMetadata{}, "created_at", "updated_at"),
+		},
+	}
+
+	webhookID := uuid.New()
+	taskID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.UpdateWebhookRequest, v1.UpdateWebhookResponse]{
+		{
+			Name: "webhook not found",
+			Request: &v1.UpdateWebhookRequest{
+				Id:      webhookID.String(),
+				Enabled: boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateWebhookResponse]{
+				Error: "not_found: webhook not found",
+			},
+		},
+		{
+			Name: "disable and clear task filter",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				test.NewWebhookBuilder(t, webhookID, db).
+					WithEventTypes("task.*").
+					WithTaskID(taskID).
+					Build(ctx)
+			},
+			Request: &v1.UpdateWebhookRequest{
+				Id:         webhookID.String(),
+				Url:        strPtr("https://example.com/v2/hooks"),
+				EventTypes: []string{"message.*"},
+				TaskId:     strPtr(""),
+				Enabled:    boolPtr(false),
+			},
+			Expected: ServiceTestExpectation[v1.UpdateWebhookResponse]{
+				Response: v1.UpdateWebhookResponse{
+					Webhook: &v1.Webhook{
+						Metadata: &v1.WebhookMetadata{Id: webhookID.String()},
+						Spec: &v1.WebhookSpec{
+							Url:        "https://example.com/v2/hooks",
+							EventTypes: []string{"message.*"},
+							Enabled:    false,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	setup := ServiceTestSetup[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+			return client.Webhook().ListWebhookDeliveries(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.ListWebhookDeliveriesResponse{}, v1.WebhookDelivery{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookDelivery{}, "created_at", "next_attempt_at"),
+		},
+	}
+
+	webhookID := uuid.New()
+	succeededID := uuid.New()
+	deadLetterID := uuid.New()
+	pendingID := uuid.New()
+	now := time.Now()
+
+	seed := func(ctx context.Context, db *memory.Client) {
+		webhook := test.NewWebhookBuilder(t, webhookID, db).Build(ctx)
+		test.NewWebhookDeliveryBuilder(t, succeededID, db, webhook).
+			WithEventSequence(1).
+			WithState(types.WebhookDeliveryStateSucceeded).
+			WithAttempts(1, "").
+			WithCreateTime(now.Add(-2 * time.Minute)).
+			Build(ctx)
+		test.NewWebhookDeliveryBuilder(t, deadLetterID, db, webhook).
+			WithEventSequence(2).
+			WithState(types.WebhookDeliveryStateDeadLetter).
+			WithAttempts(8, "receiver responded with 500 Internal Server Error").
+			WithCreateTime(now.Add(-time.Minute)).
+			Build(ctx)
+		test.NewWebhookDeliveryBuilder(t, pendingID, db, webhook).
+			WithEventSequence(3).
+			WithCreateTime(now).
+			Build(ctx)
+	}
+
+	delivery := func(id uuid.UUID, sequence int64, state v1.WebhookDeliveryState, attempts int32, lastError string) *v1.WebhookDelivery {
+		return &v1.WebhookDelivery{
+			Id:            id.String(),
+			WebhookId:     webhookID.String(),
+			EventType:     "task.created",
+			EventSequence: sequence,
+			State:         state,
+			Attempts:      attempts,
+			LastError:     lastError,
+		}
+	}
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]{
+		{
+			Name: "webhook not found",
+			Request: &v1.ListWebhookDeliveriesRequest{
+				WebhookId: webhookID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Error: "not_found: webhook not found",
+			},
+		},
+		{
+			Name:         "most recent first",
+			SeedDatabase: seed,
+			Request: &v1.ListWebhookDeliveriesRequest{
+				WebhookId: webhookID.String(),
+				PageSize:  ptr[int32](2),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Response: v1.ListWebhookDeliveriesResponse{
+					Deliveries: []*v1.WebhookDelivery{
+						delivery(pendingID, 3, v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_PENDING, 0, ""),
+						delivery(deadLetterID, 2, v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER, 8, "receiver responded with 500 Internal Server Error"),
+					},
+				},
+			},
+		},
+		{
+			Name:         "invalid page size",
+			SeedDatabase: seed,
+			Request: &v1.ListWebhookDeliveriesRequest{
+				WebhookId: webhookID.String(),
+				PageSize:  ptr[int32](101),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Error: "invalid_argument: page size must be between 1 and 100",
+			},
+		},
+		{
+			Name:         "filter by state",
+			SeedDatabase: seed,
+			Request: &v1.ListWebhookDeliveriesRequest{
+				WebhookId: webhookID.String(),
+				State:     v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER.Enum(),
+			},
+			Expected: ServiceTestExpectation[v1.ListWebhookDeliveriesResponse]{
+				Response: v1.ListWebhookDeliveriesResponse{
+					Deliveries: []*v1.WebhookDelivery{
+						delivery(deadLetterID, 2, v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER, 8, "receiver responded with 500 Internal Server Error"),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestRedeliverWebhookDelivery(t *testing.T) {
+	setup := ServiceTestSetup[v1.RedeliverWebhookDeliveryRequest, v1.RedeliverWebhookDeliveryResponse]{
+		Call: func(ctx context.Context, client *client.Client, req *connect.Request[v1.RedeliverWebhookDeliveryRequest]) (*connect.Response[v1.RedeliverWebhookDeliveryResponse], error) {
+			return client.Webhook().RedeliverWebhookDelivery(ctx, req)
+		},
+		CmpOptions: []cmp.Option{
+			cmpopts.IgnoreUnexported(v1.RedeliverWebhookDeliveryResponse{}, v1.WebhookDelivery{}),
+			protocmp.Transform(),
+			protocmp.IgnoreFields(&v1.WebhookDelivery{}, "created_at", "next_attempt_at"),
+		},
+	}
+
+	webhookID := uuid.New()
+	deliveryID := uuid.New()
+
+	setup.RunServiceTests(t, []ServiceTestScenario[v1.RedeliverWebhookDeliveryRequest, v1.RedeliverWebhookDeliveryResponse]{
+		{
+			Name: "dead letter is rescheduled",
+			SeedDatabase: func(ctx context.Context, db *memory.Client) {
+				webhook := test.NewWebhookBuilder(t, webhookID, db).Build(ctx)
+				test.NewWebhookDeliveryBuilder(t, deliveryID, db, webhook).
+					WithState(types.WebhookDeliveryStateDeadLetter).
+					WithAttempts(8, "connection refused").
+					Build(ctx)
+			},
+			Request: &v1.RedeliverWebhookDeliveryRequest{
+				Id: deliveryID.String(),
+			},
+			Expected: ServiceTestExpectation[v1.RedeliverWebhookDeliveryResponse]{
+				Response: v1.RedeliverWebhookDeliveryResponse{
+					Delivery: &v1.WebhookDelivery{
+						Id:        deliveryID.String(),
+						WebhookId: webhookID.String(),
+						EventType: "task.created",
+						State:     v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_PENDING,
+						LastError: "connection refused",
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestWebhookOwnership(t *testing.T) {
+	ctx := context.Background()
+	options := DefaultTestHandlerOptions(t)
+	db := options.DB
+	defer db.Close()
+
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed creating schema: %v", err)
+	}
+
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	foreignTask := test.NewTaskBuilder(t, uuid.New(), db, agent).WithOwner("bob").Build(ctx)
+
+	handler := NewWebhookHandler(db, options.Encryption)
+	aliceCtx := auth.WithIdentity(ctx, &auth.Identity{Subject: "alice", AuthMethod: auth.AuthMethodToken})
+	bobCtx := auth.WithIdentity(ctx, &auth.Identity{Subject: "bob", AuthMethod: auth.AuthMethodToken})
+	adminCtx := auth.WithIdentity(ctx, &auth.Identity{Subject: auth.LocalAdminSubject, AuthMethod: auth.AuthMethodUnixSocket, IsAdmin: true})
+
+	_, err := handler.CreateWebhook(aliceCtx, connect.NewRequest(&v1.CreateWebhookRequest{
+		Url:    "https://example.com/hooks",
+		TaskId: strPtr(foreignTask.ID.String()),
+	}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Errorf("CreateWebhook() for a task of another subject error = %v, want invalid argument", err)
+	}
+
+	created, err := handler.CreateWebhook(bobCtx, connect.NewRequest(&v1.CreateWebhookRequest{Url: "https://example.com/bob"}))
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+	id := created.Msg.Webhook.Metadata.Id
+
+	stored := db.Webhook.GetX(ctx, uuid.MustParse(id))
+	if stored.Owner != "bob" || stored.Admin {
+		t.Errorf("webhook has owner %q and admin %v, want bob and false", stored.Owner, stored.Admin)
+	}
+
+	list, err := handler.ListWebhooks(aliceCtx, connect.NewRequest(&v1.ListWebhooksRequest{}))
+	if err != nil {
+		t.Fatalf("ListWebhooks() error = %v", err)
+	}
+	if len(list.Msg.Webhooks) != 0 {
+		t.Errorf("ListWebhooks() = %v, want no webhooks of other subjects", list.Msg.Webhooks)
+	}
+
+	_, err = handler.GetWebhook(aliceCtx, connect.NewRequest(&v1.GetWebhookRequest{Id: id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("GetWebhook() error = %v, want not found", err)
+	}
+	_, err = handler.UpdateWebhook(aliceCtx, connect.NewRequest(&v1.UpdateWebhookRequest{Id: id, Enabled: boolPtr(false)}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("UpdateWebhook() error = %v, want not found", err)
+	}
+	_, err = handler.ListWebhookDeliveries(aliceCtx, connect.NewRequest(&v1.ListWebhookDeliveriesRequest{WebhookId: id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("ListWebhookDeliveries() error = %v, want not found", err)
+	}
+	_, err = handler.DeleteWebhook(aliceCtx, connect.NewRequest(&v1.DeleteWebhookRequest{Id: id}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("DeleteWebhook() error = %v, want not found", err)
+	}
+
+	list, err = handler.ListWebhooks(adminCtx, connect.NewRequest(&v1.ListWebhooksRequest{}))
+	if err != nil {
+		t.Fatalf("ListWebhooks() error = %v", err)
+	}
+	if len(list.Msg.Webhooks) != 1 {
+		t.Errorf("ListWebhooks() as admin = %v, want the webhook of bob", list.Msg.Webhooks)
+	}
+
+	if _, err := handler.DeleteWebhook(bobCtx, connect.NewRequest(&v1.DeleteWebhookRequest{Id: id})); err != nil {
+		t.Errorf("DeleteWebhook() by the owner error = %v", err)
+	}
+}
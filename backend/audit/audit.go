@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultRetention is how long audit events are kept by default.
+	DefaultRetention = 90 * 24 * time.Hour
+
+	pruneInterval = time.Hour
+	// writeTimeout bounds writing an audit event once the request it belongs to is done.
+	writeTimeout = 5 * time.Second
+)
+
+type Options struct {
+	// Retention is how long audit events are kept.
+	Retention time.Duration
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Retention: DefaultRetention,
+	}
+}
+
+// Entry is an action that is recorded in the audit log.
+type Entry struct {
+	Kind        types.AuditEventKind
+	Action      string
+	Subject     string
+	AuthMethod  string
+	ResourceIDs []string
+	Outcome     types.AuditOutcome
+	Error       string
+	TaskID      *uuid.UUID
+	AgentID     *uuid.UUID
+	Details     map[string]string
+}
+
+// Log is the append-only audit log. Entries are written synchronously, so that an action that
+// completed is recorded even if the daemon stops right after it.
+type Log struct {
+	db      *memory.Client
+	options Options
+}
+
+func NewLog(db *memory.Client, options Options) *Log {
+	if options.Retention <= 0 {
+		options.Retention = DefaultRetention
+	}
+
+	return &Log{
+		db:      db,
+		options: options,
+	}
+}
+
+// Record appends an entry to the audit log. Failures are logged, they never fail the action
+// that is audited.
+func (l *Log) Record(ctx context.Context, entry Entry) {
+	// The action already happened, so it is recorded even if the caller went away.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), writeTimeout)
+	defer cancel()
+
+	create := l.db.AuditEvent.Create().
+		SetKind(entry.Kind).
+		SetAction(entry.Action).
+		SetSubject(entry.Subject).
+		SetAuthMethod(entry.AuthMethod).
+		SetOutcome(entry.Outcome).
+		SetError(entry.Error).
+		SetNillableTaskID(entry.TaskID).
+		SetNillableAgentID(entry.AgentID)
+
+	if len(entry.ResourceIDs) > 0 {
+		create = create.SetResourceIds(entry.ResourceIDs)
+	}
+	if len(entry.Details) > 0 {
+		create = create.SetDetails(entry.Details)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		slog.ErrorContext(ctx, "failed to write audit event",
+			"action", entry.Action,
+			"subject", entry.Subject,
+			"outcome", entry.Outcome,
+			"error", err,
+		)
+	}
+}
+
+// Run deletes audit events that are past their retention until ctx is cancelled.
+func (l *Log) Run(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := l.prune(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to prune audit log", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (l *Log) prune(ctx context.Context) error {
+	deleted, err := l.db.AuditEvent.Delete().
+		Where(auditevent.CreateTimeLT(time.Now().Add(-l.options.Retention))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		slog.Debug("pruned audit log", "deleted", deleted)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/memory"
+	_ "github.com/furisto/construct/backend/memory/runtime"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+func TestIsMutating(t *testing.T) {
+	tests := []struct {
+		procedure string
+		want      bool
+	}{
+		{"/construct.v1.AgentService/CreateAgent", true},
+		{"/construct.v1.AgentService/UpdateAgent", true},
+		{"/construct.v1.AuthService/CreateToken", true},
+		{"/construct.v1.TaskService/ShareTask", true},
+		{"/construct.v1.AgentService/GetAgent", false},
+		{"/construct.v1.AuditService/ListAuditEvents", false},
+		{"/construct.v1.EventService/Subscribe", false},
+		{"/construct.v1.ModelProviderService/TestModelProvider", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsMutating(tt.procedure); got != tt.want {
+			t.Errorf("IsMutating(%q) = %v, want %v", tt.procedure, got, tt.want)
+		}
+	}
+}
+
+func TestAPIEntry(t *testing.T) {
+	agentID := uuid.New().String()
+	taskID := uuid.New().String()
+
+	identity := &auth.Identity{Subject: "ci", AuthMethod: auth.AuthMethodToken}
+
+	req := &v1.CreateTaskRequest{AgentId: agentID, ProjectDirectory: "/src/project"}
+	resp := &v1.CreateTaskResponse{Task: &v1.Task{Metadata: &v1.TaskMetadata{Id: taskID}}}
+
+	got := apiEntry(identity, "/construct.v1.TaskService/CreateTask", req, resp, nil)
+	want := Entry{
+		Kind:        types.AuditEventKindAPI,
+		Action:      "/construct.v1.TaskService/CreateTask",
+		Subject:     "ci",
+		AuthMethod:  "token",
+		ResourceIDs: []string{agentID, taskID},
+		Outcome:     types.AuditOutcomeSuccess,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("apiEntry() mismatch (-want +got):\n%s", diff)
+	}
+
+	err := connect.NewError(connect.CodePermissionDenied, nil)
+	got = apiEntry(identity, "/construct.v1.TaskService/DeleteTask", &v1.DeleteTaskRequest{Id: taskID}, nil, err)
+	if got.Outcome != types.AuditOutcomeFailure || got.Error != "permission_denied: " {
+		t.Errorf("apiEntry() outcome = %s, error = %q, want failure with permission_denied", got.Outcome, got.Error)
+	}
+	if diff := cmp.Diff([]string{taskID}, got.ResourceIDs); diff != "" {
+		t.Errorf("apiEntry() resource IDs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLog_Prune(t *testing.T) {
+	ctx := context.Background()
+	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	log := NewLog(db, Options{Retention: time.Hour})
+
+	test.NewAuditEventBuilder(t, uuid.New(), db).WithCreateTime(time.Now().Add(-2 * time.Hour)).Build(ctx)
+	log.Record(ctx, Entry{
+		Kind:    types.AuditEventKindAPI,
+		Action:  "/construct.v1.AgentService/DeleteAgent",
+		Subject: "local-admin",
+		Outcome: types.AuditOutcomeSuccess,
+	})
+
+	if err := log.prune(ctx); err != nil {
+		t.Fatalf("prune() error = %v", err)
+	}
+
+	events, err := db.AuditEvent.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query audit events: %v", err)
+	}
+	if len(events) != 1 || events[0].Action != "/construct.v1.AgentService/DeleteAgent" {
+		t.Errorf("expected only the recent event to be kept, got %d events", len(events))
+	}
+}
@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// readOnlyPrefixes are the prefixes of procedures that do not change anything. Every other
+// procedure is audited.
+var readOnlyPrefixes = []string{"Get", "List", "Subscribe", "Test"}
+
+// Interceptor records every call of a mutating procedure in the audit log. It has to run before
+// the auth interceptor, so that calls the auth interceptor rejects are recorded as well. The auth
+// interceptor tells it who made the call.
+type Interceptor struct {
+	log *Log
+}
+
+var _ connect.Interceptor = (*Interceptor)(nil)
+
+func NewInterceptor(log *Log) *Interceptor {
+	return &Interceptor{log: log}
+}
+
+func (i *Interceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if !IsMutating(procedure) {
+			return next(ctx, req)
+		}
+
+		ctx, authenticated := auth.WithAuthenticated(ctx)
+		resp, err := next(ctx, req)
+
+		// A failed call has no response. It may still be a typed nil, which must not be touched.
+		var respMsg any
+		if err == nil && resp != nil {
+			respMsg = resp.Any()
+		}
+		i.log.Record(ctx, apiEntry(authenticated(), procedure, req.Any(), respMsg, err))
+
+		return resp, err
+	}
+}
+
+func (i *Interceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler records the calls of mutating streaming procedures. The streamed messages
+// are not inspected, only the outcome of the call is recorded.
+func (i *Interceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		procedure := conn.Spec().Procedure
+		if !IsMutating(procedure) {
+			return next(ctx, conn)
+		}
+
+		ctx, authenticated := auth.WithAuthenticated(ctx)
+		err := next(ctx, conn)
+		i.log.Record(ctx, apiEntry(authenticated(), procedure, nil, nil, err))
+
+		return err
+	}
+}
+
+// IsMutating reports whether a procedure may change state and is therefore audited.
+func IsMutating(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func apiEntry(identity *auth.Identity, procedure string, req, resp any, err error) Entry {
+	entry := Entry{
+		Kind:    types.AuditEventKindAPI,
+		Action:  procedure,
+		Outcome: types.AuditOutcomeSuccess,
+	}
+
+	if identity != nil {
+		entry.Subject = identity.Subject
+		entry.AuthMethod = identity.AuthMethod.String()
+	}
+
+	if err != nil {
+		entry.Outcome = types.AuditOutcomeFailure
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			entry.Error = connectErr.Code().String() + ": " + connectErr.Message()
+		} else {
+			entry.Error = err.Error()
+		}
+	}
+
+	for _, msg := range []any{req, resp} {
+		if msg, ok := msg.(proto.Message); ok && msg != nil {
+			entry.ResourceIDs = appendResourceIDs(entry.ResourceIDs, msg.ProtoReflect())
+		}
+	}
+
+	return entry
+}
+
+// appendResourceIDs collects the identifiers of the resources a message refers to: the id and
+// *_id fields of the message, and the ids in the metadata of the resources it contains, e.g.
+// the agent in a CreateAgentResponse.
+func appendResourceIDs(ids []string, msg protoreflect.Message) []string {
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := string(fd.Name())
+		switch {
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && !fd.IsMap() && isIDField(name):
+			ids = appendID(ids, value.String())
+		case fd.Kind() == protoreflect.StringKind && fd.IsList() && strings.HasSuffix(name, "_ids"):
+			list := value.List()
+			for j := 0; j < list.Len(); j++ {
+				ids = appendID(ids, list.Get(j).String())
+			}
+		case fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap():
+			ids = appendMetadataID(ids, value.Message())
+		}
+		return true
+	})
+	return ids
+}
+
+func appendMetadataID(ids []string, resource protoreflect.Message) []string {
+	metadata := resource.Descriptor().Fields().ByName("metadata")
+	if metadata == nil || metadata.Kind() != protoreflect.MessageKind || !resource.Has(metadata) {
+		return ids
+	}
+
+	meta := resource.Get(metadata).Message()
+	id := meta.Descriptor().Fields().ByName("id")
+	if id == nil || id.Kind() != protoreflect.StringKind {
+		return ids
+	}
+	return appendID(ids, meta.Get(id).String())
+}
+
+func isIDField(name string) bool {
+	return name == "id" || strings.HasSuffix(name, "_id")
+}
+
+func appendID(ids []string, id string) []string {
+	if id == "" || slices.Contains(ids, id) {
+		return ids
+	}
+	return append(ids, id)
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/tool/base"
+	"github.com/furisto/construct/backend/tool/codeact"
+	"github.com/furisto/construct/backend/tool/system"
+	tooltypes "github.com/furisto/construct/backend/tool/types"
+	"github.com/grafana/sobek"
+)
+
+// sideEffectTools are the tools whose calls are audited. The other tools only read.
+var sideEffectTools = map[string]bool{
+	base.ToolNameExecuteCommand: true,
+	base.ToolNameEditFile:       true,
+	base.ToolNameCreateFile:     true,
+	base.ToolNameFetch:          true,
+}
+
+// ToolInterceptor records the calls of tools with side effects in the audit log. Tools fail by
+// throwing, so a call that panics is recorded as a failure before the panic continues. It has to
+// run before codeact.ResetTemporarySessionValuesInterceptor to see the result of a call.
+type ToolInterceptor struct {
+	log *Log
+}
+
+var _ codeact.Interceptor = (*ToolInterceptor)(nil)
+
+func NewToolInterceptor(log *Log) *ToolInterceptor {
+	return &ToolInterceptor{log: log}
+}
+
+func (i *ToolInterceptor) Intercept(session *codeact.Session, tool codeact.Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if !sideEffectTools[tool.Name()] {
+			return inner(call)
+		}
+
+		entry := Entry{
+			Kind:    types.AuditEventKindTool,
+			Action:  tool.Name(),
+			Outcome: types.AuditOutcomeFailure,
+			Details: map[string]string{},
+		}
+		if session.Task != nil {
+			taskID := session.Task.ID
+			entry.TaskID = &taskID
+			entry.ResourceIDs = []string{taskID.String()}
+			// Tools run on behalf of the owner of the task, with the agent of the task.
+			if t, err := i.log.db.Task.Get(session.Context, taskID); err == nil {
+				entry.Subject = t.Owner
+				agentID := t.AgentID
+				entry.AgentID = &agentID
+			}
+		}
+
+		input, err := tool.Input(session, call.Arguments)
+		if err != nil {
+			slog.Error("failed to get tool input for audit log", "tool", tool.Name(), "error", err)
+		} else if toolInput, err := tooltypes.ToolInputFrom(input); err == nil {
+			addInputDetails(entry.Details, toolInput)
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				entry.Error = fmt.Sprint(r)
+				i.log.Record(session.Context, entry)
+				panic(r)
+			}
+		}()
+
+		value := inner(call)
+
+		entry.Outcome = types.AuditOutcomeSuccess
+		if result, ok := codeact.GetValue[any](session, "result"); ok {
+			if commandResult, ok := result.(*system.ExecuteCommandResult); ok {
+				entry.Details["exit_code"] = strconv.Itoa(commandResult.ExitCode)
+			}
+		}
+		i.log.Record(session.Context, entry)
+
+		return value
+	}
+}
+
+// addInputDetails records what a tool call changed, but not the content it wrote.
+func addInputDetails(details map[string]string, input tooltypes.ToolInput) {
+	switch {
+	case input.ExecuteCommand != nil:
+		details["command"] = input.ExecuteCommand.Command
+		if input.ExecuteCommand.WorkingDirectory != "" {
+			details["working_directory"] = input.ExecuteCommand.WorkingDirectory
+		}
+	case input.EditFile != nil:
+		details["path"] = input.EditFile.Path
+		details["edits"] = strconv.Itoa(len(input.EditFile.Diffs))
+	case input.CreateFile != nil:
+		details["path"] = input.CreateFile.Path
+		details["size"] = strconv.Itoa(len(input.CreateFile.Content))
+	case input.Fetch != nil:
+		details["url"] = input.Fetch.URL
+	}
+}
@@ -0,0 +1,165 @@
+package catalog
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+//go:embed catalog.json
+var embeddedCatalog []byte
+
+// maxCatalogSize limits how much is read from a catalog source.
+const maxCatalogSize = 10 << 20
+
+// Catalog describes the models that model providers offer, together with their pricing and
+// context windows. Prices are in USD per million tokens.
+type Catalog struct {
+	Version string  `json:"version"`
+	Models  []Entry `json:"models"`
+}
+
+type Entry struct {
+	Provider      types.ModelProviderType `json:"provider"`
+	Name          string                  `json:"name"`
+	ContextWindow int64                   `json:"context_window"`
+	Capabilities  []types.ModelCapability `json:"capabilities,omitempty"`
+	Pricing       Pricing                 `json:"pricing"`
+}
+
+type Pricing struct {
+	Input      float64 `json:"input"`
+	Output     float64 `json:"output"`
+	CacheWrite float64 `json:"cache_write"`
+	CacheRead  float64 `json:"cache_read"`
+}
+
+// Embedded returns the catalog that is built into the binary.
+func Embedded() (*Catalog, error) {
+	return Parse(embeddedCatalog)
+}
+
+// Load reads the catalog from an http(s) URL or a file. The embedded catalog is returned if no
+// source is given.
+func Load(ctx context.Context, source string) (*Catalog, error) {
+	if source == "" {
+		return Embedded()
+	}
+
+	var (
+		data []byte
+		err  error
+	)
+	if strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://") {
+		data, err = fetch(ctx, source)
+	} else {
+		data, err = os.ReadFile(source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model catalog from %s: %w", source, err)
+	}
+
+	return Parse(data)
+}
+
+func fetch(ctx context.Context, url string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxCatalogSize))
+}
+
+// Parse decodes and validates a catalog.
+func Parse(data []byte) (*Catalog, error) {
+	var catalog Catalog
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return nil, fmt.Errorf("failed to decode model catalog: %w", err)
+	}
+
+	if err := catalog.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid model catalog: %w", err)
+	}
+
+	return &catalog, nil
+}
+
+// ProviderModels returns the entries of the models that the given provider offers.
+func (c *Catalog) ProviderModels(provider types.ModelProviderType) []Entry {
+	var entries []Entry
+	for _, entry := range c.Models {
+		if entry.Provider == provider {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func (c *Catalog) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("version is required")
+	}
+
+	type key struct {
+		provider types.ModelProviderType
+		name     string
+	}
+	seen := make(map[key]bool, len(c.Models))
+
+	for i, entry := range c.Models {
+		if !slices.Contains(types.ModelProviderType("").Values(), string(entry.Provider)) {
+			return fmt.Errorf("model %d: unknown provider %q", i, entry.Provider)
+		}
+
+		if entry.Name == "" {
+			return fmt.Errorf("model %d: name is required", i)
+		}
+
+		if entry.ContextWindow <= 0 {
+			return fmt.Errorf("model %s: context window must be positive", entry.Name)
+		}
+
+		for _, capability := range entry.Capabilities {
+			if !slices.Contains(capability.Values(), capability) {
+				return fmt.Errorf("model %s: unknown capability %q", entry.Name, capability)
+			}
+		}
+
+		p := entry.Pricing
+		if p.Input < 0 || p.Output < 0 || p.CacheWrite < 0 || p.CacheRead < 0 {
+			return fmt.Errorf("model %s: prices must not be negative", entry.Name)
+		}
+
+		k := key{entry.Provider, entry.Name}
+		if seen[k] {
+			return fmt.Errorf("model %s: listed more than once for provider %s", entry.Name, entry.Provider)
+		}
+		seen[k] = true
+	}
+
+	return nil
+}
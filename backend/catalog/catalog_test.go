@@ -0,0 +1,175 @@
+package catalog
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/model"
+	"github.com/google/go-cmp/cmp"
+)
+
+const testCatalog = `{
+	"version": "2025.12.01",
+	"models": [
+		{
+			"provider": "anthropic",
+			"name": "claude-test",
+			"context_window": 200000,
+			"capabilities": ["image", "prompt_cache"],
+			"pricing": {"input": 3, "output": 15, "cache_write": 3.75, "cache_read": 0.3}
+		}
+	]
+}`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: testCatalog,
+		},
+		{
+			name:    "malformed",
+			data:    `{"version":`,
+			wantErr: "failed to decode model catalog",
+		},
+		{
+			name:    "missing version",
+			data:    `{"models": []}`,
+			wantErr: "version is required",
+		},
+		{
+			name:    "unknown provider",
+			data:    `{"version": "1", "models": [{"provider": "acme", "name": "m", "context_window": 1}]}`,
+			wantErr: `unknown provider "acme"`,
+		},
+		{
+			name:    "missing name",
+			data:    `{"version": "1", "models": [{"provider": "openai", "context_window": 1}]}`,
+			wantErr: "name is required",
+		},
+		{
+			name:    "invalid context window",
+			data:    `{"version": "1", "models": [{"provider": "openai", "name": "m"}]}`,
+			wantErr: "context window must be positive",
+		},
+		{
+			name:    "unknown capability",
+			data:    `{"version": "1", "models": [{"provider": "openai", "name": "m", "context_window": 1, "capabilities": ["telepathy"]}]}`,
+			wantErr: `unknown capability "telepathy"`,
+		},
+		{
+			name:    "negative price",
+			data:    `{"version": "1", "models": [{"provider": "openai", "name": "m", "context_window": 1, "pricing": {"input": -1}}]}`,
+			wantErr: "prices must not be negative",
+		},
+		{
+			name: "duplicate model",
+			data: `{"version": "1", "models": [
+				{"provider": "openai", "name": "m", "context_window": 1},
+				{"provider": "openai", "name": "m", "context_window": 2}
+			]}`,
+			wantErr: "listed more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "catalog.json")
+	if err := os.WriteFile(file, []byte(testCatalog), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/catalog.json" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(testCatalog))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		source      string
+		wantVersion string
+		wantErr     bool
+	}{
+		{name: "file", source: file, wantVersion: "2025.12.01"},
+		{name: "url", source: server.URL + "/catalog.json", wantVersion: "2025.12.01"},
+		{name: "url not found", source: server.URL + "/missing.json", wantErr: true},
+		{name: "file not found", source: filepath.Join(t.TempDir(), "missing.json"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog, err := Load(context.Background(), tt.source)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if catalog.Version != tt.wantVersion {
+				t.Errorf("version = %s, want %s", catalog.Version, tt.wantVersion)
+			}
+		})
+	}
+}
+
+// TestEmbeddedCatalog makes sure that the embedded catalog offers exactly the models that the
+// model providers support.
+func TestEmbeddedCatalog(t *testing.T) {
+	catalog, err := Load(context.Background(), "")
+	if err != nil {
+		t.Fatalf("failed to load embedded catalog: %v", err)
+	}
+
+	for _, providerType := range types.ModelProviderType("").Values() {
+		var expected []string
+		for _, m := range model.SupportedModels(model.ProviderKind(providerType)) {
+			expected = append(expected, m.Name)
+		}
+
+		var actual []string
+		for _, entry := range catalog.ProviderModels(types.ModelProviderType(providerType)) {
+			actual = append(actual, entry.Name)
+		}
+
+		slices.Sort(expected)
+		slices.Sort(actual)
+		if diff := cmp.Diff(expected, actual); diff != "" {
+			t.Errorf("embedded catalog differs from supported models of %s (-want +got):\n%s", providerType, diff)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+)
+
+type ChangeKind string
+
+const (
+	// ChangeKindNew is a catalog model that the provider does not have yet.
+	ChangeKindNew ChangeKind = "new"
+	// ChangeKindRetired is a stored model that is no longer in the catalog.
+	ChangeKindRetired ChangeKind = "retired"
+	// ChangeKindRepriced is a stored model whose pricing differs from the catalog.
+	ChangeKindRepriced ChangeKind = "repriced"
+	// ChangeKindUpdated is a stored model whose context window or capabilities differ from the catalog.
+	ChangeKindUpdated ChangeKind = "updated"
+)
+
+// Change is a difference between the catalog and the models of a model provider.
+type Change struct {
+	Kind          ChangeKind
+	ModelProvider *memory.ModelProvider
+	// Model is the stored model. It is nil for new models.
+	Model *memory.Model
+	// Entry is the catalog entry. It is nil for retired models.
+	Entry *Entry
+	// Fields are the fields that differ from the catalog. Fields that the user has overridden are
+	// never reported.
+	Fields []types.ModelField
+}
+
+func (c Change) ModelName() string {
+	if c.Entry != nil {
+		return c.Entry.Name
+	}
+	return c.Model.Name
+}
+
+// Diff compares the catalog with the models of the given providers, which need to have their
+// models loaded. Providers whose type does not appear in the catalog are skipped, so that a
+// partial catalog does not retire their models.
+func Diff(catalog *Catalog, providers []*memory.ModelProvider) []Change {
+	entries := make(map[types.ModelProviderType][]*Entry)
+	for i := range catalog.Models {
+		entry := &catalog.Models[i]
+		entries[entry.Provider] = append(entries[entry.Provider], entry)
+	}
+
+	var changes []Change
+	for _, provider := range providers {
+		providerEntries, ok := entries[provider.ProviderType]
+		if !ok {
+			continue
+		}
+
+		stored := make(map[string]*memory.Model, len(provider.Edges.Models))
+		for _, m := range provider.Edges.Models {
+			stored[m.Name] = m
+		}
+
+		for _, entry := range providerEntries {
+			m, ok := stored[entry.Name]
+			if !ok {
+				changes = append(changes, Change{Kind: ChangeKindNew, ModelProvider: provider, Entry: entry})
+				continue
+			}
+
+			if m.Source != types.ModelSourceCatalog {
+				continue
+			}
+
+			fields := diffFields(m, entry)
+			if len(fields) == 0 {
+				continue
+			}
+
+			kind := ChangeKindUpdated
+			if slices.Contains(fields, types.ModelFieldPricing) {
+				kind = ChangeKindRepriced
+			}
+			changes = append(changes, Change{Kind: kind, ModelProvider: provider, Model: m, Entry: entry, Fields: fields})
+		}
+
+		models := slices.Clone(provider.Edges.Models)
+		slices.SortFunc(models, func(a, b *memory.Model) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		for _, m := range models {
+			if m.Source != types.ModelSourceCatalog || !m.Enabled || slices.Contains(m.Overrides, types.ModelFieldEnabled) {
+				continue
+			}
+
+			if !slices.ContainsFunc(providerEntries, func(e *Entry) bool { return e.Name == m.Name }) {
+				changes = append(changes, Change{Kind: ChangeKindRetired, ModelProvider: provider, Model: m, Fields: []types.ModelField{types.ModelFieldEnabled}})
+			}
+		}
+	}
+
+	return changes
+}
+
+func diffFields(m *memory.Model, entry *Entry) []types.ModelField {
+	var fields []types.ModelField
+	overridden := func(field types.ModelField) bool {
+		return slices.Contains(m.Overrides, field)
+	}
+
+	pricing := Pricing{Input: m.InputCost, Output: m.OutputCost, CacheWrite: m.CacheWriteCost, CacheRead: m.CacheReadCost}
+	if pricing != entry.Pricing && !overridden(types.ModelFieldPricing) {
+		fields = append(fields, types.ModelFieldPricing)
+	}
+
+	if m.ContextWindow != entry.ContextWindow && !overridden(types.ModelFieldContextWindow) {
+		fields = append(fields, types.ModelFieldContextWindow)
+	}
+
+	if !sameCapabilities(m.Capabilities, entry.Capabilities) && !overridden(types.ModelFieldCapabilities) {
+		fields = append(fields, types.ModelFieldCapabilities)
+	}
+
+	return fields
+}
+
+func sameCapabilities(a, b []types.ModelCapability) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// Apply writes the changes to the database. New models are added, retired models are disabled
+// and the differing fields of all other models are set to the catalog values.
+func Apply(ctx context.Context, tx *memory.Client, changes []Change) error {
+	for _, change := range changes {
+		var err error
+		switch change.Kind {
+		case ChangeKindNew:
+			entry := change.Entry
+			_, err = tx.Model.Create().
+				SetModelProvider(change.ModelProvider).
+				SetName(entry.Name).
+				SetContextWindow(entry.ContextWindow).
+				SetCapabilities(entry.Capabilities).
+				SetInputCost(entry.Pricing.Input).
+				SetOutputCost(entry.Pricing.Output).
+				SetCacheWriteCost(entry.Pricing.CacheWrite).
+				SetCacheReadCost(entry.Pricing.CacheRead).
+				SetSource(types.ModelSourceCatalog).
+				SetEnabled(true).
+				Save(ctx)
+
+		case ChangeKindRetired:
+			_, err = tx.Model.UpdateOne(change.Model).SetEnabled(false).Save(ctx)
+
+		case ChangeKindRepriced, ChangeKindUpdated:
+			entry := change.Entry
+			update := tx.Model.UpdateOne(change.Model)
+			for _, field := range change.Fields {
+				switch field {
+				case types.ModelFieldPricing:
+					update = update.
+						SetInputCost(entry.Pricing.Input).
+						SetOutputCost(entry.Pricing.Output).
+						SetCacheWriteCost(entry.Pricing.CacheWrite).
+						SetCacheReadCost(entry.Pricing.CacheRead)
+				case types.ModelFieldContextWindow:
+					update = update.SetContextWindow(entry.ContextWindow)
+				case types.ModelFieldCapabilities:
+					update = update.SetCapabilities(entry.Capabilities)
+				}
+			}
+			_, err = update.Save(ctx)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to apply %s model %s of provider %s: %w", change.Kind, change.ModelName(), change.ModelProvider.Name, err)
+		}
+	}
+
+	return nil
+}
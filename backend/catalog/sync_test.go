@@ -0,0 +1,165 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestDiff(t *testing.T) {
+	catalog := &Catalog{
+		Version: "1",
+		Models: []Entry{
+			{
+				Provider:      types.ModelProviderTypeAnthropic,
+				Name:          "claude-current",
+				ContextWindow: 200000,
+				Capabilities:  []types.ModelCapability{types.ModelCapabilityImage},
+				Pricing:       Pricing{Input: 3, Output: 15, CacheWrite: 3.75, CacheRead: 0.3},
+			},
+			{
+				Provider:      types.ModelProviderTypeAnthropic,
+				Name:          "claude-next",
+				ContextWindow: 200000,
+				Pricing:       Pricing{Input: 5, Output: 25},
+			},
+		},
+	}
+
+	current := func(opts ...func(*memory.Model)) *memory.Model {
+		m := &memory.Model{
+			ID:             uuid.New(),
+			Name:           "claude-current",
+			ContextWindow:  200000,
+			Capabilities:   []types.ModelCapability{types.ModelCapabilityImage},
+			InputCost:      3,
+			OutputCost:     15,
+			CacheWriteCost: 3.75,
+			CacheReadCost:  0.3,
+			Source:         types.ModelSourceCatalog,
+			Enabled:        true,
+		}
+		for _, opt := range opts {
+			opt(m)
+		}
+		return m
+	}
+	next := current(func(m *memory.Model) {
+		m.Name = "claude-next"
+		m.Capabilities = nil
+		m.InputCost, m.OutputCost, m.CacheWriteCost, m.CacheReadCost = 5, 25, 0, 0
+	})
+
+	type change struct {
+		Kind   ChangeKind
+		Model  string
+		Fields []types.ModelField
+	}
+
+	tests := []struct {
+		name         string
+		providerType types.ModelProviderType
+		models       []*memory.Model
+		expected     []change
+	}{
+		{
+			name:         "in sync",
+			providerType: types.ModelProviderTypeAnthropic,
+			models:       []*memory.Model{current(), next},
+		},
+		{
+			name:         "new model",
+			providerType: types.ModelProviderTypeAnthropic,
+			models:       []*memory.Model{current()},
+			expected:     []change{{Kind: ChangeKindNew, Model: "claude-next"}},
+		},
+		{
+			name:         "repriced model",
+			providerType: types.ModelProviderTypeAnthropic,
+			models: []*memory.Model{next, current(func(m *memory.Model) {
+				m.InputCost = 2
+				m.ContextWindow = 100000
+			})},
+			expected: []change{{Kind: ChangeKindRepriced, Model: "claude-current", Fields: []types.ModelField{types.ModelFieldPricing, types.ModelFieldContextWindow}}},
+		},
+		{
+			name:         "updated model",
+			providerType: types.ModelProviderTypeAnthropic,
+			models: []*memory.Model{next, current(func(m *memory.Model) {
+				m.Capabilities = []types.ModelCapability{types.ModelCapabilityImage, types.ModelCapabilityPromptCache}
+			})},
+			expected: []change{{Kind: ChangeKindUpdated, Model: "claude-current", Fields: []types.ModelField{types.ModelFieldCapabilities}}},
+		},
+		{
+			name:         "overridden fields are kept",
+			providerType: types.ModelProviderTypeAnthropic,
+			models: []*memory.Model{next, current(func(m *memory.Model) {
+				m.InputCost = 2
+				m.ContextWindow = 100000
+				m.Overrides = []types.ModelField{types.ModelFieldPricing}
+			})},
+			expected: []change{{Kind: ChangeKindUpdated, Model: "claude-current", Fields: []types.ModelField{types.ModelFieldContextWindow}}},
+		},
+		{
+			name:         "user models are kept",
+			providerType: types.ModelProviderTypeAnthropic,
+			models: []*memory.Model{next, current(func(m *memory.Model) {
+				m.InputCost = 2
+				m.Source = types.ModelSourceUser
+			})},
+		},
+		{
+			name:         "retired model",
+			providerType: types.ModelProviderTypeAnthropic,
+			models: []*memory.Model{current(), next, current(func(m *memory.Model) {
+				m.Name = "claude-old"
+			})},
+			expected: []change{{Kind: ChangeKindRetired, Model: "claude-old", Fields: []types.ModelField{types.ModelFieldEnabled}}},
+		},
+		{
+			name:         "disabled, user and explicitly enabled models are not retired",
+			providerType: types.ModelProviderTypeAnthropic,
+			models: []*memory.Model{
+				current(),
+				next,
+				current(func(m *memory.Model) { m.Name = "claude-disabled"; m.Enabled = false }),
+				current(func(m *memory.Model) { m.Name = "claude-custom"; m.Source = types.ModelSourceUser }),
+				current(func(m *memory.Model) {
+					m.Name = "claude-pinned"
+					m.Overrides = []types.ModelField{types.ModelFieldEnabled}
+				}),
+			},
+		},
+		{
+			name:         "provider type missing from catalog",
+			providerType: types.ModelProviderTypeOpenAI,
+			models:       []*memory.Model{current(func(m *memory.Model) { m.Name = "gpt-test" })},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &memory.ModelProvider{
+				ID:           uuid.New(),
+				Name:         "test",
+				ProviderType: tt.providerType,
+				Edges:        memory.ModelProviderEdges{Models: tt.models},
+			}
+
+			var actual []change
+			for _, c := range Diff(catalog, []*memory.ModelProvider{provider}) {
+				if c.ModelProvider != provider {
+					t.Errorf("change for %s has wrong model provider", c.ModelName())
+				}
+				actual = append(actual, change{Kind: c.Kind, Model: c.ModelName(), Fields: c.Fields})
+			}
+
+			if diff := cmp.Diff(tt.expected, actual); diff != "" {
+				t.Errorf("changes differ (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@ const (
 	EventTypeTaskUpdated = "task.updated"
 	EventTypeTaskDeleted = "task.deleted"
 
+	EventTypeTaskModelFallback = "task.model_fallback"
+
 	// Message events
 	EventTypeMessageCreated = "message.created"
 	EventTypeMessageUpdated = "message.updated"
@@ -40,6 +42,9 @@ const (
 	EventTypeToolCalled = "tool.called"
 	EventTypeToolResult = "tool.result"
 
+	// Stream events
+	EventTypeStreamGap = "stream.gap"
+
 	// Internal events (for internal coordination, not exposed to external clients)
 	EventTypeInternalTaskTrigger = "internal.task.trigger"
 	EventTypeInternalTaskSuspend = "internal.task.suspend"
@@ -58,6 +63,15 @@ type TaskEventPayload struct {
 	PreviousPhase string // Only set for task.updated events
 }
 
+// TaskModelFallbackPayload contains the payload for task.model_fallback events.
+type TaskModelFallbackPayload struct {
+	TaskID      uuid.UUID
+	FromModelID uuid.UUID
+	ToModelID   uuid.UUID
+	Reason      string
+	Error       string
+}
+
 // MessageEventPayload contains the payload for message events.
 type MessageEventPayload struct {
 	Message *memory.Message
@@ -92,6 +106,12 @@ type DeletedEntityPayload struct {
 	TaskID *uuid.UUID // Only set for message.deleted events
 }
 
+// StreamGapPayload contains the payload for stream.gap events.
+type StreamGapPayload struct {
+	FromSequence int64
+	ToSequence   int64
+}
+
 // InternalTaskTriggerPayload contains the payload for internal.task.trigger events.
 type InternalTaskTriggerPayload struct {
 	TaskID uuid.UUID
@@ -144,6 +164,28 @@ func NewTaskDeletedEvent(taskID uuid.UUID) *StreamEvent {
 	}
 }
 
+// NewTaskModelFallbackEvent creates a new task.model_fallback event.
+// This is a transient event emitted when a task switches to the next model in its agent's fallback chain.
+func NewTaskModelFallbackEvent(taskID, fromModelID, toModelID uuid.UUID, reason string, err error) *StreamEvent {
+	payload := &TaskModelFallbackPayload{
+		TaskID:      taskID,
+		FromModelID: fromModelID,
+		ToModelID:   toModelID,
+		Reason:      reason,
+	}
+	if err != nil {
+		payload.Error = err.Error()
+	}
+
+	return &StreamEvent{
+		Type:      EventTypeTaskModelFallback,
+		Action:    ActionCreated,
+		Timestamp: time.Now(),
+		TaskID:    &taskID,
+		Payload:   payload,
+	}
+}
+
 // --- Message Event Constructors ---
 
 // NewMessageCreatedEvent creates a new message.created event.
@@ -342,6 +384,21 @@ func NewToolResultEvent(taskID uuid.UUID, evt tooltypes.ToolResultEvent) *Stream
 	}
 }
 
+// --- Stream Event Constructors ---
+
+// NewStreamGapEvent creates a new stream.gap event for the events from fromSequence
+// to toSequence that are no longer in the event log.
+func NewStreamGapEvent(fromSequence, toSequence int64) *StreamEvent {
+	return &StreamEvent{
+		Type:      EventTypeStreamGap,
+		Timestamp: time.Now(),
+		Payload: &StreamGapPayload{
+			FromSequence: fromSequence,
+			ToSequence:   toSequence,
+		},
+	}
+}
+
 // --- Internal Event Constructors ---
 
 // NewInternalTaskTriggerEvent creates a new internal.task.trigger event.
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/furisto/construct/backend/memory"
@@ -88,6 +89,31 @@ func TestNewTaskDeletedEvent(t *testing.T) {
 	}
 }
 
+func TestNewTaskModelFallbackEvent(t *testing.T) {
+	taskID := uuid.New()
+	fromModelID := uuid.New()
+	toModelID := uuid.New()
+
+	got := NewTaskModelFallbackEvent(taskID, fromModelID, toModelID, "overloaded", errors.New("anthropic: API temporarily overloaded"))
+
+	want := &StreamEvent{
+		Type:   EventTypeTaskModelFallback,
+		Action: ActionCreated,
+		TaskID: &taskID,
+		Payload: &TaskModelFallbackPayload{
+			TaskID:      taskID,
+			FromModelID: fromModelID,
+			ToModelID:   toModelID,
+			Reason:      "overloaded",
+			Error:       "anthropic: API temporarily overloaded",
+		},
+	}
+
+	if diff := cmp.Diff(want, got, cmpOpts...); diff != "" {
+		t.Errorf("NewTaskModelFallbackEvent() mismatch (-want +got):\n%s", diff)
+	}
+}
+
 func TestNewMessageCreatedEvent(t *testing.T) {
 	taskID := uuid.New()
 	messageID := uuid.New()
@@ -0,0 +1,272 @@
+package event
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
+)
+
+const (
+	// DefaultEventRetention is how long logged events are kept by default.
+	DefaultEventRetention = 24 * time.Hour
+	// DefaultMaxLoggedEvents is the number of most recent events that are kept by default.
+	DefaultMaxLoggedEvents = 100_000
+
+	eventLogBatchSize     = 500
+	eventLogFlushInterval = time.Second
+	eventLogPruneInterval = time.Minute
+	// maxPendingEvents bounds the events that wait to be written if the database is unavailable.
+	maxPendingEvents = 10_000
+)
+
+// EventEncoder encodes an event in the format it is stored in the event log.
+type EventEncoder func(*StreamEvent) ([]byte, error)
+
+// EncodedPayload is the payload of an event that was read back from the event log. It holds the
+// whole event as it was encoded by the EventEncoder of the log.
+type EncodedPayload []byte
+
+// EventLogOptions bounds the retention of logged events.
+type EventLogOptions struct {
+	// Retention is how long events are kept.
+	Retention time.Duration
+	// MaxEvents is the number of most recent events that are kept at most.
+	MaxEvents int
+}
+
+func DefaultEventLogOptions() EventLogOptions {
+	return EventLogOptions{
+		Retention: DefaultEventRetention,
+		MaxEvents: DefaultMaxLoggedEvents,
+	}
+}
+
+// EventLog persists the events published on an EventRouter, so that subscribers can resume their
+// stream after the last event they received. Events are encoded and written in batches by Run,
+// publishing never waits for the encoder or the database. Events that are not written yet are
+// served from memory.
+type EventLog struct {
+	db      *memory.Client
+	encode  EventEncoder
+	options EventLogOptions
+
+	mu      sync.Mutex
+	pending []*StreamEvent
+	flushCh chan struct{}
+}
+
+// NewEventLog creates an event log that stores events encoded by encode.
+func NewEventLog(db *memory.Client, encode EventEncoder, options EventLogOptions) *EventLog {
+	if options.Retention <= 0 {
+		options.Retention = DefaultEventRetention
+	}
+	if options.MaxEvents <= 0 {
+		options.MaxEvents = DefaultMaxLoggedEvents
+	}
+
+	return &EventLog{
+		db:      db,
+		encode:  encode,
+		options: options,
+		flushCh: make(chan struct{}, 1),
+	}
+}
+
+// Run writes the logged events to the database and deletes events that are past their retention
+// until ctx is cancelled. Events that are still pending at that point are written before it returns.
+func (l *EventLog) Run(ctx context.Context) {
+	flushTicker := time.NewTicker(eventLogFlushInterval)
+	defer flushTicker.Stop()
+	pruneTicker := time.NewTicker(eventLogPruneInterval)
+	defer pruneTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			if err := l.flush(flushCtx); err != nil {
+				slog.Error("failed to write pending events to the event log", "error", err)
+			}
+			return
+		case <-l.flushCh:
+		case <-flushTicker.C:
+		case <-pruneTicker.C:
+			if err := l.prune(ctx); err != nil {
+				slog.Error("failed to prune event log", "error", err)
+			}
+		}
+
+		if err := l.flush(ctx); err != nil {
+			slog.Error("failed to write events to the event log", "error", err)
+		}
+	}
+}
+
+// append queues a sequenced event for writing.
+func (l *EventLog) append(event *StreamEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.pending) >= maxPendingEvents {
+		slog.Warn("dropped event from the event log because too many events are waiting to be written",
+			"sequence", l.pending[0].Sequence,
+		)
+		l.pending = l.pending[1:]
+	}
+	l.pending = append(l.pending, event)
+
+	if len(l.pending) >= eventLogBatchSize {
+		select {
+		case l.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flush encodes and writes the pending events in batches. Events stay pending until their batch
+// was written. Events that cannot be encoded are skipped.
+func (l *EventLog) flush(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		batch := slices.Clone(l.pending[:min(len(l.pending), eventLogBatchSize)])
+		l.mu.Unlock()
+
+		if len(batch) == 0 {
+			return nil
+		}
+
+		creates := make([]*memory.EventLogEntryCreate, 0, len(batch))
+		for _, event := range batch {
+			payload, err := l.encode(event)
+			if err != nil {
+				slog.Error("failed to encode event for the event log",
+					"event_type", event.Type,
+					"sequence", event.Sequence,
+					"error", err,
+				)
+				continue
+			}
+
+			creates = append(creates, l.db.EventLogEntry.Create().
+				SetSequence(event.Sequence).
+				SetType(event.Type).
+				SetAction(event.Action).
+				SetTimestamp(event.Timestamp).
+				SetNillableTaskID(event.TaskID).
+				SetPayload(payload),
+			)
+		}
+
+		if len(creates) > 0 {
+			if err := l.db.EventLogEntry.CreateBulk(creates...).Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		written := batch[len(batch)-1].Sequence
+		l.mu.Lock()
+		for len(l.pending) > 0 && l.pending[0].Sequence <= written {
+			l.pending = l.pending[1:]
+		}
+		l.mu.Unlock()
+	}
+}
+
+// prune deletes events that are older than the retention or exceed the maximum number of events.
+// The most recent event is always kept, it carries the sequence over restarts of the daemon.
+func (l *EventLog) prune(ctx context.Context) error {
+	last, err := l.lastSequence(ctx)
+	if err != nil || last == 0 {
+		return err
+	}
+
+	deleted, err := l.db.EventLogEntry.Delete().
+		Where(
+			eventlogentry.SequenceLT(last),
+			eventlogentry.Or(
+				eventlogentry.CreateTimeLT(time.Now().Add(-l.options.Retention)),
+				eventlogentry.SequenceLTE(last-int64(l.options.MaxEvents)),
+			),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if deleted > 0 {
+		slog.Debug("pruned event log", "deleted", deleted)
+	}
+	return nil
+}
+
+// lastSequence returns the sequence of the most recent event in the database, or 0 if there is none.
+func (l *EventLog) lastSequence(ctx context.Context) (int64, error) {
+	entry, err := l.db.EventLogEntry.Query().
+		Order(memory.Desc(eventlogentry.FieldSequence)).
+		First(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return entry.Sequence, nil
+}
+
+// read returns at most limit events with a sequence after after and up to upTo, in order. Events
+// that were deleted are missing from the result.
+func (l *EventLog) read(ctx context.Context, after, upTo int64, limit int) ([]*StreamEvent, error) {
+	// Pending events are captured before the database is queried. An event that is written in
+	// between is then found in both and must only be returned once.
+	l.mu.Lock()
+	pending := slices.Clone(l.pending)
+	l.mu.Unlock()
+
+	entries, err := l.db.EventLogEntry.Query().
+		Where(
+			eventlogentry.SequenceGT(after),
+			eventlogentry.SequenceLTE(upTo),
+		).
+		Order(eventlogentry.BySequence()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*StreamEvent, 0, len(entries))
+	for _, entry := range entries {
+		events = append(events, &StreamEvent{
+			Type:      entry.Type,
+			Action:    entry.Action,
+			Timestamp: entry.Timestamp,
+			TaskID:    entry.TaskID,
+			Sequence:  entry.Sequence,
+			Payload:   EncodedPayload(entry.Payload),
+		})
+	}
+	if len(events) == limit {
+		return events, nil
+	}
+
+	if len(events) > 0 {
+		after = events[len(events)-1].Sequence
+	}
+	for _, event := range pending {
+		if len(events) == limit {
+			break
+		}
+		if event.Sequence > after && event.Sequence <= upTo {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,208 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func encodeEventType(e *StreamEvent) ([]byte, error) {
+	return []byte(e.Type), nil
+}
+
+func newLoggingRouter(t *testing.T, log *EventLog, bufferSize int) *EventRouter {
+	t.Helper()
+	router := NewEventRouter(bufferSize)
+	t.Cleanup(router.Close)
+	if err := router.EnableLog(context.Background(), log); err != nil {
+		t.Fatalf("failed to enable event log: %v", err)
+	}
+	return router
+}
+
+func receiveEvents(t *testing.T, ch <-chan *StreamEvent, count int) []*StreamEvent {
+	t.Helper()
+	var events []*StreamEvent
+	for len(events) < count {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				t.Fatalf("channel closed after %d of %d events", len(events), count)
+			}
+			events = append(events, event)
+		case <-time.After(time.Second):
+			t.Fatalf("timeout after %d of %d events", len(events), count)
+		}
+	}
+	return events
+}
+
+func sequences(events []*StreamEvent) []int64 {
+	var result []int64
+	for _, event := range events {
+		result = append(result, event.Sequence)
+	}
+	return result
+}
+
+func TestEventLog_ResumeAfterSequence(t *testing.T) {
+	ctx := context.Background()
+	log := NewEventLog(setupTestDB(t), encodeEventType, DefaultEventLogOptions())
+	router := newLoggingRouter(t, log, 10)
+
+	taskID := uuid.New()
+	for i := range 5 {
+		router.Publish(NewMessageChunkEvent(taskID, uuid.New(), "chunk", i))
+	}
+	router.Publish(NewInternalTaskTriggerEvent(taskID))
+	if err := log.flush(ctx); err != nil {
+		t.Fatalf("failed to flush event log: %v", err)
+	}
+	// These events are not written yet and must be served from memory.
+	router.Publish(NewMessageChunkEvent(taskID, uuid.New(), "chunk", 5))
+	router.Publish(NewAgentDeletedEvent(uuid.New()))
+
+	after := int64(2)
+	ch, cancel := router.Subscribe(ctx, SubscribeOptions{TaskID: taskID.String(), ResumeAfterSequence: &after})
+	defer cancel()
+
+	router.Publish(NewMessageChunkEvent(taskID, uuid.New(), "chunk", 6))
+
+	events := receiveEvents(t, ch, 5)
+	if diff := cmp.Diff([]int64{3, 4, 5, 6, 8}, sequences(events)); diff != "" {
+		t.Errorf("sequence mismatch (-want +got):\n%s", diff)
+	}
+	if payload, ok := events[0].Payload.(EncodedPayload); !ok || string(payload) != EventTypeMessageChunk {
+		t.Errorf("expected event read back from the log, got payload %T", events[0].Payload)
+	}
+	if events[0].TaskID == nil || *events[0].TaskID != taskID {
+		t.Errorf("logged event lost its task")
+	}
+	if _, ok := events[3].Payload.(*MessageChunkPayload); !ok {
+		t.Errorf("expected pending event to be served from memory, got payload %T", events[3].Payload)
+	}
+}
+
+func TestEventLog_ResumeReportsGap(t *testing.T) {
+	ctx := context.Background()
+	log := NewEventLog(setupTestDB(t), encodeEventType, EventLogOptions{MaxEvents: 2})
+	router := newLoggingRouter(t, log, 10)
+
+	for range 5 {
+		router.Publish(NewAgentDeletedEvent(uuid.New()))
+	}
+	if err := log.flush(ctx); err != nil {
+		t.Fatalf("failed to flush event log: %v", err)
+	}
+	if err := log.prune(ctx); err != nil {
+		t.Fatalf("failed to prune event log: %v", err)
+	}
+
+	after := int64(1)
+	ch, cancel := router.Subscribe(ctx, SubscribeOptions{ResumeAfterSequence: &after})
+	defer cancel()
+
+	events := receiveEvents(t, ch, 3)
+	gap, ok := events[0].Payload.(*StreamGapPayload)
+	if !ok || events[0].Type != EventTypeStreamGap {
+		t.Fatalf("expected stream.gap event, got %s", events[0].Type)
+	}
+	if diff := cmp.Diff(&StreamGapPayload{FromSequence: 2, ToSequence: 3}, gap); diff != "" {
+		t.Errorf("gap mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]int64{4, 5}, sequences(events[1:])); diff != "" {
+		t.Errorf("sequence mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEventLog_SequenceContinuesAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+	log := NewEventLog(db, encodeEventType, EventLogOptions{Retention: time.Nanosecond})
+	router := newLoggingRouter(t, log, 10)
+
+	for range 3 {
+		router.Publish(NewAgentDeletedEvent(uuid.New()))
+	}
+	if err := log.flush(ctx); err != nil {
+		t.Fatalf("failed to flush event log: %v", err)
+	}
+	if err := log.prune(ctx); err != nil {
+		t.Fatalf("failed to prune event log: %v", err)
+	}
+
+	restarted := newLoggingRouter(t, NewEventLog(db, encodeEventType, DefaultEventLogOptions()), 10)
+	event := NewAgentDeletedEvent(uuid.New())
+	restarted.Publish(event)
+
+	if event.Sequence != 4 {
+		t.Errorf("sequence after restart = %d, want 4", event.Sequence)
+	}
+}
+
+func TestEventLog_EncodesOnFlush(t *testing.T) {
+	ctx := context.Background()
+	db := setupTestDB(t)
+
+	var encoded atomic.Int32
+	encode := func(e *StreamEvent) ([]byte, error) {
+		encoded.Add(1)
+		if e.Type == EventTypeAgentDeleted {
+			return nil, fmt.Errorf("cannot encode %s", e.Type)
+		}
+		return encodeEventType(e)
+	}
+	log := NewEventLog(db, encode, DefaultEventLogOptions())
+	router := newLoggingRouter(t, log, 10)
+
+	taskID := uuid.New()
+	router.Publish(NewMessageChunkEvent(taskID, uuid.New(), "chunk", 0))
+	router.Publish(NewAgentDeletedEvent(uuid.New()))
+	router.Publish(NewMessageChunkEvent(taskID, uuid.New(), "chunk", 1))
+	if encoded.Load() != 0 {
+		t.Fatalf("events were encoded while they were published")
+	}
+
+	if err := log.flush(ctx); err != nil {
+		t.Fatalf("failed to flush event log: %v", err)
+	}
+	if encoded.Load() != 3 {
+		t.Errorf("encoded %d events, want 3", encoded.Load())
+	}
+
+	entries := db.EventLogEntry.Query().AllX(ctx)
+	var written []int64
+	for _, entry := range entries {
+		written = append(written, entry.Sequence)
+	}
+	if diff := cmp.Diff([]int64{1, 3}, written); diff != "" {
+		t.Errorf("written sequences mismatch (-want +got):\n%s", diff)
+	}
+	if len(log.pending) != 0 {
+		t.Errorf("%d events are still pending", len(log.pending))
+	}
+}
+
+func TestEventRouter_ResumedSubscriptionRecoversDroppedEvents(t *testing.T) {
+	ctx := context.Background()
+	log := NewEventLog(setupTestDB(t), encodeEventType, DefaultEventLogOptions())
+	router := newLoggingRouter(t, log, 2)
+
+	after := int64(0)
+	ch, cancel := router.Subscribe(ctx, SubscribeOptions{ResumeAfterSequence: &after})
+	defer cancel()
+
+	for range 10 {
+		router.Publish(NewAgentDeletedEvent(uuid.New()))
+	}
+
+	events := receiveEvents(t, ch, 10)
+	if diff := cmp.Diff([]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, sequences(events)); diff != "" {
+		t.Errorf("sequence mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"log/slog"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
@@ -30,6 +32,10 @@ type StreamEvent struct {
 	// TaskID is the optional task scope for filtering. Nil for non-task-scoped events.
 	TaskID *uuid.UUID
 
+	// Sequence is the position of the event in the event log. It is assigned when the event is
+	// published on a router with an event log and is 0 for events that are not logged.
+	Sequence int64
+
 	// Payload is the domain payload (e.g., *memory.Task, *ToolCallPayload).
 	Payload any
 }
@@ -53,15 +59,32 @@ type SubscribeOptions struct {
 	// Internal allows subscribing to internal.* events.
 	// This should only be set by internal components, not external API consumers.
 	Internal bool
+
+	// Subscriber names the component that subscribes. It labels the metrics of the subscription.
+	Subscriber string
+
+	// ResumeAfterSequence resumes the subscription after the event with this sequence. Logged
+	// events after it are delivered before live events and events that are no longer logged
+	// are reported by a stream.gap event. Live events that are dropped because the subscriber
+	// falls behind are read back from the event log as well.
+	// Only applicable if the router has an event log.
+	ResumeAfterSequence *int64
 }
 
+// unnamedSubscriber labels the metrics of subscriptions without a subscriber name.
+const unnamedSubscriber = "unnamed"
+
 // eventSubscription represents an active event eventSubscription.
 type eventSubscription struct {
 	id         uuid.UUID
+	subscriber string
 	patterns   []string
 	taskID     *uuid.UUID
 	channel    chan *StreamEvent
 	cancelFunc context.CancelFunc
+	// overflowed is set if a live event was dropped for a resumed subscription.
+	overflowed atomic.Bool
+	resumed    bool
 }
 
 // EventRouter manages event eventSubscriptions and distribution.
@@ -70,6 +93,10 @@ type EventRouter struct {
 	mu                 sync.RWMutex
 	bufferSize         int
 	closed             bool
+	dropped            *prometheus.CounterVec
+	log                *EventLog
+	// sequence is the sequence of the last logged event.
+	sequence int64
 }
 
 // NewEventRouter creates a new EventRouter with the specified channel buffer size.
@@ -83,6 +110,35 @@ func NewEventRouter(bufferSize int) *EventRouter {
 	}
 }
 
+// RegisterMetrics exposes the number of events that were dropped for each subscriber.
+func (r *EventRouter) RegisterMetrics(registry prometheus.Registerer) {
+	dropped := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Subsystem: "event_router",
+		Name:      "dropped_events_total",
+		Help:      "Total number of events that were dropped because the channel of a subscriber was full",
+	}, []string{"subscriber"})
+	registry.MustRegister(dropped)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dropped = dropped
+}
+
+// EnableLog records all published events except internal ones in log and assigns them sequence
+// numbers that continue after the last logged event.
+func (r *EventRouter) EnableLog(ctx context.Context, log *EventLog) error {
+	sequence, err := log.lastSequence(ctx)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.log = log
+	r.sequence = sequence
+	return nil
+}
+
 // Subscribe creates a new eventSubscription with pattern matching and returns a channel for receiving events.
 // Call the returned cancel function to unsubscribe and close the channel.
 // The channel is also closed if ctx is cancelled.
@@ -117,8 +173,14 @@ func (r *EventRouter) Subscribe(ctx context.Context, opts SubscribeOptions) (<-c
 	subCtx, cancel := context.WithCancel(ctx)
 	ch := make(chan *StreamEvent, r.bufferSize)
 
+	subscriber := opts.Subscriber
+	if subscriber == "" {
+		subscriber = unnamedSubscriber
+	}
+
 	sub := &eventSubscription{
 		id:         uuid.New(),
+		subscriber: subscriber,
 		patterns:   patterns,
 		taskID:     taskID,
 		channel:    ch,
@@ -133,7 +195,96 @@ func (r *EventRouter) Subscribe(ctx context.Context, opts SubscribeOptions) (<-c
 		r.unsubscribe(sub.id)
 	}()
 
-	return ch, cancel
+	if opts.ResumeAfterSequence == nil || r.log == nil {
+		return ch, cancel
+	}
+
+	// Live events are buffered in the channel of the subscription until the logged events
+	// after the cursor were delivered.
+	sub.resumed = true
+	out := make(chan *StreamEvent, r.bufferSize)
+	go r.resume(subCtx, sub, min(*opts.ResumeAfterSequence, r.sequence), r.sequence, out)
+
+	return out, cancel
+}
+
+// resume delivers the logged events after cursor up to head and the live events of the
+// subscription afterwards. If live events were dropped in between, they are read back from the log.
+func (r *EventRouter) resume(ctx context.Context, sub *eventSubscription, cursor, head int64, out chan<- *StreamEvent) {
+	defer close(out)
+
+	cursor, ok := r.catchUp(ctx, sub, cursor, head, out)
+	if !ok {
+		return
+	}
+
+	for event := range sub.channel {
+		if sub.overflowed.Swap(false) {
+			if cursor, ok = r.catchUp(ctx, sub, cursor, r.LastSequence(), out); !ok {
+				return
+			}
+		}
+
+		if event.Sequence <= cursor {
+			continue
+		}
+		if !deliver(ctx, out, event) {
+			return
+		}
+		cursor = event.Sequence
+	}
+}
+
+// catchUp delivers the logged events after cursor up to head that match the subscription. Ranges
+// of events that are no longer logged are delivered as stream.gap events. It returns the new
+// cursor and false if the subscription ended.
+func (r *EventRouter) catchUp(ctx context.Context, sub *eventSubscription, cursor, head int64, out chan<- *StreamEvent) (int64, bool) {
+	next := cursor + 1
+	for next <= head {
+		events, err := r.log.read(ctx, next-1, head, eventLogBatchSize)
+		if err != nil {
+			slog.Error("failed to read event log",
+				"eventSubscription_id", sub.id,
+				"subscriber", sub.subscriber,
+				"error", err,
+			)
+			return cursor, false
+		}
+		if len(events) == 0 {
+			break
+		}
+
+		for _, event := range events {
+			if event.Sequence > next && !deliver(ctx, out, NewStreamGapEvent(next, event.Sequence-1)) {
+				return cursor, false
+			}
+			if r.matches(sub, event) && !deliver(ctx, out, event) {
+				return cursor, false
+			}
+			next = event.Sequence + 1
+		}
+	}
+
+	if next <= head && !deliver(ctx, out, NewStreamGapEvent(next, head)) {
+		return cursor, false
+	}
+	return head, true
+}
+
+func deliver(ctx context.Context, out chan<- *StreamEvent, event *StreamEvent) bool {
+	select {
+	case out <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// LastSequence returns the sequence of the last logged event.
+func (r *EventRouter) LastSequence() int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sequence
 }
 
 // unsubscribe removes a eventSubscription and closes its channel.
@@ -149,14 +300,23 @@ func (r *EventRouter) unsubscribe(id uuid.UUID) {
 
 // Publish sends an event to all matching subscribers.
 // Events are delivered non-blocking; if a subscriber's channel is full, the event is dropped.
+// If the router has an event log, the event is assigned the next sequence number and logged.
 func (r *EventRouter) Publish(event *StreamEvent) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	// Publishing is exclusive, so that subscribers receive events in the order of their sequence.
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
 	if r.closed {
 		return
 	}
 
+	// The log only queues the event, it is encoded and written outside of the lock.
+	if r.log != nil && !isInternalEvent(event.Type) {
+		r.sequence++
+		event.Sequence = r.sequence
+		r.log.append(event)
+	}
+
 	for _, sub := range r.eventSubscriptions {
 		if r.matches(sub, event) {
 			select {
@@ -167,7 +327,14 @@ func (r *EventRouter) Publish(event *StreamEvent) {
 				slog.Debug("dropped event due to full channel buffer",
 					"event_type", event.Type,
 					"eventSubscription_id", sub.id,
+					"subscriber", sub.subscriber,
 				)
+				if r.dropped != nil {
+					r.dropped.WithLabelValues(sub.subscriber).Inc()
+				}
+				if sub.resumed {
+					sub.overflowed.Store(true)
+				}
 			}
 		}
 	}
@@ -193,6 +360,21 @@ func (r *EventRouter) matches(sub *eventSubscription, event *StreamEvent) bool {
 	return false
 }
 
+// MatchesAny reports whether an event type matches any of the glob patterns, following the rules
+// of SubscribeOptions.EventTypes. An empty list of patterns matches all events except internal ones.
+func MatchesAny(patterns []string, eventType string) bool {
+	if len(patterns) == 0 {
+		patterns = []string{"*"}
+	}
+
+	for _, pattern := range patterns {
+		if matchPattern(pattern, eventType) {
+			return true
+		}
+	}
+	return false
+}
+
 // internalEventPrefix is the prefix for internal coordination events.
 const internalEventPrefix = "internal."
 
@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -369,6 +371,27 @@ func TestEventRouter_DropsEventsOnFullChannel(t *testing.T) {
 	}
 }
 
+func TestEventRouter_CountsDroppedEventsPerSubscriber(t *testing.T) {
+	router := NewEventRouter(2)
+	defer router.Close()
+
+	registry := prometheus.NewRegistry()
+	router.RegisterMetrics(registry)
+
+	ctx := context.Background()
+	_, unsubscribeSlow := router.Subscribe(ctx, SubscribeOptions{Subscriber: "slow"})
+	defer unsubscribeSlow()
+	_, unsubscribeUnnamed := router.Subscribe(ctx, SubscribeOptions{})
+	defer unsubscribeUnnamed()
+
+	for i := 0; i < 5; i++ {
+		router.Publish(&StreamEvent{Type: "test.event", Payload: i})
+	}
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(router.dropped.WithLabelValues("slow")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(router.dropped.WithLabelValues(unnamedSubscriber)))
+}
+
 func TestMatchPattern_InternalEventsRequireExplicitPattern(t *testing.T) {
 	tests := []struct {
 		name      string
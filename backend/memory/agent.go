@@ -3,6 +3,7 @@
 package memory
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -33,6 +35,14 @@ type Agent struct {
 	Builtin bool `json:"builtin,omitempty"`
 	// ModelID holds the value of the "model_id" field.
 	ModelID uuid.UUID `json:"model_id,omitempty"`
+	// FallbackModelIds holds the value of the "fallback_model_ids" field.
+	FallbackModelIds []uuid.UUID `json:"fallback_model_ids,omitempty"`
+	// ModelRouter holds the value of the "model_router" field.
+	ModelRouter *types.ModelRouter `json:"model_router,omitempty"`
+	// Redaction holds the value of the "redaction" field.
+	Redaction *types.Redaction `json:"redaction,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AgentQuery when eager-loading is set.
 	Edges        AgentEdges `json:"edges"`
@@ -86,9 +96,11 @@ func (*Agent) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case agent.FieldFallbackModelIds, agent.FieldModelRouter, agent.FieldRedaction:
+			values[i] = new([]byte)
 		case agent.FieldBuiltin:
 			values[i] = new(sql.NullBool)
-		case agent.FieldName, agent.FieldDescription, agent.FieldInstructions:
+		case agent.FieldName, agent.FieldDescription, agent.FieldInstructions, agent.FieldOwner:
 			values[i] = new(sql.NullString)
 		case agent.FieldCreateTime, agent.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -157,6 +169,36 @@ func (a *Agent) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				a.ModelID = *value
 			}
+		case agent.FieldFallbackModelIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_model_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.FallbackModelIds); err != nil {
+					return fmt.Errorf("unmarshal field fallback_model_ids: %w", err)
+				}
+			}
+		case agent.FieldModelRouter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field model_router", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.ModelRouter); err != nil {
+					return fmt.Errorf("unmarshal field model_router: %w", err)
+				}
+			}
+		case agent.FieldRedaction:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field redaction", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &a.Redaction); err != nil {
+					return fmt.Errorf("unmarshal field redaction: %w", err)
+				}
+			}
+		case agent.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				a.Owner = value.String
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -228,6 +270,18 @@ func (a *Agent) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("model_id=")
 	builder.WriteString(fmt.Sprintf("%v", a.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("fallback_model_ids=")
+	builder.WriteString(fmt.Sprintf("%v", a.FallbackModelIds))
+	builder.WriteString(", ")
+	builder.WriteString("model_router=")
+	builder.WriteString(fmt.Sprintf("%v", a.ModelRouter))
+	builder.WriteString(", ")
+	builder.WriteString("redaction=")
+	builder.WriteString(fmt.Sprintf("%v", a.Redaction))
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(a.Owner)
 	builder.WriteByte(')')
 	return builder.String()
 }
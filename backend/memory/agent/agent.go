@@ -29,6 +29,14 @@ const (
 	FieldBuiltin = "builtin"
 	// FieldModelID holds the string denoting the model_id field in the database.
 	FieldModelID = "model_id"
+	// FieldFallbackModelIds holds the string denoting the fallback_model_ids field in the database.
+	FieldFallbackModelIds = "fallback_model_ids"
+	// FieldModelRouter holds the string denoting the model_router field in the database.
+	FieldModelRouter = "model_router"
+	// FieldRedaction holds the string denoting the redaction field in the database.
+	FieldRedaction = "redaction"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
 	// EdgeModel holds the string denoting the model edge name in mutations.
 	EdgeModel = "model"
 	// EdgeTasks holds the string denoting the tasks edge name in mutations.
@@ -70,6 +78,10 @@ var Columns = []string{
 	FieldInstructions,
 	FieldBuiltin,
 	FieldModelID,
+	FieldFallbackModelIds,
+	FieldModelRouter,
+	FieldRedaction,
+	FieldOwner,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -140,6 +152,11 @@ func ByModelID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelID, opts...).ToFunc()
 }
 
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
 // ByModelField orders the results by model field.
 func ByModelField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -91,6 +91,11 @@ func ModelID(v uuid.UUID) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldModelID, v))
 }
 
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldOwner, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Agent {
 	return predicate.Agent(sql.FieldEQ(FieldCreateTime, v))
@@ -416,6 +421,111 @@ func ModelIDNotNil() predicate.Agent {
 	return predicate.Agent(sql.FieldNotNull(FieldModelID))
 }
 
+// FallbackModelIdsIsNil applies the IsNil predicate on the "fallback_model_ids" field.
+func FallbackModelIdsIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldFallbackModelIds))
+}
+
+// FallbackModelIdsNotNil applies the NotNil predicate on the "fallback_model_ids" field.
+func FallbackModelIdsNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldFallbackModelIds))
+}
+
+// ModelRouterIsNil applies the IsNil predicate on the "model_router" field.
+func ModelRouterIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldModelRouter))
+}
+
+// ModelRouterNotNil applies the NotNil predicate on the "model_router" field.
+func ModelRouterNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldModelRouter))
+}
+
+// RedactionIsNil applies the IsNil predicate on the "redaction" field.
+func RedactionIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldRedaction))
+}
+
+// RedactionNotNil applies the NotNil predicate on the "redaction" field.
+func RedactionNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldRedaction))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Agent {
+	return predicate.Agent(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.Agent {
+	return predicate.Agent(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.Agent {
+	return predicate.Agent(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Agent {
+	return predicate.Agent(sql.FieldContainsFold(FieldOwner, v))
+}
+
 // HasModel applies the HasEdge predicate on the "model" edge.
 func HasModel() predicate.Agent {
 	return predicate.Agent(func(s *sql.Selector) {
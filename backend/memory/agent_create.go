@@ -13,6 +13,7 @@ import (
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/google/uuid"
 )
@@ -106,6 +107,38 @@ func (ac *AgentCreate) SetNillableModelID(u *uuid.UUID) *AgentCreate {
 	return ac
 }
 
+// SetFallbackModelIds sets the "fallback_model_ids" field.
+func (ac *AgentCreate) SetFallbackModelIds(u []uuid.UUID) *AgentCreate {
+	ac.mutation.SetFallbackModelIds(u)
+	return ac
+}
+
+// SetModelRouter sets the "model_router" field.
+func (ac *AgentCreate) SetModelRouter(tr *types.ModelRouter) *AgentCreate {
+	ac.mutation.SetModelRouter(tr)
+	return ac
+}
+
+// SetRedaction sets the "redaction" field.
+func (ac *AgentCreate) SetRedaction(t *types.Redaction) *AgentCreate {
+	ac.mutation.SetRedaction(t)
+	return ac
+}
+
+// SetOwner sets the "owner" field.
+func (ac *AgentCreate) SetOwner(s string) *AgentCreate {
+	ac.mutation.SetOwner(s)
+	return ac
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (ac *AgentCreate) SetNillableOwner(s *string) *AgentCreate {
+	if s != nil {
+		ac.SetOwner(*s)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *AgentCreate) SetID(u uuid.UUID) *AgentCreate {
 	ac.mutation.SetID(u)
@@ -289,6 +322,22 @@ func (ac *AgentCreate) createSpec() (*Agent, *sqlgraph.CreateSpec) {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 		_node.Builtin = value
 	}
+	if value, ok := ac.mutation.FallbackModelIds(); ok {
+		_spec.SetField(agent.FieldFallbackModelIds, field.TypeJSON, value)
+		_node.FallbackModelIds = value
+	}
+	if value, ok := ac.mutation.ModelRouter(); ok {
+		_spec.SetField(agent.FieldModelRouter, field.TypeJSON, value)
+		_node.ModelRouter = value
+	}
+	if value, ok := ac.mutation.Redaction(); ok {
+		_spec.SetField(agent.FieldRedaction, field.TypeJSON, value)
+		_node.Redaction = value
+	}
+	if value, ok := ac.mutation.Owner(); ok {
+		_spec.SetField(agent.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
 	if nodes := ac.mutation.ModelIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -10,11 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/google/uuid"
 )
@@ -121,6 +123,68 @@ func (au *AgentUpdate) ClearModelID() *AgentUpdate {
 	return au
 }
 
+// SetFallbackModelIds sets the "fallback_model_ids" field.
+func (au *AgentUpdate) SetFallbackModelIds(u []uuid.UUID) *AgentUpdate {
+	au.mutation.SetFallbackModelIds(u)
+	return au
+}
+
+// AppendFallbackModelIds appends u to the "fallback_model_ids" field.
+func (au *AgentUpdate) AppendFallbackModelIds(u []uuid.UUID) *AgentUpdate {
+	au.mutation.AppendFallbackModelIds(u)
+	return au
+}
+
+// ClearFallbackModelIds clears the value of the "fallback_model_ids" field.
+func (au *AgentUpdate) ClearFallbackModelIds() *AgentUpdate {
+	au.mutation.ClearFallbackModelIds()
+	return au
+}
+
+// SetModelRouter sets the "model_router" field.
+func (au *AgentUpdate) SetModelRouter(tr *types.ModelRouter) *AgentUpdate {
+	au.mutation.SetModelRouter(tr)
+	return au
+}
+
+// ClearModelRouter clears the value of the "model_router" field.
+func (au *AgentUpdate) ClearModelRouter() *AgentUpdate {
+	au.mutation.ClearModelRouter()
+	return au
+}
+
+// SetRedaction sets the "redaction" field.
+func (au *AgentUpdate) SetRedaction(t *types.Redaction) *AgentUpdate {
+	au.mutation.SetRedaction(t)
+	return au
+}
+
+// ClearRedaction clears the value of the "redaction" field.
+func (au *AgentUpdate) ClearRedaction() *AgentUpdate {
+	au.mutation.ClearRedaction()
+	return au
+}
+
+// SetOwner sets the "owner" field.
+func (au *AgentUpdate) SetOwner(s string) *AgentUpdate {
+	au.mutation.SetOwner(s)
+	return au
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (au *AgentUpdate) SetNillableOwner(s *string) *AgentUpdate {
+	if s != nil {
+		au.SetOwner(*s)
+	}
+	return au
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (au *AgentUpdate) ClearOwner() *AgentUpdate {
+	au.mutation.ClearOwner()
+	return au
+}
+
 // SetModel sets the "model" edge to the Model entity.
 func (au *AgentUpdate) SetModel(m *Model) *AgentUpdate {
 	return au.SetModelID(m.ID)
@@ -291,6 +355,35 @@ func (au *AgentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Builtin(); ok {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.FallbackModelIds(); ok {
+		_spec.SetField(agent.FieldFallbackModelIds, field.TypeJSON, value)
+	}
+	if value, ok := au.mutation.AppendedFallbackModelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldFallbackModelIds, value)
+		})
+	}
+	if au.mutation.FallbackModelIdsCleared() {
+		_spec.ClearField(agent.FieldFallbackModelIds, field.TypeJSON)
+	}
+	if value, ok := au.mutation.ModelRouter(); ok {
+		_spec.SetField(agent.FieldModelRouter, field.TypeJSON, value)
+	}
+	if au.mutation.ModelRouterCleared() {
+		_spec.ClearField(agent.FieldModelRouter, field.TypeJSON)
+	}
+	if value, ok := au.mutation.Redaction(); ok {
+		_spec.SetField(agent.FieldRedaction, field.TypeJSON, value)
+	}
+	if au.mutation.RedactionCleared() {
+		_spec.ClearField(agent.FieldRedaction, field.TypeJSON)
+	}
+	if value, ok := au.mutation.Owner(); ok {
+		_spec.SetField(agent.FieldOwner, field.TypeString, value)
+	}
+	if au.mutation.OwnerCleared() {
+		_spec.ClearField(agent.FieldOwner, field.TypeString)
+	}
 	if au.mutation.ModelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -520,6 +613,68 @@ func (auo *AgentUpdateOne) ClearModelID() *AgentUpdateOne {
 	return auo
 }
 
+// SetFallbackModelIds sets the "fallback_model_ids" field.
+func (auo *AgentUpdateOne) SetFallbackModelIds(u []uuid.UUID) *AgentUpdateOne {
+	auo.mutation.SetFallbackModelIds(u)
+	return auo
+}
+
+// AppendFallbackModelIds appends u to the "fallback_model_ids" field.
+func (auo *AgentUpdateOne) AppendFallbackModelIds(u []uuid.UUID) *AgentUpdateOne {
+	auo.mutation.AppendFallbackModelIds(u)
+	return auo
+}
+
+// ClearFallbackModelIds clears the value of the "fallback_model_ids" field.
+func (auo *AgentUpdateOne) ClearFallbackModelIds() *AgentUpdateOne {
+	auo.mutation.ClearFallbackModelIds()
+	return auo
+}
+
+// SetModelRouter sets the "model_router" field.
+func (auo *AgentUpdateOne) SetModelRouter(tr *types.ModelRouter) *AgentUpdateOne {
+	auo.mutation.SetModelRouter(tr)
+	return auo
+}
+
+// ClearModelRouter clears the value of the "model_router" field.
+func (auo *AgentUpdateOne) ClearModelRouter() *AgentUpdateOne {
+	auo.mutation.ClearModelRouter()
+	return auo
+}
+
+// SetRedaction sets the "redaction" field.
+func (auo *AgentUpdateOne) SetRedaction(t *types.Redaction) *AgentUpdateOne {
+	auo.mutation.SetRedaction(t)
+	return auo
+}
+
+// ClearRedaction clears the value of the "redaction" field.
+func (auo *AgentUpdateOne) ClearRedaction() *AgentUpdateOne {
+	auo.mutation.ClearRedaction()
+	return auo
+}
+
+// SetOwner sets the "owner" field.
+func (auo *AgentUpdateOne) SetOwner(s string) *AgentUpdateOne {
+	auo.mutation.SetOwner(s)
+	return auo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (auo *AgentUpdateOne) SetNillableOwner(s *string) *AgentUpdateOne {
+	if s != nil {
+		auo.SetOwner(*s)
+	}
+	return auo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (auo *AgentUpdateOne) ClearOwner() *AgentUpdateOne {
+	auo.mutation.ClearOwner()
+	return auo
+}
+
 // SetModel sets the "model" edge to the Model entity.
 func (auo *AgentUpdateOne) SetModel(m *Model) *AgentUpdateOne {
 	return auo.SetModelID(m.ID)
@@ -720,6 +875,35 @@ func (auo *AgentUpdateOne) sqlSave(ctx context.Context) (_node *Agent, err error
 	if value, ok := auo.mutation.Builtin(); ok {
 		_spec.SetField(agent.FieldBuiltin, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.FallbackModelIds(); ok {
+		_spec.SetField(agent.FieldFallbackModelIds, field.TypeJSON, value)
+	}
+	if value, ok := auo.mutation.AppendedFallbackModelIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, agent.FieldFallbackModelIds, value)
+		})
+	}
+	if auo.mutation.FallbackModelIdsCleared() {
+		_spec.ClearField(agent.FieldFallbackModelIds, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.ModelRouter(); ok {
+		_spec.SetField(agent.FieldModelRouter, field.TypeJSON, value)
+	}
+	if auo.mutation.ModelRouterCleared() {
+		_spec.ClearField(agent.FieldModelRouter, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.Redaction(); ok {
+		_spec.SetField(agent.FieldRedaction, field.TypeJSON, value)
+	}
+	if auo.mutation.RedactionCleared() {
+		_spec.ClearField(agent.FieldRedaction, field.TypeJSON)
+	}
+	if value, ok := auo.mutation.Owner(); ok {
+		_spec.SetField(agent.FieldOwner, field.TypeString, value)
+	}
+	if auo.mutation.OwnerCleared() {
+		_spec.ClearField(agent.FieldOwner, field.TypeString)
+	}
 	if auo.mutation.ModelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
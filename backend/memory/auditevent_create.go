@@ -0,0 +1,411 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// AuditEventCreate is the builder for creating a AuditEvent entity.
+type AuditEventCreate struct {
+	config
+	mutation *AuditEventMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (aec *AuditEventCreate) SetCreateTime(t time.Time) *AuditEventCreate {
+	aec.mutation.SetCreateTime(t)
+	return aec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableCreateTime(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetCreateTime(*t)
+	}
+	return aec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (aec *AuditEventCreate) SetUpdateTime(t time.Time) *AuditEventCreate {
+	aec.mutation.SetUpdateTime(t)
+	return aec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableUpdateTime(t *time.Time) *AuditEventCreate {
+	if t != nil {
+		aec.SetUpdateTime(*t)
+	}
+	return aec
+}
+
+// SetKind sets the "kind" field.
+func (aec *AuditEventCreate) SetKind(tek types.AuditEventKind) *AuditEventCreate {
+	aec.mutation.SetKind(tek)
+	return aec
+}
+
+// SetAction sets the "action" field.
+func (aec *AuditEventCreate) SetAction(s string) *AuditEventCreate {
+	aec.mutation.SetAction(s)
+	return aec
+}
+
+// SetSubject sets the "subject" field.
+func (aec *AuditEventCreate) SetSubject(s string) *AuditEventCreate {
+	aec.mutation.SetSubject(s)
+	return aec
+}
+
+// SetNillableSubject sets the "subject" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableSubject(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetSubject(*s)
+	}
+	return aec
+}
+
+// SetAuthMethod sets the "auth_method" field.
+func (aec *AuditEventCreate) SetAuthMethod(s string) *AuditEventCreate {
+	aec.mutation.SetAuthMethod(s)
+	return aec
+}
+
+// SetNillableAuthMethod sets the "auth_method" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableAuthMethod(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetAuthMethod(*s)
+	}
+	return aec
+}
+
+// SetResourceIds sets the "resource_ids" field.
+func (aec *AuditEventCreate) SetResourceIds(s []string) *AuditEventCreate {
+	aec.mutation.SetResourceIds(s)
+	return aec
+}
+
+// SetOutcome sets the "outcome" field.
+func (aec *AuditEventCreate) SetOutcome(to types.AuditOutcome) *AuditEventCreate {
+	aec.mutation.SetOutcome(to)
+	return aec
+}
+
+// SetError sets the "error" field.
+func (aec *AuditEventCreate) SetError(s string) *AuditEventCreate {
+	aec.mutation.SetError(s)
+	return aec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableError(s *string) *AuditEventCreate {
+	if s != nil {
+		aec.SetError(*s)
+	}
+	return aec
+}
+
+// SetTaskID sets the "task_id" field.
+func (aec *AuditEventCreate) SetTaskID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetTaskID(u)
+	return aec
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableTaskID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetTaskID(*u)
+	}
+	return aec
+}
+
+// SetAgentID sets the "agent_id" field.
+func (aec *AuditEventCreate) SetAgentID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetAgentID(u)
+	return aec
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableAgentID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetAgentID(*u)
+	}
+	return aec
+}
+
+// SetDetails sets the "details" field.
+func (aec *AuditEventCreate) SetDetails(m map[string]string) *AuditEventCreate {
+	aec.mutation.SetDetails(m)
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuditEventCreate) SetID(u uuid.UUID) *AuditEventCreate {
+	aec.mutation.SetID(u)
+	return aec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (aec *AuditEventCreate) SetNillableID(u *uuid.UUID) *AuditEventCreate {
+	if u != nil {
+		aec.SetID(*u)
+	}
+	return aec
+}
+
+// Mutation returns the AuditEventMutation object of the builder.
+func (aec *AuditEventCreate) Mutation() *AuditEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuditEvent in the database.
+func (aec *AuditEventCreate) Save(ctx context.Context) (*AuditEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuditEventCreate) SaveX(ctx context.Context) *AuditEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuditEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuditEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuditEventCreate) defaults() {
+	if _, ok := aec.mutation.CreateTime(); !ok {
+		v := auditevent.DefaultCreateTime()
+		aec.mutation.SetCreateTime(v)
+	}
+	if _, ok := aec.mutation.UpdateTime(); !ok {
+		v := auditevent.DefaultUpdateTime()
+		aec.mutation.SetUpdateTime(v)
+	}
+	if _, ok := aec.mutation.ID(); !ok {
+		v := auditevent.DefaultID()
+		aec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuditEventCreate) check() error {
+	if _, ok := aec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "AuditEvent.create_time"`)}
+	}
+	if _, ok := aec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "AuditEvent.update_time"`)}
+	}
+	if _, ok := aec.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`memory: missing required field "AuditEvent.kind"`)}
+	}
+	if v, ok := aec.mutation.Kind(); ok {
+		if err := auditevent.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`memory: validator failed for field "AuditEvent.kind": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`memory: missing required field "AuditEvent.action"`)}
+	}
+	if _, ok := aec.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`memory: missing required field "AuditEvent.outcome"`)}
+	}
+	if v, ok := aec.mutation.Outcome(); ok {
+		if err := auditevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`memory: validator failed for field "AuditEvent.outcome": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aec *AuditEventCreate) sqlSave(ctx context.Context) (*AuditEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuditEventCreate) createSpec() (*AuditEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(auditevent.Table, sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := aec.mutation.CreateTime(); ok {
+		_spec.SetField(auditevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := aec.mutation.UpdateTime(); ok {
+		_spec.SetField(auditevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := aec.mutation.Kind(); ok {
+		_spec.SetField(auditevent.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := aec.mutation.Action(); ok {
+		_spec.SetField(auditevent.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := aec.mutation.Subject(); ok {
+		_spec.SetField(auditevent.FieldSubject, field.TypeString, value)
+		_node.Subject = value
+	}
+	if value, ok := aec.mutation.AuthMethod(); ok {
+		_spec.SetField(auditevent.FieldAuthMethod, field.TypeString, value)
+		_node.AuthMethod = value
+	}
+	if value, ok := aec.mutation.ResourceIds(); ok {
+		_spec.SetField(auditevent.FieldResourceIds, field.TypeJSON, value)
+		_node.ResourceIds = value
+	}
+	if value, ok := aec.mutation.Outcome(); ok {
+		_spec.SetField(auditevent.FieldOutcome, field.TypeEnum, value)
+		_node.Outcome = value
+	}
+	if value, ok := aec.mutation.Error(); ok {
+		_spec.SetField(auditevent.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := aec.mutation.TaskID(); ok {
+		_spec.SetField(auditevent.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = &value
+	}
+	if value, ok := aec.mutation.AgentID(); ok {
+		_spec.SetField(auditevent.FieldAgentID, field.TypeUUID, value)
+		_node.AgentID = &value
+	}
+	if value, ok := aec.mutation.Details(); ok {
+		_spec.SetField(auditevent.FieldDetails, field.TypeJSON, value)
+		_node.Details = value
+	}
+	return _node, _spec
+}
+
+// AuditEventCreateBulk is the builder for creating many AuditEvent entities in bulk.
+type AuditEventCreateBulk struct {
+	config
+	err      error
+	builders []*AuditEventCreate
+}
+
+// Save creates the AuditEvent entities in the database.
+func (aecb *AuditEventCreateBulk) Save(ctx context.Context) ([]*AuditEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuditEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) SaveX(ctx context.Context) []*AuditEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuditEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuditEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -17,11 +17,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
 )
 
 // Client is the client that holds all ent builders.
@@ -31,16 +37,28 @@ type Client struct {
 	Schema *migrate.Schema
 	// Agent is the client for interacting with the Agent builders.
 	Agent *AgentClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// EventLogEntry is the client for interacting with the EventLogEntry builders.
+	EventLogEntry *EventLogEntryClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
 	// Model is the client for interacting with the Model builders.
 	Model *ModelClient
+	// ModelCallTrace is the client for interacting with the ModelCallTrace builders.
+	ModelCallTrace *ModelCallTraceClient
 	// ModelProvider is the client for interacting with the ModelProvider builders.
 	ModelProvider *ModelProviderClient
+	// ModelProviderKey is the client for interacting with the ModelProviderKey builders.
+	ModelProviderKey *ModelProviderKeyClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -53,11 +71,17 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Agent = NewAgentClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.EventLogEntry = NewEventLogEntryClient(c.config)
 	c.Message = NewMessageClient(c.config)
 	c.Model = NewModelClient(c.config)
+	c.ModelCallTrace = NewModelCallTraceClient(c.config)
 	c.ModelProvider = NewModelProviderClient(c.config)
+	c.ModelProviderKey = NewModelProviderKeyClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.Token = NewTokenClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 type (
@@ -148,14 +172,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Agent:         NewAgentClient(cfg),
-		Message:       NewMessageClient(cfg),
-		Model:         NewModelClient(cfg),
-		ModelProvider: NewModelProviderClient(cfg),
-		Task:          NewTaskClient(cfg),
-		Token:         NewTokenClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Agent:            NewAgentClient(cfg),
+		AuditEvent:       NewAuditEventClient(cfg),
+		EventLogEntry:    NewEventLogEntryClient(cfg),
+		Message:          NewMessageClient(cfg),
+		Model:            NewModelClient(cfg),
+		ModelCallTrace:   NewModelCallTraceClient(cfg),
+		ModelProvider:    NewModelProviderClient(cfg),
+		ModelProviderKey: NewModelProviderKeyClient(cfg),
+		Task:             NewTaskClient(cfg),
+		Token:            NewTokenClient(cfg),
+		Webhook:          NewWebhookClient(cfg),
+		WebhookDelivery:  NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -173,14 +203,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:           ctx,
-		config:        cfg,
-		Agent:         NewAgentClient(cfg),
-		Message:       NewMessageClient(cfg),
-		Model:         NewModelClient(cfg),
-		ModelProvider: NewModelProviderClient(cfg),
-		Task:          NewTaskClient(cfg),
-		Token:         NewTokenClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		Agent:            NewAgentClient(cfg),
+		AuditEvent:       NewAuditEventClient(cfg),
+		EventLogEntry:    NewEventLogEntryClient(cfg),
+		Message:          NewMessageClient(cfg),
+		Model:            NewModelClient(cfg),
+		ModelCallTrace:   NewModelCallTraceClient(cfg),
+		ModelProvider:    NewModelProviderClient(cfg),
+		ModelProviderKey: NewModelProviderKeyClient(cfg),
+		Task:             NewTaskClient(cfg),
+		Token:            NewTokenClient(cfg),
+		Webhook:          NewWebhookClient(cfg),
+		WebhookDelivery:  NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -210,7 +246,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Agent, c.Message, c.Model, c.ModelProvider, c.Task, c.Token,
+		c.Agent, c.AuditEvent, c.EventLogEntry, c.Message, c.Model, c.ModelCallTrace,
+		c.ModelProvider, c.ModelProviderKey, c.Task, c.Token, c.Webhook,
+		c.WebhookDelivery,
 	} {
 		n.Use(hooks...)
 	}
@@ -220,7 +258,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Agent, c.Message, c.Model, c.ModelProvider, c.Task, c.Token,
+		c.Agent, c.AuditEvent, c.EventLogEntry, c.Message, c.Model, c.ModelCallTrace,
+		c.ModelProvider, c.ModelProviderKey, c.Task, c.Token, c.Webhook,
+		c.WebhookDelivery,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -231,16 +271,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AgentMutation:
 		return c.Agent.mutate(ctx, m)
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
+	case *EventLogEntryMutation:
+		return c.EventLogEntry.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
 	case *ModelMutation:
 		return c.Model.mutate(ctx, m)
+	case *ModelCallTraceMutation:
+		return c.ModelCallTrace.mutate(ctx, m)
 	case *ModelProviderMutation:
 		return c.ModelProvider.mutate(ctx, m)
+	case *ModelProviderKeyMutation:
+		return c.ModelProviderKey.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
 	case *TokenMutation:
 		return c.Token.mutate(ctx, m)
+	case *WebhookMutation:
+		return c.Webhook.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("memory: unknown mutation type %T", m)
 	}
@@ -427,6 +479,272 @@ func (c *AgentClient) mutate(ctx context.Context, m *AgentMutation) (Value, erro
 	}
 }
 
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(ae *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(ae))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id uuid.UUID) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(ae *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id uuid.UUID) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id uuid.UUID) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id uuid.UUID) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("memory: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
+// EventLogEntryClient is a client for the EventLogEntry schema.
+type EventLogEntryClient struct {
+	config
+}
+
+// NewEventLogEntryClient returns a client for the EventLogEntry from the given config.
+func NewEventLogEntryClient(c config) *EventLogEntryClient {
+	return &EventLogEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `eventlogentry.Hooks(f(g(h())))`.
+func (c *EventLogEntryClient) Use(hooks ...Hook) {
+	c.hooks.EventLogEntry = append(c.hooks.EventLogEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `eventlogentry.Intercept(f(g(h())))`.
+func (c *EventLogEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventLogEntry = append(c.inters.EventLogEntry, interceptors...)
+}
+
+// Create returns a builder for creating a EventLogEntry entity.
+func (c *EventLogEntryClient) Create() *EventLogEntryCreate {
+	mutation := newEventLogEntryMutation(c.config, OpCreate)
+	return &EventLogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EventLogEntry entities.
+func (c *EventLogEntryClient) CreateBulk(builders ...*EventLogEntryCreate) *EventLogEntryCreateBulk {
+	return &EventLogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EventLogEntryClient) MapCreateBulk(slice any, setFunc func(*EventLogEntryCreate, int)) *EventLogEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EventLogEntryCreateBulk{err: fmt.Errorf("calling to EventLogEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EventLogEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EventLogEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EventLogEntry.
+func (c *EventLogEntryClient) Update() *EventLogEntryUpdate {
+	mutation := newEventLogEntryMutation(c.config, OpUpdate)
+	return &EventLogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EventLogEntryClient) UpdateOne(ele *EventLogEntry) *EventLogEntryUpdateOne {
+	mutation := newEventLogEntryMutation(c.config, OpUpdateOne, withEventLogEntry(ele))
+	return &EventLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EventLogEntryClient) UpdateOneID(id uuid.UUID) *EventLogEntryUpdateOne {
+	mutation := newEventLogEntryMutation(c.config, OpUpdateOne, withEventLogEntryID(id))
+	return &EventLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EventLogEntry.
+func (c *EventLogEntryClient) Delete() *EventLogEntryDelete {
+	mutation := newEventLogEntryMutation(c.config, OpDelete)
+	return &EventLogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EventLogEntryClient) DeleteOne(ele *EventLogEntry) *EventLogEntryDeleteOne {
+	return c.DeleteOneID(ele.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EventLogEntryClient) DeleteOneID(id uuid.UUID) *EventLogEntryDeleteOne {
+	builder := c.Delete().Where(eventlogentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EventLogEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for EventLogEntry.
+func (c *EventLogEntryClient) Query() *EventLogEntryQuery {
+	return &EventLogEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEventLogEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EventLogEntry entity by its id.
+func (c *EventLogEntryClient) Get(ctx context.Context, id uuid.UUID) (*EventLogEntry, error) {
+	return c.Query().Where(eventlogentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EventLogEntryClient) GetX(ctx context.Context, id uuid.UUID) *EventLogEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EventLogEntryClient) Hooks() []Hook {
+	return c.hooks.EventLogEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *EventLogEntryClient) Interceptors() []Interceptor {
+	return c.inters.EventLogEntry
+}
+
+func (c *EventLogEntryClient) mutate(ctx context.Context, m *EventLogEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EventLogEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EventLogEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EventLogEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EventLogEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("memory: unknown EventLogEntry mutation op: %q", m.Op())
+	}
+}
+
 // MessageClient is a client for the Message schema.
 type MessageClient struct {
 	config
@@ -789,6 +1107,155 @@ func (c *ModelClient) mutate(ctx context.Context, m *ModelMutation) (Value, erro
 	}
 }
 
+// ModelCallTraceClient is a client for the ModelCallTrace schema.
+type ModelCallTraceClient struct {
+	config
+}
+
+// NewModelCallTraceClient returns a client for the ModelCallTrace from the given config.
+func NewModelCallTraceClient(c config) *ModelCallTraceClient {
+	return &ModelCallTraceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `modelcalltrace.Hooks(f(g(h())))`.
+func (c *ModelCallTraceClient) Use(hooks ...Hook) {
+	c.hooks.ModelCallTrace = append(c.hooks.ModelCallTrace, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `modelcalltrace.Intercept(f(g(h())))`.
+func (c *ModelCallTraceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModelCallTrace = append(c.inters.ModelCallTrace, interceptors...)
+}
+
+// Create returns a builder for creating a ModelCallTrace entity.
+func (c *ModelCallTraceClient) Create() *ModelCallTraceCreate {
+	mutation := newModelCallTraceMutation(c.config, OpCreate)
+	return &ModelCallTraceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModelCallTrace entities.
+func (c *ModelCallTraceClient) CreateBulk(builders ...*ModelCallTraceCreate) *ModelCallTraceCreateBulk {
+	return &ModelCallTraceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModelCallTraceClient) MapCreateBulk(slice any, setFunc func(*ModelCallTraceCreate, int)) *ModelCallTraceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModelCallTraceCreateBulk{err: fmt.Errorf("calling to ModelCallTraceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModelCallTraceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModelCallTraceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModelCallTrace.
+func (c *ModelCallTraceClient) Update() *ModelCallTraceUpdate {
+	mutation := newModelCallTraceMutation(c.config, OpUpdate)
+	return &ModelCallTraceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModelCallTraceClient) UpdateOne(mct *ModelCallTrace) *ModelCallTraceUpdateOne {
+	mutation := newModelCallTraceMutation(c.config, OpUpdateOne, withModelCallTrace(mct))
+	return &ModelCallTraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModelCallTraceClient) UpdateOneID(id uuid.UUID) *ModelCallTraceUpdateOne {
+	mutation := newModelCallTraceMutation(c.config, OpUpdateOne, withModelCallTraceID(id))
+	return &ModelCallTraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModelCallTrace.
+func (c *ModelCallTraceClient) Delete() *ModelCallTraceDelete {
+	mutation := newModelCallTraceMutation(c.config, OpDelete)
+	return &ModelCallTraceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModelCallTraceClient) DeleteOne(mct *ModelCallTrace) *ModelCallTraceDeleteOne {
+	return c.DeleteOneID(mct.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModelCallTraceClient) DeleteOneID(id uuid.UUID) *ModelCallTraceDeleteOne {
+	builder := c.Delete().Where(modelcalltrace.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModelCallTraceDeleteOne{builder}
+}
+
+// Query returns a query builder for ModelCallTrace.
+func (c *ModelCallTraceClient) Query() *ModelCallTraceQuery {
+	return &ModelCallTraceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModelCallTrace},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModelCallTrace entity by its id.
+func (c *ModelCallTraceClient) Get(ctx context.Context, id uuid.UUID) (*ModelCallTrace, error) {
+	return c.Query().Where(modelcalltrace.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModelCallTraceClient) GetX(ctx context.Context, id uuid.UUID) *ModelCallTrace {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTask queries the task edge of a ModelCallTrace.
+func (c *ModelCallTraceClient) QueryTask(mct *ModelCallTrace) *TaskQuery {
+	query := (&TaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mct.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modelcalltrace.Table, modelcalltrace.FieldID, id),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, modelcalltrace.TaskTable, modelcalltrace.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(mct.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModelCallTraceClient) Hooks() []Hook {
+	return c.hooks.ModelCallTrace
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModelCallTraceClient) Interceptors() []Interceptor {
+	return c.inters.ModelCallTrace
+}
+
+func (c *ModelCallTraceClient) mutate(ctx context.Context, m *ModelCallTraceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModelCallTraceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModelCallTraceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModelCallTraceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModelCallTraceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("memory: unknown ModelCallTrace mutation op: %q", m.Op())
+	}
+}
+
 // ModelProviderClient is a client for the ModelProvider schema.
 type ModelProviderClient struct {
 	config
@@ -913,6 +1380,22 @@ func (c *ModelProviderClient) QueryModels(mp *ModelProvider) *ModelQuery {
 	return query
 }
 
+// QueryKeys queries the keys edge of a ModelProvider.
+func (c *ModelProviderClient) QueryKeys(mp *ModelProvider) *ModelProviderKeyQuery {
+	query := (&ModelProviderKeyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modelprovider.Table, modelprovider.FieldID, id),
+			sqlgraph.To(modelproviderkey.Table, modelproviderkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, modelprovider.KeysTable, modelprovider.KeysColumn),
+		)
+		fromV = sqlgraph.Neighbors(mp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ModelProviderClient) Hooks() []Hook {
 	return c.hooks.ModelProvider
@@ -938,6 +1421,155 @@ func (c *ModelProviderClient) mutate(ctx context.Context, m *ModelProviderMutati
 	}
 }
 
+// ModelProviderKeyClient is a client for the ModelProviderKey schema.
+type ModelProviderKeyClient struct {
+	config
+}
+
+// NewModelProviderKeyClient returns a client for the ModelProviderKey from the given config.
+func NewModelProviderKeyClient(c config) *ModelProviderKeyClient {
+	return &ModelProviderKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `modelproviderkey.Hooks(f(g(h())))`.
+func (c *ModelProviderKeyClient) Use(hooks ...Hook) {
+	c.hooks.ModelProviderKey = append(c.hooks.ModelProviderKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `modelproviderkey.Intercept(f(g(h())))`.
+func (c *ModelProviderKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModelProviderKey = append(c.inters.ModelProviderKey, interceptors...)
+}
+
+// Create returns a builder for creating a ModelProviderKey entity.
+func (c *ModelProviderKeyClient) Create() *ModelProviderKeyCreate {
+	mutation := newModelProviderKeyMutation(c.config, OpCreate)
+	return &ModelProviderKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModelProviderKey entities.
+func (c *ModelProviderKeyClient) CreateBulk(builders ...*ModelProviderKeyCreate) *ModelProviderKeyCreateBulk {
+	return &ModelProviderKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModelProviderKeyClient) MapCreateBulk(slice any, setFunc func(*ModelProviderKeyCreate, int)) *ModelProviderKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModelProviderKeyCreateBulk{err: fmt.Errorf("calling to ModelProviderKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModelProviderKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModelProviderKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModelProviderKey.
+func (c *ModelProviderKeyClient) Update() *ModelProviderKeyUpdate {
+	mutation := newModelProviderKeyMutation(c.config, OpUpdate)
+	return &ModelProviderKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModelProviderKeyClient) UpdateOne(mpk *ModelProviderKey) *ModelProviderKeyUpdateOne {
+	mutation := newModelProviderKeyMutation(c.config, OpUpdateOne, withModelProviderKey(mpk))
+	return &ModelProviderKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModelProviderKeyClient) UpdateOneID(id uuid.UUID) *ModelProviderKeyUpdateOne {
+	mutation := newModelProviderKeyMutation(c.config, OpUpdateOne, withModelProviderKeyID(id))
+	return &ModelProviderKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModelProviderKey.
+func (c *ModelProviderKeyClient) Delete() *ModelProviderKeyDelete {
+	mutation := newModelProviderKeyMutation(c.config, OpDelete)
+	return &ModelProviderKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModelProviderKeyClient) DeleteOne(mpk *ModelProviderKey) *ModelProviderKeyDeleteOne {
+	return c.DeleteOneID(mpk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModelProviderKeyClient) DeleteOneID(id uuid.UUID) *ModelProviderKeyDeleteOne {
+	builder := c.Delete().Where(modelproviderkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModelProviderKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ModelProviderKey.
+func (c *ModelProviderKeyClient) Query() *ModelProviderKeyQuery {
+	return &ModelProviderKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModelProviderKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModelProviderKey entity by its id.
+func (c *ModelProviderKeyClient) Get(ctx context.Context, id uuid.UUID) (*ModelProviderKey, error) {
+	return c.Query().Where(modelproviderkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModelProviderKeyClient) GetX(ctx context.Context, id uuid.UUID) *ModelProviderKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryModelProvider queries the model_provider edge of a ModelProviderKey.
+func (c *ModelProviderKeyClient) QueryModelProvider(mpk *ModelProviderKey) *ModelProviderQuery {
+	query := (&ModelProviderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := mpk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modelproviderkey.Table, modelproviderkey.FieldID, id),
+			sqlgraph.To(modelprovider.Table, modelprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, modelproviderkey.ModelProviderTable, modelproviderkey.ModelProviderColumn),
+		)
+		fromV = sqlgraph.Neighbors(mpk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ModelProviderKeyClient) Hooks() []Hook {
+	return c.hooks.ModelProviderKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModelProviderKeyClient) Interceptors() []Interceptor {
+	return c.inters.ModelProviderKey
+}
+
+func (c *ModelProviderKeyClient) mutate(ctx context.Context, m *ModelProviderKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModelProviderKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModelProviderKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModelProviderKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModelProviderKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("memory: unknown ModelProviderKey mutation op: %q", m.Op())
+	}
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -1062,6 +1694,22 @@ func (c *TaskClient) QueryMessages(t *Task) *MessageQuery {
 	return query
 }
 
+// QueryModelCallTraces queries the model_call_traces edge of a Task.
+func (c *TaskClient) QueryModelCallTraces(t *Task) *ModelCallTraceQuery {
+	query := (&ModelCallTraceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, id),
+			sqlgraph.To(modelcalltrace.Table, modelcalltrace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, task.ModelCallTracesTable, task.ModelCallTracesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryAgent queries the agent edge of a Task.
 func (c *TaskClient) QueryAgent(t *Task) *AgentQuery {
 	query := (&AgentClient{config: c.config}).Query()
@@ -1236,12 +1884,312 @@ func (c *TokenClient) mutate(ctx context.Context, m *TokenMutation) (Value, erro
 	}
 }
 
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhook.Intercept(f(g(h())))`.
+func (c *WebhookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Webhook = append(c.inters.Webhook, interceptors...)
+}
+
+// Create returns a builder for creating a Webhook entity.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Webhook entities.
+func (c *WebhookClient) CreateBulk(builders ...*WebhookCreate) *WebhookCreateBulk {
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookClient) MapCreateBulk(slice any, setFunc func(*WebhookCreate, int)) *WebhookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookCreateBulk{err: fmt.Errorf("calling to WebhookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhook(w))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id uuid.UUID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne, withWebhookID(id))
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookClient) DeleteOneID(id uuid.UUID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Query returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id uuid.UUID) *Webhook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookClient) Interceptors() []Interceptor {
+	return c.inters.Webhook
+}
+
+func (c *WebhookClient) mutate(ctx context.Context, m *WebhookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("memory: unknown Webhook mutation op: %q", m.Op())
+	}
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(wd))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := (&WebhookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
+}
+
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("memory: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Agent, Message, Model, ModelProvider, Task, Token []ent.Hook
+		Agent, AuditEvent, EventLogEntry, Message, Model, ModelCallTrace, ModelProvider,
+		ModelProviderKey, Task, Token, Webhook, WebhookDelivery []ent.Hook
 	}
 	inters struct {
-		Agent, Message, Model, ModelProvider, Task, Token []ent.Interceptor
+		Agent, AuditEvent, EventLogEntry, Message, Model, ModelCallTrace, ModelProvider,
+		ModelProviderKey, Task, Token, Webhook, WebhookDelivery []ent.Interceptor
 	}
 )
@@ -3,9 +3,23 @@ package memory
 import (
 	"context"
 	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
 )
 
-func Transaction[T any](ctx context.Context, client *Client, fn func(tx *Client) (*T, error)) (*T, error) {
+var tracer = otel.Tracer("github.com/furisto/construct/backend/memory")
+
+func Transaction[T any](ctx context.Context, client *Client, fn func(tx *Client) (*T, error)) (result *T, err error) {
+	ctx, span := tracer.Start(ctx, "db.transaction")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	tx, err := client.Tx(ctx)
 	if err != nil {
 		return nil, err
@@ -18,7 +32,7 @@ func Transaction[T any](ctx context.Context, client *Client, fn func(tx *Client)
 		}
 	}()
 
-	result, err := fn(tx.Client())
+	result, err = fn(tx.Client())
 	if err != nil {
 		if rerr := tx.Rollback(); rerr != nil {
 			err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
@@ -13,11 +13,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -78,12 +84,18 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			agent.Table:         agent.ValidColumn,
-			message.Table:       message.ValidColumn,
-			model.Table:         model.ValidColumn,
-			modelprovider.Table: modelprovider.ValidColumn,
-			task.Table:          task.ValidColumn,
-			token.Table:         token.ValidColumn,
+			agent.Table:            agent.ValidColumn,
+			auditevent.Table:       auditevent.ValidColumn,
+			eventlogentry.Table:    eventlogentry.ValidColumn,
+			message.Table:          message.ValidColumn,
+			model.Table:            model.ValidColumn,
+			modelcalltrace.Table:   modelcalltrace.ValidColumn,
+			modelprovider.Table:    modelprovider.ValidColumn,
+			modelproviderkey.Table: modelproviderkey.ValidColumn,
+			task.Table:             task.ValidColumn,
+			token.Table:            token.ValidColumn,
+			webhook.Table:          webhook.ValidColumn,
+			webhookdelivery.Table:  webhookdelivery.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
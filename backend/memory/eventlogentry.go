@@ -0,0 +1,193 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
+	"github.com/google/uuid"
+)
+
+// EventLogEntry is the model entity for the EventLogEntry schema.
+type EventLogEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Sequence holds the value of the "sequence" field.
+	Sequence int64 `json:"sequence,omitempty"`
+	// Type holds the value of the "type" field.
+	Type string `json:"type,omitempty"`
+	// Action holds the value of the "action" field.
+	Action string `json:"action,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID *uuid.UUID `json:"task_id,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload      []byte `json:"payload,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventLogEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventlogentry.FieldTaskID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case eventlogentry.FieldPayload:
+			values[i] = new([]byte)
+		case eventlogentry.FieldSequence:
+			values[i] = new(sql.NullInt64)
+		case eventlogentry.FieldType, eventlogentry.FieldAction:
+			values[i] = new(sql.NullString)
+		case eventlogentry.FieldCreateTime, eventlogentry.FieldUpdateTime, eventlogentry.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		case eventlogentry.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventLogEntry fields.
+func (ele *EventLogEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventlogentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ele.ID = *value
+			}
+		case eventlogentry.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ele.CreateTime = value.Time
+			}
+		case eventlogentry.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ele.UpdateTime = value.Time
+			}
+		case eventlogentry.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				ele.Sequence = value.Int64
+			}
+		case eventlogentry.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				ele.Type = value.String
+			}
+		case eventlogentry.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ele.Action = value.String
+			}
+		case eventlogentry.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				ele.Timestamp = value.Time
+			}
+		case eventlogentry.FieldTaskID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				ele.TaskID = new(uuid.UUID)
+				*ele.TaskID = *value.S.(*uuid.UUID)
+			}
+		case eventlogentry.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				ele.Payload = *value
+			}
+		default:
+			ele.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventLogEntry.
+// This includes values selected through modifiers, order, etc.
+func (ele *EventLogEntry) Value(name string) (ent.Value, error) {
+	return ele.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventLogEntry.
+// Note that you need to call EventLogEntry.Unwrap() before calling this method if this EventLogEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ele *EventLogEntry) Update() *EventLogEntryUpdateOne {
+	return NewEventLogEntryClient(ele.config).UpdateOne(ele)
+}
+
+// Unwrap unwraps the EventLogEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ele *EventLogEntry) Unwrap() *EventLogEntry {
+	_tx, ok := ele.config.driver.(*txDriver)
+	if !ok {
+		panic("memory: EventLogEntry is not a transactional entity")
+	}
+	ele.config.driver = _tx.drv
+	return ele
+}
+
+// String implements the fmt.Stringer.
+func (ele *EventLogEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventLogEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ele.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ele.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ele.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", ele.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(ele.Type)
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(ele.Action)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(ele.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := ele.TaskID; v != nil {
+		builder.WriteString("task_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", ele.Payload))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventLogEntries is a parsable slice of EventLogEntry.
+type EventLogEntries []*EventLogEntry
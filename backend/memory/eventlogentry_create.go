@@ -0,0 +1,339 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
+	"github.com/google/uuid"
+)
+
+// EventLogEntryCreate is the builder for creating a EventLogEntry entity.
+type EventLogEntryCreate struct {
+	config
+	mutation *EventLogEntryMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (elec *EventLogEntryCreate) SetCreateTime(t time.Time) *EventLogEntryCreate {
+	elec.mutation.SetCreateTime(t)
+	return elec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (elec *EventLogEntryCreate) SetNillableCreateTime(t *time.Time) *EventLogEntryCreate {
+	if t != nil {
+		elec.SetCreateTime(*t)
+	}
+	return elec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (elec *EventLogEntryCreate) SetUpdateTime(t time.Time) *EventLogEntryCreate {
+	elec.mutation.SetUpdateTime(t)
+	return elec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (elec *EventLogEntryCreate) SetNillableUpdateTime(t *time.Time) *EventLogEntryCreate {
+	if t != nil {
+		elec.SetUpdateTime(*t)
+	}
+	return elec
+}
+
+// SetSequence sets the "sequence" field.
+func (elec *EventLogEntryCreate) SetSequence(i int64) *EventLogEntryCreate {
+	elec.mutation.SetSequence(i)
+	return elec
+}
+
+// SetType sets the "type" field.
+func (elec *EventLogEntryCreate) SetType(s string) *EventLogEntryCreate {
+	elec.mutation.SetType(s)
+	return elec
+}
+
+// SetAction sets the "action" field.
+func (elec *EventLogEntryCreate) SetAction(s string) *EventLogEntryCreate {
+	elec.mutation.SetAction(s)
+	return elec
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (elec *EventLogEntryCreate) SetNillableAction(s *string) *EventLogEntryCreate {
+	if s != nil {
+		elec.SetAction(*s)
+	}
+	return elec
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (elec *EventLogEntryCreate) SetTimestamp(t time.Time) *EventLogEntryCreate {
+	elec.mutation.SetTimestamp(t)
+	return elec
+}
+
+// SetTaskID sets the "task_id" field.
+func (elec *EventLogEntryCreate) SetTaskID(u uuid.UUID) *EventLogEntryCreate {
+	elec.mutation.SetTaskID(u)
+	return elec
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (elec *EventLogEntryCreate) SetNillableTaskID(u *uuid.UUID) *EventLogEntryCreate {
+	if u != nil {
+		elec.SetTaskID(*u)
+	}
+	return elec
+}
+
+// SetPayload sets the "payload" field.
+func (elec *EventLogEntryCreate) SetPayload(b []byte) *EventLogEntryCreate {
+	elec.mutation.SetPayload(b)
+	return elec
+}
+
+// SetID sets the "id" field.
+func (elec *EventLogEntryCreate) SetID(u uuid.UUID) *EventLogEntryCreate {
+	elec.mutation.SetID(u)
+	return elec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (elec *EventLogEntryCreate) SetNillableID(u *uuid.UUID) *EventLogEntryCreate {
+	if u != nil {
+		elec.SetID(*u)
+	}
+	return elec
+}
+
+// Mutation returns the EventLogEntryMutation object of the builder.
+func (elec *EventLogEntryCreate) Mutation() *EventLogEntryMutation {
+	return elec.mutation
+}
+
+// Save creates the EventLogEntry in the database.
+func (elec *EventLogEntryCreate) Save(ctx context.Context) (*EventLogEntry, error) {
+	elec.defaults()
+	return withHooks(ctx, elec.sqlSave, elec.mutation, elec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (elec *EventLogEntryCreate) SaveX(ctx context.Context) *EventLogEntry {
+	v, err := elec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (elec *EventLogEntryCreate) Exec(ctx context.Context) error {
+	_, err := elec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (elec *EventLogEntryCreate) ExecX(ctx context.Context) {
+	if err := elec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (elec *EventLogEntryCreate) defaults() {
+	if _, ok := elec.mutation.CreateTime(); !ok {
+		v := eventlogentry.DefaultCreateTime()
+		elec.mutation.SetCreateTime(v)
+	}
+	if _, ok := elec.mutation.UpdateTime(); !ok {
+		v := eventlogentry.DefaultUpdateTime()
+		elec.mutation.SetUpdateTime(v)
+	}
+	if _, ok := elec.mutation.ID(); !ok {
+		v := eventlogentry.DefaultID()
+		elec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (elec *EventLogEntryCreate) check() error {
+	if _, ok := elec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "EventLogEntry.create_time"`)}
+	}
+	if _, ok := elec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "EventLogEntry.update_time"`)}
+	}
+	if _, ok := elec.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`memory: missing required field "EventLogEntry.sequence"`)}
+	}
+	if _, ok := elec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`memory: missing required field "EventLogEntry.type"`)}
+	}
+	if _, ok := elec.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`memory: missing required field "EventLogEntry.timestamp"`)}
+	}
+	if _, ok := elec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`memory: missing required field "EventLogEntry.payload"`)}
+	}
+	return nil
+}
+
+func (elec *EventLogEntryCreate) sqlSave(ctx context.Context) (*EventLogEntry, error) {
+	if err := elec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := elec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, elec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	elec.mutation.id = &_node.ID
+	elec.mutation.done = true
+	return _node, nil
+}
+
+func (elec *EventLogEntryCreate) createSpec() (*EventLogEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventLogEntry{config: elec.config}
+		_spec = sqlgraph.NewCreateSpec(eventlogentry.Table, sqlgraph.NewFieldSpec(eventlogentry.FieldID, field.TypeUUID))
+	)
+	if id, ok := elec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := elec.mutation.CreateTime(); ok {
+		_spec.SetField(eventlogentry.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := elec.mutation.UpdateTime(); ok {
+		_spec.SetField(eventlogentry.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := elec.mutation.Sequence(); ok {
+		_spec.SetField(eventlogentry.FieldSequence, field.TypeInt64, value)
+		_node.Sequence = value
+	}
+	if value, ok := elec.mutation.GetType(); ok {
+		_spec.SetField(eventlogentry.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := elec.mutation.Action(); ok {
+		_spec.SetField(eventlogentry.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := elec.mutation.Timestamp(); ok {
+		_spec.SetField(eventlogentry.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := elec.mutation.TaskID(); ok {
+		_spec.SetField(eventlogentry.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = &value
+	}
+	if value, ok := elec.mutation.Payload(); ok {
+		_spec.SetField(eventlogentry.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	return _node, _spec
+}
+
+// EventLogEntryCreateBulk is the builder for creating many EventLogEntry entities in bulk.
+type EventLogEntryCreateBulk struct {
+	config
+	err      error
+	builders []*EventLogEntryCreate
+}
+
+// Save creates the EventLogEntry entities in the database.
+func (elecb *EventLogEntryCreateBulk) Save(ctx context.Context) ([]*EventLogEntry, error) {
+	if elecb.err != nil {
+		return nil, elecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(elecb.builders))
+	nodes := make([]*EventLogEntry, len(elecb.builders))
+	mutators := make([]Mutator, len(elecb.builders))
+	for i := range elecb.builders {
+		func(i int, root context.Context) {
+			builder := elecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventLogEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, elecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, elecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, elecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (elecb *EventLogEntryCreateBulk) SaveX(ctx context.Context) []*EventLogEntry {
+	v, err := elecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (elecb *EventLogEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := elecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (elecb *EventLogEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := elecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
+	"github.com/furisto/construct/backend/memory/predicate"
+)
+
+// EventLogEntryDelete is the builder for deleting a EventLogEntry entity.
+type EventLogEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *EventLogEntryMutation
+}
+
+// Where appends a list predicates to the EventLogEntryDelete builder.
+func (eled *EventLogEntryDelete) Where(ps ...predicate.EventLogEntry) *EventLogEntryDelete {
+	eled.mutation.Where(ps...)
+	return eled
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eled *EventLogEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eled.sqlExec, eled.mutation, eled.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eled *EventLogEntryDelete) ExecX(ctx context.Context) int {
+	n, err := eled.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eled *EventLogEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(eventlogentry.Table, sqlgraph.NewFieldSpec(eventlogentry.FieldID, field.TypeUUID))
+	if ps := eled.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eled.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eled.mutation.done = true
+	return affected, err
+}
+
+// EventLogEntryDeleteOne is the builder for deleting a single EventLogEntry entity.
+type EventLogEntryDeleteOne struct {
+	eled *EventLogEntryDelete
+}
+
+// Where appends a list predicates to the EventLogEntryDelete builder.
+func (eledo *EventLogEntryDeleteOne) Where(ps ...predicate.EventLogEntry) *EventLogEntryDeleteOne {
+	eledo.eled.mutation.Where(ps...)
+	return eledo
+}
+
+// Exec executes the deletion query.
+func (eledo *EventLogEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := eledo.eled.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{eventlogentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eledo *EventLogEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := eledo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,551 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/google/uuid"
+)
+
+// EventLogEntryQuery is the builder for querying EventLogEntry entities.
+type EventLogEntryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []eventlogentry.OrderOption
+	inters     []Interceptor
+	predicates []predicate.EventLogEntry
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the EventLogEntryQuery builder.
+func (eleq *EventLogEntryQuery) Where(ps ...predicate.EventLogEntry) *EventLogEntryQuery {
+	eleq.predicates = append(eleq.predicates, ps...)
+	return eleq
+}
+
+// Limit the number of records to be returned by this query.
+func (eleq *EventLogEntryQuery) Limit(limit int) *EventLogEntryQuery {
+	eleq.ctx.Limit = &limit
+	return eleq
+}
+
+// Offset to start from.
+func (eleq *EventLogEntryQuery) Offset(offset int) *EventLogEntryQuery {
+	eleq.ctx.Offset = &offset
+	return eleq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eleq *EventLogEntryQuery) Unique(unique bool) *EventLogEntryQuery {
+	eleq.ctx.Unique = &unique
+	return eleq
+}
+
+// Order specifies how the records should be ordered.
+func (eleq *EventLogEntryQuery) Order(o ...eventlogentry.OrderOption) *EventLogEntryQuery {
+	eleq.order = append(eleq.order, o...)
+	return eleq
+}
+
+// First returns the first EventLogEntry entity from the query.
+// Returns a *NotFoundError when no EventLogEntry was found.
+func (eleq *EventLogEntryQuery) First(ctx context.Context) (*EventLogEntry, error) {
+	nodes, err := eleq.Limit(1).All(setContextOp(ctx, eleq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{eventlogentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) FirstX(ctx context.Context) *EventLogEntry {
+	node, err := eleq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first EventLogEntry ID from the query.
+// Returns a *NotFoundError when no EventLogEntry ID was found.
+func (eleq *EventLogEntryQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = eleq.Limit(1).IDs(setContextOp(ctx, eleq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{eventlogentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := eleq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single EventLogEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one EventLogEntry entity is found.
+// Returns a *NotFoundError when no EventLogEntry entities are found.
+func (eleq *EventLogEntryQuery) Only(ctx context.Context) (*EventLogEntry, error) {
+	nodes, err := eleq.Limit(2).All(setContextOp(ctx, eleq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{eventlogentry.Label}
+	default:
+		return nil, &NotSingularError{eventlogentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) OnlyX(ctx context.Context) *EventLogEntry {
+	node, err := eleq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only EventLogEntry ID in the query.
+// Returns a *NotSingularError when more than one EventLogEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eleq *EventLogEntryQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = eleq.Limit(2).IDs(setContextOp(ctx, eleq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{eventlogentry.Label}
+	default:
+		err = &NotSingularError{eventlogentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := eleq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of EventLogEntries.
+func (eleq *EventLogEntryQuery) All(ctx context.Context) ([]*EventLogEntry, error) {
+	ctx = setContextOp(ctx, eleq.ctx, ent.OpQueryAll)
+	if err := eleq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*EventLogEntry, *EventLogEntryQuery]()
+	return withInterceptors[[]*EventLogEntry](ctx, eleq, qr, eleq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) AllX(ctx context.Context) []*EventLogEntry {
+	nodes, err := eleq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of EventLogEntry IDs.
+func (eleq *EventLogEntryQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if eleq.ctx.Unique == nil && eleq.path != nil {
+		eleq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eleq.ctx, ent.OpQueryIDs)
+	if err = eleq.Select(eventlogentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := eleq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eleq *EventLogEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eleq.ctx, ent.OpQueryCount)
+	if err := eleq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eleq, querierCount[*EventLogEntryQuery](), eleq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) CountX(ctx context.Context) int {
+	count, err := eleq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eleq *EventLogEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eleq.ctx, ent.OpQueryExist)
+	switch _, err := eleq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("memory: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eleq *EventLogEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := eleq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the EventLogEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eleq *EventLogEntryQuery) Clone() *EventLogEntryQuery {
+	if eleq == nil {
+		return nil
+	}
+	return &EventLogEntryQuery{
+		config:     eleq.config,
+		ctx:        eleq.ctx.Clone(),
+		order:      append([]eventlogentry.OrderOption{}, eleq.order...),
+		inters:     append([]Interceptor{}, eleq.inters...),
+		predicates: append([]predicate.EventLogEntry{}, eleq.predicates...),
+		// clone intermediate query.
+		sql:       eleq.sql.Clone(),
+		path:      eleq.path,
+		modifiers: append([]func(*sql.Selector){}, eleq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.EventLogEntry.Query().
+//		GroupBy(eventlogentry.FieldCreateTime).
+//		Aggregate(memory.Count()).
+//		Scan(ctx, &v)
+func (eleq *EventLogEntryQuery) GroupBy(field string, fields ...string) *EventLogEntryGroupBy {
+	eleq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &EventLogEntryGroupBy{build: eleq}
+	grbuild.flds = &eleq.ctx.Fields
+	grbuild.label = eventlogentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.EventLogEntry.Query().
+//		Select(eventlogentry.FieldCreateTime).
+//		Scan(ctx, &v)
+func (eleq *EventLogEntryQuery) Select(fields ...string) *EventLogEntrySelect {
+	eleq.ctx.Fields = append(eleq.ctx.Fields, fields...)
+	sbuild := &EventLogEntrySelect{EventLogEntryQuery: eleq}
+	sbuild.label = eventlogentry.Label
+	sbuild.flds, sbuild.scan = &eleq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a EventLogEntrySelect configured with the given aggregations.
+func (eleq *EventLogEntryQuery) Aggregate(fns ...AggregateFunc) *EventLogEntrySelect {
+	return eleq.Select().Aggregate(fns...)
+}
+
+func (eleq *EventLogEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eleq.inters {
+		if inter == nil {
+			return fmt.Errorf("memory: uninitialized interceptor (forgotten import memory/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eleq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eleq.ctx.Fields {
+		if !eventlogentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+		}
+	}
+	if eleq.path != nil {
+		prev, err := eleq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eleq.sql = prev
+	}
+	return nil
+}
+
+func (eleq *EventLogEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*EventLogEntry, error) {
+	var (
+		nodes = []*EventLogEntry{}
+		_spec = eleq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*EventLogEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &EventLogEntry{config: eleq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(eleq.modifiers) > 0 {
+		_spec.Modifiers = eleq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eleq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (eleq *EventLogEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eleq.querySpec()
+	if len(eleq.modifiers) > 0 {
+		_spec.Modifiers = eleq.modifiers
+	}
+	_spec.Node.Columns = eleq.ctx.Fields
+	if len(eleq.ctx.Fields) > 0 {
+		_spec.Unique = eleq.ctx.Unique != nil && *eleq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eleq.driver, _spec)
+}
+
+func (eleq *EventLogEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(eventlogentry.Table, eventlogentry.Columns, sqlgraph.NewFieldSpec(eventlogentry.FieldID, field.TypeUUID))
+	_spec.From = eleq.sql
+	if unique := eleq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eleq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eleq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventlogentry.FieldID)
+		for i := range fields {
+			if fields[i] != eventlogentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eleq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eleq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eleq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eleq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eleq *EventLogEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eleq.driver.Dialect())
+	t1 := builder.Table(eventlogentry.Table)
+	columns := eleq.ctx.Fields
+	if len(columns) == 0 {
+		columns = eventlogentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eleq.sql != nil {
+		selector = eleq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eleq.ctx.Unique != nil && *eleq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range eleq.modifiers {
+		m(selector)
+	}
+	for _, p := range eleq.predicates {
+		p(selector)
+	}
+	for _, p := range eleq.order {
+		p(selector)
+	}
+	if offset := eleq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eleq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (eleq *EventLogEntryQuery) Modify(modifiers ...func(s *sql.Selector)) *EventLogEntrySelect {
+	eleq.modifiers = append(eleq.modifiers, modifiers...)
+	return eleq.Select()
+}
+
+// EventLogEntryGroupBy is the group-by builder for EventLogEntry entities.
+type EventLogEntryGroupBy struct {
+	selector
+	build *EventLogEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (elegb *EventLogEntryGroupBy) Aggregate(fns ...AggregateFunc) *EventLogEntryGroupBy {
+	elegb.fns = append(elegb.fns, fns...)
+	return elegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (elegb *EventLogEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, elegb.build.ctx, ent.OpQueryGroupBy)
+	if err := elegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EventLogEntryQuery, *EventLogEntryGroupBy](ctx, elegb.build, elegb, elegb.build.inters, v)
+}
+
+func (elegb *EventLogEntryGroupBy) sqlScan(ctx context.Context, root *EventLogEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(elegb.fns))
+	for _, fn := range elegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*elegb.flds)+len(elegb.fns))
+		for _, f := range *elegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*elegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := elegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// EventLogEntrySelect is the builder for selecting fields of EventLogEntry entities.
+type EventLogEntrySelect struct {
+	*EventLogEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eles *EventLogEntrySelect) Aggregate(fns ...AggregateFunc) *EventLogEntrySelect {
+	eles.fns = append(eles.fns, fns...)
+	return eles
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eles *EventLogEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eles.ctx, ent.OpQuerySelect)
+	if err := eles.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*EventLogEntryQuery, *EventLogEntrySelect](ctx, eles.EventLogEntryQuery, eles, eles.inters, v)
+}
+
+func (eles *EventLogEntrySelect) sqlScan(ctx context.Context, root *EventLogEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eles.fns))
+	for _, fn := range eles.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eles.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eles.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (eles *EventLogEntrySelect) Modify(modifiers ...func(s *sql.Selector)) *EventLogEntrySelect {
+	eles.modifiers = append(eles.modifiers, modifiers...)
+	return eles
+}
@@ -0,0 +1,240 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
+	"github.com/furisto/construct/backend/memory/predicate"
+)
+
+// EventLogEntryUpdate is the builder for updating EventLogEntry entities.
+type EventLogEntryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *EventLogEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the EventLogEntryUpdate builder.
+func (eleu *EventLogEntryUpdate) Where(ps ...predicate.EventLogEntry) *EventLogEntryUpdate {
+	eleu.mutation.Where(ps...)
+	return eleu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (eleu *EventLogEntryUpdate) SetUpdateTime(t time.Time) *EventLogEntryUpdate {
+	eleu.mutation.SetUpdateTime(t)
+	return eleu
+}
+
+// Mutation returns the EventLogEntryMutation object of the builder.
+func (eleu *EventLogEntryUpdate) Mutation() *EventLogEntryMutation {
+	return eleu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eleu *EventLogEntryUpdate) Save(ctx context.Context) (int, error) {
+	eleu.defaults()
+	return withHooks(ctx, eleu.sqlSave, eleu.mutation, eleu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eleu *EventLogEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := eleu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eleu *EventLogEntryUpdate) Exec(ctx context.Context) error {
+	_, err := eleu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eleu *EventLogEntryUpdate) ExecX(ctx context.Context) {
+	if err := eleu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eleu *EventLogEntryUpdate) defaults() {
+	if _, ok := eleu.mutation.UpdateTime(); !ok {
+		v := eventlogentry.UpdateDefaultUpdateTime()
+		eleu.mutation.SetUpdateTime(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (eleu *EventLogEntryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventLogEntryUpdate {
+	eleu.modifiers = append(eleu.modifiers, modifiers...)
+	return eleu
+}
+
+func (eleu *EventLogEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventlogentry.Table, eventlogentry.Columns, sqlgraph.NewFieldSpec(eventlogentry.FieldID, field.TypeUUID))
+	if ps := eleu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eleu.mutation.UpdateTime(); ok {
+		_spec.SetField(eventlogentry.FieldUpdateTime, field.TypeTime, value)
+	}
+	if eleu.mutation.ActionCleared() {
+		_spec.ClearField(eventlogentry.FieldAction, field.TypeString)
+	}
+	if eleu.mutation.TaskIDCleared() {
+		_spec.ClearField(eventlogentry.FieldTaskID, field.TypeUUID)
+	}
+	_spec.AddModifiers(eleu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, eleu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventlogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eleu.mutation.done = true
+	return n, nil
+}
+
+// EventLogEntryUpdateOne is the builder for updating a single EventLogEntry entity.
+type EventLogEntryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *EventLogEntryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (eleuo *EventLogEntryUpdateOne) SetUpdateTime(t time.Time) *EventLogEntryUpdateOne {
+	eleuo.mutation.SetUpdateTime(t)
+	return eleuo
+}
+
+// Mutation returns the EventLogEntryMutation object of the builder.
+func (eleuo *EventLogEntryUpdateOne) Mutation() *EventLogEntryMutation {
+	return eleuo.mutation
+}
+
+// Where appends a list predicates to the EventLogEntryUpdate builder.
+func (eleuo *EventLogEntryUpdateOne) Where(ps ...predicate.EventLogEntry) *EventLogEntryUpdateOne {
+	eleuo.mutation.Where(ps...)
+	return eleuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eleuo *EventLogEntryUpdateOne) Select(field string, fields ...string) *EventLogEntryUpdateOne {
+	eleuo.fields = append([]string{field}, fields...)
+	return eleuo
+}
+
+// Save executes the query and returns the updated EventLogEntry entity.
+func (eleuo *EventLogEntryUpdateOne) Save(ctx context.Context) (*EventLogEntry, error) {
+	eleuo.defaults()
+	return withHooks(ctx, eleuo.sqlSave, eleuo.mutation, eleuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eleuo *EventLogEntryUpdateOne) SaveX(ctx context.Context) *EventLogEntry {
+	node, err := eleuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eleuo *EventLogEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := eleuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eleuo *EventLogEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := eleuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eleuo *EventLogEntryUpdateOne) defaults() {
+	if _, ok := eleuo.mutation.UpdateTime(); !ok {
+		v := eventlogentry.UpdateDefaultUpdateTime()
+		eleuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (eleuo *EventLogEntryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *EventLogEntryUpdateOne {
+	eleuo.modifiers = append(eleuo.modifiers, modifiers...)
+	return eleuo
+}
+
+func (eleuo *EventLogEntryUpdateOne) sqlSave(ctx context.Context) (_node *EventLogEntry, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventlogentry.Table, eventlogentry.Columns, sqlgraph.NewFieldSpec(eventlogentry.FieldID, field.TypeUUID))
+	id, ok := eleuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`memory: missing "EventLogEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eleuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventlogentry.FieldID)
+		for _, f := range fields {
+			if !eventlogentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+			}
+			if f != eventlogentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eleuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eleuo.mutation.UpdateTime(); ok {
+		_spec.SetField(eventlogentry.FieldUpdateTime, field.TypeTime, value)
+	}
+	if eleuo.mutation.ActionCleared() {
+		_spec.ClearField(eventlogentry.FieldAction, field.TypeString)
+	}
+	if eleuo.mutation.TaskIDCleared() {
+		_spec.ClearField(eventlogentry.FieldTaskID, field.TypeUUID)
+	}
+	_spec.AddModifiers(eleuo.modifiers...)
+	_node = &EventLogEntry{config: eleuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eleuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventlogentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eleuo.mutation.done = true
+	return _node, nil
+}
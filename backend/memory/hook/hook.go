@@ -21,6 +21,30 @@ func (f AgentFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.AgentMutation", m)
 }
 
+// The AuditEventFunc type is an adapter to allow the use of ordinary
+// function as AuditEvent mutator.
+type AuditEventFunc func(context.Context, *memory.AuditEventMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditEventFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.AuditEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.AuditEventMutation", m)
+}
+
+// The EventLogEntryFunc type is an adapter to allow the use of ordinary
+// function as EventLogEntry mutator.
+type EventLogEntryFunc func(context.Context, *memory.EventLogEntryMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventLogEntryFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.EventLogEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.EventLogEntryMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *memory.MessageMutation) (memory.Value, error)
@@ -45,6 +69,18 @@ func (f ModelFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ModelMutation", m)
 }
 
+// The ModelCallTraceFunc type is an adapter to allow the use of ordinary
+// function as ModelCallTrace mutator.
+type ModelCallTraceFunc func(context.Context, *memory.ModelCallTraceMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModelCallTraceFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ModelCallTraceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ModelCallTraceMutation", m)
+}
+
 // The ModelProviderFunc type is an adapter to allow the use of ordinary
 // function as ModelProvider mutator.
 type ModelProviderFunc func(context.Context, *memory.ModelProviderMutation) (memory.Value, error)
@@ -57,6 +93,18 @@ func (f ModelProviderFunc) Mutate(ctx context.Context, m memory.Mutation) (memor
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ModelProviderMutation", m)
 }
 
+// The ModelProviderKeyFunc type is an adapter to allow the use of ordinary
+// function as ModelProviderKey mutator.
+type ModelProviderKeyFunc func(context.Context, *memory.ModelProviderKeyMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModelProviderKeyFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.ModelProviderKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.ModelProviderKeyMutation", m)
+}
+
 // The TaskFunc type is an adapter to allow the use of ordinary
 // function as Task mutator.
 type TaskFunc func(context.Context, *memory.TaskMutation) (memory.Value, error)
@@ -81,6 +129,30 @@ func (f TokenFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.TokenMutation", m)
 }
 
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *memory.WebhookMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.WebhookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.WebhookMutation", m)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *memory.WebhookDeliveryMutation) (memory.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m memory.Mutation) (memory.Value, error) {
+	if mv, ok := m.(*memory.WebhookDeliveryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *memory.WebhookDeliveryMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, memory.Mutation) bool
 
@@ -33,8 +33,14 @@ type Message struct {
 	Content *types.MessageContent `json:"content,omitempty"`
 	// Usage holds the value of the "usage" field.
 	Usage *types.MessageUsage `json:"usage,omitempty"`
+	// Routing holds the value of the "routing" field.
+	Routing *types.MessageRouting `json:"routing,omitempty"`
 	// ProcessedTime holds the value of the "processed_time" field.
 	ProcessedTime time.Time `json:"processed_time,omitempty"`
+	// TraceID holds the value of the "trace_id" field.
+	TraceID string `json:"trace_id,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
 	// TaskID holds the value of the "task_id" field.
 	TaskID uuid.UUID `json:"task_id,omitempty"`
 	// AgentID holds the value of the "agent_id" field.
@@ -98,9 +104,9 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldContent, message.FieldUsage:
+		case message.FieldContent, message.FieldUsage, message.FieldRouting:
 			values[i] = new([]byte)
-		case message.FieldSource:
+		case message.FieldSource, message.FieldTraceID, message.FieldOwner:
 			values[i] = new(sql.NullString)
 		case message.FieldCreateTime, message.FieldUpdateTime, message.FieldProcessedTime:
 			values[i] = new(sql.NullTime)
@@ -161,12 +167,32 @@ func (m *Message) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field usage: %w", err)
 				}
 			}
+		case message.FieldRouting:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field routing", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Routing); err != nil {
+					return fmt.Errorf("unmarshal field routing: %w", err)
+				}
+			}
 		case message.FieldProcessedTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field processed_time", values[i])
 			} else if value.Valid {
 				m.ProcessedTime = value.Time
 			}
+		case message.FieldTraceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_id", values[i])
+			} else if value.Valid {
+				m.TraceID = value.String
+			}
+		case message.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				m.Owner = value.String
+			}
 		case message.FieldTaskID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field task_id", values[i])
@@ -251,9 +277,18 @@ func (m *Message) String() string {
 	builder.WriteString("usage=")
 	builder.WriteString(fmt.Sprintf("%v", m.Usage))
 	builder.WriteString(", ")
+	builder.WriteString("routing=")
+	builder.WriteString(fmt.Sprintf("%v", m.Routing))
+	builder.WriteString(", ")
 	builder.WriteString("processed_time=")
 	builder.WriteString(m.ProcessedTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("trace_id=")
+	builder.WriteString(m.TraceID)
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(m.Owner)
+	builder.WriteString(", ")
 	builder.WriteString("task_id=")
 	builder.WriteString(fmt.Sprintf("%v", m.TaskID))
 	builder.WriteString(", ")
@@ -27,8 +27,14 @@ const (
 	FieldContent = "content"
 	// FieldUsage holds the string denoting the usage field in the database.
 	FieldUsage = "usage"
+	// FieldRouting holds the string denoting the routing field in the database.
+	FieldRouting = "routing"
 	// FieldProcessedTime holds the string denoting the processed_time field in the database.
 	FieldProcessedTime = "processed_time"
+	// FieldTraceID holds the string denoting the trace_id field in the database.
+	FieldTraceID = "trace_id"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
 	// FieldTaskID holds the string denoting the task_id field in the database.
 	FieldTaskID = "task_id"
 	// FieldAgentID holds the string denoting the agent_id field in the database.
@@ -74,7 +80,10 @@ var Columns = []string{
 	FieldSource,
 	FieldContent,
 	FieldUsage,
+	FieldRouting,
 	FieldProcessedTime,
+	FieldTraceID,
+	FieldOwner,
 	FieldTaskID,
 	FieldAgentID,
 	FieldModelID,
@@ -139,6 +148,16 @@ func ByProcessedTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProcessedTime, opts...).ToFunc()
 }
 
+// ByTraceID orders the results by the trace_id field.
+func ByTraceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTraceID, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
 // ByTaskID orders the results by the task_id field.
 func ByTaskID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTaskID, opts...).ToFunc()
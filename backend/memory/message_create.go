@@ -71,6 +71,12 @@ func (mc *MessageCreate) SetUsage(tu *types.MessageUsage) *MessageCreate {
 	return mc
 }
 
+// SetRouting sets the "routing" field.
+func (mc *MessageCreate) SetRouting(tr *types.MessageRouting) *MessageCreate {
+	mc.mutation.SetRouting(tr)
+	return mc
+}
+
 // SetProcessedTime sets the "processed_time" field.
 func (mc *MessageCreate) SetProcessedTime(t time.Time) *MessageCreate {
 	mc.mutation.SetProcessedTime(t)
@@ -85,6 +91,34 @@ func (mc *MessageCreate) SetNillableProcessedTime(t *time.Time) *MessageCreate {
 	return mc
 }
 
+// SetTraceID sets the "trace_id" field.
+func (mc *MessageCreate) SetTraceID(s string) *MessageCreate {
+	mc.mutation.SetTraceID(s)
+	return mc
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableTraceID(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetTraceID(*s)
+	}
+	return mc
+}
+
+// SetOwner sets the "owner" field.
+func (mc *MessageCreate) SetOwner(s string) *MessageCreate {
+	mc.mutation.SetOwner(s)
+	return mc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableOwner(s *string) *MessageCreate {
+	if s != nil {
+		mc.SetOwner(*s)
+	}
+	return mc
+}
+
 // SetTaskID sets the "task_id" field.
 func (mc *MessageCreate) SetTaskID(u uuid.UUID) *MessageCreate {
 	mc.mutation.SetTaskID(u)
@@ -277,10 +311,22 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldUsage, field.TypeJSON, value)
 		_node.Usage = value
 	}
+	if value, ok := mc.mutation.Routing(); ok {
+		_spec.SetField(message.FieldRouting, field.TypeJSON, value)
+		_node.Routing = value
+	}
 	if value, ok := mc.mutation.ProcessedTime(); ok {
 		_spec.SetField(message.FieldProcessedTime, field.TypeTime, value)
 		_node.ProcessedTime = value
 	}
+	if value, ok := mc.mutation.TraceID(); ok {
+		_spec.SetField(message.FieldTraceID, field.TypeString, value)
+		_node.TraceID = value
+	}
+	if value, ok := mc.mutation.Owner(); ok {
+		_spec.SetField(message.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
 	if nodes := mc.mutation.TaskIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -72,6 +72,18 @@ func (mu *MessageUpdate) ClearUsage() *MessageUpdate {
 	return mu
 }
 
+// SetRouting sets the "routing" field.
+func (mu *MessageUpdate) SetRouting(tr *types.MessageRouting) *MessageUpdate {
+	mu.mutation.SetRouting(tr)
+	return mu
+}
+
+// ClearRouting clears the value of the "routing" field.
+func (mu *MessageUpdate) ClearRouting() *MessageUpdate {
+	mu.mutation.ClearRouting()
+	return mu
+}
+
 // SetProcessedTime sets the "processed_time" field.
 func (mu *MessageUpdate) SetProcessedTime(t time.Time) *MessageUpdate {
 	mu.mutation.SetProcessedTime(t)
@@ -92,6 +104,46 @@ func (mu *MessageUpdate) ClearProcessedTime() *MessageUpdate {
 	return mu
 }
 
+// SetTraceID sets the "trace_id" field.
+func (mu *MessageUpdate) SetTraceID(s string) *MessageUpdate {
+	mu.mutation.SetTraceID(s)
+	return mu
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableTraceID(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetTraceID(*s)
+	}
+	return mu
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (mu *MessageUpdate) ClearTraceID() *MessageUpdate {
+	mu.mutation.ClearTraceID()
+	return mu
+}
+
+// SetOwner sets the "owner" field.
+func (mu *MessageUpdate) SetOwner(s string) *MessageUpdate {
+	mu.mutation.SetOwner(s)
+	return mu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableOwner(s *string) *MessageUpdate {
+	if s != nil {
+		mu.SetOwner(*s)
+	}
+	return mu
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (mu *MessageUpdate) ClearOwner() *MessageUpdate {
+	mu.mutation.ClearOwner()
+	return mu
+}
+
 // SetTaskID sets the "task_id" field.
 func (mu *MessageUpdate) SetTaskID(u uuid.UUID) *MessageUpdate {
 	mu.mutation.SetTaskID(u)
@@ -266,12 +318,30 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.UsageCleared() {
 		_spec.ClearField(message.FieldUsage, field.TypeJSON)
 	}
+	if value, ok := mu.mutation.Routing(); ok {
+		_spec.SetField(message.FieldRouting, field.TypeJSON, value)
+	}
+	if mu.mutation.RoutingCleared() {
+		_spec.ClearField(message.FieldRouting, field.TypeJSON)
+	}
 	if value, ok := mu.mutation.ProcessedTime(); ok {
 		_spec.SetField(message.FieldProcessedTime, field.TypeTime, value)
 	}
 	if mu.mutation.ProcessedTimeCleared() {
 		_spec.ClearField(message.FieldProcessedTime, field.TypeTime)
 	}
+	if value, ok := mu.mutation.TraceID(); ok {
+		_spec.SetField(message.FieldTraceID, field.TypeString, value)
+	}
+	if mu.mutation.TraceIDCleared() {
+		_spec.ClearField(message.FieldTraceID, field.TypeString)
+	}
+	if value, ok := mu.mutation.Owner(); ok {
+		_spec.SetField(message.FieldOwner, field.TypeString, value)
+	}
+	if mu.mutation.OwnerCleared() {
+		_spec.ClearField(message.FieldOwner, field.TypeString)
+	}
 	if mu.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -419,6 +489,18 @@ func (muo *MessageUpdateOne) ClearUsage() *MessageUpdateOne {
 	return muo
 }
 
+// SetRouting sets the "routing" field.
+func (muo *MessageUpdateOne) SetRouting(tr *types.MessageRouting) *MessageUpdateOne {
+	muo.mutation.SetRouting(tr)
+	return muo
+}
+
+// ClearRouting clears the value of the "routing" field.
+func (muo *MessageUpdateOne) ClearRouting() *MessageUpdateOne {
+	muo.mutation.ClearRouting()
+	return muo
+}
+
 // SetProcessedTime sets the "processed_time" field.
 func (muo *MessageUpdateOne) SetProcessedTime(t time.Time) *MessageUpdateOne {
 	muo.mutation.SetProcessedTime(t)
@@ -439,6 +521,46 @@ func (muo *MessageUpdateOne) ClearProcessedTime() *MessageUpdateOne {
 	return muo
 }
 
+// SetTraceID sets the "trace_id" field.
+func (muo *MessageUpdateOne) SetTraceID(s string) *MessageUpdateOne {
+	muo.mutation.SetTraceID(s)
+	return muo
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableTraceID(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetTraceID(*s)
+	}
+	return muo
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (muo *MessageUpdateOne) ClearTraceID() *MessageUpdateOne {
+	muo.mutation.ClearTraceID()
+	return muo
+}
+
+// SetOwner sets the "owner" field.
+func (muo *MessageUpdateOne) SetOwner(s string) *MessageUpdateOne {
+	muo.mutation.SetOwner(s)
+	return muo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableOwner(s *string) *MessageUpdateOne {
+	if s != nil {
+		muo.SetOwner(*s)
+	}
+	return muo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (muo *MessageUpdateOne) ClearOwner() *MessageUpdateOne {
+	muo.mutation.ClearOwner()
+	return muo
+}
+
 // SetTaskID sets the "task_id" field.
 func (muo *MessageUpdateOne) SetTaskID(u uuid.UUID) *MessageUpdateOne {
 	muo.mutation.SetTaskID(u)
@@ -643,12 +765,30 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if muo.mutation.UsageCleared() {
 		_spec.ClearField(message.FieldUsage, field.TypeJSON)
 	}
+	if value, ok := muo.mutation.Routing(); ok {
+		_spec.SetField(message.FieldRouting, field.TypeJSON, value)
+	}
+	if muo.mutation.RoutingCleared() {
+		_spec.ClearField(message.FieldRouting, field.TypeJSON)
+	}
 	if value, ok := muo.mutation.ProcessedTime(); ok {
 		_spec.SetField(message.FieldProcessedTime, field.TypeTime, value)
 	}
 	if muo.mutation.ProcessedTimeCleared() {
 		_spec.ClearField(message.FieldProcessedTime, field.TypeTime)
 	}
+	if value, ok := muo.mutation.TraceID(); ok {
+		_spec.SetField(message.FieldTraceID, field.TypeString, value)
+	}
+	if muo.mutation.TraceIDCleared() {
+		_spec.ClearField(message.FieldTraceID, field.TypeString)
+	}
+	if value, ok := muo.mutation.Owner(); ok {
+		_spec.SetField(message.FieldOwner, field.TypeString, value)
+	}
+	if muo.mutation.OwnerCleared() {
+		_spec.ClearField(message.FieldOwner, field.TypeString)
+	}
 	if muo.mutation.TaskCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -18,6 +18,10 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "instructions", Type: field.TypeString},
 		{Name: "builtin", Type: field.TypeBool, Default: false},
+		{Name: "fallback_model_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "model_router", Type: field.TypeJSON, Nullable: true},
+		{Name: "redaction", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
 		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// AgentsTable holds the schema information for the "agents" table.
@@ -28,7 +32,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "agents_models_model",
-				Columns:    []*schema.Column{AgentsColumns[7]},
+				Columns:    []*schema.Column{AgentsColumns[11]},
 				RefColumns: []*schema.Column{ModelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -41,6 +45,70 @@ var (
 			},
 		},
 	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"api", "tool"}},
+		{Name: "action", Type: field.TypeString},
+		{Name: "subject", Type: field.TypeString, Nullable: true},
+		{Name: "auth_method", Type: field.TypeString, Nullable: true},
+		{Name: "resource_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "outcome", Type: field.TypeEnum, Enums: []string{"success", "failure"}},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_subject",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[5]},
+			},
+			{
+				Name:    "auditevent_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[10]},
+			},
+		},
+	}
+	// EventLogEntriesColumns holds the columns for the "event_log_entries" table.
+	EventLogEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "sequence", Type: field.TypeInt64, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "payload", Type: field.TypeBytes},
+	}
+	// EventLogEntriesTable holds the schema information for the "event_log_entries" table.
+	EventLogEntriesTable = &schema.Table{
+		Name:       "event_log_entries",
+		Columns:    EventLogEntriesColumns,
+		PrimaryKey: []*schema.Column{EventLogEntriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventlogentry_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{EventLogEntriesColumns[1]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -49,7 +117,10 @@ var (
 		{Name: "source", Type: field.TypeEnum, Enums: []string{"user", "assistant", "system"}},
 		{Name: "content", Type: field.TypeJSON},
 		{Name: "usage", Type: field.TypeJSON, Nullable: true},
+		{Name: "routing", Type: field.TypeJSON, Nullable: true},
 		{Name: "processed_time", Type: field.TypeTime, Nullable: true},
+		{Name: "trace_id", Type: field.TypeString, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
 		{Name: "task_id", Type: field.TypeUUID},
 		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
@@ -62,19 +133,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_tasks_task",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[10]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "messages_agents_agent",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[11]},
 				RefColumns: []*schema.Column{AgentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "messages_models_model",
-				Columns:    []*schema.Column{MessagesColumns[9]},
+				Columns:    []*schema.Column{MessagesColumns[12]},
 				RefColumns: []*schema.Column{ModelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -93,7 +164,7 @@ var (
 			{
 				Name:    "message_task_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[7]},
+				Columns: []*schema.Column{MessagesColumns[10]},
 			},
 		},
 	}
@@ -111,6 +182,8 @@ var (
 		{Name: "cache_read_cost", Type: field.TypeFloat64, Default: 0},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
 		{Name: "alias", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeEnum, Enums: []string{"catalog", "user"}, Default: "catalog"},
+		{Name: "overrides", Type: field.TypeJSON, Nullable: true},
 		{Name: "model_provider_id", Type: field.TypeUUID},
 	}
 	// ModelsTable holds the schema information for the "models" table.
@@ -121,7 +194,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "models_model_providers_models",
-				Columns:    []*schema.Column{ModelsColumns[12]},
+				Columns:    []*schema.Column{ModelsColumns[14]},
 				RefColumns: []*schema.Column{ModelProvidersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -130,7 +203,52 @@ var (
 			{
 				Name:    "model_name_model_provider_id",
 				Unique:  true,
-				Columns: []*schema.Column{ModelsColumns[3], ModelsColumns[12]},
+				Columns: []*schema.Column{ModelsColumns[3], ModelsColumns[14]},
+			},
+		},
+	}
+	// ModelCallTracesColumns holds the columns for the "model_call_traces" table.
+	ModelCallTracesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "model_name", Type: field.TypeString},
+		{Name: "model_provider_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "method", Type: field.TypeString},
+		{Name: "url", Type: field.TypeString},
+		{Name: "request_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "request_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "response_headers", Type: field.TypeJSON, Nullable: true},
+		{Name: "response_body", Type: field.TypeBytes, Nullable: true},
+		{Name: "truncated", Type: field.TypeBool, Default: false},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeInt64, Default: 0},
+		{Name: "task_id", Type: field.TypeUUID},
+	}
+	// ModelCallTracesTable holds the schema information for the "model_call_traces" table.
+	ModelCallTracesTable = &schema.Table{
+		Name:       "model_call_traces",
+		Columns:    ModelCallTracesColumns,
+		PrimaryKey: []*schema.Column{ModelCallTracesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "model_call_traces_tasks_task",
+				Columns:    []*schema.Column{ModelCallTracesColumns[15]},
+				RefColumns: []*schema.Column{TasksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "modelcalltrace_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{ModelCallTracesColumns[15]},
+			},
+			{
+				Name:    "modelcalltrace_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{ModelCallTracesColumns[1]},
 			},
 		},
 	}
@@ -140,10 +258,12 @@ var (
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString},
-		{Name: "provider_type", Type: field.TypeEnum, Enums: []string{"anthropic", "openai", "gemini", "xai"}},
+		{Name: "provider_type", Type: field.TypeEnum, Enums: []string{"anthropic", "openai", "gemini", "xai", "deepseek", "bedrock", "replay", "mock"}},
 		{Name: "url", Type: field.TypeString, Nullable: true},
 		{Name: "secret", Type: field.TypeBytes},
 		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "rate_limit", Type: field.TypeJSON, Nullable: true},
+		{Name: "key_selection", Type: field.TypeEnum, Enums: []string{"primary_backup", "round_robin", "least_recently_limited"}, Default: "primary_backup"},
 	}
 	// ModelProvidersTable holds the schema information for the "model_providers" table.
 	ModelProvidersTable = &schema.Table{
@@ -151,6 +271,46 @@ var (
 		Columns:    ModelProvidersColumns,
 		PrimaryKey: []*schema.Column{ModelProvidersColumns[0]},
 	}
+	// ModelProviderKeysColumns holds the columns for the "model_provider_keys" table.
+	ModelProviderKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "secret", Type: field.TypeBytes},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "retired_time", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_time", Type: field.TypeTime, Nullable: true},
+		{Name: "last_rate_limited_time", Type: field.TypeTime, Nullable: true},
+		{Name: "request_count", Type: field.TypeInt64, Default: 0},
+		{Name: "input_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "output_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "cache_write_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "cache_read_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "cost", Type: field.TypeFloat64, Default: 0},
+		{Name: "model_provider_id", Type: field.TypeUUID},
+	}
+	// ModelProviderKeysTable holds the schema information for the "model_provider_keys" table.
+	ModelProviderKeysTable = &schema.Table{
+		Name:       "model_provider_keys",
+		Columns:    ModelProviderKeysColumns,
+		PrimaryKey: []*schema.Column{ModelProviderKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "model_provider_keys_model_providers_model_provider",
+				Columns:    []*schema.Column{ModelProviderKeysColumns[15]},
+				RefColumns: []*schema.Column{ModelProvidersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "modelproviderkey_name_model_provider_id",
+				Unique:  true,
+				Columns: []*schema.Column{ModelProviderKeysColumns[3], ModelProviderKeysColumns[15]},
+			},
+		},
+	}
 	// TasksColumns holds the columns for the "tasks" table.
 	TasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -162,11 +322,16 @@ var (
 		{Name: "cache_write_tokens", Type: field.TypeInt64, Nullable: true},
 		{Name: "cache_read_tokens", Type: field.TypeInt64, Nullable: true},
 		{Name: "cost", Type: field.TypeFloat64, Nullable: true},
+		{Name: "routed_turns", Type: field.TypeInt64, Nullable: true},
+		{Name: "routing_savings", Type: field.TypeFloat64, Nullable: true},
 		{Name: "turns", Type: field.TypeInt64, Default: 0},
 		{Name: "tool_uses", Type: field.TypeJSON},
 		{Name: "desired_phase", Type: field.TypeEnum, Enums: []string{"unspecified", "running", "awaiting", "suspended"}, Default: "running"},
 		{Name: "phase", Type: field.TypeEnum, Enums: []string{"unspecified", "running", "awaiting", "suspended"}, Default: "awaiting"},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "trace_model_calls", Type: field.TypeBool, Default: false},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "shared_with", Type: field.TypeJSON, Nullable: true},
 		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TasksTable holds the schema information for the "tasks" table.
@@ -177,7 +342,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_agents_agent",
-				Columns:    []*schema.Column{TasksColumns[14]},
+				Columns:    []*schema.Column{TasksColumns[19]},
 				RefColumns: []*schema.Column{AgentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -193,6 +358,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TasksColumns[2]},
 			},
+			{
+				Name:    "task_owner",
+				Unique:  false,
+				Columns: []*schema.Column{TasksColumns[17]},
+			},
 		},
 	}
 	// TokensColumns holds the columns for the "tokens" table.
@@ -205,6 +375,15 @@ var (
 		{Name: "token_hash", Type: field.TypeBytes},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "agent_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "workspaces", Type: field.TypeJSON, Nullable: true},
+		{Name: "quota", Type: field.TypeJSON, Nullable: true},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "previous_token_hash", Type: field.TypeBytes, Nullable: true},
+		{Name: "previous_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_address", Type: field.TypeString, Nullable: true},
 	}
 	// TokensTable holds the schema information for the "tokens" table.
 	TokensTable = &schema.Table{
@@ -222,16 +401,90 @@ var (
 				Unique:  true,
 				Columns: []*schema.Column{TokensColumns[5]},
 			},
+			{
+				Name:    "token_previous_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{TokensColumns[13]},
+			},
+		},
+	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "url", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON, Nullable: true},
+		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "agent_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "secret", Type: field.TypeBytes},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "admin", Type: field.TypeBool, Default: false},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "event_sequence", Type: field.TypeInt64, Nullable: true},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "state", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "dead_letter"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_time", Type: field.TypeTime},
+		{Name: "last_attempt_time", Type: field.TypeTime, Nullable: true},
+		{Name: "response_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "webhook_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhooks_webhook",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_state_next_attempt_time",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[6], WebhookDeliveriesColumns[8]},
+			},
+			{
+				Name:    "webhookdelivery_webhook_id",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[12]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AgentsTable,
+		AuditEventsTable,
+		EventLogEntriesTable,
 		MessagesTable,
 		ModelsTable,
+		ModelCallTracesTable,
 		ModelProvidersTable,
+		ModelProviderKeysTable,
 		TasksTable,
 		TokensTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
@@ -245,5 +498,8 @@ func init() {
 		"agent_model": "(agent_id IS NULL OR agent_id IS NOT NULL AND model_id IS NOT NULL)",
 	}
 	ModelsTable.ForeignKeys[0].RefTable = ModelProvidersTable
+	ModelCallTracesTable.ForeignKeys[0].RefTable = TasksTable
+	ModelProviderKeysTable.ForeignKeys[0].RefTable = ModelProvidersTable
 	TasksTable.ForeignKeys[0].RefTable = AgentsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
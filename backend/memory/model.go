@@ -43,6 +43,10 @@ type Model struct {
 	Enabled bool `json:"enabled,omitempty"`
 	// Alias holds the value of the "alias" field.
 	Alias string `json:"alias,omitempty"`
+	// Source holds the value of the "source" field.
+	Source types.ModelSource `json:"source,omitempty"`
+	// Overrides holds the value of the "overrides" field.
+	Overrides []types.ModelField `json:"overrides,omitempty"`
 	// ModelProviderID holds the value of the "model_provider_id" field.
 	ModelProviderID uuid.UUID `json:"model_provider_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -98,7 +102,7 @@ func (*Model) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case model.FieldCapabilities:
+		case model.FieldCapabilities, model.FieldOverrides:
 			values[i] = new([]byte)
 		case model.FieldEnabled:
 			values[i] = new(sql.NullBool)
@@ -106,7 +110,7 @@ func (*Model) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case model.FieldContextWindow:
 			values[i] = new(sql.NullInt64)
-		case model.FieldName, model.FieldAlias:
+		case model.FieldName, model.FieldAlias, model.FieldSource:
 			values[i] = new(sql.NullString)
 		case model.FieldCreateTime, model.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -201,6 +205,20 @@ func (m *Model) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.Alias = value.String
 			}
+		case model.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				m.Source = types.ModelSource(value.String)
+			}
+		case model.FieldOverrides:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field overrides", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Overrides); err != nil {
+					return fmt.Errorf("unmarshal field overrides: %w", err)
+				}
+			}
 		case model.FieldModelProviderID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field model_provider_id", values[i])
@@ -291,6 +309,12 @@ func (m *Model) String() string {
 	builder.WriteString("alias=")
 	builder.WriteString(m.Alias)
 	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(fmt.Sprintf("%v", m.Source))
+	builder.WriteString(", ")
+	builder.WriteString("overrides=")
+	builder.WriteString(fmt.Sprintf("%v", m.Overrides))
+	builder.WriteString(", ")
 	builder.WriteString("model_provider_id=")
 	builder.WriteString(fmt.Sprintf("%v", m.ModelProviderID))
 	builder.WriteByte(')')
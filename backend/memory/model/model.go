@@ -3,10 +3,12 @@
 package model
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -37,6 +39,10 @@ const (
 	FieldEnabled = "enabled"
 	// FieldAlias holds the string denoting the alias field in the database.
 	FieldAlias = "alias"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldOverrides holds the string denoting the overrides field in the database.
+	FieldOverrides = "overrides"
 	// FieldModelProviderID holds the string denoting the model_provider_id field in the database.
 	FieldModelProviderID = "model_provider_id"
 	// EdgeAgents holds the string denoting the agents edge name in mutations.
@@ -84,6 +90,8 @@ var Columns = []string{
 	FieldCacheReadCost,
 	FieldEnabled,
 	FieldAlias,
+	FieldSource,
+	FieldOverrides,
 	FieldModelProviderID,
 }
 
@@ -126,6 +134,18 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+const DefaultSource types.ModelSource = "catalog"
+
+// SourceValidator is a validator for the "source" field enum values. It is called by the builders before save.
+func SourceValidator(s types.ModelSource) error {
+	switch s {
+	case "catalog", "user":
+		return nil
+	default:
+		return fmt.Errorf("model: invalid enum value for source field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Model queries.
 type OrderOption func(*sql.Selector)
 
@@ -184,6 +204,11 @@ func ByAlias(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAlias, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
 // ByModelProviderID orders the results by the model_provider_id field.
 func ByModelProviderID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldModelProviderID, opts...).ToFunc()
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -551,6 +552,46 @@ func AliasContainsFold(v string) predicate.Model {
 	return predicate.Model(sql.FieldContainsFold(FieldAlias, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v types.ModelSource) predicate.Model {
+	vc := v
+	return predicate.Model(sql.FieldEQ(FieldSource, vc))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v types.ModelSource) predicate.Model {
+	vc := v
+	return predicate.Model(sql.FieldNEQ(FieldSource, vc))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...types.ModelSource) predicate.Model {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Model(sql.FieldIn(FieldSource, v...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...types.ModelSource) predicate.Model {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.Model(sql.FieldNotIn(FieldSource, v...))
+}
+
+// OverridesIsNil applies the IsNil predicate on the "overrides" field.
+func OverridesIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldOverrides))
+}
+
+// OverridesNotNil applies the NotNil predicate on the "overrides" field.
+func OverridesNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldOverrides))
+}
+
 // ModelProviderIDEQ applies the EQ predicate on the "model_provider_id" field.
 func ModelProviderIDEQ(v uuid.UUID) predicate.Model {
 	return predicate.Model(sql.FieldEQ(FieldModelProviderID, v))
@@ -155,6 +155,26 @@ func (mc *ModelCreate) SetNillableAlias(s *string) *ModelCreate {
 	return mc
 }
 
+// SetSource sets the "source" field.
+func (mc *ModelCreate) SetSource(ts types.ModelSource) *ModelCreate {
+	mc.mutation.SetSource(ts)
+	return mc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableSource(ts *types.ModelSource) *ModelCreate {
+	if ts != nil {
+		mc.SetSource(*ts)
+	}
+	return mc
+}
+
+// SetOverrides sets the "overrides" field.
+func (mc *ModelCreate) SetOverrides(tf []types.ModelField) *ModelCreate {
+	mc.mutation.SetOverrides(tf)
+	return mc
+}
+
 // SetModelProviderID sets the "model_provider_id" field.
 func (mc *ModelCreate) SetModelProviderID(u uuid.UUID) *ModelCreate {
 	mc.mutation.SetModelProviderID(u)
@@ -273,6 +293,10 @@ func (mc *ModelCreate) defaults() {
 		v := model.DefaultEnabled
 		mc.mutation.SetEnabled(v)
 	}
+	if _, ok := mc.mutation.Source(); !ok {
+		v := model.DefaultSource
+		mc.mutation.SetSource(v)
+	}
 	if _, ok := mc.mutation.ID(); !ok {
 		v := model.DefaultID()
 		mc.mutation.SetID(v)
@@ -328,6 +352,14 @@ func (mc *ModelCreate) check() error {
 	if _, ok := mc.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`memory: missing required field "Model.enabled"`)}
 	}
+	if _, ok := mc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`memory: missing required field "Model.source"`)}
+	}
+	if v, ok := mc.mutation.Source(); ok {
+		if err := model.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`memory: validator failed for field "Model.source": %w`, err)}
+		}
+	}
 	if _, ok := mc.mutation.ModelProviderID(); !ok {
 		return &ValidationError{Name: "model_provider_id", err: errors.New(`memory: missing required field "Model.model_provider_id"`)}
 	}
@@ -413,6 +445,14 @@ func (mc *ModelCreate) createSpec() (*Model, *sqlgraph.CreateSpec) {
 		_spec.SetField(model.FieldAlias, field.TypeString, value)
 		_node.Alias = value
 	}
+	if value, ok := mc.mutation.Source(); ok {
+		_spec.SetField(model.FieldSource, field.TypeEnum, value)
+		_node.Source = value
+	}
+	if value, ok := mc.mutation.Overrides(); ok {
+		_spec.SetField(model.FieldOverrides, field.TypeJSON, value)
+		_node.Overrides = value
+	}
 	if nodes := mc.mutation.AgentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
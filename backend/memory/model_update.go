@@ -212,6 +212,38 @@ func (mu *ModelUpdate) ClearAlias() *ModelUpdate {
 	return mu
 }
 
+// SetSource sets the "source" field.
+func (mu *ModelUpdate) SetSource(ts types.ModelSource) *ModelUpdate {
+	mu.mutation.SetSource(ts)
+	return mu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableSource(ts *types.ModelSource) *ModelUpdate {
+	if ts != nil {
+		mu.SetSource(*ts)
+	}
+	return mu
+}
+
+// SetOverrides sets the "overrides" field.
+func (mu *ModelUpdate) SetOverrides(tf []types.ModelField) *ModelUpdate {
+	mu.mutation.SetOverrides(tf)
+	return mu
+}
+
+// AppendOverrides appends tf to the "overrides" field.
+func (mu *ModelUpdate) AppendOverrides(tf []types.ModelField) *ModelUpdate {
+	mu.mutation.AppendOverrides(tf)
+	return mu
+}
+
+// ClearOverrides clears the value of the "overrides" field.
+func (mu *ModelUpdate) ClearOverrides() *ModelUpdate {
+	mu.mutation.ClearOverrides()
+	return mu
+}
+
 // SetModelProviderID sets the "model_provider_id" field.
 func (mu *ModelUpdate) SetModelProviderID(u uuid.UUID) *ModelUpdate {
 	mu.mutation.SetModelProviderID(u)
@@ -372,6 +404,11 @@ func (mu *ModelUpdate) check() error {
 			return &ValidationError{Name: "cache_read_cost", err: fmt.Errorf(`memory: validator failed for field "Model.cache_read_cost": %w`, err)}
 		}
 	}
+	if v, ok := mu.mutation.Source(); ok {
+		if err := model.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`memory: validator failed for field "Model.source": %w`, err)}
+		}
+	}
 	if mu.mutation.ModelProviderCleared() && len(mu.mutation.ModelProviderIDs()) > 0 {
 		return errors.New(`memory: clearing a required unique edge "Model.model_provider"`)
 	}
@@ -452,6 +489,20 @@ func (mu *ModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.AliasCleared() {
 		_spec.ClearField(model.FieldAlias, field.TypeString)
 	}
+	if value, ok := mu.mutation.Source(); ok {
+		_spec.SetField(model.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Overrides(); ok {
+		_spec.SetField(model.FieldOverrides, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedOverrides(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, model.FieldOverrides, value)
+		})
+	}
+	if mu.mutation.OverridesCleared() {
+		_spec.ClearField(model.FieldOverrides, field.TypeJSON)
+	}
 	if mu.mutation.AgentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -770,6 +821,38 @@ func (muo *ModelUpdateOne) ClearAlias() *ModelUpdateOne {
 	return muo
 }
 
+// SetSource sets the "source" field.
+func (muo *ModelUpdateOne) SetSource(ts types.ModelSource) *ModelUpdateOne {
+	muo.mutation.SetSource(ts)
+	return muo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableSource(ts *types.ModelSource) *ModelUpdateOne {
+	if ts != nil {
+		muo.SetSource(*ts)
+	}
+	return muo
+}
+
+// SetOverrides sets the "overrides" field.
+func (muo *ModelUpdateOne) SetOverrides(tf []types.ModelField) *ModelUpdateOne {
+	muo.mutation.SetOverrides(tf)
+	return muo
+}
+
+// AppendOverrides appends tf to the "overrides" field.
+func (muo *ModelUpdateOne) AppendOverrides(tf []types.ModelField) *ModelUpdateOne {
+	muo.mutation.AppendOverrides(tf)
+	return muo
+}
+
+// ClearOverrides clears the value of the "overrides" field.
+func (muo *ModelUpdateOne) ClearOverrides() *ModelUpdateOne {
+	muo.mutation.ClearOverrides()
+	return muo
+}
+
 // SetModelProviderID sets the "model_provider_id" field.
 func (muo *ModelUpdateOne) SetModelProviderID(u uuid.UUID) *ModelUpdateOne {
 	muo.mutation.SetModelProviderID(u)
@@ -943,6 +1026,11 @@ func (muo *ModelUpdateOne) check() error {
 			return &ValidationError{Name: "cache_read_cost", err: fmt.Errorf(`memory: validator failed for field "Model.cache_read_cost": %w`, err)}
 		}
 	}
+	if v, ok := muo.mutation.Source(); ok {
+		if err := model.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`memory: validator failed for field "Model.source": %w`, err)}
+		}
+	}
 	if muo.mutation.ModelProviderCleared() && len(muo.mutation.ModelProviderIDs()) > 0 {
 		return errors.New(`memory: clearing a required unique edge "Model.model_provider"`)
 	}
@@ -1040,6 +1128,20 @@ func (muo *ModelUpdateOne) sqlSave(ctx context.Context) (_node *Model, err error
 	if muo.mutation.AliasCleared() {
 		_spec.ClearField(model.FieldAlias, field.TypeString)
 	}
+	if value, ok := muo.mutation.Source(); ok {
+		_spec.SetField(model.FieldSource, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Overrides(); ok {
+		_spec.SetField(model.FieldOverrides, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedOverrides(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, model.FieldOverrides, value)
+		})
+	}
+	if muo.mutation.OverridesCleared() {
+		_spec.ClearField(model.FieldOverrides, field.TypeJSON)
+	}
 	if muo.mutation.AgentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -0,0 +1,301 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+// ModelCallTrace is the model entity for the ModelCallTrace schema.
+type ModelCallTrace struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// ModelName holds the value of the "model_name" field.
+	ModelName string `json:"model_name,omitempty"`
+	// ModelProviderID holds the value of the "model_provider_id" field.
+	ModelProviderID uuid.UUID `json:"model_provider_id,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// RequestHeaders holds the value of the "request_headers" field.
+	RequestHeaders map[string][]string `json:"request_headers,omitempty"`
+	// RequestBody holds the value of the "request_body" field.
+	RequestBody []byte `json:"request_body,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode int `json:"status_code,omitempty"`
+	// ResponseHeaders holds the value of the "response_headers" field.
+	ResponseHeaders map[string][]string `json:"response_headers,omitempty"`
+	// ResponseBody holds the value of the "response_body" field.
+	ResponseBody []byte `json:"response_body,omitempty"`
+	// Truncated holds the value of the "truncated" field.
+	Truncated bool `json:"truncated,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// DurationMs holds the value of the "duration_ms" field.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID uuid.UUID `json:"task_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModelCallTraceQuery when eager-loading is set.
+	Edges        ModelCallTraceEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ModelCallTraceEdges holds the relations/edges for other nodes in the graph.
+type ModelCallTraceEdges struct {
+	// Task holds the value of the task edge.
+	Task *Task `json:"task,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModelCallTraceEdges) TaskOrErr() (*Task, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: task.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModelCallTrace) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case modelcalltrace.FieldRequestHeaders, modelcalltrace.FieldRequestBody, modelcalltrace.FieldResponseHeaders, modelcalltrace.FieldResponseBody:
+			values[i] = new([]byte)
+		case modelcalltrace.FieldTruncated:
+			values[i] = new(sql.NullBool)
+		case modelcalltrace.FieldStatusCode, modelcalltrace.FieldDurationMs:
+			values[i] = new(sql.NullInt64)
+		case modelcalltrace.FieldModelName, modelcalltrace.FieldMethod, modelcalltrace.FieldURL, modelcalltrace.FieldError:
+			values[i] = new(sql.NullString)
+		case modelcalltrace.FieldCreateTime, modelcalltrace.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case modelcalltrace.FieldID, modelcalltrace.FieldModelProviderID, modelcalltrace.FieldTaskID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModelCallTrace fields.
+func (mct *ModelCallTrace) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case modelcalltrace.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mct.ID = *value
+			}
+		case modelcalltrace.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				mct.CreateTime = value.Time
+			}
+		case modelcalltrace.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				mct.UpdateTime = value.Time
+			}
+		case modelcalltrace.FieldModelName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_name", values[i])
+			} else if value.Valid {
+				mct.ModelName = value.String
+			}
+		case modelcalltrace.FieldModelProviderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field model_provider_id", values[i])
+			} else if value != nil {
+				mct.ModelProviderID = *value
+			}
+		case modelcalltrace.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				mct.Method = value.String
+			}
+		case modelcalltrace.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				mct.URL = value.String
+			}
+		case modelcalltrace.FieldRequestHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field request_headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mct.RequestHeaders); err != nil {
+					return fmt.Errorf("unmarshal field request_headers: %w", err)
+				}
+			}
+		case modelcalltrace.FieldRequestBody:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field request_body", values[i])
+			} else if value != nil {
+				mct.RequestBody = *value
+			}
+		case modelcalltrace.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				mct.StatusCode = int(value.Int64)
+			}
+		case modelcalltrace.FieldResponseHeaders:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_headers", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mct.ResponseHeaders); err != nil {
+					return fmt.Errorf("unmarshal field response_headers: %w", err)
+				}
+			}
+		case modelcalltrace.FieldResponseBody:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field response_body", values[i])
+			} else if value != nil {
+				mct.ResponseBody = *value
+			}
+		case modelcalltrace.FieldTruncated:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field truncated", values[i])
+			} else if value.Valid {
+				mct.Truncated = value.Bool
+			}
+		case modelcalltrace.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				mct.Error = value.String
+			}
+		case modelcalltrace.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				mct.DurationMs = value.Int64
+			}
+		case modelcalltrace.FieldTaskID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value != nil {
+				mct.TaskID = *value
+			}
+		default:
+			mct.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModelCallTrace.
+// This includes values selected through modifiers, order, etc.
+func (mct *ModelCallTrace) Value(name string) (ent.Value, error) {
+	return mct.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the ModelCallTrace entity.
+func (mct *ModelCallTrace) QueryTask() *TaskQuery {
+	return NewModelCallTraceClient(mct.config).QueryTask(mct)
+}
+
+// Update returns a builder for updating this ModelCallTrace.
+// Note that you need to call ModelCallTrace.Unwrap() before calling this method if this ModelCallTrace
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mct *ModelCallTrace) Update() *ModelCallTraceUpdateOne {
+	return NewModelCallTraceClient(mct.config).UpdateOne(mct)
+}
+
+// Unwrap unwraps the ModelCallTrace entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mct *ModelCallTrace) Unwrap() *ModelCallTrace {
+	_tx, ok := mct.config.driver.(*txDriver)
+	if !ok {
+		panic("memory: ModelCallTrace is not a transactional entity")
+	}
+	mct.config.driver = _tx.drv
+	return mct
+}
+
+// String implements the fmt.Stringer.
+func (mct *ModelCallTrace) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModelCallTrace(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mct.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(mct.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(mct.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("model_name=")
+	builder.WriteString(mct.ModelName)
+	builder.WriteString(", ")
+	builder.WriteString("model_provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", mct.ModelProviderID))
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(mct.Method)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(mct.URL)
+	builder.WriteString(", ")
+	builder.WriteString("request_headers=")
+	builder.WriteString(fmt.Sprintf("%v", mct.RequestHeaders))
+	builder.WriteString(", ")
+	builder.WriteString("request_body=")
+	builder.WriteString(fmt.Sprintf("%v", mct.RequestBody))
+	builder.WriteString(", ")
+	builder.WriteString("status_code=")
+	builder.WriteString(fmt.Sprintf("%v", mct.StatusCode))
+	builder.WriteString(", ")
+	builder.WriteString("response_headers=")
+	builder.WriteString(fmt.Sprintf("%v", mct.ResponseHeaders))
+	builder.WriteString(", ")
+	builder.WriteString("response_body=")
+	builder.WriteString(fmt.Sprintf("%v", mct.ResponseBody))
+	builder.WriteString(", ")
+	builder.WriteString("truncated=")
+	builder.WriteString(fmt.Sprintf("%v", mct.Truncated))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(mct.Error)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", mct.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("task_id=")
+	builder.WriteString(fmt.Sprintf("%v", mct.TaskID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModelCallTraces is a parsable slice of ModelCallTrace.
+type ModelCallTraces []*ModelCallTrace
@@ -0,0 +1,800 @@
+// Code generated by ent. DO NOT EDIT.
+
+package modelcalltrace
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// ModelName applies equality check predicate on the "model_name" field. It's identical to ModelNameEQ.
+func ModelName(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldModelName, v))
+}
+
+// ModelProviderID applies equality check predicate on the "model_provider_id" field. It's identical to ModelProviderIDEQ.
+func ModelProviderID(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldModelProviderID, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldMethod, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldURL, v))
+}
+
+// RequestBody applies equality check predicate on the "request_body" field. It's identical to RequestBodyEQ.
+func RequestBody(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldRequestBody, v))
+}
+
+// StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
+func StatusCode(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// ResponseBody applies equality check predicate on the "response_body" field. It's identical to ResponseBodyEQ.
+func ResponseBody(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldResponseBody, v))
+}
+
+// Truncated applies equality check predicate on the "truncated" field. It's identical to TruncatedEQ.
+func Truncated(v bool) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldTruncated, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldError, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldTaskID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// ModelNameEQ applies the EQ predicate on the "model_name" field.
+func ModelNameEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldModelName, v))
+}
+
+// ModelNameNEQ applies the NEQ predicate on the "model_name" field.
+func ModelNameNEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldModelName, v))
+}
+
+// ModelNameIn applies the In predicate on the "model_name" field.
+func ModelNameIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldModelName, vs...))
+}
+
+// ModelNameNotIn applies the NotIn predicate on the "model_name" field.
+func ModelNameNotIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldModelName, vs...))
+}
+
+// ModelNameGT applies the GT predicate on the "model_name" field.
+func ModelNameGT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldModelName, v))
+}
+
+// ModelNameGTE applies the GTE predicate on the "model_name" field.
+func ModelNameGTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldModelName, v))
+}
+
+// ModelNameLT applies the LT predicate on the "model_name" field.
+func ModelNameLT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldModelName, v))
+}
+
+// ModelNameLTE applies the LTE predicate on the "model_name" field.
+func ModelNameLTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldModelName, v))
+}
+
+// ModelNameContains applies the Contains predicate on the "model_name" field.
+func ModelNameContains(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContains(FieldModelName, v))
+}
+
+// ModelNameHasPrefix applies the HasPrefix predicate on the "model_name" field.
+func ModelNameHasPrefix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasPrefix(FieldModelName, v))
+}
+
+// ModelNameHasSuffix applies the HasSuffix predicate on the "model_name" field.
+func ModelNameHasSuffix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasSuffix(FieldModelName, v))
+}
+
+// ModelNameEqualFold applies the EqualFold predicate on the "model_name" field.
+func ModelNameEqualFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEqualFold(FieldModelName, v))
+}
+
+// ModelNameContainsFold applies the ContainsFold predicate on the "model_name" field.
+func ModelNameContainsFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContainsFold(FieldModelName, v))
+}
+
+// ModelProviderIDEQ applies the EQ predicate on the "model_provider_id" field.
+func ModelProviderIDEQ(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldModelProviderID, v))
+}
+
+// ModelProviderIDNEQ applies the NEQ predicate on the "model_provider_id" field.
+func ModelProviderIDNEQ(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldModelProviderID, v))
+}
+
+// ModelProviderIDIn applies the In predicate on the "model_provider_id" field.
+func ModelProviderIDIn(vs ...uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldModelProviderID, vs...))
+}
+
+// ModelProviderIDNotIn applies the NotIn predicate on the "model_provider_id" field.
+func ModelProviderIDNotIn(vs ...uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldModelProviderID, vs...))
+}
+
+// ModelProviderIDGT applies the GT predicate on the "model_provider_id" field.
+func ModelProviderIDGT(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldModelProviderID, v))
+}
+
+// ModelProviderIDGTE applies the GTE predicate on the "model_provider_id" field.
+func ModelProviderIDGTE(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldModelProviderID, v))
+}
+
+// ModelProviderIDLT applies the LT predicate on the "model_provider_id" field.
+func ModelProviderIDLT(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldModelProviderID, v))
+}
+
+// ModelProviderIDLTE applies the LTE predicate on the "model_provider_id" field.
+func ModelProviderIDLTE(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldModelProviderID, v))
+}
+
+// ModelProviderIDIsNil applies the IsNil predicate on the "model_provider_id" field.
+func ModelProviderIDIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldModelProviderID))
+}
+
+// ModelProviderIDNotNil applies the NotNil predicate on the "model_provider_id" field.
+func ModelProviderIDNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldModelProviderID))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContainsFold(FieldURL, v))
+}
+
+// RequestHeadersIsNil applies the IsNil predicate on the "request_headers" field.
+func RequestHeadersIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldRequestHeaders))
+}
+
+// RequestHeadersNotNil applies the NotNil predicate on the "request_headers" field.
+func RequestHeadersNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldRequestHeaders))
+}
+
+// RequestBodyEQ applies the EQ predicate on the "request_body" field.
+func RequestBodyEQ(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldRequestBody, v))
+}
+
+// RequestBodyNEQ applies the NEQ predicate on the "request_body" field.
+func RequestBodyNEQ(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldRequestBody, v))
+}
+
+// RequestBodyIn applies the In predicate on the "request_body" field.
+func RequestBodyIn(vs ...[]byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldRequestBody, vs...))
+}
+
+// RequestBodyNotIn applies the NotIn predicate on the "request_body" field.
+func RequestBodyNotIn(vs ...[]byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldRequestBody, vs...))
+}
+
+// RequestBodyGT applies the GT predicate on the "request_body" field.
+func RequestBodyGT(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldRequestBody, v))
+}
+
+// RequestBodyGTE applies the GTE predicate on the "request_body" field.
+func RequestBodyGTE(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldRequestBody, v))
+}
+
+// RequestBodyLT applies the LT predicate on the "request_body" field.
+func RequestBodyLT(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldRequestBody, v))
+}
+
+// RequestBodyLTE applies the LTE predicate on the "request_body" field.
+func RequestBodyLTE(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldRequestBody, v))
+}
+
+// RequestBodyIsNil applies the IsNil predicate on the "request_body" field.
+func RequestBodyIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldRequestBody))
+}
+
+// RequestBodyNotNil applies the NotNil predicate on the "request_body" field.
+func RequestBodyNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldRequestBody))
+}
+
+// StatusCodeEQ applies the EQ predicate on the "status_code" field.
+func StatusCodeEQ(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldStatusCode, v))
+}
+
+// StatusCodeNEQ applies the NEQ predicate on the "status_code" field.
+func StatusCodeNEQ(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldStatusCode, v))
+}
+
+// StatusCodeIn applies the In predicate on the "status_code" field.
+func StatusCodeIn(vs ...int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeNotIn applies the NotIn predicate on the "status_code" field.
+func StatusCodeNotIn(vs ...int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldStatusCode, vs...))
+}
+
+// StatusCodeGT applies the GT predicate on the "status_code" field.
+func StatusCodeGT(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldStatusCode, v))
+}
+
+// StatusCodeGTE applies the GTE predicate on the "status_code" field.
+func StatusCodeGTE(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldStatusCode, v))
+}
+
+// StatusCodeLT applies the LT predicate on the "status_code" field.
+func StatusCodeLT(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldStatusCode, v))
+}
+
+// StatusCodeLTE applies the LTE predicate on the "status_code" field.
+func StatusCodeLTE(v int) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldStatusCode, v))
+}
+
+// StatusCodeIsNil applies the IsNil predicate on the "status_code" field.
+func StatusCodeIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldStatusCode))
+}
+
+// StatusCodeNotNil applies the NotNil predicate on the "status_code" field.
+func StatusCodeNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldStatusCode))
+}
+
+// ResponseHeadersIsNil applies the IsNil predicate on the "response_headers" field.
+func ResponseHeadersIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldResponseHeaders))
+}
+
+// ResponseHeadersNotNil applies the NotNil predicate on the "response_headers" field.
+func ResponseHeadersNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldResponseHeaders))
+}
+
+// ResponseBodyEQ applies the EQ predicate on the "response_body" field.
+func ResponseBodyEQ(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldResponseBody, v))
+}
+
+// ResponseBodyNEQ applies the NEQ predicate on the "response_body" field.
+func ResponseBodyNEQ(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldResponseBody, v))
+}
+
+// ResponseBodyIn applies the In predicate on the "response_body" field.
+func ResponseBodyIn(vs ...[]byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldResponseBody, vs...))
+}
+
+// ResponseBodyNotIn applies the NotIn predicate on the "response_body" field.
+func ResponseBodyNotIn(vs ...[]byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldResponseBody, vs...))
+}
+
+// ResponseBodyGT applies the GT predicate on the "response_body" field.
+func ResponseBodyGT(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldResponseBody, v))
+}
+
+// ResponseBodyGTE applies the GTE predicate on the "response_body" field.
+func ResponseBodyGTE(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldResponseBody, v))
+}
+
+// ResponseBodyLT applies the LT predicate on the "response_body" field.
+func ResponseBodyLT(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldResponseBody, v))
+}
+
+// ResponseBodyLTE applies the LTE predicate on the "response_body" field.
+func ResponseBodyLTE(v []byte) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldResponseBody, v))
+}
+
+// ResponseBodyIsNil applies the IsNil predicate on the "response_body" field.
+func ResponseBodyIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldResponseBody))
+}
+
+// ResponseBodyNotNil applies the NotNil predicate on the "response_body" field.
+func ResponseBodyNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldResponseBody))
+}
+
+// TruncatedEQ applies the EQ predicate on the "truncated" field.
+func TruncatedEQ(v bool) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldTruncated, v))
+}
+
+// TruncatedNEQ applies the NEQ predicate on the "truncated" field.
+func TruncatedNEQ(v bool) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldTruncated, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldContainsFold(FieldError, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...uuid.UUID) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.Task) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModelCallTrace) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModelCallTrace) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModelCallTrace) predicate.ModelCallTrace {
+	return predicate.ModelCallTrace(sql.NotPredicates(p))
+}
@@ -0,0 +1,469 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+// ModelCallTraceCreate is the builder for creating a ModelCallTrace entity.
+type ModelCallTraceCreate struct {
+	config
+	mutation *ModelCallTraceMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (mctc *ModelCallTraceCreate) SetCreateTime(t time.Time) *ModelCallTraceCreate {
+	mctc.mutation.SetCreateTime(t)
+	return mctc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableCreateTime(t *time.Time) *ModelCallTraceCreate {
+	if t != nil {
+		mctc.SetCreateTime(*t)
+	}
+	return mctc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mctc *ModelCallTraceCreate) SetUpdateTime(t time.Time) *ModelCallTraceCreate {
+	mctc.mutation.SetUpdateTime(t)
+	return mctc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableUpdateTime(t *time.Time) *ModelCallTraceCreate {
+	if t != nil {
+		mctc.SetUpdateTime(*t)
+	}
+	return mctc
+}
+
+// SetModelName sets the "model_name" field.
+func (mctc *ModelCallTraceCreate) SetModelName(s string) *ModelCallTraceCreate {
+	mctc.mutation.SetModelName(s)
+	return mctc
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (mctc *ModelCallTraceCreate) SetModelProviderID(u uuid.UUID) *ModelCallTraceCreate {
+	mctc.mutation.SetModelProviderID(u)
+	return mctc
+}
+
+// SetNillableModelProviderID sets the "model_provider_id" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableModelProviderID(u *uuid.UUID) *ModelCallTraceCreate {
+	if u != nil {
+		mctc.SetModelProviderID(*u)
+	}
+	return mctc
+}
+
+// SetMethod sets the "method" field.
+func (mctc *ModelCallTraceCreate) SetMethod(s string) *ModelCallTraceCreate {
+	mctc.mutation.SetMethod(s)
+	return mctc
+}
+
+// SetURL sets the "url" field.
+func (mctc *ModelCallTraceCreate) SetURL(s string) *ModelCallTraceCreate {
+	mctc.mutation.SetURL(s)
+	return mctc
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (mctc *ModelCallTraceCreate) SetRequestHeaders(m map[string][]string) *ModelCallTraceCreate {
+	mctc.mutation.SetRequestHeaders(m)
+	return mctc
+}
+
+// SetRequestBody sets the "request_body" field.
+func (mctc *ModelCallTraceCreate) SetRequestBody(b []byte) *ModelCallTraceCreate {
+	mctc.mutation.SetRequestBody(b)
+	return mctc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (mctc *ModelCallTraceCreate) SetStatusCode(i int) *ModelCallTraceCreate {
+	mctc.mutation.SetStatusCode(i)
+	return mctc
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableStatusCode(i *int) *ModelCallTraceCreate {
+	if i != nil {
+		mctc.SetStatusCode(*i)
+	}
+	return mctc
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (mctc *ModelCallTraceCreate) SetResponseHeaders(m map[string][]string) *ModelCallTraceCreate {
+	mctc.mutation.SetResponseHeaders(m)
+	return mctc
+}
+
+// SetResponseBody sets the "response_body" field.
+func (mctc *ModelCallTraceCreate) SetResponseBody(b []byte) *ModelCallTraceCreate {
+	mctc.mutation.SetResponseBody(b)
+	return mctc
+}
+
+// SetTruncated sets the "truncated" field.
+func (mctc *ModelCallTraceCreate) SetTruncated(b bool) *ModelCallTraceCreate {
+	mctc.mutation.SetTruncated(b)
+	return mctc
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableTruncated(b *bool) *ModelCallTraceCreate {
+	if b != nil {
+		mctc.SetTruncated(*b)
+	}
+	return mctc
+}
+
+// SetError sets the "error" field.
+func (mctc *ModelCallTraceCreate) SetError(s string) *ModelCallTraceCreate {
+	mctc.mutation.SetError(s)
+	return mctc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableError(s *string) *ModelCallTraceCreate {
+	if s != nil {
+		mctc.SetError(*s)
+	}
+	return mctc
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (mctc *ModelCallTraceCreate) SetDurationMs(i int64) *ModelCallTraceCreate {
+	mctc.mutation.SetDurationMs(i)
+	return mctc
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableDurationMs(i *int64) *ModelCallTraceCreate {
+	if i != nil {
+		mctc.SetDurationMs(*i)
+	}
+	return mctc
+}
+
+// SetTaskID sets the "task_id" field.
+func (mctc *ModelCallTraceCreate) SetTaskID(u uuid.UUID) *ModelCallTraceCreate {
+	mctc.mutation.SetTaskID(u)
+	return mctc
+}
+
+// SetID sets the "id" field.
+func (mctc *ModelCallTraceCreate) SetID(u uuid.UUID) *ModelCallTraceCreate {
+	mctc.mutation.SetID(u)
+	return mctc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mctc *ModelCallTraceCreate) SetNillableID(u *uuid.UUID) *ModelCallTraceCreate {
+	if u != nil {
+		mctc.SetID(*u)
+	}
+	return mctc
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (mctc *ModelCallTraceCreate) SetTask(t *Task) *ModelCallTraceCreate {
+	return mctc.SetTaskID(t.ID)
+}
+
+// Mutation returns the ModelCallTraceMutation object of the builder.
+func (mctc *ModelCallTraceCreate) Mutation() *ModelCallTraceMutation {
+	return mctc.mutation
+}
+
+// Save creates the ModelCallTrace in the database.
+func (mctc *ModelCallTraceCreate) Save(ctx context.Context) (*ModelCallTrace, error) {
+	mctc.defaults()
+	return withHooks(ctx, mctc.sqlSave, mctc.mutation, mctc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mctc *ModelCallTraceCreate) SaveX(ctx context.Context) *ModelCallTrace {
+	v, err := mctc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mctc *ModelCallTraceCreate) Exec(ctx context.Context) error {
+	_, err := mctc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mctc *ModelCallTraceCreate) ExecX(ctx context.Context) {
+	if err := mctc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mctc *ModelCallTraceCreate) defaults() {
+	if _, ok := mctc.mutation.CreateTime(); !ok {
+		v := modelcalltrace.DefaultCreateTime()
+		mctc.mutation.SetCreateTime(v)
+	}
+	if _, ok := mctc.mutation.UpdateTime(); !ok {
+		v := modelcalltrace.DefaultUpdateTime()
+		mctc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := mctc.mutation.Truncated(); !ok {
+		v := modelcalltrace.DefaultTruncated
+		mctc.mutation.SetTruncated(v)
+	}
+	if _, ok := mctc.mutation.DurationMs(); !ok {
+		v := modelcalltrace.DefaultDurationMs
+		mctc.mutation.SetDurationMs(v)
+	}
+	if _, ok := mctc.mutation.ID(); !ok {
+		v := modelcalltrace.DefaultID()
+		mctc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mctc *ModelCallTraceCreate) check() error {
+	if _, ok := mctc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "ModelCallTrace.create_time"`)}
+	}
+	if _, ok := mctc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "ModelCallTrace.update_time"`)}
+	}
+	if _, ok := mctc.mutation.ModelName(); !ok {
+		return &ValidationError{Name: "model_name", err: errors.New(`memory: missing required field "ModelCallTrace.model_name"`)}
+	}
+	if _, ok := mctc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`memory: missing required field "ModelCallTrace.method"`)}
+	}
+	if _, ok := mctc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`memory: missing required field "ModelCallTrace.url"`)}
+	}
+	if _, ok := mctc.mutation.Truncated(); !ok {
+		return &ValidationError{Name: "truncated", err: errors.New(`memory: missing required field "ModelCallTrace.truncated"`)}
+	}
+	if _, ok := mctc.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`memory: missing required field "ModelCallTrace.duration_ms"`)}
+	}
+	if _, ok := mctc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`memory: missing required field "ModelCallTrace.task_id"`)}
+	}
+	if len(mctc.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`memory: missing required edge "ModelCallTrace.task"`)}
+	}
+	return nil
+}
+
+func (mctc *ModelCallTraceCreate) sqlSave(ctx context.Context) (*ModelCallTrace, error) {
+	if err := mctc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mctc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mctc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mctc.mutation.id = &_node.ID
+	mctc.mutation.done = true
+	return _node, nil
+}
+
+func (mctc *ModelCallTraceCreate) createSpec() (*ModelCallTrace, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModelCallTrace{config: mctc.config}
+		_spec = sqlgraph.NewCreateSpec(modelcalltrace.Table, sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID))
+	)
+	if id, ok := mctc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mctc.mutation.CreateTime(); ok {
+		_spec.SetField(modelcalltrace.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := mctc.mutation.UpdateTime(); ok {
+		_spec.SetField(modelcalltrace.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := mctc.mutation.ModelName(); ok {
+		_spec.SetField(modelcalltrace.FieldModelName, field.TypeString, value)
+		_node.ModelName = value
+	}
+	if value, ok := mctc.mutation.ModelProviderID(); ok {
+		_spec.SetField(modelcalltrace.FieldModelProviderID, field.TypeUUID, value)
+		_node.ModelProviderID = value
+	}
+	if value, ok := mctc.mutation.Method(); ok {
+		_spec.SetField(modelcalltrace.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := mctc.mutation.URL(); ok {
+		_spec.SetField(modelcalltrace.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := mctc.mutation.RequestHeaders(); ok {
+		_spec.SetField(modelcalltrace.FieldRequestHeaders, field.TypeJSON, value)
+		_node.RequestHeaders = value
+	}
+	if value, ok := mctc.mutation.RequestBody(); ok {
+		_spec.SetField(modelcalltrace.FieldRequestBody, field.TypeBytes, value)
+		_node.RequestBody = value
+	}
+	if value, ok := mctc.mutation.StatusCode(); ok {
+		_spec.SetField(modelcalltrace.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := mctc.mutation.ResponseHeaders(); ok {
+		_spec.SetField(modelcalltrace.FieldResponseHeaders, field.TypeJSON, value)
+		_node.ResponseHeaders = value
+	}
+	if value, ok := mctc.mutation.ResponseBody(); ok {
+		_spec.SetField(modelcalltrace.FieldResponseBody, field.TypeBytes, value)
+		_node.ResponseBody = value
+	}
+	if value, ok := mctc.mutation.Truncated(); ok {
+		_spec.SetField(modelcalltrace.FieldTruncated, field.TypeBool, value)
+		_node.Truncated = value
+	}
+	if value, ok := mctc.mutation.Error(); ok {
+		_spec.SetField(modelcalltrace.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := mctc.mutation.DurationMs(); ok {
+		_spec.SetField(modelcalltrace.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = value
+	}
+	if nodes := mctc.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelcalltrace.TaskTable,
+			Columns: []string{modelcalltrace.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TaskID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ModelCallTraceCreateBulk is the builder for creating many ModelCallTrace entities in bulk.
+type ModelCallTraceCreateBulk struct {
+	config
+	err      error
+	builders []*ModelCallTraceCreate
+}
+
+// Save creates the ModelCallTrace entities in the database.
+func (mctcb *ModelCallTraceCreateBulk) Save(ctx context.Context) ([]*ModelCallTrace, error) {
+	if mctcb.err != nil {
+		return nil, mctcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mctcb.builders))
+	nodes := make([]*ModelCallTrace, len(mctcb.builders))
+	mutators := make([]Mutator, len(mctcb.builders))
+	for i := range mctcb.builders {
+		func(i int, root context.Context) {
+			builder := mctcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModelCallTraceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mctcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mctcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mctcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mctcb *ModelCallTraceCreateBulk) SaveX(ctx context.Context) []*ModelCallTrace {
+	v, err := mctcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mctcb *ModelCallTraceCreateBulk) Exec(ctx context.Context) error {
+	_, err := mctcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mctcb *ModelCallTraceCreateBulk) ExecX(ctx context.Context) {
+	if err := mctcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/memory/predicate"
+)
+
+// ModelCallTraceDelete is the builder for deleting a ModelCallTrace entity.
+type ModelCallTraceDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModelCallTraceMutation
+}
+
+// Where appends a list predicates to the ModelCallTraceDelete builder.
+func (mctd *ModelCallTraceDelete) Where(ps ...predicate.ModelCallTrace) *ModelCallTraceDelete {
+	mctd.mutation.Where(ps...)
+	return mctd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mctd *ModelCallTraceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mctd.sqlExec, mctd.mutation, mctd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mctd *ModelCallTraceDelete) ExecX(ctx context.Context) int {
+	n, err := mctd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mctd *ModelCallTraceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(modelcalltrace.Table, sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID))
+	if ps := mctd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mctd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mctd.mutation.done = true
+	return affected, err
+}
+
+// ModelCallTraceDeleteOne is the builder for deleting a single ModelCallTrace entity.
+type ModelCallTraceDeleteOne struct {
+	mctd *ModelCallTraceDelete
+}
+
+// Where appends a list predicates to the ModelCallTraceDelete builder.
+func (mctdo *ModelCallTraceDeleteOne) Where(ps ...predicate.ModelCallTrace) *ModelCallTraceDeleteOne {
+	mctdo.mctd.mutation.Where(ps...)
+	return mctdo
+}
+
+// Exec executes the deletion query.
+func (mctdo *ModelCallTraceDeleteOne) Exec(ctx context.Context) error {
+	n, err := mctdo.mctd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{modelcalltrace.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mctdo *ModelCallTraceDeleteOne) ExecX(ctx context.Context) {
+	if err := mctdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
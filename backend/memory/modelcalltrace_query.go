@@ -0,0 +1,630 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+// ModelCallTraceQuery is the builder for querying ModelCallTrace entities.
+type ModelCallTraceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []modelcalltrace.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ModelCallTrace
+	withTask   *TaskQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ModelCallTraceQuery builder.
+func (mctq *ModelCallTraceQuery) Where(ps ...predicate.ModelCallTrace) *ModelCallTraceQuery {
+	mctq.predicates = append(mctq.predicates, ps...)
+	return mctq
+}
+
+// Limit the number of records to be returned by this query.
+func (mctq *ModelCallTraceQuery) Limit(limit int) *ModelCallTraceQuery {
+	mctq.ctx.Limit = &limit
+	return mctq
+}
+
+// Offset to start from.
+func (mctq *ModelCallTraceQuery) Offset(offset int) *ModelCallTraceQuery {
+	mctq.ctx.Offset = &offset
+	return mctq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mctq *ModelCallTraceQuery) Unique(unique bool) *ModelCallTraceQuery {
+	mctq.ctx.Unique = &unique
+	return mctq
+}
+
+// Order specifies how the records should be ordered.
+func (mctq *ModelCallTraceQuery) Order(o ...modelcalltrace.OrderOption) *ModelCallTraceQuery {
+	mctq.order = append(mctq.order, o...)
+	return mctq
+}
+
+// QueryTask chains the current query on the "task" edge.
+func (mctq *ModelCallTraceQuery) QueryTask() *TaskQuery {
+	query := (&TaskClient{config: mctq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mctq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mctq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modelcalltrace.Table, modelcalltrace.FieldID, selector),
+			sqlgraph.To(task.Table, task.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, modelcalltrace.TaskTable, modelcalltrace.TaskColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mctq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ModelCallTrace entity from the query.
+// Returns a *NotFoundError when no ModelCallTrace was found.
+func (mctq *ModelCallTraceQuery) First(ctx context.Context) (*ModelCallTrace, error) {
+	nodes, err := mctq.Limit(1).All(setContextOp(ctx, mctq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{modelcalltrace.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) FirstX(ctx context.Context) *ModelCallTrace {
+	node, err := mctq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ModelCallTrace ID from the query.
+// Returns a *NotFoundError when no ModelCallTrace ID was found.
+func (mctq *ModelCallTraceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mctq.Limit(1).IDs(setContextOp(ctx, mctq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{modelcalltrace.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mctq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ModelCallTrace entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ModelCallTrace entity is found.
+// Returns a *NotFoundError when no ModelCallTrace entities are found.
+func (mctq *ModelCallTraceQuery) Only(ctx context.Context) (*ModelCallTrace, error) {
+	nodes, err := mctq.Limit(2).All(setContextOp(ctx, mctq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{modelcalltrace.Label}
+	default:
+		return nil, &NotSingularError{modelcalltrace.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) OnlyX(ctx context.Context) *ModelCallTrace {
+	node, err := mctq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ModelCallTrace ID in the query.
+// Returns a *NotSingularError when more than one ModelCallTrace ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mctq *ModelCallTraceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mctq.Limit(2).IDs(setContextOp(ctx, mctq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{modelcalltrace.Label}
+	default:
+		err = &NotSingularError{modelcalltrace.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mctq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ModelCallTraces.
+func (mctq *ModelCallTraceQuery) All(ctx context.Context) ([]*ModelCallTrace, error) {
+	ctx = setContextOp(ctx, mctq.ctx, ent.OpQueryAll)
+	if err := mctq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ModelCallTrace, *ModelCallTraceQuery]()
+	return withInterceptors[[]*ModelCallTrace](ctx, mctq, qr, mctq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) AllX(ctx context.Context) []*ModelCallTrace {
+	nodes, err := mctq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ModelCallTrace IDs.
+func (mctq *ModelCallTraceQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mctq.ctx.Unique == nil && mctq.path != nil {
+		mctq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mctq.ctx, ent.OpQueryIDs)
+	if err = mctq.Select(modelcalltrace.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mctq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mctq *ModelCallTraceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mctq.ctx, ent.OpQueryCount)
+	if err := mctq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mctq, querierCount[*ModelCallTraceQuery](), mctq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) CountX(ctx context.Context) int {
+	count, err := mctq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mctq *ModelCallTraceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mctq.ctx, ent.OpQueryExist)
+	switch _, err := mctq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("memory: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mctq *ModelCallTraceQuery) ExistX(ctx context.Context) bool {
+	exist, err := mctq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ModelCallTraceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mctq *ModelCallTraceQuery) Clone() *ModelCallTraceQuery {
+	if mctq == nil {
+		return nil
+	}
+	return &ModelCallTraceQuery{
+		config:     mctq.config,
+		ctx:        mctq.ctx.Clone(),
+		order:      append([]modelcalltrace.OrderOption{}, mctq.order...),
+		inters:     append([]Interceptor{}, mctq.inters...),
+		predicates: append([]predicate.ModelCallTrace{}, mctq.predicates...),
+		withTask:   mctq.withTask.Clone(),
+		// clone intermediate query.
+		sql:       mctq.sql.Clone(),
+		path:      mctq.path,
+		modifiers: append([]func(*sql.Selector){}, mctq.modifiers...),
+	}
+}
+
+// WithTask tells the query-builder to eager-load the nodes that are connected to
+// the "task" edge. The optional arguments are used to configure the query builder of the edge.
+func (mctq *ModelCallTraceQuery) WithTask(opts ...func(*TaskQuery)) *ModelCallTraceQuery {
+	query := (&TaskClient{config: mctq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mctq.withTask = query
+	return mctq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ModelCallTrace.Query().
+//		GroupBy(modelcalltrace.FieldCreateTime).
+//		Aggregate(memory.Count()).
+//		Scan(ctx, &v)
+func (mctq *ModelCallTraceQuery) GroupBy(field string, fields ...string) *ModelCallTraceGroupBy {
+	mctq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ModelCallTraceGroupBy{build: mctq}
+	grbuild.flds = &mctq.ctx.Fields
+	grbuild.label = modelcalltrace.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ModelCallTrace.Query().
+//		Select(modelcalltrace.FieldCreateTime).
+//		Scan(ctx, &v)
+func (mctq *ModelCallTraceQuery) Select(fields ...string) *ModelCallTraceSelect {
+	mctq.ctx.Fields = append(mctq.ctx.Fields, fields...)
+	sbuild := &ModelCallTraceSelect{ModelCallTraceQuery: mctq}
+	sbuild.label = modelcalltrace.Label
+	sbuild.flds, sbuild.scan = &mctq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ModelCallTraceSelect configured with the given aggregations.
+func (mctq *ModelCallTraceQuery) Aggregate(fns ...AggregateFunc) *ModelCallTraceSelect {
+	return mctq.Select().Aggregate(fns...)
+}
+
+func (mctq *ModelCallTraceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mctq.inters {
+		if inter == nil {
+			return fmt.Errorf("memory: uninitialized interceptor (forgotten import memory/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mctq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mctq.ctx.Fields {
+		if !modelcalltrace.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+		}
+	}
+	if mctq.path != nil {
+		prev, err := mctq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mctq.sql = prev
+	}
+	return nil
+}
+
+func (mctq *ModelCallTraceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ModelCallTrace, error) {
+	var (
+		nodes       = []*ModelCallTrace{}
+		_spec       = mctq.querySpec()
+		loadedTypes = [1]bool{
+			mctq.withTask != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ModelCallTrace).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ModelCallTrace{config: mctq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mctq.modifiers) > 0 {
+		_spec.Modifiers = mctq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mctq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mctq.withTask; query != nil {
+		if err := mctq.loadTask(ctx, query, nodes, nil,
+			func(n *ModelCallTrace, e *Task) { n.Edges.Task = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mctq *ModelCallTraceQuery) loadTask(ctx context.Context, query *TaskQuery, nodes []*ModelCallTrace, init func(*ModelCallTrace), assign func(*ModelCallTrace, *Task)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ModelCallTrace)
+	for i := range nodes {
+		fk := nodes[i].TaskID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(task.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "task_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mctq *ModelCallTraceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mctq.querySpec()
+	if len(mctq.modifiers) > 0 {
+		_spec.Modifiers = mctq.modifiers
+	}
+	_spec.Node.Columns = mctq.ctx.Fields
+	if len(mctq.ctx.Fields) > 0 {
+		_spec.Unique = mctq.ctx.Unique != nil && *mctq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mctq.driver, _spec)
+}
+
+func (mctq *ModelCallTraceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(modelcalltrace.Table, modelcalltrace.Columns, sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID))
+	_spec.From = mctq.sql
+	if unique := mctq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mctq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mctq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelcalltrace.FieldID)
+		for i := range fields {
+			if fields[i] != modelcalltrace.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mctq.withTask != nil {
+			_spec.Node.AddColumnOnce(modelcalltrace.FieldTaskID)
+		}
+	}
+	if ps := mctq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mctq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mctq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mctq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mctq *ModelCallTraceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mctq.driver.Dialect())
+	t1 := builder.Table(modelcalltrace.Table)
+	columns := mctq.ctx.Fields
+	if len(columns) == 0 {
+		columns = modelcalltrace.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mctq.sql != nil {
+		selector = mctq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mctq.ctx.Unique != nil && *mctq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mctq.modifiers {
+		m(selector)
+	}
+	for _, p := range mctq.predicates {
+		p(selector)
+	}
+	for _, p := range mctq.order {
+		p(selector)
+	}
+	if offset := mctq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mctq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mctq *ModelCallTraceQuery) Modify(modifiers ...func(s *sql.Selector)) *ModelCallTraceSelect {
+	mctq.modifiers = append(mctq.modifiers, modifiers...)
+	return mctq.Select()
+}
+
+// ModelCallTraceGroupBy is the group-by builder for ModelCallTrace entities.
+type ModelCallTraceGroupBy struct {
+	selector
+	build *ModelCallTraceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mctgb *ModelCallTraceGroupBy) Aggregate(fns ...AggregateFunc) *ModelCallTraceGroupBy {
+	mctgb.fns = append(mctgb.fns, fns...)
+	return mctgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mctgb *ModelCallTraceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mctgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mctgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModelCallTraceQuery, *ModelCallTraceGroupBy](ctx, mctgb.build, mctgb, mctgb.build.inters, v)
+}
+
+func (mctgb *ModelCallTraceGroupBy) sqlScan(ctx context.Context, root *ModelCallTraceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mctgb.fns))
+	for _, fn := range mctgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mctgb.flds)+len(mctgb.fns))
+		for _, f := range *mctgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mctgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mctgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ModelCallTraceSelect is the builder for selecting fields of ModelCallTrace entities.
+type ModelCallTraceSelect struct {
+	*ModelCallTraceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mcts *ModelCallTraceSelect) Aggregate(fns ...AggregateFunc) *ModelCallTraceSelect {
+	mcts.fns = append(mcts.fns, fns...)
+	return mcts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mcts *ModelCallTraceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mcts.ctx, ent.OpQuerySelect)
+	if err := mcts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModelCallTraceQuery, *ModelCallTraceSelect](ctx, mcts.ModelCallTraceQuery, mcts, mcts.inters, v)
+}
+
+func (mcts *ModelCallTraceSelect) sqlScan(ctx context.Context, root *ModelCallTraceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mcts.fns))
+	for _, fn := range mcts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mcts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mcts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mcts *ModelCallTraceSelect) Modify(modifiers ...func(s *sql.Selector)) *ModelCallTraceSelect {
+	mcts.modifiers = append(mcts.modifiers, modifiers...)
+	return mcts
+}
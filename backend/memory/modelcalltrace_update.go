@@ -0,0 +1,870 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/google/uuid"
+)
+
+// ModelCallTraceUpdate is the builder for updating ModelCallTrace entities.
+type ModelCallTraceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ModelCallTraceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ModelCallTraceUpdate builder.
+func (mctu *ModelCallTraceUpdate) Where(ps ...predicate.ModelCallTrace) *ModelCallTraceUpdate {
+	mctu.mutation.Where(ps...)
+	return mctu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mctu *ModelCallTraceUpdate) SetUpdateTime(t time.Time) *ModelCallTraceUpdate {
+	mctu.mutation.SetUpdateTime(t)
+	return mctu
+}
+
+// SetModelName sets the "model_name" field.
+func (mctu *ModelCallTraceUpdate) SetModelName(s string) *ModelCallTraceUpdate {
+	mctu.mutation.SetModelName(s)
+	return mctu
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableModelName(s *string) *ModelCallTraceUpdate {
+	if s != nil {
+		mctu.SetModelName(*s)
+	}
+	return mctu
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (mctu *ModelCallTraceUpdate) SetModelProviderID(u uuid.UUID) *ModelCallTraceUpdate {
+	mctu.mutation.SetModelProviderID(u)
+	return mctu
+}
+
+// SetNillableModelProviderID sets the "model_provider_id" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableModelProviderID(u *uuid.UUID) *ModelCallTraceUpdate {
+	if u != nil {
+		mctu.SetModelProviderID(*u)
+	}
+	return mctu
+}
+
+// ClearModelProviderID clears the value of the "model_provider_id" field.
+func (mctu *ModelCallTraceUpdate) ClearModelProviderID() *ModelCallTraceUpdate {
+	mctu.mutation.ClearModelProviderID()
+	return mctu
+}
+
+// SetMethod sets the "method" field.
+func (mctu *ModelCallTraceUpdate) SetMethod(s string) *ModelCallTraceUpdate {
+	mctu.mutation.SetMethod(s)
+	return mctu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableMethod(s *string) *ModelCallTraceUpdate {
+	if s != nil {
+		mctu.SetMethod(*s)
+	}
+	return mctu
+}
+
+// SetURL sets the "url" field.
+func (mctu *ModelCallTraceUpdate) SetURL(s string) *ModelCallTraceUpdate {
+	mctu.mutation.SetURL(s)
+	return mctu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableURL(s *string) *ModelCallTraceUpdate {
+	if s != nil {
+		mctu.SetURL(*s)
+	}
+	return mctu
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (mctu *ModelCallTraceUpdate) SetRequestHeaders(m map[string][]string) *ModelCallTraceUpdate {
+	mctu.mutation.SetRequestHeaders(m)
+	return mctu
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (mctu *ModelCallTraceUpdate) ClearRequestHeaders() *ModelCallTraceUpdate {
+	mctu.mutation.ClearRequestHeaders()
+	return mctu
+}
+
+// SetRequestBody sets the "request_body" field.
+func (mctu *ModelCallTraceUpdate) SetRequestBody(b []byte) *ModelCallTraceUpdate {
+	mctu.mutation.SetRequestBody(b)
+	return mctu
+}
+
+// ClearRequestBody clears the value of the "request_body" field.
+func (mctu *ModelCallTraceUpdate) ClearRequestBody() *ModelCallTraceUpdate {
+	mctu.mutation.ClearRequestBody()
+	return mctu
+}
+
+// SetStatusCode sets the "status_code" field.
+func (mctu *ModelCallTraceUpdate) SetStatusCode(i int) *ModelCallTraceUpdate {
+	mctu.mutation.ResetStatusCode()
+	mctu.mutation.SetStatusCode(i)
+	return mctu
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableStatusCode(i *int) *ModelCallTraceUpdate {
+	if i != nil {
+		mctu.SetStatusCode(*i)
+	}
+	return mctu
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (mctu *ModelCallTraceUpdate) AddStatusCode(i int) *ModelCallTraceUpdate {
+	mctu.mutation.AddStatusCode(i)
+	return mctu
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (mctu *ModelCallTraceUpdate) ClearStatusCode() *ModelCallTraceUpdate {
+	mctu.mutation.ClearStatusCode()
+	return mctu
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (mctu *ModelCallTraceUpdate) SetResponseHeaders(m map[string][]string) *ModelCallTraceUpdate {
+	mctu.mutation.SetResponseHeaders(m)
+	return mctu
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (mctu *ModelCallTraceUpdate) ClearResponseHeaders() *ModelCallTraceUpdate {
+	mctu.mutation.ClearResponseHeaders()
+	return mctu
+}
+
+// SetResponseBody sets the "response_body" field.
+func (mctu *ModelCallTraceUpdate) SetResponseBody(b []byte) *ModelCallTraceUpdate {
+	mctu.mutation.SetResponseBody(b)
+	return mctu
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (mctu *ModelCallTraceUpdate) ClearResponseBody() *ModelCallTraceUpdate {
+	mctu.mutation.ClearResponseBody()
+	return mctu
+}
+
+// SetTruncated sets the "truncated" field.
+func (mctu *ModelCallTraceUpdate) SetTruncated(b bool) *ModelCallTraceUpdate {
+	mctu.mutation.SetTruncated(b)
+	return mctu
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableTruncated(b *bool) *ModelCallTraceUpdate {
+	if b != nil {
+		mctu.SetTruncated(*b)
+	}
+	return mctu
+}
+
+// SetError sets the "error" field.
+func (mctu *ModelCallTraceUpdate) SetError(s string) *ModelCallTraceUpdate {
+	mctu.mutation.SetError(s)
+	return mctu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableError(s *string) *ModelCallTraceUpdate {
+	if s != nil {
+		mctu.SetError(*s)
+	}
+	return mctu
+}
+
+// ClearError clears the value of the "error" field.
+func (mctu *ModelCallTraceUpdate) ClearError() *ModelCallTraceUpdate {
+	mctu.mutation.ClearError()
+	return mctu
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (mctu *ModelCallTraceUpdate) SetDurationMs(i int64) *ModelCallTraceUpdate {
+	mctu.mutation.ResetDurationMs()
+	mctu.mutation.SetDurationMs(i)
+	return mctu
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableDurationMs(i *int64) *ModelCallTraceUpdate {
+	if i != nil {
+		mctu.SetDurationMs(*i)
+	}
+	return mctu
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (mctu *ModelCallTraceUpdate) AddDurationMs(i int64) *ModelCallTraceUpdate {
+	mctu.mutation.AddDurationMs(i)
+	return mctu
+}
+
+// SetTaskID sets the "task_id" field.
+func (mctu *ModelCallTraceUpdate) SetTaskID(u uuid.UUID) *ModelCallTraceUpdate {
+	mctu.mutation.SetTaskID(u)
+	return mctu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (mctu *ModelCallTraceUpdate) SetNillableTaskID(u *uuid.UUID) *ModelCallTraceUpdate {
+	if u != nil {
+		mctu.SetTaskID(*u)
+	}
+	return mctu
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (mctu *ModelCallTraceUpdate) SetTask(t *Task) *ModelCallTraceUpdate {
+	return mctu.SetTaskID(t.ID)
+}
+
+// Mutation returns the ModelCallTraceMutation object of the builder.
+func (mctu *ModelCallTraceUpdate) Mutation() *ModelCallTraceMutation {
+	return mctu.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (mctu *ModelCallTraceUpdate) ClearTask() *ModelCallTraceUpdate {
+	mctu.mutation.ClearTask()
+	return mctu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mctu *ModelCallTraceUpdate) Save(ctx context.Context) (int, error) {
+	mctu.defaults()
+	return withHooks(ctx, mctu.sqlSave, mctu.mutation, mctu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mctu *ModelCallTraceUpdate) SaveX(ctx context.Context) int {
+	affected, err := mctu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mctu *ModelCallTraceUpdate) Exec(ctx context.Context) error {
+	_, err := mctu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mctu *ModelCallTraceUpdate) ExecX(ctx context.Context) {
+	if err := mctu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mctu *ModelCallTraceUpdate) defaults() {
+	if _, ok := mctu.mutation.UpdateTime(); !ok {
+		v := modelcalltrace.UpdateDefaultUpdateTime()
+		mctu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mctu *ModelCallTraceUpdate) check() error {
+	if mctu.mutation.TaskCleared() && len(mctu.mutation.TaskIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "ModelCallTrace.task"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mctu *ModelCallTraceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModelCallTraceUpdate {
+	mctu.modifiers = append(mctu.modifiers, modifiers...)
+	return mctu
+}
+
+func (mctu *ModelCallTraceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mctu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelcalltrace.Table, modelcalltrace.Columns, sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID))
+	if ps := mctu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mctu.mutation.UpdateTime(); ok {
+		_spec.SetField(modelcalltrace.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := mctu.mutation.ModelName(); ok {
+		_spec.SetField(modelcalltrace.FieldModelName, field.TypeString, value)
+	}
+	if value, ok := mctu.mutation.ModelProviderID(); ok {
+		_spec.SetField(modelcalltrace.FieldModelProviderID, field.TypeUUID, value)
+	}
+	if mctu.mutation.ModelProviderIDCleared() {
+		_spec.ClearField(modelcalltrace.FieldModelProviderID, field.TypeUUID)
+	}
+	if value, ok := mctu.mutation.Method(); ok {
+		_spec.SetField(modelcalltrace.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := mctu.mutation.URL(); ok {
+		_spec.SetField(modelcalltrace.FieldURL, field.TypeString, value)
+	}
+	if value, ok := mctu.mutation.RequestHeaders(); ok {
+		_spec.SetField(modelcalltrace.FieldRequestHeaders, field.TypeJSON, value)
+	}
+	if mctu.mutation.RequestHeadersCleared() {
+		_spec.ClearField(modelcalltrace.FieldRequestHeaders, field.TypeJSON)
+	}
+	if value, ok := mctu.mutation.RequestBody(); ok {
+		_spec.SetField(modelcalltrace.FieldRequestBody, field.TypeBytes, value)
+	}
+	if mctu.mutation.RequestBodyCleared() {
+		_spec.ClearField(modelcalltrace.FieldRequestBody, field.TypeBytes)
+	}
+	if value, ok := mctu.mutation.StatusCode(); ok {
+		_spec.SetField(modelcalltrace.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := mctu.mutation.AddedStatusCode(); ok {
+		_spec.AddField(modelcalltrace.FieldStatusCode, field.TypeInt, value)
+	}
+	if mctu.mutation.StatusCodeCleared() {
+		_spec.ClearField(modelcalltrace.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := mctu.mutation.ResponseHeaders(); ok {
+		_spec.SetField(modelcalltrace.FieldResponseHeaders, field.TypeJSON, value)
+	}
+	if mctu.mutation.ResponseHeadersCleared() {
+		_spec.ClearField(modelcalltrace.FieldResponseHeaders, field.TypeJSON)
+	}
+	if value, ok := mctu.mutation.ResponseBody(); ok {
+		_spec.SetField(modelcalltrace.FieldResponseBody, field.TypeBytes, value)
+	}
+	if mctu.mutation.ResponseBodyCleared() {
+		_spec.ClearField(modelcalltrace.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := mctu.mutation.Truncated(); ok {
+		_spec.SetField(modelcalltrace.FieldTruncated, field.TypeBool, value)
+	}
+	if value, ok := mctu.mutation.Error(); ok {
+		_spec.SetField(modelcalltrace.FieldError, field.TypeString, value)
+	}
+	if mctu.mutation.ErrorCleared() {
+		_spec.ClearField(modelcalltrace.FieldError, field.TypeString)
+	}
+	if value, ok := mctu.mutation.DurationMs(); ok {
+		_spec.SetField(modelcalltrace.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := mctu.mutation.AddedDurationMs(); ok {
+		_spec.AddField(modelcalltrace.FieldDurationMs, field.TypeInt64, value)
+	}
+	if mctu.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelcalltrace.TaskTable,
+			Columns: []string{modelcalltrace.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mctu.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelcalltrace.TaskTable,
+			Columns: []string{modelcalltrace.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mctu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mctu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelcalltrace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mctu.mutation.done = true
+	return n, nil
+}
+
+// ModelCallTraceUpdateOne is the builder for updating a single ModelCallTrace entity.
+type ModelCallTraceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ModelCallTraceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mctuo *ModelCallTraceUpdateOne) SetUpdateTime(t time.Time) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetUpdateTime(t)
+	return mctuo
+}
+
+// SetModelName sets the "model_name" field.
+func (mctuo *ModelCallTraceUpdateOne) SetModelName(s string) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetModelName(s)
+	return mctuo
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableModelName(s *string) *ModelCallTraceUpdateOne {
+	if s != nil {
+		mctuo.SetModelName(*s)
+	}
+	return mctuo
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (mctuo *ModelCallTraceUpdateOne) SetModelProviderID(u uuid.UUID) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetModelProviderID(u)
+	return mctuo
+}
+
+// SetNillableModelProviderID sets the "model_provider_id" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableModelProviderID(u *uuid.UUID) *ModelCallTraceUpdateOne {
+	if u != nil {
+		mctuo.SetModelProviderID(*u)
+	}
+	return mctuo
+}
+
+// ClearModelProviderID clears the value of the "model_provider_id" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearModelProviderID() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearModelProviderID()
+	return mctuo
+}
+
+// SetMethod sets the "method" field.
+func (mctuo *ModelCallTraceUpdateOne) SetMethod(s string) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetMethod(s)
+	return mctuo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableMethod(s *string) *ModelCallTraceUpdateOne {
+	if s != nil {
+		mctuo.SetMethod(*s)
+	}
+	return mctuo
+}
+
+// SetURL sets the "url" field.
+func (mctuo *ModelCallTraceUpdateOne) SetURL(s string) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetURL(s)
+	return mctuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableURL(s *string) *ModelCallTraceUpdateOne {
+	if s != nil {
+		mctuo.SetURL(*s)
+	}
+	return mctuo
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (mctuo *ModelCallTraceUpdateOne) SetRequestHeaders(m map[string][]string) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetRequestHeaders(m)
+	return mctuo
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearRequestHeaders() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearRequestHeaders()
+	return mctuo
+}
+
+// SetRequestBody sets the "request_body" field.
+func (mctuo *ModelCallTraceUpdateOne) SetRequestBody(b []byte) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetRequestBody(b)
+	return mctuo
+}
+
+// ClearRequestBody clears the value of the "request_body" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearRequestBody() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearRequestBody()
+	return mctuo
+}
+
+// SetStatusCode sets the "status_code" field.
+func (mctuo *ModelCallTraceUpdateOne) SetStatusCode(i int) *ModelCallTraceUpdateOne {
+	mctuo.mutation.ResetStatusCode()
+	mctuo.mutation.SetStatusCode(i)
+	return mctuo
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableStatusCode(i *int) *ModelCallTraceUpdateOne {
+	if i != nil {
+		mctuo.SetStatusCode(*i)
+	}
+	return mctuo
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (mctuo *ModelCallTraceUpdateOne) AddStatusCode(i int) *ModelCallTraceUpdateOne {
+	mctuo.mutation.AddStatusCode(i)
+	return mctuo
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearStatusCode() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearStatusCode()
+	return mctuo
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (mctuo *ModelCallTraceUpdateOne) SetResponseHeaders(m map[string][]string) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetResponseHeaders(m)
+	return mctuo
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearResponseHeaders() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearResponseHeaders()
+	return mctuo
+}
+
+// SetResponseBody sets the "response_body" field.
+func (mctuo *ModelCallTraceUpdateOne) SetResponseBody(b []byte) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetResponseBody(b)
+	return mctuo
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearResponseBody() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearResponseBody()
+	return mctuo
+}
+
+// SetTruncated sets the "truncated" field.
+func (mctuo *ModelCallTraceUpdateOne) SetTruncated(b bool) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetTruncated(b)
+	return mctuo
+}
+
+// SetNillableTruncated sets the "truncated" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableTruncated(b *bool) *ModelCallTraceUpdateOne {
+	if b != nil {
+		mctuo.SetTruncated(*b)
+	}
+	return mctuo
+}
+
+// SetError sets the "error" field.
+func (mctuo *ModelCallTraceUpdateOne) SetError(s string) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetError(s)
+	return mctuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableError(s *string) *ModelCallTraceUpdateOne {
+	if s != nil {
+		mctuo.SetError(*s)
+	}
+	return mctuo
+}
+
+// ClearError clears the value of the "error" field.
+func (mctuo *ModelCallTraceUpdateOne) ClearError() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearError()
+	return mctuo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (mctuo *ModelCallTraceUpdateOne) SetDurationMs(i int64) *ModelCallTraceUpdateOne {
+	mctuo.mutation.ResetDurationMs()
+	mctuo.mutation.SetDurationMs(i)
+	return mctuo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableDurationMs(i *int64) *ModelCallTraceUpdateOne {
+	if i != nil {
+		mctuo.SetDurationMs(*i)
+	}
+	return mctuo
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (mctuo *ModelCallTraceUpdateOne) AddDurationMs(i int64) *ModelCallTraceUpdateOne {
+	mctuo.mutation.AddDurationMs(i)
+	return mctuo
+}
+
+// SetTaskID sets the "task_id" field.
+func (mctuo *ModelCallTraceUpdateOne) SetTaskID(u uuid.UUID) *ModelCallTraceUpdateOne {
+	mctuo.mutation.SetTaskID(u)
+	return mctuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (mctuo *ModelCallTraceUpdateOne) SetNillableTaskID(u *uuid.UUID) *ModelCallTraceUpdateOne {
+	if u != nil {
+		mctuo.SetTaskID(*u)
+	}
+	return mctuo
+}
+
+// SetTask sets the "task" edge to the Task entity.
+func (mctuo *ModelCallTraceUpdateOne) SetTask(t *Task) *ModelCallTraceUpdateOne {
+	return mctuo.SetTaskID(t.ID)
+}
+
+// Mutation returns the ModelCallTraceMutation object of the builder.
+func (mctuo *ModelCallTraceUpdateOne) Mutation() *ModelCallTraceMutation {
+	return mctuo.mutation
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (mctuo *ModelCallTraceUpdateOne) ClearTask() *ModelCallTraceUpdateOne {
+	mctuo.mutation.ClearTask()
+	return mctuo
+}
+
+// Where appends a list predicates to the ModelCallTraceUpdate builder.
+func (mctuo *ModelCallTraceUpdateOne) Where(ps ...predicate.ModelCallTrace) *ModelCallTraceUpdateOne {
+	mctuo.mutation.Where(ps...)
+	return mctuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mctuo *ModelCallTraceUpdateOne) Select(field string, fields ...string) *ModelCallTraceUpdateOne {
+	mctuo.fields = append([]string{field}, fields...)
+	return mctuo
+}
+
+// Save executes the query and returns the updated ModelCallTrace entity.
+func (mctuo *ModelCallTraceUpdateOne) Save(ctx context.Context) (*ModelCallTrace, error) {
+	mctuo.defaults()
+	return withHooks(ctx, mctuo.sqlSave, mctuo.mutation, mctuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mctuo *ModelCallTraceUpdateOne) SaveX(ctx context.Context) *ModelCallTrace {
+	node, err := mctuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mctuo *ModelCallTraceUpdateOne) Exec(ctx context.Context) error {
+	_, err := mctuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mctuo *ModelCallTraceUpdateOne) ExecX(ctx context.Context) {
+	if err := mctuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mctuo *ModelCallTraceUpdateOne) defaults() {
+	if _, ok := mctuo.mutation.UpdateTime(); !ok {
+		v := modelcalltrace.UpdateDefaultUpdateTime()
+		mctuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mctuo *ModelCallTraceUpdateOne) check() error {
+	if mctuo.mutation.TaskCleared() && len(mctuo.mutation.TaskIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "ModelCallTrace.task"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mctuo *ModelCallTraceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModelCallTraceUpdateOne {
+	mctuo.modifiers = append(mctuo.modifiers, modifiers...)
+	return mctuo
+}
+
+func (mctuo *ModelCallTraceUpdateOne) sqlSave(ctx context.Context) (_node *ModelCallTrace, err error) {
+	if err := mctuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelcalltrace.Table, modelcalltrace.Columns, sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID))
+	id, ok := mctuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`memory: missing "ModelCallTrace.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mctuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelcalltrace.FieldID)
+		for _, f := range fields {
+			if !modelcalltrace.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+			}
+			if f != modelcalltrace.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mctuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mctuo.mutation.UpdateTime(); ok {
+		_spec.SetField(modelcalltrace.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := mctuo.mutation.ModelName(); ok {
+		_spec.SetField(modelcalltrace.FieldModelName, field.TypeString, value)
+	}
+	if value, ok := mctuo.mutation.ModelProviderID(); ok {
+		_spec.SetField(modelcalltrace.FieldModelProviderID, field.TypeUUID, value)
+	}
+	if mctuo.mutation.ModelProviderIDCleared() {
+		_spec.ClearField(modelcalltrace.FieldModelProviderID, field.TypeUUID)
+	}
+	if value, ok := mctuo.mutation.Method(); ok {
+		_spec.SetField(modelcalltrace.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := mctuo.mutation.URL(); ok {
+		_spec.SetField(modelcalltrace.FieldURL, field.TypeString, value)
+	}
+	if value, ok := mctuo.mutation.RequestHeaders(); ok {
+		_spec.SetField(modelcalltrace.FieldRequestHeaders, field.TypeJSON, value)
+	}
+	if mctuo.mutation.RequestHeadersCleared() {
+		_spec.ClearField(modelcalltrace.FieldRequestHeaders, field.TypeJSON)
+	}
+	if value, ok := mctuo.mutation.RequestBody(); ok {
+		_spec.SetField(modelcalltrace.FieldRequestBody, field.TypeBytes, value)
+	}
+	if mctuo.mutation.RequestBodyCleared() {
+		_spec.ClearField(modelcalltrace.FieldRequestBody, field.TypeBytes)
+	}
+	if value, ok := mctuo.mutation.StatusCode(); ok {
+		_spec.SetField(modelcalltrace.FieldStatusCode, field.TypeInt, value)
+	}
+	if value, ok := mctuo.mutation.AddedStatusCode(); ok {
+		_spec.AddField(modelcalltrace.FieldStatusCode, field.TypeInt, value)
+	}
+	if mctuo.mutation.StatusCodeCleared() {
+		_spec.ClearField(modelcalltrace.FieldStatusCode, field.TypeInt)
+	}
+	if value, ok := mctuo.mutation.ResponseHeaders(); ok {
+		_spec.SetField(modelcalltrace.FieldResponseHeaders, field.TypeJSON, value)
+	}
+	if mctuo.mutation.ResponseHeadersCleared() {
+		_spec.ClearField(modelcalltrace.FieldResponseHeaders, field.TypeJSON)
+	}
+	if value, ok := mctuo.mutation.ResponseBody(); ok {
+		_spec.SetField(modelcalltrace.FieldResponseBody, field.TypeBytes, value)
+	}
+	if mctuo.mutation.ResponseBodyCleared() {
+		_spec.ClearField(modelcalltrace.FieldResponseBody, field.TypeBytes)
+	}
+	if value, ok := mctuo.mutation.Truncated(); ok {
+		_spec.SetField(modelcalltrace.FieldTruncated, field.TypeBool, value)
+	}
+	if value, ok := mctuo.mutation.Error(); ok {
+		_spec.SetField(modelcalltrace.FieldError, field.TypeString, value)
+	}
+	if mctuo.mutation.ErrorCleared() {
+		_spec.ClearField(modelcalltrace.FieldError, field.TypeString)
+	}
+	if value, ok := mctuo.mutation.DurationMs(); ok {
+		_spec.SetField(modelcalltrace.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := mctuo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(modelcalltrace.FieldDurationMs, field.TypeInt64, value)
+	}
+	if mctuo.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelcalltrace.TaskTable,
+			Columns: []string{modelcalltrace.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mctuo.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelcalltrace.TaskTable,
+			Columns: []string{modelcalltrace.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(task.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mctuo.modifiers...)
+	_node = &ModelCallTrace{config: mctuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mctuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelcalltrace.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mctuo.mutation.done = true
+	return _node, nil
+}
@@ -3,6 +3,7 @@
 package memory
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -33,6 +34,10 @@ type ModelProvider struct {
 	Secret []byte `json:"-"`
 	// Enabled holds the value of the "enabled" field.
 	Enabled bool `json:"enabled,omitempty"`
+	// RateLimit holds the value of the "rate_limit" field.
+	RateLimit *types.ModelProviderRateLimit `json:"rate_limit,omitempty"`
+	// KeySelection holds the value of the "key_selection" field.
+	KeySelection types.KeySelectionStrategy `json:"key_selection,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ModelProviderQuery when eager-loading is set.
 	Edges        ModelProviderEdges `json:"edges"`
@@ -43,9 +48,11 @@ type ModelProvider struct {
 type ModelProviderEdges struct {
 	// Models holds the value of the models edge.
 	Models []*Model `json:"models,omitempty"`
+	// Keys holds the value of the keys edge.
+	Keys []*ModelProviderKey `json:"keys,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // ModelsOrErr returns the Models value or an error if the edge
@@ -57,16 +64,25 @@ func (e ModelProviderEdges) ModelsOrErr() ([]*Model, error) {
 	return nil, &NotLoadedError{edge: "models"}
 }
 
+// KeysOrErr returns the Keys value or an error if the edge
+// was not loaded in eager-loading.
+func (e ModelProviderEdges) KeysOrErr() ([]*ModelProviderKey, error) {
+	if e.loadedTypes[1] {
+		return e.Keys, nil
+	}
+	return nil, &NotLoadedError{edge: "keys"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*ModelProvider) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case modelprovider.FieldSecret:
+		case modelprovider.FieldSecret, modelprovider.FieldRateLimit:
 			values[i] = new([]byte)
 		case modelprovider.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case modelprovider.FieldName, modelprovider.FieldProviderType, modelprovider.FieldURL:
+		case modelprovider.FieldName, modelprovider.FieldProviderType, modelprovider.FieldURL, modelprovider.FieldKeySelection:
 			values[i] = new(sql.NullString)
 		case modelprovider.FieldCreateTime, modelprovider.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -135,6 +151,20 @@ func (mp *ModelProvider) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				mp.Enabled = value.Bool
 			}
+		case modelprovider.FieldRateLimit:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &mp.RateLimit); err != nil {
+					return fmt.Errorf("unmarshal field rate_limit: %w", err)
+				}
+			}
+		case modelprovider.FieldKeySelection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_selection", values[i])
+			} else if value.Valid {
+				mp.KeySelection = types.KeySelectionStrategy(value.String)
+			}
 		default:
 			mp.selectValues.Set(columns[i], values[i])
 		}
@@ -153,6 +183,11 @@ func (mp *ModelProvider) QueryModels() *ModelQuery {
 	return NewModelProviderClient(mp.config).QueryModels(mp)
 }
 
+// QueryKeys queries the "keys" edge of the ModelProvider entity.
+func (mp *ModelProvider) QueryKeys() *ModelProviderKeyQuery {
+	return NewModelProviderClient(mp.config).QueryKeys(mp)
+}
+
 // Update returns a builder for updating this ModelProvider.
 // Note that you need to call ModelProvider.Unwrap() before calling this method if this ModelProvider
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -195,6 +230,12 @@ func (mp *ModelProvider) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("enabled=")
 	builder.WriteString(fmt.Sprintf("%v", mp.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("rate_limit=")
+	builder.WriteString(fmt.Sprintf("%v", mp.RateLimit))
+	builder.WriteString(", ")
+	builder.WriteString("key_selection=")
+	builder.WriteString(fmt.Sprintf("%v", mp.KeySelection))
 	builder.WriteByte(')')
 	return builder.String()
 }
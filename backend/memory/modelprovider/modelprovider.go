@@ -31,8 +31,14 @@ const (
 	FieldSecret = "secret"
 	// FieldEnabled holds the string denoting the enabled field in the database.
 	FieldEnabled = "enabled"
+	// FieldRateLimit holds the string denoting the rate_limit field in the database.
+	FieldRateLimit = "rate_limit"
+	// FieldKeySelection holds the string denoting the key_selection field in the database.
+	FieldKeySelection = "key_selection"
 	// EdgeModels holds the string denoting the models edge name in mutations.
 	EdgeModels = "models"
+	// EdgeKeys holds the string denoting the keys edge name in mutations.
+	EdgeKeys = "keys"
 	// Table holds the table name of the modelprovider in the database.
 	Table = "model_providers"
 	// ModelsTable is the table that holds the models relation/edge.
@@ -42,6 +48,13 @@ const (
 	ModelsInverseTable = "models"
 	// ModelsColumn is the table column denoting the models relation/edge.
 	ModelsColumn = "model_provider_id"
+	// KeysTable is the table that holds the keys relation/edge.
+	KeysTable = "model_provider_keys"
+	// KeysInverseTable is the table name for the ModelProviderKey entity.
+	// It exists in this package in order to avoid circular dependency with the "modelproviderkey" package.
+	KeysInverseTable = "model_provider_keys"
+	// KeysColumn is the table column denoting the keys relation/edge.
+	KeysColumn = "model_provider_id"
 )
 
 // Columns holds all SQL columns for modelprovider fields.
@@ -54,6 +67,8 @@ var Columns = []string{
 	FieldURL,
 	FieldSecret,
 	FieldEnabled,
+	FieldRateLimit,
+	FieldKeySelection,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -86,13 +101,25 @@ var (
 // ProviderTypeValidator is a validator for the "provider_type" field enum values. It is called by the builders before save.
 func ProviderTypeValidator(pt types.ModelProviderType) error {
 	switch pt {
-	case "anthropic", "openai", "gemini", "xai":
+	case "anthropic", "openai", "gemini", "xai", "deepseek", "bedrock", "replay", "mock":
 		return nil
 	default:
 		return fmt.Errorf("modelprovider: invalid enum value for provider_type field: %q", pt)
 	}
 }
 
+const DefaultKeySelection types.KeySelectionStrategy = "primary_backup"
+
+// KeySelectionValidator is a validator for the "key_selection" field enum values. It is called by the builders before save.
+func KeySelectionValidator(ks types.KeySelectionStrategy) error {
+	switch ks {
+	case "primary_backup", "round_robin", "least_recently_limited":
+		return nil
+	default:
+		return fmt.Errorf("modelprovider: invalid enum value for key_selection field: %q", ks)
+	}
+}
+
 // OrderOption defines the ordering options for the ModelProvider queries.
 type OrderOption func(*sql.Selector)
 
@@ -131,6 +158,11 @@ func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
 }
 
+// ByKeySelection orders the results by the key_selection field.
+func ByKeySelection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeySelection, opts...).ToFunc()
+}
+
 // ByModelsCount orders the results by models count.
 func ByModelsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -144,6 +176,20 @@ func ByModels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newModelsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByKeysCount orders the results by keys count.
+func ByKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newKeysStep(), opts...)
+	}
+}
+
+// ByKeys orders the results by keys terms.
+func ByKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newModelsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -151,3 +197,10 @@ func newModelsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ModelsTable, ModelsColumn),
 	)
 }
+func newKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(KeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, KeysTable, KeysColumn),
+	)
+}
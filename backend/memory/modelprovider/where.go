@@ -387,6 +387,46 @@ func EnabledNEQ(v bool) predicate.ModelProvider {
 	return predicate.ModelProvider(sql.FieldNEQ(FieldEnabled, v))
 }
 
+// RateLimitIsNil applies the IsNil predicate on the "rate_limit" field.
+func RateLimitIsNil() predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldIsNull(FieldRateLimit))
+}
+
+// RateLimitNotNil applies the NotNil predicate on the "rate_limit" field.
+func RateLimitNotNil() predicate.ModelProvider {
+	return predicate.ModelProvider(sql.FieldNotNull(FieldRateLimit))
+}
+
+// KeySelectionEQ applies the EQ predicate on the "key_selection" field.
+func KeySelectionEQ(v types.KeySelectionStrategy) predicate.ModelProvider {
+	vc := v
+	return predicate.ModelProvider(sql.FieldEQ(FieldKeySelection, vc))
+}
+
+// KeySelectionNEQ applies the NEQ predicate on the "key_selection" field.
+func KeySelectionNEQ(v types.KeySelectionStrategy) predicate.ModelProvider {
+	vc := v
+	return predicate.ModelProvider(sql.FieldNEQ(FieldKeySelection, vc))
+}
+
+// KeySelectionIn applies the In predicate on the "key_selection" field.
+func KeySelectionIn(vs ...types.KeySelectionStrategy) predicate.ModelProvider {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ModelProvider(sql.FieldIn(FieldKeySelection, v...))
+}
+
+// KeySelectionNotIn applies the NotIn predicate on the "key_selection" field.
+func KeySelectionNotIn(vs ...types.KeySelectionStrategy) predicate.ModelProvider {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.ModelProvider(sql.FieldNotIn(FieldKeySelection, v...))
+}
+
 // HasModels applies the HasEdge predicate on the "models" edge.
 func HasModels() predicate.ModelProvider {
 	return predicate.ModelProvider(func(s *sql.Selector) {
@@ -410,6 +450,29 @@ func HasModelsWith(preds ...predicate.Model) predicate.ModelProvider {
 	})
 }
 
+// HasKeys applies the HasEdge predicate on the "keys" edge.
+func HasKeys() predicate.ModelProvider {
+	return predicate.ModelProvider(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, KeysTable, KeysColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasKeysWith applies the HasEdge predicate on the "keys" edge with a given conditions (other predicates).
+func HasKeysWith(preds ...predicate.ModelProviderKey) predicate.ModelProvider {
+	return predicate.ModelProvider(func(s *sql.Selector) {
+		step := newKeysStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.ModelProvider) predicate.ModelProvider {
 	return predicate.ModelProvider(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
@@ -97,6 +98,26 @@ func (mpc *ModelProviderCreate) SetNillableEnabled(b *bool) *ModelProviderCreate
 	return mpc
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (mpc *ModelProviderCreate) SetRateLimit(tprl *types.ModelProviderRateLimit) *ModelProviderCreate {
+	mpc.mutation.SetRateLimit(tprl)
+	return mpc
+}
+
+// SetKeySelection sets the "key_selection" field.
+func (mpc *ModelProviderCreate) SetKeySelection(tss types.KeySelectionStrategy) *ModelProviderCreate {
+	mpc.mutation.SetKeySelection(tss)
+	return mpc
+}
+
+// SetNillableKeySelection sets the "key_selection" field if the given value is not nil.
+func (mpc *ModelProviderCreate) SetNillableKeySelection(tss *types.KeySelectionStrategy) *ModelProviderCreate {
+	if tss != nil {
+		mpc.SetKeySelection(*tss)
+	}
+	return mpc
+}
+
 // SetID sets the "id" field.
 func (mpc *ModelProviderCreate) SetID(u uuid.UUID) *ModelProviderCreate {
 	mpc.mutation.SetID(u)
@@ -126,6 +147,21 @@ func (mpc *ModelProviderCreate) AddModels(m ...*Model) *ModelProviderCreate {
 	return mpc.AddModelIDs(ids...)
 }
 
+// AddKeyIDs adds the "keys" edge to the ModelProviderKey entity by IDs.
+func (mpc *ModelProviderCreate) AddKeyIDs(ids ...uuid.UUID) *ModelProviderCreate {
+	mpc.mutation.AddKeyIDs(ids...)
+	return mpc
+}
+
+// AddKeys adds the "keys" edges to the ModelProviderKey entity.
+func (mpc *ModelProviderCreate) AddKeys(m ...*ModelProviderKey) *ModelProviderCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mpc.AddKeyIDs(ids...)
+}
+
 // Mutation returns the ModelProviderMutation object of the builder.
 func (mpc *ModelProviderCreate) Mutation() *ModelProviderMutation {
 	return mpc.mutation
@@ -173,6 +209,10 @@ func (mpc *ModelProviderCreate) defaults() {
 		v := modelprovider.DefaultEnabled
 		mpc.mutation.SetEnabled(v)
 	}
+	if _, ok := mpc.mutation.KeySelection(); !ok {
+		v := modelprovider.DefaultKeySelection
+		mpc.mutation.SetKeySelection(v)
+	}
 	if _, ok := mpc.mutation.ID(); !ok {
 		v := modelprovider.DefaultID()
 		mpc.mutation.SetID(v)
@@ -214,6 +254,14 @@ func (mpc *ModelProviderCreate) check() error {
 	if _, ok := mpc.mutation.Enabled(); !ok {
 		return &ValidationError{Name: "enabled", err: errors.New(`memory: missing required field "ModelProvider.enabled"`)}
 	}
+	if _, ok := mpc.mutation.KeySelection(); !ok {
+		return &ValidationError{Name: "key_selection", err: errors.New(`memory: missing required field "ModelProvider.key_selection"`)}
+	}
+	if v, ok := mpc.mutation.KeySelection(); ok {
+		if err := modelprovider.KeySelectionValidator(v); err != nil {
+			return &ValidationError{Name: "key_selection", err: fmt.Errorf(`memory: validator failed for field "ModelProvider.key_selection": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -277,6 +325,14 @@ func (mpc *ModelProviderCreate) createSpec() (*ModelProvider, *sqlgraph.CreateSp
 		_spec.SetField(modelprovider.FieldEnabled, field.TypeBool, value)
 		_node.Enabled = value
 	}
+	if value, ok := mpc.mutation.RateLimit(); ok {
+		_spec.SetField(modelprovider.FieldRateLimit, field.TypeJSON, value)
+		_node.RateLimit = value
+	}
+	if value, ok := mpc.mutation.KeySelection(); ok {
+		_spec.SetField(modelprovider.FieldKeySelection, field.TypeEnum, value)
+		_node.KeySelection = value
+	}
 	if nodes := mpc.mutation.ModelsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -293,6 +349,22 @@ func (mpc *ModelProviderCreate) createSpec() (*ModelProvider, *sqlgraph.CreateSp
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := mpc.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,7 @@ type ModelProviderQuery struct {
 	inters     []Interceptor
 	predicates []predicate.ModelProvider
 	withModels *ModelQuery
+	withKeys   *ModelProviderKeyQuery
 	modifiers  []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -85,6 +87,28 @@ func (mpq *ModelProviderQuery) QueryModels() *ModelQuery {
 	return query
 }
 
+// QueryKeys chains the current query on the "keys" edge.
+func (mpq *ModelProviderQuery) QueryKeys() *ModelProviderKeyQuery {
+	query := (&ModelProviderKeyClient{config: mpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modelprovider.Table, modelprovider.FieldID, selector),
+			sqlgraph.To(modelproviderkey.Table, modelproviderkey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, modelprovider.KeysTable, modelprovider.KeysColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first ModelProvider entity from the query.
 // Returns a *NotFoundError when no ModelProvider was found.
 func (mpq *ModelProviderQuery) First(ctx context.Context) (*ModelProvider, error) {
@@ -278,6 +302,7 @@ func (mpq *ModelProviderQuery) Clone() *ModelProviderQuery {
 		inters:     append([]Interceptor{}, mpq.inters...),
 		predicates: append([]predicate.ModelProvider{}, mpq.predicates...),
 		withModels: mpq.withModels.Clone(),
+		withKeys:   mpq.withKeys.Clone(),
 		// clone intermediate query.
 		sql:       mpq.sql.Clone(),
 		path:      mpq.path,
@@ -296,6 +321,17 @@ func (mpq *ModelProviderQuery) WithModels(opts ...func(*ModelQuery)) *ModelProvi
 	return mpq
 }
 
+// WithKeys tells the query-builder to eager-load the nodes that are connected to
+// the "keys" edge. The optional arguments are used to configure the query builder of the edge.
+func (mpq *ModelProviderQuery) WithKeys(opts ...func(*ModelProviderKeyQuery)) *ModelProviderQuery {
+	query := (&ModelProviderKeyClient{config: mpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mpq.withKeys = query
+	return mpq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -374,8 +410,9 @@ func (mpq *ModelProviderQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*ModelProvider{}
 		_spec       = mpq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			mpq.withModels != nil,
+			mpq.withKeys != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -406,6 +443,13 @@ func (mpq *ModelProviderQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := mpq.withKeys; query != nil {
+		if err := mpq.loadKeys(ctx, query, nodes,
+			func(n *ModelProvider) { n.Edges.Keys = []*ModelProviderKey{} },
+			func(n *ModelProvider, e *ModelProviderKey) { n.Edges.Keys = append(n.Edges.Keys, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -439,6 +483,36 @@ func (mpq *ModelProviderQuery) loadModels(ctx context.Context, query *ModelQuery
 	}
 	return nil
 }
+func (mpq *ModelProviderQuery) loadKeys(ctx context.Context, query *ModelProviderKeyQuery, nodes []*ModelProvider, init func(*ModelProvider), assign func(*ModelProvider, *ModelProviderKey)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*ModelProvider)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(modelproviderkey.FieldModelProviderID)
+	}
+	query.Where(predicate.ModelProviderKey(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(modelprovider.KeysColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ModelProviderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "model_provider_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (mpq *ModelProviderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := mpq.querySpec()
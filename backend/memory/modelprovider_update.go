@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/model"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
@@ -106,6 +107,32 @@ func (mpu *ModelProviderUpdate) SetNillableEnabled(b *bool) *ModelProviderUpdate
 	return mpu
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (mpu *ModelProviderUpdate) SetRateLimit(tprl *types.ModelProviderRateLimit) *ModelProviderUpdate {
+	mpu.mutation.SetRateLimit(tprl)
+	return mpu
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (mpu *ModelProviderUpdate) ClearRateLimit() *ModelProviderUpdate {
+	mpu.mutation.ClearRateLimit()
+	return mpu
+}
+
+// SetKeySelection sets the "key_selection" field.
+func (mpu *ModelProviderUpdate) SetKeySelection(tss types.KeySelectionStrategy) *ModelProviderUpdate {
+	mpu.mutation.SetKeySelection(tss)
+	return mpu
+}
+
+// SetNillableKeySelection sets the "key_selection" field if the given value is not nil.
+func (mpu *ModelProviderUpdate) SetNillableKeySelection(tss *types.KeySelectionStrategy) *ModelProviderUpdate {
+	if tss != nil {
+		mpu.SetKeySelection(*tss)
+	}
+	return mpu
+}
+
 // AddModelIDs adds the "models" edge to the Model entity by IDs.
 func (mpu *ModelProviderUpdate) AddModelIDs(ids ...uuid.UUID) *ModelProviderUpdate {
 	mpu.mutation.AddModelIDs(ids...)
@@ -121,6 +148,21 @@ func (mpu *ModelProviderUpdate) AddModels(m ...*Model) *ModelProviderUpdate {
 	return mpu.AddModelIDs(ids...)
 }
 
+// AddKeyIDs adds the "keys" edge to the ModelProviderKey entity by IDs.
+func (mpu *ModelProviderUpdate) AddKeyIDs(ids ...uuid.UUID) *ModelProviderUpdate {
+	mpu.mutation.AddKeyIDs(ids...)
+	return mpu
+}
+
+// AddKeys adds the "keys" edges to the ModelProviderKey entity.
+func (mpu *ModelProviderUpdate) AddKeys(m ...*ModelProviderKey) *ModelProviderUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mpu.AddKeyIDs(ids...)
+}
+
 // Mutation returns the ModelProviderMutation object of the builder.
 func (mpu *ModelProviderUpdate) Mutation() *ModelProviderMutation {
 	return mpu.mutation
@@ -147,6 +189,27 @@ func (mpu *ModelProviderUpdate) RemoveModels(m ...*Model) *ModelProviderUpdate {
 	return mpu.RemoveModelIDs(ids...)
 }
 
+// ClearKeys clears all "keys" edges to the ModelProviderKey entity.
+func (mpu *ModelProviderUpdate) ClearKeys() *ModelProviderUpdate {
+	mpu.mutation.ClearKeys()
+	return mpu
+}
+
+// RemoveKeyIDs removes the "keys" edge to ModelProviderKey entities by IDs.
+func (mpu *ModelProviderUpdate) RemoveKeyIDs(ids ...uuid.UUID) *ModelProviderUpdate {
+	mpu.mutation.RemoveKeyIDs(ids...)
+	return mpu
+}
+
+// RemoveKeys removes "keys" edges to ModelProviderKey entities.
+func (mpu *ModelProviderUpdate) RemoveKeys(m ...*ModelProviderKey) *ModelProviderUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mpu.RemoveKeyIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (mpu *ModelProviderUpdate) Save(ctx context.Context) (int, error) {
 	mpu.defaults()
@@ -200,6 +263,11 @@ func (mpu *ModelProviderUpdate) check() error {
 			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "ModelProvider.secret": %w`, err)}
 		}
 	}
+	if v, ok := mpu.mutation.KeySelection(); ok {
+		if err := modelprovider.KeySelectionValidator(v); err != nil {
+			return &ValidationError{Name: "key_selection", err: fmt.Errorf(`memory: validator failed for field "ModelProvider.key_selection": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -242,6 +310,15 @@ func (mpu *ModelProviderUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := mpu.mutation.Enabled(); ok {
 		_spec.SetField(modelprovider.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := mpu.mutation.RateLimit(); ok {
+		_spec.SetField(modelprovider.FieldRateLimit, field.TypeJSON, value)
+	}
+	if mpu.mutation.RateLimitCleared() {
+		_spec.ClearField(modelprovider.FieldRateLimit, field.TypeJSON)
+	}
+	if value, ok := mpu.mutation.KeySelection(); ok {
+		_spec.SetField(modelprovider.FieldKeySelection, field.TypeEnum, value)
+	}
 	if mpu.mutation.ModelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -287,6 +364,51 @@ func (mpu *ModelProviderUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mpu.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mpu.mutation.RemovedKeysIDs(); len(nodes) > 0 && !mpu.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mpu.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(mpu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, mpu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -383,6 +505,32 @@ func (mpuo *ModelProviderUpdateOne) SetNillableEnabled(b *bool) *ModelProviderUp
 	return mpuo
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (mpuo *ModelProviderUpdateOne) SetRateLimit(tprl *types.ModelProviderRateLimit) *ModelProviderUpdateOne {
+	mpuo.mutation.SetRateLimit(tprl)
+	return mpuo
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (mpuo *ModelProviderUpdateOne) ClearRateLimit() *ModelProviderUpdateOne {
+	mpuo.mutation.ClearRateLimit()
+	return mpuo
+}
+
+// SetKeySelection sets the "key_selection" field.
+func (mpuo *ModelProviderUpdateOne) SetKeySelection(tss types.KeySelectionStrategy) *ModelProviderUpdateOne {
+	mpuo.mutation.SetKeySelection(tss)
+	return mpuo
+}
+
+// SetNillableKeySelection sets the "key_selection" field if the given value is not nil.
+func (mpuo *ModelProviderUpdateOne) SetNillableKeySelection(tss *types.KeySelectionStrategy) *ModelProviderUpdateOne {
+	if tss != nil {
+		mpuo.SetKeySelection(*tss)
+	}
+	return mpuo
+}
+
 // AddModelIDs adds the "models" edge to the Model entity by IDs.
 func (mpuo *ModelProviderUpdateOne) AddModelIDs(ids ...uuid.UUID) *ModelProviderUpdateOne {
 	mpuo.mutation.AddModelIDs(ids...)
@@ -398,6 +546,21 @@ func (mpuo *ModelProviderUpdateOne) AddModels(m ...*Model) *ModelProviderUpdateO
 	return mpuo.AddModelIDs(ids...)
 }
 
+// AddKeyIDs adds the "keys" edge to the ModelProviderKey entity by IDs.
+func (mpuo *ModelProviderUpdateOne) AddKeyIDs(ids ...uuid.UUID) *ModelProviderUpdateOne {
+	mpuo.mutation.AddKeyIDs(ids...)
+	return mpuo
+}
+
+// AddKeys adds the "keys" edges to the ModelProviderKey entity.
+func (mpuo *ModelProviderUpdateOne) AddKeys(m ...*ModelProviderKey) *ModelProviderUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mpuo.AddKeyIDs(ids...)
+}
+
 // Mutation returns the ModelProviderMutation object of the builder.
 func (mpuo *ModelProviderUpdateOne) Mutation() *ModelProviderMutation {
 	return mpuo.mutation
@@ -424,6 +587,27 @@ func (mpuo *ModelProviderUpdateOne) RemoveModels(m ...*Model) *ModelProviderUpda
 	return mpuo.RemoveModelIDs(ids...)
 }
 
+// ClearKeys clears all "keys" edges to the ModelProviderKey entity.
+func (mpuo *ModelProviderUpdateOne) ClearKeys() *ModelProviderUpdateOne {
+	mpuo.mutation.ClearKeys()
+	return mpuo
+}
+
+// RemoveKeyIDs removes the "keys" edge to ModelProviderKey entities by IDs.
+func (mpuo *ModelProviderUpdateOne) RemoveKeyIDs(ids ...uuid.UUID) *ModelProviderUpdateOne {
+	mpuo.mutation.RemoveKeyIDs(ids...)
+	return mpuo
+}
+
+// RemoveKeys removes "keys" edges to ModelProviderKey entities.
+func (mpuo *ModelProviderUpdateOne) RemoveKeys(m ...*ModelProviderKey) *ModelProviderUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return mpuo.RemoveKeyIDs(ids...)
+}
+
 // Where appends a list predicates to the ModelProviderUpdate builder.
 func (mpuo *ModelProviderUpdateOne) Where(ps ...predicate.ModelProvider) *ModelProviderUpdateOne {
 	mpuo.mutation.Where(ps...)
@@ -490,6 +674,11 @@ func (mpuo *ModelProviderUpdateOne) check() error {
 			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "ModelProvider.secret": %w`, err)}
 		}
 	}
+	if v, ok := mpuo.mutation.KeySelection(); ok {
+		if err := modelprovider.KeySelectionValidator(v); err != nil {
+			return &ValidationError{Name: "key_selection", err: fmt.Errorf(`memory: validator failed for field "ModelProvider.key_selection": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -549,6 +738,15 @@ func (mpuo *ModelProviderUpdateOne) sqlSave(ctx context.Context) (_node *ModelPr
 	if value, ok := mpuo.mutation.Enabled(); ok {
 		_spec.SetField(modelprovider.FieldEnabled, field.TypeBool, value)
 	}
+	if value, ok := mpuo.mutation.RateLimit(); ok {
+		_spec.SetField(modelprovider.FieldRateLimit, field.TypeJSON, value)
+	}
+	if mpuo.mutation.RateLimitCleared() {
+		_spec.ClearField(modelprovider.FieldRateLimit, field.TypeJSON)
+	}
+	if value, ok := mpuo.mutation.KeySelection(); ok {
+		_spec.SetField(modelprovider.FieldKeySelection, field.TypeEnum, value)
+	}
 	if mpuo.mutation.ModelsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -594,6 +792,51 @@ func (mpuo *ModelProviderUpdateOne) sqlSave(ctx context.Context) (_node *ModelPr
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if mpuo.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mpuo.mutation.RemovedKeysIDs(); len(nodes) > 0 && !mpuo.mutation.KeysCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mpuo.mutation.KeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   modelprovider.KeysTable,
+			Columns: []string{modelprovider.KeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(mpuo.modifiers...)
 	_node = &ModelProvider{config: mpuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,295 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
+	"github.com/google/uuid"
+)
+
+// ModelProviderKey is the model entity for the ModelProviderKey schema.
+type ModelProviderKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret []byte `json:"-"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// RetiredTime holds the value of the "retired_time" field.
+	RetiredTime time.Time `json:"retired_time,omitempty"`
+	// LastUsedTime holds the value of the "last_used_time" field.
+	LastUsedTime time.Time `json:"last_used_time,omitempty"`
+	// LastRateLimitedTime holds the value of the "last_rate_limited_time" field.
+	LastRateLimitedTime time.Time `json:"last_rate_limited_time,omitempty"`
+	// RequestCount holds the value of the "request_count" field.
+	RequestCount int64 `json:"request_count,omitempty"`
+	// InputTokens holds the value of the "input_tokens" field.
+	InputTokens int64 `json:"input_tokens,omitempty"`
+	// OutputTokens holds the value of the "output_tokens" field.
+	OutputTokens int64 `json:"output_tokens,omitempty"`
+	// CacheWriteTokens holds the value of the "cache_write_tokens" field.
+	CacheWriteTokens int64 `json:"cache_write_tokens,omitempty"`
+	// CacheReadTokens holds the value of the "cache_read_tokens" field.
+	CacheReadTokens int64 `json:"cache_read_tokens,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost float64 `json:"cost,omitempty"`
+	// ModelProviderID holds the value of the "model_provider_id" field.
+	ModelProviderID uuid.UUID `json:"model_provider_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ModelProviderKeyQuery when eager-loading is set.
+	Edges        ModelProviderKeyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ModelProviderKeyEdges holds the relations/edges for other nodes in the graph.
+type ModelProviderKeyEdges struct {
+	// ModelProvider holds the value of the model_provider edge.
+	ModelProvider *ModelProvider `json:"model_provider,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ModelProviderOrErr returns the ModelProvider value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ModelProviderKeyEdges) ModelProviderOrErr() (*ModelProvider, error) {
+	if e.ModelProvider != nil {
+		return e.ModelProvider, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: modelprovider.Label}
+	}
+	return nil, &NotLoadedError{edge: "model_provider"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModelProviderKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case modelproviderkey.FieldSecret:
+			values[i] = new([]byte)
+		case modelproviderkey.FieldCost:
+			values[i] = new(sql.NullFloat64)
+		case modelproviderkey.FieldPriority, modelproviderkey.FieldRequestCount, modelproviderkey.FieldInputTokens, modelproviderkey.FieldOutputTokens, modelproviderkey.FieldCacheWriteTokens, modelproviderkey.FieldCacheReadTokens:
+			values[i] = new(sql.NullInt64)
+		case modelproviderkey.FieldName:
+			values[i] = new(sql.NullString)
+		case modelproviderkey.FieldCreateTime, modelproviderkey.FieldUpdateTime, modelproviderkey.FieldRetiredTime, modelproviderkey.FieldLastUsedTime, modelproviderkey.FieldLastRateLimitedTime:
+			values[i] = new(sql.NullTime)
+		case modelproviderkey.FieldID, modelproviderkey.FieldModelProviderID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModelProviderKey fields.
+func (mpk *ModelProviderKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case modelproviderkey.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mpk.ID = *value
+			}
+		case modelproviderkey.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				mpk.CreateTime = value.Time
+			}
+		case modelproviderkey.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				mpk.UpdateTime = value.Time
+			}
+		case modelproviderkey.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				mpk.Name = value.String
+			}
+		case modelproviderkey.FieldSecret:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value != nil {
+				mpk.Secret = *value
+			}
+		case modelproviderkey.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				mpk.Priority = int(value.Int64)
+			}
+		case modelproviderkey.FieldRetiredTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field retired_time", values[i])
+			} else if value.Valid {
+				mpk.RetiredTime = value.Time
+			}
+		case modelproviderkey.FieldLastUsedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_time", values[i])
+			} else if value.Valid {
+				mpk.LastUsedTime = value.Time
+			}
+		case modelproviderkey.FieldLastRateLimitedTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_rate_limited_time", values[i])
+			} else if value.Valid {
+				mpk.LastRateLimitedTime = value.Time
+			}
+		case modelproviderkey.FieldRequestCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field request_count", values[i])
+			} else if value.Valid {
+				mpk.RequestCount = value.Int64
+			}
+		case modelproviderkey.FieldInputTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_tokens", values[i])
+			} else if value.Valid {
+				mpk.InputTokens = value.Int64
+			}
+		case modelproviderkey.FieldOutputTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_tokens", values[i])
+			} else if value.Valid {
+				mpk.OutputTokens = value.Int64
+			}
+		case modelproviderkey.FieldCacheWriteTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_write_tokens", values[i])
+			} else if value.Valid {
+				mpk.CacheWriteTokens = value.Int64
+			}
+		case modelproviderkey.FieldCacheReadTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_read_tokens", values[i])
+			} else if value.Valid {
+				mpk.CacheReadTokens = value.Int64
+			}
+		case modelproviderkey.FieldCost:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				mpk.Cost = value.Float64
+			}
+		case modelproviderkey.FieldModelProviderID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field model_provider_id", values[i])
+			} else if value != nil {
+				mpk.ModelProviderID = *value
+			}
+		default:
+			mpk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModelProviderKey.
+// This includes values selected through modifiers, order, etc.
+func (mpk *ModelProviderKey) Value(name string) (ent.Value, error) {
+	return mpk.selectValues.Get(name)
+}
+
+// QueryModelProvider queries the "model_provider" edge of the ModelProviderKey entity.
+func (mpk *ModelProviderKey) QueryModelProvider() *ModelProviderQuery {
+	return NewModelProviderKeyClient(mpk.config).QueryModelProvider(mpk)
+}
+
+// Update returns a builder for updating this ModelProviderKey.
+// Note that you need to call ModelProviderKey.Unwrap() before calling this method if this ModelProviderKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mpk *ModelProviderKey) Update() *ModelProviderKeyUpdateOne {
+	return NewModelProviderKeyClient(mpk.config).UpdateOne(mpk)
+}
+
+// Unwrap unwraps the ModelProviderKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mpk *ModelProviderKey) Unwrap() *ModelProviderKey {
+	_tx, ok := mpk.config.driver.(*txDriver)
+	if !ok {
+		panic("memory: ModelProviderKey is not a transactional entity")
+	}
+	mpk.config.driver = _tx.drv
+	return mpk
+}
+
+// String implements the fmt.Stringer.
+func (mpk *ModelProviderKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModelProviderKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mpk.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(mpk.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(mpk.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(mpk.Name)
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("retired_time=")
+	builder.WriteString(mpk.RetiredTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_used_time=")
+	builder.WriteString(mpk.LastUsedTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("last_rate_limited_time=")
+	builder.WriteString(mpk.LastRateLimitedTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("request_count=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.RequestCount))
+	builder.WriteString(", ")
+	builder.WriteString("input_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.InputTokens))
+	builder.WriteString(", ")
+	builder.WriteString("output_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.OutputTokens))
+	builder.WriteString(", ")
+	builder.WriteString("cache_write_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.CacheWriteTokens))
+	builder.WriteString(", ")
+	builder.WriteString("cache_read_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.CacheReadTokens))
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("model_provider_id=")
+	builder.WriteString(fmt.Sprintf("%v", mpk.ModelProviderID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModelProviderKeys is a parsable slice of ModelProviderKey.
+type ModelProviderKeys []*ModelProviderKey
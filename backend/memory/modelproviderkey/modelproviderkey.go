@@ -0,0 +1,210 @@
+// Code generated by ent. DO NOT EDIT.
+
+package modelproviderkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the modelproviderkey type in the database.
+	Label = "model_provider_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSecret holds the string denoting the secret field in the database.
+	FieldSecret = "secret"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldRetiredTime holds the string denoting the retired_time field in the database.
+	FieldRetiredTime = "retired_time"
+	// FieldLastUsedTime holds the string denoting the last_used_time field in the database.
+	FieldLastUsedTime = "last_used_time"
+	// FieldLastRateLimitedTime holds the string denoting the last_rate_limited_time field in the database.
+	FieldLastRateLimitedTime = "last_rate_limited_time"
+	// FieldRequestCount holds the string denoting the request_count field in the database.
+	FieldRequestCount = "request_count"
+	// FieldInputTokens holds the string denoting the input_tokens field in the database.
+	FieldInputTokens = "input_tokens"
+	// FieldOutputTokens holds the string denoting the output_tokens field in the database.
+	FieldOutputTokens = "output_tokens"
+	// FieldCacheWriteTokens holds the string denoting the cache_write_tokens field in the database.
+	FieldCacheWriteTokens = "cache_write_tokens"
+	// FieldCacheReadTokens holds the string denoting the cache_read_tokens field in the database.
+	FieldCacheReadTokens = "cache_read_tokens"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldModelProviderID holds the string denoting the model_provider_id field in the database.
+	FieldModelProviderID = "model_provider_id"
+	// EdgeModelProvider holds the string denoting the model_provider edge name in mutations.
+	EdgeModelProvider = "model_provider"
+	// Table holds the table name of the modelproviderkey in the database.
+	Table = "model_provider_keys"
+	// ModelProviderTable is the table that holds the model_provider relation/edge.
+	ModelProviderTable = "model_provider_keys"
+	// ModelProviderInverseTable is the table name for the ModelProvider entity.
+	// It exists in this package in order to avoid circular dependency with the "modelprovider" package.
+	ModelProviderInverseTable = "model_providers"
+	// ModelProviderColumn is the table column denoting the model_provider relation/edge.
+	ModelProviderColumn = "model_provider_id"
+)
+
+// Columns holds all SQL columns for modelproviderkey fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldName,
+	FieldSecret,
+	FieldPriority,
+	FieldRetiredTime,
+	FieldLastUsedTime,
+	FieldLastRateLimitedTime,
+	FieldRequestCount,
+	FieldInputTokens,
+	FieldOutputTokens,
+	FieldCacheWriteTokens,
+	FieldCacheReadTokens,
+	FieldCost,
+	FieldModelProviderID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	SecretValidator func([]byte) error
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority int
+	// DefaultRequestCount holds the default value on creation for the "request_count" field.
+	DefaultRequestCount int64
+	// DefaultInputTokens holds the default value on creation for the "input_tokens" field.
+	DefaultInputTokens int64
+	// DefaultOutputTokens holds the default value on creation for the "output_tokens" field.
+	DefaultOutputTokens int64
+	// DefaultCacheWriteTokens holds the default value on creation for the "cache_write_tokens" field.
+	DefaultCacheWriteTokens int64
+	// DefaultCacheReadTokens holds the default value on creation for the "cache_read_tokens" field.
+	DefaultCacheReadTokens int64
+	// DefaultCost holds the default value on creation for the "cost" field.
+	DefaultCost float64
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ModelProviderKey queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByRetiredTime orders the results by the retired_time field.
+func ByRetiredTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetiredTime, opts...).ToFunc()
+}
+
+// ByLastUsedTime orders the results by the last_used_time field.
+func ByLastUsedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedTime, opts...).ToFunc()
+}
+
+// ByLastRateLimitedTime orders the results by the last_rate_limited_time field.
+func ByLastRateLimitedTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRateLimitedTime, opts...).ToFunc()
+}
+
+// ByRequestCount orders the results by the request_count field.
+func ByRequestCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequestCount, opts...).ToFunc()
+}
+
+// ByInputTokens orders the results by the input_tokens field.
+func ByInputTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInputTokens, opts...).ToFunc()
+}
+
+// ByOutputTokens orders the results by the output_tokens field.
+func ByOutputTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputTokens, opts...).ToFunc()
+}
+
+// ByCacheWriteTokens orders the results by the cache_write_tokens field.
+func ByCacheWriteTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheWriteTokens, opts...).ToFunc()
+}
+
+// ByCacheReadTokens orders the results by the cache_read_tokens field.
+func ByCacheReadTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheReadTokens, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByModelProviderID orders the results by the model_provider_id field.
+func ByModelProviderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelProviderID, opts...).ToFunc()
+}
+
+// ByModelProviderField orders the results by model_provider field.
+func ByModelProviderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModelProviderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newModelProviderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModelProviderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ModelProviderTable, ModelProviderColumn),
+	)
+}
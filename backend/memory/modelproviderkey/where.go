@@ -0,0 +1,805 @@
+// Code generated by ent. DO NOT EDIT.
+
+package modelproviderkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldName, v))
+}
+
+// Secret applies equality check predicate on the "secret" field. It's identical to SecretEQ.
+func Secret(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldSecret, v))
+}
+
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldPriority, v))
+}
+
+// RetiredTime applies equality check predicate on the "retired_time" field. It's identical to RetiredTimeEQ.
+func RetiredTime(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldRetiredTime, v))
+}
+
+// LastUsedTime applies equality check predicate on the "last_used_time" field. It's identical to LastUsedTimeEQ.
+func LastUsedTime(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldLastUsedTime, v))
+}
+
+// LastRateLimitedTime applies equality check predicate on the "last_rate_limited_time" field. It's identical to LastRateLimitedTimeEQ.
+func LastRateLimitedTime(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldLastRateLimitedTime, v))
+}
+
+// RequestCount applies equality check predicate on the "request_count" field. It's identical to RequestCountEQ.
+func RequestCount(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// InputTokens applies equality check predicate on the "input_tokens" field. It's identical to InputTokensEQ.
+func InputTokens(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldInputTokens, v))
+}
+
+// OutputTokens applies equality check predicate on the "output_tokens" field. It's identical to OutputTokensEQ.
+func OutputTokens(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldOutputTokens, v))
+}
+
+// CacheWriteTokens applies equality check predicate on the "cache_write_tokens" field. It's identical to CacheWriteTokensEQ.
+func CacheWriteTokens(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCacheWriteTokens, v))
+}
+
+// CacheReadTokens applies equality check predicate on the "cache_read_tokens" field. It's identical to CacheReadTokensEQ.
+func CacheReadTokens(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCacheReadTokens, v))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCost, v))
+}
+
+// ModelProviderID applies equality check predicate on the "model_provider_id" field. It's identical to ModelProviderIDEQ.
+func ModelProviderID(v uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldModelProviderID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldContainsFold(FieldName, v))
+}
+
+// SecretEQ applies the EQ predicate on the "secret" field.
+func SecretEQ(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldSecret, v))
+}
+
+// SecretNEQ applies the NEQ predicate on the "secret" field.
+func SecretNEQ(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldSecret, v))
+}
+
+// SecretIn applies the In predicate on the "secret" field.
+func SecretIn(vs ...[]byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldSecret, vs...))
+}
+
+// SecretNotIn applies the NotIn predicate on the "secret" field.
+func SecretNotIn(vs ...[]byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldSecret, vs...))
+}
+
+// SecretGT applies the GT predicate on the "secret" field.
+func SecretGT(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldSecret, v))
+}
+
+// SecretGTE applies the GTE predicate on the "secret" field.
+func SecretGTE(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldSecret, v))
+}
+
+// SecretLT applies the LT predicate on the "secret" field.
+func SecretLT(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldSecret, v))
+}
+
+// SecretLTE applies the LTE predicate on the "secret" field.
+func SecretLTE(v []byte) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldSecret, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v int) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldPriority, v))
+}
+
+// RetiredTimeEQ applies the EQ predicate on the "retired_time" field.
+func RetiredTimeEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldRetiredTime, v))
+}
+
+// RetiredTimeNEQ applies the NEQ predicate on the "retired_time" field.
+func RetiredTimeNEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldRetiredTime, v))
+}
+
+// RetiredTimeIn applies the In predicate on the "retired_time" field.
+func RetiredTimeIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldRetiredTime, vs...))
+}
+
+// RetiredTimeNotIn applies the NotIn predicate on the "retired_time" field.
+func RetiredTimeNotIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldRetiredTime, vs...))
+}
+
+// RetiredTimeGT applies the GT predicate on the "retired_time" field.
+func RetiredTimeGT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldRetiredTime, v))
+}
+
+// RetiredTimeGTE applies the GTE predicate on the "retired_time" field.
+func RetiredTimeGTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldRetiredTime, v))
+}
+
+// RetiredTimeLT applies the LT predicate on the "retired_time" field.
+func RetiredTimeLT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldRetiredTime, v))
+}
+
+// RetiredTimeLTE applies the LTE predicate on the "retired_time" field.
+func RetiredTimeLTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldRetiredTime, v))
+}
+
+// RetiredTimeIsNil applies the IsNil predicate on the "retired_time" field.
+func RetiredTimeIsNil() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIsNull(FieldRetiredTime))
+}
+
+// RetiredTimeNotNil applies the NotNil predicate on the "retired_time" field.
+func RetiredTimeNotNil() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotNull(FieldRetiredTime))
+}
+
+// LastUsedTimeEQ applies the EQ predicate on the "last_used_time" field.
+func LastUsedTimeEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldLastUsedTime, v))
+}
+
+// LastUsedTimeNEQ applies the NEQ predicate on the "last_used_time" field.
+func LastUsedTimeNEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldLastUsedTime, v))
+}
+
+// LastUsedTimeIn applies the In predicate on the "last_used_time" field.
+func LastUsedTimeIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldLastUsedTime, vs...))
+}
+
+// LastUsedTimeNotIn applies the NotIn predicate on the "last_used_time" field.
+func LastUsedTimeNotIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldLastUsedTime, vs...))
+}
+
+// LastUsedTimeGT applies the GT predicate on the "last_used_time" field.
+func LastUsedTimeGT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldLastUsedTime, v))
+}
+
+// LastUsedTimeGTE applies the GTE predicate on the "last_used_time" field.
+func LastUsedTimeGTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldLastUsedTime, v))
+}
+
+// LastUsedTimeLT applies the LT predicate on the "last_used_time" field.
+func LastUsedTimeLT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldLastUsedTime, v))
+}
+
+// LastUsedTimeLTE applies the LTE predicate on the "last_used_time" field.
+func LastUsedTimeLTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldLastUsedTime, v))
+}
+
+// LastUsedTimeIsNil applies the IsNil predicate on the "last_used_time" field.
+func LastUsedTimeIsNil() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIsNull(FieldLastUsedTime))
+}
+
+// LastUsedTimeNotNil applies the NotNil predicate on the "last_used_time" field.
+func LastUsedTimeNotNil() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotNull(FieldLastUsedTime))
+}
+
+// LastRateLimitedTimeEQ applies the EQ predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldLastRateLimitedTime, v))
+}
+
+// LastRateLimitedTimeNEQ applies the NEQ predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeNEQ(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldLastRateLimitedTime, v))
+}
+
+// LastRateLimitedTimeIn applies the In predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldLastRateLimitedTime, vs...))
+}
+
+// LastRateLimitedTimeNotIn applies the NotIn predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeNotIn(vs ...time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldLastRateLimitedTime, vs...))
+}
+
+// LastRateLimitedTimeGT applies the GT predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeGT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldLastRateLimitedTime, v))
+}
+
+// LastRateLimitedTimeGTE applies the GTE predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeGTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldLastRateLimitedTime, v))
+}
+
+// LastRateLimitedTimeLT applies the LT predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeLT(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldLastRateLimitedTime, v))
+}
+
+// LastRateLimitedTimeLTE applies the LTE predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeLTE(v time.Time) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldLastRateLimitedTime, v))
+}
+
+// LastRateLimitedTimeIsNil applies the IsNil predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeIsNil() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIsNull(FieldLastRateLimitedTime))
+}
+
+// LastRateLimitedTimeNotNil applies the NotNil predicate on the "last_rate_limited_time" field.
+func LastRateLimitedTimeNotNil() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotNull(FieldLastRateLimitedTime))
+}
+
+// RequestCountEQ applies the EQ predicate on the "request_count" field.
+func RequestCountEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldRequestCount, v))
+}
+
+// RequestCountNEQ applies the NEQ predicate on the "request_count" field.
+func RequestCountNEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldRequestCount, v))
+}
+
+// RequestCountIn applies the In predicate on the "request_count" field.
+func RequestCountIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldRequestCount, vs...))
+}
+
+// RequestCountNotIn applies the NotIn predicate on the "request_count" field.
+func RequestCountNotIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldRequestCount, vs...))
+}
+
+// RequestCountGT applies the GT predicate on the "request_count" field.
+func RequestCountGT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldRequestCount, v))
+}
+
+// RequestCountGTE applies the GTE predicate on the "request_count" field.
+func RequestCountGTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldRequestCount, v))
+}
+
+// RequestCountLT applies the LT predicate on the "request_count" field.
+func RequestCountLT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldRequestCount, v))
+}
+
+// RequestCountLTE applies the LTE predicate on the "request_count" field.
+func RequestCountLTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldRequestCount, v))
+}
+
+// InputTokensEQ applies the EQ predicate on the "input_tokens" field.
+func InputTokensEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldInputTokens, v))
+}
+
+// InputTokensNEQ applies the NEQ predicate on the "input_tokens" field.
+func InputTokensNEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldInputTokens, v))
+}
+
+// InputTokensIn applies the In predicate on the "input_tokens" field.
+func InputTokensIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldInputTokens, vs...))
+}
+
+// InputTokensNotIn applies the NotIn predicate on the "input_tokens" field.
+func InputTokensNotIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldInputTokens, vs...))
+}
+
+// InputTokensGT applies the GT predicate on the "input_tokens" field.
+func InputTokensGT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldInputTokens, v))
+}
+
+// InputTokensGTE applies the GTE predicate on the "input_tokens" field.
+func InputTokensGTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldInputTokens, v))
+}
+
+// InputTokensLT applies the LT predicate on the "input_tokens" field.
+func InputTokensLT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldInputTokens, v))
+}
+
+// InputTokensLTE applies the LTE predicate on the "input_tokens" field.
+func InputTokensLTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldInputTokens, v))
+}
+
+// OutputTokensEQ applies the EQ predicate on the "output_tokens" field.
+func OutputTokensEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldOutputTokens, v))
+}
+
+// OutputTokensNEQ applies the NEQ predicate on the "output_tokens" field.
+func OutputTokensNEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldOutputTokens, v))
+}
+
+// OutputTokensIn applies the In predicate on the "output_tokens" field.
+func OutputTokensIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldOutputTokens, vs...))
+}
+
+// OutputTokensNotIn applies the NotIn predicate on the "output_tokens" field.
+func OutputTokensNotIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldOutputTokens, vs...))
+}
+
+// OutputTokensGT applies the GT predicate on the "output_tokens" field.
+func OutputTokensGT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldOutputTokens, v))
+}
+
+// OutputTokensGTE applies the GTE predicate on the "output_tokens" field.
+func OutputTokensGTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldOutputTokens, v))
+}
+
+// OutputTokensLT applies the LT predicate on the "output_tokens" field.
+func OutputTokensLT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldOutputTokens, v))
+}
+
+// OutputTokensLTE applies the LTE predicate on the "output_tokens" field.
+func OutputTokensLTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldOutputTokens, v))
+}
+
+// CacheWriteTokensEQ applies the EQ predicate on the "cache_write_tokens" field.
+func CacheWriteTokensEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCacheWriteTokens, v))
+}
+
+// CacheWriteTokensNEQ applies the NEQ predicate on the "cache_write_tokens" field.
+func CacheWriteTokensNEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldCacheWriteTokens, v))
+}
+
+// CacheWriteTokensIn applies the In predicate on the "cache_write_tokens" field.
+func CacheWriteTokensIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldCacheWriteTokens, vs...))
+}
+
+// CacheWriteTokensNotIn applies the NotIn predicate on the "cache_write_tokens" field.
+func CacheWriteTokensNotIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldCacheWriteTokens, vs...))
+}
+
+// CacheWriteTokensGT applies the GT predicate on the "cache_write_tokens" field.
+func CacheWriteTokensGT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldCacheWriteTokens, v))
+}
+
+// CacheWriteTokensGTE applies the GTE predicate on the "cache_write_tokens" field.
+func CacheWriteTokensGTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldCacheWriteTokens, v))
+}
+
+// CacheWriteTokensLT applies the LT predicate on the "cache_write_tokens" field.
+func CacheWriteTokensLT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldCacheWriteTokens, v))
+}
+
+// CacheWriteTokensLTE applies the LTE predicate on the "cache_write_tokens" field.
+func CacheWriteTokensLTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldCacheWriteTokens, v))
+}
+
+// CacheReadTokensEQ applies the EQ predicate on the "cache_read_tokens" field.
+func CacheReadTokensEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCacheReadTokens, v))
+}
+
+// CacheReadTokensNEQ applies the NEQ predicate on the "cache_read_tokens" field.
+func CacheReadTokensNEQ(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldCacheReadTokens, v))
+}
+
+// CacheReadTokensIn applies the In predicate on the "cache_read_tokens" field.
+func CacheReadTokensIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldCacheReadTokens, vs...))
+}
+
+// CacheReadTokensNotIn applies the NotIn predicate on the "cache_read_tokens" field.
+func CacheReadTokensNotIn(vs ...int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldCacheReadTokens, vs...))
+}
+
+// CacheReadTokensGT applies the GT predicate on the "cache_read_tokens" field.
+func CacheReadTokensGT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldCacheReadTokens, v))
+}
+
+// CacheReadTokensGTE applies the GTE predicate on the "cache_read_tokens" field.
+func CacheReadTokensGTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldCacheReadTokens, v))
+}
+
+// CacheReadTokensLT applies the LT predicate on the "cache_read_tokens" field.
+func CacheReadTokensLT(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldCacheReadTokens, v))
+}
+
+// CacheReadTokensLTE applies the LTE predicate on the "cache_read_tokens" field.
+func CacheReadTokensLTE(v int64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldCacheReadTokens, v))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v float64) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldLTE(FieldCost, v))
+}
+
+// ModelProviderIDEQ applies the EQ predicate on the "model_provider_id" field.
+func ModelProviderIDEQ(v uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldEQ(FieldModelProviderID, v))
+}
+
+// ModelProviderIDNEQ applies the NEQ predicate on the "model_provider_id" field.
+func ModelProviderIDNEQ(v uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNEQ(FieldModelProviderID, v))
+}
+
+// ModelProviderIDIn applies the In predicate on the "model_provider_id" field.
+func ModelProviderIDIn(vs ...uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldIn(FieldModelProviderID, vs...))
+}
+
+// ModelProviderIDNotIn applies the NotIn predicate on the "model_provider_id" field.
+func ModelProviderIDNotIn(vs ...uuid.UUID) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.FieldNotIn(FieldModelProviderID, vs...))
+}
+
+// HasModelProvider applies the HasEdge predicate on the "model_provider" edge.
+func HasModelProvider() predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ModelProviderTable, ModelProviderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModelProviderWith applies the HasEdge predicate on the "model_provider" edge with a given conditions (other predicates).
+func HasModelProviderWith(preds ...predicate.ModelProvider) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(func(s *sql.Selector) {
+		step := newModelProviderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModelProviderKey) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModelProviderKey) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModelProviderKey) predicate.ModelProviderKey {
+	return predicate.ModelProviderKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,551 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
+	"github.com/google/uuid"
+)
+
+// ModelProviderKeyCreate is the builder for creating a ModelProviderKey entity.
+type ModelProviderKeyCreate struct {
+	config
+	mutation *ModelProviderKeyMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (mpkc *ModelProviderKeyCreate) SetCreateTime(t time.Time) *ModelProviderKeyCreate {
+	mpkc.mutation.SetCreateTime(t)
+	return mpkc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableCreateTime(t *time.Time) *ModelProviderKeyCreate {
+	if t != nil {
+		mpkc.SetCreateTime(*t)
+	}
+	return mpkc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mpkc *ModelProviderKeyCreate) SetUpdateTime(t time.Time) *ModelProviderKeyCreate {
+	mpkc.mutation.SetUpdateTime(t)
+	return mpkc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableUpdateTime(t *time.Time) *ModelProviderKeyCreate {
+	if t != nil {
+		mpkc.SetUpdateTime(*t)
+	}
+	return mpkc
+}
+
+// SetName sets the "name" field.
+func (mpkc *ModelProviderKeyCreate) SetName(s string) *ModelProviderKeyCreate {
+	mpkc.mutation.SetName(s)
+	return mpkc
+}
+
+// SetSecret sets the "secret" field.
+func (mpkc *ModelProviderKeyCreate) SetSecret(b []byte) *ModelProviderKeyCreate {
+	mpkc.mutation.SetSecret(b)
+	return mpkc
+}
+
+// SetPriority sets the "priority" field.
+func (mpkc *ModelProviderKeyCreate) SetPriority(i int) *ModelProviderKeyCreate {
+	mpkc.mutation.SetPriority(i)
+	return mpkc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillablePriority(i *int) *ModelProviderKeyCreate {
+	if i != nil {
+		mpkc.SetPriority(*i)
+	}
+	return mpkc
+}
+
+// SetRetiredTime sets the "retired_time" field.
+func (mpkc *ModelProviderKeyCreate) SetRetiredTime(t time.Time) *ModelProviderKeyCreate {
+	mpkc.mutation.SetRetiredTime(t)
+	return mpkc
+}
+
+// SetNillableRetiredTime sets the "retired_time" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableRetiredTime(t *time.Time) *ModelProviderKeyCreate {
+	if t != nil {
+		mpkc.SetRetiredTime(*t)
+	}
+	return mpkc
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (mpkc *ModelProviderKeyCreate) SetLastUsedTime(t time.Time) *ModelProviderKeyCreate {
+	mpkc.mutation.SetLastUsedTime(t)
+	return mpkc
+}
+
+// SetNillableLastUsedTime sets the "last_used_time" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableLastUsedTime(t *time.Time) *ModelProviderKeyCreate {
+	if t != nil {
+		mpkc.SetLastUsedTime(*t)
+	}
+	return mpkc
+}
+
+// SetLastRateLimitedTime sets the "last_rate_limited_time" field.
+func (mpkc *ModelProviderKeyCreate) SetLastRateLimitedTime(t time.Time) *ModelProviderKeyCreate {
+	mpkc.mutation.SetLastRateLimitedTime(t)
+	return mpkc
+}
+
+// SetNillableLastRateLimitedTime sets the "last_rate_limited_time" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableLastRateLimitedTime(t *time.Time) *ModelProviderKeyCreate {
+	if t != nil {
+		mpkc.SetLastRateLimitedTime(*t)
+	}
+	return mpkc
+}
+
+// SetRequestCount sets the "request_count" field.
+func (mpkc *ModelProviderKeyCreate) SetRequestCount(i int64) *ModelProviderKeyCreate {
+	mpkc.mutation.SetRequestCount(i)
+	return mpkc
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableRequestCount(i *int64) *ModelProviderKeyCreate {
+	if i != nil {
+		mpkc.SetRequestCount(*i)
+	}
+	return mpkc
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (mpkc *ModelProviderKeyCreate) SetInputTokens(i int64) *ModelProviderKeyCreate {
+	mpkc.mutation.SetInputTokens(i)
+	return mpkc
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableInputTokens(i *int64) *ModelProviderKeyCreate {
+	if i != nil {
+		mpkc.SetInputTokens(*i)
+	}
+	return mpkc
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (mpkc *ModelProviderKeyCreate) SetOutputTokens(i int64) *ModelProviderKeyCreate {
+	mpkc.mutation.SetOutputTokens(i)
+	return mpkc
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableOutputTokens(i *int64) *ModelProviderKeyCreate {
+	if i != nil {
+		mpkc.SetOutputTokens(*i)
+	}
+	return mpkc
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (mpkc *ModelProviderKeyCreate) SetCacheWriteTokens(i int64) *ModelProviderKeyCreate {
+	mpkc.mutation.SetCacheWriteTokens(i)
+	return mpkc
+}
+
+// SetNillableCacheWriteTokens sets the "cache_write_tokens" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableCacheWriteTokens(i *int64) *ModelProviderKeyCreate {
+	if i != nil {
+		mpkc.SetCacheWriteTokens(*i)
+	}
+	return mpkc
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (mpkc *ModelProviderKeyCreate) SetCacheReadTokens(i int64) *ModelProviderKeyCreate {
+	mpkc.mutation.SetCacheReadTokens(i)
+	return mpkc
+}
+
+// SetNillableCacheReadTokens sets the "cache_read_tokens" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableCacheReadTokens(i *int64) *ModelProviderKeyCreate {
+	if i != nil {
+		mpkc.SetCacheReadTokens(*i)
+	}
+	return mpkc
+}
+
+// SetCost sets the "cost" field.
+func (mpkc *ModelProviderKeyCreate) SetCost(f float64) *ModelProviderKeyCreate {
+	mpkc.mutation.SetCost(f)
+	return mpkc
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableCost(f *float64) *ModelProviderKeyCreate {
+	if f != nil {
+		mpkc.SetCost(*f)
+	}
+	return mpkc
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (mpkc *ModelProviderKeyCreate) SetModelProviderID(u uuid.UUID) *ModelProviderKeyCreate {
+	mpkc.mutation.SetModelProviderID(u)
+	return mpkc
+}
+
+// SetID sets the "id" field.
+func (mpkc *ModelProviderKeyCreate) SetID(u uuid.UUID) *ModelProviderKeyCreate {
+	mpkc.mutation.SetID(u)
+	return mpkc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mpkc *ModelProviderKeyCreate) SetNillableID(u *uuid.UUID) *ModelProviderKeyCreate {
+	if u != nil {
+		mpkc.SetID(*u)
+	}
+	return mpkc
+}
+
+// SetModelProvider sets the "model_provider" edge to the ModelProvider entity.
+func (mpkc *ModelProviderKeyCreate) SetModelProvider(m *ModelProvider) *ModelProviderKeyCreate {
+	return mpkc.SetModelProviderID(m.ID)
+}
+
+// Mutation returns the ModelProviderKeyMutation object of the builder.
+func (mpkc *ModelProviderKeyCreate) Mutation() *ModelProviderKeyMutation {
+	return mpkc.mutation
+}
+
+// Save creates the ModelProviderKey in the database.
+func (mpkc *ModelProviderKeyCreate) Save(ctx context.Context) (*ModelProviderKey, error) {
+	mpkc.defaults()
+	return withHooks(ctx, mpkc.sqlSave, mpkc.mutation, mpkc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mpkc *ModelProviderKeyCreate) SaveX(ctx context.Context) *ModelProviderKey {
+	v, err := mpkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpkc *ModelProviderKeyCreate) Exec(ctx context.Context) error {
+	_, err := mpkc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpkc *ModelProviderKeyCreate) ExecX(ctx context.Context) {
+	if err := mpkc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpkc *ModelProviderKeyCreate) defaults() {
+	if _, ok := mpkc.mutation.CreateTime(); !ok {
+		v := modelproviderkey.DefaultCreateTime()
+		mpkc.mutation.SetCreateTime(v)
+	}
+	if _, ok := mpkc.mutation.UpdateTime(); !ok {
+		v := modelproviderkey.DefaultUpdateTime()
+		mpkc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := mpkc.mutation.Priority(); !ok {
+		v := modelproviderkey.DefaultPriority
+		mpkc.mutation.SetPriority(v)
+	}
+	if _, ok := mpkc.mutation.RequestCount(); !ok {
+		v := modelproviderkey.DefaultRequestCount
+		mpkc.mutation.SetRequestCount(v)
+	}
+	if _, ok := mpkc.mutation.InputTokens(); !ok {
+		v := modelproviderkey.DefaultInputTokens
+		mpkc.mutation.SetInputTokens(v)
+	}
+	if _, ok := mpkc.mutation.OutputTokens(); !ok {
+		v := modelproviderkey.DefaultOutputTokens
+		mpkc.mutation.SetOutputTokens(v)
+	}
+	if _, ok := mpkc.mutation.CacheWriteTokens(); !ok {
+		v := modelproviderkey.DefaultCacheWriteTokens
+		mpkc.mutation.SetCacheWriteTokens(v)
+	}
+	if _, ok := mpkc.mutation.CacheReadTokens(); !ok {
+		v := modelproviderkey.DefaultCacheReadTokens
+		mpkc.mutation.SetCacheReadTokens(v)
+	}
+	if _, ok := mpkc.mutation.Cost(); !ok {
+		v := modelproviderkey.DefaultCost
+		mpkc.mutation.SetCost(v)
+	}
+	if _, ok := mpkc.mutation.ID(); !ok {
+		v := modelproviderkey.DefaultID()
+		mpkc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpkc *ModelProviderKeyCreate) check() error {
+	if _, ok := mpkc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "ModelProviderKey.create_time"`)}
+	}
+	if _, ok := mpkc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "ModelProviderKey.update_time"`)}
+	}
+	if _, ok := mpkc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`memory: missing required field "ModelProviderKey.name"`)}
+	}
+	if v, ok := mpkc.mutation.Name(); ok {
+		if err := modelproviderkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`memory: validator failed for field "ModelProviderKey.name": %w`, err)}
+		}
+	}
+	if _, ok := mpkc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`memory: missing required field "ModelProviderKey.secret"`)}
+	}
+	if v, ok := mpkc.mutation.Secret(); ok {
+		if err := modelproviderkey.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "ModelProviderKey.secret": %w`, err)}
+		}
+	}
+	if _, ok := mpkc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`memory: missing required field "ModelProviderKey.priority"`)}
+	}
+	if _, ok := mpkc.mutation.RequestCount(); !ok {
+		return &ValidationError{Name: "request_count", err: errors.New(`memory: missing required field "ModelProviderKey.request_count"`)}
+	}
+	if _, ok := mpkc.mutation.InputTokens(); !ok {
+		return &ValidationError{Name: "input_tokens", err: errors.New(`memory: missing required field "ModelProviderKey.input_tokens"`)}
+	}
+	if _, ok := mpkc.mutation.OutputTokens(); !ok {
+		return &ValidationError{Name: "output_tokens", err: errors.New(`memory: missing required field "ModelProviderKey.output_tokens"`)}
+	}
+	if _, ok := mpkc.mutation.CacheWriteTokens(); !ok {
+		return &ValidationError{Name: "cache_write_tokens", err: errors.New(`memory: missing required field "ModelProviderKey.cache_write_tokens"`)}
+	}
+	if _, ok := mpkc.mutation.CacheReadTokens(); !ok {
+		return &ValidationError{Name: "cache_read_tokens", err: errors.New(`memory: missing required field "ModelProviderKey.cache_read_tokens"`)}
+	}
+	if _, ok := mpkc.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`memory: missing required field "ModelProviderKey.cost"`)}
+	}
+	if _, ok := mpkc.mutation.ModelProviderID(); !ok {
+		return &ValidationError{Name: "model_provider_id", err: errors.New(`memory: missing required field "ModelProviderKey.model_provider_id"`)}
+	}
+	if len(mpkc.mutation.ModelProviderIDs()) == 0 {
+		return &ValidationError{Name: "model_provider", err: errors.New(`memory: missing required edge "ModelProviderKey.model_provider"`)}
+	}
+	return nil
+}
+
+func (mpkc *ModelProviderKeyCreate) sqlSave(ctx context.Context) (*ModelProviderKey, error) {
+	if err := mpkc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mpkc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mpkc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mpkc.mutation.id = &_node.ID
+	mpkc.mutation.done = true
+	return _node, nil
+}
+
+func (mpkc *ModelProviderKeyCreate) createSpec() (*ModelProviderKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModelProviderKey{config: mpkc.config}
+		_spec = sqlgraph.NewCreateSpec(modelproviderkey.Table, sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID))
+	)
+	if id, ok := mpkc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mpkc.mutation.CreateTime(); ok {
+		_spec.SetField(modelproviderkey.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := mpkc.mutation.UpdateTime(); ok {
+		_spec.SetField(modelproviderkey.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := mpkc.mutation.Name(); ok {
+		_spec.SetField(modelproviderkey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mpkc.mutation.Secret(); ok {
+		_spec.SetField(modelproviderkey.FieldSecret, field.TypeBytes, value)
+		_node.Secret = value
+	}
+	if value, ok := mpkc.mutation.Priority(); ok {
+		_spec.SetField(modelproviderkey.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := mpkc.mutation.RetiredTime(); ok {
+		_spec.SetField(modelproviderkey.FieldRetiredTime, field.TypeTime, value)
+		_node.RetiredTime = value
+	}
+	if value, ok := mpkc.mutation.LastUsedTime(); ok {
+		_spec.SetField(modelproviderkey.FieldLastUsedTime, field.TypeTime, value)
+		_node.LastUsedTime = value
+	}
+	if value, ok := mpkc.mutation.LastRateLimitedTime(); ok {
+		_spec.SetField(modelproviderkey.FieldLastRateLimitedTime, field.TypeTime, value)
+		_node.LastRateLimitedTime = value
+	}
+	if value, ok := mpkc.mutation.RequestCount(); ok {
+		_spec.SetField(modelproviderkey.FieldRequestCount, field.TypeInt64, value)
+		_node.RequestCount = value
+	}
+	if value, ok := mpkc.mutation.InputTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldInputTokens, field.TypeInt64, value)
+		_node.InputTokens = value
+	}
+	if value, ok := mpkc.mutation.OutputTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldOutputTokens, field.TypeInt64, value)
+		_node.OutputTokens = value
+	}
+	if value, ok := mpkc.mutation.CacheWriteTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldCacheWriteTokens, field.TypeInt64, value)
+		_node.CacheWriteTokens = value
+	}
+	if value, ok := mpkc.mutation.CacheReadTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldCacheReadTokens, field.TypeInt64, value)
+		_node.CacheReadTokens = value
+	}
+	if value, ok := mpkc.mutation.Cost(); ok {
+		_spec.SetField(modelproviderkey.FieldCost, field.TypeFloat64, value)
+		_node.Cost = value
+	}
+	if nodes := mpkc.mutation.ModelProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelproviderkey.ModelProviderTable,
+			Columns: []string{modelproviderkey.ModelProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelprovider.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ModelProviderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ModelProviderKeyCreateBulk is the builder for creating many ModelProviderKey entities in bulk.
+type ModelProviderKeyCreateBulk struct {
+	config
+	err      error
+	builders []*ModelProviderKeyCreate
+}
+
+// Save creates the ModelProviderKey entities in the database.
+func (mpkcb *ModelProviderKeyCreateBulk) Save(ctx context.Context) ([]*ModelProviderKey, error) {
+	if mpkcb.err != nil {
+		return nil, mpkcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mpkcb.builders))
+	nodes := make([]*ModelProviderKey, len(mpkcb.builders))
+	mutators := make([]Mutator, len(mpkcb.builders))
+	for i := range mpkcb.builders {
+		func(i int, root context.Context) {
+			builder := mpkcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModelProviderKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mpkcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mpkcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mpkcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpkcb *ModelProviderKeyCreateBulk) SaveX(ctx context.Context) []*ModelProviderKey {
+	v, err := mpkcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mpkcb *ModelProviderKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := mpkcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpkcb *ModelProviderKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := mpkcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
+	"github.com/furisto/construct/backend/memory/predicate"
+)
+
+// ModelProviderKeyDelete is the builder for deleting a ModelProviderKey entity.
+type ModelProviderKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModelProviderKeyMutation
+}
+
+// Where appends a list predicates to the ModelProviderKeyDelete builder.
+func (mpkd *ModelProviderKeyDelete) Where(ps ...predicate.ModelProviderKey) *ModelProviderKeyDelete {
+	mpkd.mutation.Where(ps...)
+	return mpkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mpkd *ModelProviderKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mpkd.sqlExec, mpkd.mutation, mpkd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpkd *ModelProviderKeyDelete) ExecX(ctx context.Context) int {
+	n, err := mpkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mpkd *ModelProviderKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(modelproviderkey.Table, sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID))
+	if ps := mpkd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mpkd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mpkd.mutation.done = true
+	return affected, err
+}
+
+// ModelProviderKeyDeleteOne is the builder for deleting a single ModelProviderKey entity.
+type ModelProviderKeyDeleteOne struct {
+	mpkd *ModelProviderKeyDelete
+}
+
+// Where appends a list predicates to the ModelProviderKeyDelete builder.
+func (mpkdo *ModelProviderKeyDeleteOne) Where(ps ...predicate.ModelProviderKey) *ModelProviderKeyDeleteOne {
+	mpkdo.mpkd.mutation.Where(ps...)
+	return mpkdo
+}
+
+// Exec executes the deletion query.
+func (mpkdo *ModelProviderKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := mpkdo.mpkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{modelproviderkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpkdo *ModelProviderKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := mpkdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,630 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/google/uuid"
+)
+
+// ModelProviderKeyQuery is the builder for querying ModelProviderKey entities.
+type ModelProviderKeyQuery struct {
+	config
+	ctx               *QueryContext
+	order             []modelproviderkey.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.ModelProviderKey
+	withModelProvider *ModelProviderQuery
+	modifiers         []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ModelProviderKeyQuery builder.
+func (mpkq *ModelProviderKeyQuery) Where(ps ...predicate.ModelProviderKey) *ModelProviderKeyQuery {
+	mpkq.predicates = append(mpkq.predicates, ps...)
+	return mpkq
+}
+
+// Limit the number of records to be returned by this query.
+func (mpkq *ModelProviderKeyQuery) Limit(limit int) *ModelProviderKeyQuery {
+	mpkq.ctx.Limit = &limit
+	return mpkq
+}
+
+// Offset to start from.
+func (mpkq *ModelProviderKeyQuery) Offset(offset int) *ModelProviderKeyQuery {
+	mpkq.ctx.Offset = &offset
+	return mpkq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mpkq *ModelProviderKeyQuery) Unique(unique bool) *ModelProviderKeyQuery {
+	mpkq.ctx.Unique = &unique
+	return mpkq
+}
+
+// Order specifies how the records should be ordered.
+func (mpkq *ModelProviderKeyQuery) Order(o ...modelproviderkey.OrderOption) *ModelProviderKeyQuery {
+	mpkq.order = append(mpkq.order, o...)
+	return mpkq
+}
+
+// QueryModelProvider chains the current query on the "model_provider" edge.
+func (mpkq *ModelProviderKeyQuery) QueryModelProvider() *ModelProviderQuery {
+	query := (&ModelProviderClient{config: mpkq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := mpkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := mpkq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(modelproviderkey.Table, modelproviderkey.FieldID, selector),
+			sqlgraph.To(modelprovider.Table, modelprovider.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, modelproviderkey.ModelProviderTable, modelproviderkey.ModelProviderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(mpkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ModelProviderKey entity from the query.
+// Returns a *NotFoundError when no ModelProviderKey was found.
+func (mpkq *ModelProviderKeyQuery) First(ctx context.Context) (*ModelProviderKey, error) {
+	nodes, err := mpkq.Limit(1).All(setContextOp(ctx, mpkq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{modelproviderkey.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) FirstX(ctx context.Context) *ModelProviderKey {
+	node, err := mpkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ModelProviderKey ID from the query.
+// Returns a *NotFoundError when no ModelProviderKey ID was found.
+func (mpkq *ModelProviderKeyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mpkq.Limit(1).IDs(setContextOp(ctx, mpkq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{modelproviderkey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mpkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ModelProviderKey entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ModelProviderKey entity is found.
+// Returns a *NotFoundError when no ModelProviderKey entities are found.
+func (mpkq *ModelProviderKeyQuery) Only(ctx context.Context) (*ModelProviderKey, error) {
+	nodes, err := mpkq.Limit(2).All(setContextOp(ctx, mpkq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{modelproviderkey.Label}
+	default:
+		return nil, &NotSingularError{modelproviderkey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) OnlyX(ctx context.Context) *ModelProviderKey {
+	node, err := mpkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ModelProviderKey ID in the query.
+// Returns a *NotSingularError when more than one ModelProviderKey ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mpkq *ModelProviderKeyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mpkq.Limit(2).IDs(setContextOp(ctx, mpkq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{modelproviderkey.Label}
+	default:
+		err = &NotSingularError{modelproviderkey.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mpkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ModelProviderKeys.
+func (mpkq *ModelProviderKeyQuery) All(ctx context.Context) ([]*ModelProviderKey, error) {
+	ctx = setContextOp(ctx, mpkq.ctx, ent.OpQueryAll)
+	if err := mpkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ModelProviderKey, *ModelProviderKeyQuery]()
+	return withInterceptors[[]*ModelProviderKey](ctx, mpkq, qr, mpkq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) AllX(ctx context.Context) []*ModelProviderKey {
+	nodes, err := mpkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ModelProviderKey IDs.
+func (mpkq *ModelProviderKeyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mpkq.ctx.Unique == nil && mpkq.path != nil {
+		mpkq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mpkq.ctx, ent.OpQueryIDs)
+	if err = mpkq.Select(modelproviderkey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mpkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mpkq *ModelProviderKeyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mpkq.ctx, ent.OpQueryCount)
+	if err := mpkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mpkq, querierCount[*ModelProviderKeyQuery](), mpkq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) CountX(ctx context.Context) int {
+	count, err := mpkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mpkq *ModelProviderKeyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mpkq.ctx, ent.OpQueryExist)
+	switch _, err := mpkq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("memory: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mpkq *ModelProviderKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := mpkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ModelProviderKeyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mpkq *ModelProviderKeyQuery) Clone() *ModelProviderKeyQuery {
+	if mpkq == nil {
+		return nil
+	}
+	return &ModelProviderKeyQuery{
+		config:            mpkq.config,
+		ctx:               mpkq.ctx.Clone(),
+		order:             append([]modelproviderkey.OrderOption{}, mpkq.order...),
+		inters:            append([]Interceptor{}, mpkq.inters...),
+		predicates:        append([]predicate.ModelProviderKey{}, mpkq.predicates...),
+		withModelProvider: mpkq.withModelProvider.Clone(),
+		// clone intermediate query.
+		sql:       mpkq.sql.Clone(),
+		path:      mpkq.path,
+		modifiers: append([]func(*sql.Selector){}, mpkq.modifiers...),
+	}
+}
+
+// WithModelProvider tells the query-builder to eager-load the nodes that are connected to
+// the "model_provider" edge. The optional arguments are used to configure the query builder of the edge.
+func (mpkq *ModelProviderKeyQuery) WithModelProvider(opts ...func(*ModelProviderQuery)) *ModelProviderKeyQuery {
+	query := (&ModelProviderClient{config: mpkq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	mpkq.withModelProvider = query
+	return mpkq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ModelProviderKey.Query().
+//		GroupBy(modelproviderkey.FieldCreateTime).
+//		Aggregate(memory.Count()).
+//		Scan(ctx, &v)
+func (mpkq *ModelProviderKeyQuery) GroupBy(field string, fields ...string) *ModelProviderKeyGroupBy {
+	mpkq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ModelProviderKeyGroupBy{build: mpkq}
+	grbuild.flds = &mpkq.ctx.Fields
+	grbuild.label = modelproviderkey.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.ModelProviderKey.Query().
+//		Select(modelproviderkey.FieldCreateTime).
+//		Scan(ctx, &v)
+func (mpkq *ModelProviderKeyQuery) Select(fields ...string) *ModelProviderKeySelect {
+	mpkq.ctx.Fields = append(mpkq.ctx.Fields, fields...)
+	sbuild := &ModelProviderKeySelect{ModelProviderKeyQuery: mpkq}
+	sbuild.label = modelproviderkey.Label
+	sbuild.flds, sbuild.scan = &mpkq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ModelProviderKeySelect configured with the given aggregations.
+func (mpkq *ModelProviderKeyQuery) Aggregate(fns ...AggregateFunc) *ModelProviderKeySelect {
+	return mpkq.Select().Aggregate(fns...)
+}
+
+func (mpkq *ModelProviderKeyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mpkq.inters {
+		if inter == nil {
+			return fmt.Errorf("memory: uninitialized interceptor (forgotten import memory/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mpkq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mpkq.ctx.Fields {
+		if !modelproviderkey.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+		}
+	}
+	if mpkq.path != nil {
+		prev, err := mpkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mpkq.sql = prev
+	}
+	return nil
+}
+
+func (mpkq *ModelProviderKeyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ModelProviderKey, error) {
+	var (
+		nodes       = []*ModelProviderKey{}
+		_spec       = mpkq.querySpec()
+		loadedTypes = [1]bool{
+			mpkq.withModelProvider != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ModelProviderKey).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ModelProviderKey{config: mpkq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(mpkq.modifiers) > 0 {
+		_spec.Modifiers = mpkq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mpkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := mpkq.withModelProvider; query != nil {
+		if err := mpkq.loadModelProvider(ctx, query, nodes, nil,
+			func(n *ModelProviderKey, e *ModelProvider) { n.Edges.ModelProvider = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (mpkq *ModelProviderKeyQuery) loadModelProvider(ctx context.Context, query *ModelProviderQuery, nodes []*ModelProviderKey, init func(*ModelProviderKey), assign func(*ModelProviderKey, *ModelProvider)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ModelProviderKey)
+	for i := range nodes {
+		fk := nodes[i].ModelProviderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(modelprovider.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "model_provider_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (mpkq *ModelProviderKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mpkq.querySpec()
+	if len(mpkq.modifiers) > 0 {
+		_spec.Modifiers = mpkq.modifiers
+	}
+	_spec.Node.Columns = mpkq.ctx.Fields
+	if len(mpkq.ctx.Fields) > 0 {
+		_spec.Unique = mpkq.ctx.Unique != nil && *mpkq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mpkq.driver, _spec)
+}
+
+func (mpkq *ModelProviderKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(modelproviderkey.Table, modelproviderkey.Columns, sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID))
+	_spec.From = mpkq.sql
+	if unique := mpkq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mpkq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mpkq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelproviderkey.FieldID)
+		for i := range fields {
+			if fields[i] != modelproviderkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if mpkq.withModelProvider != nil {
+			_spec.Node.AddColumnOnce(modelproviderkey.FieldModelProviderID)
+		}
+	}
+	if ps := mpkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mpkq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mpkq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mpkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mpkq *ModelProviderKeyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mpkq.driver.Dialect())
+	t1 := builder.Table(modelproviderkey.Table)
+	columns := mpkq.ctx.Fields
+	if len(columns) == 0 {
+		columns = modelproviderkey.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mpkq.sql != nil {
+		selector = mpkq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mpkq.ctx.Unique != nil && *mpkq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mpkq.modifiers {
+		m(selector)
+	}
+	for _, p := range mpkq.predicates {
+		p(selector)
+	}
+	for _, p := range mpkq.order {
+		p(selector)
+	}
+	if offset := mpkq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mpkq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mpkq *ModelProviderKeyQuery) Modify(modifiers ...func(s *sql.Selector)) *ModelProviderKeySelect {
+	mpkq.modifiers = append(mpkq.modifiers, modifiers...)
+	return mpkq.Select()
+}
+
+// ModelProviderKeyGroupBy is the group-by builder for ModelProviderKey entities.
+type ModelProviderKeyGroupBy struct {
+	selector
+	build *ModelProviderKeyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mpkgb *ModelProviderKeyGroupBy) Aggregate(fns ...AggregateFunc) *ModelProviderKeyGroupBy {
+	mpkgb.fns = append(mpkgb.fns, fns...)
+	return mpkgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpkgb *ModelProviderKeyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpkgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mpkgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModelProviderKeyQuery, *ModelProviderKeyGroupBy](ctx, mpkgb.build, mpkgb, mpkgb.build.inters, v)
+}
+
+func (mpkgb *ModelProviderKeyGroupBy) sqlScan(ctx context.Context, root *ModelProviderKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mpkgb.fns))
+	for _, fn := range mpkgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mpkgb.flds)+len(mpkgb.fns))
+		for _, f := range *mpkgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mpkgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpkgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ModelProviderKeySelect is the builder for selecting fields of ModelProviderKey entities.
+type ModelProviderKeySelect struct {
+	*ModelProviderKeyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mpks *ModelProviderKeySelect) Aggregate(fns ...AggregateFunc) *ModelProviderKeySelect {
+	mpks.fns = append(mpks.fns, fns...)
+	return mpks
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mpks *ModelProviderKeySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mpks.ctx, ent.OpQuerySelect)
+	if err := mpks.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModelProviderKeyQuery, *ModelProviderKeySelect](ctx, mpks.ModelProviderKeyQuery, mpks, mpks.inters, v)
+}
+
+func (mpks *ModelProviderKeySelect) sqlScan(ctx context.Context, root *ModelProviderKeyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mpks.fns))
+	for _, fn := range mpks.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mpks.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mpks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mpks *ModelProviderKeySelect) Modify(modifiers ...func(s *sql.Selector)) *ModelProviderKeySelect {
+	mpks.modifiers = append(mpks.modifiers, modifiers...)
+	return mpks
+}
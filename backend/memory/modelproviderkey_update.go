@@ -0,0 +1,966 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/google/uuid"
+)
+
+// ModelProviderKeyUpdate is the builder for updating ModelProviderKey entities.
+type ModelProviderKeyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ModelProviderKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ModelProviderKeyUpdate builder.
+func (mpku *ModelProviderKeyUpdate) Where(ps ...predicate.ModelProviderKey) *ModelProviderKeyUpdate {
+	mpku.mutation.Where(ps...)
+	return mpku
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mpku *ModelProviderKeyUpdate) SetUpdateTime(t time.Time) *ModelProviderKeyUpdate {
+	mpku.mutation.SetUpdateTime(t)
+	return mpku
+}
+
+// SetName sets the "name" field.
+func (mpku *ModelProviderKeyUpdate) SetName(s string) *ModelProviderKeyUpdate {
+	mpku.mutation.SetName(s)
+	return mpku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableName(s *string) *ModelProviderKeyUpdate {
+	if s != nil {
+		mpku.SetName(*s)
+	}
+	return mpku
+}
+
+// SetSecret sets the "secret" field.
+func (mpku *ModelProviderKeyUpdate) SetSecret(b []byte) *ModelProviderKeyUpdate {
+	mpku.mutation.SetSecret(b)
+	return mpku
+}
+
+// SetPriority sets the "priority" field.
+func (mpku *ModelProviderKeyUpdate) SetPriority(i int) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetPriority()
+	mpku.mutation.SetPriority(i)
+	return mpku
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillablePriority(i *int) *ModelProviderKeyUpdate {
+	if i != nil {
+		mpku.SetPriority(*i)
+	}
+	return mpku
+}
+
+// AddPriority adds i to the "priority" field.
+func (mpku *ModelProviderKeyUpdate) AddPriority(i int) *ModelProviderKeyUpdate {
+	mpku.mutation.AddPriority(i)
+	return mpku
+}
+
+// SetRetiredTime sets the "retired_time" field.
+func (mpku *ModelProviderKeyUpdate) SetRetiredTime(t time.Time) *ModelProviderKeyUpdate {
+	mpku.mutation.SetRetiredTime(t)
+	return mpku
+}
+
+// SetNillableRetiredTime sets the "retired_time" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableRetiredTime(t *time.Time) *ModelProviderKeyUpdate {
+	if t != nil {
+		mpku.SetRetiredTime(*t)
+	}
+	return mpku
+}
+
+// ClearRetiredTime clears the value of the "retired_time" field.
+func (mpku *ModelProviderKeyUpdate) ClearRetiredTime() *ModelProviderKeyUpdate {
+	mpku.mutation.ClearRetiredTime()
+	return mpku
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (mpku *ModelProviderKeyUpdate) SetLastUsedTime(t time.Time) *ModelProviderKeyUpdate {
+	mpku.mutation.SetLastUsedTime(t)
+	return mpku
+}
+
+// SetNillableLastUsedTime sets the "last_used_time" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableLastUsedTime(t *time.Time) *ModelProviderKeyUpdate {
+	if t != nil {
+		mpku.SetLastUsedTime(*t)
+	}
+	return mpku
+}
+
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (mpku *ModelProviderKeyUpdate) ClearLastUsedTime() *ModelProviderKeyUpdate {
+	mpku.mutation.ClearLastUsedTime()
+	return mpku
+}
+
+// SetLastRateLimitedTime sets the "last_rate_limited_time" field.
+func (mpku *ModelProviderKeyUpdate) SetLastRateLimitedTime(t time.Time) *ModelProviderKeyUpdate {
+	mpku.mutation.SetLastRateLimitedTime(t)
+	return mpku
+}
+
+// SetNillableLastRateLimitedTime sets the "last_rate_limited_time" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableLastRateLimitedTime(t *time.Time) *ModelProviderKeyUpdate {
+	if t != nil {
+		mpku.SetLastRateLimitedTime(*t)
+	}
+	return mpku
+}
+
+// ClearLastRateLimitedTime clears the value of the "last_rate_limited_time" field.
+func (mpku *ModelProviderKeyUpdate) ClearLastRateLimitedTime() *ModelProviderKeyUpdate {
+	mpku.mutation.ClearLastRateLimitedTime()
+	return mpku
+}
+
+// SetRequestCount sets the "request_count" field.
+func (mpku *ModelProviderKeyUpdate) SetRequestCount(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetRequestCount()
+	mpku.mutation.SetRequestCount(i)
+	return mpku
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableRequestCount(i *int64) *ModelProviderKeyUpdate {
+	if i != nil {
+		mpku.SetRequestCount(*i)
+	}
+	return mpku
+}
+
+// AddRequestCount adds i to the "request_count" field.
+func (mpku *ModelProviderKeyUpdate) AddRequestCount(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.AddRequestCount(i)
+	return mpku
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (mpku *ModelProviderKeyUpdate) SetInputTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetInputTokens()
+	mpku.mutation.SetInputTokens(i)
+	return mpku
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableInputTokens(i *int64) *ModelProviderKeyUpdate {
+	if i != nil {
+		mpku.SetInputTokens(*i)
+	}
+	return mpku
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (mpku *ModelProviderKeyUpdate) AddInputTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.AddInputTokens(i)
+	return mpku
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (mpku *ModelProviderKeyUpdate) SetOutputTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetOutputTokens()
+	mpku.mutation.SetOutputTokens(i)
+	return mpku
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableOutputTokens(i *int64) *ModelProviderKeyUpdate {
+	if i != nil {
+		mpku.SetOutputTokens(*i)
+	}
+	return mpku
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (mpku *ModelProviderKeyUpdate) AddOutputTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.AddOutputTokens(i)
+	return mpku
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (mpku *ModelProviderKeyUpdate) SetCacheWriteTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetCacheWriteTokens()
+	mpku.mutation.SetCacheWriteTokens(i)
+	return mpku
+}
+
+// SetNillableCacheWriteTokens sets the "cache_write_tokens" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableCacheWriteTokens(i *int64) *ModelProviderKeyUpdate {
+	if i != nil {
+		mpku.SetCacheWriteTokens(*i)
+	}
+	return mpku
+}
+
+// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
+func (mpku *ModelProviderKeyUpdate) AddCacheWriteTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.AddCacheWriteTokens(i)
+	return mpku
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (mpku *ModelProviderKeyUpdate) SetCacheReadTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetCacheReadTokens()
+	mpku.mutation.SetCacheReadTokens(i)
+	return mpku
+}
+
+// SetNillableCacheReadTokens sets the "cache_read_tokens" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableCacheReadTokens(i *int64) *ModelProviderKeyUpdate {
+	if i != nil {
+		mpku.SetCacheReadTokens(*i)
+	}
+	return mpku
+}
+
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (mpku *ModelProviderKeyUpdate) AddCacheReadTokens(i int64) *ModelProviderKeyUpdate {
+	mpku.mutation.AddCacheReadTokens(i)
+	return mpku
+}
+
+// SetCost sets the "cost" field.
+func (mpku *ModelProviderKeyUpdate) SetCost(f float64) *ModelProviderKeyUpdate {
+	mpku.mutation.ResetCost()
+	mpku.mutation.SetCost(f)
+	return mpku
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableCost(f *float64) *ModelProviderKeyUpdate {
+	if f != nil {
+		mpku.SetCost(*f)
+	}
+	return mpku
+}
+
+// AddCost adds f to the "cost" field.
+func (mpku *ModelProviderKeyUpdate) AddCost(f float64) *ModelProviderKeyUpdate {
+	mpku.mutation.AddCost(f)
+	return mpku
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (mpku *ModelProviderKeyUpdate) SetModelProviderID(u uuid.UUID) *ModelProviderKeyUpdate {
+	mpku.mutation.SetModelProviderID(u)
+	return mpku
+}
+
+// SetNillableModelProviderID sets the "model_provider_id" field if the given value is not nil.
+func (mpku *ModelProviderKeyUpdate) SetNillableModelProviderID(u *uuid.UUID) *ModelProviderKeyUpdate {
+	if u != nil {
+		mpku.SetModelProviderID(*u)
+	}
+	return mpku
+}
+
+// SetModelProvider sets the "model_provider" edge to the ModelProvider entity.
+func (mpku *ModelProviderKeyUpdate) SetModelProvider(m *ModelProvider) *ModelProviderKeyUpdate {
+	return mpku.SetModelProviderID(m.ID)
+}
+
+// Mutation returns the ModelProviderKeyMutation object of the builder.
+func (mpku *ModelProviderKeyUpdate) Mutation() *ModelProviderKeyMutation {
+	return mpku.mutation
+}
+
+// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
+func (mpku *ModelProviderKeyUpdate) ClearModelProvider() *ModelProviderKeyUpdate {
+	mpku.mutation.ClearModelProvider()
+	return mpku
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mpku *ModelProviderKeyUpdate) Save(ctx context.Context) (int, error) {
+	mpku.defaults()
+	return withHooks(ctx, mpku.sqlSave, mpku.mutation, mpku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpku *ModelProviderKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := mpku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mpku *ModelProviderKeyUpdate) Exec(ctx context.Context) error {
+	_, err := mpku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpku *ModelProviderKeyUpdate) ExecX(ctx context.Context) {
+	if err := mpku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpku *ModelProviderKeyUpdate) defaults() {
+	if _, ok := mpku.mutation.UpdateTime(); !ok {
+		v := modelproviderkey.UpdateDefaultUpdateTime()
+		mpku.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpku *ModelProviderKeyUpdate) check() error {
+	if v, ok := mpku.mutation.Name(); ok {
+		if err := modelproviderkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`memory: validator failed for field "ModelProviderKey.name": %w`, err)}
+		}
+	}
+	if v, ok := mpku.mutation.Secret(); ok {
+		if err := modelproviderkey.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "ModelProviderKey.secret": %w`, err)}
+		}
+	}
+	if mpku.mutation.ModelProviderCleared() && len(mpku.mutation.ModelProviderIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "ModelProviderKey.model_provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mpku *ModelProviderKeyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModelProviderKeyUpdate {
+	mpku.modifiers = append(mpku.modifiers, modifiers...)
+	return mpku
+}
+
+func (mpku *ModelProviderKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mpku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelproviderkey.Table, modelproviderkey.Columns, sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID))
+	if ps := mpku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpku.mutation.UpdateTime(); ok {
+		_spec.SetField(modelproviderkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := mpku.mutation.Name(); ok {
+		_spec.SetField(modelproviderkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := mpku.mutation.Secret(); ok {
+		_spec.SetField(modelproviderkey.FieldSecret, field.TypeBytes, value)
+	}
+	if value, ok := mpku.mutation.Priority(); ok {
+		_spec.SetField(modelproviderkey.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := mpku.mutation.AddedPriority(); ok {
+		_spec.AddField(modelproviderkey.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := mpku.mutation.RetiredTime(); ok {
+		_spec.SetField(modelproviderkey.FieldRetiredTime, field.TypeTime, value)
+	}
+	if mpku.mutation.RetiredTimeCleared() {
+		_spec.ClearField(modelproviderkey.FieldRetiredTime, field.TypeTime)
+	}
+	if value, ok := mpku.mutation.LastUsedTime(); ok {
+		_spec.SetField(modelproviderkey.FieldLastUsedTime, field.TypeTime, value)
+	}
+	if mpku.mutation.LastUsedTimeCleared() {
+		_spec.ClearField(modelproviderkey.FieldLastUsedTime, field.TypeTime)
+	}
+	if value, ok := mpku.mutation.LastRateLimitedTime(); ok {
+		_spec.SetField(modelproviderkey.FieldLastRateLimitedTime, field.TypeTime, value)
+	}
+	if mpku.mutation.LastRateLimitedTimeCleared() {
+		_spec.ClearField(modelproviderkey.FieldLastRateLimitedTime, field.TypeTime)
+	}
+	if value, ok := mpku.mutation.RequestCount(); ok {
+		_spec.SetField(modelproviderkey.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.AddedRequestCount(); ok {
+		_spec.AddField(modelproviderkey.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.InputTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.AddedInputTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.OutputTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.CacheWriteTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldCacheWriteTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.AddedCacheWriteTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldCacheWriteTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.CacheReadTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldCacheReadTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.AddedCacheReadTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldCacheReadTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpku.mutation.Cost(); ok {
+		_spec.SetField(modelproviderkey.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := mpku.mutation.AddedCost(); ok {
+		_spec.AddField(modelproviderkey.FieldCost, field.TypeFloat64, value)
+	}
+	if mpku.mutation.ModelProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelproviderkey.ModelProviderTable,
+			Columns: []string{modelproviderkey.ModelProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelprovider.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mpku.mutation.ModelProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelproviderkey.ModelProviderTable,
+			Columns: []string{modelproviderkey.ModelProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelprovider.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mpku.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mpku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelproviderkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mpku.mutation.done = true
+	return n, nil
+}
+
+// ModelProviderKeyUpdateOne is the builder for updating a single ModelProviderKey entity.
+type ModelProviderKeyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ModelProviderKeyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetUpdateTime(t time.Time) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetUpdateTime(t)
+	return mpkuo
+}
+
+// SetName sets the "name" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetName(s string) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetName(s)
+	return mpkuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableName(s *string) *ModelProviderKeyUpdateOne {
+	if s != nil {
+		mpkuo.SetName(*s)
+	}
+	return mpkuo
+}
+
+// SetSecret sets the "secret" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetSecret(b []byte) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetSecret(b)
+	return mpkuo
+}
+
+// SetPriority sets the "priority" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetPriority(i int) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetPriority()
+	mpkuo.mutation.SetPriority(i)
+	return mpkuo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillablePriority(i *int) *ModelProviderKeyUpdateOne {
+	if i != nil {
+		mpkuo.SetPriority(*i)
+	}
+	return mpkuo
+}
+
+// AddPriority adds i to the "priority" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddPriority(i int) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddPriority(i)
+	return mpkuo
+}
+
+// SetRetiredTime sets the "retired_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetRetiredTime(t time.Time) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetRetiredTime(t)
+	return mpkuo
+}
+
+// SetNillableRetiredTime sets the "retired_time" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableRetiredTime(t *time.Time) *ModelProviderKeyUpdateOne {
+	if t != nil {
+		mpkuo.SetRetiredTime(*t)
+	}
+	return mpkuo
+}
+
+// ClearRetiredTime clears the value of the "retired_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) ClearRetiredTime() *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ClearRetiredTime()
+	return mpkuo
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetLastUsedTime(t time.Time) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetLastUsedTime(t)
+	return mpkuo
+}
+
+// SetNillableLastUsedTime sets the "last_used_time" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableLastUsedTime(t *time.Time) *ModelProviderKeyUpdateOne {
+	if t != nil {
+		mpkuo.SetLastUsedTime(*t)
+	}
+	return mpkuo
+}
+
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) ClearLastUsedTime() *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ClearLastUsedTime()
+	return mpkuo
+}
+
+// SetLastRateLimitedTime sets the "last_rate_limited_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetLastRateLimitedTime(t time.Time) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetLastRateLimitedTime(t)
+	return mpkuo
+}
+
+// SetNillableLastRateLimitedTime sets the "last_rate_limited_time" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableLastRateLimitedTime(t *time.Time) *ModelProviderKeyUpdateOne {
+	if t != nil {
+		mpkuo.SetLastRateLimitedTime(*t)
+	}
+	return mpkuo
+}
+
+// ClearLastRateLimitedTime clears the value of the "last_rate_limited_time" field.
+func (mpkuo *ModelProviderKeyUpdateOne) ClearLastRateLimitedTime() *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ClearLastRateLimitedTime()
+	return mpkuo
+}
+
+// SetRequestCount sets the "request_count" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetRequestCount(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetRequestCount()
+	mpkuo.mutation.SetRequestCount(i)
+	return mpkuo
+}
+
+// SetNillableRequestCount sets the "request_count" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableRequestCount(i *int64) *ModelProviderKeyUpdateOne {
+	if i != nil {
+		mpkuo.SetRequestCount(*i)
+	}
+	return mpkuo
+}
+
+// AddRequestCount adds i to the "request_count" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddRequestCount(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddRequestCount(i)
+	return mpkuo
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetInputTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetInputTokens()
+	mpkuo.mutation.SetInputTokens(i)
+	return mpkuo
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableInputTokens(i *int64) *ModelProviderKeyUpdateOne {
+	if i != nil {
+		mpkuo.SetInputTokens(*i)
+	}
+	return mpkuo
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddInputTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddInputTokens(i)
+	return mpkuo
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetOutputTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetOutputTokens()
+	mpkuo.mutation.SetOutputTokens(i)
+	return mpkuo
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableOutputTokens(i *int64) *ModelProviderKeyUpdateOne {
+	if i != nil {
+		mpkuo.SetOutputTokens(*i)
+	}
+	return mpkuo
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddOutputTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddOutputTokens(i)
+	return mpkuo
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetCacheWriteTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetCacheWriteTokens()
+	mpkuo.mutation.SetCacheWriteTokens(i)
+	return mpkuo
+}
+
+// SetNillableCacheWriteTokens sets the "cache_write_tokens" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableCacheWriteTokens(i *int64) *ModelProviderKeyUpdateOne {
+	if i != nil {
+		mpkuo.SetCacheWriteTokens(*i)
+	}
+	return mpkuo
+}
+
+// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddCacheWriteTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddCacheWriteTokens(i)
+	return mpkuo
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetCacheReadTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetCacheReadTokens()
+	mpkuo.mutation.SetCacheReadTokens(i)
+	return mpkuo
+}
+
+// SetNillableCacheReadTokens sets the "cache_read_tokens" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableCacheReadTokens(i *int64) *ModelProviderKeyUpdateOne {
+	if i != nil {
+		mpkuo.SetCacheReadTokens(*i)
+	}
+	return mpkuo
+}
+
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddCacheReadTokens(i int64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddCacheReadTokens(i)
+	return mpkuo
+}
+
+// SetCost sets the "cost" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetCost(f float64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ResetCost()
+	mpkuo.mutation.SetCost(f)
+	return mpkuo
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableCost(f *float64) *ModelProviderKeyUpdateOne {
+	if f != nil {
+		mpkuo.SetCost(*f)
+	}
+	return mpkuo
+}
+
+// AddCost adds f to the "cost" field.
+func (mpkuo *ModelProviderKeyUpdateOne) AddCost(f float64) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.AddCost(f)
+	return mpkuo
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (mpkuo *ModelProviderKeyUpdateOne) SetModelProviderID(u uuid.UUID) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.SetModelProviderID(u)
+	return mpkuo
+}
+
+// SetNillableModelProviderID sets the "model_provider_id" field if the given value is not nil.
+func (mpkuo *ModelProviderKeyUpdateOne) SetNillableModelProviderID(u *uuid.UUID) *ModelProviderKeyUpdateOne {
+	if u != nil {
+		mpkuo.SetModelProviderID(*u)
+	}
+	return mpkuo
+}
+
+// SetModelProvider sets the "model_provider" edge to the ModelProvider entity.
+func (mpkuo *ModelProviderKeyUpdateOne) SetModelProvider(m *ModelProvider) *ModelProviderKeyUpdateOne {
+	return mpkuo.SetModelProviderID(m.ID)
+}
+
+// Mutation returns the ModelProviderKeyMutation object of the builder.
+func (mpkuo *ModelProviderKeyUpdateOne) Mutation() *ModelProviderKeyMutation {
+	return mpkuo.mutation
+}
+
+// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
+func (mpkuo *ModelProviderKeyUpdateOne) ClearModelProvider() *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.ClearModelProvider()
+	return mpkuo
+}
+
+// Where appends a list predicates to the ModelProviderKeyUpdate builder.
+func (mpkuo *ModelProviderKeyUpdateOne) Where(ps ...predicate.ModelProviderKey) *ModelProviderKeyUpdateOne {
+	mpkuo.mutation.Where(ps...)
+	return mpkuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mpkuo *ModelProviderKeyUpdateOne) Select(field string, fields ...string) *ModelProviderKeyUpdateOne {
+	mpkuo.fields = append([]string{field}, fields...)
+	return mpkuo
+}
+
+// Save executes the query and returns the updated ModelProviderKey entity.
+func (mpkuo *ModelProviderKeyUpdateOne) Save(ctx context.Context) (*ModelProviderKey, error) {
+	mpkuo.defaults()
+	return withHooks(ctx, mpkuo.sqlSave, mpkuo.mutation, mpkuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mpkuo *ModelProviderKeyUpdateOne) SaveX(ctx context.Context) *ModelProviderKey {
+	node, err := mpkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mpkuo *ModelProviderKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := mpkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mpkuo *ModelProviderKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := mpkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mpkuo *ModelProviderKeyUpdateOne) defaults() {
+	if _, ok := mpkuo.mutation.UpdateTime(); !ok {
+		v := modelproviderkey.UpdateDefaultUpdateTime()
+		mpkuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mpkuo *ModelProviderKeyUpdateOne) check() error {
+	if v, ok := mpkuo.mutation.Name(); ok {
+		if err := modelproviderkey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`memory: validator failed for field "ModelProviderKey.name": %w`, err)}
+		}
+	}
+	if v, ok := mpkuo.mutation.Secret(); ok {
+		if err := modelproviderkey.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "ModelProviderKey.secret": %w`, err)}
+		}
+	}
+	if mpkuo.mutation.ModelProviderCleared() && len(mpkuo.mutation.ModelProviderIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "ModelProviderKey.model_provider"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mpkuo *ModelProviderKeyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModelProviderKeyUpdateOne {
+	mpkuo.modifiers = append(mpkuo.modifiers, modifiers...)
+	return mpkuo
+}
+
+func (mpkuo *ModelProviderKeyUpdateOne) sqlSave(ctx context.Context) (_node *ModelProviderKey, err error) {
+	if err := mpkuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(modelproviderkey.Table, modelproviderkey.Columns, sqlgraph.NewFieldSpec(modelproviderkey.FieldID, field.TypeUUID))
+	id, ok := mpkuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`memory: missing "ModelProviderKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mpkuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelproviderkey.FieldID)
+		for _, f := range fields {
+			if !modelproviderkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+			}
+			if f != modelproviderkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mpkuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mpkuo.mutation.UpdateTime(); ok {
+		_spec.SetField(modelproviderkey.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := mpkuo.mutation.Name(); ok {
+		_spec.SetField(modelproviderkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := mpkuo.mutation.Secret(); ok {
+		_spec.SetField(modelproviderkey.FieldSecret, field.TypeBytes, value)
+	}
+	if value, ok := mpkuo.mutation.Priority(); ok {
+		_spec.SetField(modelproviderkey.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := mpkuo.mutation.AddedPriority(); ok {
+		_spec.AddField(modelproviderkey.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := mpkuo.mutation.RetiredTime(); ok {
+		_spec.SetField(modelproviderkey.FieldRetiredTime, field.TypeTime, value)
+	}
+	if mpkuo.mutation.RetiredTimeCleared() {
+		_spec.ClearField(modelproviderkey.FieldRetiredTime, field.TypeTime)
+	}
+	if value, ok := mpkuo.mutation.LastUsedTime(); ok {
+		_spec.SetField(modelproviderkey.FieldLastUsedTime, field.TypeTime, value)
+	}
+	if mpkuo.mutation.LastUsedTimeCleared() {
+		_spec.ClearField(modelproviderkey.FieldLastUsedTime, field.TypeTime)
+	}
+	if value, ok := mpkuo.mutation.LastRateLimitedTime(); ok {
+		_spec.SetField(modelproviderkey.FieldLastRateLimitedTime, field.TypeTime, value)
+	}
+	if mpkuo.mutation.LastRateLimitedTimeCleared() {
+		_spec.ClearField(modelproviderkey.FieldLastRateLimitedTime, field.TypeTime)
+	}
+	if value, ok := mpkuo.mutation.RequestCount(); ok {
+		_spec.SetField(modelproviderkey.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.AddedRequestCount(); ok {
+		_spec.AddField(modelproviderkey.FieldRequestCount, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.InputTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.AddedInputTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.OutputTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.CacheWriteTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldCacheWriteTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.AddedCacheWriteTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldCacheWriteTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.CacheReadTokens(); ok {
+		_spec.SetField(modelproviderkey.FieldCacheReadTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.AddedCacheReadTokens(); ok {
+		_spec.AddField(modelproviderkey.FieldCacheReadTokens, field.TypeInt64, value)
+	}
+	if value, ok := mpkuo.mutation.Cost(); ok {
+		_spec.SetField(modelproviderkey.FieldCost, field.TypeFloat64, value)
+	}
+	if value, ok := mpkuo.mutation.AddedCost(); ok {
+		_spec.AddField(modelproviderkey.FieldCost, field.TypeFloat64, value)
+	}
+	if mpkuo.mutation.ModelProviderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelproviderkey.ModelProviderTable,
+			Columns: []string{modelproviderkey.ModelProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelprovider.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mpkuo.mutation.ModelProviderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   modelproviderkey.ModelProviderTable,
+			Columns: []string{modelproviderkey.ModelProviderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelprovider.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(mpkuo.modifiers...)
+	_node = &ModelProviderKey{config: mpkuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mpkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelproviderkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mpkuo.mutation.done = true
+	return _node, nil
+}
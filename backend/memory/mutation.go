@@ -12,13 +12,19 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
 	"github.com/google/uuid"
 )
 
@@ -31,38 +37,49 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAgent         = "Agent"
-	TypeMessage       = "Message"
-	TypeModel         = "Model"
-	TypeModelProvider = "ModelProvider"
-	TypeTask          = "Task"
-	TypeToken         = "Token"
+	TypeAgent            = "Agent"
+	TypeAuditEvent       = "AuditEvent"
+	TypeEventLogEntry    = "EventLogEntry"
+	TypeMessage          = "Message"
+	TypeModel            = "Model"
+	TypeModelCallTrace   = "ModelCallTrace"
+	TypeModelProvider    = "ModelProvider"
+	TypeModelProviderKey = "ModelProviderKey"
+	TypeTask             = "Task"
+	TypeToken            = "Token"
+	TypeWebhook          = "Webhook"
+	TypeWebhookDelivery  = "WebhookDelivery"
 )
 
 // AgentMutation represents an operation that mutates the Agent nodes in the graph.
 type AgentMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *uuid.UUID
-	create_time     *time.Time
-	update_time     *time.Time
-	name            *string
-	description     *string
-	instructions    *string
-	builtin         *bool
-	clearedFields   map[string]struct{}
-	model           *uuid.UUID
-	clearedmodel    bool
-	tasks           map[uuid.UUID]struct{}
-	removedtasks    map[uuid.UUID]struct{}
-	clearedtasks    bool
-	messages        map[uuid.UUID]struct{}
-	removedmessages map[uuid.UUID]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Agent, error)
-	predicates      []predicate.Agent
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	name                     *string
+	description              *string
+	instructions             *string
+	builtin                  *bool
+	fallback_model_ids       *[]uuid.UUID
+	appendfallback_model_ids []uuid.UUID
+	model_router             **types.ModelRouter
+	redaction                **types.Redaction
+	owner                    *string
+	clearedFields            map[string]struct{}
+	model                    *uuid.UUID
+	clearedmodel             bool
+	tasks                    map[uuid.UUID]struct{}
+	removedtasks             map[uuid.UUID]struct{}
+	clearedtasks             bool
+	messages                 map[uuid.UUID]struct{}
+	removedmessages          map[uuid.UUID]struct{}
+	clearedmessages          bool
+	done                     bool
+	oldValue                 func(context.Context) (*Agent, error)
+	predicates               []predicate.Agent
 }
 
 var _ ent.Mutation = (*AgentMutation)(nil)
@@ -447,6 +464,218 @@ func (m *AgentMutation) ResetModelID() {
 	delete(m.clearedFields, agent.FieldModelID)
 }
 
+// SetFallbackModelIds sets the "fallback_model_ids" field.
+func (m *AgentMutation) SetFallbackModelIds(u []uuid.UUID) {
+	m.fallback_model_ids = &u
+	m.appendfallback_model_ids = nil
+}
+
+// FallbackModelIds returns the value of the "fallback_model_ids" field in the mutation.
+func (m *AgentMutation) FallbackModelIds() (r []uuid.UUID, exists bool) {
+	v := m.fallback_model_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFallbackModelIds returns the old "fallback_model_ids" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldFallbackModelIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFallbackModelIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFallbackModelIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFallbackModelIds: %w", err)
+	}
+	return oldValue.FallbackModelIds, nil
+}
+
+// AppendFallbackModelIds adds u to the "fallback_model_ids" field.
+func (m *AgentMutation) AppendFallbackModelIds(u []uuid.UUID) {
+	m.appendfallback_model_ids = append(m.appendfallback_model_ids, u...)
+}
+
+// AppendedFallbackModelIds returns the list of values that were appended to the "fallback_model_ids" field in this mutation.
+func (m *AgentMutation) AppendedFallbackModelIds() ([]uuid.UUID, bool) {
+	if len(m.appendfallback_model_ids) == 0 {
+		return nil, false
+	}
+	return m.appendfallback_model_ids, true
+}
+
+// ClearFallbackModelIds clears the value of the "fallback_model_ids" field.
+func (m *AgentMutation) ClearFallbackModelIds() {
+	m.fallback_model_ids = nil
+	m.appendfallback_model_ids = nil
+	m.clearedFields[agent.FieldFallbackModelIds] = struct{}{}
+}
+
+// FallbackModelIdsCleared returns if the "fallback_model_ids" field was cleared in this mutation.
+func (m *AgentMutation) FallbackModelIdsCleared() bool {
+	_, ok := m.clearedFields[agent.FieldFallbackModelIds]
+	return ok
+}
+
+// ResetFallbackModelIds resets all changes to the "fallback_model_ids" field.
+func (m *AgentMutation) ResetFallbackModelIds() {
+	m.fallback_model_ids = nil
+	m.appendfallback_model_ids = nil
+	delete(m.clearedFields, agent.FieldFallbackModelIds)
+}
+
+// SetModelRouter sets the "model_router" field.
+func (m *AgentMutation) SetModelRouter(tr *types.ModelRouter) {
+	m.model_router = &tr
+}
+
+// ModelRouter returns the value of the "model_router" field in the mutation.
+func (m *AgentMutation) ModelRouter() (r *types.ModelRouter, exists bool) {
+	v := m.model_router
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelRouter returns the old "model_router" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldModelRouter(ctx context.Context) (v *types.ModelRouter, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelRouter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelRouter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelRouter: %w", err)
+	}
+	return oldValue.ModelRouter, nil
+}
+
+// ClearModelRouter clears the value of the "model_router" field.
+func (m *AgentMutation) ClearModelRouter() {
+	m.model_router = nil
+	m.clearedFields[agent.FieldModelRouter] = struct{}{}
+}
+
+// ModelRouterCleared returns if the "model_router" field was cleared in this mutation.
+func (m *AgentMutation) ModelRouterCleared() bool {
+	_, ok := m.clearedFields[agent.FieldModelRouter]
+	return ok
+}
+
+// ResetModelRouter resets all changes to the "model_router" field.
+func (m *AgentMutation) ResetModelRouter() {
+	m.model_router = nil
+	delete(m.clearedFields, agent.FieldModelRouter)
+}
+
+// SetRedaction sets the "redaction" field.
+func (m *AgentMutation) SetRedaction(t *types.Redaction) {
+	m.redaction = &t
+}
+
+// Redaction returns the value of the "redaction" field in the mutation.
+func (m *AgentMutation) Redaction() (r *types.Redaction, exists bool) {
+	v := m.redaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRedaction returns the old "redaction" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldRedaction(ctx context.Context) (v *types.Redaction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRedaction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRedaction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRedaction: %w", err)
+	}
+	return oldValue.Redaction, nil
+}
+
+// ClearRedaction clears the value of the "redaction" field.
+func (m *AgentMutation) ClearRedaction() {
+	m.redaction = nil
+	m.clearedFields[agent.FieldRedaction] = struct{}{}
+}
+
+// RedactionCleared returns if the "redaction" field was cleared in this mutation.
+func (m *AgentMutation) RedactionCleared() bool {
+	_, ok := m.clearedFields[agent.FieldRedaction]
+	return ok
+}
+
+// ResetRedaction resets all changes to the "redaction" field.
+func (m *AgentMutation) ResetRedaction() {
+	m.redaction = nil
+	delete(m.clearedFields, agent.FieldRedaction)
+}
+
+// SetOwner sets the "owner" field.
+func (m *AgentMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *AgentMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Agent entity.
+// If the Agent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *AgentMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[agent.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *AgentMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[agent.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *AgentMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, agent.FieldOwner)
+}
+
 // ClearModel clears the "model" edge to the Model entity.
 func (m *AgentMutation) ClearModel() {
 	m.clearedmodel = true
@@ -616,7 +845,7 @@ func (m *AgentMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AgentMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 11)
 	if m.create_time != nil {
 		fields = append(fields, agent.FieldCreateTime)
 	}
@@ -638,6 +867,18 @@ func (m *AgentMutation) Fields() []string {
 	if m.model != nil {
 		fields = append(fields, agent.FieldModelID)
 	}
+	if m.fallback_model_ids != nil {
+		fields = append(fields, agent.FieldFallbackModelIds)
+	}
+	if m.model_router != nil {
+		fields = append(fields, agent.FieldModelRouter)
+	}
+	if m.redaction != nil {
+		fields = append(fields, agent.FieldRedaction)
+	}
+	if m.owner != nil {
+		fields = append(fields, agent.FieldOwner)
+	}
 	return fields
 }
 
@@ -660,6 +901,14 @@ func (m *AgentMutation) Field(name string) (ent.Value, bool) {
 		return m.Builtin()
 	case agent.FieldModelID:
 		return m.ModelID()
+	case agent.FieldFallbackModelIds:
+		return m.FallbackModelIds()
+	case agent.FieldModelRouter:
+		return m.ModelRouter()
+	case agent.FieldRedaction:
+		return m.Redaction()
+	case agent.FieldOwner:
+		return m.Owner()
 	}
 	return nil, false
 }
@@ -683,6 +932,14 @@ func (m *AgentMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldBuiltin(ctx)
 	case agent.FieldModelID:
 		return m.OldModelID(ctx)
+	case agent.FieldFallbackModelIds:
+		return m.OldFallbackModelIds(ctx)
+	case agent.FieldModelRouter:
+		return m.OldModelRouter(ctx)
+	case agent.FieldRedaction:
+		return m.OldRedaction(ctx)
+	case agent.FieldOwner:
+		return m.OldOwner(ctx)
 	}
 	return nil, fmt.Errorf("unknown Agent field %s", name)
 }
@@ -741,6 +998,34 @@ func (m *AgentMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetModelID(v)
 		return nil
+	case agent.FieldFallbackModelIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFallbackModelIds(v)
+		return nil
+	case agent.FieldModelRouter:
+		v, ok := value.(*types.ModelRouter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelRouter(v)
+		return nil
+	case agent.FieldRedaction:
+		v, ok := value.(*types.Redaction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRedaction(v)
+		return nil
+	case agent.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Agent field %s", name)
 }
@@ -777,6 +1062,18 @@ func (m *AgentMutation) ClearedFields() []string {
 	if m.FieldCleared(agent.FieldModelID) {
 		fields = append(fields, agent.FieldModelID)
 	}
+	if m.FieldCleared(agent.FieldFallbackModelIds) {
+		fields = append(fields, agent.FieldFallbackModelIds)
+	}
+	if m.FieldCleared(agent.FieldModelRouter) {
+		fields = append(fields, agent.FieldModelRouter)
+	}
+	if m.FieldCleared(agent.FieldRedaction) {
+		fields = append(fields, agent.FieldRedaction)
+	}
+	if m.FieldCleared(agent.FieldOwner) {
+		fields = append(fields, agent.FieldOwner)
+	}
 	return fields
 }
 
@@ -797,6 +1094,18 @@ func (m *AgentMutation) ClearField(name string) error {
 	case agent.FieldModelID:
 		m.ClearModelID()
 		return nil
+	case agent.FieldFallbackModelIds:
+		m.ClearFallbackModelIds()
+		return nil
+	case agent.FieldModelRouter:
+		m.ClearModelRouter()
+		return nil
+	case agent.FieldRedaction:
+		m.ClearRedaction()
+		return nil
+	case agent.FieldOwner:
+		m.ClearOwner()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent nullable field %s", name)
 }
@@ -826,6 +1135,18 @@ func (m *AgentMutation) ResetField(name string) error {
 	case agent.FieldModelID:
 		m.ResetModelID()
 		return nil
+	case agent.FieldFallbackModelIds:
+		m.ResetFallbackModelIds()
+		return nil
+	case agent.FieldModelRouter:
+		m.ResetModelRouter()
+		return nil
+	case agent.FieldRedaction:
+		m.ResetRedaction()
+		return nil
+	case agent.FieldOwner:
+		m.ResetOwner()
+		return nil
 	}
 	return fmt.Errorf("unknown Agent field %s", name)
 }
@@ -958,41 +1279,42 @@ func (m *AgentMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Agent edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	create_time    *time.Time
-	update_time    *time.Time
-	source         *types.MessageSource
-	content        **types.MessageContent
-	usage          **types.MessageUsage
-	processed_time *time.Time
-	clearedFields  map[string]struct{}
-	task           *uuid.UUID
-	clearedtask    bool
-	agent          *uuid.UUID
-	clearedagent   bool
-	model          *uuid.UUID
-	clearedmodel   bool
-	done           bool
-	oldValue       func(context.Context) (*Message, error)
-	predicates     []predicate.Message
-}
-
-var _ ent.Mutation = (*MessageMutation)(nil)
-
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	create_time        *time.Time
+	update_time        *time.Time
+	kind               *types.AuditEventKind
+	action             *string
+	subject            *string
+	auth_method        *string
+	resource_ids       *[]string
+	appendresource_ids []string
+	outcome            *types.AuditOutcome
+	error              *string
+	task_id            *uuid.UUID
+	agent_id           *uuid.UUID
+	details            *map[string]string
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*AuditEvent, error)
+	predicates         []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1001,20 +1323,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id uuid.UUID) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1023,10 +1345,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1035,7 +1357,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1043,7 +1365,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -1053,14 +1375,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of AuditEvent entities.
+func (m *AuditEventMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditEventMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1071,7 +1393,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1080,19 +1402,19 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *MessageMutation) SetCreateTime(t time.Time) {
+func (m *AuditEventMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+func (m *AuditEventMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -1100,10 +1422,10 @@ func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -1118,17 +1440,17 @@ func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *MessageMutation) ResetCreateTime() {
+func (m *AuditEventMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *MessageMutation) SetUpdateTime(t time.Time) {
+func (m *AuditEventMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *AuditEventMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -1136,10 +1458,10 @@ func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -1154,404 +1476,486 @@ func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *MessageMutation) ResetUpdateTime() {
+func (m *AuditEventMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetSource sets the "source" field.
-func (m *MessageMutation) SetSource(ts types.MessageSource) {
-	m.source = &ts
+// SetKind sets the "kind" field.
+func (m *AuditEventMutation) SetKind(tek types.AuditEventKind) {
+	m.kind = &tek
 }
 
-// Source returns the value of the "source" field in the mutation.
-func (m *MessageMutation) Source() (r types.MessageSource, exists bool) {
-	v := m.source
+// Kind returns the value of the "kind" field in the mutation.
+func (m *AuditEventMutation) Kind() (r types.AuditEventKind, exists bool) {
+	v := m.kind
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSource returns the old "source" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldKind returns the old "kind" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSource(ctx context.Context) (v types.MessageSource, err error) {
+func (m *AuditEventMutation) OldKind(ctx context.Context) (v types.AuditEventKind, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSource requires an ID field in the mutation")
+		return v, errors.New("OldKind requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
-	return oldValue.Source, nil
+	return oldValue.Kind, nil
 }
 
-// ResetSource resets all changes to the "source" field.
-func (m *MessageMutation) ResetSource() {
-	m.source = nil
+// ResetKind resets all changes to the "kind" field.
+func (m *AuditEventMutation) ResetKind() {
+	m.kind = nil
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(tc *types.MessageContent) {
-	m.content = &tc
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r *types.MessageContent, exists bool) {
-	v := m.content
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v *types.MessageContent, err error) {
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Action, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetUsage sets the "usage" field.
-func (m *MessageMutation) SetUsage(tu *types.MessageUsage) {
-	m.usage = &tu
+// SetSubject sets the "subject" field.
+func (m *AuditEventMutation) SetSubject(s string) {
+	m.subject = &s
 }
 
-// Usage returns the value of the "usage" field in the mutation.
-func (m *MessageMutation) Usage() (r *types.MessageUsage, exists bool) {
-	v := m.usage
+// Subject returns the value of the "subject" field in the mutation.
+func (m *AuditEventMutation) Subject() (r string, exists bool) {
+	v := m.subject
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsage returns the old "usage" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldSubject returns the old "subject" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldUsage(ctx context.Context) (v *types.MessageUsage, err error) {
+func (m *AuditEventMutation) OldSubject(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+		return v, errors.New("OldSubject is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsage requires an ID field in the mutation")
+		return v, errors.New("OldSubject requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+		return v, fmt.Errorf("querying old value for OldSubject: %w", err)
 	}
-	return oldValue.Usage, nil
+	return oldValue.Subject, nil
 }
 
-// ClearUsage clears the value of the "usage" field.
-func (m *MessageMutation) ClearUsage() {
-	m.usage = nil
-	m.clearedFields[message.FieldUsage] = struct{}{}
+// ClearSubject clears the value of the "subject" field.
+func (m *AuditEventMutation) ClearSubject() {
+	m.subject = nil
+	m.clearedFields[auditevent.FieldSubject] = struct{}{}
 }
 
-// UsageCleared returns if the "usage" field was cleared in this mutation.
-func (m *MessageMutation) UsageCleared() bool {
-	_, ok := m.clearedFields[message.FieldUsage]
+// SubjectCleared returns if the "subject" field was cleared in this mutation.
+func (m *AuditEventMutation) SubjectCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldSubject]
 	return ok
 }
 
-// ResetUsage resets all changes to the "usage" field.
-func (m *MessageMutation) ResetUsage() {
-	m.usage = nil
-	delete(m.clearedFields, message.FieldUsage)
+// ResetSubject resets all changes to the "subject" field.
+func (m *AuditEventMutation) ResetSubject() {
+	m.subject = nil
+	delete(m.clearedFields, auditevent.FieldSubject)
 }
 
-// SetProcessedTime sets the "processed_time" field.
-func (m *MessageMutation) SetProcessedTime(t time.Time) {
-	m.processed_time = &t
+// SetAuthMethod sets the "auth_method" field.
+func (m *AuditEventMutation) SetAuthMethod(s string) {
+	m.auth_method = &s
 }
 
-// ProcessedTime returns the value of the "processed_time" field in the mutation.
-func (m *MessageMutation) ProcessedTime() (r time.Time, exists bool) {
-	v := m.processed_time
+// AuthMethod returns the value of the "auth_method" field in the mutation.
+func (m *AuditEventMutation) AuthMethod() (r string, exists bool) {
+	v := m.auth_method
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProcessedTime returns the old "processed_time" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthMethod returns the old "auth_method" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProcessedTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldAuthMethod(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProcessedTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthMethod is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProcessedTime requires an ID field in the mutation")
+		return v, errors.New("OldAuthMethod requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProcessedTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthMethod: %w", err)
 	}
-	return oldValue.ProcessedTime, nil
+	return oldValue.AuthMethod, nil
 }
 
-// ClearProcessedTime clears the value of the "processed_time" field.
-func (m *MessageMutation) ClearProcessedTime() {
-	m.processed_time = nil
-	m.clearedFields[message.FieldProcessedTime] = struct{}{}
+// ClearAuthMethod clears the value of the "auth_method" field.
+func (m *AuditEventMutation) ClearAuthMethod() {
+	m.auth_method = nil
+	m.clearedFields[auditevent.FieldAuthMethod] = struct{}{}
 }
 
-// ProcessedTimeCleared returns if the "processed_time" field was cleared in this mutation.
-func (m *MessageMutation) ProcessedTimeCleared() bool {
-	_, ok := m.clearedFields[message.FieldProcessedTime]
+// AuthMethodCleared returns if the "auth_method" field was cleared in this mutation.
+func (m *AuditEventMutation) AuthMethodCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldAuthMethod]
 	return ok
 }
 
-// ResetProcessedTime resets all changes to the "processed_time" field.
-func (m *MessageMutation) ResetProcessedTime() {
-	m.processed_time = nil
-	delete(m.clearedFields, message.FieldProcessedTime)
+// ResetAuthMethod resets all changes to the "auth_method" field.
+func (m *AuditEventMutation) ResetAuthMethod() {
+	m.auth_method = nil
+	delete(m.clearedFields, auditevent.FieldAuthMethod)
 }
 
-// SetTaskID sets the "task_id" field.
-func (m *MessageMutation) SetTaskID(u uuid.UUID) {
-	m.task = &u
+// SetResourceIds sets the "resource_ids" field.
+func (m *AuditEventMutation) SetResourceIds(s []string) {
+	m.resource_ids = &s
+	m.appendresource_ids = nil
 }
 
-// TaskID returns the value of the "task_id" field in the mutation.
-func (m *MessageMutation) TaskID() (r uuid.UUID, exists bool) {
-	v := m.task
+// ResourceIds returns the value of the "resource_ids" field in the mutation.
+func (m *AuditEventMutation) ResourceIds() (r []string, exists bool) {
+	v := m.resource_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTaskID returns the old "task_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldResourceIds returns the old "resource_ids" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuditEventMutation) OldResourceIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+		return v, errors.New("OldResourceIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTaskID requires an ID field in the mutation")
+		return v, errors.New("OldResourceIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+		return v, fmt.Errorf("querying old value for OldResourceIds: %w", err)
 	}
-	return oldValue.TaskID, nil
+	return oldValue.ResourceIds, nil
 }
 
-// ResetTaskID resets all changes to the "task_id" field.
-func (m *MessageMutation) ResetTaskID() {
-	m.task = nil
+// AppendResourceIds adds s to the "resource_ids" field.
+func (m *AuditEventMutation) AppendResourceIds(s []string) {
+	m.appendresource_ids = append(m.appendresource_ids, s...)
 }
 
-// SetAgentID sets the "agent_id" field.
-func (m *MessageMutation) SetAgentID(u uuid.UUID) {
-	m.agent = &u
+// AppendedResourceIds returns the list of values that were appended to the "resource_ids" field in this mutation.
+func (m *AuditEventMutation) AppendedResourceIds() ([]string, bool) {
+	if len(m.appendresource_ids) == 0 {
+		return nil, false
+	}
+	return m.appendresource_ids, true
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *MessageMutation) AgentID() (r uuid.UUID, exists bool) {
-	v := m.agent
+// ClearResourceIds clears the value of the "resource_ids" field.
+func (m *AuditEventMutation) ClearResourceIds() {
+	m.resource_ids = nil
+	m.appendresource_ids = nil
+	m.clearedFields[auditevent.FieldResourceIds] = struct{}{}
+}
+
+// ResourceIdsCleared returns if the "resource_ids" field was cleared in this mutation.
+func (m *AuditEventMutation) ResourceIdsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldResourceIds]
+	return ok
+}
+
+// ResetResourceIds resets all changes to the "resource_ids" field.
+func (m *AuditEventMutation) ResetResourceIds() {
+	m.resource_ids = nil
+	m.appendresource_ids = nil
+	delete(m.clearedFields, auditevent.FieldResourceIds)
+}
+
+// SetOutcome sets the "outcome" field.
+func (m *AuditEventMutation) SetOutcome(to types.AuditOutcome) {
+	m.outcome = &to
+}
+
+// Outcome returns the value of the "outcome" field in the mutation.
+func (m *AuditEventMutation) Outcome() (r types.AuditOutcome, exists bool) {
+	v := m.outcome
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldOutcome returns the old "outcome" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuditEventMutation) OldOutcome(ctx context.Context) (v types.AuditOutcome, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOutcome is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
+		return v, errors.New("OldOutcome requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOutcome: %w", err)
 	}
-	return oldValue.AgentID, nil
+	return oldValue.Outcome, nil
 }
 
-// ClearAgentID clears the value of the "agent_id" field.
-func (m *MessageMutation) ClearAgentID() {
-	m.agent = nil
-	m.clearedFields[message.FieldAgentID] = struct{}{}
+// ResetOutcome resets all changes to the "outcome" field.
+func (m *AuditEventMutation) ResetOutcome() {
+	m.outcome = nil
 }
 
-// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *MessageMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAgentID]
+// SetError sets the "error" field.
+func (m *AuditEventMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditEventMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AuditEventMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditevent.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditEventMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldError]
 	return ok
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *MessageMutation) ResetAgentID() {
-	m.agent = nil
-	delete(m.clearedFields, message.FieldAgentID)
+// ResetError resets all changes to the "error" field.
+func (m *AuditEventMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditevent.FieldError)
 }
 
-// SetModelID sets the "model_id" field.
-func (m *MessageMutation) SetModelID(u uuid.UUID) {
-	m.model = &u
+// SetTaskID sets the "task_id" field.
+func (m *AuditEventMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
 }
 
-// ModelID returns the value of the "model_id" field in the mutation.
-func (m *MessageMutation) ModelID() (r uuid.UUID, exists bool) {
-	v := m.model
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *AuditEventMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModelID returns the old "model_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AuditEventMutation) OldTaskID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelID requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.ModelID, nil
+	return oldValue.TaskID, nil
 }
 
-// ClearModelID clears the value of the "model_id" field.
-func (m *MessageMutation) ClearModelID() {
-	m.model = nil
-	m.clearedFields[message.FieldModelID] = struct{}{}
+// ClearTaskID clears the value of the "task_id" field.
+func (m *AuditEventMutation) ClearTaskID() {
+	m.task_id = nil
+	m.clearedFields[auditevent.FieldTaskID] = struct{}{}
 }
 
-// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
-func (m *MessageMutation) ModelIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldModelID]
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *AuditEventMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldTaskID]
 	return ok
 }
 
-// ResetModelID resets all changes to the "model_id" field.
-func (m *MessageMutation) ResetModelID() {
-	m.model = nil
-	delete(m.clearedFields, message.FieldModelID)
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *AuditEventMutation) ResetTaskID() {
+	m.task_id = nil
+	delete(m.clearedFields, auditevent.FieldTaskID)
 }
 
-// ClearTask clears the "task" edge to the Task entity.
-func (m *MessageMutation) ClearTask() {
-	m.clearedtask = true
-	m.clearedFields[message.FieldTaskID] = struct{}{}
+// SetAgentID sets the "agent_id" field.
+func (m *AuditEventMutation) SetAgentID(u uuid.UUID) {
+	m.agent_id = &u
 }
 
-// TaskCleared reports if the "task" edge to the Task entity was cleared.
-func (m *MessageMutation) TaskCleared() bool {
-	return m.clearedtask
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *AuditEventMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TaskIDs returns the "task" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TaskID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) TaskIDs() (ids []uuid.UUID) {
-	if id := m.task; id != nil {
-		ids = append(ids, *id)
+// OldAgentID returns the old "agent_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldAgentID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
 }
 
-// ResetTask resets all changes to the "task" edge.
-func (m *MessageMutation) ResetTask() {
-	m.task = nil
-	m.clearedtask = false
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *AuditEventMutation) ClearAgentID() {
+	m.agent_id = nil
+	m.clearedFields[auditevent.FieldAgentID] = struct{}{}
 }
 
-// ClearAgent clears the "agent" edge to the Agent entity.
-func (m *MessageMutation) ClearAgent() {
-	m.clearedagent = true
-	m.clearedFields[message.FieldAgentID] = struct{}{}
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *AuditEventMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldAgentID]
+	return ok
 }
 
-// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
-func (m *MessageMutation) AgentCleared() bool {
-	return m.AgentIDCleared() || m.clearedagent
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *AuditEventMutation) ResetAgentID() {
+	m.agent_id = nil
+	delete(m.clearedFields, auditevent.FieldAgentID)
 }
 
-// AgentIDs returns the "agent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AgentID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) AgentIDs() (ids []uuid.UUID) {
-	if id := m.agent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// SetDetails sets the "details" field.
+func (m *AuditEventMutation) SetDetails(value map[string]string) {
+	m.details = &value
 }
 
-// ResetAgent resets all changes to the "agent" edge.
-func (m *MessageMutation) ResetAgent() {
-	m.agent = nil
-	m.clearedagent = false
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditEventMutation) Details() (r map[string]string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearModel clears the "model" edge to the Model entity.
-func (m *MessageMutation) ClearModel() {
-	m.clearedmodel = true
-	m.clearedFields[message.FieldModelID] = struct{}{}
+// OldDetails returns the old "details" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDetails(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
 }
 
-// ModelCleared reports if the "model" edge to the Model entity was cleared.
-func (m *MessageMutation) ModelCleared() bool {
-	return m.ModelIDCleared() || m.clearedmodel
+// ClearDetails clears the value of the "details" field.
+func (m *AuditEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditevent.FieldDetails] = struct{}{}
 }
 
-// ModelIDs returns the "model" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ModelIDs() (ids []uuid.UUID) {
-	if id := m.model; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDetails]
+	return ok
 }
 
-// ResetModel resets all changes to the "model" edge.
-func (m *MessageMutation) ResetModel() {
-	m.model = nil
-	m.clearedmodel = false
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditevent.FieldDetails)
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1559,51 +1963,60 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *AuditEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *AuditEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.create_time != nil {
-		fields = append(fields, message.FieldCreateTime)
+		fields = append(fields, auditevent.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, message.FieldUpdateTime)
+		fields = append(fields, auditevent.FieldUpdateTime)
 	}
-	if m.source != nil {
-		fields = append(fields, message.FieldSource)
+	if m.kind != nil {
+		fields = append(fields, auditevent.FieldKind)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
 	}
-	if m.usage != nil {
-		fields = append(fields, message.FieldUsage)
+	if m.subject != nil {
+		fields = append(fields, auditevent.FieldSubject)
 	}
-	if m.processed_time != nil {
-		fields = append(fields, message.FieldProcessedTime)
+	if m.auth_method != nil {
+		fields = append(fields, auditevent.FieldAuthMethod)
 	}
-	if m.task != nil {
-		fields = append(fields, message.FieldTaskID)
+	if m.resource_ids != nil {
+		fields = append(fields, auditevent.FieldResourceIds)
 	}
-	if m.agent != nil {
-		fields = append(fields, message.FieldAgentID)
+	if m.outcome != nil {
+		fields = append(fields, auditevent.FieldOutcome)
 	}
-	if m.model != nil {
-		fields = append(fields, message.FieldModelID)
+	if m.error != nil {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.task_id != nil {
+		fields = append(fields, auditevent.FieldTaskID)
+	}
+	if m.agent_id != nil {
+		fields = append(fields, auditevent.FieldAgentID)
+	}
+	if m.details != nil {
+		fields = append(fields, auditevent.FieldDetails)
 	}
 	return fields
 }
@@ -1611,26 +2024,32 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldCreateTime:
+	case auditevent.FieldCreateTime:
 		return m.CreateTime()
-	case message.FieldUpdateTime:
+	case auditevent.FieldUpdateTime:
 		return m.UpdateTime()
-	case message.FieldSource:
-		return m.Source()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldUsage:
-		return m.Usage()
-	case message.FieldProcessedTime:
-		return m.ProcessedTime()
-	case message.FieldTaskID:
+	case auditevent.FieldKind:
+		return m.Kind()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldSubject:
+		return m.Subject()
+	case auditevent.FieldAuthMethod:
+		return m.AuthMethod()
+	case auditevent.FieldResourceIds:
+		return m.ResourceIds()
+	case auditevent.FieldOutcome:
+		return m.Outcome()
+	case auditevent.FieldError:
+		return m.Error()
+	case auditevent.FieldTaskID:
 		return m.TaskID()
-	case message.FieldAgentID:
+	case auditevent.FieldAgentID:
 		return m.AgentID()
-	case message.FieldModelID:
-		return m.ModelID()
+	case auditevent.FieldDetails:
+		return m.Details()
 	}
 	return nil, false
 }
@@ -1638,363 +2057,339 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldCreateTime:
+	case auditevent.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case message.FieldUpdateTime:
+	case auditevent.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case message.FieldSource:
-		return m.OldSource(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldUsage:
-		return m.OldUsage(ctx)
-	case message.FieldProcessedTime:
-		return m.OldProcessedTime(ctx)
-	case message.FieldTaskID:
+	case auditevent.FieldKind:
+		return m.OldKind(ctx)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldSubject:
+		return m.OldSubject(ctx)
+	case auditevent.FieldAuthMethod:
+		return m.OldAuthMethod(ctx)
+	case auditevent.FieldResourceIds:
+		return m.OldResourceIds(ctx)
+	case auditevent.FieldOutcome:
+		return m.OldOutcome(ctx)
+	case auditevent.FieldError:
+		return m.OldError(ctx)
+	case auditevent.FieldTaskID:
 		return m.OldTaskID(ctx)
-	case message.FieldAgentID:
+	case auditevent.FieldAgentID:
 		return m.OldAgentID(ctx)
-	case message.FieldModelID:
-		return m.OldModelID(ctx)
+	case auditevent.FieldDetails:
+		return m.OldDetails(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldCreateTime:
+	case auditevent.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case message.FieldUpdateTime:
+	case auditevent.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case message.FieldSource:
-		v, ok := value.(types.MessageSource)
+	case auditevent.FieldKind:
+		v, ok := value.(types.AuditEventKind)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSource(v)
+		m.SetKind(v)
 		return nil
-	case message.FieldContent:
-		v, ok := value.(*types.MessageContent)
+	case auditevent.FieldAction:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetAction(v)
 		return nil
-	case message.FieldUsage:
-		v, ok := value.(*types.MessageUsage)
+	case auditevent.FieldSubject:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsage(v)
+		m.SetSubject(v)
 		return nil
-	case message.FieldProcessedTime:
-		v, ok := value.(time.Time)
+	case auditevent.FieldAuthMethod:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProcessedTime(v)
+		m.SetAuthMethod(v)
 		return nil
-	case message.FieldTaskID:
+	case auditevent.FieldResourceIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResourceIds(v)
+		return nil
+	case auditevent.FieldOutcome:
+		v, ok := value.(types.AuditOutcome)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutcome(v)
+		return nil
+	case auditevent.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case auditevent.FieldTaskID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTaskID(v)
 		return nil
-	case message.FieldAgentID:
+	case auditevent.FieldAgentID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetAgentID(v)
 		return nil
-	case message.FieldModelID:
-		v, ok := value.(uuid.UUID)
+	case auditevent.FieldDetails:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelID(v)
+		m.SetDetails(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *AuditEventMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *AuditEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldUsage) {
-		fields = append(fields, message.FieldUsage)
+	if m.FieldCleared(auditevent.FieldSubject) {
+		fields = append(fields, auditevent.FieldSubject)
 	}
-	if m.FieldCleared(message.FieldProcessedTime) {
-		fields = append(fields, message.FieldProcessedTime)
+	if m.FieldCleared(auditevent.FieldAuthMethod) {
+		fields = append(fields, auditevent.FieldAuthMethod)
 	}
-	if m.FieldCleared(message.FieldAgentID) {
-		fields = append(fields, message.FieldAgentID)
+	if m.FieldCleared(auditevent.FieldResourceIds) {
+		fields = append(fields, auditevent.FieldResourceIds)
 	}
-	if m.FieldCleared(message.FieldModelID) {
-		fields = append(fields, message.FieldModelID)
+	if m.FieldCleared(auditevent.FieldError) {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.FieldCleared(auditevent.FieldTaskID) {
+		fields = append(fields, auditevent.FieldTaskID)
+	}
+	if m.FieldCleared(auditevent.FieldAgentID) {
+		fields = append(fields, auditevent.FieldAgentID)
+	}
+	if m.FieldCleared(auditevent.FieldDetails) {
+		fields = append(fields, auditevent.FieldDetails)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *AuditEventMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldUsage:
-		m.ClearUsage()
+	case auditevent.FieldSubject:
+		m.ClearSubject()
 		return nil
-	case message.FieldProcessedTime:
-		m.ClearProcessedTime()
+	case auditevent.FieldAuthMethod:
+		m.ClearAuthMethod()
 		return nil
-	case message.FieldAgentID:
+	case auditevent.FieldResourceIds:
+		m.ClearResourceIds()
+		return nil
+	case auditevent.FieldError:
+		m.ClearError()
+		return nil
+	case auditevent.FieldTaskID:
+		m.ClearTaskID()
+		return nil
+	case auditevent.FieldAgentID:
 		m.ClearAgentID()
 		return nil
-	case message.FieldModelID:
-		m.ClearModelID()
+	case auditevent.FieldDetails:
+		m.ClearDetails()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *AuditEventMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldCreateTime:
+	case auditevent.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case message.FieldUpdateTime:
+	case auditevent.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case message.FieldSource:
-		m.ResetSource()
+	case auditevent.FieldKind:
+		m.ResetKind()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case auditevent.FieldAction:
+		m.ResetAction()
 		return nil
-	case message.FieldUsage:
-		m.ResetUsage()
+	case auditevent.FieldSubject:
+		m.ResetSubject()
 		return nil
-	case message.FieldProcessedTime:
-		m.ResetProcessedTime()
+	case auditevent.FieldAuthMethod:
+		m.ResetAuthMethod()
 		return nil
-	case message.FieldTaskID:
+	case auditevent.FieldResourceIds:
+		m.ResetResourceIds()
+		return nil
+	case auditevent.FieldOutcome:
+		m.ResetOutcome()
+		return nil
+	case auditevent.FieldError:
+		m.ResetError()
+		return nil
+	case auditevent.FieldTaskID:
 		m.ResetTaskID()
 		return nil
-	case message.FieldAgentID:
+	case auditevent.FieldAgentID:
 		m.ResetAgentID()
 		return nil
-	case message.FieldModelID:
-		m.ResetModelID()
+	case auditevent.FieldDetails:
+		m.ResetDetails()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.task != nil {
-		edges = append(edges, message.EdgeTask)
-	}
-	if m.agent != nil {
-		edges = append(edges, message.EdgeAgent)
-	}
-	if m.model != nil {
-		edges = append(edges, message.EdgeModel)
-	}
+func (m *AuditEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case message.EdgeTask:
-		if id := m.task; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeAgent:
-		if id := m.agent; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeModel:
-		if id := m.model; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *AuditEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedtask {
-		edges = append(edges, message.EdgeTask)
-	}
-	if m.clearedagent {
-		edges = append(edges, message.EdgeAgent)
-	}
-	if m.clearedmodel {
-		edges = append(edges, message.EdgeModel)
-	}
+func (m *AuditEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
-	switch name {
-	case message.EdgeTask:
-		return m.clearedtask
-	case message.EdgeAgent:
-		return m.clearedagent
-	case message.EdgeModel:
-		return m.clearedmodel
-	}
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
-	switch name {
-	case message.EdgeTask:
-		m.ClearTask()
-		return nil
-	case message.EdgeAgent:
-		m.ClearAgent()
-		return nil
-	case message.EdgeModel:
-		m.ClearModel()
-		return nil
-	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
-	switch name {
-	case message.EdgeTask:
-		m.ResetTask()
-		return nil
-	case message.EdgeAgent:
-		m.ResetAgent()
-		return nil
-	case message.EdgeModel:
-		m.ResetModel()
-		return nil
-	}
-	return fmt.Errorf("unknown Message edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// ModelMutation represents an operation that mutates the Model nodes in the graph.
-type ModelMutation struct {
+// EventLogEntryMutation represents an operation that mutates the EventLogEntry nodes in the graph.
+type EventLogEntryMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	name                  *string
-	context_window        *int64
-	addcontext_window     *int64
-	capabilities          *[]types.ModelCapability
-	appendcapabilities    []types.ModelCapability
-	input_cost            *float64
-	addinput_cost         *float64
-	output_cost           *float64
-	addoutput_cost        *float64
-	cache_write_cost      *float64
-	addcache_write_cost   *float64
-	cache_read_cost       *float64
-	addcache_read_cost    *float64
-	enabled               *bool
-	alias                 *string
-	clearedFields         map[string]struct{}
-	agents                map[uuid.UUID]struct{}
-	removedagents         map[uuid.UUID]struct{}
-	clearedagents         bool
-	model_provider        *uuid.UUID
-	clearedmodel_provider bool
-	messages              map[uuid.UUID]struct{}
-	removedmessages       map[uuid.UUID]struct{}
-	clearedmessages       bool
-	done                  bool
-	oldValue              func(context.Context) (*Model, error)
-	predicates            []predicate.Model
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	sequence      *int64
+	addsequence   *int64
+	_type         *string
+	action        *string
+	timestamp     *time.Time
+	task_id       *uuid.UUID
+	payload       *[]byte
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EventLogEntry, error)
+	predicates    []predicate.EventLogEntry
 }
 
-var _ ent.Mutation = (*ModelMutation)(nil)
+var _ ent.Mutation = (*EventLogEntryMutation)(nil)
 
-// modelOption allows management of the mutation configuration using functional options.
-type modelOption func(*ModelMutation)
+// eventlogentryOption allows management of the mutation configuration using functional options.
+type eventlogentryOption func(*EventLogEntryMutation)
 
-// newModelMutation creates new mutation for the Model entity.
-func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
-	m := &ModelMutation{
+// newEventLogEntryMutation creates new mutation for the EventLogEntry entity.
+func newEventLogEntryMutation(c config, op Op, opts ...eventlogentryOption) *EventLogEntryMutation {
+	m := &EventLogEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModel,
+		typ:           TypeEventLogEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2003,20 +2398,20 @@ func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
 	return m
 }
 
-// withModelID sets the ID field of the mutation.
-func withModelID(id uuid.UUID) modelOption {
-	return func(m *ModelMutation) {
+// withEventLogEntryID sets the ID field of the mutation.
+func withEventLogEntryID(id uuid.UUID) eventlogentryOption {
+	return func(m *EventLogEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Model
+			value *EventLogEntry
 		)
-		m.oldValue = func(ctx context.Context) (*Model, error) {
+		m.oldValue = func(ctx context.Context) (*EventLogEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Model.Get(ctx, id)
+					value, err = m.Client().EventLogEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2025,10 +2420,10 @@ func withModelID(id uuid.UUID) modelOption {
 	}
 }
 
-// withModel sets the old Model of the mutation.
-func withModel(node *Model) modelOption {
-	return func(m *ModelMutation) {
-		m.oldValue = func(context.Context) (*Model, error) {
+// withEventLogEntry sets the old EventLogEntry of the mutation.
+func withEventLogEntry(node *EventLogEntry) eventlogentryOption {
+	return func(m *EventLogEntryMutation) {
+		m.oldValue = func(context.Context) (*EventLogEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2037,7 +2432,7 @@ func withModel(node *Model) modelOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelMutation) Client() *Client {
+func (m EventLogEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2045,7 +2440,7 @@ func (m ModelMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelMutation) Tx() (*Tx, error) {
+func (m EventLogEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -2055,14 +2450,14 @@ func (m ModelMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Model entities.
-func (m *ModelMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of EventLogEntry entities.
+func (m *EventLogEntryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
+func (m *EventLogEntryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2073,7 +2468,7 @@ func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *EventLogEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2082,19 +2477,19 @@ func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Model.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EventLogEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ModelMutation) SetCreateTime(t time.Time) {
+func (m *EventLogEntryMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
+func (m *EventLogEntryMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -2102,10 +2497,10 @@ func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *EventLogEntryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -2120,17 +2515,17 @@ func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ModelMutation) ResetCreateTime() {
+func (m *EventLogEntryMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ModelMutation) SetUpdateTime(t time.Time) {
+func (m *EventLogEntryMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *EventLogEntryMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -2138,10 +2533,10 @@ func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *EventLogEntryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -2156,717 +2551,8491 @@ func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ModelMutation) ResetUpdateTime() {
+func (m *EventLogEntryMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetName sets the "name" field.
-func (m *ModelMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *ModelMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *ModelMutation) ResetName() {
-	m.name = nil
-}
-
-// SetContextWindow sets the "context_window" field.
-func (m *ModelMutation) SetContextWindow(i int64) {
-	m.context_window = &i
-	m.addcontext_window = nil
+// SetSequence sets the "sequence" field.
+func (m *EventLogEntryMutation) SetSequence(i int64) {
+	m.sequence = &i
+	m.addsequence = nil
 }
 
-// ContextWindow returns the value of the "context_window" field in the mutation.
-func (m *ModelMutation) ContextWindow() (r int64, exists bool) {
-	v := m.context_window
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *EventLogEntryMutation) Sequence() (r int64, exists bool) {
+	v := m.sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContextWindow returns the old "context_window" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldContextWindow(ctx context.Context) (v int64, err error) {
+func (m *EventLogEntryMutation) OldSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.ContextWindow, nil
+	return oldValue.Sequence, nil
 }
 
-// AddContextWindow adds i to the "context_window" field.
-func (m *ModelMutation) AddContextWindow(i int64) {
-	if m.addcontext_window != nil {
-		*m.addcontext_window += i
+// AddSequence adds i to the "sequence" field.
+func (m *EventLogEntryMutation) AddSequence(i int64) {
+	if m.addsequence != nil {
+		*m.addsequence += i
 	} else {
-		m.addcontext_window = &i
+		m.addsequence = &i
 	}
 }
 
-// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
-func (m *ModelMutation) AddedContextWindow() (r int64, exists bool) {
-	v := m.addcontext_window
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *EventLogEntryMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetContextWindow resets all changes to the "context_window" field.
-func (m *ModelMutation) ResetContextWindow() {
-	m.context_window = nil
-	m.addcontext_window = nil
+// ResetSequence resets all changes to the "sequence" field.
+func (m *EventLogEntryMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
 }
 
-// SetCapabilities sets the "capabilities" field.
-func (m *ModelMutation) SetCapabilities(tc []types.ModelCapability) {
-	m.capabilities = &tc
-	m.appendcapabilities = nil
+// SetType sets the "type" field.
+func (m *EventLogEntryMutation) SetType(s string) {
+	m._type = &s
 }
 
-// Capabilities returns the value of the "capabilities" field in the mutation.
-func (m *ModelMutation) Capabilities() (r []types.ModelCapability, exists bool) {
-	v := m.capabilities
+// GetType returns the value of the "type" field in the mutation.
+func (m *EventLogEntryMutation) GetType() (r string, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCapabilities returns the old "capabilities" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCapabilities(ctx context.Context) (v []types.ModelCapability, err error) {
+func (m *EventLogEntryMutation) OldType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
-	}
-	return oldValue.Capabilities, nil
-}
-
-// AppendCapabilities adds tc to the "capabilities" field.
-func (m *ModelMutation) AppendCapabilities(tc []types.ModelCapability) {
-	m.appendcapabilities = append(m.appendcapabilities, tc...)
-}
-
-// AppendedCapabilities returns the list of values that were appended to the "capabilities" field in this mutation.
-func (m *ModelMutation) AppendedCapabilities() ([]types.ModelCapability, bool) {
-	if len(m.appendcapabilities) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return m.appendcapabilities, true
-}
-
-// ClearCapabilities clears the value of the "capabilities" field.
-func (m *ModelMutation) ClearCapabilities() {
-	m.capabilities = nil
-	m.appendcapabilities = nil
-	m.clearedFields[model.FieldCapabilities] = struct{}{}
-}
-
-// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
-func (m *ModelMutation) CapabilitiesCleared() bool {
-	_, ok := m.clearedFields[model.FieldCapabilities]
-	return ok
+	return oldValue.Type, nil
 }
 
-// ResetCapabilities resets all changes to the "capabilities" field.
-func (m *ModelMutation) ResetCapabilities() {
-	m.capabilities = nil
-	m.appendcapabilities = nil
-	delete(m.clearedFields, model.FieldCapabilities)
+// ResetType resets all changes to the "type" field.
+func (m *EventLogEntryMutation) ResetType() {
+	m._type = nil
 }
 
-// SetInputCost sets the "input_cost" field.
-func (m *ModelMutation) SetInputCost(f float64) {
-	m.input_cost = &f
-	m.addinput_cost = nil
+// SetAction sets the "action" field.
+func (m *EventLogEntryMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// InputCost returns the value of the "input_cost" field in the mutation.
-func (m *ModelMutation) InputCost() (r float64, exists bool) {
-	v := m.input_cost
+// Action returns the value of the "action" field in the mutation.
+func (m *EventLogEntryMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputCost returns the old "input_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+func (m *EventLogEntryMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputCost requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.InputCost, nil
+	return oldValue.Action, nil
 }
 
-// AddInputCost adds f to the "input_cost" field.
-func (m *ModelMutation) AddInputCost(f float64) {
-	if m.addinput_cost != nil {
-		*m.addinput_cost += f
-	} else {
-		m.addinput_cost = &f
-	}
+// ClearAction clears the value of the "action" field.
+func (m *EventLogEntryMutation) ClearAction() {
+	m.action = nil
+	m.clearedFields[eventlogentry.FieldAction] = struct{}{}
 }
 
-// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
-func (m *ModelMutation) AddedInputCost() (r float64, exists bool) {
-	v := m.addinput_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// ActionCleared returns if the "action" field was cleared in this mutation.
+func (m *EventLogEntryMutation) ActionCleared() bool {
+	_, ok := m.clearedFields[eventlogentry.FieldAction]
+	return ok
 }
 
-// ResetInputCost resets all changes to the "input_cost" field.
-func (m *ModelMutation) ResetInputCost() {
-	m.input_cost = nil
-	m.addinput_cost = nil
+// ResetAction resets all changes to the "action" field.
+func (m *EventLogEntryMutation) ResetAction() {
+	m.action = nil
+	delete(m.clearedFields, eventlogentry.FieldAction)
 }
 
-// SetOutputCost sets the "output_cost" field.
-func (m *ModelMutation) SetOutputCost(f float64) {
-	m.output_cost = &f
-	m.addoutput_cost = nil
+// SetTimestamp sets the "timestamp" field.
+func (m *EventLogEntryMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
 }
 
-// OutputCost returns the value of the "output_cost" field in the mutation.
-func (m *ModelMutation) OutputCost() (r float64, exists bool) {
-	v := m.output_cost
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *EventLogEntryMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOutputCost returns the old "output_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldTimestamp returns the old "timestamp" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+func (m *EventLogEntryMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
-	}
-	return oldValue.OutputCost, nil
-}
-
-// AddOutputCost adds f to the "output_cost" field.
-func (m *ModelMutation) AddOutputCost(f float64) {
-	if m.addoutput_cost != nil {
-		*m.addoutput_cost += f
-	} else {
-		m.addoutput_cost = &f
-	}
-}
-
-// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
-func (m *ModelMutation) AddedOutputCost() (r float64, exists bool) {
-	v := m.addoutput_cost
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
 	}
-	return *v, true
+	return oldValue.Timestamp, nil
 }
 
-// ResetOutputCost resets all changes to the "output_cost" field.
-func (m *ModelMutation) ResetOutputCost() {
-	m.output_cost = nil
-	m.addoutput_cost = nil
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *EventLogEntryMutation) ResetTimestamp() {
+	m.timestamp = nil
 }
 
-// SetCacheWriteCost sets the "cache_write_cost" field.
-func (m *ModelMutation) SetCacheWriteCost(f float64) {
-	m.cache_write_cost = &f
-	m.addcache_write_cost = nil
+// SetTaskID sets the "task_id" field.
+func (m *EventLogEntryMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
 }
 
-// CacheWriteCost returns the value of the "cache_write_cost" field in the mutation.
-func (m *ModelMutation) CacheWriteCost() (r float64, exists bool) {
-	v := m.cache_write_cost
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *EventLogEntryMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheWriteCost returns the old "cache_write_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCacheWriteCost(ctx context.Context) (v float64, err error) {
+func (m *EventLogEntryMutation) OldTaskID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheWriteCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheWriteCost requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheWriteCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.CacheWriteCost, nil
+	return oldValue.TaskID, nil
 }
 
-// AddCacheWriteCost adds f to the "cache_write_cost" field.
-func (m *ModelMutation) AddCacheWriteCost(f float64) {
-	if m.addcache_write_cost != nil {
-		*m.addcache_write_cost += f
-	} else {
-		m.addcache_write_cost = &f
-	}
+// ClearTaskID clears the value of the "task_id" field.
+func (m *EventLogEntryMutation) ClearTaskID() {
+	m.task_id = nil
+	m.clearedFields[eventlogentry.FieldTaskID] = struct{}{}
 }
 
-// AddedCacheWriteCost returns the value that was added to the "cache_write_cost" field in this mutation.
-func (m *ModelMutation) AddedCacheWriteCost() (r float64, exists bool) {
-	v := m.addcache_write_cost
-	if v == nil {
-		return
-	}
-	return *v, true
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *EventLogEntryMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[eventlogentry.FieldTaskID]
+	return ok
 }
 
-// ResetCacheWriteCost resets all changes to the "cache_write_cost" field.
-func (m *ModelMutation) ResetCacheWriteCost() {
-	m.cache_write_cost = nil
-	m.addcache_write_cost = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *EventLogEntryMutation) ResetTaskID() {
+	m.task_id = nil
+	delete(m.clearedFields, eventlogentry.FieldTaskID)
 }
 
-// SetCacheReadCost sets the "cache_read_cost" field.
-func (m *ModelMutation) SetCacheReadCost(f float64) {
-	m.cache_read_cost = &f
-	m.addcache_read_cost = nil
+// SetPayload sets the "payload" field.
+func (m *EventLogEntryMutation) SetPayload(b []byte) {
+	m.payload = &b
 }
 
-// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
-func (m *ModelMutation) CacheReadCost() (r float64, exists bool) {
-	v := m.cache_read_cost
+// Payload returns the value of the "payload" field in the mutation.
+func (m *EventLogEntryMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadCost returns the old "cache_read_cost" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the EventLogEntry entity.
+// If the EventLogEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+func (m *EventLogEntryMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.CacheReadCost, nil
+	return oldValue.Payload, nil
 }
 
-// AddCacheReadCost adds f to the "cache_read_cost" field.
-func (m *ModelMutation) AddCacheReadCost(f float64) {
-	if m.addcache_read_cost != nil {
-		*m.addcache_read_cost += f
-	} else {
-		m.addcache_read_cost = &f
-	}
+// ResetPayload resets all changes to the "payload" field.
+func (m *EventLogEntryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
-func (m *ModelMutation) AddedCacheReadCost() (r float64, exists bool) {
-	v := m.addcache_read_cost
-	if v == nil {
-		return
+// Where appends a list predicates to the EventLogEntryMutation builder.
+func (m *EventLogEntryMutation) Where(ps ...predicate.EventLogEntry) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventLogEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventLogEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventLogEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
-func (m *ModelMutation) ResetCacheReadCost() {
-	m.cache_read_cost = nil
-	m.addcache_read_cost = nil
+// Op returns the operation name.
+func (m *EventLogEntryMutation) Op() Op {
+	return m.op
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ModelMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetOp allows setting the mutation operation.
+func (m *EventLogEntryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ModelMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (EventLogEntry).
+func (m *EventLogEntryMutation) Type() string {
+	return m.typ
 }
 
-// OldEnabled returns the old "enabled" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventLogEntryMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, eventlogentry.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	if m.update_time != nil {
+		fields = append(fields, eventlogentry.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	if m.sequence != nil {
+		fields = append(fields, eventlogentry.FieldSequence)
 	}
-	return oldValue.Enabled, nil
-}
-
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ModelMutation) ResetEnabled() {
-	m.enabled = nil
-}
-
-// SetAlias sets the "alias" field.
-func (m *ModelMutation) SetAlias(s string) {
-	m.alias = &s
-}
-
-// Alias returns the value of the "alias" field in the mutation.
-func (m *ModelMutation) Alias() (r string, exists bool) {
-	v := m.alias
-	if v == nil {
-		return
+	if m._type != nil {
+		fields = append(fields, eventlogentry.FieldType)
 	}
-	return *v, true
-}
-
-// OldAlias returns the old "alias" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldAlias(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	if m.action != nil {
+		fields = append(fields, eventlogentry.FieldAction)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAlias requires an ID field in the mutation")
+	if m.timestamp != nil {
+		fields = append(fields, eventlogentry.FieldTimestamp)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	if m.task_id != nil {
+		fields = append(fields, eventlogentry.FieldTaskID)
 	}
-	return oldValue.Alias, nil
+	if m.payload != nil {
+		fields = append(fields, eventlogentry.FieldPayload)
+	}
+	return fields
 }
 
-// ClearAlias clears the value of the "alias" field.
-func (m *ModelMutation) ClearAlias() {
-	m.alias = nil
-	m.clearedFields[model.FieldAlias] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventLogEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventlogentry.FieldCreateTime:
+		return m.CreateTime()
+	case eventlogentry.FieldUpdateTime:
+		return m.UpdateTime()
+	case eventlogentry.FieldSequence:
+		return m.Sequence()
+	case eventlogentry.FieldType:
+		return m.GetType()
+	case eventlogentry.FieldAction:
+		return m.Action()
+	case eventlogentry.FieldTimestamp:
+		return m.Timestamp()
+	case eventlogentry.FieldTaskID:
+		return m.TaskID()
+	case eventlogentry.FieldPayload:
+		return m.Payload()
+	}
+	return nil, false
 }
 
-// AliasCleared returns if the "alias" field was cleared in this mutation.
-func (m *ModelMutation) AliasCleared() bool {
-	_, ok := m.clearedFields[model.FieldAlias]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventLogEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventlogentry.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case eventlogentry.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case eventlogentry.FieldSequence:
+		return m.OldSequence(ctx)
+	case eventlogentry.FieldType:
+		return m.OldType(ctx)
+	case eventlogentry.FieldAction:
+		return m.OldAction(ctx)
+	case eventlogentry.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case eventlogentry.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case eventlogentry.FieldPayload:
+		return m.OldPayload(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventLogEntry field %s", name)
 }
 
-// ResetAlias resets all changes to the "alias" field.
-func (m *ModelMutation) ResetAlias() {
-	m.alias = nil
-	delete(m.clearedFields, model.FieldAlias)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventLogEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventlogentry.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case eventlogentry.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case eventlogentry.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequence(v)
+		return nil
+	case eventlogentry.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case eventlogentry.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case eventlogentry.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case eventlogentry.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case eventlogentry.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventLogEntry field %s", name)
 }
 
-// SetModelProviderID sets the "model_provider_id" field.
-func (m *ModelMutation) SetModelProviderID(u uuid.UUID) {
-	m.model_provider = &u
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventLogEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addsequence != nil {
+		fields = append(fields, eventlogentry.FieldSequence)
+	}
+	return fields
 }
 
-// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
-func (m *ModelMutation) ModelProviderID() (r uuid.UUID, exists bool) {
-	v := m.model_provider
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventLogEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventlogentry.FieldSequence:
+		return m.AddedSequence()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldModelProviderID returns the old "model_provider_id" field's value of the Model entity.
-// If the Model object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventLogEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventlogentry.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+	return fmt.Errorf("unknown EventLogEntry numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventLogEntryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventlogentry.FieldAction) {
+		fields = append(fields, eventlogentry.FieldAction)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+	if m.FieldCleared(eventlogentry.FieldTaskID) {
+		fields = append(fields, eventlogentry.FieldTaskID)
 	}
-	return oldValue.ModelProviderID, nil
+	return fields
 }
 
-// ResetModelProviderID resets all changes to the "model_provider_id" field.
-func (m *ModelMutation) ResetModelProviderID() {
-	m.model_provider = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventLogEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
-func (m *ModelMutation) AddAgentIDs(ids ...uuid.UUID) {
-	if m.agents == nil {
-		m.agents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.agents[ids[i]] = struct{}{}
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventLogEntryMutation) ClearField(name string) error {
+	switch name {
+	case eventlogentry.FieldAction:
+		m.ClearAction()
+		return nil
+	case eventlogentry.FieldTaskID:
+		m.ClearTaskID()
+		return nil
 	}
+	return fmt.Errorf("unknown EventLogEntry nullable field %s", name)
 }
 
-// ClearAgents clears the "agents" edge to the Agent entity.
-func (m *ModelMutation) ClearAgents() {
-	m.clearedagents = true
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventLogEntryMutation) ResetField(name string) error {
+	switch name {
+	case eventlogentry.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case eventlogentry.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case eventlogentry.FieldSequence:
+		m.ResetSequence()
+		return nil
+	case eventlogentry.FieldType:
+		m.ResetType()
+		return nil
+	case eventlogentry.FieldAction:
+		m.ResetAction()
+		return nil
+	case eventlogentry.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case eventlogentry.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case eventlogentry.FieldPayload:
+		m.ResetPayload()
+		return nil
+	}
+	return fmt.Errorf("unknown EventLogEntry field %s", name)
 }
 
-// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
-func (m *ModelMutation) AgentsCleared() bool {
-	return m.clearedagents
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventLogEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
-func (m *ModelMutation) RemoveAgentIDs(ids ...uuid.UUID) {
-	if m.removedagents == nil {
-		m.removedagents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.agents, ids[i])
-		m.removedagents[ids[i]] = struct{}{}
-	}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventLogEntryMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedAgents returns the removed IDs of the "agents" edge to the Agent entity.
-func (m *ModelMutation) RemovedAgentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedagents {
-		ids = append(ids, id)
-	}
-	return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventLogEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AgentsIDs returns the "agents" edge IDs in the mutation.
-func (m *ModelMutation) AgentsIDs() (ids []uuid.UUID) {
-	for id := range m.agents {
-		ids = append(ids, id)
-	}
-	return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventLogEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetAgents resets all changes to the "agents" edge.
-func (m *ModelMutation) ResetAgents() {
-	m.agents = nil
-	m.clearedagents = false
-	m.removedagents = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventLogEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
-func (m *ModelMutation) ClearModelProvider() {
-	m.clearedmodel_provider = true
-	m.clearedFields[model.FieldModelProviderID] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventLogEntryMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
-func (m *ModelMutation) ModelProviderCleared() bool {
-	return m.clearedmodel_provider
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventLogEntryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventLogEntry unique edge %s", name)
 }
 
-// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ModelProviderID instead. It exists only for internal usage by the builders.
-func (m *ModelMutation) ModelProviderIDs() (ids []uuid.UUID) {
-	if id := m.model_provider; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventLogEntryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventLogEntry edge %s", name)
 }
 
-// ResetModelProvider resets all changes to the "model_provider" edge.
-func (m *ModelMutation) ResetModelProvider() {
-	m.model_provider = nil
-	m.clearedmodel_provider = false
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	create_time    *time.Time
+	update_time    *time.Time
+	source         *types.MessageSource
+	content        **types.MessageContent
+	usage          **types.MessageUsage
+	routing        **types.MessageRouting
+	processed_time *time.Time
+	trace_id       *string
+	owner          *string
+	clearedFields  map[string]struct{}
+	task           *uuid.UUID
+	clearedtask    bool
+	agent          *uuid.UUID
+	clearedagent   bool
+	model          *uuid.UUID
+	clearedmodel   bool
+	done           bool
+	oldValue       func(context.Context) (*Message, error)
+	predicates     []predicate.Message
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ModelMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ModelMutation) ClearMessages() {
-	m.clearedmessages = true
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ModelMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ModelMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ModelMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ModelMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ModelMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// Where appends a list predicates to the ModelMutation builder.
-func (m *ModelMutation) Where(ps ...predicate.Model) {
-	m.predicates = append(m.predicates, ps...)
+// SetCreateTime sets the "create_time" field.
+func (m *MessageMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Model, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *MessageMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *ModelMutation) Op() Op {
-	return m.op
+// OldCreateTime returns the old "create_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModelMutation) SetOp(op Op) {
-	m.op = op
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *MessageMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// Type returns the node type of this mutation (Model).
-func (m *ModelMutation) Type() string {
-	return m.typ
+// SetUpdateTime sets the "update_time" field.
+func (m *MessageMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *MessageMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *MessageMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetSource sets the "source" field.
+func (m *MessageMutation) SetSource(ts types.MessageSource) {
+	m.source = &ts
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *MessageMutation) Source() (r types.MessageSource, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSource(ctx context.Context) (v types.MessageSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *MessageMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(tc *types.MessageContent) {
+	m.content = &tc
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r *types.MessageContent, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldContent(ctx context.Context) (v *types.MessageContent, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetUsage sets the "usage" field.
+func (m *MessageMutation) SetUsage(tu *types.MessageUsage) {
+	m.usage = &tu
+}
+
+// Usage returns the value of the "usage" field in the mutation.
+func (m *MessageMutation) Usage() (r *types.MessageUsage, exists bool) {
+	v := m.usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsage returns the old "usage" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldUsage(ctx context.Context) (v *types.MessageUsage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsage: %w", err)
+	}
+	return oldValue.Usage, nil
+}
+
+// ClearUsage clears the value of the "usage" field.
+func (m *MessageMutation) ClearUsage() {
+	m.usage = nil
+	m.clearedFields[message.FieldUsage] = struct{}{}
+}
+
+// UsageCleared returns if the "usage" field was cleared in this mutation.
+func (m *MessageMutation) UsageCleared() bool {
+	_, ok := m.clearedFields[message.FieldUsage]
+	return ok
+}
+
+// ResetUsage resets all changes to the "usage" field.
+func (m *MessageMutation) ResetUsage() {
+	m.usage = nil
+	delete(m.clearedFields, message.FieldUsage)
+}
+
+// SetRouting sets the "routing" field.
+func (m *MessageMutation) SetRouting(tr *types.MessageRouting) {
+	m.routing = &tr
+}
+
+// Routing returns the value of the "routing" field in the mutation.
+func (m *MessageMutation) Routing() (r *types.MessageRouting, exists bool) {
+	v := m.routing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRouting returns the old "routing" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldRouting(ctx context.Context) (v *types.MessageRouting, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRouting is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRouting requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRouting: %w", err)
+	}
+	return oldValue.Routing, nil
+}
+
+// ClearRouting clears the value of the "routing" field.
+func (m *MessageMutation) ClearRouting() {
+	m.routing = nil
+	m.clearedFields[message.FieldRouting] = struct{}{}
+}
+
+// RoutingCleared returns if the "routing" field was cleared in this mutation.
+func (m *MessageMutation) RoutingCleared() bool {
+	_, ok := m.clearedFields[message.FieldRouting]
+	return ok
+}
+
+// ResetRouting resets all changes to the "routing" field.
+func (m *MessageMutation) ResetRouting() {
+	m.routing = nil
+	delete(m.clearedFields, message.FieldRouting)
+}
+
+// SetProcessedTime sets the "processed_time" field.
+func (m *MessageMutation) SetProcessedTime(t time.Time) {
+	m.processed_time = &t
+}
+
+// ProcessedTime returns the value of the "processed_time" field in the mutation.
+func (m *MessageMutation) ProcessedTime() (r time.Time, exists bool) {
+	v := m.processed_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProcessedTime returns the old "processed_time" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldProcessedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProcessedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProcessedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProcessedTime: %w", err)
+	}
+	return oldValue.ProcessedTime, nil
+}
+
+// ClearProcessedTime clears the value of the "processed_time" field.
+func (m *MessageMutation) ClearProcessedTime() {
+	m.processed_time = nil
+	m.clearedFields[message.FieldProcessedTime] = struct{}{}
+}
+
+// ProcessedTimeCleared returns if the "processed_time" field was cleared in this mutation.
+func (m *MessageMutation) ProcessedTimeCleared() bool {
+	_, ok := m.clearedFields[message.FieldProcessedTime]
+	return ok
+}
+
+// ResetProcessedTime resets all changes to the "processed_time" field.
+func (m *MessageMutation) ResetProcessedTime() {
+	m.processed_time = nil
+	delete(m.clearedFields, message.FieldProcessedTime)
+}
+
+// SetTraceID sets the "trace_id" field.
+func (m *MessageMutation) SetTraceID(s string) {
+	m.trace_id = &s
+}
+
+// TraceID returns the value of the "trace_id" field in the mutation.
+func (m *MessageMutation) TraceID() (r string, exists bool) {
+	v := m.trace_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceID returns the old "trace_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTraceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceID: %w", err)
+	}
+	return oldValue.TraceID, nil
+}
+
+// ClearTraceID clears the value of the "trace_id" field.
+func (m *MessageMutation) ClearTraceID() {
+	m.trace_id = nil
+	m.clearedFields[message.FieldTraceID] = struct{}{}
+}
+
+// TraceIDCleared returns if the "trace_id" field was cleared in this mutation.
+func (m *MessageMutation) TraceIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldTraceID]
+	return ok
+}
+
+// ResetTraceID resets all changes to the "trace_id" field.
+func (m *MessageMutation) ResetTraceID() {
+	m.trace_id = nil
+	delete(m.clearedFields, message.FieldTraceID)
+}
+
+// SetOwner sets the "owner" field.
+func (m *MessageMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *MessageMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *MessageMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[message.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *MessageMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[message.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *MessageMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, message.FieldOwner)
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *MessageMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *MessageMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *MessageMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *MessageMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *MessageMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *MessageMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[message.FieldAgentID] = struct{}{}
+}
+
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *MessageMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAgentID]
+	return ok
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *MessageMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, message.FieldAgentID)
+}
+
+// SetModelID sets the "model_id" field.
+func (m *MessageMutation) SetModelID(u uuid.UUID) {
+	m.model = &u
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *MessageMutation) ModelID() (r uuid.UUID, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldModelID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *MessageMutation) ClearModelID() {
+	m.model = nil
+	m.clearedFields[message.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *MessageMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *MessageMutation) ResetModelID() {
+	m.model = nil
+	delete(m.clearedFields, message.FieldModelID)
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *MessageMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[message.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *MessageMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *MessageMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *MessageMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[message.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *MessageMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *MessageMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// ClearModel clears the "model" edge to the Model entity.
+func (m *MessageMutation) ClearModel() {
+	m.clearedmodel = true
+	m.clearedFields[message.FieldModelID] = struct{}{}
+}
+
+// ModelCleared reports if the "model" edge to the Model entity was cleared.
+func (m *MessageMutation) ModelCleared() bool {
+	return m.ModelIDCleared() || m.clearedmodel
+}
+
+// ModelIDs returns the "model" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ModelIDs() (ids []uuid.UUID) {
+	if id := m.model; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModel resets all changes to the "model" edge.
+func (m *MessageMutation) ResetModel() {
+	m.model = nil
+	m.clearedmodel = false
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, message.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, message.FieldUpdateTime)
+	}
+	if m.source != nil {
+		fields = append(fields, message.FieldSource)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.usage != nil {
+		fields = append(fields, message.FieldUsage)
+	}
+	if m.routing != nil {
+		fields = append(fields, message.FieldRouting)
+	}
+	if m.processed_time != nil {
+		fields = append(fields, message.FieldProcessedTime)
+	}
+	if m.trace_id != nil {
+		fields = append(fields, message.FieldTraceID)
+	}
+	if m.owner != nil {
+		fields = append(fields, message.FieldOwner)
+	}
+	if m.task != nil {
+		fields = append(fields, message.FieldTaskID)
+	}
+	if m.agent != nil {
+		fields = append(fields, message.FieldAgentID)
+	}
+	if m.model != nil {
+		fields = append(fields, message.FieldModelID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.CreateTime()
+	case message.FieldUpdateTime:
+		return m.UpdateTime()
+	case message.FieldSource:
+		return m.Source()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldUsage:
+		return m.Usage()
+	case message.FieldRouting:
+		return m.Routing()
+	case message.FieldProcessedTime:
+		return m.ProcessedTime()
+	case message.FieldTraceID:
+		return m.TraceID()
+	case message.FieldOwner:
+		return m.Owner()
+	case message.FieldTaskID:
+		return m.TaskID()
+	case message.FieldAgentID:
+		return m.AgentID()
+	case message.FieldModelID:
+		return m.ModelID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case message.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case message.FieldSource:
+		return m.OldSource(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldUsage:
+		return m.OldUsage(ctx)
+	case message.FieldRouting:
+		return m.OldRouting(ctx)
+	case message.FieldProcessedTime:
+		return m.OldProcessedTime(ctx)
+	case message.FieldTraceID:
+		return m.OldTraceID(ctx)
+	case message.FieldOwner:
+		return m.OldOwner(ctx)
+	case message.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case message.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case message.FieldModelID:
+		return m.OldModelID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case message.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case message.FieldSource:
+		v, ok := value.(types.MessageSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(*types.MessageContent)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldUsage:
+		v, ok := value.(*types.MessageUsage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsage(v)
+		return nil
+	case message.FieldRouting:
+		v, ok := value.(*types.MessageRouting)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRouting(v)
+		return nil
+	case message.FieldProcessedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProcessedTime(v)
+		return nil
+	case message.FieldTraceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceID(v)
+		return nil
+	case message.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case message.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	case message.FieldAgentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case message.FieldModelID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldUsage) {
+		fields = append(fields, message.FieldUsage)
+	}
+	if m.FieldCleared(message.FieldRouting) {
+		fields = append(fields, message.FieldRouting)
+	}
+	if m.FieldCleared(message.FieldProcessedTime) {
+		fields = append(fields, message.FieldProcessedTime)
+	}
+	if m.FieldCleared(message.FieldTraceID) {
+		fields = append(fields, message.FieldTraceID)
+	}
+	if m.FieldCleared(message.FieldOwner) {
+		fields = append(fields, message.FieldOwner)
+	}
+	if m.FieldCleared(message.FieldAgentID) {
+		fields = append(fields, message.FieldAgentID)
+	}
+	if m.FieldCleared(message.FieldModelID) {
+		fields = append(fields, message.FieldModelID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldUsage:
+		m.ClearUsage()
+		return nil
+	case message.FieldRouting:
+		m.ClearRouting()
+		return nil
+	case message.FieldProcessedTime:
+		m.ClearProcessedTime()
+		return nil
+	case message.FieldTraceID:
+		m.ClearTraceID()
+		return nil
+	case message.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case message.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case message.FieldModelID:
+		m.ClearModelID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case message.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case message.FieldSource:
+		m.ResetSource()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldUsage:
+		m.ResetUsage()
+		return nil
+	case message.FieldRouting:
+		m.ResetRouting()
+		return nil
+	case message.FieldProcessedTime:
+		m.ResetProcessedTime()
+		return nil
+	case message.FieldTraceID:
+		m.ResetTraceID()
+		return nil
+	case message.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case message.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	case message.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case message.FieldModelID:
+		m.ResetModelID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.task != nil {
+		edges = append(edges, message.EdgeTask)
+	}
+	if m.agent != nil {
+		edges = append(edges, message.EdgeAgent)
+	}
+	if m.model != nil {
+		edges = append(edges, message.EdgeModel)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeModel:
+		if id := m.model; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtask {
+		edges = append(edges, message.EdgeTask)
+	}
+	if m.clearedagent {
+		edges = append(edges, message.EdgeAgent)
+	}
+	if m.clearedmodel {
+		edges = append(edges, message.EdgeModel)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeTask:
+		return m.clearedtask
+	case message.EdgeAgent:
+		return m.clearedagent
+	case message.EdgeModel:
+		return m.clearedmodel
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeTask:
+		m.ClearTask()
+		return nil
+	case message.EdgeAgent:
+		m.ClearAgent()
+		return nil
+	case message.EdgeModel:
+		m.ClearModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeTask:
+		m.ResetTask()
+		return nil
+	case message.EdgeAgent:
+		m.ResetAgent()
+		return nil
+	case message.EdgeModel:
+		m.ResetModel()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// ModelMutation represents an operation that mutates the Model nodes in the graph.
+type ModelMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	create_time           *time.Time
+	update_time           *time.Time
+	name                  *string
+	context_window        *int64
+	addcontext_window     *int64
+	capabilities          *[]types.ModelCapability
+	appendcapabilities    []types.ModelCapability
+	input_cost            *float64
+	addinput_cost         *float64
+	output_cost           *float64
+	addoutput_cost        *float64
+	cache_write_cost      *float64
+	addcache_write_cost   *float64
+	cache_read_cost       *float64
+	addcache_read_cost    *float64
+	enabled               *bool
+	alias                 *string
+	source                *types.ModelSource
+	overrides             *[]types.ModelField
+	appendoverrides       []types.ModelField
+	clearedFields         map[string]struct{}
+	agents                map[uuid.UUID]struct{}
+	removedagents         map[uuid.UUID]struct{}
+	clearedagents         bool
+	model_provider        *uuid.UUID
+	clearedmodel_provider bool
+	messages              map[uuid.UUID]struct{}
+	removedmessages       map[uuid.UUID]struct{}
+	clearedmessages       bool
+	done                  bool
+	oldValue              func(context.Context) (*Model, error)
+	predicates            []predicate.Model
+}
+
+var _ ent.Mutation = (*ModelMutation)(nil)
+
+// modelOption allows management of the mutation configuration using functional options.
+type modelOption func(*ModelMutation)
+
+// newModelMutation creates new mutation for the Model entity.
+func newModelMutation(c config, op Op, opts ...modelOption) *ModelMutation {
+	m := &ModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelID sets the ID field of the mutation.
+func withModelID(id uuid.UUID) modelOption {
+	return func(m *ModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Model
+		)
+		m.oldValue = func(ctx context.Context) (*Model, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Model.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModel sets the old Model of the mutation.
+func withModel(node *Model) modelOption {
+	return func(m *ModelMutation) {
+		m.oldValue = func(context.Context) (*Model, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Model entities.
+func (m *ModelMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Model.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ModelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetContextWindow sets the "context_window" field.
+func (m *ModelMutation) SetContextWindow(i int64) {
+	m.context_window = &i
+	m.addcontext_window = nil
+}
+
+// ContextWindow returns the value of the "context_window" field in the mutation.
+func (m *ModelMutation) ContextWindow() (r int64, exists bool) {
+	v := m.context_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContextWindow returns the old "context_window" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldContextWindow(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContextWindow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContextWindow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContextWindow: %w", err)
+	}
+	return oldValue.ContextWindow, nil
+}
+
+// AddContextWindow adds i to the "context_window" field.
+func (m *ModelMutation) AddContextWindow(i int64) {
+	if m.addcontext_window != nil {
+		*m.addcontext_window += i
+	} else {
+		m.addcontext_window = &i
+	}
+}
+
+// AddedContextWindow returns the value that was added to the "context_window" field in this mutation.
+func (m *ModelMutation) AddedContextWindow() (r int64, exists bool) {
+	v := m.addcontext_window
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetContextWindow resets all changes to the "context_window" field.
+func (m *ModelMutation) ResetContextWindow() {
+	m.context_window = nil
+	m.addcontext_window = nil
+}
+
+// SetCapabilities sets the "capabilities" field.
+func (m *ModelMutation) SetCapabilities(tc []types.ModelCapability) {
+	m.capabilities = &tc
+	m.appendcapabilities = nil
+}
+
+// Capabilities returns the value of the "capabilities" field in the mutation.
+func (m *ModelMutation) Capabilities() (r []types.ModelCapability, exists bool) {
+	v := m.capabilities
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapabilities returns the old "capabilities" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCapabilities(ctx context.Context) (v []types.ModelCapability, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapabilities is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapabilities requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapabilities: %w", err)
+	}
+	return oldValue.Capabilities, nil
+}
+
+// AppendCapabilities adds tc to the "capabilities" field.
+func (m *ModelMutation) AppendCapabilities(tc []types.ModelCapability) {
+	m.appendcapabilities = append(m.appendcapabilities, tc...)
+}
+
+// AppendedCapabilities returns the list of values that were appended to the "capabilities" field in this mutation.
+func (m *ModelMutation) AppendedCapabilities() ([]types.ModelCapability, bool) {
+	if len(m.appendcapabilities) == 0 {
+		return nil, false
+	}
+	return m.appendcapabilities, true
+}
+
+// ClearCapabilities clears the value of the "capabilities" field.
+func (m *ModelMutation) ClearCapabilities() {
+	m.capabilities = nil
+	m.appendcapabilities = nil
+	m.clearedFields[model.FieldCapabilities] = struct{}{}
+}
+
+// CapabilitiesCleared returns if the "capabilities" field was cleared in this mutation.
+func (m *ModelMutation) CapabilitiesCleared() bool {
+	_, ok := m.clearedFields[model.FieldCapabilities]
+	return ok
+}
+
+// ResetCapabilities resets all changes to the "capabilities" field.
+func (m *ModelMutation) ResetCapabilities() {
+	m.capabilities = nil
+	m.appendcapabilities = nil
+	delete(m.clearedFields, model.FieldCapabilities)
+}
+
+// SetInputCost sets the "input_cost" field.
+func (m *ModelMutation) SetInputCost(f float64) {
+	m.input_cost = &f
+	m.addinput_cost = nil
+}
+
+// InputCost returns the value of the "input_cost" field in the mutation.
+func (m *ModelMutation) InputCost() (r float64, exists bool) {
+	v := m.input_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputCost returns the old "input_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldInputCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputCost: %w", err)
+	}
+	return oldValue.InputCost, nil
+}
+
+// AddInputCost adds f to the "input_cost" field.
+func (m *ModelMutation) AddInputCost(f float64) {
+	if m.addinput_cost != nil {
+		*m.addinput_cost += f
+	} else {
+		m.addinput_cost = &f
+	}
+}
+
+// AddedInputCost returns the value that was added to the "input_cost" field in this mutation.
+func (m *ModelMutation) AddedInputCost() (r float64, exists bool) {
+	v := m.addinput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputCost resets all changes to the "input_cost" field.
+func (m *ModelMutation) ResetInputCost() {
+	m.input_cost = nil
+	m.addinput_cost = nil
+}
+
+// SetOutputCost sets the "output_cost" field.
+func (m *ModelMutation) SetOutputCost(f float64) {
+	m.output_cost = &f
+	m.addoutput_cost = nil
+}
+
+// OutputCost returns the value of the "output_cost" field in the mutation.
+func (m *ModelMutation) OutputCost() (r float64, exists bool) {
+	v := m.output_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputCost returns the old "output_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldOutputCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputCost: %w", err)
+	}
+	return oldValue.OutputCost, nil
+}
+
+// AddOutputCost adds f to the "output_cost" field.
+func (m *ModelMutation) AddOutputCost(f float64) {
+	if m.addoutput_cost != nil {
+		*m.addoutput_cost += f
+	} else {
+		m.addoutput_cost = &f
+	}
+}
+
+// AddedOutputCost returns the value that was added to the "output_cost" field in this mutation.
+func (m *ModelMutation) AddedOutputCost() (r float64, exists bool) {
+	v := m.addoutput_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputCost resets all changes to the "output_cost" field.
+func (m *ModelMutation) ResetOutputCost() {
+	m.output_cost = nil
+	m.addoutput_cost = nil
+}
+
+// SetCacheWriteCost sets the "cache_write_cost" field.
+func (m *ModelMutation) SetCacheWriteCost(f float64) {
+	m.cache_write_cost = &f
+	m.addcache_write_cost = nil
+}
+
+// CacheWriteCost returns the value of the "cache_write_cost" field in the mutation.
+func (m *ModelMutation) CacheWriteCost() (r float64, exists bool) {
+	v := m.cache_write_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteCost returns the old "cache_write_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCacheWriteCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteCost: %w", err)
+	}
+	return oldValue.CacheWriteCost, nil
+}
+
+// AddCacheWriteCost adds f to the "cache_write_cost" field.
+func (m *ModelMutation) AddCacheWriteCost(f float64) {
+	if m.addcache_write_cost != nil {
+		*m.addcache_write_cost += f
+	} else {
+		m.addcache_write_cost = &f
+	}
+}
+
+// AddedCacheWriteCost returns the value that was added to the "cache_write_cost" field in this mutation.
+func (m *ModelMutation) AddedCacheWriteCost() (r float64, exists bool) {
+	v := m.addcache_write_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheWriteCost resets all changes to the "cache_write_cost" field.
+func (m *ModelMutation) ResetCacheWriteCost() {
+	m.cache_write_cost = nil
+	m.addcache_write_cost = nil
+}
+
+// SetCacheReadCost sets the "cache_read_cost" field.
+func (m *ModelMutation) SetCacheReadCost(f float64) {
+	m.cache_read_cost = &f
+	m.addcache_read_cost = nil
+}
+
+// CacheReadCost returns the value of the "cache_read_cost" field in the mutation.
+func (m *ModelMutation) CacheReadCost() (r float64, exists bool) {
+	v := m.cache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadCost returns the old "cache_read_cost" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldCacheReadCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadCost: %w", err)
+	}
+	return oldValue.CacheReadCost, nil
+}
+
+// AddCacheReadCost adds f to the "cache_read_cost" field.
+func (m *ModelMutation) AddCacheReadCost(f float64) {
+	if m.addcache_read_cost != nil {
+		*m.addcache_read_cost += f
+	} else {
+		m.addcache_read_cost = &f
+	}
+}
+
+// AddedCacheReadCost returns the value that was added to the "cache_read_cost" field in this mutation.
+func (m *ModelMutation) AddedCacheReadCost() (r float64, exists bool) {
+	v := m.addcache_read_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheReadCost resets all changes to the "cache_read_cost" field.
+func (m *ModelMutation) ResetCacheReadCost() {
+	m.cache_read_cost = nil
+	m.addcache_read_cost = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModelMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModelMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModelMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetAlias sets the "alias" field.
+func (m *ModelMutation) SetAlias(s string) {
+	m.alias = &s
+}
+
+// Alias returns the value of the "alias" field in the mutation.
+func (m *ModelMutation) Alias() (r string, exists bool) {
+	v := m.alias
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlias returns the old "alias" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldAlias(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlias is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlias requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlias: %w", err)
+	}
+	return oldValue.Alias, nil
+}
+
+// ClearAlias clears the value of the "alias" field.
+func (m *ModelMutation) ClearAlias() {
+	m.alias = nil
+	m.clearedFields[model.FieldAlias] = struct{}{}
+}
+
+// AliasCleared returns if the "alias" field was cleared in this mutation.
+func (m *ModelMutation) AliasCleared() bool {
+	_, ok := m.clearedFields[model.FieldAlias]
+	return ok
+}
+
+// ResetAlias resets all changes to the "alias" field.
+func (m *ModelMutation) ResetAlias() {
+	m.alias = nil
+	delete(m.clearedFields, model.FieldAlias)
+}
+
+// SetSource sets the "source" field.
+func (m *ModelMutation) SetSource(ts types.ModelSource) {
+	m.source = &ts
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ModelMutation) Source() (r types.ModelSource, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldSource(ctx context.Context) (v types.ModelSource, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ModelMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetOverrides sets the "overrides" field.
+func (m *ModelMutation) SetOverrides(tf []types.ModelField) {
+	m.overrides = &tf
+	m.appendoverrides = nil
+}
+
+// Overrides returns the value of the "overrides" field in the mutation.
+func (m *ModelMutation) Overrides() (r []types.ModelField, exists bool) {
+	v := m.overrides
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOverrides returns the old "overrides" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldOverrides(ctx context.Context) (v []types.ModelField, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOverrides is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOverrides requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOverrides: %w", err)
+	}
+	return oldValue.Overrides, nil
+}
+
+// AppendOverrides adds tf to the "overrides" field.
+func (m *ModelMutation) AppendOverrides(tf []types.ModelField) {
+	m.appendoverrides = append(m.appendoverrides, tf...)
+}
+
+// AppendedOverrides returns the list of values that were appended to the "overrides" field in this mutation.
+func (m *ModelMutation) AppendedOverrides() ([]types.ModelField, bool) {
+	if len(m.appendoverrides) == 0 {
+		return nil, false
+	}
+	return m.appendoverrides, true
+}
+
+// ClearOverrides clears the value of the "overrides" field.
+func (m *ModelMutation) ClearOverrides() {
+	m.overrides = nil
+	m.appendoverrides = nil
+	m.clearedFields[model.FieldOverrides] = struct{}{}
+}
+
+// OverridesCleared returns if the "overrides" field was cleared in this mutation.
+func (m *ModelMutation) OverridesCleared() bool {
+	_, ok := m.clearedFields[model.FieldOverrides]
+	return ok
+}
+
+// ResetOverrides resets all changes to the "overrides" field.
+func (m *ModelMutation) ResetOverrides() {
+	m.overrides = nil
+	m.appendoverrides = nil
+	delete(m.clearedFields, model.FieldOverrides)
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (m *ModelMutation) SetModelProviderID(u uuid.UUID) {
+	m.model_provider = &u
+}
+
+// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
+func (m *ModelMutation) ModelProviderID() (r uuid.UUID, exists bool) {
+	v := m.model_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelProviderID returns the old "model_provider_id" field's value of the Model entity.
+// If the Model object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+	}
+	return oldValue.ModelProviderID, nil
+}
+
+// ResetModelProviderID resets all changes to the "model_provider_id" field.
+func (m *ModelMutation) ResetModelProviderID() {
+	m.model_provider = nil
+}
+
+// AddAgentIDs adds the "agents" edge to the Agent entity by ids.
+func (m *ModelMutation) AddAgentIDs(ids ...uuid.UUID) {
+	if m.agents == nil {
+		m.agents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.agents[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAgents clears the "agents" edge to the Agent entity.
+func (m *ModelMutation) ClearAgents() {
+	m.clearedagents = true
+}
+
+// AgentsCleared reports if the "agents" edge to the Agent entity was cleared.
+func (m *ModelMutation) AgentsCleared() bool {
+	return m.clearedagents
+}
+
+// RemoveAgentIDs removes the "agents" edge to the Agent entity by IDs.
+func (m *ModelMutation) RemoveAgentIDs(ids ...uuid.UUID) {
+	if m.removedagents == nil {
+		m.removedagents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.agents, ids[i])
+		m.removedagents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAgents returns the removed IDs of the "agents" edge to the Agent entity.
+func (m *ModelMutation) RemovedAgentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedagents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AgentsIDs returns the "agents" edge IDs in the mutation.
+func (m *ModelMutation) AgentsIDs() (ids []uuid.UUID) {
+	for id := range m.agents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAgents resets all changes to the "agents" edge.
+func (m *ModelMutation) ResetAgents() {
+	m.agents = nil
+	m.clearedagents = false
+	m.removedagents = nil
+}
+
+// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
+func (m *ModelMutation) ClearModelProvider() {
+	m.clearedmodel_provider = true
+	m.clearedFields[model.FieldModelProviderID] = struct{}{}
+}
+
+// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
+func (m *ModelMutation) ModelProviderCleared() bool {
+	return m.clearedmodel_provider
+}
+
+// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelProviderID instead. It exists only for internal usage by the builders.
+func (m *ModelMutation) ModelProviderIDs() (ids []uuid.UUID) {
+	if id := m.model_provider; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModelProvider resets all changes to the "model_provider" edge.
+func (m *ModelMutation) ResetModelProvider() {
+	m.model_provider = nil
+	m.clearedmodel_provider = false
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ModelMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ModelMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ModelMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ModelMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ModelMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ModelMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ModelMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// Where appends a list predicates to the ModelMutation builder.
+func (m *ModelMutation) Where(ps ...predicate.Model) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Model, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Model).
+func (m *ModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.create_time != nil {
+		fields = append(fields, model.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, model.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, model.FieldName)
+	}
+	if m.context_window != nil {
+		fields = append(fields, model.FieldContextWindow)
+	}
+	if m.capabilities != nil {
+		fields = append(fields, model.FieldCapabilities)
+	}
+	if m.input_cost != nil {
+		fields = append(fields, model.FieldInputCost)
+	}
+	if m.output_cost != nil {
+		fields = append(fields, model.FieldOutputCost)
+	}
+	if m.cache_write_cost != nil {
+		fields = append(fields, model.FieldCacheWriteCost)
+	}
+	if m.cache_read_cost != nil {
+		fields = append(fields, model.FieldCacheReadCost)
+	}
+	if m.enabled != nil {
+		fields = append(fields, model.FieldEnabled)
+	}
+	if m.alias != nil {
+		fields = append(fields, model.FieldAlias)
+	}
+	if m.source != nil {
+		fields = append(fields, model.FieldSource)
+	}
+	if m.overrides != nil {
+		fields = append(fields, model.FieldOverrides)
+	}
+	if m.model_provider != nil {
+		fields = append(fields, model.FieldModelProviderID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldCreateTime:
+		return m.CreateTime()
+	case model.FieldUpdateTime:
+		return m.UpdateTime()
+	case model.FieldName:
+		return m.Name()
+	case model.FieldContextWindow:
+		return m.ContextWindow()
+	case model.FieldCapabilities:
+		return m.Capabilities()
+	case model.FieldInputCost:
+		return m.InputCost()
+	case model.FieldOutputCost:
+		return m.OutputCost()
+	case model.FieldCacheWriteCost:
+		return m.CacheWriteCost()
+	case model.FieldCacheReadCost:
+		return m.CacheReadCost()
+	case model.FieldEnabled:
+		return m.Enabled()
+	case model.FieldAlias:
+		return m.Alias()
+	case model.FieldSource:
+		return m.Source()
+	case model.FieldOverrides:
+		return m.Overrides()
+	case model.FieldModelProviderID:
+		return m.ModelProviderID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case model.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case model.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case model.FieldName:
+		return m.OldName(ctx)
+	case model.FieldContextWindow:
+		return m.OldContextWindow(ctx)
+	case model.FieldCapabilities:
+		return m.OldCapabilities(ctx)
+	case model.FieldInputCost:
+		return m.OldInputCost(ctx)
+	case model.FieldOutputCost:
+		return m.OldOutputCost(ctx)
+	case model.FieldCacheWriteCost:
+		return m.OldCacheWriteCost(ctx)
+	case model.FieldCacheReadCost:
+		return m.OldCacheReadCost(ctx)
+	case model.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case model.FieldAlias:
+		return m.OldAlias(ctx)
+	case model.FieldSource:
+		return m.OldSource(ctx)
+	case model.FieldOverrides:
+		return m.OldOverrides(ctx)
+	case model.FieldModelProviderID:
+		return m.OldModelProviderID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Model field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case model.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case model.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case model.FieldContextWindow:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContextWindow(v)
+		return nil
+	case model.FieldCapabilities:
+		v, ok := value.([]types.ModelCapability)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapabilities(v)
+		return nil
+	case model.FieldInputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputCost(v)
+		return nil
+	case model.FieldOutputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputCost(v)
+		return nil
+	case model.FieldCacheWriteCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheWriteCost(v)
+		return nil
+	case model.FieldCacheReadCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadCost(v)
+		return nil
+	case model.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case model.FieldAlias:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlias(v)
+		return nil
+	case model.FieldSource:
+		v, ok := value.(types.ModelSource)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case model.FieldOverrides:
+		v, ok := value.([]types.ModelField)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOverrides(v)
+		return nil
+	case model.FieldModelProviderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelProviderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelMutation) AddedFields() []string {
+	var fields []string
+	if m.addcontext_window != nil {
+		fields = append(fields, model.FieldContextWindow)
+	}
+	if m.addinput_cost != nil {
+		fields = append(fields, model.FieldInputCost)
+	}
+	if m.addoutput_cost != nil {
+		fields = append(fields, model.FieldOutputCost)
+	}
+	if m.addcache_write_cost != nil {
+		fields = append(fields, model.FieldCacheWriteCost)
+	}
+	if m.addcache_read_cost != nil {
+		fields = append(fields, model.FieldCacheReadCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case model.FieldContextWindow:
+		return m.AddedContextWindow()
+	case model.FieldInputCost:
+		return m.AddedInputCost()
+	case model.FieldOutputCost:
+		return m.AddedOutputCost()
+	case model.FieldCacheWriteCost:
+		return m.AddedCacheWriteCost()
+	case model.FieldCacheReadCost:
+		return m.AddedCacheReadCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case model.FieldContextWindow:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContextWindow(v)
+		return nil
+	case model.FieldInputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputCost(v)
+		return nil
+	case model.FieldOutputCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputCost(v)
+		return nil
+	case model.FieldCacheWriteCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheWriteCost(v)
+		return nil
+	case model.FieldCacheReadCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Model numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(model.FieldCapabilities) {
+		fields = append(fields, model.FieldCapabilities)
+	}
+	if m.FieldCleared(model.FieldAlias) {
+		fields = append(fields, model.FieldAlias)
+	}
+	if m.FieldCleared(model.FieldOverrides) {
+		fields = append(fields, model.FieldOverrides)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelMutation) ClearField(name string) error {
+	switch name {
+	case model.FieldCapabilities:
+		m.ClearCapabilities()
+		return nil
+	case model.FieldAlias:
+		m.ClearAlias()
+		return nil
+	case model.FieldOverrides:
+		m.ClearOverrides()
+		return nil
+	}
+	return fmt.Errorf("unknown Model nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelMutation) ResetField(name string) error {
+	switch name {
+	case model.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case model.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case model.FieldName:
+		m.ResetName()
+		return nil
+	case model.FieldContextWindow:
+		m.ResetContextWindow()
+		return nil
+	case model.FieldCapabilities:
+		m.ResetCapabilities()
+		return nil
+	case model.FieldInputCost:
+		m.ResetInputCost()
+		return nil
+	case model.FieldOutputCost:
+		m.ResetOutputCost()
+		return nil
+	case model.FieldCacheWriteCost:
+		m.ResetCacheWriteCost()
+		return nil
+	case model.FieldCacheReadCost:
+		m.ResetCacheReadCost()
+		return nil
+	case model.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case model.FieldAlias:
+		m.ResetAlias()
+		return nil
+	case model.FieldSource:
+		m.ResetSource()
+		return nil
+	case model.FieldOverrides:
+		m.ResetOverrides()
+		return nil
+	case model.FieldModelProviderID:
+		m.ResetModelProviderID()
+		return nil
+	}
+	return fmt.Errorf("unknown Model field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.agents != nil {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.model_provider != nil {
+		edges = append(edges, model.EdgeModelProvider)
+	}
+	if m.messages != nil {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.agents))
+		for id := range m.agents {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeModelProvider:
+		if id := m.model_provider; id != nil {
+			return []ent.Value{*id}
+		}
+	case model.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedagents != nil {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.removedmessages != nil {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case model.EdgeAgents:
+		ids := make([]ent.Value, 0, len(m.removedagents))
+		for id := range m.removedagents {
+			ids = append(ids, id)
+		}
+		return ids
+	case model.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedagents {
+		edges = append(edges, model.EdgeAgents)
+	}
+	if m.clearedmodel_provider {
+		edges = append(edges, model.EdgeModelProvider)
+	}
+	if m.clearedmessages {
+		edges = append(edges, model.EdgeMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelMutation) EdgeCleared(name string) bool {
+	switch name {
+	case model.EdgeAgents:
+		return m.clearedagents
+	case model.EdgeModelProvider:
+		return m.clearedmodel_provider
+	case model.EdgeMessages:
+		return m.clearedmessages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelMutation) ClearEdge(name string) error {
+	switch name {
+	case model.EdgeModelProvider:
+		m.ClearModelProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown Model unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelMutation) ResetEdge(name string) error {
+	switch name {
+	case model.EdgeAgents:
+		m.ResetAgents()
+		return nil
+	case model.EdgeModelProvider:
+		m.ResetModelProvider()
+		return nil
+	case model.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown Model edge %s", name)
+}
+
+// ModelCallTraceMutation represents an operation that mutates the ModelCallTrace nodes in the graph.
+type ModelCallTraceMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	create_time       *time.Time
+	update_time       *time.Time
+	model_name        *string
+	model_provider_id *uuid.UUID
+	method            *string
+	url               *string
+	request_headers   *map[string][]string
+	request_body      *[]byte
+	status_code       *int
+	addstatus_code    *int
+	response_headers  *map[string][]string
+	response_body     *[]byte
+	truncated         *bool
+	error             *string
+	duration_ms       *int64
+	addduration_ms    *int64
+	clearedFields     map[string]struct{}
+	task              *uuid.UUID
+	clearedtask       bool
+	done              bool
+	oldValue          func(context.Context) (*ModelCallTrace, error)
+	predicates        []predicate.ModelCallTrace
+}
+
+var _ ent.Mutation = (*ModelCallTraceMutation)(nil)
+
+// modelcalltraceOption allows management of the mutation configuration using functional options.
+type modelcalltraceOption func(*ModelCallTraceMutation)
+
+// newModelCallTraceMutation creates new mutation for the ModelCallTrace entity.
+func newModelCallTraceMutation(c config, op Op, opts ...modelcalltraceOption) *ModelCallTraceMutation {
+	m := &ModelCallTraceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelCallTrace,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelCallTraceID sets the ID field of the mutation.
+func withModelCallTraceID(id uuid.UUID) modelcalltraceOption {
+	return func(m *ModelCallTraceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelCallTrace
+		)
+		m.oldValue = func(ctx context.Context) (*ModelCallTrace, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelCallTrace.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelCallTrace sets the old ModelCallTrace of the mutation.
+func withModelCallTrace(node *ModelCallTrace) modelcalltraceOption {
+	return func(m *ModelCallTraceMutation) {
+		m.oldValue = func(context.Context) (*ModelCallTrace, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelCallTraceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelCallTraceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelCallTrace entities.
+func (m *ModelCallTraceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelCallTraceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelCallTraceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelCallTrace.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelCallTraceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelCallTraceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelCallTraceMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelCallTraceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelCallTraceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelCallTraceMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetModelName sets the "model_name" field.
+func (m *ModelCallTraceMutation) SetModelName(s string) {
+	m.model_name = &s
+}
+
+// ModelName returns the value of the "model_name" field in the mutation.
+func (m *ModelCallTraceMutation) ModelName() (r string, exists bool) {
+	v := m.model_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelName returns the old "model_name" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldModelName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelName: %w", err)
+	}
+	return oldValue.ModelName, nil
+}
+
+// ResetModelName resets all changes to the "model_name" field.
+func (m *ModelCallTraceMutation) ResetModelName() {
+	m.model_name = nil
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (m *ModelCallTraceMutation) SetModelProviderID(u uuid.UUID) {
+	m.model_provider_id = &u
+}
+
+// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
+func (m *ModelCallTraceMutation) ModelProviderID() (r uuid.UUID, exists bool) {
+	v := m.model_provider_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelProviderID returns the old "model_provider_id" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+	}
+	return oldValue.ModelProviderID, nil
+}
+
+// ClearModelProviderID clears the value of the "model_provider_id" field.
+func (m *ModelCallTraceMutation) ClearModelProviderID() {
+	m.model_provider_id = nil
+	m.clearedFields[modelcalltrace.FieldModelProviderID] = struct{}{}
+}
+
+// ModelProviderIDCleared returns if the "model_provider_id" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) ModelProviderIDCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldModelProviderID]
+	return ok
+}
+
+// ResetModelProviderID resets all changes to the "model_provider_id" field.
+func (m *ModelCallTraceMutation) ResetModelProviderID() {
+	m.model_provider_id = nil
+	delete(m.clearedFields, modelcalltrace.FieldModelProviderID)
+}
+
+// SetMethod sets the "method" field.
+func (m *ModelCallTraceMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *ModelCallTraceMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *ModelCallTraceMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ModelCallTraceMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ModelCallTraceMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ModelCallTraceMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetRequestHeaders sets the "request_headers" field.
+func (m *ModelCallTraceMutation) SetRequestHeaders(value map[string][]string) {
+	m.request_headers = &value
+}
+
+// RequestHeaders returns the value of the "request_headers" field in the mutation.
+func (m *ModelCallTraceMutation) RequestHeaders() (r map[string][]string, exists bool) {
+	v := m.request_headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestHeaders returns the old "request_headers" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldRequestHeaders(ctx context.Context) (v map[string][]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestHeaders: %w", err)
+	}
+	return oldValue.RequestHeaders, nil
+}
+
+// ClearRequestHeaders clears the value of the "request_headers" field.
+func (m *ModelCallTraceMutation) ClearRequestHeaders() {
+	m.request_headers = nil
+	m.clearedFields[modelcalltrace.FieldRequestHeaders] = struct{}{}
+}
+
+// RequestHeadersCleared returns if the "request_headers" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) RequestHeadersCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldRequestHeaders]
+	return ok
+}
+
+// ResetRequestHeaders resets all changes to the "request_headers" field.
+func (m *ModelCallTraceMutation) ResetRequestHeaders() {
+	m.request_headers = nil
+	delete(m.clearedFields, modelcalltrace.FieldRequestHeaders)
+}
+
+// SetRequestBody sets the "request_body" field.
+func (m *ModelCallTraceMutation) SetRequestBody(b []byte) {
+	m.request_body = &b
+}
+
+// RequestBody returns the value of the "request_body" field in the mutation.
+func (m *ModelCallTraceMutation) RequestBody() (r []byte, exists bool) {
+	v := m.request_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestBody returns the old "request_body" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldRequestBody(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestBody: %w", err)
+	}
+	return oldValue.RequestBody, nil
+}
+
+// ClearRequestBody clears the value of the "request_body" field.
+func (m *ModelCallTraceMutation) ClearRequestBody() {
+	m.request_body = nil
+	m.clearedFields[modelcalltrace.FieldRequestBody] = struct{}{}
+}
+
+// RequestBodyCleared returns if the "request_body" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) RequestBodyCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldRequestBody]
+	return ok
+}
+
+// ResetRequestBody resets all changes to the "request_body" field.
+func (m *ModelCallTraceMutation) ResetRequestBody() {
+	m.request_body = nil
+	delete(m.clearedFields, modelcalltrace.FieldRequestBody)
+}
+
+// SetStatusCode sets the "status_code" field.
+func (m *ModelCallTraceMutation) SetStatusCode(i int) {
+	m.status_code = &i
+	m.addstatus_code = nil
+}
+
+// StatusCode returns the value of the "status_code" field in the mutation.
+func (m *ModelCallTraceMutation) StatusCode() (r int, exists bool) {
+	v := m.status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusCode returns the old "status_code" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusCode: %w", err)
+	}
+	return oldValue.StatusCode, nil
+}
+
+// AddStatusCode adds i to the "status_code" field.
+func (m *ModelCallTraceMutation) AddStatusCode(i int) {
+	if m.addstatus_code != nil {
+		*m.addstatus_code += i
+	} else {
+		m.addstatus_code = &i
+	}
+}
+
+// AddedStatusCode returns the value that was added to the "status_code" field in this mutation.
+func (m *ModelCallTraceMutation) AddedStatusCode() (r int, exists bool) {
+	v := m.addstatus_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearStatusCode clears the value of the "status_code" field.
+func (m *ModelCallTraceMutation) ClearStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	m.clearedFields[modelcalltrace.FieldStatusCode] = struct{}{}
+}
+
+// StatusCodeCleared returns if the "status_code" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) StatusCodeCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldStatusCode]
+	return ok
+}
+
+// ResetStatusCode resets all changes to the "status_code" field.
+func (m *ModelCallTraceMutation) ResetStatusCode() {
+	m.status_code = nil
+	m.addstatus_code = nil
+	delete(m.clearedFields, modelcalltrace.FieldStatusCode)
+}
+
+// SetResponseHeaders sets the "response_headers" field.
+func (m *ModelCallTraceMutation) SetResponseHeaders(value map[string][]string) {
+	m.response_headers = &value
+}
+
+// ResponseHeaders returns the value of the "response_headers" field in the mutation.
+func (m *ModelCallTraceMutation) ResponseHeaders() (r map[string][]string, exists bool) {
+	v := m.response_headers
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseHeaders returns the old "response_headers" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldResponseHeaders(ctx context.Context) (v map[string][]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseHeaders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseHeaders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseHeaders: %w", err)
+	}
+	return oldValue.ResponseHeaders, nil
+}
+
+// ClearResponseHeaders clears the value of the "response_headers" field.
+func (m *ModelCallTraceMutation) ClearResponseHeaders() {
+	m.response_headers = nil
+	m.clearedFields[modelcalltrace.FieldResponseHeaders] = struct{}{}
+}
+
+// ResponseHeadersCleared returns if the "response_headers" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) ResponseHeadersCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldResponseHeaders]
+	return ok
+}
+
+// ResetResponseHeaders resets all changes to the "response_headers" field.
+func (m *ModelCallTraceMutation) ResetResponseHeaders() {
+	m.response_headers = nil
+	delete(m.clearedFields, modelcalltrace.FieldResponseHeaders)
+}
+
+// SetResponseBody sets the "response_body" field.
+func (m *ModelCallTraceMutation) SetResponseBody(b []byte) {
+	m.response_body = &b
+}
+
+// ResponseBody returns the value of the "response_body" field in the mutation.
+func (m *ModelCallTraceMutation) ResponseBody() (r []byte, exists bool) {
+	v := m.response_body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseBody returns the old "response_body" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldResponseBody(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseBody: %w", err)
+	}
+	return oldValue.ResponseBody, nil
+}
+
+// ClearResponseBody clears the value of the "response_body" field.
+func (m *ModelCallTraceMutation) ClearResponseBody() {
+	m.response_body = nil
+	m.clearedFields[modelcalltrace.FieldResponseBody] = struct{}{}
+}
+
+// ResponseBodyCleared returns if the "response_body" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) ResponseBodyCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldResponseBody]
+	return ok
+}
+
+// ResetResponseBody resets all changes to the "response_body" field.
+func (m *ModelCallTraceMutation) ResetResponseBody() {
+	m.response_body = nil
+	delete(m.clearedFields, modelcalltrace.FieldResponseBody)
+}
+
+// SetTruncated sets the "truncated" field.
+func (m *ModelCallTraceMutation) SetTruncated(b bool) {
+	m.truncated = &b
+}
+
+// Truncated returns the value of the "truncated" field in the mutation.
+func (m *ModelCallTraceMutation) Truncated() (r bool, exists bool) {
+	v := m.truncated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTruncated returns the old "truncated" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldTruncated(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTruncated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTruncated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTruncated: %w", err)
+	}
+	return oldValue.Truncated, nil
+}
+
+// ResetTruncated resets all changes to the "truncated" field.
+func (m *ModelCallTraceMutation) ResetTruncated() {
+	m.truncated = nil
+}
+
+// SetError sets the "error" field.
+func (m *ModelCallTraceMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *ModelCallTraceMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *ModelCallTraceMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[modelcalltrace.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *ModelCallTraceMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[modelcalltrace.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *ModelCallTraceMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, modelcalltrace.FieldError)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *ModelCallTraceMutation) SetDurationMs(i int64) {
+	m.duration_ms = &i
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *ModelCallTraceMutation) DurationMs() (r int64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldDurationMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds i to the "duration_ms" field.
+func (m *ModelCallTraceMutation) AddDurationMs(i int64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += i
+	} else {
+		m.addduration_ms = &i
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *ModelCallTraceMutation) AddedDurationMs() (r int64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *ModelCallTraceMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+}
+
+// SetTaskID sets the "task_id" field.
+func (m *ModelCallTraceMutation) SetTaskID(u uuid.UUID) {
+	m.task = &u
+}
+
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *ModelCallTraceMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTaskID returns the old "task_id" field's value of the ModelCallTrace entity.
+// If the ModelCallTrace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelCallTraceMutation) OldTaskID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
+	}
+	return oldValue.TaskID, nil
+}
+
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *ModelCallTraceMutation) ResetTaskID() {
+	m.task = nil
+}
+
+// ClearTask clears the "task" edge to the Task entity.
+func (m *ModelCallTraceMutation) ClearTask() {
+	m.clearedtask = true
+	m.clearedFields[modelcalltrace.FieldTaskID] = struct{}{}
+}
+
+// TaskCleared reports if the "task" edge to the Task entity was cleared.
+func (m *ModelCallTraceMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *ModelCallTraceMutation) TaskIDs() (ids []uuid.UUID) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *ModelCallTraceMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// Where appends a list predicates to the ModelCallTraceMutation builder.
+func (m *ModelCallTraceMutation) Where(ps ...predicate.ModelCallTrace) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelCallTraceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelCallTraceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelCallTrace, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelCallTraceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelCallTraceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelCallTrace).
+func (m *ModelCallTraceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelCallTraceMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, modelcalltrace.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, modelcalltrace.FieldUpdateTime)
+	}
+	if m.model_name != nil {
+		fields = append(fields, modelcalltrace.FieldModelName)
+	}
+	if m.model_provider_id != nil {
+		fields = append(fields, modelcalltrace.FieldModelProviderID)
+	}
+	if m.method != nil {
+		fields = append(fields, modelcalltrace.FieldMethod)
+	}
+	if m.url != nil {
+		fields = append(fields, modelcalltrace.FieldURL)
+	}
+	if m.request_headers != nil {
+		fields = append(fields, modelcalltrace.FieldRequestHeaders)
+	}
+	if m.request_body != nil {
+		fields = append(fields, modelcalltrace.FieldRequestBody)
+	}
+	if m.status_code != nil {
+		fields = append(fields, modelcalltrace.FieldStatusCode)
+	}
+	if m.response_headers != nil {
+		fields = append(fields, modelcalltrace.FieldResponseHeaders)
+	}
+	if m.response_body != nil {
+		fields = append(fields, modelcalltrace.FieldResponseBody)
+	}
+	if m.truncated != nil {
+		fields = append(fields, modelcalltrace.FieldTruncated)
+	}
+	if m.error != nil {
+		fields = append(fields, modelcalltrace.FieldError)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, modelcalltrace.FieldDurationMs)
+	}
+	if m.task != nil {
+		fields = append(fields, modelcalltrace.FieldTaskID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelCallTraceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelcalltrace.FieldCreateTime:
+		return m.CreateTime()
+	case modelcalltrace.FieldUpdateTime:
+		return m.UpdateTime()
+	case modelcalltrace.FieldModelName:
+		return m.ModelName()
+	case modelcalltrace.FieldModelProviderID:
+		return m.ModelProviderID()
+	case modelcalltrace.FieldMethod:
+		return m.Method()
+	case modelcalltrace.FieldURL:
+		return m.URL()
+	case modelcalltrace.FieldRequestHeaders:
+		return m.RequestHeaders()
+	case modelcalltrace.FieldRequestBody:
+		return m.RequestBody()
+	case modelcalltrace.FieldStatusCode:
+		return m.StatusCode()
+	case modelcalltrace.FieldResponseHeaders:
+		return m.ResponseHeaders()
+	case modelcalltrace.FieldResponseBody:
+		return m.ResponseBody()
+	case modelcalltrace.FieldTruncated:
+		return m.Truncated()
+	case modelcalltrace.FieldError:
+		return m.Error()
+	case modelcalltrace.FieldDurationMs:
+		return m.DurationMs()
+	case modelcalltrace.FieldTaskID:
+		return m.TaskID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelCallTraceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelcalltrace.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case modelcalltrace.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case modelcalltrace.FieldModelName:
+		return m.OldModelName(ctx)
+	case modelcalltrace.FieldModelProviderID:
+		return m.OldModelProviderID(ctx)
+	case modelcalltrace.FieldMethod:
+		return m.OldMethod(ctx)
+	case modelcalltrace.FieldURL:
+		return m.OldURL(ctx)
+	case modelcalltrace.FieldRequestHeaders:
+		return m.OldRequestHeaders(ctx)
+	case modelcalltrace.FieldRequestBody:
+		return m.OldRequestBody(ctx)
+	case modelcalltrace.FieldStatusCode:
+		return m.OldStatusCode(ctx)
+	case modelcalltrace.FieldResponseHeaders:
+		return m.OldResponseHeaders(ctx)
+	case modelcalltrace.FieldResponseBody:
+		return m.OldResponseBody(ctx)
+	case modelcalltrace.FieldTruncated:
+		return m.OldTruncated(ctx)
+	case modelcalltrace.FieldError:
+		return m.OldError(ctx)
+	case modelcalltrace.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case modelcalltrace.FieldTaskID:
+		return m.OldTaskID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelCallTrace field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelCallTraceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelcalltrace.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case modelcalltrace.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case modelcalltrace.FieldModelName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelName(v)
+		return nil
+	case modelcalltrace.FieldModelProviderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelProviderID(v)
+		return nil
+	case modelcalltrace.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case modelcalltrace.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case modelcalltrace.FieldRequestHeaders:
+		v, ok := value.(map[string][]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestHeaders(v)
+		return nil
+	case modelcalltrace.FieldRequestBody:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestBody(v)
+		return nil
+	case modelcalltrace.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusCode(v)
+		return nil
+	case modelcalltrace.FieldResponseHeaders:
+		v, ok := value.(map[string][]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseHeaders(v)
+		return nil
+	case modelcalltrace.FieldResponseBody:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseBody(v)
+		return nil
+	case modelcalltrace.FieldTruncated:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTruncated(v)
+		return nil
+	case modelcalltrace.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case modelcalltrace.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case modelcalltrace.FieldTaskID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTaskID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelCallTrace field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelCallTraceMutation) AddedFields() []string {
+	var fields []string
+	if m.addstatus_code != nil {
+		fields = append(fields, modelcalltrace.FieldStatusCode)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, modelcalltrace.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelCallTraceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case modelcalltrace.FieldStatusCode:
+		return m.AddedStatusCode()
+	case modelcalltrace.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelCallTraceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case modelcalltrace.FieldStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatusCode(v)
+		return nil
+	case modelcalltrace.FieldDurationMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelCallTrace numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelCallTraceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelcalltrace.FieldModelProviderID) {
+		fields = append(fields, modelcalltrace.FieldModelProviderID)
+	}
+	if m.FieldCleared(modelcalltrace.FieldRequestHeaders) {
+		fields = append(fields, modelcalltrace.FieldRequestHeaders)
+	}
+	if m.FieldCleared(modelcalltrace.FieldRequestBody) {
+		fields = append(fields, modelcalltrace.FieldRequestBody)
+	}
+	if m.FieldCleared(modelcalltrace.FieldStatusCode) {
+		fields = append(fields, modelcalltrace.FieldStatusCode)
+	}
+	if m.FieldCleared(modelcalltrace.FieldResponseHeaders) {
+		fields = append(fields, modelcalltrace.FieldResponseHeaders)
+	}
+	if m.FieldCleared(modelcalltrace.FieldResponseBody) {
+		fields = append(fields, modelcalltrace.FieldResponseBody)
+	}
+	if m.FieldCleared(modelcalltrace.FieldError) {
+		fields = append(fields, modelcalltrace.FieldError)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelCallTraceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelCallTraceMutation) ClearField(name string) error {
+	switch name {
+	case modelcalltrace.FieldModelProviderID:
+		m.ClearModelProviderID()
+		return nil
+	case modelcalltrace.FieldRequestHeaders:
+		m.ClearRequestHeaders()
+		return nil
+	case modelcalltrace.FieldRequestBody:
+		m.ClearRequestBody()
+		return nil
+	case modelcalltrace.FieldStatusCode:
+		m.ClearStatusCode()
+		return nil
+	case modelcalltrace.FieldResponseHeaders:
+		m.ClearResponseHeaders()
+		return nil
+	case modelcalltrace.FieldResponseBody:
+		m.ClearResponseBody()
+		return nil
+	case modelcalltrace.FieldError:
+		m.ClearError()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelCallTrace nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelCallTraceMutation) ResetField(name string) error {
+	switch name {
+	case modelcalltrace.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case modelcalltrace.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case modelcalltrace.FieldModelName:
+		m.ResetModelName()
+		return nil
+	case modelcalltrace.FieldModelProviderID:
+		m.ResetModelProviderID()
+		return nil
+	case modelcalltrace.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case modelcalltrace.FieldURL:
+		m.ResetURL()
+		return nil
+	case modelcalltrace.FieldRequestHeaders:
+		m.ResetRequestHeaders()
+		return nil
+	case modelcalltrace.FieldRequestBody:
+		m.ResetRequestBody()
+		return nil
+	case modelcalltrace.FieldStatusCode:
+		m.ResetStatusCode()
+		return nil
+	case modelcalltrace.FieldResponseHeaders:
+		m.ResetResponseHeaders()
+		return nil
+	case modelcalltrace.FieldResponseBody:
+		m.ResetResponseBody()
+		return nil
+	case modelcalltrace.FieldTruncated:
+		m.ResetTruncated()
+		return nil
+	case modelcalltrace.FieldError:
+		m.ResetError()
+		return nil
+	case modelcalltrace.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case modelcalltrace.FieldTaskID:
+		m.ResetTaskID()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelCallTrace field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelCallTraceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.task != nil {
+		edges = append(edges, modelcalltrace.EdgeTask)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelCallTraceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelcalltrace.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelCallTraceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelCallTraceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelCallTraceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtask {
+		edges = append(edges, modelcalltrace.EdgeTask)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelCallTraceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelcalltrace.EdgeTask:
+		return m.clearedtask
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelCallTraceMutation) ClearEdge(name string) error {
+	switch name {
+	case modelcalltrace.EdgeTask:
+		m.ClearTask()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelCallTrace unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelCallTraceMutation) ResetEdge(name string) error {
+	switch name {
+	case modelcalltrace.EdgeTask:
+		m.ResetTask()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelCallTrace edge %s", name)
+}
+
+// ModelProviderMutation represents an operation that mutates the ModelProvider nodes in the graph.
+type ModelProviderMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	provider_type *types.ModelProviderType
+	url           *string
+	secret        *[]byte
+	enabled       *bool
+	rate_limit    **types.ModelProviderRateLimit
+	key_selection *types.KeySelectionStrategy
+	clearedFields map[string]struct{}
+	models        map[uuid.UUID]struct{}
+	removedmodels map[uuid.UUID]struct{}
+	clearedmodels bool
+	keys          map[uuid.UUID]struct{}
+	removedkeys   map[uuid.UUID]struct{}
+	clearedkeys   bool
+	done          bool
+	oldValue      func(context.Context) (*ModelProvider, error)
+	predicates    []predicate.ModelProvider
+}
+
+var _ ent.Mutation = (*ModelProviderMutation)(nil)
+
+// modelproviderOption allows management of the mutation configuration using functional options.
+type modelproviderOption func(*ModelProviderMutation)
+
+// newModelProviderMutation creates new mutation for the ModelProvider entity.
+func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *ModelProviderMutation {
+	m := &ModelProviderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelProvider,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelProviderID sets the ID field of the mutation.
+func withModelProviderID(id uuid.UUID) modelproviderOption {
+	return func(m *ModelProviderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelProvider
+		)
+		m.oldValue = func(ctx context.Context) (*ModelProvider, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelProvider.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelProvider sets the old ModelProvider of the mutation.
+func withModelProvider(node *ModelProvider) modelproviderOption {
+	return func(m *ModelProviderMutation) {
+		m.oldValue = func(context.Context) (*ModelProvider, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelProviderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelProviderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelProvider entities.
+func (m *ModelProviderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelProvider.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelProviderMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelProviderMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelProviderMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelProviderMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ModelProviderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelProviderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelProviderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetProviderType sets the "provider_type" field.
+func (m *ModelProviderMutation) SetProviderType(tpt types.ModelProviderType) {
+	m.provider_type = &tpt
+}
+
+// ProviderType returns the value of the "provider_type" field in the mutation.
+func (m *ModelProviderMutation) ProviderType() (r types.ModelProviderType, exists bool) {
+	v := m.provider_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderType returns the old "provider_type" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldProviderType(ctx context.Context) (v types.ModelProviderType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+	}
+	return oldValue.ProviderType, nil
+}
+
+// ResetProviderType resets all changes to the "provider_type" field.
+func (m *ModelProviderMutation) ResetProviderType() {
+	m.provider_type = nil
+}
+
+// SetURL sets the "url" field.
+func (m *ModelProviderMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *ModelProviderMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ClearURL clears the value of the "url" field.
+func (m *ModelProviderMutation) ClearURL() {
+	m.url = nil
+	m.clearedFields[modelprovider.FieldURL] = struct{}{}
+}
+
+// URLCleared returns if the "url" field was cleared in this mutation.
+func (m *ModelProviderMutation) URLCleared() bool {
+	_, ok := m.clearedFields[modelprovider.FieldURL]
+	return ok
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *ModelProviderMutation) ResetURL() {
+	m.url = nil
+	delete(m.clearedFields, modelprovider.FieldURL)
+}
+
+// SetSecret sets the "secret" field.
+func (m *ModelProviderMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *ModelProviderMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *ModelProviderMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *ModelProviderMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *ModelProviderMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *ModelProviderMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetRateLimit sets the "rate_limit" field.
+func (m *ModelProviderMutation) SetRateLimit(tprl *types.ModelProviderRateLimit) {
+	m.rate_limit = &tprl
+}
+
+// RateLimit returns the value of the "rate_limit" field in the mutation.
+func (m *ModelProviderMutation) RateLimit() (r *types.ModelProviderRateLimit, exists bool) {
+	v := m.rate_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRateLimit returns the old "rate_limit" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldRateLimit(ctx context.Context) (v *types.ModelProviderRateLimit, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRateLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRateLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRateLimit: %w", err)
+	}
+	return oldValue.RateLimit, nil
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (m *ModelProviderMutation) ClearRateLimit() {
+	m.rate_limit = nil
+	m.clearedFields[modelprovider.FieldRateLimit] = struct{}{}
+}
+
+// RateLimitCleared returns if the "rate_limit" field was cleared in this mutation.
+func (m *ModelProviderMutation) RateLimitCleared() bool {
+	_, ok := m.clearedFields[modelprovider.FieldRateLimit]
+	return ok
+}
+
+// ResetRateLimit resets all changes to the "rate_limit" field.
+func (m *ModelProviderMutation) ResetRateLimit() {
+	m.rate_limit = nil
+	delete(m.clearedFields, modelprovider.FieldRateLimit)
+}
+
+// SetKeySelection sets the "key_selection" field.
+func (m *ModelProviderMutation) SetKeySelection(tss types.KeySelectionStrategy) {
+	m.key_selection = &tss
+}
+
+// KeySelection returns the value of the "key_selection" field in the mutation.
+func (m *ModelProviderMutation) KeySelection() (r types.KeySelectionStrategy, exists bool) {
+	v := m.key_selection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeySelection returns the old "key_selection" field's value of the ModelProvider entity.
+// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderMutation) OldKeySelection(ctx context.Context) (v types.KeySelectionStrategy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeySelection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeySelection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeySelection: %w", err)
+	}
+	return oldValue.KeySelection, nil
+}
+
+// ResetKeySelection resets all changes to the "key_selection" field.
+func (m *ModelProviderMutation) ResetKeySelection() {
+	m.key_selection = nil
+}
+
+// AddModelIDs adds the "models" edge to the Model entity by ids.
+func (m *ModelProviderMutation) AddModelIDs(ids ...uuid.UUID) {
+	if m.models == nil {
+		m.models = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.models[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModels clears the "models" edge to the Model entity.
+func (m *ModelProviderMutation) ClearModels() {
+	m.clearedmodels = true
+}
+
+// ModelsCleared reports if the "models" edge to the Model entity was cleared.
+func (m *ModelProviderMutation) ModelsCleared() bool {
+	return m.clearedmodels
+}
+
+// RemoveModelIDs removes the "models" edge to the Model entity by IDs.
+func (m *ModelProviderMutation) RemoveModelIDs(ids ...uuid.UUID) {
+	if m.removedmodels == nil {
+		m.removedmodels = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.models, ids[i])
+		m.removedmodels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModels returns the removed IDs of the "models" edge to the Model entity.
+func (m *ModelProviderMutation) RemovedModelsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmodels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModelsIDs returns the "models" edge IDs in the mutation.
+func (m *ModelProviderMutation) ModelsIDs() (ids []uuid.UUID) {
+	for id := range m.models {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModels resets all changes to the "models" edge.
+func (m *ModelProviderMutation) ResetModels() {
+	m.models = nil
+	m.clearedmodels = false
+	m.removedmodels = nil
+}
+
+// AddKeyIDs adds the "keys" edge to the ModelProviderKey entity by ids.
+func (m *ModelProviderMutation) AddKeyIDs(ids ...uuid.UUID) {
+	if m.keys == nil {
+		m.keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearKeys clears the "keys" edge to the ModelProviderKey entity.
+func (m *ModelProviderMutation) ClearKeys() {
+	m.clearedkeys = true
+}
+
+// KeysCleared reports if the "keys" edge to the ModelProviderKey entity was cleared.
+func (m *ModelProviderMutation) KeysCleared() bool {
+	return m.clearedkeys
+}
+
+// RemoveKeyIDs removes the "keys" edge to the ModelProviderKey entity by IDs.
+func (m *ModelProviderMutation) RemoveKeyIDs(ids ...uuid.UUID) {
+	if m.removedkeys == nil {
+		m.removedkeys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.keys, ids[i])
+		m.removedkeys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedKeys returns the removed IDs of the "keys" edge to the ModelProviderKey entity.
+func (m *ModelProviderMutation) RemovedKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedkeys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// KeysIDs returns the "keys" edge IDs in the mutation.
+func (m *ModelProviderMutation) KeysIDs() (ids []uuid.UUID) {
+	for id := range m.keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetKeys resets all changes to the "keys" edge.
+func (m *ModelProviderMutation) ResetKeys() {
+	m.keys = nil
+	m.clearedkeys = false
+	m.removedkeys = nil
+}
+
+// Where appends a list predicates to the ModelProviderMutation builder.
+func (m *ModelProviderMutation) Where(ps ...predicate.ModelProvider) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelProviderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelProvider, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelProviderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelProviderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelProvider).
+func (m *ModelProviderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelProviderMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, modelprovider.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, modelprovider.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, modelprovider.FieldName)
+	}
+	if m.provider_type != nil {
+		fields = append(fields, modelprovider.FieldProviderType)
+	}
+	if m.url != nil {
+		fields = append(fields, modelprovider.FieldURL)
+	}
+	if m.secret != nil {
+		fields = append(fields, modelprovider.FieldSecret)
+	}
+	if m.enabled != nil {
+		fields = append(fields, modelprovider.FieldEnabled)
+	}
+	if m.rate_limit != nil {
+		fields = append(fields, modelprovider.FieldRateLimit)
+	}
+	if m.key_selection != nil {
+		fields = append(fields, modelprovider.FieldKeySelection)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		return m.CreateTime()
+	case modelprovider.FieldUpdateTime:
+		return m.UpdateTime()
+	case modelprovider.FieldName:
+		return m.Name()
+	case modelprovider.FieldProviderType:
+		return m.ProviderType()
+	case modelprovider.FieldURL:
+		return m.URL()
+	case modelprovider.FieldSecret:
+		return m.Secret()
+	case modelprovider.FieldEnabled:
+		return m.Enabled()
+	case modelprovider.FieldRateLimit:
+		return m.RateLimit()
+	case modelprovider.FieldKeySelection:
+		return m.KeySelection()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case modelprovider.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case modelprovider.FieldName:
+		return m.OldName(ctx)
+	case modelprovider.FieldProviderType:
+		return m.OldProviderType(ctx)
+	case modelprovider.FieldURL:
+		return m.OldURL(ctx)
+	case modelprovider.FieldSecret:
+		return m.OldSecret(ctx)
+	case modelprovider.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case modelprovider.FieldRateLimit:
+		return m.OldRateLimit(ctx)
+	case modelprovider.FieldKeySelection:
+		return m.OldKeySelection(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case modelprovider.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case modelprovider.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case modelprovider.FieldProviderType:
+		v, ok := value.(types.ModelProviderType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProviderType(v)
+		return nil
+	case modelprovider.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case modelprovider.FieldSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case modelprovider.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case modelprovider.FieldRateLimit:
+		v, ok := value.(*types.ModelProviderRateLimit)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRateLimit(v)
+		return nil
+	case modelprovider.FieldKeySelection:
+		v, ok := value.(types.KeySelectionStrategy)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeySelection(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelProviderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelProviderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelProvider numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelProviderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelprovider.FieldURL) {
+		fields = append(fields, modelprovider.FieldURL)
+	}
+	if m.FieldCleared(modelprovider.FieldRateLimit) {
+		fields = append(fields, modelprovider.FieldRateLimit)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelProviderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelProviderMutation) ClearField(name string) error {
+	switch name {
+	case modelprovider.FieldURL:
+		m.ClearURL()
+		return nil
+	case modelprovider.FieldRateLimit:
+		m.ClearRateLimit()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelProviderMutation) ResetField(name string) error {
+	switch name {
+	case modelprovider.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case modelprovider.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case modelprovider.FieldName:
+		m.ResetName()
+		return nil
+	case modelprovider.FieldProviderType:
+		m.ResetProviderType()
+		return nil
+	case modelprovider.FieldURL:
+		m.ResetURL()
+		return nil
+	case modelprovider.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case modelprovider.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case modelprovider.FieldRateLimit:
+		m.ResetRateLimit()
+		return nil
+	case modelprovider.FieldKeySelection:
+		m.ResetKeySelection()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelProviderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.models != nil {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	if m.keys != nil {
+		edges = append(edges, modelprovider.EdgeKeys)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelprovider.EdgeModels:
+		ids := make([]ent.Value, 0, len(m.models))
+		for id := range m.models {
+			ids = append(ids, id)
+		}
+		return ids
+	case modelprovider.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.keys))
+		for id := range m.keys {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelProviderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmodels != nil {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	if m.removedkeys != nil {
+		edges = append(edges, modelprovider.EdgeKeys)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case modelprovider.EdgeModels:
+		ids := make([]ent.Value, 0, len(m.removedmodels))
+		for id := range m.removedmodels {
+			ids = append(ids, id)
+		}
+		return ids
+	case modelprovider.EdgeKeys:
+		ids := make([]ent.Value, 0, len(m.removedkeys))
+		for id := range m.removedkeys {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelProviderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmodels {
+		edges = append(edges, modelprovider.EdgeModels)
+	}
+	if m.clearedkeys {
+		edges = append(edges, modelprovider.EdgeKeys)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelProviderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelprovider.EdgeModels:
+		return m.clearedmodels
+	case modelprovider.EdgeKeys:
+		return m.clearedkeys
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelProviderMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ModelProvider unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelProviderMutation) ResetEdge(name string) error {
+	switch name {
+	case modelprovider.EdgeModels:
+		m.ResetModels()
+		return nil
+	case modelprovider.EdgeKeys:
+		m.ResetKeys()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProvider edge %s", name)
+}
+
+// ModelProviderKeyMutation represents an operation that mutates the ModelProviderKey nodes in the graph.
+type ModelProviderKeyMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	create_time            *time.Time
+	update_time            *time.Time
+	name                   *string
+	secret                 *[]byte
+	priority               *int
+	addpriority            *int
+	retired_time           *time.Time
+	last_used_time         *time.Time
+	last_rate_limited_time *time.Time
+	request_count          *int64
+	addrequest_count       *int64
+	input_tokens           *int64
+	addinput_tokens        *int64
+	output_tokens          *int64
+	addoutput_tokens       *int64
+	cache_write_tokens     *int64
+	addcache_write_tokens  *int64
+	cache_read_tokens      *int64
+	addcache_read_tokens   *int64
+	cost                   *float64
+	addcost                *float64
+	clearedFields          map[string]struct{}
+	model_provider         *uuid.UUID
+	clearedmodel_provider  bool
+	done                   bool
+	oldValue               func(context.Context) (*ModelProviderKey, error)
+	predicates             []predicate.ModelProviderKey
+}
+
+var _ ent.Mutation = (*ModelProviderKeyMutation)(nil)
+
+// modelproviderkeyOption allows management of the mutation configuration using functional options.
+type modelproviderkeyOption func(*ModelProviderKeyMutation)
+
+// newModelProviderKeyMutation creates new mutation for the ModelProviderKey entity.
+func newModelProviderKeyMutation(c config, op Op, opts ...modelproviderkeyOption) *ModelProviderKeyMutation {
+	m := &ModelProviderKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModelProviderKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModelProviderKeyID sets the ID field of the mutation.
+func withModelProviderKeyID(id uuid.UUID) modelproviderkeyOption {
+	return func(m *ModelProviderKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModelProviderKey
+		)
+		m.oldValue = func(ctx context.Context) (*ModelProviderKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModelProviderKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModelProviderKey sets the old ModelProviderKey of the mutation.
+func withModelProviderKey(node *ModelProviderKey) modelproviderkeyOption {
+	return func(m *ModelProviderKeyMutation) {
+		m.oldValue = func(context.Context) (*ModelProviderKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModelProviderKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModelProviderKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ModelProviderKey entities.
+func (m *ModelProviderKeyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModelProviderKeyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModelProviderKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModelProviderKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *ModelProviderKeyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *ModelProviderKeyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *ModelProviderKeyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *ModelProviderKeyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *ModelProviderKeyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *ModelProviderKeyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *ModelProviderKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ModelProviderKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ModelProviderKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSecret sets the "secret" field.
+func (m *ModelProviderKeyMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *ModelProviderKeyMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSecret returns the old "secret" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSecret requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+	}
+	return oldValue.Secret, nil
+}
+
+// ResetSecret resets all changes to the "secret" field.
+func (m *ModelProviderKeyMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *ModelProviderKeyMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *ModelProviderKeyMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *ModelProviderKeyMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *ModelProviderKeyMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetRetiredTime sets the "retired_time" field.
+func (m *ModelProviderKeyMutation) SetRetiredTime(t time.Time) {
+	m.retired_time = &t
+}
+
+// RetiredTime returns the value of the "retired_time" field in the mutation.
+func (m *ModelProviderKeyMutation) RetiredTime() (r time.Time, exists bool) {
+	v := m.retired_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetiredTime returns the old "retired_time" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldRetiredTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetiredTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetiredTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetiredTime: %w", err)
+	}
+	return oldValue.RetiredTime, nil
+}
+
+// ClearRetiredTime clears the value of the "retired_time" field.
+func (m *ModelProviderKeyMutation) ClearRetiredTime() {
+	m.retired_time = nil
+	m.clearedFields[modelproviderkey.FieldRetiredTime] = struct{}{}
+}
+
+// RetiredTimeCleared returns if the "retired_time" field was cleared in this mutation.
+func (m *ModelProviderKeyMutation) RetiredTimeCleared() bool {
+	_, ok := m.clearedFields[modelproviderkey.FieldRetiredTime]
+	return ok
+}
+
+// ResetRetiredTime resets all changes to the "retired_time" field.
+func (m *ModelProviderKeyMutation) ResetRetiredTime() {
+	m.retired_time = nil
+	delete(m.clearedFields, modelproviderkey.FieldRetiredTime)
+}
+
+// SetLastUsedTime sets the "last_used_time" field.
+func (m *ModelProviderKeyMutation) SetLastUsedTime(t time.Time) {
+	m.last_used_time = &t
+}
+
+// LastUsedTime returns the value of the "last_used_time" field in the mutation.
+func (m *ModelProviderKeyMutation) LastUsedTime() (r time.Time, exists bool) {
+	v := m.last_used_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUsedTime returns the old "last_used_time" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldLastUsedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedTime: %w", err)
+	}
+	return oldValue.LastUsedTime, nil
+}
+
+// ClearLastUsedTime clears the value of the "last_used_time" field.
+func (m *ModelProviderKeyMutation) ClearLastUsedTime() {
+	m.last_used_time = nil
+	m.clearedFields[modelproviderkey.FieldLastUsedTime] = struct{}{}
+}
+
+// LastUsedTimeCleared returns if the "last_used_time" field was cleared in this mutation.
+func (m *ModelProviderKeyMutation) LastUsedTimeCleared() bool {
+	_, ok := m.clearedFields[modelproviderkey.FieldLastUsedTime]
+	return ok
+}
+
+// ResetLastUsedTime resets all changes to the "last_used_time" field.
+func (m *ModelProviderKeyMutation) ResetLastUsedTime() {
+	m.last_used_time = nil
+	delete(m.clearedFields, modelproviderkey.FieldLastUsedTime)
+}
+
+// SetLastRateLimitedTime sets the "last_rate_limited_time" field.
+func (m *ModelProviderKeyMutation) SetLastRateLimitedTime(t time.Time) {
+	m.last_rate_limited_time = &t
+}
+
+// LastRateLimitedTime returns the value of the "last_rate_limited_time" field in the mutation.
+func (m *ModelProviderKeyMutation) LastRateLimitedTime() (r time.Time, exists bool) {
+	v := m.last_rate_limited_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRateLimitedTime returns the old "last_rate_limited_time" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldLastRateLimitedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRateLimitedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRateLimitedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRateLimitedTime: %w", err)
+	}
+	return oldValue.LastRateLimitedTime, nil
+}
+
+// ClearLastRateLimitedTime clears the value of the "last_rate_limited_time" field.
+func (m *ModelProviderKeyMutation) ClearLastRateLimitedTime() {
+	m.last_rate_limited_time = nil
+	m.clearedFields[modelproviderkey.FieldLastRateLimitedTime] = struct{}{}
+}
+
+// LastRateLimitedTimeCleared returns if the "last_rate_limited_time" field was cleared in this mutation.
+func (m *ModelProviderKeyMutation) LastRateLimitedTimeCleared() bool {
+	_, ok := m.clearedFields[modelproviderkey.FieldLastRateLimitedTime]
+	return ok
+}
+
+// ResetLastRateLimitedTime resets all changes to the "last_rate_limited_time" field.
+func (m *ModelProviderKeyMutation) ResetLastRateLimitedTime() {
+	m.last_rate_limited_time = nil
+	delete(m.clearedFields, modelproviderkey.FieldLastRateLimitedTime)
+}
+
+// SetRequestCount sets the "request_count" field.
+func (m *ModelProviderKeyMutation) SetRequestCount(i int64) {
+	m.request_count = &i
+	m.addrequest_count = nil
+}
+
+// RequestCount returns the value of the "request_count" field in the mutation.
+func (m *ModelProviderKeyMutation) RequestCount() (r int64, exists bool) {
+	v := m.request_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestCount returns the old "request_count" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldRequestCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestCount: %w", err)
+	}
+	return oldValue.RequestCount, nil
+}
+
+// AddRequestCount adds i to the "request_count" field.
+func (m *ModelProviderKeyMutation) AddRequestCount(i int64) {
+	if m.addrequest_count != nil {
+		*m.addrequest_count += i
+	} else {
+		m.addrequest_count = &i
+	}
+}
+
+// AddedRequestCount returns the value that was added to the "request_count" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedRequestCount() (r int64, exists bool) {
+	v := m.addrequest_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRequestCount resets all changes to the "request_count" field.
+func (m *ModelProviderKeyMutation) ResetRequestCount() {
+	m.request_count = nil
+	m.addrequest_count = nil
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (m *ModelProviderKeyMutation) SetInputTokens(i int64) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
+}
+
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *ModelProviderKeyMutation) InputTokens() (r int64, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputTokens returns the old "input_tokens" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *ModelProviderKeyMutation) AddInputTokens(i int64) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
+}
+
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedInputTokens() (r int64, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *ModelProviderKeyMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (m *ModelProviderKeyMutation) SetOutputTokens(i int64) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *ModelProviderKeyMutation) OutputTokens() (r int64, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *ModelProviderKeyMutation) AddOutputTokens(i int64) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
+}
+
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedOutputTokens() (r int64, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *ModelProviderKeyMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (m *ModelProviderKeyMutation) SetCacheWriteTokens(i int64) {
+	m.cache_write_tokens = &i
+	m.addcache_write_tokens = nil
+}
+
+// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
+func (m *ModelProviderKeyMutation) CacheWriteTokens() (r int64, exists bool) {
+	v := m.cache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
+	}
+	return oldValue.CacheWriteTokens, nil
+}
+
+// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
+func (m *ModelProviderKeyMutation) AddCacheWriteTokens(i int64) {
+	if m.addcache_write_tokens != nil {
+		*m.addcache_write_tokens += i
+	} else {
+		m.addcache_write_tokens = &i
+	}
+}
+
+// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedCacheWriteTokens() (r int64, exists bool) {
+	v := m.addcache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
+func (m *ModelProviderKeyMutation) ResetCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (m *ModelProviderKeyMutation) SetCacheReadTokens(i int64) {
+	m.cache_read_tokens = &i
+	m.addcache_read_tokens = nil
+}
+
+// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
+func (m *ModelProviderKeyMutation) CacheReadTokens() (r int64, exists bool) {
+	v := m.cache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+	}
+	return oldValue.CacheReadTokens, nil
+}
+
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (m *ModelProviderKeyMutation) AddCacheReadTokens(i int64) {
+	if m.addcache_read_tokens != nil {
+		*m.addcache_read_tokens += i
+	} else {
+		m.addcache_read_tokens = &i
+	}
+}
+
+// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedCacheReadTokens() (r int64, exists bool) {
+	v := m.addcache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
+func (m *ModelProviderKeyMutation) ResetCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+}
+
+// SetCost sets the "cost" field.
+func (m *ModelProviderKeyMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *ModelProviderKeyMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *ModelProviderKeyMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *ModelProviderKeyMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *ModelProviderKeyMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+}
+
+// SetModelProviderID sets the "model_provider_id" field.
+func (m *ModelProviderKeyMutation) SetModelProviderID(u uuid.UUID) {
+	m.model_provider = &u
+}
+
+// ModelProviderID returns the value of the "model_provider_id" field in the mutation.
+func (m *ModelProviderKeyMutation) ModelProviderID() (r uuid.UUID, exists bool) {
+	v := m.model_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelProviderID returns the old "model_provider_id" field's value of the ModelProviderKey entity.
+// If the ModelProviderKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModelProviderKeyMutation) OldModelProviderID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelProviderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelProviderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelProviderID: %w", err)
+	}
+	return oldValue.ModelProviderID, nil
+}
+
+// ResetModelProviderID resets all changes to the "model_provider_id" field.
+func (m *ModelProviderKeyMutation) ResetModelProviderID() {
+	m.model_provider = nil
+}
+
+// ClearModelProvider clears the "model_provider" edge to the ModelProvider entity.
+func (m *ModelProviderKeyMutation) ClearModelProvider() {
+	m.clearedmodel_provider = true
+	m.clearedFields[modelproviderkey.FieldModelProviderID] = struct{}{}
+}
+
+// ModelProviderCleared reports if the "model_provider" edge to the ModelProvider entity was cleared.
+func (m *ModelProviderKeyMutation) ModelProviderCleared() bool {
+	return m.clearedmodel_provider
+}
+
+// ModelProviderIDs returns the "model_provider" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ModelProviderID instead. It exists only for internal usage by the builders.
+func (m *ModelProviderKeyMutation) ModelProviderIDs() (ids []uuid.UUID) {
+	if id := m.model_provider; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetModelProvider resets all changes to the "model_provider" edge.
+func (m *ModelProviderKeyMutation) ResetModelProvider() {
+	m.model_provider = nil
+	m.clearedmodel_provider = false
+}
+
+// Where appends a list predicates to the ModelProviderKeyMutation builder.
+func (m *ModelProviderKeyMutation) Where(ps ...predicate.ModelProviderKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModelProviderKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModelProviderKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModelProviderKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModelProviderKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModelProviderKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModelProviderKey).
+func (m *ModelProviderKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModelProviderKeyMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, modelproviderkey.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, modelproviderkey.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, modelproviderkey.FieldName)
+	}
+	if m.secret != nil {
+		fields = append(fields, modelproviderkey.FieldSecret)
+	}
+	if m.priority != nil {
+		fields = append(fields, modelproviderkey.FieldPriority)
+	}
+	if m.retired_time != nil {
+		fields = append(fields, modelproviderkey.FieldRetiredTime)
+	}
+	if m.last_used_time != nil {
+		fields = append(fields, modelproviderkey.FieldLastUsedTime)
+	}
+	if m.last_rate_limited_time != nil {
+		fields = append(fields, modelproviderkey.FieldLastRateLimitedTime)
+	}
+	if m.request_count != nil {
+		fields = append(fields, modelproviderkey.FieldRequestCount)
+	}
+	if m.input_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldInputTokens)
+	}
+	if m.output_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldOutputTokens)
+	}
+	if m.cache_write_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldCacheWriteTokens)
+	}
+	if m.cache_read_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldCacheReadTokens)
+	}
+	if m.cost != nil {
+		fields = append(fields, modelproviderkey.FieldCost)
+	}
+	if m.model_provider != nil {
+		fields = append(fields, modelproviderkey.FieldModelProviderID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModelProviderKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case modelproviderkey.FieldCreateTime:
+		return m.CreateTime()
+	case modelproviderkey.FieldUpdateTime:
+		return m.UpdateTime()
+	case modelproviderkey.FieldName:
+		return m.Name()
+	case modelproviderkey.FieldSecret:
+		return m.Secret()
+	case modelproviderkey.FieldPriority:
+		return m.Priority()
+	case modelproviderkey.FieldRetiredTime:
+		return m.RetiredTime()
+	case modelproviderkey.FieldLastUsedTime:
+		return m.LastUsedTime()
+	case modelproviderkey.FieldLastRateLimitedTime:
+		return m.LastRateLimitedTime()
+	case modelproviderkey.FieldRequestCount:
+		return m.RequestCount()
+	case modelproviderkey.FieldInputTokens:
+		return m.InputTokens()
+	case modelproviderkey.FieldOutputTokens:
+		return m.OutputTokens()
+	case modelproviderkey.FieldCacheWriteTokens:
+		return m.CacheWriteTokens()
+	case modelproviderkey.FieldCacheReadTokens:
+		return m.CacheReadTokens()
+	case modelproviderkey.FieldCost:
+		return m.Cost()
+	case modelproviderkey.FieldModelProviderID:
+		return m.ModelProviderID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModelProviderKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case modelproviderkey.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case modelproviderkey.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case modelproviderkey.FieldName:
+		return m.OldName(ctx)
+	case modelproviderkey.FieldSecret:
+		return m.OldSecret(ctx)
+	case modelproviderkey.FieldPriority:
+		return m.OldPriority(ctx)
+	case modelproviderkey.FieldRetiredTime:
+		return m.OldRetiredTime(ctx)
+	case modelproviderkey.FieldLastUsedTime:
+		return m.OldLastUsedTime(ctx)
+	case modelproviderkey.FieldLastRateLimitedTime:
+		return m.OldLastRateLimitedTime(ctx)
+	case modelproviderkey.FieldRequestCount:
+		return m.OldRequestCount(ctx)
+	case modelproviderkey.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case modelproviderkey.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case modelproviderkey.FieldCacheWriteTokens:
+		return m.OldCacheWriteTokens(ctx)
+	case modelproviderkey.FieldCacheReadTokens:
+		return m.OldCacheReadTokens(ctx)
+	case modelproviderkey.FieldCost:
+		return m.OldCost(ctx)
+	case modelproviderkey.FieldModelProviderID:
+		return m.OldModelProviderID(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModelProviderKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case modelproviderkey.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case modelproviderkey.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case modelproviderkey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case modelproviderkey.FieldSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case modelproviderkey.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case modelproviderkey.FieldRetiredTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetiredTime(v)
+		return nil
+	case modelproviderkey.FieldLastUsedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedTime(v)
+		return nil
+	case modelproviderkey.FieldLastRateLimitedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRateLimitedTime(v)
+		return nil
+	case modelproviderkey.FieldRequestCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestCount(v)
+		return nil
+	case modelproviderkey.FieldInputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputTokens(v)
+		return nil
+	case modelproviderkey.FieldOutputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputTokens(v)
+		return nil
+	case modelproviderkey.FieldCacheWriteTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheWriteTokens(v)
+		return nil
+	case modelproviderkey.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCacheReadTokens(v)
+		return nil
+	case modelproviderkey.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCost(v)
+		return nil
+	case modelproviderkey.FieldModelProviderID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelProviderID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProviderKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModelProviderKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addpriority != nil {
+		fields = append(fields, modelproviderkey.FieldPriority)
+	}
+	if m.addrequest_count != nil {
+		fields = append(fields, modelproviderkey.FieldRequestCount)
+	}
+	if m.addinput_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldInputTokens)
+	}
+	if m.addoutput_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldOutputTokens)
+	}
+	if m.addcache_write_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldCacheWriteTokens)
+	}
+	if m.addcache_read_tokens != nil {
+		fields = append(fields, modelproviderkey.FieldCacheReadTokens)
+	}
+	if m.addcost != nil {
+		fields = append(fields, modelproviderkey.FieldCost)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModelProviderKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case modelproviderkey.FieldPriority:
+		return m.AddedPriority()
+	case modelproviderkey.FieldRequestCount:
+		return m.AddedRequestCount()
+	case modelproviderkey.FieldInputTokens:
+		return m.AddedInputTokens()
+	case modelproviderkey.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case modelproviderkey.FieldCacheWriteTokens:
+		return m.AddedCacheWriteTokens()
+	case modelproviderkey.FieldCacheReadTokens:
+		return m.AddedCacheReadTokens()
+	case modelproviderkey.FieldCost:
+		return m.AddedCost()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModelProviderKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case modelproviderkey.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case modelproviderkey.FieldRequestCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRequestCount(v)
+		return nil
+	case modelproviderkey.FieldInputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputTokens(v)
+		return nil
+	case modelproviderkey.FieldOutputTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputTokens(v)
+		return nil
+	case modelproviderkey.FieldCacheWriteTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheWriteTokens(v)
+		return nil
+	case modelproviderkey.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadTokens(v)
+		return nil
+	case modelproviderkey.FieldCost:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCost(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProviderKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModelProviderKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(modelproviderkey.FieldRetiredTime) {
+		fields = append(fields, modelproviderkey.FieldRetiredTime)
+	}
+	if m.FieldCleared(modelproviderkey.FieldLastUsedTime) {
+		fields = append(fields, modelproviderkey.FieldLastUsedTime)
+	}
+	if m.FieldCleared(modelproviderkey.FieldLastRateLimitedTime) {
+		fields = append(fields, modelproviderkey.FieldLastRateLimitedTime)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModelProviderKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModelProviderKeyMutation) ClearField(name string) error {
+	switch name {
+	case modelproviderkey.FieldRetiredTime:
+		m.ClearRetiredTime()
+		return nil
+	case modelproviderkey.FieldLastUsedTime:
+		m.ClearLastUsedTime()
+		return nil
+	case modelproviderkey.FieldLastRateLimitedTime:
+		m.ClearLastRateLimitedTime()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProviderKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModelProviderKeyMutation) ResetField(name string) error {
+	switch name {
+	case modelproviderkey.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case modelproviderkey.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case modelproviderkey.FieldName:
+		m.ResetName()
+		return nil
+	case modelproviderkey.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case modelproviderkey.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case modelproviderkey.FieldRetiredTime:
+		m.ResetRetiredTime()
+		return nil
+	case modelproviderkey.FieldLastUsedTime:
+		m.ResetLastUsedTime()
+		return nil
+	case modelproviderkey.FieldLastRateLimitedTime:
+		m.ResetLastRateLimitedTime()
+		return nil
+	case modelproviderkey.FieldRequestCount:
+		m.ResetRequestCount()
+		return nil
+	case modelproviderkey.FieldInputTokens:
+		m.ResetInputTokens()
+		return nil
+	case modelproviderkey.FieldOutputTokens:
+		m.ResetOutputTokens()
+		return nil
+	case modelproviderkey.FieldCacheWriteTokens:
+		m.ResetCacheWriteTokens()
+		return nil
+	case modelproviderkey.FieldCacheReadTokens:
+		m.ResetCacheReadTokens()
+		return nil
+	case modelproviderkey.FieldCost:
+		m.ResetCost()
+		return nil
+	case modelproviderkey.FieldModelProviderID:
+		m.ResetModelProviderID()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProviderKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModelProviderKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.model_provider != nil {
+		edges = append(edges, modelproviderkey.EdgeModelProvider)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModelProviderKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case modelproviderkey.EdgeModelProvider:
+		if id := m.model_provider; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModelProviderKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModelProviderKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModelProviderKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmodel_provider {
+		edges = append(edges, modelproviderkey.EdgeModelProvider)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModelProviderKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case modelproviderkey.EdgeModelProvider:
+		return m.clearedmodel_provider
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModelProviderKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case modelproviderkey.EdgeModelProvider:
+		m.ClearModelProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProviderKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModelProviderKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case modelproviderkey.EdgeModelProvider:
+		m.ResetModelProvider()
+		return nil
+	}
+	return fmt.Errorf("unknown ModelProviderKey edge %s", name)
+}
+
+// TaskMutation represents an operation that mutates the Task nodes in the graph.
+type TaskMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	project_directory        *string
+	input_tokens             *int64
+	addinput_tokens          *int64
+	output_tokens            *int64
+	addoutput_tokens         *int64
+	cache_write_tokens       *int64
+	addcache_write_tokens    *int64
+	cache_read_tokens        *int64
+	addcache_read_tokens     *int64
+	cost                     *float64
+	addcost                  *float64
+	routed_turns             *int64
+	addrouted_turns          *int64
+	routing_savings          *float64
+	addrouting_savings       *float64
+	turns                    *int64
+	addturns                 *int64
+	tool_uses                *map[string]int64
+	desired_phase            *types.TaskPhase
+	phase                    *types.TaskPhase
+	description              *string
+	trace_model_calls        *bool
+	owner                    *string
+	shared_with              *[]string
+	appendshared_with        []string
+	clearedFields            map[string]struct{}
+	messages                 map[uuid.UUID]struct{}
+	removedmessages          map[uuid.UUID]struct{}
+	clearedmessages          bool
+	model_call_traces        map[uuid.UUID]struct{}
+	removedmodel_call_traces map[uuid.UUID]struct{}
+	clearedmodel_call_traces bool
+	agent                    *uuid.UUID
+	clearedagent             bool
+	done                     bool
+	oldValue                 func(context.Context) (*Task, error)
+	predicates               []predicate.Task
+}
+
+var _ ent.Mutation = (*TaskMutation)(nil)
+
+// taskOption allows management of the mutation configuration using functional options.
+type taskOption func(*TaskMutation)
+
+// newTaskMutation creates new mutation for the Task entity.
+func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
+	m := &TaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTaskID sets the ID field of the mutation.
+func withTaskID(id uuid.UUID) taskOption {
+	return func(m *TaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Task
+		)
+		m.oldValue = func(ctx context.Context) (*Task, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Task.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTask sets the old Task of the mutation.
+func withTask(node *Task) taskOption {
+	return func(m *TaskMutation) {
+		m.oldValue = func(context.Context) (*Task, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Task entities.
+func (m *TaskMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TaskMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TaskMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TaskMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TaskMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetProjectDirectory sets the "project_directory" field.
+func (m *TaskMutation) SetProjectDirectory(s string) {
+	m.project_directory = &s
+}
+
+// ProjectDirectory returns the value of the "project_directory" field in the mutation.
+func (m *TaskMutation) ProjectDirectory() (r string, exists bool) {
+	v := m.project_directory
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProjectDirectory returns the old "project_directory" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
+	}
+	return oldValue.ProjectDirectory, nil
+}
+
+// ClearProjectDirectory clears the value of the "project_directory" field.
+func (m *TaskMutation) ClearProjectDirectory() {
+	m.project_directory = nil
+	m.clearedFields[task.FieldProjectDirectory] = struct{}{}
+}
+
+// ProjectDirectoryCleared returns if the "project_directory" field was cleared in this mutation.
+func (m *TaskMutation) ProjectDirectoryCleared() bool {
+	_, ok := m.clearedFields[task.FieldProjectDirectory]
+	return ok
+}
+
+// ResetProjectDirectory resets all changes to the "project_directory" field.
+func (m *TaskMutation) ResetProjectDirectory() {
+	m.project_directory = nil
+	delete(m.clearedFields, task.FieldProjectDirectory)
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (m *TaskMutation) SetInputTokens(i int64) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
+}
+
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *TaskMutation) InputTokens() (r int64, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputTokens returns the old "input_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *TaskMutation) AddInputTokens(i int64) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
+}
+
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *TaskMutation) AddedInputTokens() (r int64, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearInputTokens clears the value of the "input_tokens" field.
+func (m *TaskMutation) ClearInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+	m.clearedFields[task.FieldInputTokens] = struct{}{}
+}
+
+// InputTokensCleared returns if the "input_tokens" field was cleared in this mutation.
+func (m *TaskMutation) InputTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldInputTokens]
+	return ok
+}
+
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *TaskMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+	delete(m.clearedFields, task.FieldInputTokens)
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (m *TaskMutation) SetOutputTokens(i int64) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *TaskMutation) OutputTokens() (r int64, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *TaskMutation) AddOutputTokens(i int64) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
+}
+
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *TaskMutation) AddedOutputTokens() (r int64, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOutputTokens clears the value of the "output_tokens" field.
+func (m *TaskMutation) ClearOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+	m.clearedFields[task.FieldOutputTokens] = struct{}{}
+}
+
+// OutputTokensCleared returns if the "output_tokens" field was cleared in this mutation.
+func (m *TaskMutation) OutputTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldOutputTokens]
+	return ok
+}
+
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *TaskMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+	delete(m.clearedFields, task.FieldOutputTokens)
+}
+
+// SetCacheWriteTokens sets the "cache_write_tokens" field.
+func (m *TaskMutation) SetCacheWriteTokens(i int64) {
+	m.cache_write_tokens = &i
+	m.addcache_write_tokens = nil
+}
+
+// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
+func (m *TaskMutation) CacheWriteTokens() (r int64, exists bool) {
+	v := m.cache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
+	}
+	return oldValue.CacheWriteTokens, nil
+}
+
+// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
+func (m *TaskMutation) AddCacheWriteTokens(i int64) {
+	if m.addcache_write_tokens != nil {
+		*m.addcache_write_tokens += i
+	} else {
+		m.addcache_write_tokens = &i
+	}
+}
+
+// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
+func (m *TaskMutation) AddedCacheWriteTokens() (r int64, exists bool) {
+	v := m.addcache_write_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheWriteTokens clears the value of the "cache_write_tokens" field.
+func (m *TaskMutation) ClearCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+	m.clearedFields[task.FieldCacheWriteTokens] = struct{}{}
+}
+
+// CacheWriteTokensCleared returns if the "cache_write_tokens" field was cleared in this mutation.
+func (m *TaskMutation) CacheWriteTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldCacheWriteTokens]
+	return ok
+}
+
+// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
+func (m *TaskMutation) ResetCacheWriteTokens() {
+	m.cache_write_tokens = nil
+	m.addcache_write_tokens = nil
+	delete(m.clearedFields, task.FieldCacheWriteTokens)
+}
+
+// SetCacheReadTokens sets the "cache_read_tokens" field.
+func (m *TaskMutation) SetCacheReadTokens(i int64) {
+	m.cache_read_tokens = &i
+	m.addcache_read_tokens = nil
+}
+
+// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
+func (m *TaskMutation) CacheReadTokens() (r int64, exists bool) {
+	v := m.cache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+	}
+	return oldValue.CacheReadTokens, nil
+}
+
+// AddCacheReadTokens adds i to the "cache_read_tokens" field.
+func (m *TaskMutation) AddCacheReadTokens(i int64) {
+	if m.addcache_read_tokens != nil {
+		*m.addcache_read_tokens += i
+	} else {
+		m.addcache_read_tokens = &i
+	}
+}
+
+// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
+func (m *TaskMutation) AddedCacheReadTokens() (r int64, exists bool) {
+	v := m.addcache_read_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCacheReadTokens clears the value of the "cache_read_tokens" field.
+func (m *TaskMutation) ClearCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+	m.clearedFields[task.FieldCacheReadTokens] = struct{}{}
+}
+
+// CacheReadTokensCleared returns if the "cache_read_tokens" field was cleared in this mutation.
+func (m *TaskMutation) CacheReadTokensCleared() bool {
+	_, ok := m.clearedFields[task.FieldCacheReadTokens]
+	return ok
+}
+
+// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
+func (m *TaskMutation) ResetCacheReadTokens() {
+	m.cache_read_tokens = nil
+	m.addcache_read_tokens = nil
+	delete(m.clearedFields, task.FieldCacheReadTokens)
+}
+
+// SetCost sets the "cost" field.
+func (m *TaskMutation) SetCost(f float64) {
+	m.cost = &f
+	m.addcost = nil
+}
+
+// Cost returns the value of the "cost" field in the mutation.
+func (m *TaskMutation) Cost() (r float64, exists bool) {
+	v := m.cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCost returns the old "cost" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldCost(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+	}
+	return oldValue.Cost, nil
+}
+
+// AddCost adds f to the "cost" field.
+func (m *TaskMutation) AddCost(f float64) {
+	if m.addcost != nil {
+		*m.addcost += f
+	} else {
+		m.addcost = &f
+	}
+}
+
+// AddedCost returns the value that was added to the "cost" field in this mutation.
+func (m *TaskMutation) AddedCost() (r float64, exists bool) {
+	v := m.addcost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCost clears the value of the "cost" field.
+func (m *TaskMutation) ClearCost() {
+	m.cost = nil
+	m.addcost = nil
+	m.clearedFields[task.FieldCost] = struct{}{}
+}
+
+// CostCleared returns if the "cost" field was cleared in this mutation.
+func (m *TaskMutation) CostCleared() bool {
+	_, ok := m.clearedFields[task.FieldCost]
+	return ok
+}
+
+// ResetCost resets all changes to the "cost" field.
+func (m *TaskMutation) ResetCost() {
+	m.cost = nil
+	m.addcost = nil
+	delete(m.clearedFields, task.FieldCost)
+}
+
+// SetRoutedTurns sets the "routed_turns" field.
+func (m *TaskMutation) SetRoutedTurns(i int64) {
+	m.routed_turns = &i
+	m.addrouted_turns = nil
+}
+
+// RoutedTurns returns the value of the "routed_turns" field in the mutation.
+func (m *TaskMutation) RoutedTurns() (r int64, exists bool) {
+	v := m.routed_turns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoutedTurns returns the old "routed_turns" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRoutedTurns(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutedTurns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutedTurns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutedTurns: %w", err)
+	}
+	return oldValue.RoutedTurns, nil
+}
+
+// AddRoutedTurns adds i to the "routed_turns" field.
+func (m *TaskMutation) AddRoutedTurns(i int64) {
+	if m.addrouted_turns != nil {
+		*m.addrouted_turns += i
+	} else {
+		m.addrouted_turns = &i
+	}
+}
+
+// AddedRoutedTurns returns the value that was added to the "routed_turns" field in this mutation.
+func (m *TaskMutation) AddedRoutedTurns() (r int64, exists bool) {
+	v := m.addrouted_turns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRoutedTurns clears the value of the "routed_turns" field.
+func (m *TaskMutation) ClearRoutedTurns() {
+	m.routed_turns = nil
+	m.addrouted_turns = nil
+	m.clearedFields[task.FieldRoutedTurns] = struct{}{}
+}
+
+// RoutedTurnsCleared returns if the "routed_turns" field was cleared in this mutation.
+func (m *TaskMutation) RoutedTurnsCleared() bool {
+	_, ok := m.clearedFields[task.FieldRoutedTurns]
+	return ok
+}
+
+// ResetRoutedTurns resets all changes to the "routed_turns" field.
+func (m *TaskMutation) ResetRoutedTurns() {
+	m.routed_turns = nil
+	m.addrouted_turns = nil
+	delete(m.clearedFields, task.FieldRoutedTurns)
+}
+
+// SetRoutingSavings sets the "routing_savings" field.
+func (m *TaskMutation) SetRoutingSavings(f float64) {
+	m.routing_savings = &f
+	m.addrouting_savings = nil
+}
+
+// RoutingSavings returns the value of the "routing_savings" field in the mutation.
+func (m *TaskMutation) RoutingSavings() (r float64, exists bool) {
+	v := m.routing_savings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoutingSavings returns the old "routing_savings" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldRoutingSavings(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutingSavings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutingSavings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutingSavings: %w", err)
+	}
+	return oldValue.RoutingSavings, nil
+}
+
+// AddRoutingSavings adds f to the "routing_savings" field.
+func (m *TaskMutation) AddRoutingSavings(f float64) {
+	if m.addrouting_savings != nil {
+		*m.addrouting_savings += f
+	} else {
+		m.addrouting_savings = &f
+	}
+}
+
+// AddedRoutingSavings returns the value that was added to the "routing_savings" field in this mutation.
+func (m *TaskMutation) AddedRoutingSavings() (r float64, exists bool) {
+	v := m.addrouting_savings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRoutingSavings clears the value of the "routing_savings" field.
+func (m *TaskMutation) ClearRoutingSavings() {
+	m.routing_savings = nil
+	m.addrouting_savings = nil
+	m.clearedFields[task.FieldRoutingSavings] = struct{}{}
+}
+
+// RoutingSavingsCleared returns if the "routing_savings" field was cleared in this mutation.
+func (m *TaskMutation) RoutingSavingsCleared() bool {
+	_, ok := m.clearedFields[task.FieldRoutingSavings]
+	return ok
+}
+
+// ResetRoutingSavings resets all changes to the "routing_savings" field.
+func (m *TaskMutation) ResetRoutingSavings() {
+	m.routing_savings = nil
+	m.addrouting_savings = nil
+	delete(m.clearedFields, task.FieldRoutingSavings)
+}
+
+// SetTurns sets the "turns" field.
+func (m *TaskMutation) SetTurns(i int64) {
+	m.turns = &i
+	m.addturns = nil
+}
+
+// Turns returns the value of the "turns" field in the mutation.
+func (m *TaskMutation) Turns() (r int64, exists bool) {
+	v := m.turns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTurns returns the old "turns" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTurns(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTurns is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTurns requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTurns: %w", err)
+	}
+	return oldValue.Turns, nil
+}
+
+// AddTurns adds i to the "turns" field.
+func (m *TaskMutation) AddTurns(i int64) {
+	if m.addturns != nil {
+		*m.addturns += i
+	} else {
+		m.addturns = &i
+	}
+}
+
+// AddedTurns returns the value that was added to the "turns" field in this mutation.
+func (m *TaskMutation) AddedTurns() (r int64, exists bool) {
+	v := m.addturns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTurns resets all changes to the "turns" field.
+func (m *TaskMutation) ResetTurns() {
+	m.turns = nil
+	m.addturns = nil
+}
+
+// SetToolUses sets the "tool_uses" field.
+func (m *TaskMutation) SetToolUses(value map[string]int64) {
+	m.tool_uses = &value
+}
+
+// ToolUses returns the value of the "tool_uses" field in the mutation.
+func (m *TaskMutation) ToolUses() (r map[string]int64, exists bool) {
+	v := m.tool_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToolUses returns the old "tool_uses" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldToolUses(ctx context.Context) (v map[string]int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToolUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToolUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToolUses: %w", err)
+	}
+	return oldValue.ToolUses, nil
+}
+
+// ResetToolUses resets all changes to the "tool_uses" field.
+func (m *TaskMutation) ResetToolUses() {
+	m.tool_uses = nil
+}
+
+// SetDesiredPhase sets the "desired_phase" field.
+func (m *TaskMutation) SetDesiredPhase(tp types.TaskPhase) {
+	m.desired_phase = &tp
+}
+
+// DesiredPhase returns the value of the "desired_phase" field in the mutation.
+func (m *TaskMutation) DesiredPhase() (r types.TaskPhase, exists bool) {
+	v := m.desired_phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDesiredPhase returns the old "desired_phase" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDesiredPhase(ctx context.Context) (v types.TaskPhase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDesiredPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDesiredPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDesiredPhase: %w", err)
+	}
+	return oldValue.DesiredPhase, nil
+}
+
+// ResetDesiredPhase resets all changes to the "desired_phase" field.
+func (m *TaskMutation) ResetDesiredPhase() {
+	m.desired_phase = nil
+}
+
+// SetPhase sets the "phase" field.
+func (m *TaskMutation) SetPhase(tp types.TaskPhase) {
+	m.phase = &tp
+}
+
+// Phase returns the value of the "phase" field in the mutation.
+func (m *TaskMutation) Phase() (r types.TaskPhase, exists bool) {
+	v := m.phase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhase returns the old "phase" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldPhase(ctx context.Context) (v types.TaskPhase, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+	}
+	return oldValue.Phase, nil
+}
+
+// ResetPhase resets all changes to the "phase" field.
+func (m *TaskMutation) ResetPhase() {
+	m.phase = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *TaskMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TaskMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TaskMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[task.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TaskMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[task.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *TaskMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, task.FieldDescription)
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *TaskMutation) SetAgentID(u uuid.UUID) {
+	m.agent = &u
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *TaskMutation) ClearAgentID() {
+	m.agent = nil
+	m.clearedFields[task.FieldAgentID] = struct{}{}
+}
+
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *TaskMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[task.FieldAgentID]
+	return ok
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *TaskMutation) ResetAgentID() {
+	m.agent = nil
+	delete(m.clearedFields, task.FieldAgentID)
+}
+
+// SetTraceModelCalls sets the "trace_model_calls" field.
+func (m *TaskMutation) SetTraceModelCalls(b bool) {
+	m.trace_model_calls = &b
+}
+
+// TraceModelCalls returns the value of the "trace_model_calls" field in the mutation.
+func (m *TaskMutation) TraceModelCalls() (r bool, exists bool) {
+	v := m.trace_model_calls
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTraceModelCalls returns the old "trace_model_calls" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldTraceModelCalls(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTraceModelCalls is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTraceModelCalls requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTraceModelCalls: %w", err)
+	}
+	return oldValue.TraceModelCalls, nil
+}
+
+// ResetTraceModelCalls resets all changes to the "trace_model_calls" field.
+func (m *TaskMutation) ResetTraceModelCalls() {
+	m.trace_model_calls = nil
+}
+
+// SetOwner sets the "owner" field.
+func (m *TaskMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *TaskMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *TaskMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[task.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *TaskMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[task.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *TaskMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, task.FieldOwner)
+}
+
+// SetSharedWith sets the "shared_with" field.
+func (m *TaskMutation) SetSharedWith(s []string) {
+	m.shared_with = &s
+	m.appendshared_with = nil
+}
+
+// SharedWith returns the value of the "shared_with" field in the mutation.
+func (m *TaskMutation) SharedWith() (r []string, exists bool) {
+	v := m.shared_with
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharedWith returns the old "shared_with" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldSharedWith(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharedWith is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharedWith requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharedWith: %w", err)
+	}
+	return oldValue.SharedWith, nil
+}
+
+// AppendSharedWith adds s to the "shared_with" field.
+func (m *TaskMutation) AppendSharedWith(s []string) {
+	m.appendshared_with = append(m.appendshared_with, s...)
+}
+
+// AppendedSharedWith returns the list of values that were appended to the "shared_with" field in this mutation.
+func (m *TaskMutation) AppendedSharedWith() ([]string, bool) {
+	if len(m.appendshared_with) == 0 {
+		return nil, false
+	}
+	return m.appendshared_with, true
+}
+
+// ClearSharedWith clears the value of the "shared_with" field.
+func (m *TaskMutation) ClearSharedWith() {
+	m.shared_with = nil
+	m.appendshared_with = nil
+	m.clearedFields[task.FieldSharedWith] = struct{}{}
+}
+
+// SharedWithCleared returns if the "shared_with" field was cleared in this mutation.
+func (m *TaskMutation) SharedWithCleared() bool {
+	_, ok := m.clearedFields[task.FieldSharedWith]
+	return ok
+}
+
+// ResetSharedWith resets all changes to the "shared_with" field.
+func (m *TaskMutation) ResetSharedWith() {
+	m.shared_with = nil
+	m.appendshared_with = nil
+	delete(m.clearedFields, task.FieldSharedWith)
+}
+
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *TaskMutation) AddMessageIDs(ids ...uuid.UUID) {
+	if m.messages == nil {
+		m.messages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *TaskMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *TaskMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *TaskMutation) RemoveMessageIDs(ids ...uuid.UUID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *TaskMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *TaskMutation) MessagesIDs() (ids []uuid.UUID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *TaskMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddModelCallTraceIDs adds the "model_call_traces" edge to the ModelCallTrace entity by ids.
+func (m *TaskMutation) AddModelCallTraceIDs(ids ...uuid.UUID) {
+	if m.model_call_traces == nil {
+		m.model_call_traces = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.model_call_traces[ids[i]] = struct{}{}
+	}
+}
+
+// ClearModelCallTraces clears the "model_call_traces" edge to the ModelCallTrace entity.
+func (m *TaskMutation) ClearModelCallTraces() {
+	m.clearedmodel_call_traces = true
+}
+
+// ModelCallTracesCleared reports if the "model_call_traces" edge to the ModelCallTrace entity was cleared.
+func (m *TaskMutation) ModelCallTracesCleared() bool {
+	return m.clearedmodel_call_traces
+}
+
+// RemoveModelCallTraceIDs removes the "model_call_traces" edge to the ModelCallTrace entity by IDs.
+func (m *TaskMutation) RemoveModelCallTraceIDs(ids ...uuid.UUID) {
+	if m.removedmodel_call_traces == nil {
+		m.removedmodel_call_traces = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.model_call_traces, ids[i])
+		m.removedmodel_call_traces[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedModelCallTraces returns the removed IDs of the "model_call_traces" edge to the ModelCallTrace entity.
+func (m *TaskMutation) RemovedModelCallTracesIDs() (ids []uuid.UUID) {
+	for id := range m.removedmodel_call_traces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ModelCallTracesIDs returns the "model_call_traces" edge IDs in the mutation.
+func (m *TaskMutation) ModelCallTracesIDs() (ids []uuid.UUID) {
+	for id := range m.model_call_traces {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetModelCallTraces resets all changes to the "model_call_traces" edge.
+func (m *TaskMutation) ResetModelCallTraces() {
+	m.model_call_traces = nil
+	m.clearedmodel_call_traces = false
+	m.removedmodel_call_traces = nil
+}
+
+// ClearAgent clears the "agent" edge to the Agent entity.
+func (m *TaskMutation) ClearAgent() {
+	m.clearedagent = true
+	m.clearedFields[task.FieldAgentID] = struct{}{}
+}
+
+// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
+func (m *TaskMutation) AgentCleared() bool {
+	return m.AgentIDCleared() || m.clearedagent
+}
+
+// AgentIDs returns the "agent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AgentID instead. It exists only for internal usage by the builders.
+func (m *TaskMutation) AgentIDs() (ids []uuid.UUID) {
+	if id := m.agent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAgent resets all changes to the "agent" edge.
+func (m *TaskMutation) ResetAgent() {
+	m.agent = nil
+	m.clearedagent = false
+}
+
+// Where appends a list predicates to the TaskMutation builder.
+func (m *TaskMutation) Where(ps ...predicate.Task) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Task, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Task).
+func (m *TaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ModelMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *TaskMutation) Fields() []string {
+	fields := make([]string, 0, 19)
 	if m.create_time != nil {
-		fields = append(fields, model.FieldCreateTime)
+		fields = append(fields, task.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, model.FieldUpdateTime)
+		fields = append(fields, task.FieldUpdateTime)
 	}
-	if m.name != nil {
-		fields = append(fields, model.FieldName)
+	if m.project_directory != nil {
+		fields = append(fields, task.FieldProjectDirectory)
 	}
-	if m.context_window != nil {
-		fields = append(fields, model.FieldContextWindow)
+	if m.input_tokens != nil {
+		fields = append(fields, task.FieldInputTokens)
 	}
-	if m.capabilities != nil {
-		fields = append(fields, model.FieldCapabilities)
+	if m.output_tokens != nil {
+		fields = append(fields, task.FieldOutputTokens)
 	}
-	if m.input_cost != nil {
-		fields = append(fields, model.FieldInputCost)
+	if m.cache_write_tokens != nil {
+		fields = append(fields, task.FieldCacheWriteTokens)
 	}
-	if m.output_cost != nil {
-		fields = append(fields, model.FieldOutputCost)
+	if m.cache_read_tokens != nil {
+		fields = append(fields, task.FieldCacheReadTokens)
 	}
-	if m.cache_write_cost != nil {
-		fields = append(fields, model.FieldCacheWriteCost)
+	if m.cost != nil {
+		fields = append(fields, task.FieldCost)
 	}
-	if m.cache_read_cost != nil {
-		fields = append(fields, model.FieldCacheReadCost)
+	if m.routed_turns != nil {
+		fields = append(fields, task.FieldRoutedTurns)
 	}
-	if m.enabled != nil {
-		fields = append(fields, model.FieldEnabled)
+	if m.routing_savings != nil {
+		fields = append(fields, task.FieldRoutingSavings)
 	}
-	if m.alias != nil {
-		fields = append(fields, model.FieldAlias)
+	if m.turns != nil {
+		fields = append(fields, task.FieldTurns)
 	}
-	if m.model_provider != nil {
-		fields = append(fields, model.FieldModelProviderID)
+	if m.tool_uses != nil {
+		fields = append(fields, task.FieldToolUses)
+	}
+	if m.desired_phase != nil {
+		fields = append(fields, task.FieldDesiredPhase)
+	}
+	if m.phase != nil {
+		fields = append(fields, task.FieldPhase)
+	}
+	if m.description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.agent != nil {
+		fields = append(fields, task.FieldAgentID)
+	}
+	if m.trace_model_calls != nil {
+		fields = append(fields, task.FieldTraceModelCalls)
+	}
+	if m.owner != nil {
+		fields = append(fields, task.FieldOwner)
+	}
+	if m.shared_with != nil {
+		fields = append(fields, task.FieldSharedWith)
 	}
 	return fields
 }
@@ -2874,32 +11043,46 @@ func (m *ModelMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ModelMutation) Field(name string) (ent.Value, bool) {
+func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case model.FieldCreateTime:
+	case task.FieldCreateTime:
 		return m.CreateTime()
-	case model.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		return m.UpdateTime()
-	case model.FieldName:
-		return m.Name()
-	case model.FieldContextWindow:
-		return m.ContextWindow()
-	case model.FieldCapabilities:
-		return m.Capabilities()
-	case model.FieldInputCost:
-		return m.InputCost()
-	case model.FieldOutputCost:
-		return m.OutputCost()
-	case model.FieldCacheWriteCost:
-		return m.CacheWriteCost()
-	case model.FieldCacheReadCost:
-		return m.CacheReadCost()
-	case model.FieldEnabled:
-		return m.Enabled()
-	case model.FieldAlias:
-		return m.Alias()
-	case model.FieldModelProviderID:
-		return m.ModelProviderID()
+	case task.FieldProjectDirectory:
+		return m.ProjectDirectory()
+	case task.FieldInputTokens:
+		return m.InputTokens()
+	case task.FieldOutputTokens:
+		return m.OutputTokens()
+	case task.FieldCacheWriteTokens:
+		return m.CacheWriteTokens()
+	case task.FieldCacheReadTokens:
+		return m.CacheReadTokens()
+	case task.FieldCost:
+		return m.Cost()
+	case task.FieldRoutedTurns:
+		return m.RoutedTurns()
+	case task.FieldRoutingSavings:
+		return m.RoutingSavings()
+	case task.FieldTurns:
+		return m.Turns()
+	case task.FieldToolUses:
+		return m.ToolUses()
+	case task.FieldDesiredPhase:
+		return m.DesiredPhase()
+	case task.FieldPhase:
+		return m.Phase()
+	case task.FieldDescription:
+		return m.Description()
+	case task.FieldAgentID:
+		return m.AgentID()
+	case task.FieldTraceModelCalls:
+		return m.TraceModelCalls()
+	case task.FieldOwner:
+		return m.Owner()
+	case task.FieldSharedWith:
+		return m.SharedWith()
 	}
 	return nil, false
 }
@@ -2907,147 +11090,219 @@ func (m *ModelMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case model.FieldCreateTime:
+	case task.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case model.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case model.FieldName:
-		return m.OldName(ctx)
-	case model.FieldContextWindow:
-		return m.OldContextWindow(ctx)
-	case model.FieldCapabilities:
-		return m.OldCapabilities(ctx)
-	case model.FieldInputCost:
-		return m.OldInputCost(ctx)
-	case model.FieldOutputCost:
-		return m.OldOutputCost(ctx)
-	case model.FieldCacheWriteCost:
-		return m.OldCacheWriteCost(ctx)
-	case model.FieldCacheReadCost:
-		return m.OldCacheReadCost(ctx)
-	case model.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case model.FieldAlias:
-		return m.OldAlias(ctx)
-	case model.FieldModelProviderID:
-		return m.OldModelProviderID(ctx)
+	case task.FieldProjectDirectory:
+		return m.OldProjectDirectory(ctx)
+	case task.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case task.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case task.FieldCacheWriteTokens:
+		return m.OldCacheWriteTokens(ctx)
+	case task.FieldCacheReadTokens:
+		return m.OldCacheReadTokens(ctx)
+	case task.FieldCost:
+		return m.OldCost(ctx)
+	case task.FieldRoutedTurns:
+		return m.OldRoutedTurns(ctx)
+	case task.FieldRoutingSavings:
+		return m.OldRoutingSavings(ctx)
+	case task.FieldTurns:
+		return m.OldTurns(ctx)
+	case task.FieldToolUses:
+		return m.OldToolUses(ctx)
+	case task.FieldDesiredPhase:
+		return m.OldDesiredPhase(ctx)
+	case task.FieldPhase:
+		return m.OldPhase(ctx)
+	case task.FieldDescription:
+		return m.OldDescription(ctx)
+	case task.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case task.FieldTraceModelCalls:
+		return m.OldTraceModelCalls(ctx)
+	case task.FieldOwner:
+		return m.OldOwner(ctx)
+	case task.FieldSharedWith:
+		return m.OldSharedWith(ctx)
 	}
-	return nil, fmt.Errorf("unknown Model field %s", name)
+	return nil, fmt.Errorf("unknown Task field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelMutation) SetField(name string, value ent.Value) error {
+func (m *TaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case model.FieldCreateTime:
+	case task.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case model.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case model.FieldName:
+	case task.FieldProjectDirectory:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProjectDirectory(v)
 		return nil
-	case model.FieldContextWindow:
+	case task.FieldInputTokens:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContextWindow(v)
+		m.SetInputTokens(v)
 		return nil
-	case model.FieldCapabilities:
-		v, ok := value.([]types.ModelCapability)
+	case task.FieldOutputTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCapabilities(v)
+		m.SetOutputTokens(v)
 		return nil
-	case model.FieldInputCost:
-		v, ok := value.(float64)
+	case task.FieldCacheWriteTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInputCost(v)
+		m.SetCacheWriteTokens(v)
 		return nil
-	case model.FieldOutputCost:
-		v, ok := value.(float64)
+	case task.FieldCacheReadTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOutputCost(v)
+		m.SetCacheReadTokens(v)
 		return nil
-	case model.FieldCacheWriteCost:
+	case task.FieldCost:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheWriteCost(v)
+		m.SetCost(v)
 		return nil
-	case model.FieldCacheReadCost:
+	case task.FieldRoutedTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutedTurns(v)
+		return nil
+	case task.FieldRoutingSavings:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheReadCost(v)
+		m.SetRoutingSavings(v)
 		return nil
-	case model.FieldEnabled:
-		v, ok := value.(bool)
+	case task.FieldTurns:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetTurns(v)
 		return nil
-	case model.FieldAlias:
+	case task.FieldToolUses:
+		v, ok := value.(map[string]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToolUses(v)
+		return nil
+	case task.FieldDesiredPhase:
+		v, ok := value.(types.TaskPhase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDesiredPhase(v)
+		return nil
+	case task.FieldPhase:
+		v, ok := value.(types.TaskPhase)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhase(v)
+		return nil
+	case task.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAlias(v)
+		m.SetDescription(v)
 		return nil
-	case model.FieldModelProviderID:
+	case task.FieldAgentID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModelProviderID(v)
+		m.SetAgentID(v)
+		return nil
+	case task.FieldTraceModelCalls:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTraceModelCalls(v)
+		return nil
+	case task.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case task.FieldSharedWith:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharedWith(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Model field %s", name)
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ModelMutation) AddedFields() []string {
+func (m *TaskMutation) AddedFields() []string {
 	var fields []string
-	if m.addcontext_window != nil {
-		fields = append(fields, model.FieldContextWindow)
+	if m.addinput_tokens != nil {
+		fields = append(fields, task.FieldInputTokens)
 	}
-	if m.addinput_cost != nil {
-		fields = append(fields, model.FieldInputCost)
+	if m.addoutput_tokens != nil {
+		fields = append(fields, task.FieldOutputTokens)
 	}
-	if m.addoutput_cost != nil {
-		fields = append(fields, model.FieldOutputCost)
+	if m.addcache_write_tokens != nil {
+		fields = append(fields, task.FieldCacheWriteTokens)
 	}
-	if m.addcache_write_cost != nil {
-		fields = append(fields, model.FieldCacheWriteCost)
+	if m.addcache_read_tokens != nil {
+		fields = append(fields, task.FieldCacheReadTokens)
 	}
-	if m.addcache_read_cost != nil {
-		fields = append(fields, model.FieldCacheReadCost)
+	if m.addcost != nil {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.addrouted_turns != nil {
+		fields = append(fields, task.FieldRoutedTurns)
+	}
+	if m.addrouting_savings != nil {
+		fields = append(fields, task.FieldRoutingSavings)
+	}
+	if m.addturns != nil {
+		fields = append(fields, task.FieldTurns)
 	}
 	return fields
 }
@@ -3055,18 +11310,24 @@ func (m *ModelMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case model.FieldContextWindow:
-		return m.AddedContextWindow()
-	case model.FieldInputCost:
-		return m.AddedInputCost()
-	case model.FieldOutputCost:
-		return m.AddedOutputCost()
-	case model.FieldCacheWriteCost:
-		return m.AddedCacheWriteCost()
-	case model.FieldCacheReadCost:
-		return m.AddedCacheReadCost()
+	case task.FieldInputTokens:
+		return m.AddedInputTokens()
+	case task.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case task.FieldCacheWriteTokens:
+		return m.AddedCacheWriteTokens()
+	case task.FieldCacheReadTokens:
+		return m.AddedCacheReadTokens()
+	case task.FieldCost:
+		return m.AddedCost()
+	case task.FieldRoutedTurns:
+		return m.AddedRoutedTurns()
+	case task.FieldRoutingSavings:
+		return m.AddedRoutingSavings()
+	case task.FieldTurns:
+		return m.AddedTurns()
 	}
 	return nil, false
 }
@@ -3074,189 +11335,291 @@ func (m *ModelMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ModelMutation) AddField(name string, value ent.Value) error {
+func (m *TaskMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case model.FieldContextWindow:
+	case task.FieldInputTokens:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddContextWindow(v)
+		m.AddInputTokens(v)
 		return nil
-	case model.FieldInputCost:
-		v, ok := value.(float64)
+	case task.FieldOutputTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddInputCost(v)
+		m.AddOutputTokens(v)
 		return nil
-	case model.FieldOutputCost:
-		v, ok := value.(float64)
+	case task.FieldCacheWriteTokens:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddOutputCost(v)
+		m.AddCacheWriteTokens(v)
 		return nil
-	case model.FieldCacheWriteCost:
+	case task.FieldCacheReadTokens:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCacheReadTokens(v)
+		return nil
+	case task.FieldCost:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCacheWriteCost(v)
+		m.AddCost(v)
 		return nil
-	case model.FieldCacheReadCost:
+	case task.FieldRoutedTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRoutedTurns(v)
+		return nil
+	case task.FieldRoutingSavings:
 		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCacheReadCost(v)
+		m.AddRoutingSavings(v)
+		return nil
+	case task.FieldTurns:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTurns(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Model numeric field %s", name)
+	return fmt.Errorf("unknown Task numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ModelMutation) ClearedFields() []string {
+func (m *TaskMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(model.FieldCapabilities) {
-		fields = append(fields, model.FieldCapabilities)
+	if m.FieldCleared(task.FieldProjectDirectory) {
+		fields = append(fields, task.FieldProjectDirectory)
 	}
-	if m.FieldCleared(model.FieldAlias) {
-		fields = append(fields, model.FieldAlias)
+	if m.FieldCleared(task.FieldInputTokens) {
+		fields = append(fields, task.FieldInputTokens)
+	}
+	if m.FieldCleared(task.FieldOutputTokens) {
+		fields = append(fields, task.FieldOutputTokens)
+	}
+	if m.FieldCleared(task.FieldCacheWriteTokens) {
+		fields = append(fields, task.FieldCacheWriteTokens)
+	}
+	if m.FieldCleared(task.FieldCacheReadTokens) {
+		fields = append(fields, task.FieldCacheReadTokens)
+	}
+	if m.FieldCleared(task.FieldCost) {
+		fields = append(fields, task.FieldCost)
+	}
+	if m.FieldCleared(task.FieldRoutedTurns) {
+		fields = append(fields, task.FieldRoutedTurns)
+	}
+	if m.FieldCleared(task.FieldRoutingSavings) {
+		fields = append(fields, task.FieldRoutingSavings)
+	}
+	if m.FieldCleared(task.FieldDescription) {
+		fields = append(fields, task.FieldDescription)
+	}
+	if m.FieldCleared(task.FieldAgentID) {
+		fields = append(fields, task.FieldAgentID)
+	}
+	if m.FieldCleared(task.FieldOwner) {
+		fields = append(fields, task.FieldOwner)
+	}
+	if m.FieldCleared(task.FieldSharedWith) {
+		fields = append(fields, task.FieldSharedWith)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ModelMutation) FieldCleared(name string) bool {
+func (m *TaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ModelMutation) ClearField(name string) error {
+func (m *TaskMutation) ClearField(name string) error {
 	switch name {
-	case model.FieldCapabilities:
-		m.ClearCapabilities()
+	case task.FieldProjectDirectory:
+		m.ClearProjectDirectory()
+		return nil
+	case task.FieldInputTokens:
+		m.ClearInputTokens()
+		return nil
+	case task.FieldOutputTokens:
+		m.ClearOutputTokens()
+		return nil
+	case task.FieldCacheWriteTokens:
+		m.ClearCacheWriteTokens()
+		return nil
+	case task.FieldCacheReadTokens:
+		m.ClearCacheReadTokens()
+		return nil
+	case task.FieldCost:
+		m.ClearCost()
 		return nil
-	case model.FieldAlias:
-		m.ClearAlias()
+	case task.FieldRoutedTurns:
+		m.ClearRoutedTurns()
+		return nil
+	case task.FieldRoutingSavings:
+		m.ClearRoutingSavings()
+		return nil
+	case task.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case task.FieldAgentID:
+		m.ClearAgentID()
+		return nil
+	case task.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case task.FieldSharedWith:
+		m.ClearSharedWith()
 		return nil
 	}
-	return fmt.Errorf("unknown Model nullable field %s", name)
+	return fmt.Errorf("unknown Task nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ModelMutation) ResetField(name string) error {
+func (m *TaskMutation) ResetField(name string) error {
 	switch name {
-	case model.FieldCreateTime:
+	case task.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case model.FieldUpdateTime:
+	case task.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case model.FieldName:
-		m.ResetName()
+	case task.FieldProjectDirectory:
+		m.ResetProjectDirectory()
 		return nil
-	case model.FieldContextWindow:
-		m.ResetContextWindow()
+	case task.FieldInputTokens:
+		m.ResetInputTokens()
 		return nil
-	case model.FieldCapabilities:
-		m.ResetCapabilities()
+	case task.FieldOutputTokens:
+		m.ResetOutputTokens()
 		return nil
-	case model.FieldInputCost:
-		m.ResetInputCost()
+	case task.FieldCacheWriteTokens:
+		m.ResetCacheWriteTokens()
 		return nil
-	case model.FieldOutputCost:
-		m.ResetOutputCost()
+	case task.FieldCacheReadTokens:
+		m.ResetCacheReadTokens()
 		return nil
-	case model.FieldCacheWriteCost:
-		m.ResetCacheWriteCost()
+	case task.FieldCost:
+		m.ResetCost()
 		return nil
-	case model.FieldCacheReadCost:
-		m.ResetCacheReadCost()
+	case task.FieldRoutedTurns:
+		m.ResetRoutedTurns()
 		return nil
-	case model.FieldEnabled:
-		m.ResetEnabled()
+	case task.FieldRoutingSavings:
+		m.ResetRoutingSavings()
 		return nil
-	case model.FieldAlias:
-		m.ResetAlias()
+	case task.FieldTurns:
+		m.ResetTurns()
 		return nil
-	case model.FieldModelProviderID:
-		m.ResetModelProviderID()
+	case task.FieldToolUses:
+		m.ResetToolUses()
+		return nil
+	case task.FieldDesiredPhase:
+		m.ResetDesiredPhase()
+		return nil
+	case task.FieldPhase:
+		m.ResetPhase()
+		return nil
+	case task.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case task.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case task.FieldTraceModelCalls:
+		m.ResetTraceModelCalls()
+		return nil
+	case task.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case task.FieldSharedWith:
+		m.ResetSharedWith()
 		return nil
 	}
-	return fmt.Errorf("unknown Model field %s", name)
+	return fmt.Errorf("unknown Task field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelMutation) AddedEdges() []string {
+func (m *TaskMutation) AddedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.agents != nil {
-		edges = append(edges, model.EdgeAgents)
+	if m.messages != nil {
+		edges = append(edges, task.EdgeMessages)
 	}
-	if m.model_provider != nil {
-		edges = append(edges, model.EdgeModelProvider)
+	if m.model_call_traces != nil {
+		edges = append(edges, task.EdgeModelCallTraces)
 	}
-	if m.messages != nil {
-		edges = append(edges, model.EdgeMessages)
+	if m.agent != nil {
+		edges = append(edges, task.EdgeAgent)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ModelMutation) AddedIDs(name string) []ent.Value {
+func (m *TaskMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case model.EdgeAgents:
-		ids := make([]ent.Value, 0, len(m.agents))
-		for id := range m.agents {
+	case task.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
 			ids = append(ids, id)
 		}
 		return ids
-	case model.EdgeModelProvider:
-		if id := m.model_provider; id != nil {
-			return []ent.Value{*id}
-		}
-	case model.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
+	case task.EdgeModelCallTraces:
+		ids := make([]ent.Value, 0, len(m.model_call_traces))
+		for id := range m.model_call_traces {
 			ids = append(ids, id)
 		}
 		return ids
+	case task.EdgeAgent:
+		if id := m.agent; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelMutation) RemovedEdges() []string {
+func (m *TaskMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.removedagents != nil {
-		edges = append(edges, model.EdgeAgents)
-	}
 	if m.removedmessages != nil {
-		edges = append(edges, model.EdgeMessages)
+		edges = append(edges, task.EdgeMessages)
+	}
+	if m.removedmodel_call_traces != nil {
+		edges = append(edges, task.EdgeModelCallTraces)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
+func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case model.EdgeAgents:
-		ids := make([]ent.Value, 0, len(m.removedagents))
-		for id := range m.removedagents {
+	case task.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
 			ids = append(ids, id)
 		}
 		return ids
-	case model.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
+	case task.EdgeModelCallTraces:
+		ids := make([]ent.Value, 0, len(m.removedmodel_call_traces))
+		for id := range m.removedmodel_call_traces {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3265,95 +11628,104 @@ func (m *ModelMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelMutation) ClearedEdges() []string {
+func (m *TaskMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.clearedagents {
-		edges = append(edges, model.EdgeAgents)
+	if m.clearedmessages {
+		edges = append(edges, task.EdgeMessages)
 	}
-	if m.clearedmodel_provider {
-		edges = append(edges, model.EdgeModelProvider)
+	if m.clearedmodel_call_traces {
+		edges = append(edges, task.EdgeModelCallTraces)
 	}
-	if m.clearedmessages {
-		edges = append(edges, model.EdgeMessages)
+	if m.clearedagent {
+		edges = append(edges, task.EdgeAgent)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ModelMutation) EdgeCleared(name string) bool {
+func (m *TaskMutation) EdgeCleared(name string) bool {
 	switch name {
-	case model.EdgeAgents:
-		return m.clearedagents
-	case model.EdgeModelProvider:
-		return m.clearedmodel_provider
-	case model.EdgeMessages:
+	case task.EdgeMessages:
 		return m.clearedmessages
+	case task.EdgeModelCallTraces:
+		return m.clearedmodel_call_traces
+	case task.EdgeAgent:
+		return m.clearedagent
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ModelMutation) ClearEdge(name string) error {
+func (m *TaskMutation) ClearEdge(name string) error {
 	switch name {
-	case model.EdgeModelProvider:
-		m.ClearModelProvider()
+	case task.EdgeAgent:
+		m.ClearAgent()
 		return nil
 	}
-	return fmt.Errorf("unknown Model unique edge %s", name)
+	return fmt.Errorf("unknown Task unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ModelMutation) ResetEdge(name string) error {
+func (m *TaskMutation) ResetEdge(name string) error {
 	switch name {
-	case model.EdgeAgents:
-		m.ResetAgents()
+	case task.EdgeMessages:
+		m.ResetMessages()
 		return nil
-	case model.EdgeModelProvider:
-		m.ResetModelProvider()
+	case task.EdgeModelCallTraces:
+		m.ResetModelCallTraces()
 		return nil
-	case model.EdgeMessages:
-		m.ResetMessages()
+	case task.EdgeAgent:
+		m.ResetAgent()
 		return nil
 	}
-	return fmt.Errorf("unknown Model edge %s", name)
+	return fmt.Errorf("unknown Task edge %s", name)
 }
 
-// ModelProviderMutation represents an operation that mutates the ModelProvider nodes in the graph.
-type ModelProviderMutation struct {
+// TokenMutation represents an operation that mutates the Token nodes in the graph.
+type TokenMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	create_time   *time.Time
-	update_time   *time.Time
-	name          *string
-	provider_type *types.ModelProviderType
-	url           *string
-	secret        *[]byte
-	enabled       *bool
-	clearedFields map[string]struct{}
-	models        map[uuid.UUID]struct{}
-	removedmodels map[uuid.UUID]struct{}
-	clearedmodels bool
-	done          bool
-	oldValue      func(context.Context) (*ModelProvider, error)
-	predicates    []predicate.ModelProvider
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	name                *string
+	_type               *types.TokenType
+	token_hash          *[]byte
+	description         *string
+	expires_at          *time.Time
+	scopes              *[]string
+	appendscopes        []string
+	agent_ids           *[]uuid.UUID
+	appendagent_ids     []uuid.UUID
+	workspaces          *[]string
+	appendworkspaces    []string
+	quota               **types.Quota
+	rotated_at          *time.Time
+	previous_token_hash *[]byte
+	previous_expires_at *time.Time
+	last_used_at        *time.Time
+	last_used_address   *string
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Token, error)
+	predicates          []predicate.Token
 }
 
-var _ ent.Mutation = (*ModelProviderMutation)(nil)
+var _ ent.Mutation = (*TokenMutation)(nil)
 
-// modelproviderOption allows management of the mutation configuration using functional options.
-type modelproviderOption func(*ModelProviderMutation)
+// tokenOption allows management of the mutation configuration using functional options.
+type tokenOption func(*TokenMutation)
 
-// newModelProviderMutation creates new mutation for the ModelProvider entity.
-func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *ModelProviderMutation {
-	m := &ModelProviderMutation{
+// newTokenMutation creates new mutation for the Token entity.
+func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
+	m := &TokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeModelProvider,
+		typ:           TypeToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3362,20 +11734,20 @@ func newModelProviderMutation(c config, op Op, opts ...modelproviderOption) *Mod
 	return m
 }
 
-// withModelProviderID sets the ID field of the mutation.
-func withModelProviderID(id uuid.UUID) modelproviderOption {
-	return func(m *ModelProviderMutation) {
+// withTokenID sets the ID field of the mutation.
+func withTokenID(id uuid.UUID) tokenOption {
+	return func(m *TokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ModelProvider
+			value *Token
 		)
-		m.oldValue = func(ctx context.Context) (*ModelProvider, error) {
+		m.oldValue = func(ctx context.Context) (*Token, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ModelProvider.Get(ctx, id)
+					value, err = m.Client().Token.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3384,10 +11756,10 @@ func withModelProviderID(id uuid.UUID) modelproviderOption {
 	}
 }
 
-// withModelProvider sets the old ModelProvider of the mutation.
-func withModelProvider(node *ModelProvider) modelproviderOption {
-	return func(m *ModelProviderMutation) {
-		m.oldValue = func(context.Context) (*ModelProvider, error) {
+// withToken sets the old Token of the mutation.
+func withToken(node *Token) tokenOption {
+	return func(m *TokenMutation) {
+		m.oldValue = func(context.Context) (*Token, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3396,7 +11768,7 @@ func withModelProvider(node *ModelProvider) modelproviderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ModelProviderMutation) Client() *Client {
+func (m TokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3404,7 +11776,7 @@ func (m ModelProviderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ModelProviderMutation) Tx() (*Tx, error) {
+func (m TokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -3414,14 +11786,14 @@ func (m ModelProviderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ModelProvider entities.
-func (m *ModelProviderMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Token entities.
+func (m *TokenMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3432,7 +11804,7 @@ func (m *ModelProviderMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3441,19 +11813,19 @@ func (m *ModelProviderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ModelProvider.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *ModelProviderMutation) SetCreateTime(t time.Time) {
+func (m *TokenMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -3461,10 +11833,10 @@ func (m *ModelProviderMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -3479,17 +11851,17 @@ func (m *ModelProviderMutation) OldCreateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *ModelProviderMutation) ResetCreateTime() {
+func (m *TokenMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *ModelProviderMutation) SetUpdateTime(t time.Time) {
+func (m *TokenMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -3497,10 +11869,10 @@ func (m *ModelProviderMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -3515,17 +11887,17 @@ func (m *ModelProviderMutation) OldUpdateTime(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *ModelProviderMutation) ResetUpdateTime() {
+func (m *TokenMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetName sets the "name" field.
-func (m *ModelProviderMutation) SetName(s string) {
+func (m *TokenMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ModelProviderMutation) Name() (r string, exists bool) {
+func (m *TokenMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -3533,10 +11905,10 @@ func (m *ModelProviderMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TokenMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -3551,1329 +11923,1634 @@ func (m *ModelProviderMutation) OldName(ctx context.Context) (v string, err erro
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ModelProviderMutation) ResetName() {
+func (m *TokenMutation) ResetName() {
 	m.name = nil
 }
 
-// SetProviderType sets the "provider_type" field.
-func (m *ModelProviderMutation) SetProviderType(tpt types.ModelProviderType) {
-	m.provider_type = &tpt
+// SetType sets the "type" field.
+func (m *TokenMutation) SetType(tt types.TokenType) {
+	m._type = &tt
 }
 
-// ProviderType returns the value of the "provider_type" field in the mutation.
-func (m *ModelProviderMutation) ProviderType() (r types.ModelProviderType, exists bool) {
-	v := m.provider_type
+// GetType returns the value of the "type" field in the mutation.
+func (m *TokenMutation) GetType() (r types.TokenType, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderType returns the old "provider_type" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldProviderType(ctx context.Context) (v types.ModelProviderType, err error) {
+func (m *TokenMutation) OldType(ctx context.Context) (v types.TokenType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderType is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderType requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderType: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.ProviderType, nil
+	return oldValue.Type, nil
 }
 
-// ResetProviderType resets all changes to the "provider_type" field.
-func (m *ModelProviderMutation) ResetProviderType() {
-	m.provider_type = nil
+// ResetType resets all changes to the "type" field.
+func (m *TokenMutation) ResetType() {
+	m._type = nil
 }
 
-// SetURL sets the "url" field.
-func (m *ModelProviderMutation) SetURL(s string) {
-	m.url = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *TokenMutation) SetTokenHash(b []byte) {
+	m.token_hash = &b
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *ModelProviderMutation) URL() (r string, exists bool) {
-	v := m.url
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *TokenMutation) TokenHash() (r []byte, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *TokenMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.TokenHash, nil
 }
 
-// ClearURL clears the value of the "url" field.
-func (m *ModelProviderMutation) ClearURL() {
-	m.url = nil
-	m.clearedFields[modelprovider.FieldURL] = struct{}{}
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *TokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// URLCleared returns if the "url" field was cleared in this mutation.
-func (m *ModelProviderMutation) URLCleared() bool {
-	_, ok := m.clearedFields[modelprovider.FieldURL]
+// SetDescription sets the "description" field.
+func (m *TokenMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TokenMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *TokenMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[token.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *TokenMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[token.FieldDescription]
 	return ok
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *ModelProviderMutation) ResetURL() {
-	m.url = nil
-	delete(m.clearedFields, modelprovider.FieldURL)
+// ResetDescription resets all changes to the "description" field.
+func (m *TokenMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, token.FieldDescription)
 }
 
-// SetSecret sets the "secret" field.
-func (m *ModelProviderMutation) SetSecret(b []byte) {
-	m.secret = &b
+// SetExpiresAt sets the "expires_at" field.
+func (m *TokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Secret returns the value of the "secret" field in the mutation.
-func (m *ModelProviderMutation) Secret() (r []byte, exists bool) {
-	v := m.secret
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecret returns the old "secret" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldSecret(ctx context.Context) (v []byte, err error) {
+func (m *TokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecret requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Secret, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetSecret resets all changes to the "secret" field.
-func (m *ModelProviderMutation) ResetSecret() {
-	m.secret = nil
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *ModelProviderMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetScopes sets the "scopes" field.
+func (m *TokenMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *ModelProviderMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *TokenMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the ModelProvider entity.
-// If the ModelProvider object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ModelProviderMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *TokenMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Scopes, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *ModelProviderMutation) ResetEnabled() {
-	m.enabled = nil
+// AppendScopes adds s to the "scopes" field.
+func (m *TokenMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// AddModelIDs adds the "models" edge to the Model entity by ids.
-func (m *ModelProviderMutation) AddModelIDs(ids ...uuid.UUID) {
-	if m.models == nil {
-		m.models = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.models[ids[i]] = struct{}{}
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *TokenMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
 	}
+	return m.appendscopes, true
 }
 
-// ClearModels clears the "models" edge to the Model entity.
-func (m *ModelProviderMutation) ClearModels() {
-	m.clearedmodels = true
+// ClearScopes clears the value of the "scopes" field.
+func (m *TokenMutation) ClearScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	m.clearedFields[token.FieldScopes] = struct{}{}
 }
 
-// ModelsCleared reports if the "models" edge to the Model entity was cleared.
-func (m *ModelProviderMutation) ModelsCleared() bool {
-	return m.clearedmodels
+// ScopesCleared returns if the "scopes" field was cleared in this mutation.
+func (m *TokenMutation) ScopesCleared() bool {
+	_, ok := m.clearedFields[token.FieldScopes]
+	return ok
 }
 
-// RemoveModelIDs removes the "models" edge to the Model entity by IDs.
-func (m *ModelProviderMutation) RemoveModelIDs(ids ...uuid.UUID) {
-	if m.removedmodels == nil {
-		m.removedmodels = make(map[uuid.UUID]struct{})
+// ResetScopes resets all changes to the "scopes" field.
+func (m *TokenMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
+	delete(m.clearedFields, token.FieldScopes)
+}
+
+// SetAgentIds sets the "agent_ids" field.
+func (m *TokenMutation) SetAgentIds(u []uuid.UUID) {
+	m.agent_ids = &u
+	m.appendagent_ids = nil
+}
+
+// AgentIds returns the value of the "agent_ids" field in the mutation.
+func (m *TokenMutation) AgentIds() (r []uuid.UUID, exists bool) {
+	v := m.agent_ids
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.models, ids[i])
-		m.removedmodels[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldAgentIds returns the old "agent_ids" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldAgentIds(ctx context.Context) (v []uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentIds is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentIds: %w", err)
+	}
+	return oldValue.AgentIds, nil
 }
 
-// RemovedModels returns the removed IDs of the "models" edge to the Model entity.
-func (m *ModelProviderMutation) RemovedModelsIDs() (ids []uuid.UUID) {
-	for id := range m.removedmodels {
-		ids = append(ids, id)
+// AppendAgentIds adds u to the "agent_ids" field.
+func (m *TokenMutation) AppendAgentIds(u []uuid.UUID) {
+	m.appendagent_ids = append(m.appendagent_ids, u...)
+}
+
+// AppendedAgentIds returns the list of values that were appended to the "agent_ids" field in this mutation.
+func (m *TokenMutation) AppendedAgentIds() ([]uuid.UUID, bool) {
+	if len(m.appendagent_ids) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendagent_ids, true
 }
 
-// ModelsIDs returns the "models" edge IDs in the mutation.
-func (m *ModelProviderMutation) ModelsIDs() (ids []uuid.UUID) {
-	for id := range m.models {
-		ids = append(ids, id)
+// ClearAgentIds clears the value of the "agent_ids" field.
+func (m *TokenMutation) ClearAgentIds() {
+	m.agent_ids = nil
+	m.appendagent_ids = nil
+	m.clearedFields[token.FieldAgentIds] = struct{}{}
+}
+
+// AgentIdsCleared returns if the "agent_ids" field was cleared in this mutation.
+func (m *TokenMutation) AgentIdsCleared() bool {
+	_, ok := m.clearedFields[token.FieldAgentIds]
+	return ok
+}
+
+// ResetAgentIds resets all changes to the "agent_ids" field.
+func (m *TokenMutation) ResetAgentIds() {
+	m.agent_ids = nil
+	m.appendagent_ids = nil
+	delete(m.clearedFields, token.FieldAgentIds)
+}
+
+// SetWorkspaces sets the "workspaces" field.
+func (m *TokenMutation) SetWorkspaces(s []string) {
+	m.workspaces = &s
+	m.appendworkspaces = nil
+}
+
+// Workspaces returns the value of the "workspaces" field in the mutation.
+func (m *TokenMutation) Workspaces() (r []string, exists bool) {
+	v := m.workspaces
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkspaces returns the old "workspaces" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldWorkspaces(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkspaces is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkspaces requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkspaces: %w", err)
 	}
-	return
+	return oldValue.Workspaces, nil
 }
 
-// ResetModels resets all changes to the "models" edge.
-func (m *ModelProviderMutation) ResetModels() {
-	m.models = nil
-	m.clearedmodels = false
-	m.removedmodels = nil
+// AppendWorkspaces adds s to the "workspaces" field.
+func (m *TokenMutation) AppendWorkspaces(s []string) {
+	m.appendworkspaces = append(m.appendworkspaces, s...)
 }
 
-// Where appends a list predicates to the ModelProviderMutation builder.
-func (m *ModelProviderMutation) Where(ps ...predicate.ModelProvider) {
-	m.predicates = append(m.predicates, ps...)
+// AppendedWorkspaces returns the list of values that were appended to the "workspaces" field in this mutation.
+func (m *TokenMutation) AppendedWorkspaces() ([]string, bool) {
+	if len(m.appendworkspaces) == 0 {
+		return nil, false
+	}
+	return m.appendworkspaces, true
 }
 
-// WhereP appends storage-level predicates to the ModelProviderMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ModelProviderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ModelProvider, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearWorkspaces clears the value of the "workspaces" field.
+func (m *TokenMutation) ClearWorkspaces() {
+	m.workspaces = nil
+	m.appendworkspaces = nil
+	m.clearedFields[token.FieldWorkspaces] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *ModelProviderMutation) Op() Op {
-	return m.op
+// WorkspacesCleared returns if the "workspaces" field was cleared in this mutation.
+func (m *TokenMutation) WorkspacesCleared() bool {
+	_, ok := m.clearedFields[token.FieldWorkspaces]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ModelProviderMutation) SetOp(op Op) {
-	m.op = op
+// ResetWorkspaces resets all changes to the "workspaces" field.
+func (m *TokenMutation) ResetWorkspaces() {
+	m.workspaces = nil
+	m.appendworkspaces = nil
+	delete(m.clearedFields, token.FieldWorkspaces)
 }
 
-// Type returns the node type of this mutation (ModelProvider).
-func (m *ModelProviderMutation) Type() string {
-	return m.typ
+// SetQuota sets the "quota" field.
+func (m *TokenMutation) SetQuota(t *types.Quota) {
+	m.quota = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ModelProviderMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.create_time != nil {
-		fields = append(fields, modelprovider.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, modelprovider.FieldUpdateTime)
-	}
-	if m.name != nil {
-		fields = append(fields, modelprovider.FieldName)
-	}
-	if m.provider_type != nil {
-		fields = append(fields, modelprovider.FieldProviderType)
+// Quota returns the value of the "quota" field in the mutation.
+func (m *TokenMutation) Quota() (r *types.Quota, exists bool) {
+	v := m.quota
+	if v == nil {
+		return
 	}
-	if m.url != nil {
-		fields = append(fields, modelprovider.FieldURL)
+	return *v, true
+}
+
+// OldQuota returns the old "quota" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldQuota(ctx context.Context) (v *types.Quota, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuota is only allowed on UpdateOne operations")
 	}
-	if m.secret != nil {
-		fields = append(fields, modelprovider.FieldSecret)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuota requires an ID field in the mutation")
 	}
-	if m.enabled != nil {
-		fields = append(fields, modelprovider.FieldEnabled)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuota: %w", err)
 	}
-	return fields
+	return oldValue.Quota, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ModelProviderMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case modelprovider.FieldCreateTime:
-		return m.CreateTime()
-	case modelprovider.FieldUpdateTime:
-		return m.UpdateTime()
-	case modelprovider.FieldName:
-		return m.Name()
-	case modelprovider.FieldProviderType:
-		return m.ProviderType()
-	case modelprovider.FieldURL:
-		return m.URL()
-	case modelprovider.FieldSecret:
-		return m.Secret()
-	case modelprovider.FieldEnabled:
-		return m.Enabled()
-	}
-	return nil, false
+// ClearQuota clears the value of the "quota" field.
+func (m *TokenMutation) ClearQuota() {
+	m.quota = nil
+	m.clearedFields[token.FieldQuota] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ModelProviderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case modelprovider.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case modelprovider.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case modelprovider.FieldName:
-		return m.OldName(ctx)
-	case modelprovider.FieldProviderType:
-		return m.OldProviderType(ctx)
-	case modelprovider.FieldURL:
-		return m.OldURL(ctx)
-	case modelprovider.FieldSecret:
-		return m.OldSecret(ctx)
-	case modelprovider.FieldEnabled:
-		return m.OldEnabled(ctx)
-	}
-	return nil, fmt.Errorf("unknown ModelProvider field %s", name)
+// QuotaCleared returns if the "quota" field was cleared in this mutation.
+func (m *TokenMutation) QuotaCleared() bool {
+	_, ok := m.clearedFields[token.FieldQuota]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelProviderMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case modelprovider.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case modelprovider.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case modelprovider.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case modelprovider.FieldProviderType:
-		v, ok := value.(types.ModelProviderType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProviderType(v)
-		return nil
-	case modelprovider.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case modelprovider.FieldSecret:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecret(v)
-		return nil
-	case modelprovider.FieldEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEnabled(v)
-		return nil
-	}
-	return fmt.Errorf("unknown ModelProvider field %s", name)
+// ResetQuota resets all changes to the "quota" field.
+func (m *TokenMutation) ResetQuota() {
+	m.quota = nil
+	delete(m.clearedFields, token.FieldQuota)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ModelProviderMutation) AddedFields() []string {
-	return nil
+// SetRotatedAt sets the "rotated_at" field.
+func (m *TokenMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ModelProviderMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *TokenMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ModelProviderMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldRotatedAt returns the old "rotated_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldRotatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ModelProvider numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
+	}
+	return oldValue.RotatedAt, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ModelProviderMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(modelprovider.FieldURL) {
-		fields = append(fields, modelprovider.FieldURL)
-	}
-	return fields
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (m *TokenMutation) ClearRotatedAt() {
+	m.rotated_at = nil
+	m.clearedFields[token.FieldRotatedAt] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ModelProviderMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// RotatedAtCleared returns if the "rotated_at" field was cleared in this mutation.
+func (m *TokenMutation) RotatedAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldRotatedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ModelProviderMutation) ClearField(name string) error {
-	switch name {
-	case modelprovider.FieldURL:
-		m.ClearURL()
-		return nil
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *TokenMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+	delete(m.clearedFields, token.FieldRotatedAt)
+}
+
+// SetPreviousTokenHash sets the "previous_token_hash" field.
+func (m *TokenMutation) SetPreviousTokenHash(b []byte) {
+	m.previous_token_hash = &b
+}
+
+// PreviousTokenHash returns the value of the "previous_token_hash" field in the mutation.
+func (m *TokenMutation) PreviousTokenHash() (r []byte, exists bool) {
+	v := m.previous_token_hash
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ModelProvider nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ModelProviderMutation) ResetField(name string) error {
-	switch name {
-	case modelprovider.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case modelprovider.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case modelprovider.FieldName:
-		m.ResetName()
-		return nil
-	case modelprovider.FieldProviderType:
-		m.ResetProviderType()
-		return nil
-	case modelprovider.FieldURL:
-		m.ResetURL()
-		return nil
-	case modelprovider.FieldSecret:
-		m.ResetSecret()
-		return nil
-	case modelprovider.FieldEnabled:
-		m.ResetEnabled()
-		return nil
+// OldPreviousTokenHash returns the old "previous_token_hash" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldPreviousTokenHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousTokenHash is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ModelProvider field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousTokenHash: %w", err)
+	}
+	return oldValue.PreviousTokenHash, nil
+}
+
+// ClearPreviousTokenHash clears the value of the "previous_token_hash" field.
+func (m *TokenMutation) ClearPreviousTokenHash() {
+	m.previous_token_hash = nil
+	m.clearedFields[token.FieldPreviousTokenHash] = struct{}{}
+}
+
+// PreviousTokenHashCleared returns if the "previous_token_hash" field was cleared in this mutation.
+func (m *TokenMutation) PreviousTokenHashCleared() bool {
+	_, ok := m.clearedFields[token.FieldPreviousTokenHash]
+	return ok
+}
+
+// ResetPreviousTokenHash resets all changes to the "previous_token_hash" field.
+func (m *TokenMutation) ResetPreviousTokenHash() {
+	m.previous_token_hash = nil
+	delete(m.clearedFields, token.FieldPreviousTokenHash)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ModelProviderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.models != nil {
-		edges = append(edges, modelprovider.EdgeModels)
-	}
-	return edges
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (m *TokenMutation) SetPreviousExpiresAt(t time.Time) {
+	m.previous_expires_at = &t
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ModelProviderMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case modelprovider.EdgeModels:
-		ids := make([]ent.Value, 0, len(m.models))
-		for id := range m.models {
-			ids = append(ids, id)
-		}
-		return ids
+// PreviousExpiresAt returns the value of the "previous_expires_at" field in the mutation.
+func (m *TokenMutation) PreviousExpiresAt() (r time.Time, exists bool) {
+	v := m.previous_expires_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ModelProviderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmodels != nil {
-		edges = append(edges, modelprovider.EdgeModels)
+// OldPreviousExpiresAt returns the old "previous_expires_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldPreviousExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousExpiresAt is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousExpiresAt: %w", err)
+	}
+	return oldValue.PreviousExpiresAt, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ModelProviderMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case modelprovider.EdgeModels:
-		ids := make([]ent.Value, 0, len(m.removedmodels))
-		for id := range m.removedmodels {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (m *TokenMutation) ClearPreviousExpiresAt() {
+	m.previous_expires_at = nil
+	m.clearedFields[token.FieldPreviousExpiresAt] = struct{}{}
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ModelProviderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmodels {
-		edges = append(edges, modelprovider.EdgeModels)
-	}
-	return edges
+// PreviousExpiresAtCleared returns if the "previous_expires_at" field was cleared in this mutation.
+func (m *TokenMutation) PreviousExpiresAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldPreviousExpiresAt]
+	return ok
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ModelProviderMutation) EdgeCleared(name string) bool {
-	switch name {
-	case modelprovider.EdgeModels:
-		return m.clearedmodels
-	}
-	return false
+// ResetPreviousExpiresAt resets all changes to the "previous_expires_at" field.
+func (m *TokenMutation) ResetPreviousExpiresAt() {
+	m.previous_expires_at = nil
+	delete(m.clearedFields, token.FieldPreviousExpiresAt)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ModelProviderMutation) ClearEdge(name string) error {
-	switch name {
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *TokenMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *TokenMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown ModelProvider unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ModelProviderMutation) ResetEdge(name string) error {
-	switch name {
-	case modelprovider.EdgeModels:
-		m.ResetModels()
-		return nil
+// OldLastUsedAt returns the old "last_used_at" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown ModelProvider edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
 }
 
-// TaskMutation represents an operation that mutates the Task nodes in the graph.
-type TaskMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	project_directory     *string
-	input_tokens          *int64
-	addinput_tokens       *int64
-	output_tokens         *int64
-	addoutput_tokens      *int64
-	cache_write_tokens    *int64
-	addcache_write_tokens *int64
-	cache_read_tokens     *int64
-	addcache_read_tokens  *int64
-	cost                  *float64
-	addcost               *float64
-	turns                 *int64
-	addturns              *int64
-	tool_uses             *map[string]int64
-	desired_phase         *types.TaskPhase
-	phase                 *types.TaskPhase
-	description           *string
-	clearedFields         map[string]struct{}
-	messages              map[uuid.UUID]struct{}
-	removedmessages       map[uuid.UUID]struct{}
-	clearedmessages       bool
-	agent                 *uuid.UUID
-	clearedagent          bool
-	done                  bool
-	oldValue              func(context.Context) (*Task, error)
-	predicates            []predicate.Task
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *TokenMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[token.FieldLastUsedAt] = struct{}{}
 }
 
-var _ ent.Mutation = (*TaskMutation)(nil)
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *TokenMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[token.FieldLastUsedAt]
+	return ok
+}
 
-// taskOption allows management of the mutation configuration using functional options.
-type taskOption func(*TaskMutation)
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *TokenMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, token.FieldLastUsedAt)
+}
 
-// newTaskMutation creates new mutation for the Task entity.
-func newTaskMutation(c config, op Op, opts ...taskOption) *TaskMutation {
-	m := &TaskMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTask,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetLastUsedAddress sets the "last_used_address" field.
+func (m *TokenMutation) SetLastUsedAddress(s string) {
+	m.last_used_address = &s
 }
 
-// withTaskID sets the ID field of the mutation.
-func withTaskID(id uuid.UUID) taskOption {
-	return func(m *TaskMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Task
-		)
-		m.oldValue = func(ctx context.Context) (*Task, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Task.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// LastUsedAddress returns the value of the "last_used_address" field in the mutation.
+func (m *TokenMutation) LastUsedAddress() (r string, exists bool) {
+	v := m.last_used_address
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withTask sets the old Task of the mutation.
-func withTask(node *Task) taskOption {
-	return func(m *TaskMutation) {
-		m.oldValue = func(context.Context) (*Task, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldLastUsedAddress returns the old "last_used_address" field's value of the Token entity.
+// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMutation) OldLastUsedAddress(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAddress is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAddress requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAddress: %w", err)
+	}
+	return oldValue.LastUsedAddress, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TaskMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ClearLastUsedAddress clears the value of the "last_used_address" field.
+func (m *TokenMutation) ClearLastUsedAddress() {
+	m.last_used_address = nil
+	m.clearedFields[token.FieldLastUsedAddress] = struct{}{}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TaskMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("memory: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// LastUsedAddressCleared returns if the "last_used_address" field was cleared in this mutation.
+func (m *TokenMutation) LastUsedAddressCleared() bool {
+	_, ok := m.clearedFields[token.FieldLastUsedAddress]
+	return ok
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Task entities.
-func (m *TaskMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetLastUsedAddress resets all changes to the "last_used_address" field.
+func (m *TokenMutation) ResetLastUsedAddress() {
+	m.last_used_address = nil
+	delete(m.clearedFields, token.FieldLastUsedAddress)
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TaskMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// Where appends a list predicates to the TokenMutation builder.
+func (m *TokenMutation) Where(ps ...predicate.Token) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Token, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *m.id, true
+	m.Where(p...)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TaskMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Task.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// Op returns the operation name.
+func (m *TokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.create_time != nil {
+		fields = append(fields, token.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, token.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, token.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, token.FieldType)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, token.FieldTokenHash)
+	}
+	if m.description != nil {
+		fields = append(fields, token.FieldDescription)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, token.FieldExpiresAt)
+	}
+	if m.scopes != nil {
+		fields = append(fields, token.FieldScopes)
+	}
+	if m.agent_ids != nil {
+		fields = append(fields, token.FieldAgentIds)
+	}
+	if m.workspaces != nil {
+		fields = append(fields, token.FieldWorkspaces)
+	}
+	if m.quota != nil {
+		fields = append(fields, token.FieldQuota)
+	}
+	if m.rotated_at != nil {
+		fields = append(fields, token.FieldRotatedAt)
+	}
+	if m.previous_token_hash != nil {
+		fields = append(fields, token.FieldPreviousTokenHash)
 	}
+	if m.previous_expires_at != nil {
+		fields = append(fields, token.FieldPreviousExpiresAt)
+	}
+	if m.last_used_at != nil {
+		fields = append(fields, token.FieldLastUsedAt)
+	}
+	if m.last_used_address != nil {
+		fields = append(fields, token.FieldLastUsedAddress)
+	}
+	return fields
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *TaskMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldCreateTime:
+		return m.CreateTime()
+	case token.FieldUpdateTime:
+		return m.UpdateTime()
+	case token.FieldName:
+		return m.Name()
+	case token.FieldType:
+		return m.GetType()
+	case token.FieldTokenHash:
+		return m.TokenHash()
+	case token.FieldDescription:
+		return m.Description()
+	case token.FieldExpiresAt:
+		return m.ExpiresAt()
+	case token.FieldScopes:
+		return m.Scopes()
+	case token.FieldAgentIds:
+		return m.AgentIds()
+	case token.FieldWorkspaces:
+		return m.Workspaces()
+	case token.FieldQuota:
+		return m.Quota()
+	case token.FieldRotatedAt:
+		return m.RotatedAt()
+	case token.FieldPreviousTokenHash:
+		return m.PreviousTokenHash()
+	case token.FieldPreviousExpiresAt:
+		return m.PreviousExpiresAt()
+	case token.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case token.FieldLastUsedAddress:
+		return m.LastUsedAddress()
+	}
+	return nil, false
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TaskMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case token.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case token.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case token.FieldName:
+		return m.OldName(ctx)
+	case token.FieldType:
+		return m.OldType(ctx)
+	case token.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case token.FieldDescription:
+		return m.OldDescription(ctx)
+	case token.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case token.FieldScopes:
+		return m.OldScopes(ctx)
+	case token.FieldAgentIds:
+		return m.OldAgentIds(ctx)
+	case token.FieldWorkspaces:
+		return m.OldWorkspaces(ctx)
+	case token.FieldQuota:
+		return m.OldQuota(ctx)
+	case token.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
+	case token.FieldPreviousTokenHash:
+		return m.OldPreviousTokenHash(ctx)
+	case token.FieldPreviousExpiresAt:
+		return m.OldPreviousExpiresAt(ctx)
+	case token.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case token.FieldLastUsedAddress:
+		return m.OldLastUsedAddress(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Token field %s", name)
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case token.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case token.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case token.FieldType:
+		v, ok := value.(types.TokenType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case token.FieldTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case token.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case token.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case token.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case token.FieldAgentIds:
+		v, ok := value.([]uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentIds(v)
+		return nil
+	case token.FieldWorkspaces:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkspaces(v)
+		return nil
+	case token.FieldQuota:
+		v, ok := value.(*types.Quota)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuota(v)
+		return nil
+	case token.FieldRotatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRotatedAt(v)
+		return nil
+	case token.FieldPreviousTokenHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousTokenHash(v)
+		return nil
+	case token.FieldPreviousExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousExpiresAt(v)
+		return nil
+	case token.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case token.FieldLastUsedAddress:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAddress(v)
+		return nil
 	}
-	return oldValue.CreateTime, nil
+	return fmt.Errorf("unknown Token field %s", name)
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TaskMutation) ResetCreateTime() {
-	m.create_time = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenMutation) AddedFields() []string {
+	return nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *TaskMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TaskMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Token numeric field %s", name)
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(token.FieldDescription) {
+		fields = append(fields, token.FieldDescription)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	if m.FieldCleared(token.FieldScopes) {
+		fields = append(fields, token.FieldScopes)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	if m.FieldCleared(token.FieldAgentIds) {
+		fields = append(fields, token.FieldAgentIds)
 	}
-	return oldValue.UpdateTime, nil
-}
-
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TaskMutation) ResetUpdateTime() {
-	m.update_time = nil
-}
-
-// SetProjectDirectory sets the "project_directory" field.
-func (m *TaskMutation) SetProjectDirectory(s string) {
-	m.project_directory = &s
-}
-
-// ProjectDirectory returns the value of the "project_directory" field in the mutation.
-func (m *TaskMutation) ProjectDirectory() (r string, exists bool) {
-	v := m.project_directory
-	if v == nil {
-		return
+	if m.FieldCleared(token.FieldWorkspaces) {
+		fields = append(fields, token.FieldWorkspaces)
 	}
-	return *v, true
-}
-
-// OldProjectDirectory returns the old "project_directory" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldProjectDirectory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProjectDirectory is only allowed on UpdateOne operations")
+	if m.FieldCleared(token.FieldQuota) {
+		fields = append(fields, token.FieldQuota)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProjectDirectory requires an ID field in the mutation")
+	if m.FieldCleared(token.FieldRotatedAt) {
+		fields = append(fields, token.FieldRotatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProjectDirectory: %w", err)
+	if m.FieldCleared(token.FieldPreviousTokenHash) {
+		fields = append(fields, token.FieldPreviousTokenHash)
 	}
-	return oldValue.ProjectDirectory, nil
-}
-
-// ClearProjectDirectory clears the value of the "project_directory" field.
-func (m *TaskMutation) ClearProjectDirectory() {
-	m.project_directory = nil
-	m.clearedFields[task.FieldProjectDirectory] = struct{}{}
-}
-
-// ProjectDirectoryCleared returns if the "project_directory" field was cleared in this mutation.
-func (m *TaskMutation) ProjectDirectoryCleared() bool {
-	_, ok := m.clearedFields[task.FieldProjectDirectory]
-	return ok
+	if m.FieldCleared(token.FieldPreviousExpiresAt) {
+		fields = append(fields, token.FieldPreviousExpiresAt)
+	}
+	if m.FieldCleared(token.FieldLastUsedAt) {
+		fields = append(fields, token.FieldLastUsedAt)
+	}
+	if m.FieldCleared(token.FieldLastUsedAddress) {
+		fields = append(fields, token.FieldLastUsedAddress)
+	}
+	return fields
 }
 
-// ResetProjectDirectory resets all changes to the "project_directory" field.
-func (m *TaskMutation) ResetProjectDirectory() {
-	m.project_directory = nil
-	delete(m.clearedFields, task.FieldProjectDirectory)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetInputTokens sets the "input_tokens" field.
-func (m *TaskMutation) SetInputTokens(i int64) {
-	m.input_tokens = &i
-	m.addinput_tokens = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenMutation) ClearField(name string) error {
+	switch name {
+	case token.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case token.FieldScopes:
+		m.ClearScopes()
+		return nil
+	case token.FieldAgentIds:
+		m.ClearAgentIds()
+		return nil
+	case token.FieldWorkspaces:
+		m.ClearWorkspaces()
+		return nil
+	case token.FieldQuota:
+		m.ClearQuota()
+		return nil
+	case token.FieldRotatedAt:
+		m.ClearRotatedAt()
+		return nil
+	case token.FieldPreviousTokenHash:
+		m.ClearPreviousTokenHash()
+		return nil
+	case token.FieldPreviousExpiresAt:
+		m.ClearPreviousExpiresAt()
+		return nil
+	case token.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case token.FieldLastUsedAddress:
+		m.ClearLastUsedAddress()
+		return nil
+	}
+	return fmt.Errorf("unknown Token nullable field %s", name)
 }
 
-// InputTokens returns the value of the "input_tokens" field in the mutation.
-func (m *TaskMutation) InputTokens() (r int64, exists bool) {
-	v := m.input_tokens
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenMutation) ResetField(name string) error {
+	switch name {
+	case token.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case token.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case token.FieldName:
+		m.ResetName()
+		return nil
+	case token.FieldType:
+		m.ResetType()
+		return nil
+	case token.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case token.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case token.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case token.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case token.FieldAgentIds:
+		m.ResetAgentIds()
+		return nil
+	case token.FieldWorkspaces:
+		m.ResetWorkspaces()
+		return nil
+	case token.FieldQuota:
+		m.ResetQuota()
+		return nil
+	case token.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
+	case token.FieldPreviousTokenHash:
+		m.ResetPreviousTokenHash()
+		return nil
+	case token.FieldPreviousExpiresAt:
+		m.ResetPreviousExpiresAt()
+		return nil
+	case token.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case token.FieldLastUsedAddress:
+		m.ResetLastUsedAddress()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Token field %s", name)
 }
 
-// OldInputTokens returns the old "input_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldInputTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputTokens requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
-	}
-	return oldValue.InputTokens, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddInputTokens adds i to the "input_tokens" field.
-func (m *TaskMutation) AddInputTokens(i int64) {
-	if m.addinput_tokens != nil {
-		*m.addinput_tokens += i
-	} else {
-		m.addinput_tokens = &i
-	}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
-func (m *TaskMutation) AddedInputTokens() (r int64, exists bool) {
-	v := m.addinput_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ClearInputTokens clears the value of the "input_tokens" field.
-func (m *TaskMutation) ClearInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
-	m.clearedFields[task.FieldInputTokens] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// InputTokensCleared returns if the "input_tokens" field was cleared in this mutation.
-func (m *TaskMutation) InputTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldInputTokens]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetInputTokens resets all changes to the "input_tokens" field.
-func (m *TaskMutation) ResetInputTokens() {
-	m.input_tokens = nil
-	m.addinput_tokens = nil
-	delete(m.clearedFields, task.FieldInputTokens)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// SetOutputTokens sets the "output_tokens" field.
-func (m *TaskMutation) SetOutputTokens(i int64) {
-	m.output_tokens = &i
-	m.addoutput_tokens = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Token unique edge %s", name)
 }
 
-// OutputTokens returns the value of the "output_tokens" field in the mutation.
-func (m *TaskMutation) OutputTokens() (r int64, exists bool) {
-	v := m.output_tokens
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Token edge %s", name)
 }
 
-// OldOutputTokens returns the old "output_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldOutputTokens(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+// WebhookMutation represents an operation that mutates the Webhook nodes in the graph.
+type WebhookMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	create_time       *time.Time
+	update_time       *time.Time
+	url               *string
+	event_types       *[]string
+	appendevent_types []string
+	task_id           *uuid.UUID
+	agent_id          *uuid.UUID
+	secret            *[]byte
+	enabled           *bool
+	description       *string
+	owner             *string
+	admin             *bool
+	clearedFields     map[string]struct{}
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+	cleareddeliveries bool
+	done              bool
+	oldValue          func(context.Context) (*Webhook, error)
+	predicates        []predicate.Webhook
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// webhookOption allows management of the mutation configuration using functional options.
+type webhookOption func(*WebhookMutation)
+
+// newWebhookMutation creates new mutation for the Webhook entity.
+func newWebhookMutation(c config, op Op, opts ...webhookOption) *WebhookMutation {
+	m := &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	for _, opt := range opts {
+		opt(m)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	return m
+}
+
+// withWebhookID sets the ID field of the mutation.
+func withWebhookID(id uuid.UUID) webhookOption {
+	return func(m *WebhookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Webhook
+		)
+		m.oldValue = func(ctx context.Context) (*Webhook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Webhook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.OutputTokens, nil
 }
 
-// AddOutputTokens adds i to the "output_tokens" field.
-func (m *TaskMutation) AddOutputTokens(i int64) {
-	if m.addoutput_tokens != nil {
-		*m.addoutput_tokens += i
-	} else {
-		m.addoutput_tokens = &i
+// withWebhook sets the old Webhook of the mutation.
+func withWebhook(node *Webhook) webhookOption {
+	return func(m *WebhookMutation) {
+		m.oldValue = func(context.Context) (*Webhook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
-func (m *TaskMutation) AddedOutputTokens() (r int64, exists bool) {
-	v := m.addoutput_tokens
-	if v == nil {
-		return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearOutputTokens clears the value of the "output_tokens" field.
-func (m *TaskMutation) ClearOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
-	m.clearedFields[task.FieldOutputTokens] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Webhook entities.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// OutputTokensCleared returns if the "output_tokens" field was cleared in this mutation.
-func (m *TaskMutation) OutputTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldOutputTokens]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetOutputTokens resets all changes to the "output_tokens" field.
-func (m *TaskMutation) ResetOutputTokens() {
-	m.output_tokens = nil
-	m.addoutput_tokens = nil
-	delete(m.clearedFields, task.FieldOutputTokens)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Webhook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCacheWriteTokens sets the "cache_write_tokens" field.
-func (m *TaskMutation) SetCacheWriteTokens(i int64) {
-	m.cache_write_tokens = &i
-	m.addcache_write_tokens = nil
+// SetCreateTime sets the "create_time" field.
+func (m *WebhookMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// CacheWriteTokens returns the value of the "cache_write_tokens" field in the mutation.
-func (m *TaskMutation) CacheWriteTokens() (r int64, exists bool) {
-	v := m.cache_write_tokens
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *WebhookMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheWriteTokens returns the old "cache_write_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCacheWriteTokens(ctx context.Context) (v int64, err error) {
+func (m *WebhookMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheWriteTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheWriteTokens requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheWriteTokens: %w", err)
-	}
-	return oldValue.CacheWriteTokens, nil
-}
-
-// AddCacheWriteTokens adds i to the "cache_write_tokens" field.
-func (m *TaskMutation) AddCacheWriteTokens(i int64) {
-	if m.addcache_write_tokens != nil {
-		*m.addcache_write_tokens += i
-	} else {
-		m.addcache_write_tokens = &i
-	}
-}
-
-// AddedCacheWriteTokens returns the value that was added to the "cache_write_tokens" field in this mutation.
-func (m *TaskMutation) AddedCacheWriteTokens() (r int64, exists bool) {
-	v := m.addcache_write_tokens
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return *v, true
-}
-
-// ClearCacheWriteTokens clears the value of the "cache_write_tokens" field.
-func (m *TaskMutation) ClearCacheWriteTokens() {
-	m.cache_write_tokens = nil
-	m.addcache_write_tokens = nil
-	m.clearedFields[task.FieldCacheWriteTokens] = struct{}{}
-}
-
-// CacheWriteTokensCleared returns if the "cache_write_tokens" field was cleared in this mutation.
-func (m *TaskMutation) CacheWriteTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldCacheWriteTokens]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetCacheWriteTokens resets all changes to the "cache_write_tokens" field.
-func (m *TaskMutation) ResetCacheWriteTokens() {
-	m.cache_write_tokens = nil
-	m.addcache_write_tokens = nil
-	delete(m.clearedFields, task.FieldCacheWriteTokens)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *WebhookMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetCacheReadTokens sets the "cache_read_tokens" field.
-func (m *TaskMutation) SetCacheReadTokens(i int64) {
-	m.cache_read_tokens = &i
-	m.addcache_read_tokens = nil
+// SetUpdateTime sets the "update_time" field.
+func (m *WebhookMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// CacheReadTokens returns the value of the "cache_read_tokens" field in the mutation.
-func (m *TaskMutation) CacheReadTokens() (r int64, exists bool) {
-	v := m.cache_read_tokens
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *WebhookMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCacheReadTokens returns the old "cache_read_tokens" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCacheReadTokens(ctx context.Context) (v int64, err error) {
+func (m *WebhookMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCacheReadTokens is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCacheReadTokens requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCacheReadTokens: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.CacheReadTokens, nil
+	return oldValue.UpdateTime, nil
 }
 
-// AddCacheReadTokens adds i to the "cache_read_tokens" field.
-func (m *TaskMutation) AddCacheReadTokens(i int64) {
-	if m.addcache_read_tokens != nil {
-		*m.addcache_read_tokens += i
-	} else {
-		m.addcache_read_tokens = &i
-	}
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *WebhookMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// AddedCacheReadTokens returns the value that was added to the "cache_read_tokens" field in this mutation.
-func (m *TaskMutation) AddedCacheReadTokens() (r int64, exists bool) {
-	v := m.addcache_read_tokens
+// SetURL sets the "url" field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearCacheReadTokens clears the value of the "cache_read_tokens" field.
-func (m *TaskMutation) ClearCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
-	m.clearedFields[task.FieldCacheReadTokens] = struct{}{}
-}
-
-// CacheReadTokensCleared returns if the "cache_read_tokens" field was cleared in this mutation.
-func (m *TaskMutation) CacheReadTokensCleared() bool {
-	_, ok := m.clearedFields[task.FieldCacheReadTokens]
-	return ok
+// OldURL returns the old "url" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
 }
 
-// ResetCacheReadTokens resets all changes to the "cache_read_tokens" field.
-func (m *TaskMutation) ResetCacheReadTokens() {
-	m.cache_read_tokens = nil
-	m.addcache_read_tokens = nil
-	delete(m.clearedFields, task.FieldCacheReadTokens)
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetCost sets the "cost" field.
-func (m *TaskMutation) SetCost(f float64) {
-	m.cost = &f
-	m.addcost = nil
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
 }
 
-// Cost returns the value of the "cost" field in the mutation.
-func (m *TaskMutation) Cost() (r float64, exists bool) {
-	v := m.cost
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCost returns the old "cost" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEventTypes returns the old "event_types" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldCost(ctx context.Context) (v float64, err error) {
+func (m *WebhookMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCost requires an ID field in the mutation")
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return oldValue.Cost, nil
+	return oldValue.EventTypes, nil
 }
 
-// AddCost adds f to the "cost" field.
-func (m *TaskMutation) AddCost(f float64) {
-	if m.addcost != nil {
-		*m.addcost += f
-	} else {
-		m.addcost = &f
-	}
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// AddedCost returns the value that was added to the "cost" field in this mutation.
-func (m *TaskMutation) AddedCost() (r float64, exists bool) {
-	v := m.addcost
-	if v == nil {
-		return
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendevent_types, true
 }
 
-// ClearCost clears the value of the "cost" field.
-func (m *TaskMutation) ClearCost() {
-	m.cost = nil
-	m.addcost = nil
-	m.clearedFields[task.FieldCost] = struct{}{}
+// ClearEventTypes clears the value of the "event_types" field.
+func (m *WebhookMutation) ClearEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	m.clearedFields[webhook.FieldEventTypes] = struct{}{}
 }
 
-// CostCleared returns if the "cost" field was cleared in this mutation.
-func (m *TaskMutation) CostCleared() bool {
-	_, ok := m.clearedFields[task.FieldCost]
+// EventTypesCleared returns if the "event_types" field was cleared in this mutation.
+func (m *WebhookMutation) EventTypesCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldEventTypes]
 	return ok
 }
 
-// ResetCost resets all changes to the "cost" field.
-func (m *TaskMutation) ResetCost() {
-	m.cost = nil
-	m.addcost = nil
-	delete(m.clearedFields, task.FieldCost)
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
+	delete(m.clearedFields, webhook.FieldEventTypes)
 }
 
-// SetTurns sets the "turns" field.
-func (m *TaskMutation) SetTurns(i int64) {
-	m.turns = &i
-	m.addturns = nil
+// SetTaskID sets the "task_id" field.
+func (m *WebhookMutation) SetTaskID(u uuid.UUID) {
+	m.task_id = &u
 }
 
-// Turns returns the value of the "turns" field in the mutation.
-func (m *TaskMutation) Turns() (r int64, exists bool) {
-	v := m.turns
+// TaskID returns the value of the "task_id" field in the mutation.
+func (m *WebhookMutation) TaskID() (r uuid.UUID, exists bool) {
+	v := m.task_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTurns returns the old "turns" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldTaskID returns the old "task_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldTurns(ctx context.Context) (v int64, err error) {
+func (m *WebhookMutation) OldTaskID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTurns is only allowed on UpdateOne operations")
+		return v, errors.New("OldTaskID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTurns requires an ID field in the mutation")
+		return v, errors.New("OldTaskID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTurns: %w", err)
+		return v, fmt.Errorf("querying old value for OldTaskID: %w", err)
 	}
-	return oldValue.Turns, nil
+	return oldValue.TaskID, nil
 }
 
-// AddTurns adds i to the "turns" field.
-func (m *TaskMutation) AddTurns(i int64) {
-	if m.addturns != nil {
-		*m.addturns += i
-	} else {
-		m.addturns = &i
-	}
+// ClearTaskID clears the value of the "task_id" field.
+func (m *WebhookMutation) ClearTaskID() {
+	m.task_id = nil
+	m.clearedFields[webhook.FieldTaskID] = struct{}{}
 }
 
-// AddedTurns returns the value that was added to the "turns" field in this mutation.
-func (m *TaskMutation) AddedTurns() (r int64, exists bool) {
-	v := m.addturns
-	if v == nil {
-		return
-	}
-	return *v, true
+// TaskIDCleared returns if the "task_id" field was cleared in this mutation.
+func (m *WebhookMutation) TaskIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldTaskID]
+	return ok
 }
 
-// ResetTurns resets all changes to the "turns" field.
-func (m *TaskMutation) ResetTurns() {
-	m.turns = nil
-	m.addturns = nil
+// ResetTaskID resets all changes to the "task_id" field.
+func (m *WebhookMutation) ResetTaskID() {
+	m.task_id = nil
+	delete(m.clearedFields, webhook.FieldTaskID)
 }
 
-// SetToolUses sets the "tool_uses" field.
-func (m *TaskMutation) SetToolUses(value map[string]int64) {
-	m.tool_uses = &value
+// SetAgentID sets the "agent_id" field.
+func (m *WebhookMutation) SetAgentID(u uuid.UUID) {
+	m.agent_id = &u
 }
 
-// ToolUses returns the value of the "tool_uses" field in the mutation.
-func (m *TaskMutation) ToolUses() (r map[string]int64, exists bool) {
-	v := m.tool_uses
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *WebhookMutation) AgentID() (r uuid.UUID, exists bool) {
+	v := m.agent_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToolUses returns the old "tool_uses" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldAgentID returns the old "agent_id" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldToolUses(ctx context.Context) (v map[string]int64, err error) {
+func (m *WebhookMutation) OldAgentID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToolUses is only allowed on UpdateOne operations")
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToolUses requires an ID field in the mutation")
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToolUses: %w", err)
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
 	}
-	return oldValue.ToolUses, nil
+	return oldValue.AgentID, nil
 }
 
-// ResetToolUses resets all changes to the "tool_uses" field.
-func (m *TaskMutation) ResetToolUses() {
-	m.tool_uses = nil
+// ClearAgentID clears the value of the "agent_id" field.
+func (m *WebhookMutation) ClearAgentID() {
+	m.agent_id = nil
+	m.clearedFields[webhook.FieldAgentID] = struct{}{}
 }
 
-// SetDesiredPhase sets the "desired_phase" field.
-func (m *TaskMutation) SetDesiredPhase(tp types.TaskPhase) {
-	m.desired_phase = &tp
+// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
+func (m *WebhookMutation) AgentIDCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldAgentID]
+	return ok
 }
 
-// DesiredPhase returns the value of the "desired_phase" field in the mutation.
-func (m *TaskMutation) DesiredPhase() (r types.TaskPhase, exists bool) {
-	v := m.desired_phase
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *WebhookMutation) ResetAgentID() {
+	m.agent_id = nil
+	delete(m.clearedFields, webhook.FieldAgentID)
+}
+
+// SetSecret sets the "secret" field.
+func (m *WebhookMutation) SetSecret(b []byte) {
+	m.secret = &b
+}
+
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookMutation) Secret() (r []byte, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDesiredPhase returns the old "desired_phase" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDesiredPhase(ctx context.Context) (v types.TaskPhase, err error) {
+func (m *WebhookMutation) OldSecret(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDesiredPhase is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDesiredPhase requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDesiredPhase: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.DesiredPhase, nil
+	return oldValue.Secret, nil
 }
 
-// ResetDesiredPhase resets all changes to the "desired_phase" field.
-func (m *TaskMutation) ResetDesiredPhase() {
-	m.desired_phase = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetPhase sets the "phase" field.
-func (m *TaskMutation) SetPhase(tp types.TaskPhase) {
-	m.phase = &tp
+// SetEnabled sets the "enabled" field.
+func (m *WebhookMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// Phase returns the value of the "phase" field in the mutation.
-func (m *TaskMutation) Phase() (r types.TaskPhase, exists bool) {
-	v := m.phase
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *WebhookMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPhase returns the old "phase" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldPhase(ctx context.Context) (v types.TaskPhase, err error) {
+func (m *WebhookMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhase is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhase requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhase: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.Phase, nil
+	return oldValue.Enabled, nil
 }
 
-// ResetPhase resets all changes to the "phase" field.
-func (m *TaskMutation) ResetPhase() {
-	m.phase = nil
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *WebhookMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *TaskMutation) SetDescription(s string) {
+func (m *WebhookMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *TaskMutation) Description() (r string, exists bool) {
+func (m *WebhookMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -4881,10 +13558,10 @@ func (m *TaskMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -4899,162 +13576,171 @@ func (m *TaskMutation) OldDescription(ctx context.Context) (v string, err error)
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *TaskMutation) ClearDescription() {
+func (m *WebhookMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[task.FieldDescription] = struct{}{}
+	m.clearedFields[webhook.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TaskMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[task.FieldDescription]
+func (m *WebhookMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *TaskMutation) ResetDescription() {
+func (m *WebhookMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, task.FieldDescription)
+	delete(m.clearedFields, webhook.FieldDescription)
 }
 
-// SetAgentID sets the "agent_id" field.
-func (m *TaskMutation) SetAgentID(u uuid.UUID) {
-	m.agent = &u
+// SetOwner sets the "owner" field.
+func (m *WebhookMutation) SetOwner(s string) {
+	m.owner = &s
 }
 
-// AgentID returns the value of the "agent_id" field in the mutation.
-func (m *TaskMutation) AgentID() (r uuid.UUID, exists bool) {
-	v := m.agent
+// Owner returns the value of the "owner" field in the mutation.
+func (m *WebhookMutation) Owner() (r string, exists bool) {
+	v := m.owner
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAgentID returns the old "agent_id" field's value of the Task entity.
-// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// OldOwner returns the old "owner" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TaskMutation) OldAgentID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookMutation) OldOwner(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAgentID requires an ID field in the mutation")
+		return v, errors.New("OldOwner requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
 	}
-	return oldValue.AgentID, nil
+	return oldValue.Owner, nil
 }
 
-// ClearAgentID clears the value of the "agent_id" field.
-func (m *TaskMutation) ClearAgentID() {
-	m.agent = nil
-	m.clearedFields[task.FieldAgentID] = struct{}{}
+// ClearOwner clears the value of the "owner" field.
+func (m *WebhookMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[webhook.FieldOwner] = struct{}{}
 }
 
-// AgentIDCleared returns if the "agent_id" field was cleared in this mutation.
-func (m *TaskMutation) AgentIDCleared() bool {
-	_, ok := m.clearedFields[task.FieldAgentID]
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *WebhookMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[webhook.FieldOwner]
 	return ok
 }
 
-// ResetAgentID resets all changes to the "agent_id" field.
-func (m *TaskMutation) ResetAgentID() {
-	m.agent = nil
-	delete(m.clearedFields, task.FieldAgentID)
+// ResetOwner resets all changes to the "owner" field.
+func (m *WebhookMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, webhook.FieldOwner)
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *TaskMutation) AddMessageIDs(ids ...uuid.UUID) {
-	if m.messages == nil {
-		m.messages = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+// SetAdmin sets the "admin" field.
+func (m *WebhookMutation) SetAdmin(b bool) {
+	m.admin = &b
+}
+
+// Admin returns the value of the "admin" field in the mutation.
+func (m *WebhookMutation) Admin() (r bool, exists bool) {
+	v := m.admin
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *TaskMutation) ClearMessages() {
-	m.clearedmessages = true
+// OldAdmin returns the old "admin" field's value of the Webhook entity.
+// If the Webhook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookMutation) OldAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdmin: %w", err)
+	}
+	return oldValue.Admin, nil
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *TaskMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// ResetAdmin resets all changes to the "admin" field.
+func (m *WebhookMutation) ResetAdmin() {
+	m.admin = nil
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *TaskMutation) RemoveMessageIDs(ids ...uuid.UUID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[uuid.UUID]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *TaskMutation) RemovedMessagesIDs() (ids []uuid.UUID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+		m.deliveries[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *TaskMutation) MessagesIDs() (ids []uuid.UUID) {
-	for id := range m.messages {
-		ids = append(ids, id)
-	}
-	return
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *TaskMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// ClearAgent clears the "agent" edge to the Agent entity.
-func (m *TaskMutation) ClearAgent() {
-	m.clearedagent = true
-	m.clearedFields[task.FieldAgentID] = struct{}{}
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
 }
 
-// AgentCleared reports if the "agent" edge to the Agent entity was cleared.
-func (m *TaskMutation) AgentCleared() bool {
-	return m.AgentIDCleared() || m.clearedagent
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AgentIDs returns the "agent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AgentID instead. It exists only for internal usage by the builders.
-func (m *TaskMutation) AgentIDs() (ids []uuid.UUID) {
-	if id := m.agent; id != nil {
-		ids = append(ids, *id)
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAgent resets all changes to the "agent" edge.
-func (m *TaskMutation) ResetAgent() {
-	m.agent = nil
-	m.clearedagent = false
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the TaskMutation builder.
-func (m *TaskMutation) Where(ps ...predicate.Task) {
+// Where appends a list predicates to the WebhookMutation builder.
+func (m *WebhookMutation) Where(ps ...predicate.Webhook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TaskMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Task, len(ps))
+func (m *WebhookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Webhook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5062,66 +13748,57 @@ func (m *TaskMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TaskMutation) Op() Op {
+func (m *WebhookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TaskMutation) SetOp(op Op) {
+func (m *WebhookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Task).
-func (m *TaskMutation) Type() string {
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.create_time != nil {
-		fields = append(fields, task.FieldCreateTime)
+		fields = append(fields, webhook.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, task.FieldUpdateTime)
-	}
-	if m.project_directory != nil {
-		fields = append(fields, task.FieldProjectDirectory)
-	}
-	if m.input_tokens != nil {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.output_tokens != nil {
-		fields = append(fields, task.FieldOutputTokens)
+		fields = append(fields, webhook.FieldUpdateTime)
 	}
-	if m.cache_write_tokens != nil {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.cache_read_tokens != nil {
-		fields = append(fields, task.FieldCacheReadTokens)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
 	}
-	if m.cost != nil {
-		fields = append(fields, task.FieldCost)
+	if m.event_types != nil {
+		fields = append(fields, webhook.FieldEventTypes)
 	}
-	if m.turns != nil {
-		fields = append(fields, task.FieldTurns)
+	if m.task_id != nil {
+		fields = append(fields, webhook.FieldTaskID)
 	}
-	if m.tool_uses != nil {
-		fields = append(fields, task.FieldToolUses)
+	if m.agent_id != nil {
+		fields = append(fields, webhook.FieldAgentID)
 	}
-	if m.desired_phase != nil {
-		fields = append(fields, task.FieldDesiredPhase)
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
 	}
-	if m.phase != nil {
-		fields = append(fields, task.FieldPhase)
+	if m.enabled != nil {
+		fields = append(fields, webhook.FieldEnabled)
 	}
 	if m.description != nil {
-		fields = append(fields, task.FieldDescription)
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.agent != nil {
-		fields = append(fields, task.FieldAgentID)
+	if m.owner != nil {
+		fields = append(fields, webhook.FieldOwner)
+	}
+	if m.admin != nil {
+		fields = append(fields, webhook.FieldAdmin)
 	}
 	return fields
 }
@@ -5129,447 +13806,305 @@ func (m *TaskMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TaskMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case task.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		return m.CreateTime()
-	case task.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		return m.UpdateTime()
-	case task.FieldProjectDirectory:
-		return m.ProjectDirectory()
-	case task.FieldInputTokens:
-		return m.InputTokens()
-	case task.FieldOutputTokens:
-		return m.OutputTokens()
-	case task.FieldCacheWriteTokens:
-		return m.CacheWriteTokens()
-	case task.FieldCacheReadTokens:
-		return m.CacheReadTokens()
-	case task.FieldCost:
-		return m.Cost()
-	case task.FieldTurns:
-		return m.Turns()
-	case task.FieldToolUses:
-		return m.ToolUses()
-	case task.FieldDesiredPhase:
-		return m.DesiredPhase()
-	case task.FieldPhase:
-		return m.Phase()
-	case task.FieldDescription:
-		return m.Description()
-	case task.FieldAgentID:
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldEventTypes:
+		return m.EventTypes()
+	case webhook.FieldTaskID:
+		return m.TaskID()
+	case webhook.FieldAgentID:
 		return m.AgentID()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldEnabled:
+		return m.Enabled()
+	case webhook.FieldDescription:
+		return m.Description()
+	case webhook.FieldOwner:
+		return m.Owner()
+	case webhook.FieldAdmin:
+		return m.Admin()
 	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case task.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case task.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case task.FieldProjectDirectory:
-		return m.OldProjectDirectory(ctx)
-	case task.FieldInputTokens:
-		return m.OldInputTokens(ctx)
-	case task.FieldOutputTokens:
-		return m.OldOutputTokens(ctx)
-	case task.FieldCacheWriteTokens:
-		return m.OldCacheWriteTokens(ctx)
-	case task.FieldCacheReadTokens:
-		return m.OldCacheReadTokens(ctx)
-	case task.FieldCost:
-		return m.OldCost(ctx)
-	case task.FieldTurns:
-		return m.OldTurns(ctx)
-	case task.FieldToolUses:
-		return m.OldToolUses(ctx)
-	case task.FieldDesiredPhase:
-		return m.OldDesiredPhase(ctx)
-	case task.FieldPhase:
-		return m.OldPhase(ctx)
-	case task.FieldDescription:
-		return m.OldDescription(ctx)
-	case task.FieldAgentID:
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WebhookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case webhook.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case webhook.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case webhook.FieldURL:
+		return m.OldURL(ctx)
+	case webhook.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhook.FieldTaskID:
+		return m.OldTaskID(ctx)
+	case webhook.FieldAgentID:
 		return m.OldAgentID(ctx)
+	case webhook.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhook.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case webhook.FieldDescription:
+		return m.OldDescription(ctx)
+	case webhook.FieldOwner:
+		return m.OldOwner(ctx)
+	case webhook.FieldAdmin:
+		return m.OldAdmin(ctx)
 	}
-	return nil, fmt.Errorf("unknown Task field %s", name)
+	return nil, fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case task.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case task.FieldProjectDirectory:
+	case webhook.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProjectDirectory(v)
-		return nil
-	case task.FieldInputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInputTokens(v)
-		return nil
-	case task.FieldOutputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOutputTokens(v)
-		return nil
-	case task.FieldCacheWriteTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCacheWriteTokens(v)
+		m.SetURL(v)
 		return nil
-	case task.FieldCacheReadTokens:
-		v, ok := value.(int64)
+	case webhook.FieldEventTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCacheReadTokens(v)
+		m.SetEventTypes(v)
 		return nil
-	case task.FieldCost:
-		v, ok := value.(float64)
+	case webhook.FieldTaskID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCost(v)
+		m.SetTaskID(v)
 		return nil
-	case task.FieldTurns:
-		v, ok := value.(int64)
+	case webhook.FieldAgentID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTurns(v)
+		m.SetAgentID(v)
 		return nil
-	case task.FieldToolUses:
-		v, ok := value.(map[string]int64)
+	case webhook.FieldSecret:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToolUses(v)
+		m.SetSecret(v)
 		return nil
-	case task.FieldDesiredPhase:
-		v, ok := value.(types.TaskPhase)
+	case webhook.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDesiredPhase(v)
+		m.SetEnabled(v)
 		return nil
-	case task.FieldPhase:
-		v, ok := value.(types.TaskPhase)
+	case webhook.FieldDescription:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhase(v)
+		m.SetDescription(v)
 		return nil
-	case task.FieldDescription:
+	case webhook.FieldOwner:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetOwner(v)
 		return nil
-	case task.FieldAgentID:
-		v, ok := value.(uuid.UUID)
+	case webhook.FieldAdmin:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAgentID(v)
+		m.SetAdmin(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TaskMutation) AddedFields() []string {
-	var fields []string
-	if m.addinput_tokens != nil {
-		fields = append(fields, task.FieldInputTokens)
-	}
-	if m.addoutput_tokens != nil {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.addcache_write_tokens != nil {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.addcache_read_tokens != nil {
-		fields = append(fields, task.FieldCacheReadTokens)
-	}
-	if m.addcost != nil {
-		fields = append(fields, task.FieldCost)
-	}
-	if m.addturns != nil {
-		fields = append(fields, task.FieldTurns)
-	}
-	return fields
+func (m *WebhookMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TaskMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case task.FieldInputTokens:
-		return m.AddedInputTokens()
-	case task.FieldOutputTokens:
-		return m.AddedOutputTokens()
-	case task.FieldCacheWriteTokens:
-		return m.AddedCacheWriteTokens()
-	case task.FieldCacheReadTokens:
-		return m.AddedCacheReadTokens()
-	case task.FieldCost:
-		return m.AddedCost()
-	case task.FieldTurns:
-		return m.AddedTurns()
-	}
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TaskMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case task.FieldInputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddInputTokens(v)
-		return nil
-	case task.FieldOutputTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddOutputTokens(v)
-		return nil
-	case task.FieldCacheWriteTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheWriteTokens(v)
-		return nil
-	case task.FieldCacheReadTokens:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCacheReadTokens(v)
-		return nil
-	case task.FieldCost:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCost(v)
-		return nil
-	case task.FieldTurns:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTurns(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Task numeric field %s", name)
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TaskMutation) ClearedFields() []string {
+func (m *WebhookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(task.FieldProjectDirectory) {
-		fields = append(fields, task.FieldProjectDirectory)
-	}
-	if m.FieldCleared(task.FieldInputTokens) {
-		fields = append(fields, task.FieldInputTokens)
+	if m.FieldCleared(webhook.FieldEventTypes) {
+		fields = append(fields, webhook.FieldEventTypes)
 	}
-	if m.FieldCleared(task.FieldOutputTokens) {
-		fields = append(fields, task.FieldOutputTokens)
-	}
-	if m.FieldCleared(task.FieldCacheWriteTokens) {
-		fields = append(fields, task.FieldCacheWriteTokens)
-	}
-	if m.FieldCleared(task.FieldCacheReadTokens) {
-		fields = append(fields, task.FieldCacheReadTokens)
+	if m.FieldCleared(webhook.FieldTaskID) {
+		fields = append(fields, webhook.FieldTaskID)
 	}
-	if m.FieldCleared(task.FieldCost) {
-		fields = append(fields, task.FieldCost)
+	if m.FieldCleared(webhook.FieldAgentID) {
+		fields = append(fields, webhook.FieldAgentID)
 	}
-	if m.FieldCleared(task.FieldDescription) {
-		fields = append(fields, task.FieldDescription)
+	if m.FieldCleared(webhook.FieldDescription) {
+		fields = append(fields, webhook.FieldDescription)
 	}
-	if m.FieldCleared(task.FieldAgentID) {
-		fields = append(fields, task.FieldAgentID)
+	if m.FieldCleared(webhook.FieldOwner) {
+		fields = append(fields, webhook.FieldOwner)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TaskMutation) FieldCleared(name string) bool {
+func (m *WebhookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TaskMutation) ClearField(name string) error {
+func (m *WebhookMutation) ClearField(name string) error {
 	switch name {
-	case task.FieldProjectDirectory:
-		m.ClearProjectDirectory()
-		return nil
-	case task.FieldInputTokens:
-		m.ClearInputTokens()
-		return nil
-	case task.FieldOutputTokens:
-		m.ClearOutputTokens()
+	case webhook.FieldEventTypes:
+		m.ClearEventTypes()
 		return nil
-	case task.FieldCacheWriteTokens:
-		m.ClearCacheWriteTokens()
-		return nil
-	case task.FieldCacheReadTokens:
-		m.ClearCacheReadTokens()
+	case webhook.FieldTaskID:
+		m.ClearTaskID()
 		return nil
-	case task.FieldCost:
-		m.ClearCost()
+	case webhook.FieldAgentID:
+		m.ClearAgentID()
 		return nil
-	case task.FieldDescription:
+	case webhook.FieldDescription:
 		m.ClearDescription()
 		return nil
-	case task.FieldAgentID:
-		m.ClearAgentID()
+	case webhook.FieldOwner:
+		m.ClearOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown Task nullable field %s", name)
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TaskMutation) ResetField(name string) error {
+func (m *WebhookMutation) ResetField(name string) error {
 	switch name {
-	case task.FieldCreateTime:
+	case webhook.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case task.FieldUpdateTime:
+	case webhook.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case task.FieldProjectDirectory:
-		m.ResetProjectDirectory()
-		return nil
-	case task.FieldInputTokens:
-		m.ResetInputTokens()
-		return nil
-	case task.FieldOutputTokens:
-		m.ResetOutputTokens()
-		return nil
-	case task.FieldCacheWriteTokens:
-		m.ResetCacheWriteTokens()
-		return nil
-	case task.FieldCacheReadTokens:
-		m.ResetCacheReadTokens()
+	case webhook.FieldURL:
+		m.ResetURL()
 		return nil
-	case task.FieldCost:
-		m.ResetCost()
+	case webhook.FieldEventTypes:
+		m.ResetEventTypes()
 		return nil
-	case task.FieldTurns:
-		m.ResetTurns()
+	case webhook.FieldTaskID:
+		m.ResetTaskID()
 		return nil
-	case task.FieldToolUses:
-		m.ResetToolUses()
+	case webhook.FieldAgentID:
+		m.ResetAgentID()
 		return nil
-	case task.FieldDesiredPhase:
-		m.ResetDesiredPhase()
+	case webhook.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case task.FieldPhase:
-		m.ResetPhase()
+	case webhook.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case task.FieldDescription:
+	case webhook.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case task.FieldAgentID:
-		m.ResetAgentID()
+	case webhook.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case webhook.FieldAdmin:
+		m.ResetAdmin()
 		return nil
 	}
-	return fmt.Errorf("unknown Task field %s", name)
+	return fmt.Errorf("unknown Webhook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.messages != nil {
-		edges = append(edges, task.EdgeMessages)
-	}
-	if m.agent != nil {
-		edges = append(edges, task.EdgeAgent)
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TaskMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
-	case task.EdgeAgent:
-		if id := m.agent; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, task.EdgeMessages)
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case task.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5578,84 +14113,82 @@ func (m *TaskMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessages {
-		edges = append(edges, task.EdgeMessages)
-	}
-	if m.clearedagent {
-		edges = append(edges, task.EdgeAgent)
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddeliveries {
+		edges = append(edges, webhook.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TaskMutation) EdgeCleared(name string) bool {
+func (m *WebhookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case task.EdgeMessages:
-		return m.clearedmessages
-	case task.EdgeAgent:
-		return m.clearedagent
+	case webhook.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TaskMutation) ClearEdge(name string) error {
+func (m *WebhookMutation) ClearEdge(name string) error {
 	switch name {
-	case task.EdgeAgent:
-		m.ClearAgent()
-		return nil
 	}
-	return fmt.Errorf("unknown Task unique edge %s", name)
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TaskMutation) ResetEdge(name string) error {
+func (m *WebhookMutation) ResetEdge(name string) error {
 	switch name {
-	case task.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case task.EdgeAgent:
-		m.ResetAgent()
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown Task edge %s", name)
+	return fmt.Errorf("unknown Webhook edge %s", name)
 }
 
-// TokenMutation represents an operation that mutates the Token nodes in the graph.
-type TokenMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	create_time   *time.Time
-	update_time   *time.Time
-	name          *string
-	_type         *types.TokenType
-	token_hash    *[]byte
-	description   *string
-	expires_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Token, error)
-	predicates    []predicate.Token
-}
-
-var _ ent.Mutation = (*TokenMutation)(nil)
-
-// tokenOption allows management of the mutation configuration using functional options.
-type tokenOption func(*TokenMutation)
-
-// newTokenMutation creates new mutation for the Token entity.
-func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
-	m := &TokenMutation{
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	create_time             *time.Time
+	update_time             *time.Time
+	event_type              *string
+	event_sequence          *int64
+	addevent_sequence       *int64
+	payload                 *[]byte
+	state                   *types.WebhookDeliveryState
+	attempts                *int
+	addattempts             *int
+	next_attempt_time       *time.Time
+	last_attempt_time       *time.Time
+	response_status_code    *int
+	addresponse_status_code *int
+	last_error              *string
+	clearedFields           map[string]struct{}
+	webhook                 *uuid.UUID
+	clearedwebhook          bool
+	done                    bool
+	oldValue                func(context.Context) (*WebhookDelivery, error)
+	predicates              []predicate.WebhookDelivery
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
+
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeToken,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5664,20 +14197,20 @@ func newTokenMutation(c config, op Op, opts ...tokenOption) *TokenMutation {
 	return m
 }
 
-// withTokenID sets the ID field of the mutation.
-func withTokenID(id uuid.UUID) tokenOption {
-	return func(m *TokenMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Token
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Token, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Token.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5686,10 +14219,10 @@ func withTokenID(id uuid.UUID) tokenOption {
 	}
 }
 
-// withToken sets the old Token of the mutation.
-func withToken(node *Token) tokenOption {
-	return func(m *TokenMutation) {
-		m.oldValue = func(context.Context) (*Token, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5698,7 +14231,7 @@ func withToken(node *Token) tokenOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5706,7 +14239,7 @@ func (m TokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("memory: mutation is not running in a transaction")
 	}
@@ -5716,14 +14249,14 @@ func (m TokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Token entities.
-func (m *TokenMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5734,7 +14267,7 @@ func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5743,19 +14276,19 @@ func (m *TokenMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Token.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TokenMutation) SetCreateTime(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenMutation) CreateTime() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -5763,10 +14296,10 @@ func (m *TokenMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -5781,17 +14314,17 @@ func (m *TokenMutation) OldCreateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenMutation) ResetCreateTime() {
+func (m *WebhookDeliveryMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TokenMutation) SetUpdateTime(t time.Time) {
+func (m *WebhookDeliveryMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -5799,10 +14332,10 @@ func (m *TokenMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -5817,212 +14350,520 @@ func (m *TokenMutation) OldUpdateTime(ctx context.Context) (v time.Time, err err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenMutation) ResetUpdateTime() {
+func (m *WebhookDeliveryMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetName sets the "name" field.
-func (m *TokenMutation) SetName(s string) {
-	m.name = &s
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *TokenMutation) Name() (r string, exists bool) {
-	v := m.name
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.EventType, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *TokenMutation) ResetName() {
-	m.name = nil
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// SetType sets the "type" field.
-func (m *TokenMutation) SetType(tt types.TokenType) {
-	m._type = &tt
+// SetEventSequence sets the "event_sequence" field.
+func (m *WebhookDeliveryMutation) SetEventSequence(i int64) {
+	m.event_sequence = &i
+	m.addevent_sequence = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *TokenMutation) GetType() (r types.TokenType, exists bool) {
-	v := m._type
+// EventSequence returns the value of the "event_sequence" field in the mutation.
+func (m *WebhookDeliveryMutation) EventSequence() (r int64, exists bool) {
+	v := m.event_sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldEventSequence returns the old "event_sequence" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldType(ctx context.Context) (v types.TokenType, err error) {
+func (m *WebhookDeliveryMutation) OldEventSequence(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldEventSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventSequence: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.EventSequence, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TokenMutation) ResetType() {
-	m._type = nil
+// AddEventSequence adds i to the "event_sequence" field.
+func (m *WebhookDeliveryMutation) AddEventSequence(i int64) {
+	if m.addevent_sequence != nil {
+		*m.addevent_sequence += i
+	} else {
+		m.addevent_sequence = &i
+	}
 }
 
-// SetTokenHash sets the "token_hash" field.
-func (m *TokenMutation) SetTokenHash(b []byte) {
-	m.token_hash = &b
+// AddedEventSequence returns the value that was added to the "event_sequence" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedEventSequence() (r int64, exists bool) {
+	v := m.addevent_sequence
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TokenHash returns the value of the "token_hash" field in the mutation.
-func (m *TokenMutation) TokenHash() (r []byte, exists bool) {
-	v := m.token_hash
+// ClearEventSequence clears the value of the "event_sequence" field.
+func (m *WebhookDeliveryMutation) ClearEventSequence() {
+	m.event_sequence = nil
+	m.addevent_sequence = nil
+	m.clearedFields[webhookdelivery.FieldEventSequence] = struct{}{}
+}
+
+// EventSequenceCleared returns if the "event_sequence" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) EventSequenceCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldEventSequence]
+	return ok
+}
+
+// ResetEventSequence resets all changes to the "event_sequence" field.
+func (m *WebhookDeliveryMutation) ResetEventSequence() {
+	m.event_sequence = nil
+	m.addevent_sequence = nil
+	delete(m.clearedFields, webhookdelivery.FieldEventSequence)
+}
+
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenHash returns the old "token_hash" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldTokenHash(ctx context.Context) (v []byte, err error) {
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetState sets the "state" field.
+func (m *WebhookDeliveryMutation) SetState(tds types.WebhookDeliveryState) {
+	m.state = &tds
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *WebhookDeliveryMutation) State() (r types.WebhookDeliveryState, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldState(ctx context.Context) (v types.WebhookDeliveryState, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *WebhookDeliveryMutation) ResetState() {
+	m.state = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptTime(t time.Time) {
+	m.next_attempt_time = &t
+}
+
+// NextAttemptTime returns the value of the "next_attempt_time" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptTime() (r time.Time, exists bool) {
+	v := m.next_attempt_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptTime returns the old "next_attempt_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldNextAttemptTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptTime: %w", err)
+	}
+	return oldValue.NextAttemptTime, nil
+}
+
+// ResetNextAttemptTime resets all changes to the "next_attempt_time" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptTime() {
+	m.next_attempt_time = nil
+}
+
+// SetLastAttemptTime sets the "last_attempt_time" field.
+func (m *WebhookDeliveryMutation) SetLastAttemptTime(t time.Time) {
+	m.last_attempt_time = &t
+}
+
+// LastAttemptTime returns the value of the "last_attempt_time" field in the mutation.
+func (m *WebhookDeliveryMutation) LastAttemptTime() (r time.Time, exists bool) {
+	v := m.last_attempt_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastAttemptTime returns the old "last_attempt_time" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldLastAttemptTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastAttemptTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastAttemptTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastAttemptTime: %w", err)
+	}
+	return oldValue.LastAttemptTime, nil
+}
+
+// ClearLastAttemptTime clears the value of the "last_attempt_time" field.
+func (m *WebhookDeliveryMutation) ClearLastAttemptTime() {
+	m.last_attempt_time = nil
+	m.clearedFields[webhookdelivery.FieldLastAttemptTime] = struct{}{}
+}
+
+// LastAttemptTimeCleared returns if the "last_attempt_time" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastAttemptTimeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastAttemptTime]
+	return ok
+}
+
+// ResetLastAttemptTime resets all changes to the "last_attempt_time" field.
+func (m *WebhookDeliveryMutation) ResetLastAttemptTime() {
+	m.last_attempt_time = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastAttemptTime)
+}
+
+// SetResponseStatusCode sets the "response_status_code" field.
+func (m *WebhookDeliveryMutation) SetResponseStatusCode(i int) {
+	m.response_status_code = &i
+	m.addresponse_status_code = nil
+}
+
+// ResponseStatusCode returns the value of the "response_status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCode() (r int, exists bool) {
+	v := m.response_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseStatusCode returns the old "response_status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldResponseStatusCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseStatusCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseStatusCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldResponseStatusCode: %w", err)
 	}
-	return oldValue.TokenHash, nil
+	return oldValue.ResponseStatusCode, nil
 }
 
-// ResetTokenHash resets all changes to the "token_hash" field.
-func (m *TokenMutation) ResetTokenHash() {
-	m.token_hash = nil
+// AddResponseStatusCode adds i to the "response_status_code" field.
+func (m *WebhookDeliveryMutation) AddResponseStatusCode(i int) {
+	if m.addresponse_status_code != nil {
+		*m.addresponse_status_code += i
+	} else {
+		m.addresponse_status_code = &i
+	}
 }
 
-// SetDescription sets the "description" field.
-func (m *TokenMutation) SetDescription(s string) {
-	m.description = &s
+// AddedResponseStatusCode returns the value that was added to the "response_status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatusCode() (r int, exists bool) {
+	v := m.addresponse_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *TokenMutation) Description() (r string, exists bool) {
-	v := m.description
+// ClearResponseStatusCode clears the value of the "response_status_code" field.
+func (m *WebhookDeliveryMutation) ClearResponseStatusCode() {
+	m.response_status_code = nil
+	m.addresponse_status_code = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatusCode] = struct{}{}
+}
+
+// ResponseStatusCodeCleared returns if the "response_status_code" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCodeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatusCode]
+	return ok
+}
+
+// ResetResponseStatusCode resets all changes to the "response_status_code" field.
+func (m *WebhookDeliveryMutation) ResetResponseStatusCode() {
+	m.response_status_code = nil
+	m.addresponse_status_code = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatusCode)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.LastError, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *TokenMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[token.FieldDescription] = struct{}{}
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *TokenMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[token.FieldDescription]
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
 	return ok
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *TokenMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, token.FieldDescription)
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *TokenMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetWebhookID sets the "webhook_id" field.
+func (m *WebhookDeliveryMutation) SetWebhookID(u uuid.UUID) {
+	m.webhook = &u
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *TokenMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// WebhookID returns the value of the "webhook_id" field in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (r uuid.UUID, exists bool) {
+	v := m.webhook
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Token entity.
-// If the Token object wasn't provided to the builder, the object is fetched from the database.
+// OldWebhookID returns the old "webhook_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldWebhookID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldWebhookID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldWebhookID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldWebhookID: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.WebhookID, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *TokenMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ResetWebhookID resets all changes to the "webhook_id" field.
+func (m *WebhookDeliveryMutation) ResetWebhookID() {
+	m.webhook = nil
 }
 
-// Where appends a list predicates to the TokenMutation builder.
-func (m *TokenMutation) Where(ps ...predicate.Token) {
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
+	m.clearedFields[webhookdelivery.FieldWebhookID] = struct{}{}
+}
+
+// WebhookCleared reports if the "webhook" edge to the Webhook entity was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
+}
+
+// WebhookIDs returns the "webhook" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWebhook resets all changes to the "webhook" edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
+}
+
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Token, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6030,45 +14871,60 @@ func (m *TokenMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Token).
-func (m *TokenMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 12)
 	if m.create_time != nil {
-		fields = append(fields, token.FieldCreateTime)
+		fields = append(fields, webhookdelivery.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, token.FieldUpdateTime)
+		fields = append(fields, webhookdelivery.FieldUpdateTime)
 	}
-	if m.name != nil {
-		fields = append(fields, token.FieldName)
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m._type != nil {
-		fields = append(fields, token.FieldType)
+	if m.event_sequence != nil {
+		fields = append(fields, webhookdelivery.FieldEventSequence)
 	}
-	if m.token_hash != nil {
-		fields = append(fields, token.FieldTokenHash)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.description != nil {
-		fields = append(fields, token.FieldDescription)
+	if m.state != nil {
+		fields = append(fields, webhookdelivery.FieldState)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, token.FieldExpiresAt)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_time != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptTime)
+	}
+	if m.last_attempt_time != nil {
+		fields = append(fields, webhookdelivery.FieldLastAttemptTime)
+	}
+	if m.response_status_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatusCode)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.webhook != nil {
+		fields = append(fields, webhookdelivery.FieldWebhookID)
 	}
 	return fields
 }
@@ -6076,22 +14932,32 @@ func (m *TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.CreateTime()
-	case token.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.UpdateTime()
-	case token.FieldName:
-		return m.Name()
-	case token.FieldType:
-		return m.GetType()
-	case token.FieldTokenHash:
-		return m.TokenHash()
-	case token.FieldDescription:
-		return m.Description()
-	case token.FieldExpiresAt:
-		return m.ExpiresAt()
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldEventSequence:
+		return m.EventSequence()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldState:
+		return m.State()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptTime:
+		return m.NextAttemptTime()
+	case webhookdelivery.FieldLastAttemptTime:
+		return m.LastAttemptTime()
+	case webhookdelivery.FieldResponseStatusCode:
+		return m.ResponseStatusCode()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldWebhookID:
+		return m.WebhookID()
 	}
 	return nil, false
 }
@@ -6099,207 +14965,350 @@ func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case token.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case token.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case token.FieldName:
-		return m.OldName(ctx)
-	case token.FieldType:
-		return m.OldType(ctx)
-	case token.FieldTokenHash:
-		return m.OldTokenHash(ctx)
-	case token.FieldDescription:
-		return m.OldDescription(ctx)
-	case token.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Token field %s", name)
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldEventSequence:
+		return m.OldEventSequence(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldState:
+		return m.OldState(ctx)
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldNextAttemptTime:
+		return m.OldNextAttemptTime(ctx)
+	case webhookdelivery.FieldLastAttemptTime:
+		return m.OldLastAttemptTime(ctx)
+	case webhookdelivery.FieldResponseStatusCode:
+		return m.OldResponseStatusCode(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldWebhookID:
+		return m.OldWebhookID(ctx)
+	}
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case token.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case token.FieldName:
+	case webhookdelivery.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEventType(v)
 		return nil
-	case token.FieldType:
-		v, ok := value.(types.TokenType)
+	case webhookdelivery.FieldEventSequence:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetEventSequence(v)
 		return nil
-	case token.FieldTokenHash:
+	case webhookdelivery.FieldPayload:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenHash(v)
+		m.SetPayload(v)
 		return nil
-	case token.FieldDescription:
-		v, ok := value.(string)
+	case webhookdelivery.FieldState:
+		v, ok := value.(types.WebhookDeliveryState)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetState(v)
 		return nil
-	case token.FieldExpiresAt:
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetNextAttemptTime(v)
+		return nil
+	case webhookdelivery.FieldLastAttemptTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAttemptTime(v)
+		return nil
+	case webhookdelivery.FieldResponseStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatusCode(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldWebhookID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWebhookID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addevent_sequence != nil {
+		fields = append(fields, webhookdelivery.FieldEventSequence)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status_code != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatusCode)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldEventSequence:
+		return m.AddedEventSequence()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatusCode:
+		return m.AddedResponseStatusCode()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldEventSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventSequence(v)
+		return nil
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatusCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatusCode(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Token numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(token.FieldDescription) {
-		fields = append(fields, token.FieldDescription)
+	if m.FieldCleared(webhookdelivery.FieldEventSequence) {
+		fields = append(fields, webhookdelivery.FieldEventSequence)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastAttemptTime) {
+		fields = append(fields, webhookdelivery.FieldLastAttemptTime)
+	}
+	if m.FieldCleared(webhookdelivery.FieldResponseStatusCode) {
+		fields = append(fields, webhookdelivery.FieldResponseStatusCode)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case token.FieldDescription:
-		m.ClearDescription()
+	case webhookdelivery.FieldEventSequence:
+		m.ClearEventSequence()
+		return nil
+	case webhookdelivery.FieldLastAttemptTime:
+		m.ClearLastAttemptTime()
+		return nil
+	case webhookdelivery.FieldResponseStatusCode:
+		m.ClearResponseStatusCode()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
 	}
-	return fmt.Errorf("unknown Token nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case token.FieldCreateTime:
+	case webhookdelivery.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case token.FieldUpdateTime:
+	case webhookdelivery.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case token.FieldName:
-		m.ResetName()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case token.FieldType:
-		m.ResetType()
+	case webhookdelivery.FieldEventSequence:
+		m.ResetEventSequence()
 		return nil
-	case token.FieldTokenHash:
-		m.ResetTokenHash()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case token.FieldDescription:
-		m.ResetDescription()
+	case webhookdelivery.FieldState:
+		m.ResetState()
 		return nil
-	case token.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case webhookdelivery.FieldNextAttemptTime:
+		m.ResetNextAttemptTime()
+		return nil
+	case webhookdelivery.FieldLastAttemptTime:
+		m.ResetLastAttemptTime()
+		return nil
+	case webhookdelivery.FieldResponseStatusCode:
+		m.ResetResponseStatusCode()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldWebhookID:
+		m.ResetWebhookID()
 		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Token unique edge %s", name)
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Token edge %s", name)
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
+	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -9,17 +9,35 @@ import (
 // Agent is the predicate function for agent builders.
 type Agent func(*sql.Selector)
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// EventLogEntry is the predicate function for eventlogentry builders.
+type EventLogEntry func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
 // Model is the predicate function for model builders.
 type Model func(*sql.Selector)
 
+// ModelCallTrace is the predicate function for modelcalltrace builders.
+type ModelCallTrace func(*sql.Selector)
+
 // ModelProvider is the predicate function for modelprovider builders.
 type ModelProvider func(*sql.Selector)
 
+// ModelProviderKey is the predicate function for modelproviderkey builders.
+type ModelProviderKey func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
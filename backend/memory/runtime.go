@@ -6,12 +6,18 @@ import (
 	"time"
 
 	"github.com/furisto/construct/backend/memory/agent"
+	"github.com/furisto/construct/backend/memory/auditevent"
+	"github.com/furisto/construct/backend/memory/eventlogentry"
 	"github.com/furisto/construct/backend/memory/message"
 	"github.com/furisto/construct/backend/memory/model"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/modelprovider"
+	"github.com/furisto/construct/backend/memory/modelproviderkey"
 	"github.com/furisto/construct/backend/memory/schema"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/furisto/construct/backend/memory/token"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
 	"github.com/google/uuid"
 )
 
@@ -46,6 +52,44 @@ func init() {
 	agentDescID := agentFields[0].Descriptor()
 	// agent.DefaultID holds the default value on creation for the id field.
 	agent.DefaultID = agentDescID.Default.(func() uuid.UUID)
+	auditeventMixin := schema.AuditEvent{}.Mixin()
+	auditeventMixinFields0 := auditeventMixin[0].Fields()
+	_ = auditeventMixinFields0
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreateTime is the schema descriptor for create_time field.
+	auditeventDescCreateTime := auditeventMixinFields0[0].Descriptor()
+	// auditevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	auditevent.DefaultCreateTime = auditeventDescCreateTime.Default.(func() time.Time)
+	// auditeventDescUpdateTime is the schema descriptor for update_time field.
+	auditeventDescUpdateTime := auditeventMixinFields0[1].Descriptor()
+	// auditevent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	auditevent.DefaultUpdateTime = auditeventDescUpdateTime.Default.(func() time.Time)
+	// auditevent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	auditevent.UpdateDefaultUpdateTime = auditeventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventFields[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	eventlogentryMixin := schema.EventLogEntry{}.Mixin()
+	eventlogentryMixinFields0 := eventlogentryMixin[0].Fields()
+	_ = eventlogentryMixinFields0
+	eventlogentryFields := schema.EventLogEntry{}.Fields()
+	_ = eventlogentryFields
+	// eventlogentryDescCreateTime is the schema descriptor for create_time field.
+	eventlogentryDescCreateTime := eventlogentryMixinFields0[0].Descriptor()
+	// eventlogentry.DefaultCreateTime holds the default value on creation for the create_time field.
+	eventlogentry.DefaultCreateTime = eventlogentryDescCreateTime.Default.(func() time.Time)
+	// eventlogentryDescUpdateTime is the schema descriptor for update_time field.
+	eventlogentryDescUpdateTime := eventlogentryMixinFields0[1].Descriptor()
+	// eventlogentry.DefaultUpdateTime holds the default value on creation for the update_time field.
+	eventlogentry.DefaultUpdateTime = eventlogentryDescUpdateTime.Default.(func() time.Time)
+	// eventlogentry.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	eventlogentry.UpdateDefaultUpdateTime = eventlogentryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// eventlogentryDescID is the schema descriptor for id field.
+	eventlogentryDescID := eventlogentryFields[0].Descriptor()
+	// eventlogentry.DefaultID holds the default value on creation for the id field.
+	eventlogentry.DefaultID = eventlogentryDescID.Default.(func() uuid.UUID)
 	messageMixin := schema.Message{}.Mixin()
 	messageMixinFields0 := messageMixin[0].Fields()
 	_ = messageMixinFields0
@@ -112,6 +156,33 @@ func init() {
 	modelDescID := modelFields[0].Descriptor()
 	// model.DefaultID holds the default value on creation for the id field.
 	model.DefaultID = modelDescID.Default.(func() uuid.UUID)
+	modelcalltraceMixin := schema.ModelCallTrace{}.Mixin()
+	modelcalltraceMixinFields0 := modelcalltraceMixin[0].Fields()
+	_ = modelcalltraceMixinFields0
+	modelcalltraceFields := schema.ModelCallTrace{}.Fields()
+	_ = modelcalltraceFields
+	// modelcalltraceDescCreateTime is the schema descriptor for create_time field.
+	modelcalltraceDescCreateTime := modelcalltraceMixinFields0[0].Descriptor()
+	// modelcalltrace.DefaultCreateTime holds the default value on creation for the create_time field.
+	modelcalltrace.DefaultCreateTime = modelcalltraceDescCreateTime.Default.(func() time.Time)
+	// modelcalltraceDescUpdateTime is the schema descriptor for update_time field.
+	modelcalltraceDescUpdateTime := modelcalltraceMixinFields0[1].Descriptor()
+	// modelcalltrace.DefaultUpdateTime holds the default value on creation for the update_time field.
+	modelcalltrace.DefaultUpdateTime = modelcalltraceDescUpdateTime.Default.(func() time.Time)
+	// modelcalltrace.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	modelcalltrace.UpdateDefaultUpdateTime = modelcalltraceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// modelcalltraceDescTruncated is the schema descriptor for truncated field.
+	modelcalltraceDescTruncated := modelcalltraceFields[10].Descriptor()
+	// modelcalltrace.DefaultTruncated holds the default value on creation for the truncated field.
+	modelcalltrace.DefaultTruncated = modelcalltraceDescTruncated.Default.(bool)
+	// modelcalltraceDescDurationMs is the schema descriptor for duration_ms field.
+	modelcalltraceDescDurationMs := modelcalltraceFields[12].Descriptor()
+	// modelcalltrace.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	modelcalltrace.DefaultDurationMs = modelcalltraceDescDurationMs.Default.(int64)
+	// modelcalltraceDescID is the schema descriptor for id field.
+	modelcalltraceDescID := modelcalltraceFields[0].Descriptor()
+	// modelcalltrace.DefaultID holds the default value on creation for the id field.
+	modelcalltrace.DefaultID = modelcalltraceDescID.Default.(func() uuid.UUID)
 	modelproviderMixin := schema.ModelProvider{}.Mixin()
 	modelproviderMixinFields0 := modelproviderMixin[0].Fields()
 	_ = modelproviderMixinFields0
@@ -143,6 +214,61 @@ func init() {
 	modelproviderDescID := modelproviderFields[0].Descriptor()
 	// modelprovider.DefaultID holds the default value on creation for the id field.
 	modelprovider.DefaultID = modelproviderDescID.Default.(func() uuid.UUID)
+	modelproviderkeyMixin := schema.ModelProviderKey{}.Mixin()
+	modelproviderkeyMixinFields0 := modelproviderkeyMixin[0].Fields()
+	_ = modelproviderkeyMixinFields0
+	modelproviderkeyFields := schema.ModelProviderKey{}.Fields()
+	_ = modelproviderkeyFields
+	// modelproviderkeyDescCreateTime is the schema descriptor for create_time field.
+	modelproviderkeyDescCreateTime := modelproviderkeyMixinFields0[0].Descriptor()
+	// modelproviderkey.DefaultCreateTime holds the default value on creation for the create_time field.
+	modelproviderkey.DefaultCreateTime = modelproviderkeyDescCreateTime.Default.(func() time.Time)
+	// modelproviderkeyDescUpdateTime is the schema descriptor for update_time field.
+	modelproviderkeyDescUpdateTime := modelproviderkeyMixinFields0[1].Descriptor()
+	// modelproviderkey.DefaultUpdateTime holds the default value on creation for the update_time field.
+	modelproviderkey.DefaultUpdateTime = modelproviderkeyDescUpdateTime.Default.(func() time.Time)
+	// modelproviderkey.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	modelproviderkey.UpdateDefaultUpdateTime = modelproviderkeyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// modelproviderkeyDescName is the schema descriptor for name field.
+	modelproviderkeyDescName := modelproviderkeyFields[1].Descriptor()
+	// modelproviderkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	modelproviderkey.NameValidator = modelproviderkeyDescName.Validators[0].(func(string) error)
+	// modelproviderkeyDescSecret is the schema descriptor for secret field.
+	modelproviderkeyDescSecret := modelproviderkeyFields[2].Descriptor()
+	// modelproviderkey.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	modelproviderkey.SecretValidator = modelproviderkeyDescSecret.Validators[0].(func([]byte) error)
+	// modelproviderkeyDescPriority is the schema descriptor for priority field.
+	modelproviderkeyDescPriority := modelproviderkeyFields[3].Descriptor()
+	// modelproviderkey.DefaultPriority holds the default value on creation for the priority field.
+	modelproviderkey.DefaultPriority = modelproviderkeyDescPriority.Default.(int)
+	// modelproviderkeyDescRequestCount is the schema descriptor for request_count field.
+	modelproviderkeyDescRequestCount := modelproviderkeyFields[7].Descriptor()
+	// modelproviderkey.DefaultRequestCount holds the default value on creation for the request_count field.
+	modelproviderkey.DefaultRequestCount = modelproviderkeyDescRequestCount.Default.(int64)
+	// modelproviderkeyDescInputTokens is the schema descriptor for input_tokens field.
+	modelproviderkeyDescInputTokens := modelproviderkeyFields[8].Descriptor()
+	// modelproviderkey.DefaultInputTokens holds the default value on creation for the input_tokens field.
+	modelproviderkey.DefaultInputTokens = modelproviderkeyDescInputTokens.Default.(int64)
+	// modelproviderkeyDescOutputTokens is the schema descriptor for output_tokens field.
+	modelproviderkeyDescOutputTokens := modelproviderkeyFields[9].Descriptor()
+	// modelproviderkey.DefaultOutputTokens holds the default value on creation for the output_tokens field.
+	modelproviderkey.DefaultOutputTokens = modelproviderkeyDescOutputTokens.Default.(int64)
+	// modelproviderkeyDescCacheWriteTokens is the schema descriptor for cache_write_tokens field.
+	modelproviderkeyDescCacheWriteTokens := modelproviderkeyFields[10].Descriptor()
+	// modelproviderkey.DefaultCacheWriteTokens holds the default value on creation for the cache_write_tokens field.
+	modelproviderkey.DefaultCacheWriteTokens = modelproviderkeyDescCacheWriteTokens.Default.(int64)
+	// modelproviderkeyDescCacheReadTokens is the schema descriptor for cache_read_tokens field.
+	modelproviderkeyDescCacheReadTokens := modelproviderkeyFields[11].Descriptor()
+	// modelproviderkey.DefaultCacheReadTokens holds the default value on creation for the cache_read_tokens field.
+	modelproviderkey.DefaultCacheReadTokens = modelproviderkeyDescCacheReadTokens.Default.(int64)
+	// modelproviderkeyDescCost is the schema descriptor for cost field.
+	modelproviderkeyDescCost := modelproviderkeyFields[12].Descriptor()
+	// modelproviderkey.DefaultCost holds the default value on creation for the cost field.
+	modelproviderkey.DefaultCost = modelproviderkeyDescCost.Default.(float64)
+	// modelproviderkeyDescID is the schema descriptor for id field.
+	modelproviderkeyDescID := modelproviderkeyFields[0].Descriptor()
+	// modelproviderkey.DefaultID holds the default value on creation for the id field.
+	modelproviderkey.DefaultID = modelproviderkeyDescID.Default.(func() uuid.UUID)
 	taskMixin := schema.Task{}.Mixin()
 	taskMixinFields0 := taskMixin[0].Fields()
 	_ = taskMixinFields0
@@ -159,13 +285,17 @@ func init() {
 	// task.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
 	task.UpdateDefaultUpdateTime = taskDescUpdateTime.UpdateDefault.(func() time.Time)
 	// taskDescTurns is the schema descriptor for turns field.
-	taskDescTurns := taskFields[7].Descriptor()
+	taskDescTurns := taskFields[9].Descriptor()
 	// task.DefaultTurns holds the default value on creation for the turns field.
 	task.DefaultTurns = taskDescTurns.Default.(int64)
 	// taskDescToolUses is the schema descriptor for tool_uses field.
-	taskDescToolUses := taskFields[8].Descriptor()
+	taskDescToolUses := taskFields[10].Descriptor()
 	// task.DefaultToolUses holds the default value on creation for the tool_uses field.
 	task.DefaultToolUses = taskDescToolUses.Default.(map[string]int64)
+	// taskDescTraceModelCalls is the schema descriptor for trace_model_calls field.
+	taskDescTraceModelCalls := taskFields[15].Descriptor()
+	// task.DefaultTraceModelCalls holds the default value on creation for the trace_model_calls field.
+	task.DefaultTraceModelCalls = taskDescTraceModelCalls.Default.(bool)
 	// taskDescID is the schema descriptor for id field.
 	taskDescID := taskFields[0].Descriptor()
 	// task.DefaultID holds the default value on creation for the id field.
@@ -193,4 +323,58 @@ func init() {
 	tokenDescID := tokenFields[0].Descriptor()
 	// token.DefaultID holds the default value on creation for the id field.
 	token.DefaultID = tokenDescID.Default.(func() uuid.UUID)
+	webhookMixin := schema.Webhook{}.Mixin()
+	webhookMixinFields0 := webhookMixin[0].Fields()
+	_ = webhookMixinFields0
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescCreateTime is the schema descriptor for create_time field.
+	webhookDescCreateTime := webhookMixinFields0[0].Descriptor()
+	// webhook.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhook.DefaultCreateTime = webhookDescCreateTime.Default.(func() time.Time)
+	// webhookDescUpdateTime is the schema descriptor for update_time field.
+	webhookDescUpdateTime := webhookMixinFields0[1].Descriptor()
+	// webhook.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhook.DefaultUpdateTime = webhookDescUpdateTime.Default.(func() time.Time)
+	// webhook.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhook.UpdateDefaultUpdateTime = webhookDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = webhookDescURL.Validators[0].(func(string) error)
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[5].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = webhookDescSecret.Validators[0].(func([]byte) error)
+	// webhookDescEnabled is the schema descriptor for enabled field.
+	webhookDescEnabled := webhookFields[6].Descriptor()
+	// webhook.DefaultEnabled holds the default value on creation for the enabled field.
+	webhook.DefaultEnabled = webhookDescEnabled.Default.(bool)
+	// webhookDescID is the schema descriptor for id field.
+	webhookDescID := webhookFields[0].Descriptor()
+	// webhook.DefaultID holds the default value on creation for the id field.
+	webhook.DefaultID = webhookDescID.Default.(func() uuid.UUID)
+	webhookdeliveryMixin := schema.WebhookDelivery{}.Mixin()
+	webhookdeliveryMixinFields0 := webhookdeliveryMixin[0].Fields()
+	_ = webhookdeliveryMixinFields0
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescCreateTime is the schema descriptor for create_time field.
+	webhookdeliveryDescCreateTime := webhookdeliveryMixinFields0[0].Descriptor()
+	// webhookdelivery.DefaultCreateTime holds the default value on creation for the create_time field.
+	webhookdelivery.DefaultCreateTime = webhookdeliveryDescCreateTime.Default.(func() time.Time)
+	// webhookdeliveryDescUpdateTime is the schema descriptor for update_time field.
+	webhookdeliveryDescUpdateTime := webhookdeliveryMixinFields0[1].Descriptor()
+	// webhookdelivery.DefaultUpdateTime holds the default value on creation for the update_time field.
+	webhookdelivery.DefaultUpdateTime = webhookdeliveryDescUpdateTime.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	webhookdelivery.UpdateDefaultUpdateTime = webhookdeliveryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
 }
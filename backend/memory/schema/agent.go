@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/google/uuid"
 )
 
@@ -22,6 +23,11 @@ func (Agent) Fields() []ent.Field {
 		field.Bool("builtin").Default(false),
 
 		field.UUID("model_id", uuid.UUID{}).Optional(),
+		field.JSON("fallback_model_ids", []uuid.UUID{}).Optional(),
+		field.JSON("model_router", &types.ModelRouter{}).Optional(),
+		field.JSON("redaction", &types.Redaction{}).Optional(),
+		// owner is the subject of the identity that created the agent. Built-in agents have none.
+		field.String("owner").Optional(),
 	}
 }
 
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// AuditEvent records who changed what through the API, and which side effects the tools of an
+// agent had. Audit events are append-only, all fields are immutable and events are only deleted
+// once they are past their retention.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Enum("kind").GoType(types.AuditEventKind("")).Immutable(),
+		// action is the procedure for API events and the tool for tool events.
+		field.String("action").Immutable(),
+		field.String("subject").Optional().Immutable(),
+		field.String("auth_method").Optional().Immutable(),
+		field.JSON("resource_ids", []string{}).Optional().Immutable(),
+		field.Enum("outcome").GoType(types.AuditOutcome("")).Immutable(),
+		field.String("error").Optional().Immutable(),
+		// task_id and agent_id are not edges, the audit trail outlives tasks and agents.
+		field.UUID("task_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		field.UUID("agent_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		// details describe the side effect of a tool call, e.g. the command that was executed.
+		field.JSON("details", map[string]string{}).Optional().Immutable(),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("create_time"),
+		index.Fields("subject"),
+		index.Fields("task_id"),
+	}
+}
+
+func (AuditEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// EventLogEntry is an event that was published on the event router. Entries are kept for a
+// bounded time so that subscribers can resume a stream after they lost their connection.
+type EventLogEntry struct {
+	ent.Schema
+}
+
+func (EventLogEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.Int64("sequence").Unique().Immutable(),
+		field.String("type").Immutable(),
+		field.String("action").Optional().Immutable(),
+		field.Time("timestamp").Immutable(),
+		// task_id is not an edge, the events of a task outlive the task itself.
+		field.UUID("task_id", uuid.UUID{}).Optional().Nillable().Immutable(),
+		// payload is the event in the wire format of the event API.
+		field.Bytes("payload").Immutable(),
+	}
+}
+
+func (EventLogEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("create_time"),
+	}
+}
+
+func (EventLogEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -22,7 +22,13 @@ func (Message) Fields() []ent.Field {
 		field.Enum("source").GoType(types.MessageSource("")),
 		field.JSON("content", &types.MessageContent{}),
 		field.JSON("usage", &types.MessageUsage{}).Optional(),
+		field.JSON("routing", &types.MessageRouting{}).Optional(),
 		field.Time("processed_time").Optional(),
+		// trace_id identifies the trace of the reconcile pass that generated an assistant message.
+		field.String("trace_id").Optional(),
+		// owner is the subject of the identity that created the message. Messages of the
+		// assistant have none.
+		field.String("owner").Optional(),
 
 		field.UUID("task_id", uuid.UUID{}),
 		field.UUID("agent_id", uuid.UUID{}).Optional(),
@@ -26,6 +26,8 @@ func (Model) Fields() []ent.Field {
 		field.Float("cache_read_cost").Min(0).Default(0),
 		field.Bool("enabled").Default(true),
 		field.String("alias").Optional(),
+		field.Enum("source").GoType(types.ModelSource("")).Default(string(types.ModelSourceCatalog)),
+		field.JSON("overrides", []types.ModelField{}).Optional(),
 
 		field.UUID("model_provider_id", uuid.UUID{}),
 	}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// ModelCallTrace is the raw HTTP exchange of a single request to a model provider. Traces are
+// only captured for tasks that enabled tracing and the secrets in their headers are redacted.
+type ModelCallTrace struct {
+	ent.Schema
+}
+
+func (ModelCallTrace) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("model_name"),
+		field.UUID("model_provider_id", uuid.UUID{}).Optional(),
+		field.String("method"),
+		field.String("url"),
+		field.JSON("request_headers", map[string][]string{}).Optional(),
+		field.Bytes("request_body").Optional(),
+		field.Int("status_code").Optional(),
+		field.JSON("response_headers", map[string][]string{}).Optional(),
+		field.Bytes("response_body").Optional(),
+		field.Bool("truncated").Default(false),
+		field.String("error").Optional(),
+		field.Int64("duration_ms").Default(0),
+
+		field.UUID("task_id", uuid.UUID{}),
+	}
+}
+
+func (ModelCallTrace) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("task", Task.Type).Field("task_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (ModelCallTrace) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id"),
+		index.Fields("create_time"),
+	}
+}
+
+func (ModelCallTrace) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
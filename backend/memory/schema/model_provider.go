@@ -21,12 +21,15 @@ func (ModelProvider) Fields() []ent.Field {
 		field.String("url").Optional(),
 		field.Bytes("secret").NotEmpty().Sensitive(),
 		field.Bool("enabled").Default(true),
+		field.JSON("rate_limit", &types.ModelProviderRateLimit{}).Optional(),
+		field.Enum("key_selection").GoType(types.KeySelectionStrategy("")).Default(string(types.KeySelectionStrategyPrimaryBackup)),
 	}
 }
 
 func (ModelProvider) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("models", Model.Type),
+		edge.From("keys", ModelProviderKey.Type).Ref("model_provider"),
 	}
 }
 
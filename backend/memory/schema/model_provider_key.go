@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+type ModelProviderKey struct {
+	ent.Schema
+}
+
+func (ModelProviderKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("name").NotEmpty(),
+		field.Bytes("secret").NotEmpty().Sensitive(),
+		field.Int("priority").Default(0),
+		field.Time("retired_time").Optional(),
+		field.Time("last_used_time").Optional(),
+		field.Time("last_rate_limited_time").Optional(),
+
+		field.Int64("request_count").Default(0),
+		field.Int64("input_tokens").Default(0),
+		field.Int64("output_tokens").Default(0),
+		field.Int64("cache_write_tokens").Default(0),
+		field.Int64("cache_read_tokens").Default(0),
+		field.Float("cost").Default(0),
+
+		field.UUID("model_provider_id", uuid.UUID{}),
+	}
+}
+
+func (ModelProviderKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("model_provider", ModelProvider.Type).Field("model_provider_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (ModelProviderKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "model_provider_id").
+			Unique(),
+	}
+}
+
+func (ModelProviderKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
@@ -23,6 +23,8 @@ func (Task) Fields() []ent.Field {
 		field.Int64("cache_write_tokens").Optional(),
 		field.Int64("cache_read_tokens").Optional(),
 		field.Float("cost").Optional(),
+		field.Int64("routed_turns").Optional(),
+		field.Float("routing_savings").Optional(),
 		field.Int64("turns").Default(0),
 		field.JSON("tool_uses", map[string]int64{}).Default(map[string]int64{}),
 		field.Enum("desired_phase").GoType(types.TaskPhase("")).Default(string(types.TaskPhaseRunning)),
@@ -30,12 +32,19 @@ func (Task) Fields() []ent.Field {
 
 		field.String("description").Optional(),
 		field.UUID("agent_id", uuid.UUID{}).Optional(),
+		field.Bool("trace_model_calls").Default(false),
+		// owner is the subject of the identity that created the task. Tasks without an owner were
+		// created before ownership was recorded and are only visible to admins.
+		field.String("owner").Optional(),
+		// shared_with lists further subjects that may access the task.
+		field.JSON("shared_with", []string{}).Optional(),
 	}
 }
 
 func (Task) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("messages", Message.Type).Ref("task"),
+		edge.From("model_call_traces", ModelCallTrace.Type).Ref("task"),
 		edge.To("agent", Agent.Type).Field("agent_id").Unique(),
 	}
 }
@@ -44,6 +53,7 @@ func (Task) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("create_time"),
 		index.Fields("update_time"),
+		index.Fields("owner"),
 	}
 }
 
@@ -21,6 +21,22 @@ func (Token) Fields() []ent.Field {
 		field.Bytes("token_hash"),
 		field.String("description").Optional(),
 		field.Time("expires_at"),
+		// scopes limits the procedures the token can call. A token without scopes has full access
+		// except for token management.
+		field.JSON("scopes", []string{}).Optional(),
+		// agent_ids and workspaces limit the token to the tasks of these agents and project directories.
+		field.JSON("agent_ids", []uuid.UUID{}).Optional(),
+		field.JSON("workspaces", []string{}).Optional(),
+		// quota limits the tasks and model usage of the subject of the token.
+		field.JSON("quota", &types.Quota{}).Optional(),
+		// rotated_at is set when the token was last rotated. A rotation replaces token_hash and keeps
+		// the replaced hash valid until previous_expires_at, so clients can switch over.
+		field.Time("rotated_at").Optional().Nillable(),
+		field.Bytes("previous_token_hash").Optional(),
+		field.Time("previous_expires_at").Optional().Nillable(),
+		// last_used_at and last_used_address are only updated every few minutes, not on every request.
+		field.Time("last_used_at").Optional().Nillable(),
+		field.String("last_used_address").Optional(),
 	}
 }
 
@@ -32,6 +48,7 @@ func (Token) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("name").Unique(),
 		index.Fields("token_hash").Unique(),
+		index.Fields("previous_token_hash"),
 	}
 }
 
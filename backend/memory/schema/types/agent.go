@@ -0,0 +1,49 @@
+package types
+
+import "github.com/google/uuid"
+
+type TurnType string
+
+const (
+	TurnTypeFirst       TurnType = "first"
+	TurnTypeUserMessage TurnType = "user_message"
+	TurnTypeToolResult  TurnType = "tool_result"
+	TurnTypeToolError   TurnType = "tool_error"
+)
+
+func (t TurnType) Values() []string {
+	return []string{
+		string(TurnTypeFirst),
+		string(TurnTypeUserMessage),
+		string(TurnTypeToolResult),
+		string(TurnTypeToolError),
+	}
+}
+
+// ModelRouter selects the model that serves a single model invocation of an agent.
+// Rules are evaluated in order and the first matching rule wins. If no rule matches,
+// the agent's default model is used.
+type ModelRouter struct {
+	Rules []ModelRouteRule `json:"rules,omitempty"`
+}
+
+// ModelRouteRule matches a turn if all of its conditions are satisfied. Conditions
+// with a zero value are ignored.
+type ModelRouteRule struct {
+	Name                  string     `json:"name"`
+	ModelID               uuid.UUID  `json:"model_id"`
+	TurnTypes             []TurnType `json:"turn_types,omitempty"`
+	MinPromptTokens       int64      `json:"min_prompt_tokens,omitempty"`
+	MaxPromptTokens       int64      `json:"max_prompt_tokens,omitempty"`
+	MinRecentToolFailures int64      `json:"min_recent_tool_failures,omitempty"`
+	MaxRecentToolFailures *int64     `json:"max_recent_tool_failures,omitempty"`
+}
+
+// Redaction configures how secrets are removed from the output of the agent's tools before it is
+// stored, published or sent to the model provider. Secrets with a well-known format and the API
+// keys of the daemon's model providers are always redacted unless redaction is disabled.
+type Redaction struct {
+	Disabled           bool     `json:"disabled,omitempty"`
+	HighEntropyStrings bool     `json:"high_entropy_strings,omitempty"`
+	Patterns           []string `json:"patterns,omitempty"`
+}
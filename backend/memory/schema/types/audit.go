@@ -0,0 +1,41 @@
+package types
+
+// AuditEventKind tells what was audited.
+type AuditEventKind string
+
+const (
+	// AuditEventKindAPI events record calls of mutating API procedures.
+	AuditEventKindAPI AuditEventKind = "api"
+	// AuditEventKindTool events record tool calls of agents that have side effects.
+	AuditEventKindTool AuditEventKind = "tool"
+)
+
+func (k AuditEventKind) String() string {
+	return string(k)
+}
+
+func (k AuditEventKind) Values() []string {
+	return []string{
+		string(AuditEventKindAPI),
+		string(AuditEventKindTool),
+	}
+}
+
+// AuditOutcome tells whether an audited action succeeded.
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+func (o AuditOutcome) String() string {
+	return string(o)
+}
+
+func (o AuditOutcome) Values() []string {
+	return []string{
+		string(AuditOutcomeSuccess),
+		string(AuditOutcomeFailure),
+	}
+}
@@ -1,11 +1,14 @@
 package types
 
+import "github.com/google/uuid"
+
 type MessageBlockKind string
 
 const (
 	MessageBlockKindText       MessageBlockKind = "text"
 	MessageBlockKindToolCall   MessageBlockKind = "tool_call"
 	MessageBlockKindToolResult MessageBlockKind = "tool_result"
+	MessageBlockKindReasoning  MessageBlockKind = "reasoning"
 )
 
 type MessageContent struct {
@@ -39,4 +42,19 @@ type MessageUsage struct {
 	CacheWriteTokens int64   `json:"cache_write_tokens"`
 	CacheReadTokens  int64   `json:"cache_read_tokens"`
 	Cost             float64 `json:"cost"`
+	// EstimatedInputTokens is the prompt size that was estimated before the model was invoked.
+	// Together with the actual input tokens it shows how accurate the estimates are.
+	EstimatedInputTokens int64 `json:"estimated_input_tokens,omitempty"`
+	// EstimateSource is how the estimate was made, either by the provider or approximated locally.
+	EstimateSource string `json:"estimate_source,omitempty"`
+}
+
+// MessageRouting records why a model was chosen to generate an assistant message.
+type MessageRouting struct {
+	Rule                  string    `json:"rule,omitempty"`
+	TurnType              TurnType  `json:"turn_type"`
+	EstimatedPromptTokens int64     `json:"estimated_prompt_tokens"`
+	RecentToolFailures    int64     `json:"recent_tool_failures"`
+	DefaultModelID        uuid.UUID `json:"default_model_id"`
+	DefaultModelCost      float64   `json:"default_model_cost"`
 }
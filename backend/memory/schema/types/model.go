@@ -19,3 +19,31 @@ func (c ModelCapability) Values() []ModelCapability {
 		ModelCapabilityAudio,
 	}
 }
+
+// ModelSource tells where the metadata of a model comes from.
+type ModelSource string
+
+const (
+	// ModelSourceCatalog models are kept up to date by catalog syncs.
+	ModelSourceCatalog ModelSource = "catalog"
+	// ModelSourceUser models have been created by the user and are never touched by catalog syncs.
+	ModelSourceUser ModelSource = "user"
+)
+
+func (s ModelSource) Values() []string {
+	return []string{
+		string(ModelSourceCatalog),
+		string(ModelSourceUser),
+	}
+}
+
+// ModelField is a field of a model that the user can override. Overridden fields are not changed
+// by catalog syncs.
+type ModelField string
+
+const (
+	ModelFieldPricing       ModelField = "pricing"
+	ModelFieldContextWindow ModelField = "context_window"
+	ModelFieldCapabilities  ModelField = "capabilities"
+	ModelFieldEnabled       ModelField = "enabled"
+)
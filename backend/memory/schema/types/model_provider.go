@@ -7,6 +7,10 @@ const (
 	ModelProviderTypeOpenAI    ModelProviderType = "openai"
 	ModelProviderTypeGemini    ModelProviderType = "gemini"
 	ModelProviderTypeXAI       ModelProviderType = "xai"
+	ModelProviderTypeDeepSeek  ModelProviderType = "deepseek"
+	ModelProviderTypeBedrock   ModelProviderType = "bedrock"
+	ModelProviderTypeReplay    ModelProviderType = "replay"
+	ModelProviderTypeMock      ModelProviderType = "mock"
 )
 
 func (p ModelProviderType) Values() []string {
@@ -15,5 +19,43 @@ func (p ModelProviderType) Values() []string {
 		string(ModelProviderTypeOpenAI),
 		string(ModelProviderTypeGemini),
 		string(ModelProviderTypeXAI),
+		string(ModelProviderTypeDeepSeek),
+		string(ModelProviderTypeBedrock),
+		string(ModelProviderTypeReplay),
+		string(ModelProviderTypeMock),
 	}
 }
+
+// KeySelectionStrategy decides which of the active keys of a provider is used for a model invocation.
+type KeySelectionStrategy string
+
+const (
+	// KeySelectionStrategyPrimaryBackup uses the key with the highest priority and only switches to
+	// the next key while the preferred key is rate limited.
+	KeySelectionStrategyPrimaryBackup KeySelectionStrategy = "primary_backup"
+	// KeySelectionStrategyRoundRobin spreads invocations evenly across all keys.
+	KeySelectionStrategyRoundRobin KeySelectionStrategy = "round_robin"
+	// KeySelectionStrategyLeastRecentlyLimited uses the key that has been rate limited least recently.
+	KeySelectionStrategyLeastRecentlyLimited KeySelectionStrategy = "least_recently_limited"
+)
+
+func (s KeySelectionStrategy) Values() []string {
+	return []string{
+		string(KeySelectionStrategyPrimaryBackup),
+		string(KeySelectionStrategyRoundRobin),
+		string(KeySelectionStrategyLeastRecentlyLimited),
+	}
+}
+
+// ModelProviderRateLimit limits the load that all tasks together put on a provider.
+// A limit of zero means that the dimension is not limited.
+type ModelProviderRateLimit struct {
+	RequestsPerMinute int64 `json:"requests_per_minute,omitempty"`
+	TokensPerMinute   int64 `json:"tokens_per_minute,omitempty"`
+	MaxInFlight       int64 `json:"max_in_flight,omitempty"`
+}
+
+// IsZero reports whether no limit is configured.
+func (l *ModelProviderRateLimit) IsZero() bool {
+	return l == nil || (l.RequestsPerMinute == 0 && l.TokensPerMinute == 0 && l.MaxInFlight == 0)
+}
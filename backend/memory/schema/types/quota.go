@@ -0,0 +1,17 @@
+package types
+
+// Quota limits the resources a subject may use. A zero limit means that the resource is not limited.
+type Quota struct {
+	// MaxConcurrentTasks is the number of tasks of the subject that may run at the same time.
+	MaxConcurrentTasks int `json:"max_concurrent_tasks,omitempty"`
+	// DailySpend and MonthlySpend limit the cost of the model calls of the subject in USD.
+	DailySpend   float64 `json:"daily_spend,omitempty"`
+	MonthlySpend float64 `json:"monthly_spend,omitempty"`
+	// DailyTokens limits the input, cache write and output tokens of the model calls of the subject.
+	DailyTokens int64 `json:"daily_tokens,omitempty"`
+}
+
+// IsZero reports whether the quota does not limit anything.
+func (q Quota) IsZero() bool {
+	return q == Quota{}
+}
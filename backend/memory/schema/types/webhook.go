@@ -0,0 +1,24 @@
+package types
+
+type WebhookDeliveryState string
+
+const (
+	// WebhookDeliveryStatePending deliveries wait for their first or next attempt.
+	WebhookDeliveryStatePending WebhookDeliveryState = "pending"
+	// WebhookDeliveryStateSucceeded deliveries were acknowledged by the receiver.
+	WebhookDeliveryStateSucceeded WebhookDeliveryState = "succeeded"
+	// WebhookDeliveryStateDeadLetter deliveries failed too often and are not retried anymore.
+	WebhookDeliveryStateDeadLetter WebhookDeliveryState = "dead_letter"
+)
+
+func (s WebhookDeliveryState) String() string {
+	return string(s)
+}
+
+func (s WebhookDeliveryState) Values() []string {
+	return []string{
+		string(WebhookDeliveryStatePending),
+		string(WebhookDeliveryStateSucceeded),
+		string(WebhookDeliveryStateDeadLetter),
+	}
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/google/uuid"
+)
+
+// Webhook pushes the events that match its filters to an HTTP endpoint.
+type Webhook struct {
+	ent.Schema
+}
+
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("url").NotEmpty(),
+		// event_types are glob patterns of event types, all events match if there are none.
+		field.JSON("event_types", []string{}).Optional(),
+		field.UUID("task_id", uuid.UUID{}).Optional().Nillable(),
+		field.UUID("agent_id", uuid.UUID{}).Optional().Nillable(),
+		// secret signs the payloads. It is encrypted.
+		field.Bytes("secret").Sensitive().NotEmpty(),
+		field.Bool("enabled").Default(true),
+		field.String("description").Optional(),
+		// owner is the subject of the identity that created the webhook. Webhooks of other owners
+		// only receive the events of the tasks their owner may access.
+		field.String("owner").Optional(),
+		// admin marks webhooks that were created by admins. They receive the events of all tasks,
+		// just like webhooks without an owner, which were created without authentication or
+		// before ownership was recorded.
+		field.Bool("admin").Default(false),
+	}
+}
+
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("deliveries", WebhookDelivery.Type).Ref("webhook"),
+	}
+}
+
+func (Webhook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
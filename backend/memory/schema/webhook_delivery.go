@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery is an event that is pushed to a webhook, together with the history of its
+// delivery attempts.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Unique().Immutable(),
+		field.String("event_type").Immutable(),
+		field.Int64("event_sequence").Optional().Immutable(),
+		// payload is the JSON body that is posted to the webhook.
+		field.Bytes("payload").Immutable(),
+		field.Enum("state").GoType(types.WebhookDeliveryState("")).Default(string(types.WebhookDeliveryStatePending)),
+		field.Int("attempts").Default(0),
+		field.Time("next_attempt_time"),
+		field.Time("last_attempt_time").Optional().Nillable(),
+		field.Int("response_status_code").Optional(),
+		field.String("last_error").Optional(),
+
+		field.UUID("webhook_id", uuid.UUID{}),
+	}
+}
+
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("webhook", Webhook.Type).Field("webhook_id").Unique().Required().Annotations(
+			entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			},
+		),
+	}
+}
+
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("state", "next_attempt_time"),
+		index.Fields("webhook_id"),
+	}
+}
+
+func (WebhookDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
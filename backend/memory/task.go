@@ -37,6 +37,10 @@ type Task struct {
 	CacheReadTokens int64 `json:"cache_read_tokens,omitempty"`
 	// Cost holds the value of the "cost" field.
 	Cost float64 `json:"cost,omitempty"`
+	// RoutedTurns holds the value of the "routed_turns" field.
+	RoutedTurns int64 `json:"routed_turns,omitempty"`
+	// RoutingSavings holds the value of the "routing_savings" field.
+	RoutingSavings float64 `json:"routing_savings,omitempty"`
 	// Turns holds the value of the "turns" field.
 	Turns int64 `json:"turns,omitempty"`
 	// ToolUses holds the value of the "tool_uses" field.
@@ -49,6 +53,12 @@ type Task struct {
 	Description string `json:"description,omitempty"`
 	// AgentID holds the value of the "agent_id" field.
 	AgentID uuid.UUID `json:"agent_id,omitempty"`
+	// TraceModelCalls holds the value of the "trace_model_calls" field.
+	TraceModelCalls bool `json:"trace_model_calls,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// SharedWith holds the value of the "shared_with" field.
+	SharedWith []string `json:"shared_with,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges        TaskEdges `json:"edges"`
@@ -59,11 +69,13 @@ type Task struct {
 type TaskEdges struct {
 	// Messages holds the value of the messages edge.
 	Messages []*Message `json:"messages,omitempty"`
+	// ModelCallTraces holds the value of the model_call_traces edge.
+	ModelCallTraces []*ModelCallTrace `json:"model_call_traces,omitempty"`
 	// Agent holds the value of the agent edge.
 	Agent *Agent `json:"agent,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -75,12 +87,21 @@ func (e TaskEdges) MessagesOrErr() ([]*Message, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// ModelCallTracesOrErr returns the ModelCallTraces value or an error if the edge
+// was not loaded in eager-loading.
+func (e TaskEdges) ModelCallTracesOrErr() ([]*ModelCallTrace, error) {
+	if e.loadedTypes[1] {
+		return e.ModelCallTraces, nil
+	}
+	return nil, &NotLoadedError{edge: "model_call_traces"}
+}
+
 // AgentOrErr returns the Agent value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e TaskEdges) AgentOrErr() (*Agent, error) {
 	if e.Agent != nil {
 		return e.Agent, nil
-	} else if e.loadedTypes[1] {
+	} else if e.loadedTypes[2] {
 		return nil, &NotFoundError{label: agent.Label}
 	}
 	return nil, &NotLoadedError{edge: "agent"}
@@ -91,13 +112,15 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case task.FieldToolUses:
+		case task.FieldToolUses, task.FieldSharedWith:
 			values[i] = new([]byte)
-		case task.FieldCost:
+		case task.FieldTraceModelCalls:
+			values[i] = new(sql.NullBool)
+		case task.FieldCost, task.FieldRoutingSavings:
 			values[i] = new(sql.NullFloat64)
-		case task.FieldInputTokens, task.FieldOutputTokens, task.FieldCacheWriteTokens, task.FieldCacheReadTokens, task.FieldTurns:
+		case task.FieldInputTokens, task.FieldOutputTokens, task.FieldCacheWriteTokens, task.FieldCacheReadTokens, task.FieldRoutedTurns, task.FieldTurns:
 			values[i] = new(sql.NullInt64)
-		case task.FieldProjectDirectory, task.FieldDesiredPhase, task.FieldPhase, task.FieldDescription:
+		case task.FieldProjectDirectory, task.FieldDesiredPhase, task.FieldPhase, task.FieldDescription, task.FieldOwner:
 			values[i] = new(sql.NullString)
 		case task.FieldCreateTime, task.FieldUpdateTime:
 			values[i] = new(sql.NullTime)
@@ -172,6 +195,18 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.Cost = value.Float64
 			}
+		case task.FieldRoutedTurns:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field routed_turns", values[i])
+			} else if value.Valid {
+				t.RoutedTurns = value.Int64
+			}
+		case task.FieldRoutingSavings:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field routing_savings", values[i])
+			} else if value.Valid {
+				t.RoutingSavings = value.Float64
+			}
 		case task.FieldTurns:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field turns", values[i])
@@ -210,6 +245,26 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				t.AgentID = *value
 			}
+		case task.FieldTraceModelCalls:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field trace_model_calls", values[i])
+			} else if value.Valid {
+				t.TraceModelCalls = value.Bool
+			}
+		case task.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				t.Owner = value.String
+			}
+		case task.FieldSharedWith:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field shared_with", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.SharedWith); err != nil {
+					return fmt.Errorf("unmarshal field shared_with: %w", err)
+				}
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -228,6 +283,11 @@ func (t *Task) QueryMessages() *MessageQuery {
 	return NewTaskClient(t.config).QueryMessages(t)
 }
 
+// QueryModelCallTraces queries the "model_call_traces" edge of the Task entity.
+func (t *Task) QueryModelCallTraces() *ModelCallTraceQuery {
+	return NewTaskClient(t.config).QueryModelCallTraces(t)
+}
+
 // QueryAgent queries the "agent" edge of the Task entity.
 func (t *Task) QueryAgent() *AgentQuery {
 	return NewTaskClient(t.config).QueryAgent(t)
@@ -280,6 +340,12 @@ func (t *Task) String() string {
 	builder.WriteString("cost=")
 	builder.WriteString(fmt.Sprintf("%v", t.Cost))
 	builder.WriteString(", ")
+	builder.WriteString("routed_turns=")
+	builder.WriteString(fmt.Sprintf("%v", t.RoutedTurns))
+	builder.WriteString(", ")
+	builder.WriteString("routing_savings=")
+	builder.WriteString(fmt.Sprintf("%v", t.RoutingSavings))
+	builder.WriteString(", ")
 	builder.WriteString("turns=")
 	builder.WriteString(fmt.Sprintf("%v", t.Turns))
 	builder.WriteString(", ")
@@ -297,6 +363,15 @@ func (t *Task) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("agent_id=")
 	builder.WriteString(fmt.Sprintf("%v", t.AgentID))
+	builder.WriteString(", ")
+	builder.WriteString("trace_model_calls=")
+	builder.WriteString(fmt.Sprintf("%v", t.TraceModelCalls))
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(t.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("shared_with=")
+	builder.WriteString(fmt.Sprintf("%v", t.SharedWith))
 	builder.WriteByte(')')
 	return builder.String()
 }
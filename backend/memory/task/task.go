@@ -33,6 +33,10 @@ const (
 	FieldCacheReadTokens = "cache_read_tokens"
 	// FieldCost holds the string denoting the cost field in the database.
 	FieldCost = "cost"
+	// FieldRoutedTurns holds the string denoting the routed_turns field in the database.
+	FieldRoutedTurns = "routed_turns"
+	// FieldRoutingSavings holds the string denoting the routing_savings field in the database.
+	FieldRoutingSavings = "routing_savings"
 	// FieldTurns holds the string denoting the turns field in the database.
 	FieldTurns = "turns"
 	// FieldToolUses holds the string denoting the tool_uses field in the database.
@@ -45,8 +49,16 @@ const (
 	FieldDescription = "description"
 	// FieldAgentID holds the string denoting the agent_id field in the database.
 	FieldAgentID = "agent_id"
+	// FieldTraceModelCalls holds the string denoting the trace_model_calls field in the database.
+	FieldTraceModelCalls = "trace_model_calls"
+	// FieldOwner holds the string denoting the owner field in the database.
+	FieldOwner = "owner"
+	// FieldSharedWith holds the string denoting the shared_with field in the database.
+	FieldSharedWith = "shared_with"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeModelCallTraces holds the string denoting the model_call_traces edge name in mutations.
+	EdgeModelCallTraces = "model_call_traces"
 	// EdgeAgent holds the string denoting the agent edge name in mutations.
 	EdgeAgent = "agent"
 	// Table holds the table name of the task in the database.
@@ -58,6 +70,13 @@ const (
 	MessagesInverseTable = "messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "task_id"
+	// ModelCallTracesTable is the table that holds the model_call_traces relation/edge.
+	ModelCallTracesTable = "model_call_traces"
+	// ModelCallTracesInverseTable is the table name for the ModelCallTrace entity.
+	// It exists in this package in order to avoid circular dependency with the "modelcalltrace" package.
+	ModelCallTracesInverseTable = "model_call_traces"
+	// ModelCallTracesColumn is the table column denoting the model_call_traces relation/edge.
+	ModelCallTracesColumn = "task_id"
 	// AgentTable is the table that holds the agent relation/edge.
 	AgentTable = "tasks"
 	// AgentInverseTable is the table name for the Agent entity.
@@ -78,12 +97,17 @@ var Columns = []string{
 	FieldCacheWriteTokens,
 	FieldCacheReadTokens,
 	FieldCost,
+	FieldRoutedTurns,
+	FieldRoutingSavings,
 	FieldTurns,
 	FieldToolUses,
 	FieldDesiredPhase,
 	FieldPhase,
 	FieldDescription,
 	FieldAgentID,
+	FieldTraceModelCalls,
+	FieldOwner,
+	FieldSharedWith,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -107,6 +131,8 @@ var (
 	DefaultTurns int64
 	// DefaultToolUses holds the default value on creation for the "tool_uses" field.
 	DefaultToolUses map[string]int64
+	// DefaultTraceModelCalls holds the default value on creation for the "trace_model_calls" field.
+	DefaultTraceModelCalls bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -183,6 +209,16 @@ func ByCost(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCost, opts...).ToFunc()
 }
 
+// ByRoutedTurns orders the results by the routed_turns field.
+func ByRoutedTurns(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoutedTurns, opts...).ToFunc()
+}
+
+// ByRoutingSavings orders the results by the routing_savings field.
+func ByRoutingSavings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRoutingSavings, opts...).ToFunc()
+}
+
 // ByTurns orders the results by the turns field.
 func ByTurns(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTurns, opts...).ToFunc()
@@ -208,6 +244,16 @@ func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
 }
 
+// ByTraceModelCalls orders the results by the trace_model_calls field.
+func ByTraceModelCalls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTraceModelCalls, opts...).ToFunc()
+}
+
+// ByOwner orders the results by the owner field.
+func ByOwner(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOwner, opts...).ToFunc()
+}
+
 // ByMessagesCount orders the results by messages count.
 func ByMessagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -222,6 +268,20 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByModelCallTracesCount orders the results by model_call_traces count.
+func ByModelCallTracesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newModelCallTracesStep(), opts...)
+	}
+}
+
+// ByModelCallTraces orders the results by model_call_traces terms.
+func ByModelCallTraces(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newModelCallTracesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByAgentField orders the results by agent field.
 func ByAgentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -235,6 +295,13 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, MessagesTable, MessagesColumn),
 	)
 }
+func newModelCallTracesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ModelCallTracesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, ModelCallTracesTable, ModelCallTracesColumn),
+	)
+}
 func newAgentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -97,6 +97,16 @@ func Cost(v float64) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCost, v))
 }
 
+// RoutedTurns applies equality check predicate on the "routed_turns" field. It's identical to RoutedTurnsEQ.
+func RoutedTurns(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRoutedTurns, v))
+}
+
+// RoutingSavings applies equality check predicate on the "routing_savings" field. It's identical to RoutingSavingsEQ.
+func RoutingSavings(v float64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRoutingSavings, v))
+}
+
 // Turns applies equality check predicate on the "turns" field. It's identical to TurnsEQ.
 func Turns(v int64) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTurns, v))
@@ -112,6 +122,16 @@ func AgentID(v uuid.UUID) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldAgentID, v))
 }
 
+// TraceModelCalls applies equality check predicate on the "trace_model_calls" field. It's identical to TraceModelCallsEQ.
+func TraceModelCalls(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTraceModelCalls, v))
+}
+
+// Owner applies equality check predicate on the "owner" field. It's identical to OwnerEQ.
+func Owner(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOwner, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreateTime, v))
@@ -517,6 +537,106 @@ func CostNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldCost))
 }
 
+// RoutedTurnsEQ applies the EQ predicate on the "routed_turns" field.
+func RoutedTurnsEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRoutedTurns, v))
+}
+
+// RoutedTurnsNEQ applies the NEQ predicate on the "routed_turns" field.
+func RoutedTurnsNEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRoutedTurns, v))
+}
+
+// RoutedTurnsIn applies the In predicate on the "routed_turns" field.
+func RoutedTurnsIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRoutedTurns, vs...))
+}
+
+// RoutedTurnsNotIn applies the NotIn predicate on the "routed_turns" field.
+func RoutedTurnsNotIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRoutedTurns, vs...))
+}
+
+// RoutedTurnsGT applies the GT predicate on the "routed_turns" field.
+func RoutedTurnsGT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRoutedTurns, v))
+}
+
+// RoutedTurnsGTE applies the GTE predicate on the "routed_turns" field.
+func RoutedTurnsGTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRoutedTurns, v))
+}
+
+// RoutedTurnsLT applies the LT predicate on the "routed_turns" field.
+func RoutedTurnsLT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRoutedTurns, v))
+}
+
+// RoutedTurnsLTE applies the LTE predicate on the "routed_turns" field.
+func RoutedTurnsLTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRoutedTurns, v))
+}
+
+// RoutedTurnsIsNil applies the IsNil predicate on the "routed_turns" field.
+func RoutedTurnsIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRoutedTurns))
+}
+
+// RoutedTurnsNotNil applies the NotNil predicate on the "routed_turns" field.
+func RoutedTurnsNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRoutedTurns))
+}
+
+// RoutingSavingsEQ applies the EQ predicate on the "routing_savings" field.
+func RoutingSavingsEQ(v float64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldRoutingSavings, v))
+}
+
+// RoutingSavingsNEQ applies the NEQ predicate on the "routing_savings" field.
+func RoutingSavingsNEQ(v float64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldRoutingSavings, v))
+}
+
+// RoutingSavingsIn applies the In predicate on the "routing_savings" field.
+func RoutingSavingsIn(vs ...float64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldRoutingSavings, vs...))
+}
+
+// RoutingSavingsNotIn applies the NotIn predicate on the "routing_savings" field.
+func RoutingSavingsNotIn(vs ...float64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldRoutingSavings, vs...))
+}
+
+// RoutingSavingsGT applies the GT predicate on the "routing_savings" field.
+func RoutingSavingsGT(v float64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldRoutingSavings, v))
+}
+
+// RoutingSavingsGTE applies the GTE predicate on the "routing_savings" field.
+func RoutingSavingsGTE(v float64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldRoutingSavings, v))
+}
+
+// RoutingSavingsLT applies the LT predicate on the "routing_savings" field.
+func RoutingSavingsLT(v float64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldRoutingSavings, v))
+}
+
+// RoutingSavingsLTE applies the LTE predicate on the "routing_savings" field.
+func RoutingSavingsLTE(v float64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldRoutingSavings, v))
+}
+
+// RoutingSavingsIsNil applies the IsNil predicate on the "routing_savings" field.
+func RoutingSavingsIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldRoutingSavings))
+}
+
+// RoutingSavingsNotNil applies the NotNil predicate on the "routing_savings" field.
+func RoutingSavingsNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldRoutingSavings))
+}
+
 // TurnsEQ applies the EQ predicate on the "turns" field.
 func TurnsEQ(v int64) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldTurns, v))
@@ -722,6 +842,101 @@ func AgentIDNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldAgentID))
 }
 
+// TraceModelCallsEQ applies the EQ predicate on the "trace_model_calls" field.
+func TraceModelCallsEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldTraceModelCalls, v))
+}
+
+// TraceModelCallsNEQ applies the NEQ predicate on the "trace_model_calls" field.
+func TraceModelCallsNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldTraceModelCalls, v))
+}
+
+// OwnerEQ applies the EQ predicate on the "owner" field.
+func OwnerEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldOwner, v))
+}
+
+// OwnerNEQ applies the NEQ predicate on the "owner" field.
+func OwnerNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldOwner, v))
+}
+
+// OwnerIn applies the In predicate on the "owner" field.
+func OwnerIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldOwner, vs...))
+}
+
+// OwnerNotIn applies the NotIn predicate on the "owner" field.
+func OwnerNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldOwner, vs...))
+}
+
+// OwnerGT applies the GT predicate on the "owner" field.
+func OwnerGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldOwner, v))
+}
+
+// OwnerGTE applies the GTE predicate on the "owner" field.
+func OwnerGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldOwner, v))
+}
+
+// OwnerLT applies the LT predicate on the "owner" field.
+func OwnerLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldOwner, v))
+}
+
+// OwnerLTE applies the LTE predicate on the "owner" field.
+func OwnerLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldOwner, v))
+}
+
+// OwnerContains applies the Contains predicate on the "owner" field.
+func OwnerContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldOwner, v))
+}
+
+// OwnerHasPrefix applies the HasPrefix predicate on the "owner" field.
+func OwnerHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldOwner, v))
+}
+
+// OwnerHasSuffix applies the HasSuffix predicate on the "owner" field.
+func OwnerHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldOwner, v))
+}
+
+// OwnerIsNil applies the IsNil predicate on the "owner" field.
+func OwnerIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldOwner))
+}
+
+// OwnerNotNil applies the NotNil predicate on the "owner" field.
+func OwnerNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldOwner))
+}
+
+// OwnerEqualFold applies the EqualFold predicate on the "owner" field.
+func OwnerEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldOwner, v))
+}
+
+// OwnerContainsFold applies the ContainsFold predicate on the "owner" field.
+func OwnerContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldOwner, v))
+}
+
+// SharedWithIsNil applies the IsNil predicate on the "shared_with" field.
+func SharedWithIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldSharedWith))
+}
+
+// SharedWithNotNil applies the NotNil predicate on the "shared_with" field.
+func SharedWithNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldSharedWith))
+}
+
 // HasMessages applies the HasEdge predicate on the "messages" edge.
 func HasMessages() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
@@ -745,6 +960,29 @@ func HasMessagesWith(preds ...predicate.Message) predicate.Task {
 	})
 }
 
+// HasModelCallTraces applies the HasEdge predicate on the "model_call_traces" edge.
+func HasModelCallTraces() predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ModelCallTracesTable, ModelCallTracesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasModelCallTracesWith applies the HasEdge predicate on the "model_call_traces" edge with a given conditions (other predicates).
+func HasModelCallTracesWith(preds ...predicate.ModelCallTrace) predicate.Task {
+	return predicate.Task(func(s *sql.Selector) {
+		step := newModelCallTracesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasAgent applies the HasEdge predicate on the "agent" edge.
 func HasAgent() predicate.Task {
 	return predicate.Task(func(s *sql.Selector) {
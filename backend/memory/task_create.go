@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/google/uuid"
@@ -136,6 +137,34 @@ func (tc *TaskCreate) SetNillableCost(f *float64) *TaskCreate {
 	return tc
 }
 
+// SetRoutedTurns sets the "routed_turns" field.
+func (tc *TaskCreate) SetRoutedTurns(i int64) *TaskCreate {
+	tc.mutation.SetRoutedTurns(i)
+	return tc
+}
+
+// SetNillableRoutedTurns sets the "routed_turns" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRoutedTurns(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetRoutedTurns(*i)
+	}
+	return tc
+}
+
+// SetRoutingSavings sets the "routing_savings" field.
+func (tc *TaskCreate) SetRoutingSavings(f float64) *TaskCreate {
+	tc.mutation.SetRoutingSavings(f)
+	return tc
+}
+
+// SetNillableRoutingSavings sets the "routing_savings" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableRoutingSavings(f *float64) *TaskCreate {
+	if f != nil {
+		tc.SetRoutingSavings(*f)
+	}
+	return tc
+}
+
 // SetTurns sets the "turns" field.
 func (tc *TaskCreate) SetTurns(i int64) *TaskCreate {
 	tc.mutation.SetTurns(i)
@@ -212,6 +241,40 @@ func (tc *TaskCreate) SetNillableAgentID(u *uuid.UUID) *TaskCreate {
 	return tc
 }
 
+// SetTraceModelCalls sets the "trace_model_calls" field.
+func (tc *TaskCreate) SetTraceModelCalls(b bool) *TaskCreate {
+	tc.mutation.SetTraceModelCalls(b)
+	return tc
+}
+
+// SetNillableTraceModelCalls sets the "trace_model_calls" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableTraceModelCalls(b *bool) *TaskCreate {
+	if b != nil {
+		tc.SetTraceModelCalls(*b)
+	}
+	return tc
+}
+
+// SetOwner sets the "owner" field.
+func (tc *TaskCreate) SetOwner(s string) *TaskCreate {
+	tc.mutation.SetOwner(s)
+	return tc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableOwner(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetOwner(*s)
+	}
+	return tc
+}
+
+// SetSharedWith sets the "shared_with" field.
+func (tc *TaskCreate) SetSharedWith(s []string) *TaskCreate {
+	tc.mutation.SetSharedWith(s)
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TaskCreate) SetID(u uuid.UUID) *TaskCreate {
 	tc.mutation.SetID(u)
@@ -241,6 +304,21 @@ func (tc *TaskCreate) AddMessages(m ...*Message) *TaskCreate {
 	return tc.AddMessageIDs(ids...)
 }
 
+// AddModelCallTraceIDs adds the "model_call_traces" edge to the ModelCallTrace entity by IDs.
+func (tc *TaskCreate) AddModelCallTraceIDs(ids ...uuid.UUID) *TaskCreate {
+	tc.mutation.AddModelCallTraceIDs(ids...)
+	return tc
+}
+
+// AddModelCallTraces adds the "model_call_traces" edges to the ModelCallTrace entity.
+func (tc *TaskCreate) AddModelCallTraces(m ...*ModelCallTrace) *TaskCreate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tc.AddModelCallTraceIDs(ids...)
+}
+
 // SetAgent sets the "agent" edge to the Agent entity.
 func (tc *TaskCreate) SetAgent(a *Agent) *TaskCreate {
 	return tc.SetAgentID(a.ID)
@@ -305,6 +383,10 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultPhase
 		tc.mutation.SetPhase(v)
 	}
+	if _, ok := tc.mutation.TraceModelCalls(); !ok {
+		v := task.DefaultTraceModelCalls
+		tc.mutation.SetTraceModelCalls(v)
+	}
 	if _, ok := tc.mutation.ID(); !ok {
 		v := task.DefaultID()
 		tc.mutation.SetID(v)
@@ -341,6 +423,9 @@ func (tc *TaskCreate) check() error {
 			return &ValidationError{Name: "phase", err: fmt.Errorf(`memory: validator failed for field "Task.phase": %w`, err)}
 		}
 	}
+	if _, ok := tc.mutation.TraceModelCalls(); !ok {
+		return &ValidationError{Name: "trace_model_calls", err: errors.New(`memory: missing required field "Task.trace_model_calls"`)}
+	}
 	return nil
 }
 
@@ -408,6 +493,14 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldCost, field.TypeFloat64, value)
 		_node.Cost = value
 	}
+	if value, ok := tc.mutation.RoutedTurns(); ok {
+		_spec.SetField(task.FieldRoutedTurns, field.TypeInt64, value)
+		_node.RoutedTurns = value
+	}
+	if value, ok := tc.mutation.RoutingSavings(); ok {
+		_spec.SetField(task.FieldRoutingSavings, field.TypeFloat64, value)
+		_node.RoutingSavings = value
+	}
 	if value, ok := tc.mutation.Turns(); ok {
 		_spec.SetField(task.FieldTurns, field.TypeInt64, value)
 		_node.Turns = value
@@ -428,6 +521,18 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := tc.mutation.TraceModelCalls(); ok {
+		_spec.SetField(task.FieldTraceModelCalls, field.TypeBool, value)
+		_node.TraceModelCalls = value
+	}
+	if value, ok := tc.mutation.Owner(); ok {
+		_spec.SetField(task.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := tc.mutation.SharedWith(); ok {
+		_spec.SetField(task.FieldSharedWith, field.TypeJSON, value)
+		_node.SharedWith = value
+	}
 	if nodes := tc.mutation.MessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -444,6 +549,22 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.ModelCallTracesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := tc.mutation.AgentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/task"
 	"github.com/google/uuid"
@@ -22,13 +23,14 @@ import (
 // TaskQuery is the builder for querying Task entities.
 type TaskQuery struct {
 	config
-	ctx          *QueryContext
-	order        []task.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Task
-	withMessages *MessageQuery
-	withAgent    *AgentQuery
-	modifiers    []func(*sql.Selector)
+	ctx                 *QueryContext
+	order               []task.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Task
+	withMessages        *MessageQuery
+	withModelCallTraces *ModelCallTraceQuery
+	withAgent           *AgentQuery
+	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -87,6 +89,28 @@ func (tq *TaskQuery) QueryMessages() *MessageQuery {
 	return query
 }
 
+// QueryModelCallTraces chains the current query on the "model_call_traces" edge.
+func (tq *TaskQuery) QueryModelCallTraces() *ModelCallTraceQuery {
+	query := (&ModelCallTraceClient{config: tq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(task.Table, task.FieldID, selector),
+			sqlgraph.To(modelcalltrace.Table, modelcalltrace.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, task.ModelCallTracesTable, task.ModelCallTracesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryAgent chains the current query on the "agent" edge.
 func (tq *TaskQuery) QueryAgent() *AgentQuery {
 	query := (&AgentClient{config: tq.config}).Query()
@@ -296,13 +320,14 @@ func (tq *TaskQuery) Clone() *TaskQuery {
 		return nil
 	}
 	return &TaskQuery{
-		config:       tq.config,
-		ctx:          tq.ctx.Clone(),
-		order:        append([]task.OrderOption{}, tq.order...),
-		inters:       append([]Interceptor{}, tq.inters...),
-		predicates:   append([]predicate.Task{}, tq.predicates...),
-		withMessages: tq.withMessages.Clone(),
-		withAgent:    tq.withAgent.Clone(),
+		config:              tq.config,
+		ctx:                 tq.ctx.Clone(),
+		order:               append([]task.OrderOption{}, tq.order...),
+		inters:              append([]Interceptor{}, tq.inters...),
+		predicates:          append([]predicate.Task{}, tq.predicates...),
+		withMessages:        tq.withMessages.Clone(),
+		withModelCallTraces: tq.withModelCallTraces.Clone(),
+		withAgent:           tq.withAgent.Clone(),
 		// clone intermediate query.
 		sql:       tq.sql.Clone(),
 		path:      tq.path,
@@ -321,6 +346,17 @@ func (tq *TaskQuery) WithMessages(opts ...func(*MessageQuery)) *TaskQuery {
 	return tq
 }
 
+// WithModelCallTraces tells the query-builder to eager-load the nodes that are connected to
+// the "model_call_traces" edge. The optional arguments are used to configure the query builder of the edge.
+func (tq *TaskQuery) WithModelCallTraces(opts ...func(*ModelCallTraceQuery)) *TaskQuery {
+	query := (&ModelCallTraceClient{config: tq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tq.withModelCallTraces = query
+	return tq
+}
+
 // WithAgent tells the query-builder to eager-load the nodes that are connected to
 // the "agent" edge. The optional arguments are used to configure the query builder of the edge.
 func (tq *TaskQuery) WithAgent(opts ...func(*AgentQuery)) *TaskQuery {
@@ -410,8 +446,9 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 	var (
 		nodes       = []*Task{}
 		_spec       = tq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			tq.withMessages != nil,
+			tq.withModelCallTraces != nil,
 			tq.withAgent != nil,
 		}
 	)
@@ -443,6 +480,13 @@ func (tq *TaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Task, e
 			return nil, err
 		}
 	}
+	if query := tq.withModelCallTraces; query != nil {
+		if err := tq.loadModelCallTraces(ctx, query, nodes,
+			func(n *Task) { n.Edges.ModelCallTraces = []*ModelCallTrace{} },
+			func(n *Task, e *ModelCallTrace) { n.Edges.ModelCallTraces = append(n.Edges.ModelCallTraces, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := tq.withAgent; query != nil {
 		if err := tq.loadAgent(ctx, query, nodes, nil,
 			func(n *Task, e *Agent) { n.Edges.Agent = e }); err != nil {
@@ -482,6 +526,36 @@ func (tq *TaskQuery) loadMessages(ctx context.Context, query *MessageQuery, node
 	}
 	return nil
 }
+func (tq *TaskQuery) loadModelCallTraces(ctx context.Context, query *ModelCallTraceQuery, nodes []*Task, init func(*Task), assign func(*Task, *ModelCallTrace)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Task)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(modelcalltrace.FieldTaskID)
+	}
+	query.Where(predicate.ModelCallTrace(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(task.ModelCallTracesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TaskID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "task_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (tq *TaskQuery) loadAgent(ctx context.Context, query *AgentQuery, nodes []*Task, init func(*Task), assign func(*Task, *Agent)) error {
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Task)
@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/agent"
 	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/modelcalltrace"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/task"
@@ -194,6 +196,60 @@ func (tu *TaskUpdate) ClearCost() *TaskUpdate {
 	return tu
 }
 
+// SetRoutedTurns sets the "routed_turns" field.
+func (tu *TaskUpdate) SetRoutedTurns(i int64) *TaskUpdate {
+	tu.mutation.ResetRoutedTurns()
+	tu.mutation.SetRoutedTurns(i)
+	return tu
+}
+
+// SetNillableRoutedTurns sets the "routed_turns" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRoutedTurns(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetRoutedTurns(*i)
+	}
+	return tu
+}
+
+// AddRoutedTurns adds i to the "routed_turns" field.
+func (tu *TaskUpdate) AddRoutedTurns(i int64) *TaskUpdate {
+	tu.mutation.AddRoutedTurns(i)
+	return tu
+}
+
+// ClearRoutedTurns clears the value of the "routed_turns" field.
+func (tu *TaskUpdate) ClearRoutedTurns() *TaskUpdate {
+	tu.mutation.ClearRoutedTurns()
+	return tu
+}
+
+// SetRoutingSavings sets the "routing_savings" field.
+func (tu *TaskUpdate) SetRoutingSavings(f float64) *TaskUpdate {
+	tu.mutation.ResetRoutingSavings()
+	tu.mutation.SetRoutingSavings(f)
+	return tu
+}
+
+// SetNillableRoutingSavings sets the "routing_savings" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableRoutingSavings(f *float64) *TaskUpdate {
+	if f != nil {
+		tu.SetRoutingSavings(*f)
+	}
+	return tu
+}
+
+// AddRoutingSavings adds f to the "routing_savings" field.
+func (tu *TaskUpdate) AddRoutingSavings(f float64) *TaskUpdate {
+	tu.mutation.AddRoutingSavings(f)
+	return tu
+}
+
+// ClearRoutingSavings clears the value of the "routing_savings" field.
+func (tu *TaskUpdate) ClearRoutingSavings() *TaskUpdate {
+	tu.mutation.ClearRoutingSavings()
+	return tu
+}
+
 // SetTurns sets the "turns" field.
 func (tu *TaskUpdate) SetTurns(i int64) *TaskUpdate {
 	tu.mutation.ResetTurns()
@@ -289,6 +345,58 @@ func (tu *TaskUpdate) ClearAgentID() *TaskUpdate {
 	return tu
 }
 
+// SetTraceModelCalls sets the "trace_model_calls" field.
+func (tu *TaskUpdate) SetTraceModelCalls(b bool) *TaskUpdate {
+	tu.mutation.SetTraceModelCalls(b)
+	return tu
+}
+
+// SetNillableTraceModelCalls sets the "trace_model_calls" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableTraceModelCalls(b *bool) *TaskUpdate {
+	if b != nil {
+		tu.SetTraceModelCalls(*b)
+	}
+	return tu
+}
+
+// SetOwner sets the "owner" field.
+func (tu *TaskUpdate) SetOwner(s string) *TaskUpdate {
+	tu.mutation.SetOwner(s)
+	return tu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableOwner(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetOwner(*s)
+	}
+	return tu
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (tu *TaskUpdate) ClearOwner() *TaskUpdate {
+	tu.mutation.ClearOwner()
+	return tu
+}
+
+// SetSharedWith sets the "shared_with" field.
+func (tu *TaskUpdate) SetSharedWith(s []string) *TaskUpdate {
+	tu.mutation.SetSharedWith(s)
+	return tu
+}
+
+// AppendSharedWith appends s to the "shared_with" field.
+func (tu *TaskUpdate) AppendSharedWith(s []string) *TaskUpdate {
+	tu.mutation.AppendSharedWith(s)
+	return tu
+}
+
+// ClearSharedWith clears the value of the "shared_with" field.
+func (tu *TaskUpdate) ClearSharedWith() *TaskUpdate {
+	tu.mutation.ClearSharedWith()
+	return tu
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (tu *TaskUpdate) AddMessageIDs(ids ...uuid.UUID) *TaskUpdate {
 	tu.mutation.AddMessageIDs(ids...)
@@ -304,6 +412,21 @@ func (tu *TaskUpdate) AddMessages(m ...*Message) *TaskUpdate {
 	return tu.AddMessageIDs(ids...)
 }
 
+// AddModelCallTraceIDs adds the "model_call_traces" edge to the ModelCallTrace entity by IDs.
+func (tu *TaskUpdate) AddModelCallTraceIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.AddModelCallTraceIDs(ids...)
+	return tu
+}
+
+// AddModelCallTraces adds the "model_call_traces" edges to the ModelCallTrace entity.
+func (tu *TaskUpdate) AddModelCallTraces(m ...*ModelCallTrace) *TaskUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tu.AddModelCallTraceIDs(ids...)
+}
+
 // SetAgent sets the "agent" edge to the Agent entity.
 func (tu *TaskUpdate) SetAgent(a *Agent) *TaskUpdate {
 	return tu.SetAgentID(a.ID)
@@ -335,6 +458,27 @@ func (tu *TaskUpdate) RemoveMessages(m ...*Message) *TaskUpdate {
 	return tu.RemoveMessageIDs(ids...)
 }
 
+// ClearModelCallTraces clears all "model_call_traces" edges to the ModelCallTrace entity.
+func (tu *TaskUpdate) ClearModelCallTraces() *TaskUpdate {
+	tu.mutation.ClearModelCallTraces()
+	return tu
+}
+
+// RemoveModelCallTraceIDs removes the "model_call_traces" edge to ModelCallTrace entities by IDs.
+func (tu *TaskUpdate) RemoveModelCallTraceIDs(ids ...uuid.UUID) *TaskUpdate {
+	tu.mutation.RemoveModelCallTraceIDs(ids...)
+	return tu
+}
+
+// RemoveModelCallTraces removes "model_call_traces" edges to ModelCallTrace entities.
+func (tu *TaskUpdate) RemoveModelCallTraces(m ...*ModelCallTrace) *TaskUpdate {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tu.RemoveModelCallTraceIDs(ids...)
+}
+
 // ClearAgent clears the "agent" edge to the Agent entity.
 func (tu *TaskUpdate) ClearAgent() *TaskUpdate {
 	tu.mutation.ClearAgent()
@@ -464,6 +608,24 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.CostCleared() {
 		_spec.ClearField(task.FieldCost, field.TypeFloat64)
 	}
+	if value, ok := tu.mutation.RoutedTurns(); ok {
+		_spec.SetField(task.FieldRoutedTurns, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedRoutedTurns(); ok {
+		_spec.AddField(task.FieldRoutedTurns, field.TypeInt64, value)
+	}
+	if tu.mutation.RoutedTurnsCleared() {
+		_spec.ClearField(task.FieldRoutedTurns, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.RoutingSavings(); ok {
+		_spec.SetField(task.FieldRoutingSavings, field.TypeFloat64, value)
+	}
+	if value, ok := tu.mutation.AddedRoutingSavings(); ok {
+		_spec.AddField(task.FieldRoutingSavings, field.TypeFloat64, value)
+	}
+	if tu.mutation.RoutingSavingsCleared() {
+		_spec.ClearField(task.FieldRoutingSavings, field.TypeFloat64)
+	}
 	if value, ok := tu.mutation.Turns(); ok {
 		_spec.SetField(task.FieldTurns, field.TypeInt64, value)
 	}
@@ -485,6 +647,26 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
+	if value, ok := tu.mutation.TraceModelCalls(); ok {
+		_spec.SetField(task.FieldTraceModelCalls, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.Owner(); ok {
+		_spec.SetField(task.FieldOwner, field.TypeString, value)
+	}
+	if tu.mutation.OwnerCleared() {
+		_spec.ClearField(task.FieldOwner, field.TypeString)
+	}
+	if value, ok := tu.mutation.SharedWith(); ok {
+		_spec.SetField(task.FieldSharedWith, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedSharedWith(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldSharedWith, value)
+		})
+	}
+	if tu.mutation.SharedWithCleared() {
+		_spec.ClearField(task.FieldSharedWith, field.TypeJSON)
+	}
 	if tu.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -530,6 +712,51 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.ModelCallTracesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedModelCallTracesIDs(); len(nodes) > 0 && !tu.mutation.ModelCallTracesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.ModelCallTracesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tu.mutation.AgentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -742,6 +969,60 @@ func (tuo *TaskUpdateOne) ClearCost() *TaskUpdateOne {
 	return tuo
 }
 
+// SetRoutedTurns sets the "routed_turns" field.
+func (tuo *TaskUpdateOne) SetRoutedTurns(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetRoutedTurns()
+	tuo.mutation.SetRoutedTurns(i)
+	return tuo
+}
+
+// SetNillableRoutedTurns sets the "routed_turns" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRoutedTurns(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetRoutedTurns(*i)
+	}
+	return tuo
+}
+
+// AddRoutedTurns adds i to the "routed_turns" field.
+func (tuo *TaskUpdateOne) AddRoutedTurns(i int64) *TaskUpdateOne {
+	tuo.mutation.AddRoutedTurns(i)
+	return tuo
+}
+
+// ClearRoutedTurns clears the value of the "routed_turns" field.
+func (tuo *TaskUpdateOne) ClearRoutedTurns() *TaskUpdateOne {
+	tuo.mutation.ClearRoutedTurns()
+	return tuo
+}
+
+// SetRoutingSavings sets the "routing_savings" field.
+func (tuo *TaskUpdateOne) SetRoutingSavings(f float64) *TaskUpdateOne {
+	tuo.mutation.ResetRoutingSavings()
+	tuo.mutation.SetRoutingSavings(f)
+	return tuo
+}
+
+// SetNillableRoutingSavings sets the "routing_savings" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableRoutingSavings(f *float64) *TaskUpdateOne {
+	if f != nil {
+		tuo.SetRoutingSavings(*f)
+	}
+	return tuo
+}
+
+// AddRoutingSavings adds f to the "routing_savings" field.
+func (tuo *TaskUpdateOne) AddRoutingSavings(f float64) *TaskUpdateOne {
+	tuo.mutation.AddRoutingSavings(f)
+	return tuo
+}
+
+// ClearRoutingSavings clears the value of the "routing_savings" field.
+func (tuo *TaskUpdateOne) ClearRoutingSavings() *TaskUpdateOne {
+	tuo.mutation.ClearRoutingSavings()
+	return tuo
+}
+
 // SetTurns sets the "turns" field.
 func (tuo *TaskUpdateOne) SetTurns(i int64) *TaskUpdateOne {
 	tuo.mutation.ResetTurns()
@@ -837,6 +1118,58 @@ func (tuo *TaskUpdateOne) ClearAgentID() *TaskUpdateOne {
 	return tuo
 }
 
+// SetTraceModelCalls sets the "trace_model_calls" field.
+func (tuo *TaskUpdateOne) SetTraceModelCalls(b bool) *TaskUpdateOne {
+	tuo.mutation.SetTraceModelCalls(b)
+	return tuo
+}
+
+// SetNillableTraceModelCalls sets the "trace_model_calls" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableTraceModelCalls(b *bool) *TaskUpdateOne {
+	if b != nil {
+		tuo.SetTraceModelCalls(*b)
+	}
+	return tuo
+}
+
+// SetOwner sets the "owner" field.
+func (tuo *TaskUpdateOne) SetOwner(s string) *TaskUpdateOne {
+	tuo.mutation.SetOwner(s)
+	return tuo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableOwner(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetOwner(*s)
+	}
+	return tuo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (tuo *TaskUpdateOne) ClearOwner() *TaskUpdateOne {
+	tuo.mutation.ClearOwner()
+	return tuo
+}
+
+// SetSharedWith sets the "shared_with" field.
+func (tuo *TaskUpdateOne) SetSharedWith(s []string) *TaskUpdateOne {
+	tuo.mutation.SetSharedWith(s)
+	return tuo
+}
+
+// AppendSharedWith appends s to the "shared_with" field.
+func (tuo *TaskUpdateOne) AppendSharedWith(s []string) *TaskUpdateOne {
+	tuo.mutation.AppendSharedWith(s)
+	return tuo
+}
+
+// ClearSharedWith clears the value of the "shared_with" field.
+func (tuo *TaskUpdateOne) ClearSharedWith() *TaskUpdateOne {
+	tuo.mutation.ClearSharedWith()
+	return tuo
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (tuo *TaskUpdateOne) AddMessageIDs(ids ...uuid.UUID) *TaskUpdateOne {
 	tuo.mutation.AddMessageIDs(ids...)
@@ -852,6 +1185,21 @@ func (tuo *TaskUpdateOne) AddMessages(m ...*Message) *TaskUpdateOne {
 	return tuo.AddMessageIDs(ids...)
 }
 
+// AddModelCallTraceIDs adds the "model_call_traces" edge to the ModelCallTrace entity by IDs.
+func (tuo *TaskUpdateOne) AddModelCallTraceIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.AddModelCallTraceIDs(ids...)
+	return tuo
+}
+
+// AddModelCallTraces adds the "model_call_traces" edges to the ModelCallTrace entity.
+func (tuo *TaskUpdateOne) AddModelCallTraces(m ...*ModelCallTrace) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tuo.AddModelCallTraceIDs(ids...)
+}
+
 // SetAgent sets the "agent" edge to the Agent entity.
 func (tuo *TaskUpdateOne) SetAgent(a *Agent) *TaskUpdateOne {
 	return tuo.SetAgentID(a.ID)
@@ -883,6 +1231,27 @@ func (tuo *TaskUpdateOne) RemoveMessages(m ...*Message) *TaskUpdateOne {
 	return tuo.RemoveMessageIDs(ids...)
 }
 
+// ClearModelCallTraces clears all "model_call_traces" edges to the ModelCallTrace entity.
+func (tuo *TaskUpdateOne) ClearModelCallTraces() *TaskUpdateOne {
+	tuo.mutation.ClearModelCallTraces()
+	return tuo
+}
+
+// RemoveModelCallTraceIDs removes the "model_call_traces" edge to ModelCallTrace entities by IDs.
+func (tuo *TaskUpdateOne) RemoveModelCallTraceIDs(ids ...uuid.UUID) *TaskUpdateOne {
+	tuo.mutation.RemoveModelCallTraceIDs(ids...)
+	return tuo
+}
+
+// RemoveModelCallTraces removes "model_call_traces" edges to ModelCallTrace entities.
+func (tuo *TaskUpdateOne) RemoveModelCallTraces(m ...*ModelCallTrace) *TaskUpdateOne {
+	ids := make([]uuid.UUID, len(m))
+	for i := range m {
+		ids[i] = m[i].ID
+	}
+	return tuo.RemoveModelCallTraceIDs(ids...)
+}
+
 // ClearAgent clears the "agent" edge to the Agent entity.
 func (tuo *TaskUpdateOne) ClearAgent() *TaskUpdateOne {
 	tuo.mutation.ClearAgent()
@@ -1042,6 +1411,24 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.CostCleared() {
 		_spec.ClearField(task.FieldCost, field.TypeFloat64)
 	}
+	if value, ok := tuo.mutation.RoutedTurns(); ok {
+		_spec.SetField(task.FieldRoutedTurns, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedRoutedTurns(); ok {
+		_spec.AddField(task.FieldRoutedTurns, field.TypeInt64, value)
+	}
+	if tuo.mutation.RoutedTurnsCleared() {
+		_spec.ClearField(task.FieldRoutedTurns, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.RoutingSavings(); ok {
+		_spec.SetField(task.FieldRoutingSavings, field.TypeFloat64, value)
+	}
+	if value, ok := tuo.mutation.AddedRoutingSavings(); ok {
+		_spec.AddField(task.FieldRoutingSavings, field.TypeFloat64, value)
+	}
+	if tuo.mutation.RoutingSavingsCleared() {
+		_spec.ClearField(task.FieldRoutingSavings, field.TypeFloat64)
+	}
 	if value, ok := tuo.mutation.Turns(); ok {
 		_spec.SetField(task.FieldTurns, field.TypeInt64, value)
 	}
@@ -1063,6 +1450,26 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.DescriptionCleared() {
 		_spec.ClearField(task.FieldDescription, field.TypeString)
 	}
+	if value, ok := tuo.mutation.TraceModelCalls(); ok {
+		_spec.SetField(task.FieldTraceModelCalls, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.Owner(); ok {
+		_spec.SetField(task.FieldOwner, field.TypeString, value)
+	}
+	if tuo.mutation.OwnerCleared() {
+		_spec.ClearField(task.FieldOwner, field.TypeString)
+	}
+	if value, ok := tuo.mutation.SharedWith(); ok {
+		_spec.SetField(task.FieldSharedWith, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedSharedWith(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldSharedWith, value)
+		})
+	}
+	if tuo.mutation.SharedWithCleared() {
+		_spec.ClearField(task.FieldSharedWith, field.TypeJSON)
+	}
 	if tuo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1108,6 +1515,51 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.ModelCallTracesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedModelCallTracesIDs(); len(nodes) > 0 && !tuo.mutation.ModelCallTracesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.ModelCallTracesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   task.ModelCallTracesTable,
+			Columns: []string{task.ModelCallTracesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(modelcalltrace.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if tuo.mutation.AgentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -38,6 +38,7 @@ type ModelProviderBuilder struct {
 	name         string
 	secret       []byte
 	enabled      bool
+	rateLimit    *types.ModelProviderRateLimit
 }
 
 func NewModelProviderBuilder(t *testing.T, id uuid.UUID, db *memory.Client) *ModelProviderBuilder {
@@ -71,6 +72,11 @@ func (b *ModelProviderBuilder) WithEnabled(enabled bool) *ModelProviderBuilder {
 	return b
 }
 
+func (b *ModelProviderBuilder) WithRateLimit(rateLimit *types.ModelProviderRateLimit) *ModelProviderBuilder {
+	b.rateLimit = rateLimit
+	return b
+}
+
 func (b *ModelProviderBuilder) Build(ctx context.Context) *memory.ModelProvider {
 	modelProvider, err := b.db.ModelProvider.Create().
 		SetID(b.modelProviderID).
@@ -78,6 +84,7 @@ func (b *ModelProviderBuilder) Build(ctx context.Context) *memory.ModelProvider
 		SetProviderType(b.providerType).
 		SetSecret(b.secret).
 		SetEnabled(b.enabled).
+		SetRateLimit(b.rateLimit).
 		Save(ctx)
 
 	if err != nil {
@@ -87,6 +94,66 @@ func (b *ModelProviderBuilder) Build(ctx context.Context) *memory.ModelProvider
 	return modelProvider
 }
 
+type ModelProviderKeyBuilder struct {
+	*entityBuilder
+	keyID uuid.UUID
+
+	modelProviderID uuid.UUID
+	name            string
+	secret          []byte
+	priority        int
+	retiredTime     time.Time
+}
+
+func NewModelProviderKeyBuilder(t *testing.T, id uuid.UUID, db *memory.Client, modelProvider *memory.ModelProvider) *ModelProviderKeyBuilder {
+	if modelProvider == nil {
+		t.Fatal("model provider is required")
+	}
+
+	return &ModelProviderKeyBuilder{
+		entityBuilder:   newEntityBuilder(t, db),
+		keyID:           id,
+		modelProviderID: modelProvider.ID,
+		name:            "default",
+		secret:          []byte("mock-secret"),
+	}
+}
+
+func (b *ModelProviderKeyBuilder) WithName(name string) *ModelProviderKeyBuilder {
+	b.name = name
+	return b
+}
+
+func (b *ModelProviderKeyBuilder) WithPriority(priority int) *ModelProviderKeyBuilder {
+	b.priority = priority
+	return b
+}
+
+func (b *ModelProviderKeyBuilder) WithRetiredTime(retiredTime time.Time) *ModelProviderKeyBuilder {
+	b.retiredTime = retiredTime
+	return b
+}
+
+func (b *ModelProviderKeyBuilder) Build(ctx context.Context) *memory.ModelProviderKey {
+	create := b.db.ModelProviderKey.Create().
+		SetID(b.keyID).
+		SetModelProviderID(b.modelProviderID).
+		SetName(b.name).
+		SetSecret(b.secret).
+		SetPriority(b.priority)
+
+	if !b.retiredTime.IsZero() {
+		create = create.SetRetiredTime(b.retiredTime)
+	}
+
+	key, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create model provider key: %v", err)
+	}
+
+	return key
+}
+
 type ModelBuilder struct {
 	*entityBuilder
 	modelID uuid.UUID
@@ -226,7 +293,11 @@ type TaskBuilder struct {
 	*entityBuilder
 	taskID uuid.UUID
 
-	agentID uuid.UUID
+	agentID          uuid.UUID
+	projectDirectory string
+	owner            string
+	sharedWith       []string
+	phase            types.TaskPhase
 }
 
 func NewTaskBuilder(t *testing.T, id uuid.UUID, db *memory.Client, agent *memory.Agent) *TaskBuilder {
@@ -242,11 +313,48 @@ func (b *TaskBuilder) WithID(id uuid.UUID) *TaskBuilder {
 	return b
 }
 
+func (b *TaskBuilder) WithProjectDirectory(projectDirectory string) *TaskBuilder {
+	b.projectDirectory = projectDirectory
+	return b
+}
+
+func (b *TaskBuilder) WithOwner(owner string) *TaskBuilder {
+	b.owner = owner
+	return b
+}
+
+func (b *TaskBuilder) WithSharedWith(subjects ...string) *TaskBuilder {
+	b.sharedWith = subjects
+	return b
+}
+
+func (b *TaskBuilder) WithPhase(phase types.TaskPhase) *TaskBuilder {
+	b.phase = phase
+	return b
+}
+
 func (b *TaskBuilder) Build(ctx context.Context) *memory.Task {
-	task, err := b.db.Task.Create().
+	create := b.db.Task.Create().
 		SetID(b.taskID).
-		SetAgentID(b.agentID).
-		Save(ctx)
+		SetAgentID(b.agentID)
+
+	if b.projectDirectory != "" {
+		create = create.SetProjectDirectory(b.projectDirectory)
+	}
+
+	if b.owner != "" {
+		create = create.SetOwner(b.owner)
+	}
+
+	if len(b.sharedWith) > 0 {
+		create = create.SetSharedWith(b.sharedWith)
+	}
+
+	if b.phase != "" {
+		create = create.SetPhase(b.phase)
+	}
+
+	task, err := create.Save(ctx)
 
 	if err != nil {
 		b.t.Fatalf("failed to create task: %v", err)
@@ -261,10 +369,12 @@ type MessageBuilder struct {
 
 	taskID uuid.UUID
 
-	agentID uuid.UUID
-	modelID uuid.UUID
-	source  types.MessageSource
-	content *types.MessageContent
+	agentID    uuid.UUID
+	modelID    uuid.UUID
+	source     types.MessageSource
+	content    *types.MessageContent
+	usage      *types.MessageUsage
+	createTime time.Time
 }
 
 func NewMessageBuilder(t *testing.T, id uuid.UUID, db *memory.Client, task *memory.Task) *MessageBuilder {
@@ -303,6 +413,16 @@ func (b *MessageBuilder) WithID(id uuid.UUID) *MessageBuilder {
 	return b
 }
 
+func (b *MessageBuilder) WithUsage(usage *types.MessageUsage) *MessageBuilder {
+	b.usage = usage
+	return b
+}
+
+func (b *MessageBuilder) WithCreateTime(createTime time.Time) *MessageBuilder {
+	b.createTime = createTime
+	return b
+}
+
 func (b *MessageBuilder) Build(ctx context.Context) *memory.Message {
 	create := b.db.Message.Create().
 		SetID(b.messageID).
@@ -318,6 +438,14 @@ func (b *MessageBuilder) Build(ctx context.Context) *memory.Message {
 		create.SetModelID(b.modelID)
 	}
 
+	if b.usage != nil {
+		create.SetUsage(b.usage)
+	}
+
+	if !b.createTime.IsZero() {
+		create.SetCreateTime(b.createTime)
+	}
+
 	message, err := create.Save(ctx)
 
 	if err != nil {
@@ -331,9 +459,13 @@ type TokenBuilder struct {
 	*entityBuilder
 	tokenID uuid.UUID
 
-	name      string
-	expiresAt time.Time
-	tokenHash []byte
+	name       string
+	expiresAt  time.Time
+	tokenHash  []byte
+	scopes     []string
+	agentIDs   []uuid.UUID
+	workspaces []string
+	quota      *types.Quota
 }
 
 func NewTokenBuilder(t *testing.T, id uuid.UUID, db *memory.Client) *TokenBuilder {
@@ -356,14 +488,53 @@ func (b *TokenBuilder) WithExpiresAt(expiresAt time.Time) *TokenBuilder {
 	return b
 }
 
+func (b *TokenBuilder) WithTokenHash(tokenHash []byte) *TokenBuilder {
+	b.tokenHash = tokenHash
+	return b
+}
+
+func (b *TokenBuilder) WithScopes(scopes ...string) *TokenBuilder {
+	b.scopes = scopes
+	return b
+}
+
+func (b *TokenBuilder) WithAgentIDs(agentIDs ...uuid.UUID) *TokenBuilder {
+	b.agentIDs = agentIDs
+	return b
+}
+
+func (b *TokenBuilder) WithWorkspaces(workspaces ...string) *TokenBuilder {
+	b.workspaces = workspaces
+	return b
+}
+
+func (b *TokenBuilder) WithQuota(quota types.Quota) *TokenBuilder {
+	b.quota = &quota
+	return b
+}
+
 func (b *TokenBuilder) Build(ctx context.Context) *memory.Token {
-	token, err := b.db.Token.Create().
+	create := b.db.Token.Create().
 		SetID(b.tokenID).
 		SetName(b.name).
 		SetType(types.TokenTypeAPIToken).
 		SetTokenHash(b.tokenHash).
-		SetExpiresAt(b.expiresAt).
-		Save(ctx)
+		SetExpiresAt(b.expiresAt)
+
+	if b.scopes != nil {
+		create = create.SetScopes(b.scopes)
+	}
+	if b.agentIDs != nil {
+		create = create.SetAgentIds(b.agentIDs)
+	}
+	if b.workspaces != nil {
+		create = create.SetWorkspaces(b.workspaces)
+	}
+	if b.quota != nil {
+		create = create.SetQuota(b.quota)
+	}
+
+	token, err := create.Save(ctx)
 
 	if err != nil {
 		b.t.Fatalf("failed to create token: %v", err)
@@ -371,3 +542,215 @@ func (b *TokenBuilder) Build(ctx context.Context) *memory.Token {
 
 	return token
 }
+
+type WebhookBuilder struct {
+	*entityBuilder
+	webhookID uuid.UUID
+
+	url        string
+	eventTypes []string
+	taskID     *uuid.UUID
+	enabled    bool
+	secret     []byte
+}
+
+func NewWebhookBuilder(t *testing.T, id uuid.UUID, db *memory.Client) *WebhookBuilder {
+	return &WebhookBuilder{
+		entityBuilder: newEntityBuilder(t, db),
+		webhookID:     id,
+		url:           "https://example.com/hooks/construct",
+		enabled:       true,
+		secret:        []byte("mock-secret"),
+	}
+}
+
+func (b *WebhookBuilder) WithURL(url string) *WebhookBuilder {
+	b.url = url
+	return b
+}
+
+func (b *WebhookBuilder) WithEventTypes(eventTypes ...string) *WebhookBuilder {
+	b.eventTypes = eventTypes
+	return b
+}
+
+func (b *WebhookBuilder) WithTaskID(taskID uuid.UUID) *WebhookBuilder {
+	b.taskID = &taskID
+	return b
+}
+
+func (b *WebhookBuilder) WithEnabled(enabled bool) *WebhookBuilder {
+	b.enabled = enabled
+	return b
+}
+
+func (b *WebhookBuilder) Build(ctx context.Context) *memory.Webhook {
+	create := b.db.Webhook.Create().
+		SetID(b.webhookID).
+		SetURL(b.url).
+		SetNillableTaskID(b.taskID).
+		SetEnabled(b.enabled).
+		SetSecret(b.secret)
+
+	if len(b.eventTypes) > 0 {
+		create = create.SetEventTypes(b.eventTypes)
+	}
+
+	webhook, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create webhook: %v", err)
+	}
+
+	return webhook
+}
+
+type WebhookDeliveryBuilder struct {
+	*entityBuilder
+	deliveryID uuid.UUID
+
+	webhookID     uuid.UUID
+	eventType     string
+	eventSequence int64
+	state         types.WebhookDeliveryState
+	attempts      int
+	lastError     string
+	createTime    time.Time
+}
+
+func NewWebhookDeliveryBuilder(t *testing.T, id uuid.UUID, db *memory.Client, webhook *memory.Webhook) *WebhookDeliveryBuilder {
+	return &WebhookDeliveryBuilder{
+		entityBuilder: newEntityBuilder(t, db),
+		deliveryID:    id,
+		webhookID:     webhook.ID,
+		eventType:     "task.created",
+		state:         types.WebhookDeliveryStatePending,
+		createTime:    time.Now(),
+	}
+}
+
+func (b *WebhookDeliveryBuilder) WithEventSequence(sequence int64) *WebhookDeliveryBuilder {
+	b.eventSequence = sequence
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) WithState(state types.WebhookDeliveryState) *WebhookDeliveryBuilder {
+	b.state = state
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) WithAttempts(attempts int, lastError string) *WebhookDeliveryBuilder {
+	b.attempts = attempts
+	b.lastError = lastError
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) WithCreateTime(createTime time.Time) *WebhookDeliveryBuilder {
+	b.createTime = createTime
+	return b
+}
+
+func (b *WebhookDeliveryBuilder) Build(ctx context.Context) *memory.WebhookDelivery {
+	create := b.db.WebhookDelivery.Create().
+		SetID(b.deliveryID).
+		SetWebhookID(b.webhookID).
+		SetEventType(b.eventType).
+		SetEventSequence(b.eventSequence).
+		SetPayload([]byte("{}")).
+		SetState(b.state).
+		SetAttempts(b.attempts).
+		SetNextAttemptTime(b.createTime).
+		SetCreateTime(b.createTime)
+
+	if b.lastError != "" {
+		create = create.SetLastError(b.lastError)
+	}
+
+	delivery, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create webhook delivery: %v", err)
+	}
+
+	return delivery
+}
+
+type AuditEventBuilder struct {
+	*entityBuilder
+	eventID uuid.UUID
+
+	kind        types.AuditEventKind
+	action      string
+	subject     string
+	resourceIDs []string
+	outcome     types.AuditOutcome
+	taskID      *uuid.UUID
+	createTime  time.Time
+}
+
+func NewAuditEventBuilder(t *testing.T, id uuid.UUID, db *memory.Client) *AuditEventBuilder {
+	return &AuditEventBuilder{
+		entityBuilder: newEntityBuilder(t, db),
+		eventID:       id,
+		kind:          types.AuditEventKindAPI,
+		action:        "/construct.v1.AgentService/CreateAgent",
+		subject:       "local-admin",
+		outcome:       types.AuditOutcomeSuccess,
+		createTime:    time.Now(),
+	}
+}
+
+func (b *AuditEventBuilder) WithKind(kind types.AuditEventKind) *AuditEventBuilder {
+	b.kind = kind
+	return b
+}
+
+func (b *AuditEventBuilder) WithAction(action string) *AuditEventBuilder {
+	b.action = action
+	return b
+}
+
+func (b *AuditEventBuilder) WithSubject(subject string) *AuditEventBuilder {
+	b.subject = subject
+	return b
+}
+
+func (b *AuditEventBuilder) WithResourceIDs(ids ...string) *AuditEventBuilder {
+	b.resourceIDs = ids
+	return b
+}
+
+func (b *AuditEventBuilder) WithOutcome(outcome types.AuditOutcome) *AuditEventBuilder {
+	b.outcome = outcome
+	return b
+}
+
+func (b *AuditEventBuilder) WithTaskID(taskID uuid.UUID) *AuditEventBuilder {
+	b.taskID = &taskID
+	return b
+}
+
+func (b *AuditEventBuilder) WithCreateTime(createTime time.Time) *AuditEventBuilder {
+	b.createTime = createTime
+	return b
+}
+
+func (b *AuditEventBuilder) Build(ctx context.Context) *memory.AuditEvent {
+	create := b.db.AuditEvent.Create().
+		SetID(b.eventID).
+		SetKind(b.kind).
+		SetAction(b.action).
+		SetSubject(b.subject).
+		SetOutcome(b.outcome).
+		SetNillableTaskID(b.taskID).
+		SetCreateTime(b.createTime)
+
+	if len(b.resourceIDs) > 0 {
+		create = create.SetResourceIds(b.resourceIDs)
+	}
+
+	event, err := create.Save(ctx)
+	if err != nil {
+		b.t.Fatalf("failed to create audit event: %v", err)
+	}
+
+	return event
+}
@@ -3,6 +3,7 @@
 package memory
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -32,8 +33,26 @@ type Token struct {
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
-	ExpiresAt    time.Time `json:"expires_at,omitempty"`
-	selectValues sql.SelectValues
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// AgentIds holds the value of the "agent_ids" field.
+	AgentIds []uuid.UUID `json:"agent_ids,omitempty"`
+	// Workspaces holds the value of the "workspaces" field.
+	Workspaces []string `json:"workspaces,omitempty"`
+	// Quota holds the value of the "quota" field.
+	Quota *types.Quota `json:"quota,omitempty"`
+	// RotatedAt holds the value of the "rotated_at" field.
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	// PreviousTokenHash holds the value of the "previous_token_hash" field.
+	PreviousTokenHash []byte `json:"previous_token_hash,omitempty"`
+	// PreviousExpiresAt holds the value of the "previous_expires_at" field.
+	PreviousExpiresAt *time.Time `json:"previous_expires_at,omitempty"`
+	// LastUsedAt holds the value of the "last_used_at" field.
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	// LastUsedAddress holds the value of the "last_used_address" field.
+	LastUsedAddress string `json:"last_used_address,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -41,11 +60,11 @@ func (*Token) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case token.FieldTokenHash:
+		case token.FieldTokenHash, token.FieldScopes, token.FieldAgentIds, token.FieldWorkspaces, token.FieldQuota, token.FieldPreviousTokenHash:
 			values[i] = new([]byte)
-		case token.FieldName, token.FieldType, token.FieldDescription:
+		case token.FieldName, token.FieldType, token.FieldDescription, token.FieldLastUsedAddress:
 			values[i] = new(sql.NullString)
-		case token.FieldCreateTime, token.FieldUpdateTime, token.FieldExpiresAt:
+		case token.FieldCreateTime, token.FieldUpdateTime, token.FieldExpiresAt, token.FieldRotatedAt, token.FieldPreviousExpiresAt, token.FieldLastUsedAt:
 			values[i] = new(sql.NullTime)
 		case token.FieldID:
 			values[i] = new(uuid.UUID)
@@ -112,6 +131,71 @@ func (t *Token) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.ExpiresAt = value.Time
 			}
+		case token.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case token.FieldAgentIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.AgentIds); err != nil {
+					return fmt.Errorf("unmarshal field agent_ids: %w", err)
+				}
+			}
+		case token.FieldWorkspaces:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field workspaces", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Workspaces); err != nil {
+					return fmt.Errorf("unmarshal field workspaces: %w", err)
+				}
+			}
+		case token.FieldQuota:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field quota", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Quota); err != nil {
+					return fmt.Errorf("unmarshal field quota: %w", err)
+				}
+			}
+		case token.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				t.RotatedAt = new(time.Time)
+				*t.RotatedAt = value.Time
+			}
+		case token.FieldPreviousTokenHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_token_hash", values[i])
+			} else if value != nil {
+				t.PreviousTokenHash = *value
+			}
+		case token.FieldPreviousExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_expires_at", values[i])
+			} else if value.Valid {
+				t.PreviousExpiresAt = new(time.Time)
+				*t.PreviousExpiresAt = value.Time
+			}
+		case token.FieldLastUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_at", values[i])
+			} else if value.Valid {
+				t.LastUsedAt = new(time.Time)
+				*t.LastUsedAt = value.Time
+			}
+		case token.FieldLastUsedAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_used_address", values[i])
+			} else if value.Valid {
+				t.LastUsedAddress = value.String
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -168,6 +252,39 @@ func (t *Token) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("expires_at=")
 	builder.WriteString(t.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", t.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("agent_ids=")
+	builder.WriteString(fmt.Sprintf("%v", t.AgentIds))
+	builder.WriteString(", ")
+	builder.WriteString("workspaces=")
+	builder.WriteString(fmt.Sprintf("%v", t.Workspaces))
+	builder.WriteString(", ")
+	builder.WriteString("quota=")
+	builder.WriteString(fmt.Sprintf("%v", t.Quota))
+	builder.WriteString(", ")
+	if v := t.RotatedAt; v != nil {
+		builder.WriteString("rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("previous_token_hash=")
+	builder.WriteString(fmt.Sprintf("%v", t.PreviousTokenHash))
+	builder.WriteString(", ")
+	if v := t.PreviousExpiresAt; v != nil {
+		builder.WriteString("previous_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := t.LastUsedAt; v != nil {
+		builder.WriteString("last_used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("last_used_address=")
+	builder.WriteString(t.LastUsedAddress)
 	builder.WriteByte(')')
 	return builder.String()
 }
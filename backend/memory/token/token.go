@@ -30,6 +30,24 @@ const (
 	FieldDescription = "description"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
+	// FieldScopes holds the string denoting the scopes field in the database.
+	FieldScopes = "scopes"
+	// FieldAgentIds holds the string denoting the agent_ids field in the database.
+	FieldAgentIds = "agent_ids"
+	// FieldWorkspaces holds the string denoting the workspaces field in the database.
+	FieldWorkspaces = "workspaces"
+	// FieldQuota holds the string denoting the quota field in the database.
+	FieldQuota = "quota"
+	// FieldRotatedAt holds the string denoting the rotated_at field in the database.
+	FieldRotatedAt = "rotated_at"
+	// FieldPreviousTokenHash holds the string denoting the previous_token_hash field in the database.
+	FieldPreviousTokenHash = "previous_token_hash"
+	// FieldPreviousExpiresAt holds the string denoting the previous_expires_at field in the database.
+	FieldPreviousExpiresAt = "previous_expires_at"
+	// FieldLastUsedAt holds the string denoting the last_used_at field in the database.
+	FieldLastUsedAt = "last_used_at"
+	// FieldLastUsedAddress holds the string denoting the last_used_address field in the database.
+	FieldLastUsedAddress = "last_used_address"
 	// Table holds the table name of the token in the database.
 	Table = "tokens"
 )
@@ -44,6 +62,15 @@ var Columns = []string{
 	FieldTokenHash,
 	FieldDescription,
 	FieldExpiresAt,
+	FieldScopes,
+	FieldAgentIds,
+	FieldWorkspaces,
+	FieldQuota,
+	FieldRotatedAt,
+	FieldPreviousTokenHash,
+	FieldPreviousExpiresAt,
+	FieldLastUsedAt,
+	FieldLastUsedAddress,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -118,3 +145,23 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
+
+// ByRotatedAt orders the results by the rotated_at field.
+func ByRotatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRotatedAt, opts...).ToFunc()
+}
+
+// ByPreviousExpiresAt orders the results by the previous_expires_at field.
+func ByPreviousExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousExpiresAt, opts...).ToFunc()
+}
+
+// ByLastUsedAt orders the results by the last_used_at field.
+func ByLastUsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAt, opts...).ToFunc()
+}
+
+// ByLastUsedAddress orders the results by the last_used_address field.
+func ByLastUsedAddress(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastUsedAddress, opts...).ToFunc()
+}
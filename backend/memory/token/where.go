@@ -86,6 +86,31 @@ func ExpiresAt(v time.Time) predicate.Token {
 	return predicate.Token(sql.FieldEQ(FieldExpiresAt, v))
 }
 
+// RotatedAt applies equality check predicate on the "rotated_at" field. It's identical to RotatedAtEQ.
+func RotatedAt(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// PreviousTokenHash applies equality check predicate on the "previous_token_hash" field. It's identical to PreviousTokenHashEQ.
+func PreviousTokenHash(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldPreviousTokenHash, v))
+}
+
+// PreviousExpiresAt applies equality check predicate on the "previous_expires_at" field. It's identical to PreviousExpiresAtEQ.
+func PreviousExpiresAt(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldPreviousExpiresAt, v))
+}
+
+// LastUsedAt applies equality check predicate on the "last_used_at" field. It's identical to LastUsedAtEQ.
+func LastUsedAt(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAddress applies equality check predicate on the "last_used_address" field. It's identical to LastUsedAddressEQ.
+func LastUsedAddress(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldLastUsedAddress, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Token {
 	return predicate.Token(sql.FieldEQ(FieldCreateTime, v))
@@ -416,6 +441,321 @@ func ExpiresAtLTE(v time.Time) predicate.Token {
 	return predicate.Token(sql.FieldLTE(FieldExpiresAt, v))
 }
 
+// ScopesIsNil applies the IsNil predicate on the "scopes" field.
+func ScopesIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldScopes))
+}
+
+// ScopesNotNil applies the NotNil predicate on the "scopes" field.
+func ScopesNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldScopes))
+}
+
+// AgentIdsIsNil applies the IsNil predicate on the "agent_ids" field.
+func AgentIdsIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldAgentIds))
+}
+
+// AgentIdsNotNil applies the NotNil predicate on the "agent_ids" field.
+func AgentIdsNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldAgentIds))
+}
+
+// WorkspacesIsNil applies the IsNil predicate on the "workspaces" field.
+func WorkspacesIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldWorkspaces))
+}
+
+// WorkspacesNotNil applies the NotNil predicate on the "workspaces" field.
+func WorkspacesNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldWorkspaces))
+}
+
+// QuotaIsNil applies the IsNil predicate on the "quota" field.
+func QuotaIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldQuota))
+}
+
+// QuotaNotNil applies the NotNil predicate on the "quota" field.
+func QuotaNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldQuota))
+}
+
+// RotatedAtEQ applies the EQ predicate on the "rotated_at" field.
+func RotatedAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtNEQ applies the NEQ predicate on the "rotated_at" field.
+func RotatedAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldRotatedAt, v))
+}
+
+// RotatedAtIn applies the In predicate on the "rotated_at" field.
+func RotatedAtIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtNotIn applies the NotIn predicate on the "rotated_at" field.
+func RotatedAtNotIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldRotatedAt, vs...))
+}
+
+// RotatedAtGT applies the GT predicate on the "rotated_at" field.
+func RotatedAtGT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldRotatedAt, v))
+}
+
+// RotatedAtGTE applies the GTE predicate on the "rotated_at" field.
+func RotatedAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldRotatedAt, v))
+}
+
+// RotatedAtLT applies the LT predicate on the "rotated_at" field.
+func RotatedAtLT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldRotatedAt, v))
+}
+
+// RotatedAtLTE applies the LTE predicate on the "rotated_at" field.
+func RotatedAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldRotatedAt, v))
+}
+
+// RotatedAtIsNil applies the IsNil predicate on the "rotated_at" field.
+func RotatedAtIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldRotatedAt))
+}
+
+// RotatedAtNotNil applies the NotNil predicate on the "rotated_at" field.
+func RotatedAtNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldRotatedAt))
+}
+
+// PreviousTokenHashEQ applies the EQ predicate on the "previous_token_hash" field.
+func PreviousTokenHashEQ(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldPreviousTokenHash, v))
+}
+
+// PreviousTokenHashNEQ applies the NEQ predicate on the "previous_token_hash" field.
+func PreviousTokenHashNEQ(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldPreviousTokenHash, v))
+}
+
+// PreviousTokenHashIn applies the In predicate on the "previous_token_hash" field.
+func PreviousTokenHashIn(vs ...[]byte) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldPreviousTokenHash, vs...))
+}
+
+// PreviousTokenHashNotIn applies the NotIn predicate on the "previous_token_hash" field.
+func PreviousTokenHashNotIn(vs ...[]byte) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldPreviousTokenHash, vs...))
+}
+
+// PreviousTokenHashGT applies the GT predicate on the "previous_token_hash" field.
+func PreviousTokenHashGT(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldPreviousTokenHash, v))
+}
+
+// PreviousTokenHashGTE applies the GTE predicate on the "previous_token_hash" field.
+func PreviousTokenHashGTE(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldPreviousTokenHash, v))
+}
+
+// PreviousTokenHashLT applies the LT predicate on the "previous_token_hash" field.
+func PreviousTokenHashLT(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldPreviousTokenHash, v))
+}
+
+// PreviousTokenHashLTE applies the LTE predicate on the "previous_token_hash" field.
+func PreviousTokenHashLTE(v []byte) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldPreviousTokenHash, v))
+}
+
+// PreviousTokenHashIsNil applies the IsNil predicate on the "previous_token_hash" field.
+func PreviousTokenHashIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldPreviousTokenHash))
+}
+
+// PreviousTokenHashNotNil applies the NotNil predicate on the "previous_token_hash" field.
+func PreviousTokenHashNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldPreviousTokenHash))
+}
+
+// PreviousExpiresAtEQ applies the EQ predicate on the "previous_expires_at" field.
+func PreviousExpiresAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtNEQ applies the NEQ predicate on the "previous_expires_at" field.
+func PreviousExpiresAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtIn applies the In predicate on the "previous_expires_at" field.
+func PreviousExpiresAtIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldPreviousExpiresAt, vs...))
+}
+
+// PreviousExpiresAtNotIn applies the NotIn predicate on the "previous_expires_at" field.
+func PreviousExpiresAtNotIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldPreviousExpiresAt, vs...))
+}
+
+// PreviousExpiresAtGT applies the GT predicate on the "previous_expires_at" field.
+func PreviousExpiresAtGT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtGTE applies the GTE predicate on the "previous_expires_at" field.
+func PreviousExpiresAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtLT applies the LT predicate on the "previous_expires_at" field.
+func PreviousExpiresAtLT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtLTE applies the LTE predicate on the "previous_expires_at" field.
+func PreviousExpiresAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldPreviousExpiresAt, v))
+}
+
+// PreviousExpiresAtIsNil applies the IsNil predicate on the "previous_expires_at" field.
+func PreviousExpiresAtIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldPreviousExpiresAt))
+}
+
+// PreviousExpiresAtNotNil applies the NotNil predicate on the "previous_expires_at" field.
+func PreviousExpiresAtNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldPreviousExpiresAt))
+}
+
+// LastUsedAtEQ applies the EQ predicate on the "last_used_at" field.
+func LastUsedAtEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtNEQ applies the NEQ predicate on the "last_used_at" field.
+func LastUsedAtNEQ(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIn applies the In predicate on the "last_used_at" field.
+func LastUsedAtIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtNotIn applies the NotIn predicate on the "last_used_at" field.
+func LastUsedAtNotIn(vs ...time.Time) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldLastUsedAt, vs...))
+}
+
+// LastUsedAtGT applies the GT predicate on the "last_used_at" field.
+func LastUsedAtGT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtGTE applies the GTE predicate on the "last_used_at" field.
+func LastUsedAtGTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLT applies the LT predicate on the "last_used_at" field.
+func LastUsedAtLT(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldLastUsedAt, v))
+}
+
+// LastUsedAtLTE applies the LTE predicate on the "last_used_at" field.
+func LastUsedAtLTE(v time.Time) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldLastUsedAt, v))
+}
+
+// LastUsedAtIsNil applies the IsNil predicate on the "last_used_at" field.
+func LastUsedAtIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldLastUsedAt))
+}
+
+// LastUsedAtNotNil applies the NotNil predicate on the "last_used_at" field.
+func LastUsedAtNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldLastUsedAt))
+}
+
+// LastUsedAddressEQ applies the EQ predicate on the "last_used_address" field.
+func LastUsedAddressEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldEQ(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressNEQ applies the NEQ predicate on the "last_used_address" field.
+func LastUsedAddressNEQ(v string) predicate.Token {
+	return predicate.Token(sql.FieldNEQ(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressIn applies the In predicate on the "last_used_address" field.
+func LastUsedAddressIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldIn(FieldLastUsedAddress, vs...))
+}
+
+// LastUsedAddressNotIn applies the NotIn predicate on the "last_used_address" field.
+func LastUsedAddressNotIn(vs ...string) predicate.Token {
+	return predicate.Token(sql.FieldNotIn(FieldLastUsedAddress, vs...))
+}
+
+// LastUsedAddressGT applies the GT predicate on the "last_used_address" field.
+func LastUsedAddressGT(v string) predicate.Token {
+	return predicate.Token(sql.FieldGT(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressGTE applies the GTE predicate on the "last_used_address" field.
+func LastUsedAddressGTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldGTE(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressLT applies the LT predicate on the "last_used_address" field.
+func LastUsedAddressLT(v string) predicate.Token {
+	return predicate.Token(sql.FieldLT(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressLTE applies the LTE predicate on the "last_used_address" field.
+func LastUsedAddressLTE(v string) predicate.Token {
+	return predicate.Token(sql.FieldLTE(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressContains applies the Contains predicate on the "last_used_address" field.
+func LastUsedAddressContains(v string) predicate.Token {
+	return predicate.Token(sql.FieldContains(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressHasPrefix applies the HasPrefix predicate on the "last_used_address" field.
+func LastUsedAddressHasPrefix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasPrefix(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressHasSuffix applies the HasSuffix predicate on the "last_used_address" field.
+func LastUsedAddressHasSuffix(v string) predicate.Token {
+	return predicate.Token(sql.FieldHasSuffix(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressIsNil applies the IsNil predicate on the "last_used_address" field.
+func LastUsedAddressIsNil() predicate.Token {
+	return predicate.Token(sql.FieldIsNull(FieldLastUsedAddress))
+}
+
+// LastUsedAddressNotNil applies the NotNil predicate on the "last_used_address" field.
+func LastUsedAddressNotNil() predicate.Token {
+	return predicate.Token(sql.FieldNotNull(FieldLastUsedAddress))
+}
+
+// LastUsedAddressEqualFold applies the EqualFold predicate on the "last_used_address" field.
+func LastUsedAddressEqualFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldEqualFold(FieldLastUsedAddress, v))
+}
+
+// LastUsedAddressContainsFold applies the ContainsFold predicate on the "last_used_address" field.
+func LastUsedAddressContainsFold(v string) predicate.Token {
+	return predicate.Token(sql.FieldContainsFold(FieldLastUsedAddress, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Token) predicate.Token {
 	return predicate.Token(sql.AndPredicates(predicates...))
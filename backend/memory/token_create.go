@@ -96,6 +96,92 @@ func (tc *TokenCreate) SetExpiresAt(t time.Time) *TokenCreate {
 	return tc
 }
 
+// SetScopes sets the "scopes" field.
+func (tc *TokenCreate) SetScopes(s []string) *TokenCreate {
+	tc.mutation.SetScopes(s)
+	return tc
+}
+
+// SetAgentIds sets the "agent_ids" field.
+func (tc *TokenCreate) SetAgentIds(u []uuid.UUID) *TokenCreate {
+	tc.mutation.SetAgentIds(u)
+	return tc
+}
+
+// SetWorkspaces sets the "workspaces" field.
+func (tc *TokenCreate) SetWorkspaces(s []string) *TokenCreate {
+	tc.mutation.SetWorkspaces(s)
+	return tc
+}
+
+// SetQuota sets the "quota" field.
+func (tc *TokenCreate) SetQuota(t *types.Quota) *TokenCreate {
+	tc.mutation.SetQuota(t)
+	return tc
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (tc *TokenCreate) SetRotatedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetRotatedAt(t)
+	return tc
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableRotatedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetRotatedAt(*t)
+	}
+	return tc
+}
+
+// SetPreviousTokenHash sets the "previous_token_hash" field.
+func (tc *TokenCreate) SetPreviousTokenHash(b []byte) *TokenCreate {
+	tc.mutation.SetPreviousTokenHash(b)
+	return tc
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (tc *TokenCreate) SetPreviousExpiresAt(t time.Time) *TokenCreate {
+	tc.mutation.SetPreviousExpiresAt(t)
+	return tc
+}
+
+// SetNillablePreviousExpiresAt sets the "previous_expires_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillablePreviousExpiresAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetPreviousExpiresAt(*t)
+	}
+	return tc
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (tc *TokenCreate) SetLastUsedAt(t time.Time) *TokenCreate {
+	tc.mutation.SetLastUsedAt(t)
+	return tc
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableLastUsedAt(t *time.Time) *TokenCreate {
+	if t != nil {
+		tc.SetLastUsedAt(*t)
+	}
+	return tc
+}
+
+// SetLastUsedAddress sets the "last_used_address" field.
+func (tc *TokenCreate) SetLastUsedAddress(s string) *TokenCreate {
+	tc.mutation.SetLastUsedAddress(s)
+	return tc
+}
+
+// SetNillableLastUsedAddress sets the "last_used_address" field if the given value is not nil.
+func (tc *TokenCreate) SetNillableLastUsedAddress(s *string) *TokenCreate {
+	if s != nil {
+		tc.SetLastUsedAddress(*s)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TokenCreate) SetID(u uuid.UUID) *TokenCreate {
 	tc.mutation.SetID(u)
@@ -256,6 +342,42 @@ func (tc *TokenCreate) createSpec() (*Token, *sqlgraph.CreateSpec) {
 		_spec.SetField(token.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
+	if value, ok := tc.mutation.Scopes(); ok {
+		_spec.SetField(token.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := tc.mutation.AgentIds(); ok {
+		_spec.SetField(token.FieldAgentIds, field.TypeJSON, value)
+		_node.AgentIds = value
+	}
+	if value, ok := tc.mutation.Workspaces(); ok {
+		_spec.SetField(token.FieldWorkspaces, field.TypeJSON, value)
+		_node.Workspaces = value
+	}
+	if value, ok := tc.mutation.Quota(); ok {
+		_spec.SetField(token.FieldQuota, field.TypeJSON, value)
+		_node.Quota = value
+	}
+	if value, ok := tc.mutation.RotatedAt(); ok {
+		_spec.SetField(token.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
+	if value, ok := tc.mutation.PreviousTokenHash(); ok {
+		_spec.SetField(token.FieldPreviousTokenHash, field.TypeBytes, value)
+		_node.PreviousTokenHash = value
+	}
+	if value, ok := tc.mutation.PreviousExpiresAt(); ok {
+		_spec.SetField(token.FieldPreviousExpiresAt, field.TypeTime, value)
+		_node.PreviousExpiresAt = &value
+	}
+	if value, ok := tc.mutation.LastUsedAt(); ok {
+		_spec.SetField(token.FieldLastUsedAt, field.TypeTime, value)
+		_node.LastUsedAt = &value
+	}
+	if value, ok := tc.mutation.LastUsedAddress(); ok {
+		_spec.SetField(token.FieldLastUsedAddress, field.TypeString, value)
+		_node.LastUsedAddress = value
+	}
 	return _node, _spec
 }
 
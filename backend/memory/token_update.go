@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/furisto/construct/backend/memory/predicate"
 	"github.com/furisto/construct/backend/memory/schema/types"
 	"github.com/furisto/construct/backend/memory/token"
+	"github.com/google/uuid"
 )
 
 // TokenUpdate is the builder for updating Token entities.
@@ -104,6 +106,164 @@ func (tu *TokenUpdate) SetNillableExpiresAt(t *time.Time) *TokenUpdate {
 	return tu
 }
 
+// SetScopes sets the "scopes" field.
+func (tu *TokenUpdate) SetScopes(s []string) *TokenUpdate {
+	tu.mutation.SetScopes(s)
+	return tu
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (tu *TokenUpdate) AppendScopes(s []string) *TokenUpdate {
+	tu.mutation.AppendScopes(s)
+	return tu
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (tu *TokenUpdate) ClearScopes() *TokenUpdate {
+	tu.mutation.ClearScopes()
+	return tu
+}
+
+// SetAgentIds sets the "agent_ids" field.
+func (tu *TokenUpdate) SetAgentIds(u []uuid.UUID) *TokenUpdate {
+	tu.mutation.SetAgentIds(u)
+	return tu
+}
+
+// AppendAgentIds appends u to the "agent_ids" field.
+func (tu *TokenUpdate) AppendAgentIds(u []uuid.UUID) *TokenUpdate {
+	tu.mutation.AppendAgentIds(u)
+	return tu
+}
+
+// ClearAgentIds clears the value of the "agent_ids" field.
+func (tu *TokenUpdate) ClearAgentIds() *TokenUpdate {
+	tu.mutation.ClearAgentIds()
+	return tu
+}
+
+// SetWorkspaces sets the "workspaces" field.
+func (tu *TokenUpdate) SetWorkspaces(s []string) *TokenUpdate {
+	tu.mutation.SetWorkspaces(s)
+	return tu
+}
+
+// AppendWorkspaces appends s to the "workspaces" field.
+func (tu *TokenUpdate) AppendWorkspaces(s []string) *TokenUpdate {
+	tu.mutation.AppendWorkspaces(s)
+	return tu
+}
+
+// ClearWorkspaces clears the value of the "workspaces" field.
+func (tu *TokenUpdate) ClearWorkspaces() *TokenUpdate {
+	tu.mutation.ClearWorkspaces()
+	return tu
+}
+
+// SetQuota sets the "quota" field.
+func (tu *TokenUpdate) SetQuota(t *types.Quota) *TokenUpdate {
+	tu.mutation.SetQuota(t)
+	return tu
+}
+
+// ClearQuota clears the value of the "quota" field.
+func (tu *TokenUpdate) ClearQuota() *TokenUpdate {
+	tu.mutation.ClearQuota()
+	return tu
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (tu *TokenUpdate) SetRotatedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetRotatedAt(t)
+	return tu
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableRotatedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetRotatedAt(*t)
+	}
+	return tu
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (tu *TokenUpdate) ClearRotatedAt() *TokenUpdate {
+	tu.mutation.ClearRotatedAt()
+	return tu
+}
+
+// SetPreviousTokenHash sets the "previous_token_hash" field.
+func (tu *TokenUpdate) SetPreviousTokenHash(b []byte) *TokenUpdate {
+	tu.mutation.SetPreviousTokenHash(b)
+	return tu
+}
+
+// ClearPreviousTokenHash clears the value of the "previous_token_hash" field.
+func (tu *TokenUpdate) ClearPreviousTokenHash() *TokenUpdate {
+	tu.mutation.ClearPreviousTokenHash()
+	return tu
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (tu *TokenUpdate) SetPreviousExpiresAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetPreviousExpiresAt(t)
+	return tu
+}
+
+// SetNillablePreviousExpiresAt sets the "previous_expires_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillablePreviousExpiresAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetPreviousExpiresAt(*t)
+	}
+	return tu
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (tu *TokenUpdate) ClearPreviousExpiresAt() *TokenUpdate {
+	tu.mutation.ClearPreviousExpiresAt()
+	return tu
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (tu *TokenUpdate) SetLastUsedAt(t time.Time) *TokenUpdate {
+	tu.mutation.SetLastUsedAt(t)
+	return tu
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableLastUsedAt(t *time.Time) *TokenUpdate {
+	if t != nil {
+		tu.SetLastUsedAt(*t)
+	}
+	return tu
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (tu *TokenUpdate) ClearLastUsedAt() *TokenUpdate {
+	tu.mutation.ClearLastUsedAt()
+	return tu
+}
+
+// SetLastUsedAddress sets the "last_used_address" field.
+func (tu *TokenUpdate) SetLastUsedAddress(s string) *TokenUpdate {
+	tu.mutation.SetLastUsedAddress(s)
+	return tu
+}
+
+// SetNillableLastUsedAddress sets the "last_used_address" field if the given value is not nil.
+func (tu *TokenUpdate) SetNillableLastUsedAddress(s *string) *TokenUpdate {
+	if s != nil {
+		tu.SetLastUsedAddress(*s)
+	}
+	return tu
+}
+
+// ClearLastUsedAddress clears the value of the "last_used_address" field.
+func (tu *TokenUpdate) ClearLastUsedAddress() *TokenUpdate {
+	tu.mutation.ClearLastUsedAddress()
+	return tu
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tu *TokenUpdate) Mutation() *TokenMutation {
 	return tu.mutation
@@ -199,6 +359,75 @@ func (tu *TokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := tu.mutation.ExpiresAt(); ok {
 		_spec.SetField(token.FieldExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := tu.mutation.Scopes(); ok {
+		_spec.SetField(token.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, token.FieldScopes, value)
+		})
+	}
+	if tu.mutation.ScopesCleared() {
+		_spec.ClearField(token.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.AgentIds(); ok {
+		_spec.SetField(token.FieldAgentIds, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedAgentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, token.FieldAgentIds, value)
+		})
+	}
+	if tu.mutation.AgentIdsCleared() {
+		_spec.ClearField(token.FieldAgentIds, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.Workspaces(); ok {
+		_spec.SetField(token.FieldWorkspaces, field.TypeJSON, value)
+	}
+	if value, ok := tu.mutation.AppendedWorkspaces(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, token.FieldWorkspaces, value)
+		})
+	}
+	if tu.mutation.WorkspacesCleared() {
+		_spec.ClearField(token.FieldWorkspaces, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.Quota(); ok {
+		_spec.SetField(token.FieldQuota, field.TypeJSON, value)
+	}
+	if tu.mutation.QuotaCleared() {
+		_spec.ClearField(token.FieldQuota, field.TypeJSON)
+	}
+	if value, ok := tu.mutation.RotatedAt(); ok {
+		_spec.SetField(token.FieldRotatedAt, field.TypeTime, value)
+	}
+	if tu.mutation.RotatedAtCleared() {
+		_spec.ClearField(token.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.PreviousTokenHash(); ok {
+		_spec.SetField(token.FieldPreviousTokenHash, field.TypeBytes, value)
+	}
+	if tu.mutation.PreviousTokenHashCleared() {
+		_spec.ClearField(token.FieldPreviousTokenHash, field.TypeBytes)
+	}
+	if value, ok := tu.mutation.PreviousExpiresAt(); ok {
+		_spec.SetField(token.FieldPreviousExpiresAt, field.TypeTime, value)
+	}
+	if tu.mutation.PreviousExpiresAtCleared() {
+		_spec.ClearField(token.FieldPreviousExpiresAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.LastUsedAt(); ok {
+		_spec.SetField(token.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if tu.mutation.LastUsedAtCleared() {
+		_spec.ClearField(token.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := tu.mutation.LastUsedAddress(); ok {
+		_spec.SetField(token.FieldLastUsedAddress, field.TypeString, value)
+	}
+	if tu.mutation.LastUsedAddressCleared() {
+		_spec.ClearField(token.FieldLastUsedAddress, field.TypeString)
+	}
 	_spec.AddModifiers(tu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -295,6 +524,164 @@ func (tuo *TokenUpdateOne) SetNillableExpiresAt(t *time.Time) *TokenUpdateOne {
 	return tuo
 }
 
+// SetScopes sets the "scopes" field.
+func (tuo *TokenUpdateOne) SetScopes(s []string) *TokenUpdateOne {
+	tuo.mutation.SetScopes(s)
+	return tuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (tuo *TokenUpdateOne) AppendScopes(s []string) *TokenUpdateOne {
+	tuo.mutation.AppendScopes(s)
+	return tuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (tuo *TokenUpdateOne) ClearScopes() *TokenUpdateOne {
+	tuo.mutation.ClearScopes()
+	return tuo
+}
+
+// SetAgentIds sets the "agent_ids" field.
+func (tuo *TokenUpdateOne) SetAgentIds(u []uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.SetAgentIds(u)
+	return tuo
+}
+
+// AppendAgentIds appends u to the "agent_ids" field.
+func (tuo *TokenUpdateOne) AppendAgentIds(u []uuid.UUID) *TokenUpdateOne {
+	tuo.mutation.AppendAgentIds(u)
+	return tuo
+}
+
+// ClearAgentIds clears the value of the "agent_ids" field.
+func (tuo *TokenUpdateOne) ClearAgentIds() *TokenUpdateOne {
+	tuo.mutation.ClearAgentIds()
+	return tuo
+}
+
+// SetWorkspaces sets the "workspaces" field.
+func (tuo *TokenUpdateOne) SetWorkspaces(s []string) *TokenUpdateOne {
+	tuo.mutation.SetWorkspaces(s)
+	return tuo
+}
+
+// AppendWorkspaces appends s to the "workspaces" field.
+func (tuo *TokenUpdateOne) AppendWorkspaces(s []string) *TokenUpdateOne {
+	tuo.mutation.AppendWorkspaces(s)
+	return tuo
+}
+
+// ClearWorkspaces clears the value of the "workspaces" field.
+func (tuo *TokenUpdateOne) ClearWorkspaces() *TokenUpdateOne {
+	tuo.mutation.ClearWorkspaces()
+	return tuo
+}
+
+// SetQuota sets the "quota" field.
+func (tuo *TokenUpdateOne) SetQuota(t *types.Quota) *TokenUpdateOne {
+	tuo.mutation.SetQuota(t)
+	return tuo
+}
+
+// ClearQuota clears the value of the "quota" field.
+func (tuo *TokenUpdateOne) ClearQuota() *TokenUpdateOne {
+	tuo.mutation.ClearQuota()
+	return tuo
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (tuo *TokenUpdateOne) SetRotatedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetRotatedAt(t)
+	return tuo
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableRotatedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetRotatedAt(*t)
+	}
+	return tuo
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (tuo *TokenUpdateOne) ClearRotatedAt() *TokenUpdateOne {
+	tuo.mutation.ClearRotatedAt()
+	return tuo
+}
+
+// SetPreviousTokenHash sets the "previous_token_hash" field.
+func (tuo *TokenUpdateOne) SetPreviousTokenHash(b []byte) *TokenUpdateOne {
+	tuo.mutation.SetPreviousTokenHash(b)
+	return tuo
+}
+
+// ClearPreviousTokenHash clears the value of the "previous_token_hash" field.
+func (tuo *TokenUpdateOne) ClearPreviousTokenHash() *TokenUpdateOne {
+	tuo.mutation.ClearPreviousTokenHash()
+	return tuo
+}
+
+// SetPreviousExpiresAt sets the "previous_expires_at" field.
+func (tuo *TokenUpdateOne) SetPreviousExpiresAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetPreviousExpiresAt(t)
+	return tuo
+}
+
+// SetNillablePreviousExpiresAt sets the "previous_expires_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillablePreviousExpiresAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetPreviousExpiresAt(*t)
+	}
+	return tuo
+}
+
+// ClearPreviousExpiresAt clears the value of the "previous_expires_at" field.
+func (tuo *TokenUpdateOne) ClearPreviousExpiresAt() *TokenUpdateOne {
+	tuo.mutation.ClearPreviousExpiresAt()
+	return tuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (tuo *TokenUpdateOne) SetLastUsedAt(t time.Time) *TokenUpdateOne {
+	tuo.mutation.SetLastUsedAt(t)
+	return tuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableLastUsedAt(t *time.Time) *TokenUpdateOne {
+	if t != nil {
+		tuo.SetLastUsedAt(*t)
+	}
+	return tuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (tuo *TokenUpdateOne) ClearLastUsedAt() *TokenUpdateOne {
+	tuo.mutation.ClearLastUsedAt()
+	return tuo
+}
+
+// SetLastUsedAddress sets the "last_used_address" field.
+func (tuo *TokenUpdateOne) SetLastUsedAddress(s string) *TokenUpdateOne {
+	tuo.mutation.SetLastUsedAddress(s)
+	return tuo
+}
+
+// SetNillableLastUsedAddress sets the "last_used_address" field if the given value is not nil.
+func (tuo *TokenUpdateOne) SetNillableLastUsedAddress(s *string) *TokenUpdateOne {
+	if s != nil {
+		tuo.SetLastUsedAddress(*s)
+	}
+	return tuo
+}
+
+// ClearLastUsedAddress clears the value of the "last_used_address" field.
+func (tuo *TokenUpdateOne) ClearLastUsedAddress() *TokenUpdateOne {
+	tuo.mutation.ClearLastUsedAddress()
+	return tuo
+}
+
 // Mutation returns the TokenMutation object of the builder.
 func (tuo *TokenUpdateOne) Mutation() *TokenMutation {
 	return tuo.mutation
@@ -420,6 +807,75 @@ func (tuo *TokenUpdateOne) sqlSave(ctx context.Context) (_node *Token, err error
 	if value, ok := tuo.mutation.ExpiresAt(); ok {
 		_spec.SetField(token.FieldExpiresAt, field.TypeTime, value)
 	}
+	if value, ok := tuo.mutation.Scopes(); ok {
+		_spec.SetField(token.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, token.FieldScopes, value)
+		})
+	}
+	if tuo.mutation.ScopesCleared() {
+		_spec.ClearField(token.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.AgentIds(); ok {
+		_spec.SetField(token.FieldAgentIds, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedAgentIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, token.FieldAgentIds, value)
+		})
+	}
+	if tuo.mutation.AgentIdsCleared() {
+		_spec.ClearField(token.FieldAgentIds, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.Workspaces(); ok {
+		_spec.SetField(token.FieldWorkspaces, field.TypeJSON, value)
+	}
+	if value, ok := tuo.mutation.AppendedWorkspaces(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, token.FieldWorkspaces, value)
+		})
+	}
+	if tuo.mutation.WorkspacesCleared() {
+		_spec.ClearField(token.FieldWorkspaces, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.Quota(); ok {
+		_spec.SetField(token.FieldQuota, field.TypeJSON, value)
+	}
+	if tuo.mutation.QuotaCleared() {
+		_spec.ClearField(token.FieldQuota, field.TypeJSON)
+	}
+	if value, ok := tuo.mutation.RotatedAt(); ok {
+		_spec.SetField(token.FieldRotatedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.RotatedAtCleared() {
+		_spec.ClearField(token.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.PreviousTokenHash(); ok {
+		_spec.SetField(token.FieldPreviousTokenHash, field.TypeBytes, value)
+	}
+	if tuo.mutation.PreviousTokenHashCleared() {
+		_spec.ClearField(token.FieldPreviousTokenHash, field.TypeBytes)
+	}
+	if value, ok := tuo.mutation.PreviousExpiresAt(); ok {
+		_spec.SetField(token.FieldPreviousExpiresAt, field.TypeTime, value)
+	}
+	if tuo.mutation.PreviousExpiresAtCleared() {
+		_spec.ClearField(token.FieldPreviousExpiresAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(token.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if tuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(token.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := tuo.mutation.LastUsedAddress(); ok {
+		_spec.SetField(token.FieldLastUsedAddress, field.TypeString, value)
+	}
+	if tuo.mutation.LastUsedAddressCleared() {
+		_spec.ClearField(token.FieldLastUsedAddress, field.TypeString)
+	}
 	_spec.AddModifiers(tuo.modifiers...)
 	_node = &Token{config: tuo.config}
 	_spec.Assign = _node.assignValues
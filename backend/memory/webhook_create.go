@@ -0,0 +1,451 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wc *WebhookCreate) SetCreateTime(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreateTime(t)
+	return wc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreateTime(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreateTime(*t)
+	}
+	return wc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wc *WebhookCreate) SetUpdateTime(t time.Time) *WebhookCreate {
+	wc.mutation.SetUpdateTime(t)
+	return wc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableUpdateTime(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetUpdateTime(*t)
+	}
+	return wc
+}
+
+// SetURL sets the "url" field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wc *WebhookCreate) SetEventTypes(s []string) *WebhookCreate {
+	wc.mutation.SetEventTypes(s)
+	return wc
+}
+
+// SetTaskID sets the "task_id" field.
+func (wc *WebhookCreate) SetTaskID(u uuid.UUID) *WebhookCreate {
+	wc.mutation.SetTaskID(u)
+	return wc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableTaskID(u *uuid.UUID) *WebhookCreate {
+	if u != nil {
+		wc.SetTaskID(*u)
+	}
+	return wc
+}
+
+// SetAgentID sets the "agent_id" field.
+func (wc *WebhookCreate) SetAgentID(u uuid.UUID) *WebhookCreate {
+	wc.mutation.SetAgentID(u)
+	return wc
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableAgentID(u *uuid.UUID) *WebhookCreate {
+	if u != nil {
+		wc.SetAgentID(*u)
+	}
+	return wc
+}
+
+// SetSecret sets the "secret" field.
+func (wc *WebhookCreate) SetSecret(b []byte) *WebhookCreate {
+	wc.mutation.SetSecret(b)
+	return wc
+}
+
+// SetEnabled sets the "enabled" field.
+func (wc *WebhookCreate) SetEnabled(b bool) *WebhookCreate {
+	wc.mutation.SetEnabled(b)
+	return wc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableEnabled(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetEnabled(*b)
+	}
+	return wc
+}
+
+// SetDescription sets the "description" field.
+func (wc *WebhookCreate) SetDescription(s string) *WebhookCreate {
+	wc.mutation.SetDescription(s)
+	return wc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableDescription(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetDescription(*s)
+	}
+	return wc
+}
+
+// SetOwner sets the "owner" field.
+func (wc *WebhookCreate) SetOwner(s string) *WebhookCreate {
+	wc.mutation.SetOwner(s)
+	return wc
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableOwner(s *string) *WebhookCreate {
+	if s != nil {
+		wc.SetOwner(*s)
+	}
+	return wc
+}
+
+// SetAdmin sets the "admin" field.
+func (wc *WebhookCreate) SetAdmin(b bool) *WebhookCreate {
+	wc.mutation.SetAdmin(b)
+	return wc
+}
+
+// SetNillableAdmin sets the "admin" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableAdmin(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetAdmin(*b)
+	}
+	return wc
+}
+
+// SetID sets the "id" field.
+func (wc *WebhookCreate) SetID(u uuid.UUID) *WebhookCreate {
+	wc.mutation.SetID(u)
+	return wc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableID(u *uuid.UUID) *WebhookCreate {
+	if u != nil {
+		wc.SetID(*u)
+	}
+	return wc
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wc *WebhookCreate) Mutation() *WebhookMutation {
+	return wc.mutation
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	wc.defaults()
+	return withHooks(ctx, wc.sqlSave, wc.mutation, wc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wc *WebhookCreate) Exec(ctx context.Context) error {
+	_, err := wc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wc *WebhookCreate) ExecX(ctx context.Context) {
+	if err := wc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wc *WebhookCreate) defaults() {
+	if _, ok := wc.mutation.CreateTime(); !ok {
+		v := webhook.DefaultCreateTime()
+		wc.mutation.SetCreateTime(v)
+	}
+	if _, ok := wc.mutation.UpdateTime(); !ok {
+		v := webhook.DefaultUpdateTime()
+		wc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := wc.mutation.Enabled(); !ok {
+		v := webhook.DefaultEnabled
+		wc.mutation.SetEnabled(v)
+	}
+	if _, ok := wc.mutation.Admin(); !ok {
+		v := webhook.DefaultAdmin
+		wc.mutation.SetAdmin(v)
+	}
+	if _, ok := wc.mutation.ID(); !ok {
+		v := webhook.DefaultID()
+		wc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wc *WebhookCreate) check() error {
+	if _, ok := wc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "Webhook.create_time"`)}
+	}
+	if _, ok := wc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "Webhook.update_time"`)}
+	}
+	if _, ok := wc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`memory: missing required field "Webhook.url"`)}
+	}
+	if v, ok := wc.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`memory: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`memory: missing required field "Webhook.secret"`)}
+	}
+	if v, ok := wc.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	if _, ok := wc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`memory: missing required field "Webhook.enabled"`)}
+	}
+	if _, ok := wc.mutation.Admin(); !ok {
+		return &ValidationError{Name: "admin", err: errors.New(`memory: missing required field "Webhook.admin"`)}
+	}
+	return nil
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	if err := wc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wc.mutation.id = &_node.ID
+	wc.mutation.done = true
+	return _node, nil
+}
+
+func (wc *WebhookCreate) createSpec() (*Webhook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Webhook{config: wc.config}
+		_spec = sqlgraph.NewCreateSpec(webhook.Table, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wc.mutation.CreateTime(); ok {
+		_spec.SetField(webhook.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := wc.mutation.UpdateTime(); ok {
+		_spec.SetField(webhook.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := wc.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+		_node.EventTypes = value
+	}
+	if value, ok := wc.mutation.TaskID(); ok {
+		_spec.SetField(webhook.FieldTaskID, field.TypeUUID, value)
+		_node.TaskID = &value
+	}
+	if value, ok := wc.mutation.AgentID(); ok {
+		_spec.SetField(webhook.FieldAgentID, field.TypeUUID, value)
+		_node.AgentID = &value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeBytes, value)
+		_node.Secret = value
+	}
+	if value, ok := wc.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := wc.mutation.Description(); ok {
+		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := wc.mutation.Owner(); ok {
+		_spec.SetField(webhook.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := wc.mutation.Admin(); ok {
+		_spec.SetField(webhook.FieldAdmin, field.TypeBool, value)
+		_node.Admin = value
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookCreateBulk is the builder for creating many Webhook entities in bulk.
+type WebhookCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookCreate
+}
+
+// Save creates the Webhook entities in the database.
+func (wcb *WebhookCreateBulk) Save(ctx context.Context) ([]*Webhook, error) {
+	if wcb.err != nil {
+		return nil, wcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wcb.builders))
+	nodes := make([]*Webhook, len(wcb.builders))
+	mutators := make([]Mutator, len(wcb.builders))
+	for i := range wcb.builders {
+		func(i int, root context.Context) {
+			builder := wcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) SaveX(ctx context.Context) []*Webhook {
+	v, err := wcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wcb *WebhookCreateBulk) Exec(ctx context.Context) error {
+	_, err := wcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wcb *WebhookCreateBulk) ExecX(ctx context.Context) {
+	if err := wcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,815 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.mutation.Where(ps...)
+	return wu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wu *WebhookUpdate) SetUpdateTime(t time.Time) *WebhookUpdate {
+	wu.mutation.SetUpdateTime(t)
+	return wu
+}
+
+// SetURL sets the "url" field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableURL(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetURL(*s)
+	}
+	return wu
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wu *WebhookUpdate) SetEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.SetEventTypes(s)
+	return wu
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wu *WebhookUpdate) AppendEventTypes(s []string) *WebhookUpdate {
+	wu.mutation.AppendEventTypes(s)
+	return wu
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (wu *WebhookUpdate) ClearEventTypes() *WebhookUpdate {
+	wu.mutation.ClearEventTypes()
+	return wu
+}
+
+// SetTaskID sets the "task_id" field.
+func (wu *WebhookUpdate) SetTaskID(u uuid.UUID) *WebhookUpdate {
+	wu.mutation.SetTaskID(u)
+	return wu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableTaskID(u *uuid.UUID) *WebhookUpdate {
+	if u != nil {
+		wu.SetTaskID(*u)
+	}
+	return wu
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (wu *WebhookUpdate) ClearTaskID() *WebhookUpdate {
+	wu.mutation.ClearTaskID()
+	return wu
+}
+
+// SetAgentID sets the "agent_id" field.
+func (wu *WebhookUpdate) SetAgentID(u uuid.UUID) *WebhookUpdate {
+	wu.mutation.SetAgentID(u)
+	return wu
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableAgentID(u *uuid.UUID) *WebhookUpdate {
+	if u != nil {
+		wu.SetAgentID(*u)
+	}
+	return wu
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (wu *WebhookUpdate) ClearAgentID() *WebhookUpdate {
+	wu.mutation.ClearAgentID()
+	return wu
+}
+
+// SetSecret sets the "secret" field.
+func (wu *WebhookUpdate) SetSecret(b []byte) *WebhookUpdate {
+	wu.mutation.SetSecret(b)
+	return wu
+}
+
+// SetEnabled sets the "enabled" field.
+func (wu *WebhookUpdate) SetEnabled(b bool) *WebhookUpdate {
+	wu.mutation.SetEnabled(b)
+	return wu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableEnabled(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetEnabled(*b)
+	}
+	return wu
+}
+
+// SetDescription sets the "description" field.
+func (wu *WebhookUpdate) SetDescription(s string) *WebhookUpdate {
+	wu.mutation.SetDescription(s)
+	return wu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableDescription(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetDescription(*s)
+	}
+	return wu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (wu *WebhookUpdate) ClearDescription() *WebhookUpdate {
+	wu.mutation.ClearDescription()
+	return wu
+}
+
+// SetOwner sets the "owner" field.
+func (wu *WebhookUpdate) SetOwner(s string) *WebhookUpdate {
+	wu.mutation.SetOwner(s)
+	return wu
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableOwner(s *string) *WebhookUpdate {
+	if s != nil {
+		wu.SetOwner(*s)
+	}
+	return wu
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (wu *WebhookUpdate) ClearOwner() *WebhookUpdate {
+	wu.mutation.ClearOwner()
+	return wu
+}
+
+// SetAdmin sets the "admin" field.
+func (wu *WebhookUpdate) SetAdmin(b bool) *WebhookUpdate {
+	wu.mutation.SetAdmin(b)
+	return wu
+}
+
+// SetNillableAdmin sets the "admin" field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableAdmin(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetAdmin(*b)
+	}
+	return wu
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wu *WebhookUpdate) Mutation() *WebhookMutation {
+	return wu.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wu *WebhookUpdate) ClearDeliveries() *WebhookUpdate {
+	wu.mutation.ClearDeliveries()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	wu.defaults()
+	return withHooks(ctx, wu.sqlSave, wu.mutation, wu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wu *WebhookUpdate) defaults() {
+	if _, ok := wu.mutation.UpdateTime(); !ok {
+		v := webhook.UpdateDefaultUpdateTime()
+		wu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wu *WebhookUpdate) check() error {
+	if v, ok := wu.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`memory: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if v, ok := wu.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wu *WebhookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdate {
+	wu.modifiers = append(wu.modifiers, modifiers...)
+	return wu
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	if ps := wu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhook.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wu.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if wu.mutation.EventTypesCleared() {
+		_spec.ClearField(webhook.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := wu.mutation.TaskID(); ok {
+		_spec.SetField(webhook.FieldTaskID, field.TypeUUID, value)
+	}
+	if wu.mutation.TaskIDCleared() {
+		_spec.ClearField(webhook.FieldTaskID, field.TypeUUID)
+	}
+	if value, ok := wu.mutation.AgentID(); ok {
+		_spec.SetField(webhook.FieldAgentID, field.TypeUUID, value)
+	}
+	if wu.mutation.AgentIDCleared() {
+		_spec.ClearField(webhook.FieldAgentID, field.TypeUUID)
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeBytes, value)
+	}
+	if value, ok := wu.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := wu.mutation.Description(); ok {
+		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
+	}
+	if wu.mutation.DescriptionCleared() {
+		_spec.ClearField(webhook.FieldDescription, field.TypeString)
+	}
+	if value, ok := wu.mutation.Owner(); ok {
+		_spec.SetField(webhook.FieldOwner, field.TypeString, value)
+	}
+	if wu.mutation.OwnerCleared() {
+		_spec.ClearField(webhook.FieldOwner, field.TypeString)
+	}
+	if value, ok := wu.mutation.Admin(); ok {
+		_spec.SetField(webhook.FieldAdmin, field.TypeBool, value)
+	}
+	if wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wu.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wu.mutation.done = true
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wuo *WebhookUpdateOne) SetUpdateTime(t time.Time) *WebhookUpdateOne {
+	wuo.mutation.SetUpdateTime(t)
+	return wuo
+}
+
+// SetURL sets the "url" field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableURL(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetURL(*s)
+	}
+	return wuo
+}
+
+// SetEventTypes sets the "event_types" field.
+func (wuo *WebhookUpdateOne) SetEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEventTypes(s)
+	return wuo
+}
+
+// AppendEventTypes appends s to the "event_types" field.
+func (wuo *WebhookUpdateOne) AppendEventTypes(s []string) *WebhookUpdateOne {
+	wuo.mutation.AppendEventTypes(s)
+	return wuo
+}
+
+// ClearEventTypes clears the value of the "event_types" field.
+func (wuo *WebhookUpdateOne) ClearEventTypes() *WebhookUpdateOne {
+	wuo.mutation.ClearEventTypes()
+	return wuo
+}
+
+// SetTaskID sets the "task_id" field.
+func (wuo *WebhookUpdateOne) SetTaskID(u uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.SetTaskID(u)
+	return wuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableTaskID(u *uuid.UUID) *WebhookUpdateOne {
+	if u != nil {
+		wuo.SetTaskID(*u)
+	}
+	return wuo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (wuo *WebhookUpdateOne) ClearTaskID() *WebhookUpdateOne {
+	wuo.mutation.ClearTaskID()
+	return wuo
+}
+
+// SetAgentID sets the "agent_id" field.
+func (wuo *WebhookUpdateOne) SetAgentID(u uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.SetAgentID(u)
+	return wuo
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableAgentID(u *uuid.UUID) *WebhookUpdateOne {
+	if u != nil {
+		wuo.SetAgentID(*u)
+	}
+	return wuo
+}
+
+// ClearAgentID clears the value of the "agent_id" field.
+func (wuo *WebhookUpdateOne) ClearAgentID() *WebhookUpdateOne {
+	wuo.mutation.ClearAgentID()
+	return wuo
+}
+
+// SetSecret sets the "secret" field.
+func (wuo *WebhookUpdateOne) SetSecret(b []byte) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(b)
+	return wuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (wuo *WebhookUpdateOne) SetEnabled(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetEnabled(b)
+	return wuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableEnabled(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetEnabled(*b)
+	}
+	return wuo
+}
+
+// SetDescription sets the "description" field.
+func (wuo *WebhookUpdateOne) SetDescription(s string) *WebhookUpdateOne {
+	wuo.mutation.SetDescription(s)
+	return wuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableDescription(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetDescription(*s)
+	}
+	return wuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (wuo *WebhookUpdateOne) ClearDescription() *WebhookUpdateOne {
+	wuo.mutation.ClearDescription()
+	return wuo
+}
+
+// SetOwner sets the "owner" field.
+func (wuo *WebhookUpdateOne) SetOwner(s string) *WebhookUpdateOne {
+	wuo.mutation.SetOwner(s)
+	return wuo
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableOwner(s *string) *WebhookUpdateOne {
+	if s != nil {
+		wuo.SetOwner(*s)
+	}
+	return wuo
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (wuo *WebhookUpdateOne) ClearOwner() *WebhookUpdateOne {
+	wuo.mutation.ClearOwner()
+	return wuo
+}
+
+// SetAdmin sets the "admin" field.
+func (wuo *WebhookUpdateOne) SetAdmin(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetAdmin(b)
+	return wuo
+}
+
+// SetNillableAdmin sets the "admin" field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableAdmin(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetAdmin(*b)
+	}
+	return wuo
+}
+
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// Mutation returns the WebhookMutation object of the builder.
+func (wuo *WebhookUpdateOne) Mutation() *WebhookMutation {
+	return wuo.mutation
+}
+
+// ClearDeliveries clears all "deliveries" edges to the WebhookDelivery entity.
+func (wuo *WebhookUpdateOne) ClearDeliveries() *WebhookUpdateOne {
+	wuo.mutation.ClearDeliveries()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the "deliveries" edge to WebhookDelivery entities by IDs.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes "deliveries" edges to WebhookDelivery entities.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Where appends a list predicates to the WebhookUpdate builder.
+func (wuo *WebhookUpdateOne) Where(ps ...predicate.Webhook) *WebhookUpdateOne {
+	wuo.mutation.Where(ps...)
+	return wuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wuo *WebhookUpdateOne) Select(field string, fields ...string) *WebhookUpdateOne {
+	wuo.fields = append([]string{field}, fields...)
+	return wuo
+}
+
+// Save executes the query and returns the updated Webhook entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	wuo.defaults()
+	return withHooks(ctx, wuo.sqlSave, wuo.mutation, wuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	node, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wuo *WebhookUpdateOne) defaults() {
+	if _, ok := wuo.mutation.UpdateTime(); !ok {
+		v := webhook.UpdateDefaultUpdateTime()
+		wuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wuo *WebhookUpdateOne) check() error {
+	if v, ok := wuo.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`memory: validator failed for field "Webhook.url": %w`, err)}
+		}
+	}
+	if v, ok := wuo.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`memory: validator failed for field "Webhook.secret": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wuo *WebhookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookUpdateOne {
+	wuo.modifiers = append(wuo.modifiers, modifiers...)
+	return wuo
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (_node *Webhook, err error) {
+	if err := wuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhook.Table, webhook.Columns, sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID))
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`memory: missing "Webhook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.FieldID)
+		for _, f := range fields {
+			if !webhook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+			}
+			if f != webhook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wuo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhook.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.SetField(webhook.FieldURL, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.EventTypes(); ok {
+		_spec.SetField(webhook.FieldEventTypes, field.TypeJSON, value)
+	}
+	if value, ok := wuo.mutation.AppendedEventTypes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, webhook.FieldEventTypes, value)
+		})
+	}
+	if wuo.mutation.EventTypesCleared() {
+		_spec.ClearField(webhook.FieldEventTypes, field.TypeJSON)
+	}
+	if value, ok := wuo.mutation.TaskID(); ok {
+		_spec.SetField(webhook.FieldTaskID, field.TypeUUID, value)
+	}
+	if wuo.mutation.TaskIDCleared() {
+		_spec.ClearField(webhook.FieldTaskID, field.TypeUUID)
+	}
+	if value, ok := wuo.mutation.AgentID(); ok {
+		_spec.SetField(webhook.FieldAgentID, field.TypeUUID, value)
+	}
+	if wuo.mutation.AgentIDCleared() {
+		_spec.ClearField(webhook.FieldAgentID, field.TypeUUID)
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.SetField(webhook.FieldSecret, field.TypeBytes, value)
+	}
+	if value, ok := wuo.mutation.Enabled(); ok {
+		_spec.SetField(webhook.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := wuo.mutation.Description(); ok {
+		_spec.SetField(webhook.FieldDescription, field.TypeString, value)
+	}
+	if wuo.mutation.DescriptionCleared() {
+		_spec.ClearField(webhook.FieldDescription, field.TypeString)
+	}
+	if value, ok := wuo.mutation.Owner(); ok {
+		_spec.SetField(webhook.FieldOwner, field.TypeString, value)
+	}
+	if wuo.mutation.OwnerCleared() {
+		_spec.ClearField(webhook.FieldOwner, field.TypeString)
+	}
+	if value, ok := wuo.mutation.Admin(); ok {
+		_spec.SetField(webhook.FieldAdmin, field.TypeBool, value)
+	}
+	if wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 && !wuo.mutation.DeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wuo.modifiers...)
+	_node = &Webhook{config: wuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,453 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+}
+
+// SetCreateTime sets the "create_time" field.
+func (wdc *WebhookDeliveryCreate) SetCreateTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreateTime(t)
+	return wdc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreateTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreateTime(*t)
+	}
+	return wdc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdc *WebhookDeliveryCreate) SetUpdateTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetUpdateTime(t)
+	return wdc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableUpdateTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetUpdateTime(*t)
+	}
+	return wdc
+}
+
+// SetEventType sets the "event_type" field.
+func (wdc *WebhookDeliveryCreate) SetEventType(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventType(s)
+	return wdc
+}
+
+// SetEventSequence sets the "event_sequence" field.
+func (wdc *WebhookDeliveryCreate) SetEventSequence(i int64) *WebhookDeliveryCreate {
+	wdc.mutation.SetEventSequence(i)
+	return wdc
+}
+
+// SetNillableEventSequence sets the "event_sequence" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableEventSequence(i *int64) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetEventSequence(*i)
+	}
+	return wdc
+}
+
+// SetPayload sets the "payload" field.
+func (wdc *WebhookDeliveryCreate) SetPayload(b []byte) *WebhookDeliveryCreate {
+	wdc.mutation.SetPayload(b)
+	return wdc
+}
+
+// SetState sets the "state" field.
+func (wdc *WebhookDeliveryCreate) SetState(tds types.WebhookDeliveryState) *WebhookDeliveryCreate {
+	wdc.mutation.SetState(tds)
+	return wdc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableState(tds *types.WebhookDeliveryState) *WebhookDeliveryCreate {
+	if tds != nil {
+		wdc.SetState(*tds)
+	}
+	return wdc
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(i)
+	return wdc
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempts(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempts(*i)
+	}
+	return wdc
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (wdc *WebhookDeliveryCreate) SetNextAttemptTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetNextAttemptTime(t)
+	return wdc
+}
+
+// SetLastAttemptTime sets the "last_attempt_time" field.
+func (wdc *WebhookDeliveryCreate) SetLastAttemptTime(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetLastAttemptTime(t)
+	return wdc
+}
+
+// SetNillableLastAttemptTime sets the "last_attempt_time" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLastAttemptTime(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetLastAttemptTime(*t)
+	}
+	return wdc
+}
+
+// SetResponseStatusCode sets the "response_status_code" field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatusCode(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatusCode(i)
+	return wdc
+}
+
+// SetNillableResponseStatusCode sets the "response_status_code" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatusCode(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatusCode(*i)
+	}
+	return wdc
+}
+
+// SetLastError sets the "last_error" field.
+func (wdc *WebhookDeliveryCreate) SetLastError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetLastError(s)
+	return wdc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLastError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetLastError(*s)
+	}
+	return wdc
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdc *WebhookDeliveryCreate) SetWebhookID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookID(u)
+	return wdc
+}
+
+// SetID sets the "id" field.
+func (wdc *WebhookDeliveryCreate) SetID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(u)
+	return wdc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableID(u *uuid.UUID) *WebhookDeliveryCreate {
+	if u != nil {
+		wdc.SetID(*u)
+	}
+	return wdc
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdc *WebhookDeliveryCreate) SetWebhook(w *Webhook) *WebhookDeliveryCreate {
+	return wdc.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdc *WebhookDeliveryCreate) Mutation() *WebhookDeliveryMutation {
+	return wdc.mutation
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wdc.defaults()
+	return withHooks(ctx, wdc.sqlSave, wdc.mutation, wdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdc *WebhookDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := wdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdc *WebhookDeliveryCreate) ExecX(ctx context.Context) {
+	if err := wdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdc *WebhookDeliveryCreate) defaults() {
+	if _, ok := wdc.mutation.CreateTime(); !ok {
+		v := webhookdelivery.DefaultCreateTime()
+		wdc.mutation.SetCreateTime(v)
+	}
+	if _, ok := wdc.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.DefaultUpdateTime()
+		wdc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := wdc.mutation.State(); !ok {
+		v := webhookdelivery.DefaultState
+		wdc.mutation.SetState(v)
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		v := webhookdelivery.DefaultAttempts
+		wdc.mutation.SetAttempts(v)
+	}
+	if _, ok := wdc.mutation.ID(); !ok {
+		v := webhookdelivery.DefaultID()
+		wdc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdc *WebhookDeliveryCreate) check() error {
+	if _, ok := wdc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`memory: missing required field "WebhookDelivery.create_time"`)}
+	}
+	if _, ok := wdc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`memory: missing required field "WebhookDelivery.update_time"`)}
+	}
+	if _, ok := wdc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`memory: missing required field "WebhookDelivery.event_type"`)}
+	}
+	if _, ok := wdc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`memory: missing required field "WebhookDelivery.payload"`)}
+	}
+	if _, ok := wdc.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`memory: missing required field "WebhookDelivery.state"`)}
+	}
+	if v, ok := wdc.mutation.State(); ok {
+		if err := webhookdelivery.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`memory: validator failed for field "WebhookDelivery.state": %w`, err)}
+		}
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`memory: missing required field "WebhookDelivery.attempts"`)}
+	}
+	if _, ok := wdc.mutation.NextAttemptTime(); !ok {
+		return &ValidationError{Name: "next_attempt_time", err: errors.New(`memory: missing required field "WebhookDelivery.next_attempt_time"`)}
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return &ValidationError{Name: "webhook_id", err: errors.New(`memory: missing required field "WebhookDelivery.webhook_id"`)}
+	}
+	if len(wdc.mutation.WebhookIDs()) == 0 {
+		return &ValidationError{Name: "webhook", err: errors.New(`memory: missing required edge "WebhookDelivery.webhook"`)}
+	}
+	return nil
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	if err := wdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	wdc.mutation.id = &_node.ID
+	wdc.mutation.done = true
+	return _node, nil
+}
+
+func (wdc *WebhookDeliveryCreate) createSpec() (*WebhookDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookDelivery{config: wdc.config}
+		_spec = sqlgraph.NewCreateSpec(webhookdelivery.Table, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	)
+	if id, ok := wdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := wdc.mutation.CreateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := wdc.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := wdc.mutation.EventType(); ok {
+		_spec.SetField(webhookdelivery.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := wdc.mutation.EventSequence(); ok {
+		_spec.SetField(webhookdelivery.FieldEventSequence, field.TypeInt64, value)
+		_node.EventSequence = value
+	}
+	if value, ok := wdc.mutation.Payload(); ok {
+		_spec.SetField(webhookdelivery.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := wdc.mutation.State(); ok {
+		_spec.SetField(webhookdelivery.FieldState, field.TypeEnum, value)
+		_node.State = value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := wdc.mutation.NextAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptTime, field.TypeTime, value)
+		_node.NextAttemptTime = value
+	}
+	if value, ok := wdc.mutation.LastAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldLastAttemptTime, field.TypeTime, value)
+		_node.LastAttemptTime = &value
+	}
+	if value, ok := wdc.mutation.ResponseStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatusCode, field.TypeInt, value)
+		_node.ResponseStatusCode = value
+	}
+	if value, ok := wdc.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if nodes := wdc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WebhookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookDeliveryCreateBulk is the builder for creating many WebhookDelivery entities in bulk.
+type WebhookDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookDeliveryCreate
+}
+
+// Save creates the WebhookDelivery entities in the database.
+func (wdcb *WebhookDeliveryCreateBulk) Save(ctx context.Context) ([]*WebhookDelivery, error) {
+	if wdcb.err != nil {
+		return nil, wdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wdcb.builders))
+	nodes := make([]*WebhookDelivery, len(wdcb.builders))
+	mutators := make([]Mutator, len(wdcb.builders))
+	for i := range wdcb.builders {
+		func(i int, root context.Context) {
+			builder := wdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) SaveX(ctx context.Context) []*WebhookDelivery {
+	v, err := wdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wdcb *WebhookDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := wdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdcb *WebhookDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := wdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
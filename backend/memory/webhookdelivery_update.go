@@ -0,0 +1,675 @@
+// Code generated by ent. DO NOT EDIT.
+
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/furisto/construct/backend/memory/predicate"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.mutation.Where(ps...)
+	return wdu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wdu *WebhookDeliveryUpdate) SetUpdateTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetUpdateTime(t)
+	return wdu
+}
+
+// SetState sets the "state" field.
+func (wdu *WebhookDeliveryUpdate) SetState(tds types.WebhookDeliveryState) *WebhookDeliveryUpdate {
+	wdu.mutation.SetState(tds)
+	return wdu
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableState(tds *types.WebhookDeliveryState) *WebhookDeliveryUpdate {
+	if tds != nil {
+		wdu.SetState(*tds)
+	}
+	return wdu
+}
+
+// SetAttempts sets the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptTime(t)
+	return wdu
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptTime(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptTime(*t)
+	}
+	return wdu
+}
+
+// SetLastAttemptTime sets the "last_attempt_time" field.
+func (wdu *WebhookDeliveryUpdate) SetLastAttemptTime(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastAttemptTime(t)
+	return wdu
+}
+
+// SetNillableLastAttemptTime sets the "last_attempt_time" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastAttemptTime(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetLastAttemptTime(*t)
+	}
+	return wdu
+}
+
+// ClearLastAttemptTime clears the value of the "last_attempt_time" field.
+func (wdu *WebhookDeliveryUpdate) ClearLastAttemptTime() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearLastAttemptTime()
+	return wdu
+}
+
+// SetResponseStatusCode sets the "response_status_code" field.
+func (wdu *WebhookDeliveryUpdate) SetResponseStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseStatusCode()
+	wdu.mutation.SetResponseStatusCode(i)
+	return wdu
+}
+
+// SetNillableResponseStatusCode sets the "response_status_code" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseStatusCode(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseStatusCode(*i)
+	}
+	return wdu
+}
+
+// AddResponseStatusCode adds i to the "response_status_code" field.
+func (wdu *WebhookDeliveryUpdate) AddResponseStatusCode(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseStatusCode(i)
+	return wdu
+}
+
+// ClearResponseStatusCode clears the value of the "response_status_code" field.
+func (wdu *WebhookDeliveryUpdate) ClearResponseStatusCode() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseStatusCode()
+	return wdu
+}
+
+// SetLastError sets the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) SetLastError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastError(s)
+	return wdu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetLastError(*s)
+	}
+	return wdu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (wdu *WebhookDeliveryUpdate) ClearLastError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearLastError()
+	return wdu
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(u uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(u)
+	return wdu
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableWebhookID(u *uuid.UUID) *WebhookDeliveryUpdate {
+	if u != nil {
+		wdu.SetWebhookID(*u)
+	}
+	return wdu
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wdu *WebhookDeliveryUpdate) Mutation() *WebhookDeliveryMutation {
+	return wdu.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	wdu.defaults()
+	return withHooks(ctx, wdu.sqlSave, wdu.mutation, wdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wdu *WebhookDeliveryUpdate) defaults() {
+	if _, ok := wdu.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wdu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wdu *WebhookDeliveryUpdate) check() error {
+	if v, ok := wdu.mutation.State(); ok {
+		if err := webhookdelivery.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`memory: validator failed for field "WebhookDelivery.state": %w`, err)}
+		}
+	}
+	if wdu.mutation.WebhookCleared() && len(wdu.mutation.WebhookIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wdu *WebhookDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdate {
+	wdu.modifiers = append(wdu.modifiers, modifiers...)
+	return wdu
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	if ps := wdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if wdu.mutation.EventSequenceCleared() {
+		_spec.ClearField(webhookdelivery.FieldEventSequence, field.TypeInt64)
+	}
+	if value, ok := wdu.mutation.State(); ok {
+		_spec.SetField(webhookdelivery.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.NextAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if value, ok := wdu.mutation.LastAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldLastAttemptTime, field.TypeTime, value)
+	}
+	if wdu.mutation.LastAttemptTimeCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastAttemptTime, field.TypeTime)
+	}
+	if value, ok := wdu.mutation.ResponseStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wdu.mutation.AddedResponseStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatusCode, field.TypeInt, value)
+	}
+	if wdu.mutation.ResponseStatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatusCode, field.TypeInt)
+	}
+	if value, ok := wdu.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if wdu.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastError, field.TypeString)
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wdu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wdu.mutation.done = true
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WebhookDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetUpdateTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetUpdateTime(t)
+	return wduo
+}
+
+// SetState sets the "state" field.
+func (wduo *WebhookDeliveryUpdateOne) SetState(tds types.WebhookDeliveryState) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetState(tds)
+	return wduo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableState(tds *types.WebhookDeliveryState) *WebhookDeliveryUpdateOne {
+	if tds != nil {
+		wduo.SetState(*tds)
+	}
+	return wduo
+}
+
+// SetAttempts sets the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptTime(t)
+	return wduo
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptTime(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptTime(*t)
+	}
+	return wduo
+}
+
+// SetLastAttemptTime sets the "last_attempt_time" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastAttemptTime(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastAttemptTime(t)
+	return wduo
+}
+
+// SetNillableLastAttemptTime sets the "last_attempt_time" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastAttemptTime(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetLastAttemptTime(*t)
+	}
+	return wduo
+}
+
+// ClearLastAttemptTime clears the value of the "last_attempt_time" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearLastAttemptTime() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearLastAttemptTime()
+	return wduo
+}
+
+// SetResponseStatusCode sets the "response_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseStatusCode()
+	wduo.mutation.SetResponseStatusCode(i)
+	return wduo
+}
+
+// SetNillableResponseStatusCode sets the "response_status_code" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseStatusCode(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseStatusCode(*i)
+	}
+	return wduo
+}
+
+// AddResponseStatusCode adds i to the "response_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseStatusCode(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseStatusCode(i)
+	return wduo
+}
+
+// ClearResponseStatusCode clears the value of the "response_status_code" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseStatusCode() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseStatusCode()
+	return wduo
+}
+
+// SetLastError sets the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastError(s)
+	return wduo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetLastError(*s)
+	}
+	return wduo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (wduo *WebhookDeliveryUpdateOne) ClearLastError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearLastError()
+	return wduo
+}
+
+// SetWebhookID sets the "webhook_id" field.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(u uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(u)
+	return wduo
+}
+
+// SetNillableWebhookID sets the "webhook_id" field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableWebhookID(u *uuid.UUID) *WebhookDeliveryUpdateOne {
+	if u != nil {
+		wduo.SetWebhookID(*u)
+	}
+	return wduo
+}
+
+// SetWebhook sets the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// Mutation returns the WebhookDeliveryMutation object of the builder.
+func (wduo *WebhookDeliveryUpdateOne) Mutation() *WebhookDeliveryMutation {
+	return wduo.mutation
+}
+
+// ClearWebhook clears the "webhook" edge to the Webhook entity.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Where appends a list predicates to the WebhookDeliveryUpdate builder.
+func (wduo *WebhookDeliveryUpdateOne) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdateOne {
+	wduo.mutation.Where(ps...)
+	return wduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wduo *WebhookDeliveryUpdateOne) Select(field string, fields ...string) *WebhookDeliveryUpdateOne {
+	wduo.fields = append([]string{field}, fields...)
+	return wduo
+}
+
+// Save executes the query and returns the updated WebhookDelivery entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	wduo.defaults()
+	return withHooks(ctx, wduo.sqlSave, wduo.mutation, wduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	node, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wduo *WebhookDeliveryUpdateOne) defaults() {
+	if _, ok := wduo.mutation.UpdateTime(); !ok {
+		v := webhookdelivery.UpdateDefaultUpdateTime()
+		wduo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wduo *WebhookDeliveryUpdateOne) check() error {
+	if v, ok := wduo.mutation.State(); ok {
+		if err := webhookdelivery.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`memory: validator failed for field "WebhookDelivery.state": %w`, err)}
+		}
+	}
+	if wduo.mutation.WebhookCleared() && len(wduo.mutation.WebhookIDs()) > 0 {
+		return errors.New(`memory: clearing a required unique edge "WebhookDelivery.webhook"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wduo *WebhookDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WebhookDeliveryUpdateOne {
+	wduo.modifiers = append(wduo.modifiers, modifiers...)
+	return wduo
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *WebhookDelivery, err error) {
+	if err := wduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookdelivery.Table, webhookdelivery.Columns, sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeUUID))
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`memory: missing "WebhookDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookdelivery.FieldID)
+		for _, f := range fields {
+			if !webhookdelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("memory: invalid field %q for query", f)}
+			}
+			if f != webhookdelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wduo.mutation.UpdateTime(); ok {
+		_spec.SetField(webhookdelivery.FieldUpdateTime, field.TypeTime, value)
+	}
+	if wduo.mutation.EventSequenceCleared() {
+		_spec.ClearField(webhookdelivery.FieldEventSequence, field.TypeInt64)
+	}
+	if value, ok := wduo.mutation.State(); ok {
+		_spec.SetField(webhookdelivery.FieldState, field.TypeEnum, value)
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.SetField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.AddField(webhookdelivery.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.NextAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if value, ok := wduo.mutation.LastAttemptTime(); ok {
+		_spec.SetField(webhookdelivery.FieldLastAttemptTime, field.TypeTime, value)
+	}
+	if wduo.mutation.LastAttemptTimeCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastAttemptTime, field.TypeTime)
+	}
+	if value, ok := wduo.mutation.ResponseStatusCode(); ok {
+		_spec.SetField(webhookdelivery.FieldResponseStatusCode, field.TypeInt, value)
+	}
+	if value, ok := wduo.mutation.AddedResponseStatusCode(); ok {
+		_spec.AddField(webhookdelivery.FieldResponseStatusCode, field.TypeInt, value)
+	}
+	if wduo.mutation.ResponseStatusCodeCleared() {
+		_spec.ClearField(webhookdelivery.FieldResponseStatusCode, field.TypeInt)
+	}
+	if value, ok := wduo.mutation.LastError(); ok {
+		_spec.SetField(webhookdelivery.FieldLastError, field.TypeString, value)
+	}
+	if wduo.mutation.LastErrorCleared() {
+		_spec.ClearField(webhookdelivery.FieldLastError, field.TypeString)
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhook.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wduo.modifiers...)
+	_node = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wduo.mutation.done = true
+	return _node, nil
+}
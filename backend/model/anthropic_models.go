@@ -20,190 +20,56 @@ func SupportedAnthropicModels() []Model {
 			ID:       uuid.MustParse("019abac1-a52d-7dbf-bf8d-d9155ca475dc"),
 			Name:     "claude-opus-4-5-20251101",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      5.0,
-				Output:     25.0,
-				CacheWrite: 6.25,
-				CacheRead:  0.5,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0199ee5a-ffd4-721f-9e41-ad8167f7d909"),
 			Name:     "claude-haiku-4-5-20251001",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      1.0,
-				Output:     5.0,
-				CacheWrite: 1.25,
-				CacheRead:  0.1,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0199ee5a-ffd4-721f-9e41-ad8167f7d909"),
 			Name:     "claude-sonnet-4-5-20250929",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      3.0,
-				Output:     15.0,
-				CacheWrite: 3.75,
-				CacheRead:  0.3,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0197e0d5-7567-70c6-8f64-e217dee9eb05"),
 			Name:     "claude-sonnet-4-20250514",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      3.0,
-				Output:     15.0,
-				CacheWrite: 3.75,
-				CacheRead:  0.3,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0197e0d5-8f08-7609-9fe0-d407b2563375"),
 			Name:     "claude-opus-4-20250514",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      15.0,
-				Output:     75.0,
-				CacheWrite: 18.75,
-				CacheRead:  1.5,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0195b4e2-45b6-76df-b208-f48b7b0d5f51"),
 			Name:     "claude-3-7-sonnet-20250219",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      3.0,
-				Output:     15.0,
-				CacheWrite: 3.75,
-				CacheRead:  0.3,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0195b4e2-7d71-79e0-97da-3045fb1ffc3e"),
 			Name:     "claude-3-5-sonnet-20241022",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      3.0,
-				Output:     15.0,
-				CacheWrite: 3.75,
-				CacheRead:  0.3,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0195b4e2-a5df-736d-82ea-00f46db3dadc"),
 			Name:     "claude-3-5-sonnet-20240620",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-			},
-			ContextWindow: 100000,
-			Pricing: ModelPricing{
-				Input:      3.0,
-				Output:     15.0,
-				CacheWrite: 3.75,
-				CacheRead:  0.3,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0195b4e2-c741-724d-bb2a-3b0f7fdbc5f4"),
 			Name:     "claude-3-5-haiku-20241022",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityPromptCache,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      0.8,
-				Output:     4.0,
-				CacheWrite: 1.0,
-				CacheRead:  0.08,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0195b4e2-efd4-7c5c-a9a2-219318e0e181"),
 			Name:     "claude-3-opus-20240229",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityPromptCache,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      15.0,
-				Output:     75.0,
-				CacheWrite: 18.75,
-				CacheRead:  1.5,
-			},
 		},
 		{
 			ID:       uuid.MustParse("0195b4e3-1da7-71af-ba34-6689aed6c4a2"),
 			Name:     "claude-3-haiku-20240307",
 			Provider: ProviderKindAnthropic,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityPromptCache,
-			},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      0.25,
-				Output:     1.25,
-				CacheWrite: 0.3,
-				CacheRead:  0.03,
-			},
 		},
 	}
 }
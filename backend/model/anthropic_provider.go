@@ -24,6 +24,8 @@ type AnthropicProvider struct {
 }
 
 var _ ModelProvider = (*AnthropicProvider)(nil)
+var _ ModelLister = (*AnthropicProvider)(nil)
+var _ TokenCounter = (*AnthropicProvider)(nil)
 
 func NewAnthropicProvider(apiKey string, opts ...ProviderOption) (*AnthropicProvider, error) {
 	logger := slog.With("component", "anthropic_provider")
@@ -34,15 +36,16 @@ func NewAnthropicProvider(apiKey string, opts ...ProviderOption) (*AnthropicProv
 	}
 	logger.Debug("initializing Anthropic provider")
 
-	clientOptions := []option.RequestOption{
-		option.WithAPIKey(apiKey),
-	}
-
 	providerOptions := DefaultProviderOptions("anthropic")
 	for _, opt := range opts {
 		opt(providerOptions)
 	}
 
+	clientOptions := []option.RequestOption{
+		option.WithAPIKey(apiKey),
+		option.WithHTTPClient(providerOptions.HTTPClient),
+	}
+
 	if providerOptions.URL != "" {
 		logger.Debug("using custom Anthropic URL",
 			"url", providerOptions.URL,
@@ -151,7 +154,7 @@ func (p *AnthropicProvider) invokeInternal(ctx context.Context, request anthropi
 	return backoff.Retry(ctx, func() (*Message, error) {
 		if !p.circuitBreaker.Allow() {
 			logger.Error("circuit breaker open - too many errors")
-			return nil, backoff.Permanent(fmt.Errorf("too many errors from anthropic provider, circuit breaker open"))
+			return nil, backoff.Permanent(NewAnthropicProviderError(ProviderErrorKindCircuitOpen, fmt.Errorf("too many errors from anthropic provider")))
 		}
 
 		streamStart := time.Now()
@@ -178,7 +181,7 @@ func (p *AnthropicProvider) invokeInternal(ctx context.Context, request anthropi
 			p.circuitBreaker.RecordResult(stream.Err())
 			err := p.mapError(stream.Err())
 			if err.retryableInternal() {
-				return nil, stream.Err()
+				return nil, err
 			}
 			return nil, backoff.Permanent(err)
 		}
@@ -224,14 +227,71 @@ func (p *AnthropicProvider) invokeInternal(ctx context.Context, request anthropi
 	}, retryOptions...)
 }
 
+func (p *AnthropicProvider) ListModels(ctx context.Context) ([]string, error) {
+	var models []string
+	pager := p.client.Models.ListAutoPaging(ctx, anthropic.ModelListParams{})
+	for pager.Next() {
+		models = append(models, pager.Current().ID)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, p.mapError(err)
+	}
+
+	return models, nil
+}
+
+// CountTokens counts the input tokens of a prompt with the token counting endpoint. The request
+// is built exactly like the one of InvokeModel.
+func (p *AnthropicProvider) CountTokens(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (int64, error) {
+	if err := p.validateInput(model, systemPrompt, messages); err != nil {
+		return 0, err
+	}
+
+	options := defaultAnthropicInvokeOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	anthropicMessages, err := p.transformMessages(messages)
+	if err != nil {
+		return 0, err
+	}
+
+	anthropicTools, err := p.transformTools(options.Tools)
+	if err != nil {
+		return 0, err
+	}
+
+	request := anthropic.MessageCountTokensParams{
+		Model: anthropic.Model(model),
+		System: anthropic.MessageCountTokensParamsSystemUnion{
+			OfTextBlockArray: []anthropic.TextBlockParam{{Text: systemPrompt}},
+		},
+		Messages: anthropicMessages,
+	}
+	for _, tool := range anthropicTools {
+		request.Tools = append(request.Tools, anthropic.MessageCountTokensToolUnionParam{OfTool: tool.OfTool})
+	}
+
+	count, err := p.client.Messages.CountTokens(ctx, request)
+	if err != nil {
+		return 0, p.mapError(err)
+	}
+
+	return count.InputTokens, nil
+}
+
 func (p *AnthropicProvider) mapError(err error) *ProviderError {
 	var apiErr *anthropic.Error
 	if errors.As(err, &apiErr) {
 		var kind ProviderErrorKind
 
 		switch apiErr.StatusCode {
-		case 400, 401, 403, 404, 413:
+		case 400, 404, 413:
 			kind = ProviderErrorKindInvalidRequest
+		case 401, 403:
+			kind = ProviderErrorKindAuthentication
 		case 429:
 			kind = ProviderErrorKindRateLimitExceeded
 		case 529:
@@ -290,14 +350,15 @@ func (p *AnthropicProvider) transformMessages(messages []*Message) ([]anthropic.
 
 	anthropicMessages := make([]anthropic.MessageParam, len(messages))
 	for i, message := range messages {
-		anthropicBlocks := make([]anthropic.ContentBlockParamUnion, len(message.Content))
-		for j, b := range message.Content {
+		content := withoutReasoning(message.Content)
+		anthropicBlocks := make([]anthropic.ContentBlockParamUnion, len(content))
+		for j, b := range content {
 			switch block := b.(type) {
 			case *TextBlock:
 				textBlockParam := anthropic.TextBlockParam{
 					Text: block.Text,
 				}
-				if (i == lastUserMessageIndex || i == secondToLastUserMessageIndex) && j == len(message.Content)-1 {
+				if (i == lastUserMessageIndex || i == secondToLastUserMessageIndex) && j == len(content)-1 {
 					textBlockParam.CacheControl = anthropic.NewCacheControlEphemeralParam()
 				}
 				anthropicBlocks[j] = anthropic.ContentBlockParamUnion{OfText: &textBlockParam}
@@ -316,7 +377,7 @@ func (p *AnthropicProvider) transformMessages(messages []*Message) ([]anthropic.
 					},
 					IsError: anthropic.Bool(!block.Succeeded),
 				}
-				if (i == lastUserMessageIndex || i == secondToLastUserMessageIndex) && j == len(message.Content)-1 {
+				if (i == lastUserMessageIndex || i == secondToLastUserMessageIndex) && j == len(content)-1 {
 					toolResultBlockParam.CacheControl = anthropic.NewCacheControlEphemeralParam()
 				}
 				anthropicBlocks[j] = anthropic.ContentBlockParamUnion{OfToolResult: &toolResultBlockParam}
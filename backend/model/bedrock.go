@@ -0,0 +1,361 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/document"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockruntime/types"
+	"github.com/furisto/construct/backend/tool/native"
+	"github.com/furisto/construct/shared/resilience"
+	"github.com/google/uuid"
+)
+
+const (
+	BedrockBudgetModel  = "global.anthropic.claude-haiku-4-5-20251001-v1:0"
+	BedrockDefaultModel = "global.anthropic.claude-sonnet-4-5-20250929-v1:0"
+	BedrockPlanModel    = "global.anthropic.claude-opus-4-5-20251101-v1:0"
+)
+
+func SupportedBedrockModels() []Model {
+	return []Model{
+		{
+			ID:       uuid.MustParse("019ac0be-0001-7000-8000-000000000001"),
+			Name:     BedrockDefaultModel,
+			Provider: ProviderKindBedrock,
+		},
+		{
+			ID:       uuid.MustParse("019ac0be-0002-7000-8000-000000000002"),
+			Name:     BedrockBudgetModel,
+			Provider: ProviderKindBedrock,
+		},
+		{
+			ID:       uuid.MustParse("019ac0be-0003-7000-8000-000000000003"),
+			Name:     BedrockPlanModel,
+			Provider: ProviderKindBedrock,
+		},
+		{
+			ID:       uuid.MustParse("019ac0be-0004-7000-8000-000000000004"),
+			Name:     "us.amazon.nova-pro-v1:0",
+			Provider: ProviderKindBedrock,
+		},
+	}
+}
+
+// BedrockProvider invokes the models hosted on Amazon Bedrock through the Converse API.
+type BedrockProvider struct {
+	client         *bedrockruntime.Client
+	circuitBreaker *resilience.CircuitBreaker
+}
+
+var _ ModelProvider = (*BedrockProvider)(nil)
+
+func NewBedrockProvider(creds AWSCredentials, opts ...ProviderOption) (*BedrockProvider, error) {
+	logger := slog.With("component", "bedrock_provider")
+
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		logger.Error("bedrock access key is required")
+		return nil, fmt.Errorf("bedrock access key ID and secret access key are required")
+	}
+
+	if creds.Region == "" {
+		logger.Error("bedrock region is required")
+		return nil, fmt.Errorf("bedrock region is required")
+	}
+	logger.Debug("initializing Bedrock provider", "region", creds.Region)
+
+	providerOptions := DefaultProviderOptions("bedrock")
+	for _, opt := range opts {
+		opt(providerOptions)
+	}
+
+	clientOptions := bedrockruntime.Options{
+		Region:      creds.Region,
+		Credentials: credentials.NewStaticCredentialsProvider(creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken),
+		// Failed requests are retried by the agent, which knows whether to wait or to fall back
+		// to another model.
+		Retryer:    aws.NopRetryer{},
+		HTTPClient: providerOptions.HTTPClient,
+	}
+	if providerOptions.URL != "" {
+		logger.Debug("using custom Bedrock URL",
+			"url", providerOptions.URL,
+		)
+		clientOptions.BaseEndpoint = aws.String(providerOptions.URL)
+	}
+
+	logger.Info("Bedrock provider initialized successfully")
+
+	return &BedrockProvider{
+		client:         bedrockruntime.New(clientOptions),
+		circuitBreaker: providerOptions.CircuitBreaker,
+	}, nil
+}
+
+func (p *BedrockProvider) InvokeModel(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	logger := slog.With(
+		"component", "bedrock_provider",
+		"model", model,
+		"message_count", len(messages),
+	)
+
+	if err := p.validateInput(model, systemPrompt, messages); err != nil {
+		logger.Error("validation failed", "error", err)
+		return nil, err
+	}
+
+	options := defaultAnthropicInvokeOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	modelProfile, err := ensureModelProfile[*AnthropicModelProfile](options.ModelProfile)
+	if err != nil {
+		logger.Error("failed to ensure model profile", "error", err)
+		return nil, err
+	}
+
+	promptCache := bedrockSupportsPromptCache(model)
+	bedrockMessages, err := p.transformMessages(messages)
+	if err != nil {
+		logger.Error("failed to transform messages", "error", err)
+		return nil, err
+	}
+	logger.Debug("messages transformed",
+		"transformed_count", len(bedrockMessages),
+	)
+
+	system := []types.SystemContentBlock{&types.SystemContentBlockMemberText{Value: systemPrompt}}
+	if promptCache {
+		system = append(system, &types.SystemContentBlockMemberCachePoint{Value: types.CachePointBlock{Type: types.CachePointTypeDefault}})
+	}
+
+	input := &bedrockruntime.ConverseInput{
+		ModelId:  aws.String(model),
+		System:   system,
+		Messages: bedrockMessages,
+		InferenceConfig: &types.InferenceConfiguration{
+			MaxTokens: aws.Int32(int32(modelProfile.MaxTokens)),
+		},
+	}
+
+	if tools := p.transformTools(options.Tools, promptCache); len(tools) > 0 {
+		input.ToolConfig = &types.ToolConfiguration{
+			Tools:      tools,
+			ToolChoice: &types.ToolChoiceMemberAuto{},
+		}
+	}
+
+	if !p.circuitBreaker.Allow() {
+		logger.Error("circuit breaker open - too many errors")
+		return nil, NewProviderError("bedrock", ProviderErrorKindCircuitOpen, fmt.Errorf("too many errors from bedrock provider"))
+	}
+
+	invokeStart := time.Now()
+	logger.Debug("invoking Bedrock Converse API")
+
+	output, err := p.client.Converse(ctx, input)
+	if err != nil {
+		logger.Error("bedrock converse error",
+			"error", err,
+			"duration_ms", time.Since(invokeStart).Milliseconds(),
+		)
+		p.circuitBreaker.RecordResult(err)
+		return nil, p.mapError(err)
+	}
+	p.circuitBreaker.RecordResult(nil)
+
+	message, ok := output.Output.(*types.ConverseOutputMemberMessage)
+	if !ok {
+		return nil, NewProviderError("bedrock", ProviderErrorKindUnknown, fmt.Errorf("unexpected converse output %T", output.Output))
+	}
+
+	var content []ContentBlock
+	for _, block := range message.Value.Content {
+		switch b := block.(type) {
+		case *types.ContentBlockMemberText:
+			content = append(content, &TextBlock{Text: b.Value})
+			if options.StreamCallback != nil {
+				options.StreamCallback(ctx, b.Value)
+			}
+		case *types.ContentBlockMemberToolUse:
+			args := json.RawMessage("{}")
+			if b.Value.Input != nil {
+				args, err = b.Value.Input.MarshalSmithyDocument()
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode tool input: %w", err)
+				}
+			}
+			content = append(content, &ToolCallBlock{
+				ID:   aws.ToString(b.Value.ToolUseId),
+				Tool: aws.ToString(b.Value.Name),
+				Args: args,
+			})
+		}
+	}
+
+	var usage Usage
+	if output.Usage != nil {
+		usage = Usage{
+			InputTokens:      int64(aws.ToInt32(output.Usage.InputTokens)),
+			OutputTokens:     int64(aws.ToInt32(output.Usage.OutputTokens)),
+			CacheWriteTokens: int64(aws.ToInt32(output.Usage.CacheWriteInputTokens)),
+			CacheReadTokens:  int64(aws.ToInt32(output.Usage.CacheReadInputTokens)),
+		}
+	}
+
+	logger.Info("bedrock invocation successful",
+		"input_tokens", usage.InputTokens,
+		"output_tokens", usage.OutputTokens,
+		"cache_write_tokens", usage.CacheWriteTokens,
+		"cache_read_tokens", usage.CacheReadTokens,
+		"stop_reason", output.StopReason,
+		"duration_ms", time.Since(invokeStart).Milliseconds(),
+	)
+
+	return NewModelMessage(content, usage), nil
+}
+
+// bedrockSupportsPromptCache reports whether the model accepts cache points. Other models reject
+// requests that contain them.
+func bedrockSupportsPromptCache(model string) bool {
+	return strings.Contains(model, "anthropic.claude") || strings.Contains(model, "amazon.nova")
+}
+
+func (p *BedrockProvider) mapError(err error) *ProviderError {
+	var respErr *awshttp.ResponseError
+	if !errors.As(err, &respErr) {
+		if errors.Is(err, context.Canceled) {
+			return NewProviderError("bedrock", ProviderErrorKindCanceled, err)
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return NewProviderError("bedrock", ProviderErrorKindTimeout, err)
+		}
+		return NewProviderError("bedrock", ProviderErrorKindUnknown, err)
+	}
+
+	var kind ProviderErrorKind
+	switch respErr.HTTPStatusCode() {
+	case 400, 404, 413:
+		kind = ProviderErrorKindInvalidRequest
+	case 401, 403:
+		kind = ProviderErrorKindAuthentication
+	case 408:
+		kind = ProviderErrorKindTimeout
+	case 429:
+		kind = ProviderErrorKindRateLimitExceeded
+	case 503:
+		kind = ProviderErrorKindOverloaded
+	default:
+		if respErr.HTTPStatusCode() >= 500 && respErr.HTTPStatusCode() < 600 {
+			kind = ProviderErrorKindInternal
+		} else {
+			kind = ProviderErrorKindUnknown
+		}
+	}
+
+	return NewProviderError("bedrock", kind, err)
+}
+
+// transformMessages converts the messages into Converse messages. Tool results are sent by the
+// user and consecutive messages of the same role are merged, because the Converse API requires
+// user and assistant messages to alternate.
+func (p *BedrockProvider) transformMessages(messages []*Message) ([]types.Message, error) {
+	var bedrockMessages []types.Message
+	for _, message := range messages {
+		role := types.ConversationRoleUser
+		if message.Source == MessageSourceModel {
+			role = types.ConversationRoleAssistant
+		}
+
+		var blocks []types.ContentBlock
+		for _, b := range message.Content {
+			switch block := b.(type) {
+			case *TextBlock:
+				if block.Text == "" {
+					continue
+				}
+				blocks = append(blocks, &types.ContentBlockMemberText{Value: block.Text})
+
+			case *ToolCallBlock:
+				var input any = map[string]any{}
+				if len(block.Args) > 0 {
+					if err := json.Unmarshal(block.Args, &input); err != nil {
+						return nil, fmt.Errorf("failed to decode arguments of tool call %s: %w", block.ID, err)
+					}
+				}
+				blocks = append(blocks, &types.ContentBlockMemberToolUse{Value: types.ToolUseBlock{
+					ToolUseId: aws.String(block.ID),
+					Name:      aws.String(block.Tool),
+					Input:     document.NewLazyDocument(input),
+				}})
+
+			case *ToolResultBlock:
+				status := types.ToolResultStatusSuccess
+				if !block.Succeeded {
+					status = types.ToolResultStatusError
+				}
+				blocks = append(blocks, &types.ContentBlockMemberToolResult{Value: types.ToolResultBlock{
+					ToolUseId: aws.String(block.ID),
+					Content:   []types.ToolResultContentBlock{&types.ToolResultContentBlockMemberText{Value: block.Result}},
+					Status:    status,
+				}})
+			}
+		}
+
+		if len(blocks) == 0 {
+			continue
+		}
+
+		if last := len(bedrockMessages) - 1; last >= 0 && bedrockMessages[last].Role == role {
+			bedrockMessages[last].Content = append(bedrockMessages[last].Content, blocks...)
+			continue
+		}
+
+		bedrockMessages = append(bedrockMessages, types.Message{Role: role, Content: blocks})
+	}
+
+	return bedrockMessages, nil
+}
+
+func (p *BedrockProvider) transformTools(tools []native.Tool, promptCache bool) []types.Tool {
+	var bedrockTools []types.Tool
+	for _, tool := range tools {
+		bedrockTools = append(bedrockTools, &types.ToolMemberToolSpec{Value: types.ToolSpecification{
+			Name:        aws.String(tool.Name()),
+			Description: aws.String(tool.Description()),
+			InputSchema: &types.ToolInputSchemaMemberJson{Value: document.NewLazyDocument(tool.Schema())},
+		}})
+	}
+
+	if promptCache && len(bedrockTools) > 0 {
+		bedrockTools = append(bedrockTools, &types.ToolMemberCachePoint{Value: types.CachePointBlock{Type: types.CachePointTypeDefault}})
+	}
+
+	return bedrockTools
+}
+
+func (p *BedrockProvider) validateInput(model, systemPrompt string, messages []*Message) error {
+	if model == "" {
+		return fmt.Errorf("model is required")
+	}
+
+	if systemPrompt == "" {
+		return fmt.Errorf("system prompt is required")
+	}
+
+	if len(messages) == 0 {
+		return fmt.Errorf("at least one message is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are replaced in captured exchanges, because they carry credentials.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"X-Api-Key",
+	"Api-Key",
+	"X-Goog-Api-Key",
+	"X-Amz-Security-Token",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// HTTPExchange is a request to a model provider as it was sent over the wire, together with the
+// response of the provider.
+type HTTPExchange struct {
+	Method          string
+	URL             string
+	RequestHeaders  http.Header
+	RequestBody     []byte
+	StatusCode      int
+	ResponseHeaders http.Header
+	ResponseBody    []byte
+	// Truncated is set if a body was larger than the capture limit.
+	Truncated bool
+	Error     string
+	Duration  time.Duration
+}
+
+// CallCapture collects the HTTP exchanges of model invocations whose context carries it. Bodies
+// are cut off after maxBodyBytes.
+type CallCapture struct {
+	maxBodyBytes int
+
+	mu        sync.Mutex
+	exchanges []*HTTPExchange
+}
+
+func NewCallCapture(maxBodyBytes int) *CallCapture {
+	return &CallCapture{maxBodyBytes: maxBodyBytes}
+}
+
+// Exchanges returns copies of the exchanges captured so far, in the order they were sent.
+func (c *CallCapture) Exchanges() []HTTPExchange {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	exchanges := make([]HTTPExchange, 0, len(c.exchanges))
+	for _, exchange := range c.exchanges {
+		copied := *exchange
+		copied.RequestBody = bytes.Clone(exchange.RequestBody)
+		copied.ResponseBody = bytes.Clone(exchange.ResponseBody)
+		exchanges = append(exchanges, copied)
+	}
+
+	return exchanges
+}
+
+func (c *CallCapture) add(exchange *HTTPExchange) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.exchanges = append(c.exchanges, exchange)
+}
+
+// limit cuts data off at the body limit and reports whether it did.
+func (c *CallCapture) limit(data []byte) ([]byte, bool) {
+	if c.maxBodyBytes > 0 && len(data) > c.maxBodyBytes {
+		return data[:c.maxBodyBytes], true
+	}
+	return data, false
+}
+
+type callCaptureKey struct{}
+
+// WithCallCapture makes all model invocations with the returned context record their HTTP
+// exchanges to capture.
+func WithCallCapture(ctx context.Context, capture *CallCapture) context.Context {
+	return context.WithValue(ctx, callCaptureKey{}, capture)
+}
+
+func callCaptureFrom(ctx context.Context) *CallCapture {
+	capture, _ := ctx.Value(callCaptureKey{}).(*CallCapture)
+	return capture
+}
+
+// CaptureTransport records the requests whose context carries a CallCapture. All other
+// requests are passed through untouched.
+type CaptureTransport struct {
+	base http.RoundTripper
+}
+
+var _ http.RoundTripper = (*CaptureTransport)(nil)
+
+func NewCaptureTransport(base http.RoundTripper) *CaptureTransport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &CaptureTransport{base: base}
+}
+
+func (t *CaptureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	capture := callCaptureFrom(req.Context())
+	if capture == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	exchange := &HTTPExchange{
+		Method:         req.Method,
+		URL:            redactURL(req.URL),
+		RequestHeaders: RedactHeaders(req.Header),
+	}
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		exchange.RequestBody, exchange.Truncated = capture.limit(body)
+	}
+	capture.add(exchange)
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		capture.mu.Lock()
+		exchange.Error = err.Error()
+		exchange.Duration = time.Since(start)
+		capture.mu.Unlock()
+		return nil, err
+	}
+
+	capture.mu.Lock()
+	exchange.StatusCode = resp.StatusCode
+	exchange.ResponseHeaders = RedactHeaders(resp.Header)
+	exchange.Duration = time.Since(start)
+	capture.mu.Unlock()
+
+	resp.Body = &captureBody{
+		ReadCloser: resp.Body,
+		capture:    capture,
+		exchange:   exchange,
+		start:      start,
+	}
+	return resp, nil
+}
+
+// captureBody records the response body while the provider client reads it, so that streamed
+// responses are captured without being buffered up front.
+type captureBody struct {
+	io.ReadCloser
+	capture  *CallCapture
+	exchange *HTTPExchange
+	start    time.Time
+}
+
+func (b *captureBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	b.capture.mu.Lock()
+	if n > 0 {
+		body, truncated := b.capture.limit(append(b.exchange.ResponseBody, p[:n]...))
+		b.exchange.ResponseBody = body
+		b.exchange.Truncated = b.exchange.Truncated || truncated
+	}
+	b.exchange.Duration = time.Since(b.start)
+	b.capture.mu.Unlock()
+
+	return n, err
+}
+
+// RedactHeaders returns a copy of the headers in which all credentials are replaced.
+func RedactHeaders(header http.Header) http.Header {
+	redactedHeader := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := redactedHeader[http.CanonicalHeaderKey(name)]; ok {
+			redactedHeader.Set(name, redacted)
+		}
+	}
+
+	return redactedHeader
+}
+
+// redactURL removes API keys that are passed as query parameters.
+func redactURL(u *url.URL) string {
+	query := u.Query()
+	if !query.Has("key") {
+		return u.String()
+	}
+
+	redactedURL := *u
+	query.Set("key", redacted)
+	redactedURL.RawQuery = query.Encode()
+	return redactedURL.String()
+}
+
+var defaultHTTPClient = &http.Client{Transport: NewCaptureTransport(nil)}
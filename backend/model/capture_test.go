@@ -0,0 +1,131 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCaptureTransport(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = string(body)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "data: {\"error\":\"invalid tool schema\"}\n\n")
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewCaptureTransport(nil)}
+	capture := NewCallCapture(0)
+	ctx := WithCallCapture(context.Background(), capture)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v1/messages?key=secret&alt=sse", strings.NewReader(`{"model":"test"}`))
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	req.Header.Set("X-Api-Key", "secret")
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Anthropic-Version", "2023-06-01")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if received != `{"model":"test"}` {
+		t.Errorf("server received %q, the request body was not passed on", received)
+	}
+
+	exchanges := capture.Exchanges()
+	if len(exchanges) != 1 {
+		t.Fatalf("expected 1 exchange, got %d", len(exchanges))
+	}
+	exchange := exchanges[0]
+
+	if strings.Contains(exchange.URL, "secret") || !strings.Contains(exchange.URL, "alt=sse") {
+		t.Errorf("URL was not redacted correctly: %s", exchange.URL)
+	}
+
+	for _, name := range []string{"X-Api-Key", "Authorization"} {
+		if got := exchange.RequestHeaders.Get(name); got != redacted {
+			t.Errorf("request header %s = %q, want it redacted", name, got)
+		}
+	}
+	if got := exchange.RequestHeaders.Get("Anthropic-Version"); got != "2023-06-01" {
+		t.Errorf("request header Anthropic-Version = %q, want it unchanged", got)
+	}
+	if got := exchange.ResponseHeaders.Get("Set-Cookie"); got != redacted {
+		t.Errorf("response header Set-Cookie = %q, want it redacted", got)
+	}
+
+	if diff := cmp.Diff(`{"model":"test"}`, string(exchange.RequestBody)); diff != "" {
+		t.Errorf("request body mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(string(body), string(exchange.ResponseBody)); diff != "" {
+		t.Errorf("response body mismatch (-want +got):\n%s", diff)
+	}
+	if exchange.StatusCode != http.StatusBadRequest || exchange.Truncated {
+		t.Errorf("unexpected exchange: status %d, truncated %t", exchange.StatusCode, exchange.Truncated)
+	}
+}
+
+func TestCaptureTransport_Truncate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("b", 100))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewCaptureTransport(nil)}
+	capture := NewCallCapture(10)
+	ctx := WithCallCapture(context.Background(), capture)
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(strings.Repeat("a", 50)))
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if len(body) != 100 {
+		t.Errorf("client read %d bytes, the response must not be truncated for the client", len(body))
+	}
+
+	exchange := capture.Exchanges()[0]
+	if len(exchange.RequestBody) != 10 || len(exchange.ResponseBody) != 10 || !exchange.Truncated {
+		t.Errorf("bodies were not truncated: request %d bytes, response %d bytes, truncated %t",
+			len(exchange.RequestBody), len(exchange.ResponseBody), exchange.Truncated)
+	}
+}
+
+func TestCaptureTransport_WithoutCapture(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	capture := NewCallCapture(0)
+	client := &http.Client{Transport: NewCaptureTransport(nil)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if len(capture.Exchanges()) != 0 {
+		t.Errorf("requests without a capture in their context must not be captured")
+	}
+}
@@ -0,0 +1,336 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const CassetteVersion = 1
+
+// Cassette holds model invocations in the order in which they were made, so that they can be
+// served again without calling the provider.
+type Cassette struct {
+	Version      int            `json:"version"`
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded model invocation.
+type Interaction struct {
+	// RequestHash identifies the request by its messages and tools, see RequestHash.
+	RequestHash string `json:"request_hash"`
+	Model       string `json:"model"`
+	// Chunks are the text chunks that the provider streamed, in order.
+	Chunks   []string         `json:"chunks,omitempty"`
+	Response *CassetteMessage `json:"response,omitempty"`
+	Error    *CassetteError   `json:"error,omitempty"`
+}
+
+// CassetteMessage is the serializable form of a Message.
+type CassetteMessage struct {
+	Source  MessageSource   `json:"source"`
+	Content []CassetteBlock `json:"content"`
+	Usage   Usage           `json:"usage"`
+}
+
+// CassetteBlock is the serializable form of a ContentBlock. Only the fields of its type are set.
+type CassetteBlock struct {
+	Type      ContentBlockType `json:"type"`
+	Text      string           `json:"text,omitempty"`
+	ID        string           `json:"id,omitempty"`
+	Tool      string           `json:"tool,omitempty"`
+	Args      json.RawMessage  `json:"args,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Result    string           `json:"result,omitempty"`
+	Succeeded bool             `json:"succeeded,omitempty"`
+}
+
+// CassetteError is the serializable form of an error returned by a provider.
+type CassetteError struct {
+	Provider   string            `json:"provider,omitempty"`
+	Kind       ProviderErrorKind `json:"kind"`
+	Message    string            `json:"message"`
+	RetryAfter time.Duration     `json:"retry_after,omitempty"`
+}
+
+// LoadCassette reads a cassette from disk.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cassette: %w", err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, fmt.Errorf("failed to parse cassette %s: %w", path, err)
+	}
+
+	if cassette.Version != CassetteVersion {
+		return nil, fmt.Errorf("unsupported cassette version %d in %s", cassette.Version, path)
+	}
+
+	return &cassette, nil
+}
+
+// Save writes the cassette to disk. The cassette is written to a temporary file first, so
+// that a crash never leaves a truncated cassette behind.
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode cassette: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create cassette directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write cassette: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// RequestHash returns a stable hash of the messages and tools of a model invocation. The system
+// prompt and the model are left out on purpose, because the system prompt contains details of
+// the environment, like the working directory and the current date, that differ between the
+// recording and the replay.
+func RequestHash(messages []*Message, tools []ToolDefinition) (string, error) {
+	request := struct {
+		Messages []*CassetteMessage `json:"messages"`
+		Tools    []ToolDefinition   `json:"tools"`
+	}{
+		Tools: tools,
+	}
+
+	for _, message := range messages {
+		encoded := encodeCassetteMessage(message)
+		encoded.Usage = Usage{}
+		request.Messages = append(request.Messages, encoded)
+	}
+
+	// Maps are encoded with sorted keys, so the encoding of the tool schemas is stable as well.
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ToolDefinition is the part of a tool that is sent to the model.
+type ToolDefinition struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Schema      map[string]any `json:"schema"`
+}
+
+func toolDefinitions(options *InvokeModelOptions) []ToolDefinition {
+	var definitions []ToolDefinition
+	for _, tool := range options.Tools {
+		definitions = append(definitions, ToolDefinition{
+			Name:        tool.Name(),
+			Description: tool.Description(),
+			Schema:      tool.Schema(),
+		})
+	}
+
+	return definitions
+}
+
+func encodeCassetteMessage(message *Message) *CassetteMessage {
+	encoded := &CassetteMessage{
+		Source:  message.Source,
+		Content: []CassetteBlock{},
+		Usage:   message.Usage,
+	}
+
+	for _, block := range message.Content {
+		switch b := block.(type) {
+		case *TextBlock:
+			encoded.Content = append(encoded.Content, CassetteBlock{Type: ContentBlockTypeText, Text: b.Text})
+		case *ReasoningBlock:
+			encoded.Content = append(encoded.Content, CassetteBlock{Type: ContentBlockTypeReasoning, Text: b.Text})
+		case *ToolCallBlock:
+			encoded.Content = append(encoded.Content, CassetteBlock{Type: ContentBlockTypeToolRequest, ID: b.ID, Tool: b.Tool, Args: compactJSON(b.Args)})
+		case *ToolResultBlock:
+			encoded.Content = append(encoded.Content, CassetteBlock{Type: ContentBlockTypeToolResult, ID: b.ID, Name: b.Name, Result: b.Result, Succeeded: b.Succeeded})
+		}
+	}
+
+	return encoded
+}
+
+func decodeCassetteMessage(message *CassetteMessage) (*Message, error) {
+	var content []ContentBlock
+	for _, block := range message.Content {
+		switch block.Type {
+		case ContentBlockTypeText:
+			content = append(content, &TextBlock{Text: block.Text})
+		case ContentBlockTypeReasoning:
+			content = append(content, &ReasoningBlock{Text: block.Text})
+		case ContentBlockTypeToolRequest:
+			content = append(content, &ToolCallBlock{ID: block.ID, Tool: block.Tool, Args: compactJSON(block.Args)})
+		case ContentBlockTypeToolResult:
+			content = append(content, &ToolResultBlock{ID: block.ID, Name: block.Name, Result: block.Result, Succeeded: block.Succeeded})
+		default:
+			return nil, fmt.Errorf("unknown content block type %q in cassette", block.Type)
+		}
+	}
+
+	return &Message{
+		Source:  message.Source,
+		Content: content,
+		Usage:   message.Usage,
+	}, nil
+}
+
+// compactJSON removes insignificant whitespace, so that tool arguments hash the same no matter
+// how they were formatted by the provider or in the cassette.
+func compactJSON(raw json.RawMessage) json.RawMessage {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return raw
+	}
+	return buf.Bytes()
+}
+
+func encodeCassetteError(err error) *CassetteError {
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		encoded := &CassetteError{
+			Provider:   providerErr.Provider,
+			Kind:       providerErr.Kind,
+			RetryAfter: providerErr.RetryAfter,
+		}
+		if providerErr.Err != nil {
+			encoded.Message = providerErr.Err.Error()
+		}
+		return encoded
+	}
+
+	return &CassetteError{
+		Kind:    ProviderErrorKindUnknown,
+		Message: err.Error(),
+	}
+}
+
+func (e *CassetteError) decode() error {
+	if e.Provider == "" {
+		return errors.New(e.Message)
+	}
+
+	providerErr := NewProviderError(e.Provider, e.Kind, nil)
+	if e.Message != "" {
+		providerErr.Err = errors.New(e.Message)
+	}
+	providerErr.RetryAfter = e.RetryAfter
+	return providerErr
+}
+
+// CassetteRecorder appends interactions to a cassette and persists the cassette after every
+// interaction. It is shared by all recording providers that write to the same cassette.
+type CassetteRecorder struct {
+	path string
+
+	mu       sync.Mutex
+	cassette *Cassette
+}
+
+// NewCassetteRecorder creates a recorder for the cassette at path. Interactions are appended if
+// the cassette already exists.
+func NewCassetteRecorder(path string) (*CassetteRecorder, error) {
+	cassette, err := LoadCassette(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		cassette = &Cassette{Version: CassetteVersion}
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &CassetteRecorder{
+		path:     path,
+		cassette: cassette,
+	}, nil
+}
+
+func (r *CassetteRecorder) Path() string {
+	return r.path
+}
+
+func (r *CassetteRecorder) record(interaction *Interaction) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	return r.cassette.Save(r.path)
+}
+
+// RecordingProvider passes all invocations to the wrapped provider and records them, including
+// the streamed chunks and errors, to a cassette.
+type RecordingProvider struct {
+	provider ModelProvider
+	recorder *CassetteRecorder
+}
+
+var _ ModelProvider = (*RecordingProvider)(nil)
+
+func NewRecordingProvider(provider ModelProvider, recorder *CassetteRecorder) *RecordingProvider {
+	return &RecordingProvider{
+		provider: provider,
+		recorder: recorder,
+	}
+}
+
+func (p *RecordingProvider) InvokeModel(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	options := &InvokeModelOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	hash, err := RequestHash(messages, toolDefinitions(options))
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := &Interaction{
+		RequestHash: hash,
+		Model:       model,
+	}
+
+	var chunksMu sync.Mutex
+	streamCallback := options.StreamCallback
+	opts = append(opts, WithStreamHandler(func(ctx context.Context, chunk string) {
+		chunksMu.Lock()
+		interaction.Chunks = append(interaction.Chunks, chunk)
+		chunksMu.Unlock()
+
+		if streamCallback != nil {
+			streamCallback(ctx, chunk)
+		}
+	}))
+
+	message, invokeErr := p.provider.InvokeModel(ctx, model, systemPrompt, messages, opts...)
+	if invokeErr != nil {
+		interaction.Error = encodeCassetteError(invokeErr)
+	} else {
+		interaction.Response = encodeCassetteMessage(message)
+	}
+
+	if err := p.recorder.record(interaction); err != nil {
+		slog.Error("failed to record model invocation", "cassette", p.recorder.Path(), "error", err)
+	}
+
+	return message, invokeErr
+}
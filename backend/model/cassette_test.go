@@ -0,0 +1,184 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "session.json")
+
+	firstTurn := []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix the bug"}}},
+	}
+	firstResponse := NewModelMessage([]ContentBlock{
+		&ReasoningBlock{Text: "The bug is in main.go"},
+		&TextBlock{Text: "Reading the file."},
+		&ToolCallBlock{ID: "call_1", Tool: "read_file", Args: json.RawMessage(`{"path":"main.go"}`)},
+	}, Usage{InputTokens: 100, OutputTokens: 20, CacheReadTokens: 10})
+
+	secondTurn := append(firstTurn, firstResponse, &Message{
+		Source:  MessageSourceSystem,
+		Content: []ContentBlock{&ToolResultBlock{ID: "call_1", Name: "read_file", Result: "package main", Succeeded: true}},
+	})
+	rateLimited := &ProviderError{Provider: "anthropic", Kind: ProviderErrorKindRateLimitExceeded, RetryAfter: 30 * time.Second, Err: errors.New("too many requests")}
+
+	recorder, err := NewCassetteRecorder(cassettePath)
+	if err != nil {
+		t.Fatalf("NewCassetteRecorder() error = %v", err)
+	}
+
+	live := &scriptedProvider{
+		chunks:    [][]string{{"Reading ", "the file."}, nil},
+		responses: []*Message{firstResponse, nil},
+		errors:    []error{nil, rateLimited},
+	}
+	recording := NewRecordingProvider(live, recorder)
+
+	var recordedChunks []string
+	_, err = recording.InvokeModel(context.Background(), "claude-sonnet-4-5", "recorded system prompt", firstTurn, WithStreamHandler(func(ctx context.Context, chunk string) {
+		recordedChunks = append(recordedChunks, chunk)
+	}))
+	if err != nil {
+		t.Fatalf("recording InvokeModel() error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"Reading ", "the file."}, recordedChunks); diff != "" {
+		t.Errorf("recording did not pass chunks through (-want +got):\n%s", diff)
+	}
+
+	if _, err := recording.InvokeModel(context.Background(), "claude-sonnet-4-5", "recorded system prompt", secondTurn); !errors.Is(err, rateLimited) {
+		t.Fatalf("recording InvokeModel() error = %v, want %v", err, rateLimited)
+	}
+
+	replay, err := NewReplayProvider(cassettePath)
+	if err != nil {
+		t.Fatalf("NewReplayProvider() error = %v", err)
+	}
+
+	var replayedChunks []string
+	message, err := replay.InvokeModel(context.Background(), "other-model", "replayed system prompt", firstTurn, WithStreamHandler(func(ctx context.Context, chunk string) {
+		replayedChunks = append(replayedChunks, chunk)
+	}))
+	if err != nil {
+		t.Fatalf("replay InvokeModel() error = %v", err)
+	}
+
+	if diff := cmp.Diff(firstResponse, message); diff != "" {
+		t.Errorf("replayed message mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(recordedChunks, replayedChunks); diff != "" {
+		t.Errorf("replayed chunks mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = replay.InvokeModel(context.Background(), "claude-sonnet-4-5", "recorded system prompt", secondTurn)
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) {
+		t.Fatalf("expected a provider error, got %v", err)
+	}
+	if diff := cmp.Diff(rateLimited.Error(), providerErr.Error()); diff != "" {
+		t.Errorf("replayed error mismatch (-want +got):\n%s", diff)
+	}
+	if providerErr.RetryAfter != rateLimited.RetryAfter {
+		t.Errorf("retry after = %s, want %s", providerErr.RetryAfter, rateLimited.RetryAfter)
+	}
+
+	_, err = replay.InvokeModel(context.Background(), "claude-sonnet-4-5", "recorded system prompt", secondTurn)
+	if err == nil || !strings.Contains(err.Error(), "has no interaction left for request 3") {
+		t.Errorf("expected exhausted cassette error, got %v", err)
+	}
+}
+
+func TestReplayProvider_Mismatch(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "session.json")
+
+	recorder, err := NewCassetteRecorder(cassettePath)
+	if err != nil {
+		t.Fatalf("NewCassetteRecorder() error = %v", err)
+	}
+
+	recording := NewRecordingProvider(&scriptedProvider{
+		responses: []*Message{NewModelMessage([]ContentBlock{&TextBlock{Text: "Done."}}, Usage{})},
+		errors:    []error{nil},
+		chunks:    [][]string{nil},
+	}, recorder)
+
+	_, err = recording.InvokeModel(context.Background(), "claude-sonnet-4-5", "system prompt", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix the bug"}}},
+	})
+	if err != nil {
+		t.Fatalf("recording InvokeModel() error = %v", err)
+	}
+
+	replay, err := NewReplayProvider(cassettePath)
+	if err != nil {
+		t.Fatalf("NewReplayProvider() error = %v", err)
+	}
+
+	_, err = replay.InvokeModel(context.Background(), "claude-sonnet-4-5", "system prompt", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix another bug"}}},
+	})
+
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) || providerErr.Kind != ProviderErrorKindInvalidRequest {
+		t.Fatalf("expected an invalid request error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "request 1 does not match cassette") {
+		t.Errorf("error does not explain the mismatch: %v", err)
+	}
+}
+
+func TestCassette_Models(t *testing.T) {
+	cassette := &Cassette{
+		Version: CassetteVersion,
+		Interactions: []*Interaction{
+			{Model: AnthropicDefaultModel},
+			{Model: "unknown-model"},
+			{Model: AnthropicDefaultModel},
+		},
+	}
+
+	models := cassette.Models()
+	if len(models) != 2 {
+		t.Fatalf("expected 2 models, got %d", len(models))
+	}
+
+	if models[0].Name != AnthropicDefaultModel || models[0].Provider != ProviderKindReplay {
+		t.Errorf("unexpected model: %+v", models[0])
+	}
+
+	if models[1].Name != "unknown-model" || models[1].Provider != ProviderKindReplay {
+		t.Errorf("unexpected model: %+v", models[1])
+	}
+}
+
+type scriptedProvider struct {
+	chunks    [][]string
+	responses []*Message
+	errors    []error
+	calls     int
+}
+
+func (p *scriptedProvider) InvokeModel(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	options := &InvokeModelOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	call := p.calls
+	p.calls++
+
+	for _, chunk := range p.chunks[call] {
+		if options.StreamCallback != nil {
+			options.StreamCallback(ctx, chunk)
+		}
+	}
+
+	return p.responses[call], p.errors[call]
+}
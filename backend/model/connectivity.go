@@ -0,0 +1,88 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/furisto/construct/shared/resilience"
+)
+
+// ModelLister is implemented by providers that can list the models which are available to
+// their credentials.
+type ModelLister interface {
+	ListModels(ctx context.Context) ([]string, error)
+}
+
+type AuthStatus string
+
+const (
+	AuthStatusAuthenticated   AuthStatus = "authenticated"
+	AuthStatusUnauthenticated AuthStatus = "unauthenticated"
+	// AuthStatusUnknown is reported if the provider could not be reached.
+	AuthStatusUnknown AuthStatus = "unknown"
+)
+
+// ConnectivityReport is the outcome of a minimal authenticated call against a model provider.
+type ConnectivityReport struct {
+	Latency    time.Duration
+	AuthStatus AuthStatus
+	Err        error
+	// ModelsListed is true if the provider returned the models available to the credentials.
+	// Providers that cannot list their models are probed with a completion instead.
+	ModelsListed    bool
+	AvailableModels []string
+	CircuitState    resilience.CircuitState
+}
+
+// CheckConnectivity makes the cheapest authenticated call the provider supports. Providers
+// that implement ModelLister list their models, all others are asked to complete a single
+// message with the probe model.
+func CheckConnectivity(ctx context.Context, provider ModelProvider, probeModel string) *ConnectivityReport {
+	report := &ConnectivityReport{}
+	start := time.Now()
+
+	var err error
+	if lister, ok := provider.(ModelLister); ok {
+		report.AvailableModels, err = lister.ListModels(ctx)
+		report.ModelsListed = err == nil
+		slices.Sort(report.AvailableModels)
+	} else {
+		_, err = provider.InvokeModel(ctx, probeModel, "Reply with a single word.", []*Message{
+			{
+				Source:  MessageSourceUser,
+				Content: []ContentBlock{&TextBlock{Text: "ping"}},
+			},
+		}, WithRetryCallback(func(context.Context, error, time.Duration) {}))
+	}
+
+	report.Latency = time.Since(start)
+	report.Err = err
+	report.AuthStatus = authStatusFromError(err)
+
+	return report
+}
+
+func authStatusFromError(err error) AuthStatus {
+	if err == nil {
+		return AuthStatusAuthenticated
+	}
+
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) {
+		return AuthStatusUnknown
+	}
+
+	switch providerErr.Kind {
+	case ProviderErrorKindAuthentication:
+		return AuthStatusUnauthenticated
+	case ProviderErrorKindInvalidRequest,
+		ProviderErrorKindRateLimitExceeded,
+		ProviderErrorKindOverloaded:
+		// The provider only answers like this after it accepted the credentials.
+		return AuthStatusAuthenticated
+	default:
+		return AuthStatusUnknown
+	}
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckConnectivity(t *testing.T) {
+	tests := []struct {
+		name            string
+		provider        func(t *testing.T, url string) ModelProvider
+		status          int
+		body            string
+		authStatus      AuthStatus
+		modelsListed    bool
+		availableModels []string
+	}{
+		{
+			name:            "anthropic lists models",
+			provider:        newTestAnthropicProvider,
+			status:          http.StatusOK,
+			body:            `{"data":[{"id":"claude-sonnet-4-20250514","type":"model","display_name":"Claude Sonnet 4","created_at":"2025-05-14T00:00:00Z"},{"id":"claude-3-5-haiku-20241022","type":"model","display_name":"Claude Haiku 3.5","created_at":"2024-10-22T00:00:00Z"}],"has_more":false}`,
+			authStatus:      AuthStatusAuthenticated,
+			modelsListed:    true,
+			availableModels: []string{"claude-3-5-haiku-20241022", "claude-sonnet-4-20250514"},
+		},
+		{
+			name:       "anthropic rejects api key",
+			provider:   newTestAnthropicProvider,
+			status:     http.StatusUnauthorized,
+			body:       `{"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`,
+			authStatus: AuthStatusUnauthenticated,
+		},
+		{
+			name:            "openai lists models",
+			provider:        newTestOpenAIProvider,
+			status:          http.StatusOK,
+			body:            `{"object":"list","data":[{"id":"gpt-4.1","object":"model","created":0,"owned_by":"openai"}]}`,
+			authStatus:      AuthStatusAuthenticated,
+			modelsListed:    true,
+			availableModels: []string{"gpt-4.1"},
+		},
+		{
+			name:       "openai rejects api key",
+			provider:   newTestOpenAIProvider,
+			status:     http.StatusUnauthorized,
+			body:       `{"error":{"message":"Incorrect API key provided","type":"invalid_request_error","code":"invalid_api_key"}}`,
+			authStatus: AuthStatusUnauthenticated,
+		},
+		{
+			name:            "deepseek lists models",
+			provider:        newTestDeepSeekConnectivityProvider,
+			status:          http.StatusOK,
+			body:            `{"object":"list","data":[{"id":"deepseek-chat","object":"model","owned_by":"deepseek"},{"id":"deepseek-reasoner","object":"model","owned_by":"deepseek"}]}`,
+			authStatus:      AuthStatusAuthenticated,
+			modelsListed:    true,
+			availableModels: []string{"deepseek-chat", "deepseek-reasoner"},
+		},
+		{
+			name: "provider without model listing is probed with a completion",
+			provider: func(t *testing.T, url string) ModelProvider {
+				return &probeProvider{}
+			},
+			authStatus: AuthStatusAuthenticated,
+		},
+		{
+			name: "unreachable provider",
+			provider: func(t *testing.T, url string) ModelProvider {
+				return &probeProvider{err: fmt.Errorf("connection refused")}
+			},
+			authStatus: AuthStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			report := CheckConnectivity(context.Background(), tt.provider(t, server.URL), "probe-model")
+
+			if report.AuthStatus != tt.authStatus {
+				t.Errorf("auth status = %s, want %s (error: %v)", report.AuthStatus, tt.authStatus, report.Err)
+			}
+
+			if report.ModelsListed != tt.modelsListed {
+				t.Errorf("models listed = %t, want %t", report.ModelsListed, tt.modelsListed)
+			}
+
+			if diff := cmp.Diff(tt.availableModels, report.AvailableModels); diff != "" {
+				t.Errorf("available models mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func newTestAnthropicProvider(t *testing.T, url string) ModelProvider {
+	provider, err := NewAnthropicProvider("test-key", WithURL(url))
+	if err != nil {
+		t.Fatalf("failed to create anthropic provider: %v", err)
+	}
+	return provider
+}
+
+func newTestOpenAIProvider(t *testing.T, url string) ModelProvider {
+	provider, err := NewOpenAICompletionProvider("test-key", WithURL(url))
+	if err != nil {
+		t.Fatalf("failed to create openai provider: %v", err)
+	}
+	return provider
+}
+
+func newTestDeepSeekConnectivityProvider(t *testing.T, url string) ModelProvider {
+	return newTestDeepSeekProvider(t, url)
+}
+
+type probeProvider struct {
+	err error
+}
+
+func (p *probeProvider) InvokeModel(ctx context.Context, model, prompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return NewModelMessage([]ContentBlock{&TextBlock{Text: "pong"}}, Usage{}), nil
+}
@@ -0,0 +1,265 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/furisto/construct/shared/resilience"
+	"github.com/google/uuid"
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+)
+
+const (
+	DeepSeekDefaultModel   = "deepseek-chat"
+	DeepSeekReasonerModel  = "deepseek-reasoner"
+	deepSeekDefaultBaseURL = "https://api.deepseek.com"
+)
+
+func SupportedDeepSeekModels() []Model {
+	return []Model{
+		{
+			ID:       uuid.MustParse("019ac0de-0001-7000-8000-000000000001"),
+			Name:     DeepSeekDefaultModel,
+			Provider: ProviderKindDeepSeek,
+		},
+		{
+			ID:       uuid.MustParse("019ac0de-0002-7000-8000-000000000002"),
+			Name:     DeepSeekReasonerModel,
+			Provider: ProviderKindDeepSeek,
+		},
+	}
+}
+
+// DeepSeekProvider talks to the OpenAI compatible API of DeepSeek. On top of the OpenAI API,
+// DeepSeek returns the reasoning of its thinking models as reasoning_content and reports how many
+// prompt tokens were served from its context cache.
+type DeepSeekProvider struct {
+	*OpenAICompletionProvider
+	circuitBreaker *resilience.CircuitBreaker
+}
+
+var _ ModelProvider = (*DeepSeekProvider)(nil)
+var _ ModelLister = (*DeepSeekProvider)(nil)
+
+func NewDeepSeekProvider(apiKey string, opts ...ProviderOption) (*DeepSeekProvider, error) {
+	logger := slog.With("component", "deepseek_provider")
+
+	if apiKey == "" {
+		logger.Error("deepseek API key is required")
+		return nil, fmt.Errorf("deepseek API key is required")
+	}
+	logger.Debug("initializing DeepSeek provider")
+
+	providerOptions := DefaultProviderOptions("deepseek")
+	for _, opt := range opts {
+		opt(providerOptions)
+	}
+
+	url := deepSeekDefaultBaseURL
+	if providerOptions.URL != "" {
+		logger.Debug("using custom DeepSeek URL",
+			"url", providerOptions.URL,
+		)
+		url = providerOptions.URL
+	}
+
+	logger.Info("DeepSeek provider initialized successfully")
+
+	return &DeepSeekProvider{
+		OpenAICompletionProvider: &OpenAICompletionProvider{
+			name:   "deepseek",
+			client: openai.NewClient(option.WithAPIKey(apiKey), option.WithBaseURL(url), option.WithHTTPClient(providerOptions.HTTPClient)),
+		},
+		circuitBreaker: providerOptions.CircuitBreaker,
+	}, nil
+}
+
+// deepSeekUsage holds the DeepSeek specific usage fields. Cache hits and misses add up to the
+// prompt tokens.
+type deepSeekUsage struct {
+	PromptTokens            int64 `json:"prompt_tokens"`
+	CompletionTokens        int64 `json:"completion_tokens"`
+	PromptCacheHitTokens    int64 `json:"prompt_cache_hit_tokens"`
+	PromptCacheMissTokens   int64 `json:"prompt_cache_miss_tokens"`
+	CompletionTokensDetails struct {
+		ReasoningTokens int64 `json:"reasoning_tokens"`
+	} `json:"completion_tokens_details"`
+}
+
+func (p *DeepSeekProvider) InvokeModel(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	logger := slog.With(
+		"component", "deepseek_provider",
+		"model", model,
+		"message_count", len(messages),
+	)
+
+	if err := p.validateInput(model, systemPrompt, messages); err != nil {
+		logger.Error("validation failed", "error", err)
+		return nil, err
+	}
+
+	options := DefaultOpenAIModelOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	modelProfile, err := ensureModelProfile[*OpenAIModelProfile](options.ModelProfile)
+	if err != nil {
+		logger.Error("failed to ensure model profile", "error", err)
+		return nil, err
+	}
+
+	deepSeekMessages, err := p.transformDeepSeekMessages(systemPrompt, messages)
+	if err != nil {
+		logger.Error("failed to transform messages", "error", err)
+		return nil, err
+	}
+
+	request := openai.ChatCompletionNewParams{
+		Model:     model,
+		MaxTokens: openai.Int(modelProfile.MaxTokens),
+		Messages:  deepSeekMessages,
+		StreamOptions: openai.ChatCompletionStreamOptionsParam{
+			IncludeUsage: openai.Bool(true),
+		},
+	}
+
+	if tools := p.transformTools(options.Tools); len(tools) > 0 && modelProfile.EnableFunctionCalling {
+		request.Tools = tools
+		request.ToolChoice = openai.ChatCompletionToolChoiceOptionUnionParam{
+			OfAuto: openai.String("auto"),
+		}
+	}
+
+	if !p.circuitBreaker.Allow() {
+		logger.Error("circuit breaker open - too many errors")
+		return nil, NewProviderError("deepseek", ProviderErrorKindCircuitOpen, fmt.Errorf("too many errors from deepseek provider"))
+	}
+
+	invokeStart := time.Now()
+	logger.Debug("invoking DeepSeek API")
+
+	stream := p.client.Chat.Completions.NewStreaming(ctx, request)
+	defer stream.Close()
+
+	var (
+		accumulator openai.ChatCompletionAccumulator
+		reasoning   strings.Builder
+		usage       deepSeekUsage
+	)
+	for stream.Next() {
+		chunk := stream.Current()
+		accumulator.AddChunk(chunk)
+
+		for _, choice := range chunk.Choices {
+			if field, ok := choice.Delta.JSON.ExtraFields["reasoning_content"]; ok {
+				var text string
+				if err := json.Unmarshal([]byte(field.Raw()), &text); err == nil {
+					reasoning.WriteString(text)
+				}
+			}
+
+			if choice.Delta.Content != "" && options.StreamCallback != nil {
+				options.StreamCallback(ctx, choice.Delta.Content)
+			}
+		}
+
+		if chunk.JSON.Usage.Valid() {
+			if err := json.Unmarshal([]byte(chunk.Usage.RawJSON()), &usage); err != nil {
+				logger.Warn("failed to decode deepseek usage", "error", err)
+			}
+		}
+	}
+
+	if err := stream.Err(); err != nil {
+		logger.Error("deepseek stream error",
+			"error", err,
+			"duration_ms", time.Since(invokeStart).Milliseconds(),
+		)
+		p.circuitBreaker.RecordResult(err)
+		return nil, p.mapError(err)
+	}
+	p.circuitBreaker.RecordResult(nil)
+
+	var content []ContentBlock
+	if reasoning.Len() > 0 {
+		content = append(content, &ReasoningBlock{Text: reasoning.String()})
+	}
+	for _, choice := range accumulator.Choices {
+		if choice.Message.Content != "" {
+			content = append(content, &TextBlock{Text: choice.Message.Content})
+		}
+		for _, toolCall := range choice.Message.ToolCalls {
+			content = append(content, &ToolCallBlock{ID: toolCall.ID, Tool: toolCall.Function.Name, Args: json.RawMessage(toolCall.Function.Arguments)})
+		}
+	}
+
+	inputTokens := usage.PromptCacheMissTokens
+	if inputTokens == 0 && usage.PromptCacheHitTokens == 0 {
+		inputTokens = usage.PromptTokens
+	}
+
+	cacheHitRatio := 0.0
+	if usage.PromptTokens > 0 {
+		cacheHitRatio = float64(usage.PromptCacheHitTokens) / float64(usage.PromptTokens)
+	}
+
+	logger.Info("deepseek invocation successful",
+		"input_tokens", inputTokens,
+		"output_tokens", usage.CompletionTokens,
+		"reasoning_tokens", usage.CompletionTokensDetails.ReasoningTokens,
+		"cache_read_tokens", usage.PromptCacheHitTokens,
+		"cache_hit_ratio", fmt.Sprintf("%.1f%%", cacheHitRatio*100),
+		"duration_ms", time.Since(invokeStart).Milliseconds(),
+	)
+
+	return NewModelMessage(content, Usage{
+		InputTokens:     inputTokens,
+		OutputTokens:    usage.CompletionTokens,
+		CacheReadTokens: usage.PromptCacheHitTokens,
+	}), nil
+}
+
+// transformDeepSeekMessages converts the messages like the OpenAI provider does. The reasoning of
+// the model is only sent back for the current turn, that is after the last user message. DeepSeek
+// needs it to continue reasoning across tool calls and ignores it for earlier turns.
+func (p *DeepSeekProvider) transformDeepSeekMessages(systemPrompt string, messages []*Message) ([]openai.ChatCompletionMessageParamUnion, error) {
+	turnStart := 0
+	for i, message := range messages {
+		if message.Source == MessageSourceUser {
+			turnStart = i
+		}
+	}
+
+	deepSeekMessages := []openai.ChatCompletionMessageParamUnion{openai.SystemMessage(systemPrompt)}
+	for i, message := range messages {
+		transformed, err := p.transformMessages([]*Message{message})
+		if err != nil {
+			return nil, err
+		}
+
+		if message.Source == MessageSourceModel && i > turnStart && len(transformed) == 1 && transformed[0].OfAssistant != nil {
+			var reasoning strings.Builder
+			for _, block := range message.Content {
+				if b, ok := block.(*ReasoningBlock); ok {
+					reasoning.WriteString(b.Text)
+				}
+			}
+
+			if reasoning.Len() > 0 {
+				transformed[0].OfAssistant.SetExtraFields(map[string]any{
+					"reasoning_content": reasoning.String(),
+				})
+			}
+		}
+
+		deepSeekMessages = append(deepSeekMessages, transformed...)
+	}
+
+	return deepSeekMessages, nil
+}
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDeepSeekProvider_InvokeModel(t *testing.T) {
+	var request map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		chunks := []string{
+			`{"id":"1","object":"chat.completion.chunk","created":0,"model":"deepseek-reasoner","choices":[{"index":0,"delta":{"role":"assistant","content":null,"reasoning_content":"The bug is "}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","created":0,"model":"deepseek-reasoner","choices":[{"index":0,"delta":{"content":null,"reasoning_content":"in main.go"}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","created":0,"model":"deepseek-reasoner","choices":[{"index":0,"delta":{"content":"Reading the file."}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","created":0,"model":"deepseek-reasoner","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"read_file","arguments":"{\"path\":\"main.go\"}"}}]}}]}`,
+			`{"id":"1","object":"chat.completion.chunk","created":0,"model":"deepseek-reasoner","choices":[{"index":0,"delta":{},"finish_reason":"tool_calls"}]}`,
+			`{"id":"1","object":"chat.completion.chunk","created":0,"model":"deepseek-reasoner","choices":[],"usage":{"prompt_tokens":100,"completion_tokens":40,"total_tokens":140,"prompt_cache_hit_tokens":64,"prompt_cache_miss_tokens":36,"completion_tokens_details":{"reasoning_tokens":20}}}`,
+		}
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: %s\n\n", chunk)
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer server.Close()
+
+	provider := newTestDeepSeekProvider(t, server.URL)
+
+	var streamed string
+	message, err := provider.InvokeModel(context.Background(), DeepSeekReasonerModel, "You are a coding agent", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix the bug"}}},
+	}, WithStreamHandler(func(ctx context.Context, chunk string) {
+		streamed += chunk
+	}))
+	if err != nil {
+		t.Fatalf("InvokeModel() error = %v", err)
+	}
+
+	if request["model"] != DeepSeekReasonerModel {
+		t.Errorf("model = %v, want %s", request["model"], DeepSeekReasonerModel)
+	}
+
+	expected := NewModelMessage([]ContentBlock{
+		&ReasoningBlock{Text: "The bug is in main.go"},
+		&TextBlock{Text: "Reading the file."},
+		&ToolCallBlock{ID: "call_1", Tool: "read_file", Args: json.RawMessage(`{"path":"main.go"}`)},
+	}, Usage{
+		InputTokens:     36,
+		OutputTokens:    40,
+		CacheReadTokens: 64,
+	})
+	if diff := cmp.Diff(expected, message); diff != "" {
+		t.Errorf("message mismatch (-want +got):\n%s", diff)
+	}
+
+	if streamed != "Reading the file." {
+		t.Errorf("streamed = %q, want %q", streamed, "Reading the file.")
+	}
+}
+
+func TestDeepSeekProvider_TransformMessages(t *testing.T) {
+	provider := newTestDeepSeekProvider(t, "http://localhost")
+
+	messages, err := provider.transformDeepSeekMessages("You are a coding agent", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix the bug"}}},
+		NewModelMessage([]ContentBlock{&ReasoningBlock{Text: "earlier turn"}, &TextBlock{Text: "Done."}}, Usage{}),
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Also add a test"}}},
+		NewModelMessage([]ContentBlock{
+			&ReasoningBlock{Text: "current turn"},
+			&ToolCallBlock{ID: "call_1", Tool: "read_file", Args: json.RawMessage(`{"path":"main.go"}`)},
+		}, Usage{}),
+		{Source: MessageSourceSystem, Content: []ContentBlock{&ToolResultBlock{ID: "call_1", Name: "read_file", Result: "package main", Succeeded: true}}},
+	})
+	if err != nil {
+		t.Fatalf("transformDeepSeekMessages() error = %v", err)
+	}
+
+	raw, err := json.Marshal(messages)
+	if err != nil {
+		t.Fatalf("failed to marshal messages: %v", err)
+	}
+
+	var decoded []map[string]any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("failed to unmarshal messages: %v", err)
+	}
+
+	var roles []string
+	var reasoning []any
+	for _, message := range decoded {
+		roles = append(roles, message["role"].(string))
+		if message["role"] == "assistant" {
+			reasoning = append(reasoning, message["reasoning_content"])
+		}
+	}
+
+	if diff := cmp.Diff([]string{"system", "user", "assistant", "user", "assistant", "tool"}, roles); diff != "" {
+		t.Errorf("roles mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]any{nil, "current turn"}, reasoning); diff != "" {
+		t.Errorf("reasoning mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeepSeekProvider_MapError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"error":{"message":"Authentication Fails, Your api key is invalid","type":"authentication_error","code":"invalid_request_error"}}`)
+	}))
+	defer server.Close()
+
+	provider := newTestDeepSeekProvider(t, server.URL)
+	_, err := provider.InvokeModel(context.Background(), DeepSeekDefaultModel, "You are a coding agent", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Hello"}}},
+	})
+
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) {
+		t.Fatalf("expected a provider error, got %v", err)
+	}
+
+	if providerErr.Kind != ProviderErrorKindAuthentication || providerErr.Provider != "deepseek" {
+		t.Errorf("error = %s/%s, want deepseek/%s", providerErr.Provider, providerErr.Kind, ProviderErrorKindAuthentication)
+	}
+}
+
+func newTestDeepSeekProvider(t *testing.T, url string) *DeepSeekProvider {
+	provider, err := NewDeepSeekProvider("test-key", WithURL(url))
+	if err != nil {
+		t.Fatalf("failed to create deepseek provider: %v", err)
+	}
+	return provider
+}
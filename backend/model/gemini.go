@@ -3,8 +3,10 @@ package model
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/furisto/construct/backend/tool/native"
@@ -16,6 +18,9 @@ type GeminiProvider struct {
 	client *genai.Client
 }
 
+var _ ModelLister = (*GeminiProvider)(nil)
+var _ TokenCounter = (*GeminiProvider)(nil)
+
 type GeminiModelProfile struct {
 	// API configuration
 	APIKey     string `json:"api_key,omitempty"`
@@ -51,7 +56,7 @@ func (g *GeminiModelProfile) Validate() error {
 	return nil
 }
 
-func NewGeminiProvider(apiKey string) (*GeminiProvider, error) {
+func NewGeminiProvider(apiKey string, opts ...ProviderOption) (*GeminiProvider, error) {
 	logger := slog.With("component", "gemini_provider")
 
 	if apiKey == "" {
@@ -60,8 +65,14 @@ func NewGeminiProvider(apiKey string) (*GeminiProvider, error) {
 	}
 	logger.Debug("initializing Gemini provider")
 
+	providerOptions := DefaultProviderOptions("gemini")
+	for _, opt := range opts {
+		opt(providerOptions)
+	}
+
 	client, err := genai.NewClient(context.Background(), &genai.ClientConfig{
-		APIKey: apiKey,
+		APIKey:     apiKey,
+		HTTPClient: providerOptions.HTTPClient,
 	})
 	if err != nil {
 		logger.Error("failed to create gemini client", "error", err)
@@ -191,6 +202,77 @@ func (p *GeminiProvider) InvokeModel(ctx context.Context, model, systemPrompt st
 	}), nil
 }
 
+func (p *GeminiProvider) ListModels(ctx context.Context) ([]string, error) {
+	var models []string
+	for m, err := range p.client.Models.All(ctx) {
+		if err != nil {
+			return nil, p.mapError(err)
+		}
+		models = append(models, strings.TrimPrefix(m.Name, "models/"))
+	}
+
+	return models, nil
+}
+
+// CountTokens counts the input tokens of a prompt. The Gemini API only counts the tokens of the
+// conversation, so the system prompt and the tool definitions are approximated.
+func (p *GeminiProvider) CountTokens(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (int64, error) {
+	if err := p.validateInput(model, systemPrompt, messages); err != nil {
+		return 0, err
+	}
+
+	options := defaultGeminiInvokeOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	history, currentMsg, err := p.transformMessages(messages)
+	if err != nil {
+		return 0, err
+	}
+
+	contents := make([]*genai.Content, 0, len(history)+1)
+	contents = append(contents, history...)
+	if len(currentMsg) > 0 {
+		contents = append(contents, genai.NewContentFromParts(currentMsg, genai.RoleUser))
+	}
+
+	count, err := p.client.Models.CountTokens(ctx, model, contents, nil)
+	if err != nil {
+		return 0, p.mapError(err)
+	}
+
+	return int64(count.TotalTokens) + ApproximateTokens(systemPrompt, nil, options.Tools), nil
+}
+
+func (p *GeminiProvider) mapError(err error) error {
+	var apiErr genai.APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	var kind ProviderErrorKind
+	switch {
+	case apiErr.Code == 401, apiErr.Code == 403:
+		kind = ProviderErrorKindAuthentication
+	case apiErr.Code == 400 && strings.Contains(apiErr.Message, "API key"):
+		// Gemini rejects invalid API keys as bad requests.
+		kind = ProviderErrorKindAuthentication
+	case apiErr.Code == 429:
+		kind = ProviderErrorKindRateLimitExceeded
+	case apiErr.Code == 503:
+		kind = ProviderErrorKindOverloaded
+	case apiErr.Code >= 500 && apiErr.Code < 600:
+		kind = ProviderErrorKindInternal
+	case apiErr.Code >= 400:
+		kind = ProviderErrorKindInvalidRequest
+	default:
+		kind = ProviderErrorKindUnknown
+	}
+
+	return NewProviderError("gemini", kind, err)
+}
+
 func (p *GeminiProvider) validateInput(model, systemPrompt string, messages []*Message) error {
 	if model == "" {
 		return fmt.Errorf("model is required")
@@ -431,49 +513,16 @@ func SupportedGeminiModels() []Model {
 			ID:       uuid.MustParse("01970000-0001-7000-8000-000000000001"),
 			Name:     "gemini-2.5-pro",
 			Provider: ProviderKindGemini,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityAudio,
-			},
-			ContextWindow: 1048576,
-			Pricing: ModelPricing{
-				Input:      1.125,
-				Output:     10.0,
-				CacheWrite: 0.0,
-				CacheRead:  0.0,
-			},
 		},
 		{
 			ID:       uuid.MustParse("01970000-0002-7000-8000-000000000002"),
 			Name:     "gemini-2.5-flash",
 			Provider: ProviderKindGemini,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityAudio,
-			},
-			ContextWindow: 1048576,
-			Pricing: ModelPricing{
-				Input:      1.25,
-				Output:     5.0,
-				CacheWrite: 0.0,
-				CacheRead:  0.0,
-			},
 		},
 		{
 			ID:       uuid.MustParse("01970000-0003-7000-8000-000000000003"),
 			Name:     "gemini-2.5-flash-lite",
 			Provider: ProviderKindGemini,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityAudio,
-			},
-			ContextWindow: 1000000,
-			Pricing: ModelPricing{
-				Input:      0.075,
-				Output:     0.3,
-				CacheWrite: 0.0,
-				CacheRead:  0.0,
-			},
 		},
 	}
 }
@@ -0,0 +1,291 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	MockModel             = "mock"
+	mockDefaultChunkDelay = 15 * time.Millisecond
+)
+
+func SupportedMockModels() []Model {
+	return []Model{
+		{
+			ID:       uuid.MustParse("019ac0ff-0001-7000-8000-000000000001"),
+			Name:     MockModel,
+			Provider: ProviderKindMock,
+		},
+	}
+}
+
+// MockScript decides how the mock provider answers. Rules are checked first, in order, against
+// the last user message. If no rule matches, the turns are served in the order of the
+// conversation.
+//
+//	chunk_delay: 20ms
+//	rules:
+//	  - match: "(?i)hello"
+//	    turns:
+//	      - text: Hi, what can I do for you?
+//	turns:
+//	  - text: Let me look at the project.
+//	    script: print(list_files("."))
+//	  - text: The project is a Go module.
+//
+// A turn with an error fails the invocation with a provider error of that kind, for example
+// "overloaded" or "rate_limit_exceeded".
+type MockScript struct {
+	// ChunkDelay is the pause between two streamed chunks.
+	ChunkDelay *time.Duration `yaml:"chunk_delay"`
+	Rules      []MockRule     `yaml:"rules"`
+	Turns      []MockTurn     `yaml:"turns"`
+}
+
+// MockRule answers user messages that match its pattern. The first turn answers the user
+// message, every following turn answers the tool results of the turn before.
+type MockRule struct {
+	Match string     `yaml:"match"`
+	Turns []MockTurn `yaml:"turns"`
+
+	pattern *regexp.Regexp
+}
+
+// MockTurn is a single assistant message.
+type MockTurn struct {
+	Text string `yaml:"text"`
+	// Script is run with the code interpreter.
+	Script string `yaml:"script"`
+	// Error fails the invocation with a provider error of this kind instead of answering.
+	Error ProviderErrorKind `yaml:"error"`
+}
+
+// LoadMockScript reads and validates a mock script.
+func LoadMockScript(path string) (*MockScript, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mock script: %w", err)
+	}
+
+	var script MockScript
+	if err := yaml.Unmarshal(data, &script); err != nil {
+		return nil, fmt.Errorf("failed to parse mock script %s: %w", path, err)
+	}
+
+	if err := script.validate(); err != nil {
+		return nil, fmt.Errorf("invalid mock script %s: %w", path, err)
+	}
+
+	return &script, nil
+}
+
+func (s *MockScript) validate() error {
+	if len(s.Rules) == 0 && len(s.Turns) == 0 {
+		return fmt.Errorf("script has neither rules nor turns")
+	}
+
+	for i := range s.Rules {
+		rule := &s.Rules[i]
+		pattern, err := regexp.Compile(rule.Match)
+		if err != nil {
+			return fmt.Errorf("rule %d: invalid match: %w", i+1, err)
+		}
+		rule.pattern = pattern
+
+		if len(rule.Turns) == 0 {
+			return fmt.Errorf("rule %d: at least one turn is required", i+1)
+		}
+		if err := validateMockTurns(rule.Turns); err != nil {
+			return fmt.Errorf("rule %d: %w", i+1, err)
+		}
+	}
+
+	if err := validateMockTurns(s.Turns); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMockTurns(turns []MockTurn) error {
+	for i, turn := range turns {
+		if turn.Error != "" {
+			if !slices.Contains(providerErrorKinds, turn.Error) {
+				return fmt.Errorf("turn %d: unknown error %q", i+1, turn.Error)
+			}
+			continue
+		}
+
+		if turn.Text == "" && turn.Script == "" {
+			return fmt.Errorf("turn %d: text or script is required", i+1)
+		}
+	}
+
+	return nil
+}
+
+// turn selects the turn that answers the conversation. The selection only depends on the
+// messages, so a conversation can be continued by any instance of the provider.
+func (s *MockScript) turn(messages []*Message) (*MockTurn, error) {
+	lastUser := -1
+	for i, message := range messages {
+		if message.Source == MessageSourceUser {
+			lastUser = i
+		}
+	}
+
+	if lastUser >= 0 {
+		text := messageText(messages[lastUser])
+		answered := countModelMessages(messages[lastUser+1:])
+		for _, rule := range s.Rules {
+			if !rule.pattern.MatchString(text) {
+				continue
+			}
+
+			if answered >= len(rule.Turns) {
+				return nil, fmt.Errorf("rule %q has no turn %d", rule.Match, answered+1)
+			}
+			return &rule.Turns[answered], nil
+		}
+	}
+
+	answered := countModelMessages(messages)
+	if answered >= len(s.Turns) {
+		return nil, fmt.Errorf("script has no turn %d", answered+1)
+	}
+
+	return &s.Turns[answered], nil
+}
+
+func messageText(message *Message) string {
+	var text strings.Builder
+	for _, block := range message.Content {
+		if b, ok := block.(*TextBlock); ok {
+			text.WriteString(b.Text)
+		}
+	}
+
+	return text.String()
+}
+
+func countModelMessages(messages []*Message) int {
+	count := 0
+	for _, message := range messages {
+		if message.Source == MessageSourceModel {
+			count++
+		}
+	}
+
+	return count
+}
+
+// MockProvider answers with the turns of a mock script instead of calling a model. The script
+// is read on creation, so changes to the script apply to the next invocation.
+type MockProvider struct {
+	script *MockScript
+}
+
+var _ ModelProvider = (*MockProvider)(nil)
+
+func NewMockProvider(path string) (*MockProvider, error) {
+	if path == "" {
+		return nil, fmt.Errorf("mock provider requires a script")
+	}
+
+	script, err := LoadMockScript(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MockProvider{script: script}, nil
+}
+
+func (p *MockProvider) InvokeModel(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	options := &InvokeModelOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	turn, err := p.script.turn(messages)
+	if err != nil {
+		return nil, NewProviderError("mock", ProviderErrorKindInvalidRequest, err)
+	}
+
+	if turn.Error != "" {
+		return nil, NewProviderError("mock", turn.Error, fmt.Errorf("scripted error"))
+	}
+
+	chunkDelay := mockDefaultChunkDelay
+	if p.script.ChunkDelay != nil {
+		chunkDelay = *p.script.ChunkDelay
+	}
+
+	if options.StreamCallback != nil {
+		for _, chunk := range mockChunks(turn.Text) {
+			select {
+			case <-ctx.Done():
+				return nil, NewProviderError("mock", ProviderErrorKindCanceled, ctx.Err())
+			case <-time.After(chunkDelay):
+			}
+			options.StreamCallback(ctx, chunk)
+		}
+	}
+
+	var content []ContentBlock
+	if turn.Text != "" {
+		content = append(content, &TextBlock{Text: turn.Text})
+	}
+
+	if turn.Script != "" {
+		args, err := json.Marshal(map[string]string{"script": turn.Script})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode script: %w", err)
+		}
+		content = append(content, &ToolCallBlock{
+			ID:   fmt.Sprintf("mock_call_%d", countModelMessages(messages)+1),
+			Tool: "code_interpreter",
+			Args: args,
+		})
+	}
+
+	prompt := len(systemPrompt)
+	for _, message := range messages {
+		for _, block := range encodeCassetteMessage(message).Content {
+			prompt += len(block.Text) + len(block.Args) + len(block.Result)
+		}
+	}
+
+	return NewModelMessage(content, Usage{
+		InputTokens:  int64(prompt / 4),
+		OutputTokens: int64((len(turn.Text) + len(turn.Script)) / 4),
+	}), nil
+}
+
+// mockChunks splits the text after whitespace, similar to how providers stream a few tokens
+// at a time.
+func mockChunks(text string) []string {
+	var chunks []string
+	start := 0
+	for i, r := range text {
+		if r == ' ' || r == '\n' {
+			chunks = append(chunks, text[start:i+1])
+			start = i + 1
+		}
+	}
+
+	if start < len(text) {
+		chunks = append(chunks, text[start:])
+	}
+
+	return chunks
+}
@@ -0,0 +1,192 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const testMockScript = `chunk_delay: 0s
+rules:
+  - match: "(?i)^hello"
+    turns:
+      - text: Hi there!
+  - match: "(?i)run tests"
+    turns:
+      - text: Running the tests.
+        script: print(execute_command("go test ./..."))
+      - text: All tests passed.
+turns:
+  - text: First answer.
+  - text: Second answer.
+`
+
+func TestMockProvider_Turns(t *testing.T) {
+	provider := newTestMockProvider(t, testMockScript)
+
+	conversation := []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "What does this project do?"}}},
+	}
+
+	var streamed []string
+	message, err := provider.InvokeModel(context.Background(), MockModel, "system prompt", conversation, WithStreamHandler(func(ctx context.Context, chunk string) {
+		streamed = append(streamed, chunk)
+	}))
+	if err != nil {
+		t.Fatalf("InvokeModel() error = %v", err)
+	}
+
+	if diff := cmp.Diff([]ContentBlock{&TextBlock{Text: "First answer."}}, message.Content); diff != "" {
+		t.Errorf("content mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"First ", "answer."}, streamed); diff != "" {
+		t.Errorf("chunks mismatch (-want +got):\n%s", diff)
+	}
+	if message.Usage.InputTokens == 0 || message.Usage.OutputTokens == 0 {
+		t.Errorf("expected usage to be estimated, got %+v", message.Usage)
+	}
+
+	conversation = append(conversation, message, &Message{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "And then?"}}})
+	message, err = provider.InvokeModel(context.Background(), MockModel, "system prompt", conversation)
+	if err != nil {
+		t.Fatalf("InvokeModel() error = %v", err)
+	}
+	if diff := cmp.Diff([]ContentBlock{&TextBlock{Text: "Second answer."}}, message.Content); diff != "" {
+		t.Errorf("content mismatch (-want +got):\n%s", diff)
+	}
+
+	conversation = append(conversation, message, &Message{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Anything else?"}}})
+	_, err = provider.InvokeModel(context.Background(), MockModel, "system prompt", conversation)
+
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) || providerErr.Kind != ProviderErrorKindInvalidRequest {
+		t.Fatalf("expected an invalid request error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "script has no turn 3") {
+		t.Errorf("error does not explain that the script is exhausted: %v", err)
+	}
+}
+
+func TestMockProvider_Rules(t *testing.T) {
+	provider := newTestMockProvider(t, testMockScript)
+
+	message, err := provider.InvokeModel(context.Background(), MockModel, "system prompt", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Hello"}}},
+	})
+	if err != nil {
+		t.Fatalf("InvokeModel() error = %v", err)
+	}
+	if diff := cmp.Diff([]ContentBlock{&TextBlock{Text: "Hi there!"}}, message.Content); diff != "" {
+		t.Errorf("content mismatch (-want +got):\n%s", diff)
+	}
+
+	conversation := []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Hello"}}},
+		message,
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Please run tests"}}},
+	}
+	message, err = provider.InvokeModel(context.Background(), MockModel, "system prompt", conversation)
+	if err != nil {
+		t.Fatalf("InvokeModel() error = %v", err)
+	}
+
+	expected := []ContentBlock{
+		&TextBlock{Text: "Running the tests."},
+		&ToolCallBlock{ID: "mock_call_2", Tool: "code_interpreter", Args: json.RawMessage(`{"script":"print(execute_command(\"go test ./...\"))"}`)},
+	}
+	if diff := cmp.Diff(expected, message.Content); diff != "" {
+		t.Errorf("content mismatch (-want +got):\n%s", diff)
+	}
+
+	conversation = append(conversation, message, &Message{
+		Source:  MessageSourceSystem,
+		Content: []ContentBlock{&ToolResultBlock{ID: "mock_call_2", Name: "code_interpreter", Result: "ok", Succeeded: true}},
+	})
+	message, err = provider.InvokeModel(context.Background(), MockModel, "system prompt", conversation)
+	if err != nil {
+		t.Fatalf("InvokeModel() error = %v", err)
+	}
+	if diff := cmp.Diff([]ContentBlock{&TextBlock{Text: "All tests passed."}}, message.Content); diff != "" {
+		t.Errorf("content mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLoadMockScript(t *testing.T) {
+	tests := []struct {
+		name    string
+		script  string
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			script: testMockScript,
+		},
+		{
+			name:    "empty",
+			script:  "chunk_delay: 10ms\n",
+			wantErr: "script has neither rules nor turns",
+		},
+		{
+			name:    "invalid match",
+			script:  "rules:\n  - match: \"(\"\n    turns:\n      - text: Hi\n",
+			wantErr: "rule 1: invalid match",
+		},
+		{
+			name:    "rule without turns",
+			script:  "rules:\n  - match: hello\n",
+			wantErr: "rule 1: at least one turn is required",
+		},
+		{
+			name:    "empty turn",
+			script:  "turns:\n  - text: Hi\n  - script: \"\"\n",
+			wantErr: "turn 2: text or script is required",
+		},
+		{
+			name:   "error turn",
+			script: "turns:\n  - error: overloaded\n",
+		},
+		{
+			name:    "unknown error",
+			script:  "turns:\n  - error: broken\n",
+			wantErr: "turn 1: unknown error \"broken\"",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadMockScript(writeMockScript(t, tt.script))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("LoadMockScript() error = %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("LoadMockScript() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func newTestMockProvider(t *testing.T, script string) *MockProvider {
+	provider, err := NewMockProvider(writeMockScript(t, script))
+	if err != nil {
+		t.Fatalf("failed to create mock provider: %v", err)
+	}
+	return provider
+}
+
+func writeMockScript(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "mock.yaml")
+	if err := os.WriteFile(path, []byte(script), 0600); err != nil {
+		t.Fatalf("failed to write mock script: %v", err)
+	}
+	return path
+}
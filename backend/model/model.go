@@ -6,13 +6,13 @@ import (
 	"github.com/google/uuid"
 )
 
+// Model is a model that a provider offers. The capabilities and pricing of models are kept in the
+// model catalog.
 type Model struct {
 	ID            uuid.UUID
 	Provider      ProviderKind
 	Name          string
-	Capabilities  []Capability
 	ContextWindow int64
-	Pricing       ModelPricing
 }
 
 type ProviderKind string
@@ -42,25 +42,10 @@ const (
 	ProviderKindGemini    ProviderKind = "gemini"
 	ProviderKindXAI       ProviderKind = "xai"
 	ProviderKindBedrock   ProviderKind = "bedrock"
+	ProviderKindReplay    ProviderKind = "replay"
+	ProviderKindMock      ProviderKind = "mock"
 )
 
-type Capability string
-
-const (
-	CapabilityImage            Capability = "image"
-	CapabilityAudio            Capability = "audio"
-	CapabilityComputerUse      Capability = "computer_use"
-	CapabilityPromptCache      Capability = "prompt_cache"
-	CapabilityExtendedThinking Capability = "extended_thinking"
-)
-
-type ModelPricing struct {
-	Input      float64
-	Output     float64
-	CacheWrite float64
-	CacheRead  float64
-}
-
 func SupportedModels(provider ProviderKind) []Model {
 	switch provider {
 	case ProviderKindAnthropic:
@@ -71,11 +56,41 @@ func SupportedModels(provider ProviderKind) []Model {
 		return SupportedGeminiModels()
 	case ProviderKindXAI:
 		return SupportedXAIModels()
+	case ProviderKindDeepSeek:
+		return SupportedDeepSeekModels()
+	case ProviderKindBedrock:
+		return SupportedBedrockModels()
+	case ProviderKindMock:
+		return SupportedMockModels()
 	}
 
 	return nil
 }
 
+// BuiltinAgentModels are the models that the builtin agents use.
+type BuiltinAgentModels struct {
+	Default string
+	Budget  string
+	Plan    string
+}
+
+// DefaultBuiltinAgentModels returns the models of the provider that the builtin agents are
+// created with.
+func DefaultBuiltinAgentModels(provider ProviderKind) (*BuiltinAgentModels, error) {
+	switch provider {
+	case ProviderKindAnthropic:
+		return &BuiltinAgentModels{Default: AnthropicDefaultModel, Budget: AnthropicBudgetModel, Plan: AnthropicPlanModel}, nil
+	case ProviderKindDeepSeek:
+		return &BuiltinAgentModels{Default: DeepSeekDefaultModel, Budget: DeepSeekDefaultModel, Plan: DeepSeekReasonerModel}, nil
+	case ProviderKindBedrock:
+		return &BuiltinAgentModels{Default: BedrockDefaultModel, Budget: BedrockBudgetModel, Plan: BedrockPlanModel}, nil
+	case ProviderKindMock:
+		return &BuiltinAgentModels{Default: MockModel, Budget: MockModel, Plan: MockModel}, nil
+	}
+
+	return nil, fmt.Errorf("provider %s is not supported yet", provider)
+}
+
 func DefaultModel(provider ProviderKind) (*Model, error) {
 	switch provider {
 	case ProviderKindAnthropic:
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -14,9 +15,13 @@ import (
 )
 
 type OpenAICompletionProvider struct {
+	// name identifies the provider in errors, OpenAI compatible APIs use their own name.
+	name   string
 	client openai.Client
 }
 
+var _ ModelLister = (*OpenAICompletionProvider)(nil)
+
 func NewOpenAICompletionProvider(apiKey string, opts ...ProviderOption) (*OpenAICompletionProvider, error) {
 	logger := slog.With("component", "openai_provider")
 
@@ -33,6 +38,7 @@ func NewOpenAICompletionProvider(apiKey string, opts ...ProviderOption) (*OpenAI
 
 	options := []option.RequestOption{
 		option.WithAPIKey(apiKey),
+		option.WithHTTPClient(providerOptions.HTTPClient),
 	}
 	if providerOptions.URL != "" {
 		logger.Debug("using custom OpenAI URL",
@@ -44,6 +50,7 @@ func NewOpenAICompletionProvider(apiKey string, opts ...ProviderOption) (*OpenAI
 	logger.Info("OpenAI provider initialized successfully")
 
 	return &OpenAICompletionProvider{
+		name:   "openai",
 		client: openai.NewClient(options...),
 	}, nil
 }
@@ -124,7 +131,7 @@ func (p *OpenAICompletionProvider) InvokeModel(ctx context.Context, model, syste
 			"error", err,
 			"duration_ms", time.Since(invokeStart).Milliseconds(),
 		)
-		return nil, err
+		return nil, p.mapError(err)
 	}
 
 	var content []ContentBlock
@@ -160,6 +167,57 @@ func (p *OpenAICompletionProvider) InvokeModel(ctx context.Context, model, syste
 	}), nil
 }
 
+func (p *OpenAICompletionProvider) ListModels(ctx context.Context) ([]string, error) {
+	var models []string
+	pager := p.client.Models.ListAutoPaging(ctx)
+	for pager.Next() {
+		models = append(models, pager.Current().ID)
+	}
+
+	if err := pager.Err(); err != nil {
+		return nil, p.mapError(err)
+	}
+
+	return models, nil
+}
+
+func (p *OpenAICompletionProvider) mapError(err error) error {
+	var apiErr *openai.Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	var kind ProviderErrorKind
+	switch apiErr.StatusCode {
+	case 400, 404, 413:
+		kind = ProviderErrorKindInvalidRequest
+	case 401, 403:
+		kind = ProviderErrorKindAuthentication
+	case 429:
+		kind = ProviderErrorKindRateLimitExceeded
+	case 503, 529:
+		kind = ProviderErrorKindOverloaded
+	default:
+		if apiErr.StatusCode >= 500 && apiErr.StatusCode < 600 {
+			kind = ProviderErrorKindInternal
+		} else {
+			kind = ProviderErrorKindUnknown
+		}
+	}
+
+	providerErr := NewProviderError(p.name, kind, err)
+
+	if kind == ProviderErrorKindRateLimitExceeded && apiErr.Response != nil {
+		if retryAfter := apiErr.Response.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := time.ParseDuration(retryAfter + "s"); err == nil {
+				providerErr.RetryAfter = seconds
+			}
+		}
+	}
+
+	return providerErr
+}
+
 func (p *OpenAICompletionProvider) transformMessages(messages []*Message) ([]openai.ChatCompletionMessageParamUnion, error) {
 	openaiMessages := make([]openai.ChatCompletionMessageParamUnion, 0, len(messages))
 
@@ -84,100 +84,39 @@ func (c *OpenAIModelProfile) Validate() error {
 func SupportedOpenAIModels() []Model {
 	return []Model{
 		{
-			ID:            uuid.MustParse("01960000-0001-7000-8000-000000000001"),
-			Name:          shared.ChatModelChatgpt4oLatest,
-			Provider:      ProviderKindOpenAI,
-			Capabilities:  []Capability{CapabilityImage},
-			ContextWindow: 128000,
-			Pricing: ModelPricing{
-				Input:      2.5,
-				Output:     10.0,
-				CacheWrite: 1.25,
-				CacheRead:  0.25,
-			},
+			ID:       uuid.MustParse("01960000-0001-7000-8000-000000000001"),
+			Name:     shared.ChatModelChatgpt4oLatest,
+			Provider: ProviderKindOpenAI,
 		},
 		{
-			ID:            uuid.MustParse("01960000-0002-7000-8000-000000000002"),
-			Name:          shared.ChatModelO4Mini,
-			Provider:      ProviderKindOpenAI,
-			Capabilities:  []Capability{CapabilityImage},
-			ContextWindow: 128000,
-			Pricing: ModelPricing{
-				Input:      0.15,
-				Output:     0.6,
-				CacheWrite: 0.075,
-				CacheRead:  0.015,
-			},
+			ID:       uuid.MustParse("01960000-0002-7000-8000-000000000002"),
+			Name:     shared.ChatModelO4Mini,
+			Provider: ProviderKindOpenAI,
 		},
 		{
-			ID:            uuid.MustParse("01960000-0003-7000-8000-000000000003"),
-			Name:          "gpt-4-turbo",
-			Provider:      ProviderKindOpenAI,
-			Capabilities:  []Capability{CapabilityImage},
-			ContextWindow: 128000,
-			Pricing: ModelPricing{
-				Input:      10.0,
-				Output:     30.0,
-				CacheWrite: 5.0,
-				CacheRead:  1.0,
-			},
+			ID:       uuid.MustParse("01960000-0003-7000-8000-000000000003"),
+			Name:     "gpt-4-turbo",
+			Provider: ProviderKindOpenAI,
 		},
 		{
-			ID:            uuid.MustParse("01960000-0004-7000-8000-000000000004"),
-			Name:          "gpt-3.5-turbo",
-			Provider:      ProviderKindOpenAI,
-			Capabilities:  []Capability{},
-			ContextWindow: 16385,
-			Pricing: ModelPricing{
-				Input:      0.5,
-				Output:     1.5,
-				CacheWrite: 0.25,
-				CacheRead:  0.05,
-			},
+			ID:       uuid.MustParse("01960000-0004-7000-8000-000000000004"),
+			Name:     "gpt-3.5-turbo",
+			Provider: ProviderKindOpenAI,
 		},
 		{
-			ID:            uuid.MustParse("01960000-0005-7000-8000-000000000005"),
-			Name:          "o1",
-			Provider:      ProviderKindOpenAI,
-			Capabilities:  []Capability{},
-			ContextWindow: 200000,
-			Pricing: ModelPricing{
-				Input:      15.0,
-				Output:     60.0,
-				CacheWrite: 7.5,
-				CacheRead:  1.5,
-			},
+			ID:       uuid.MustParse("01960000-0005-7000-8000-000000000005"),
+			Name:     "o1",
+			Provider: ProviderKindOpenAI,
 		},
 		{
-			ID:            uuid.MustParse("01960000-0006-7000-8000-000000000006"),
-			Name:          "o1-mini",
-			Provider:      ProviderKindOpenAI,
-			Capabilities:  []Capability{},
-			ContextWindow: 128000,
-			Pricing: ModelPricing{
-				Input:      3.0,
-				Output:     12.0,
-				CacheWrite: 1.5,
-				CacheRead:  0.3,
-			},
+			ID:       uuid.MustParse("01960000-0006-7000-8000-000000000006"),
+			Name:     "o1-mini",
+			Provider: ProviderKindOpenAI,
 		},
 		{
 			ID:       uuid.MustParse("01960000-0007-7000-8000-000000000007"),
 			Name:     "gpt-5-2025-08-07",
 			Provider: ProviderKindOpenAI,
-			Capabilities: []Capability{
-				CapabilityImage,
-				CapabilityComputerUse,
-				CapabilityPromptCache,
-				CapabilityExtendedThinking,
-			},
-			ContextWindow: 128000,
-			Pricing: ModelPricing{
-				Input:      1.25,
-				Output:     10.0,
-				CacheWrite: 1.25,
-				CacheRead:  0.25,
-			},
 		},
 	}
 }
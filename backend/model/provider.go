@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/furisto/construct/backend/tool/native"
@@ -44,11 +45,29 @@ func WithRetryCallback(handler func(ctx context.Context, err error, nextRetry ti
 	}
 }
 
+// Credentials authenticate the requests to a model provider. They are stored as JSON in the
+// encrypted secret of the provider.
+type Credentials struct {
+	APIKey string          `json:"apiKey,omitempty"`
+	AWS    *AWSCredentials `json:"aws,omitempty"`
+}
+
+// AWSCredentials are the credentials that requests to providers hosted on AWS are signed with.
+type AWSCredentials struct {
+	AccessKeyID     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	SessionToken    string `json:"sessionToken,omitempty"`
+	Region          string `json:"region"`
+}
+
 type ProviderOptions struct {
 	URL            string
 	RetryConfig    *resilience.RetryConfig
 	CircuitBreaker *resilience.CircuitBreaker
 	Metrics        *prometheus.Registry
+	// HTTPClient sends the requests to the provider. The default client supports capturing
+	// exchanges with WithCallCapture.
+	HTTPClient *http.Client
 }
 
 type ProviderOption func(*ProviderOptions)
@@ -77,6 +96,12 @@ func WithMetrics(metrics *prometheus.Registry) ProviderOption {
 	}
 }
 
+func WithHTTPClient(client *http.Client) ProviderOption {
+	return func(o *ProviderOptions) {
+		o.HTTPClient = client
+	}
+}
+
 func DefaultProviderOptions(name string) *ProviderOptions {
 	return &ProviderOptions{
 		RetryConfig: &resilience.RetryConfig{
@@ -87,6 +112,7 @@ func DefaultProviderOptions(name string) *ProviderOptions {
 		},
 		CircuitBreaker: resilience.NewCircuitBreaker(name, 5, 10*time.Second),
 		Metrics:        prometheus.NewRegistry(),
+		HTTPClient:     defaultHTTPClient,
 	}
 }
 
@@ -122,6 +148,7 @@ const (
 	ContentBlockTypeText        ContentBlockType = "text"
 	ContentBlockTypeToolRequest ContentBlockType = "tool_request"
 	ContentBlockTypeToolResult  ContentBlockType = "tool_result"
+	ContentBlockTypeReasoning   ContentBlockType = "reasoning"
 )
 
 type ContentBlock interface {
@@ -157,6 +184,29 @@ func (t *ToolResultBlock) Type() ContentBlockType {
 	return ContentBlockTypeToolResult
 }
 
+// ReasoningBlock is the reasoning that a model produced before its answer. Providers that need
+// the reasoning of earlier turns send it back, all others ignore it.
+type ReasoningBlock struct {
+	Text string `json:"text"`
+}
+
+func (r *ReasoningBlock) Type() ContentBlockType {
+	return ContentBlockTypeReasoning
+}
+
+// withoutReasoning returns the blocks without the reasoning blocks, for providers that map blocks
+// one to one.
+func withoutReasoning(blocks []ContentBlock) []ContentBlock {
+	filtered := make([]ContentBlock, 0, len(blocks))
+	for _, block := range blocks {
+		if _, ok := block.(*ReasoningBlock); !ok {
+			filtered = append(filtered, block)
+		}
+	}
+
+	return filtered
+}
+
 type Usage struct {
 	InputTokens      int64 `json:"input_tokens"`
 	OutputTokens     int64 `json:"output_tokens"`
@@ -183,6 +233,8 @@ func (pe *ProviderError) Message() string {
 	switch pe.Kind {
 	case ProviderErrorKindInvalidRequest:
 		return "Invalid request format or content"
+	case ProviderErrorKindAuthentication:
+		return "Authentication failed, check the API key"
 	case ProviderErrorKindRateLimitExceeded:
 		if pe.RetryAfter > 0 {
 			return fmt.Sprintf("Rate limit exceeded, retry after %s", pe.RetryAfter)
@@ -190,6 +242,8 @@ func (pe *ProviderError) Message() string {
 		return "Rate limit exceeded"
 	case ProviderErrorKindOverloaded:
 		return "API temporarily overloaded"
+	case ProviderErrorKindCircuitOpen:
+		return "Circuit breaker open, provider temporarily unavailable"
 	case ProviderErrorKindInternal:
 		return "Internal server error"
 	case ProviderErrorKindTimeout:
@@ -209,11 +263,26 @@ func (pe *ProviderError) Retryable() (bool, time.Duration) {
 		return true, pe.RetryAfter
 	case ProviderErrorKindOverloaded:
 		return true, 20 * time.Second
+	case ProviderErrorKindCircuitOpen:
+		return true, 10 * time.Second
 	default:
 		return false, 0
 	}
 }
 
+// ShouldFallback reports whether the provider is temporarily unable to serve the request,
+// in which case the request can be sent to a different model instead.
+func (pe *ProviderError) ShouldFallback() bool {
+	switch pe.Kind {
+	case ProviderErrorKindRateLimitExceeded,
+		ProviderErrorKindOverloaded,
+		ProviderErrorKindCircuitOpen:
+		return true
+	default:
+		return false
+	}
+}
+
 func (pe *ProviderError) retryableInternal() bool {
 	switch pe.Kind {
 	case ProviderErrorKindOverloaded,
@@ -240,10 +309,24 @@ type ProviderErrorKind string
 
 const (
 	ProviderErrorKindInvalidRequest    ProviderErrorKind = "invalid_request"
+	ProviderErrorKindAuthentication    ProviderErrorKind = "authentication"
 	ProviderErrorKindRateLimitExceeded ProviderErrorKind = "rate_limit_exceeded"
 	ProviderErrorKindOverloaded        ProviderErrorKind = "overloaded"
+	ProviderErrorKindCircuitOpen       ProviderErrorKind = "circuit_open"
 	ProviderErrorKindInternal          ProviderErrorKind = "internal"
 	ProviderErrorKindTimeout           ProviderErrorKind = "timeout"
 	ProviderErrorKindCanceled          ProviderErrorKind = "canceled"
 	ProviderErrorKindUnknown           ProviderErrorKind = "unknown"
 )
+
+var providerErrorKinds = []ProviderErrorKind{
+	ProviderErrorKindInvalidRequest,
+	ProviderErrorKindAuthentication,
+	ProviderErrorKindRateLimitExceeded,
+	ProviderErrorKindOverloaded,
+	ProviderErrorKindCircuitOpen,
+	ProviderErrorKindInternal,
+	ProviderErrorKindTimeout,
+	ProviderErrorKindCanceled,
+	ProviderErrorKindUnknown,
+}
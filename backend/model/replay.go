@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+)
+
+// ReplayProvider serves the interactions of a cassette in the order in which they were
+// recorded. Every request has to match the recorded request, otherwise the replay fails.
+type ReplayProvider struct {
+	path     string
+	cassette *Cassette
+
+	mu   sync.Mutex
+	next int
+}
+
+var _ ModelProvider = (*ReplayProvider)(nil)
+
+func NewReplayProvider(path string) (*ReplayProvider, error) {
+	if path == "" {
+		return nil, fmt.Errorf("replay provider requires a cassette")
+	}
+
+	cassette, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("replay provider initialized successfully",
+		"cassette", path,
+		"interactions", len(cassette.Interactions),
+	)
+
+	return &ReplayProvider{
+		path:     path,
+		cassette: cassette,
+	}, nil
+}
+
+func (p *ReplayProvider) Path() string {
+	return p.path
+}
+
+func (p *ReplayProvider) InvokeModel(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (*Message, error) {
+	options := &InvokeModelOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	hash, err := RequestHash(messages, toolDefinitions(options))
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	index := p.next
+	if index >= len(p.cassette.Interactions) {
+		p.mu.Unlock()
+		return nil, NewProviderError("replay", ProviderErrorKindInvalidRequest,
+			fmt.Errorf("cassette %s has no interaction left for request %d", p.path, index+1))
+	}
+
+	interaction := p.cassette.Interactions[index]
+	if interaction.RequestHash != hash {
+		p.mu.Unlock()
+		return nil, NewProviderError("replay", ProviderErrorKindInvalidRequest,
+			fmt.Errorf("request %d does not match cassette %s: recorded %s for model %s, got %s for model %s",
+				index+1, p.path, interaction.RequestHash, interaction.Model, hash, model))
+	}
+	p.next++
+	p.mu.Unlock()
+
+	if options.StreamCallback != nil {
+		for _, chunk := range interaction.Chunks {
+			options.StreamCallback(ctx, chunk)
+		}
+	}
+
+	if interaction.Error != nil {
+		return nil, interaction.Error.decode()
+	}
+
+	if interaction.Response == nil {
+		return nil, fmt.Errorf("interaction %d of cassette %s has neither a response nor an error", index+1, p.path)
+	}
+
+	return decodeCassetteMessage(interaction.Response)
+}
+
+// Models returns a model for every model that was invoked in the cassette.
+func (c *Cassette) Models() []Model {
+	var models []Model
+	seen := make(map[string]bool)
+	for _, interaction := range c.Interactions {
+		if seen[interaction.Model] {
+			continue
+		}
+		seen[interaction.Model] = true
+
+		models = append(models, Model{
+			Name:     interaction.Model,
+			Provider: ProviderKindReplay,
+		})
+	}
+
+	return models
+}
+
+// BuiltinAgentModels returns the models for the builtin agents of a replay provider. Replays
+// match requests regardless of the model, so all builtin agents use the first recorded model.
+func (c *Cassette) BuiltinAgentModels() (*BuiltinAgentModels, error) {
+	if len(c.Interactions) == 0 {
+		return nil, fmt.Errorf("cassette has no interactions")
+	}
+
+	first := c.Interactions[0].Model
+	return &BuiltinAgentModels{Default: first, Budget: first, Plan: first}, nil
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/furisto/construct/backend/tool/native"
+)
+
+// TokenCounter is implemented by providers that can count the tokens of a prompt without
+// invoking the model.
+type TokenCounter interface {
+	CountTokens(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (int64, error)
+}
+
+type TokenEstimateSource string
+
+const (
+	// TokenEstimateSourceProvider estimates were counted by the token counting endpoint of the provider.
+	TokenEstimateSourceProvider TokenEstimateSource = "provider"
+	// TokenEstimateSourceApproximation estimates were computed locally by ApproximateTokens.
+	TokenEstimateSourceApproximation TokenEstimateSource = "approximation"
+)
+
+// TokenEstimate is the expected number of input tokens of a model invocation.
+type TokenEstimate struct {
+	Tokens int64
+	Source TokenEstimateSource
+}
+
+const (
+	// messageOverheadTokens accounts for the role markers and separators of every message.
+	messageOverheadTokens = 4
+	// toolOverheadTokens accounts for the framing of every tool definition.
+	toolOverheadTokens = 8
+)
+
+// EstimateTokens estimates the input tokens of a prompt. Providers that implement TokenCounter
+// are asked to count the tokens. The local approximation is used for all other providers and if
+// counting fails, so that an estimate is always available.
+func EstimateTokens(ctx context.Context, provider ModelProvider, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) TokenEstimate {
+	if counter, ok := provider.(TokenCounter); ok {
+		tokens, err := counter.CountTokens(ctx, model, systemPrompt, messages, opts...)
+		if err == nil {
+			return TokenEstimate{Tokens: tokens, Source: TokenEstimateSourceProvider}
+		}
+		slog.Warn("failed to count tokens, falling back to approximation",
+			"model", model,
+			"error", err,
+		)
+	}
+
+	options := &InvokeModelOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return TokenEstimate{
+		Tokens: ApproximateTokens(systemPrompt, messages, options.Tools),
+		Source: TokenEstimateSourceApproximation,
+	}
+}
+
+// ApproximateTokens approximates the input tokens of a prompt without a provider. It mimics
+// byte pair encoding tokenizers closely enough to protect the context window, but it is not
+// exact and tends to overestimate.
+func ApproximateTokens(systemPrompt string, messages []*Message, tools []native.Tool) int64 {
+	tokens := ApproximateTextTokens(systemPrompt)
+	for _, message := range messages {
+		tokens += ApproximateMessageTokens(message)
+	}
+
+	for _, tool := range tools {
+		schema, _ := json.Marshal(tool.Schema())
+		tokens += toolOverheadTokens +
+			ApproximateTextTokens(tool.Name()) +
+			ApproximateTextTokens(tool.Description()) +
+			ApproximateTextTokens(string(schema))
+	}
+
+	return tokens
+}
+
+// ApproximateMessageTokens approximates the tokens of a single message including its framing.
+func ApproximateMessageTokens(message *Message) int64 {
+	tokens := int64(messageOverheadTokens)
+	for _, block := range message.Content {
+		switch b := block.(type) {
+		case *TextBlock:
+			tokens += ApproximateTextTokens(b.Text)
+		case *ToolCallBlock:
+			tokens += messageOverheadTokens + ApproximateTextTokens(b.Tool) + ApproximateTextTokens(string(b.Args))
+		case *ToolResultBlock:
+			tokens += messageOverheadTokens + ApproximateTextTokens(b.Name) + ApproximateTextTokens(b.Result)
+		}
+	}
+
+	return tokens
+}
+
+// ApproximateTextTokens approximates the tokens of a text the way common tokenizers split it:
+// short words are a single token and long words are split into chunks of four characters, digits
+// are grouped by three, every punctuation character and every non-latin character is a token of
+// its own, line breaks and indentation are a token and a single space is merged into the
+// following word.
+func ApproximateTextTokens(text string) int64 {
+	var (
+		tokens     int64
+		wordLength int64
+		digitCount int64
+		spaceCount int64
+		newline    bool
+	)
+
+	flushWord := func() {
+		tokens += (wordLength + 3) / 4
+		wordLength = 0
+	}
+	flushDigits := func() {
+		tokens += (digitCount + 2) / 3
+		digitCount = 0
+	}
+	flushSpaces := func() {
+		if spaceCount > 1 || newline {
+			tokens++
+		}
+		spaceCount = 0
+		newline = false
+	}
+
+	for len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		text = text[size:]
+
+		switch {
+		case r < utf8.RuneSelf && (unicode.IsLetter(r) || r == '_'):
+			flushDigits()
+			flushSpaces()
+			wordLength++
+		case unicode.IsDigit(r):
+			flushWord()
+			flushSpaces()
+			digitCount++
+		case unicode.IsSpace(r):
+			flushWord()
+			flushDigits()
+			spaceCount++
+			newline = newline || r == '\n'
+		default:
+			flushWord()
+			flushDigits()
+			flushSpaces()
+			tokens++
+		}
+	}
+	flushWord()
+	flushDigits()
+	flushSpaces()
+
+	return tokens
+}
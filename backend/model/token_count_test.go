@@ -0,0 +1,121 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestApproximateTextTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int64
+	}{
+		{name: "empty", text: "", want: 0},
+		{name: "short words", text: "fix the bug", want: 3},
+		{name: "long word", text: "reconciliation", want: 4},
+		{name: "digits", text: "1234567", want: 3},
+		{name: "punctuation", text: `{"a":1}`, want: 7},
+		{name: "indentation", text: "if x {\n    return\n}", want: 8},
+		{name: "non latin", text: "日本語", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ApproximateTextTokens(tt.text); got != tt.want {
+				t.Errorf("ApproximateTextTokens(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApproximateTokens_Overestimates(t *testing.T) {
+	// Source code usually takes about one token per three to four characters.
+	code := strings.Repeat("func (r *TaskReconciler) reconcile(ctx context.Context, taskID uuid.UUID) (Result, error) {\n\treturn Result{}, nil\n}\n", 100)
+	messages := []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Explain this code"}}},
+		{Source: MessageSourceSystem, Content: []ContentBlock{&ToolResultBlock{ID: "1", Name: "read_file", Result: code, Succeeded: true}}},
+	}
+
+	tokens := ApproximateTokens("", messages, nil)
+	if tokens < int64(len(code)/4) || tokens > int64(len(code)/2) {
+		t.Errorf("ApproximateTokens() = %d for %d characters of code, want between a quarter and a half", tokens, len(code))
+	}
+}
+
+func TestEstimateTokens(t *testing.T) {
+	messages := []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix the bug"}}},
+	}
+
+	estimate := EstimateTokens(context.Background(), &stubTokenCounter{tokens: 42}, "model", "system", messages)
+	if estimate.Tokens != 42 || estimate.Source != TokenEstimateSourceProvider {
+		t.Errorf("EstimateTokens() = %+v, want the count of the provider", estimate)
+	}
+
+	approximation := ApproximateTokens("system", messages, nil)
+	estimate = EstimateTokens(context.Background(), &stubTokenCounter{err: errors.New("unavailable")}, "model", "system", messages)
+	if estimate.Tokens != approximation || estimate.Source != TokenEstimateSourceApproximation {
+		t.Errorf("EstimateTokens() = %+v, want the approximation %d if counting fails", estimate, approximation)
+	}
+
+	estimate = EstimateTokens(context.Background(), &MockProvider{}, "model", "system", messages)
+	if estimate.Tokens != approximation || estimate.Source != TokenEstimateSourceApproximation {
+		t.Errorf("EstimateTokens() = %+v, want the approximation %d for providers without token counting", estimate, approximation)
+	}
+}
+
+func TestAnthropicProvider_CountTokens(t *testing.T) {
+	var (
+		path    string
+		request map[string]any
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &request)
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"input_tokens":1234}`)
+	}))
+	defer server.Close()
+
+	provider, err := NewAnthropicProvider("test-key", WithURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewAnthropicProvider() error = %v", err)
+	}
+
+	tokens, err := provider.CountTokens(context.Background(), "claude-sonnet-4-0", "You are a coding agent", []*Message{
+		{Source: MessageSourceUser, Content: []ContentBlock{&TextBlock{Text: "Fix the bug"}}},
+	})
+	if err != nil {
+		t.Fatalf("CountTokens() error = %v", err)
+	}
+
+	if tokens != 1234 {
+		t.Errorf("CountTokens() = %d, want 1234", tokens)
+	}
+	if path != "/v1/messages/count_tokens" {
+		t.Errorf("path = %s, want the token counting endpoint", path)
+	}
+	if request["model"] != "claude-sonnet-4-0" || request["system"] == nil || request["messages"] == nil {
+		t.Errorf("request = %v, want model, system prompt and messages", request)
+	}
+}
+
+type stubTokenCounter struct {
+	MockProvider
+	tokens int64
+	err    error
+}
+
+func (c *stubTokenCounter) CountTokens(ctx context.Context, model, systemPrompt string, messages []*Message, opts ...InvokeModelOption) (int64, error) {
+	return c.tokens, c.err
+}
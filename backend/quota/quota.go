@@ -0,0 +1,207 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/message"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/task"
+	"github.com/furisto/construct/backend/memory/token"
+	"github.com/google/uuid"
+)
+
+// Limit names a limit of a quota.
+type Limit string
+
+const (
+	LimitConcurrentTasks Limit = "concurrent tasks"
+	LimitDailySpend      Limit = "daily spend"
+	LimitMonthlySpend    Limit = "monthly spend"
+	LimitDailyTokens     Limit = "daily tokens"
+)
+
+// ExceededError reports that a subject used up a limit of its quota.
+type ExceededError struct {
+	Subject string
+	Limit   Limit
+	Used    float64
+	Max     float64
+	// ResetAt is when the usage starts over. It is zero for concurrent tasks, which are freed
+	// as soon as a task stops running.
+	ResetAt time.Time
+}
+
+func (e *ExceededError) Error() string {
+	var used, max string
+	switch e.Limit {
+	case LimitDailySpend, LimitMonthlySpend:
+		used, max = fmt.Sprintf("$%.2f", e.Used), fmt.Sprintf("$%.2f", e.Max)
+	default:
+		used, max = fmt.Sprintf("%.0f", e.Used), fmt.Sprintf("%.0f", e.Max)
+	}
+
+	msg := fmt.Sprintf("%s quota of %s exceeded for %s: %s used", e.Limit, max, e.Subject, used)
+	if !e.ResetAt.IsZero() {
+		msg += fmt.Sprintf(", resets at %s", e.ResetAt.Format(time.RFC3339))
+	}
+	return msg
+}
+
+// Usage is what a subject used of its quota. Days and months are in UTC.
+type Usage struct {
+	RunningTasks   int
+	DailySpend     float64
+	MonthlySpend   float64
+	DailyTokens    int64
+	DailyResetAt   time.Time
+	MonthlyResetAt time.Time
+}
+
+// Enforcer checks the quotas of subjects. Quotas are set on tokens, so only subjects that
+// authenticate with a token can have a quota. Spend and tokens are taken from the usage that is
+// recorded for every model call, which also adds up to the cost of the task.
+type Enforcer struct {
+	db  *memory.Client
+	now func() time.Time
+}
+
+func NewEnforcer(db *memory.Client) *Enforcer {
+	return &Enforcer{
+		db:  db,
+		now: time.Now,
+	}
+}
+
+// Quota returns the quota of the subject, or nil if it has none.
+func (e *Enforcer) Quota(ctx context.Context, subject string) (*types.Quota, error) {
+	if subject == "" {
+		return nil, nil
+	}
+
+	tok, err := e.db.Token.Query().
+		Where(token.NameEQ(subject), token.ExpiresAtGT(e.now())).
+		Only(ctx)
+	if err != nil {
+		if memory.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to look up quota of %s: %w", subject, err)
+	}
+
+	if tok.Quota == nil || tok.Quota.IsZero() {
+		return nil, nil
+	}
+	return tok.Quota, nil
+}
+
+// Usage returns what the subject used of its quota.
+func (e *Enforcer) Usage(ctx context.Context, subject string) (Usage, error) {
+	now := e.now().UTC()
+	dayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	usage := Usage{
+		DailyResetAt:   dayStart.AddDate(0, 0, 1),
+		MonthlyResetAt: monthStart.AddDate(0, 1, 0),
+	}
+
+	running, err := e.db.Task.Query().
+		Where(task.OwnerEQ(subject), task.PhaseEQ(types.TaskPhaseRunning)).
+		Count(ctx)
+	if err != nil {
+		return Usage{}, fmt.Errorf("failed to count running tasks of %s: %w", subject, err)
+	}
+	usage.RunningTasks = running
+
+	messages, err := e.db.Message.Query().
+		Where(
+			message.SourceEQ(types.MessageSourceAssistant),
+			message.CreateTimeGTE(monthStart),
+			message.HasTaskWith(task.OwnerEQ(subject)),
+		).
+		Select(message.FieldUsage, message.FieldCreateTime).
+		All(ctx)
+	if err != nil {
+		return Usage{}, fmt.Errorf("failed to sum model usage of %s: %w", subject, err)
+	}
+
+	for _, m := range messages {
+		if m.Usage == nil {
+			continue
+		}
+		usage.MonthlySpend += m.Usage.Cost
+		if !m.CreateTime.Before(dayStart) {
+			usage.DailySpend += m.Usage.Cost
+			usage.DailyTokens += m.Usage.InputTokens + m.Usage.CacheWriteTokens + m.Usage.OutputTokens
+		}
+	}
+
+	return usage, nil
+}
+
+// Check returns an *ExceededError if the subject may not run another turn of the task. The task
+// does not count against the concurrent tasks if it is already running. Pass uuid.Nil for a task
+// that does not exist yet.
+func (e *Enforcer) Check(ctx context.Context, subject string, taskID uuid.UUID) error {
+	quota, err := e.Quota(ctx, subject)
+	if err != nil || quota == nil {
+		return err
+	}
+
+	usage, err := e.Usage(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	if quota.MaxConcurrentTasks > 0 {
+		running := usage.RunningTasks
+		if taskID != uuid.Nil {
+			isRunning, err := e.db.Task.Query().
+				Where(task.IDEQ(taskID), task.PhaseEQ(types.TaskPhaseRunning)).
+				Exist(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to check if task %s is running: %w", taskID, err)
+			}
+			if isRunning {
+				running--
+			}
+		}
+
+		if running >= quota.MaxConcurrentTasks {
+			return &ExceededError{Subject: subject, Limit: LimitConcurrentTasks, Used: float64(running), Max: float64(quota.MaxConcurrentTasks)}
+		}
+	}
+
+	return checkUsage(subject, quota, usage)
+}
+
+// CheckSpend returns an *ExceededError if the subject used up its spend or tokens. Unlike Check
+// it ignores the concurrent tasks.
+func (e *Enforcer) CheckSpend(ctx context.Context, subject string) error {
+	quota, err := e.Quota(ctx, subject)
+	if err != nil || quota == nil {
+		return err
+	}
+
+	usage, err := e.Usage(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	return checkUsage(subject, quota, usage)
+}
+
+func checkUsage(subject string, quota *types.Quota, usage Usage) error {
+	switch {
+	case quota.DailySpend > 0 && usage.DailySpend >= quota.DailySpend:
+		return &ExceededError{Subject: subject, Limit: LimitDailySpend, Used: usage.DailySpend, Max: quota.DailySpend, ResetAt: usage.DailyResetAt}
+	case quota.MonthlySpend > 0 && usage.MonthlySpend >= quota.MonthlySpend:
+		return &ExceededError{Subject: subject, Limit: LimitMonthlySpend, Used: usage.MonthlySpend, Max: quota.MonthlySpend, ResetAt: usage.MonthlyResetAt}
+	case quota.DailyTokens > 0 && usage.DailyTokens >= quota.DailyTokens:
+		return &ExceededError{Subject: subject, Limit: LimitDailyTokens, Used: float64(usage.DailyTokens), Max: float64(quota.DailyTokens), ResetAt: usage.DailyResetAt}
+	}
+	return nil
+}
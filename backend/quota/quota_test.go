@@ -0,0 +1,203 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/memory"
+	_ "github.com/furisto/construct/backend/memory/runtime"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	_ "modernc.org/sqlite"
+)
+
+type quotaTest struct {
+	db       *memory.Client
+	agent    *memory.Agent
+	enforcer *Enforcer
+	now      time.Time
+}
+
+func setupQuota(t *testing.T, quota types.Quota) *quotaTest {
+	t.Helper()
+	ctx := context.Background()
+
+	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Schema.Create(ctx); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	model := test.NewModelBuilder(t, uuid.New(), db, test.NewModelProviderBuilder(t, uuid.New(), db).Build(ctx)).Build(ctx)
+	agent := test.NewAgentBuilder(t, uuid.New(), db, model).Build(ctx)
+	test.NewTokenBuilder(t, uuid.New(), db).WithName("ci").WithQuota(quota).Build(ctx)
+
+	now := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	enforcer := NewEnforcer(db)
+	enforcer.now = func() time.Time { return now }
+
+	return &quotaTest{db: db, agent: agent, enforcer: enforcer, now: now}
+}
+
+func (q *quotaTest) task(t *testing.T, owner string, phase types.TaskPhase) *memory.Task {
+	return test.NewTaskBuilder(t, uuid.New(), q.db, q.agent).WithOwner(owner).WithPhase(phase).Build(context.Background())
+}
+
+func (q *quotaTest) turn(t *testing.T, task *memory.Task, at time.Time, tokens int64, cost float64) {
+	test.NewMessageBuilder(t, uuid.New(), q.db, task).
+		WithAgent(q.agent).
+		WithUsage(&types.MessageUsage{InputTokens: tokens, CacheReadTokens: 1000, Cost: cost}).
+		WithCreateTime(at).
+		Build(context.Background())
+}
+
+func TestEnforcer_Usage(t *testing.T) {
+	q := setupQuota(t, types.Quota{DailySpend: 10})
+
+	own := q.task(t, "ci", types.TaskPhaseRunning)
+	q.task(t, "ci", types.TaskPhaseAwaiting)
+	other := q.task(t, "alice", types.TaskPhaseRunning)
+
+	q.turn(t, own, q.now.Add(-time.Hour), 100, 1.5)
+	q.turn(t, own, q.now.Add(-24*time.Hour), 200, 2)
+	q.turn(t, own, q.now.AddDate(0, -1, 0), 400, 4)
+	q.turn(t, other, q.now, 800, 8)
+
+	usage, err := q.enforcer.Usage(context.Background(), "ci")
+	if err != nil {
+		t.Fatalf("Usage() error = %v", err)
+	}
+
+	want := Usage{
+		RunningTasks:   1,
+		DailySpend:     1.5,
+		MonthlySpend:   3.5,
+		DailyTokens:    100,
+		DailyResetAt:   time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC),
+		MonthlyResetAt: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+	}
+	if diff := cmp.Diff(want, usage); diff != "" {
+		t.Errorf("Usage() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEnforcer_Check(t *testing.T) {
+	tests := []struct {
+		name  string
+		quota types.Quota
+		setup func(t *testing.T, q *quotaTest) uuid.UUID
+		want  Limit
+	}{
+		{
+			name:  "within quota",
+			quota: types.Quota{MaxConcurrentTasks: 2, DailySpend: 10, MonthlySpend: 100, DailyTokens: 1000},
+			setup: func(t *testing.T, q *quotaTest) uuid.UUID {
+				q.turn(t, q.task(t, "ci", types.TaskPhaseRunning), q.now, 500, 5)
+				return uuid.Nil
+			},
+		},
+		{
+			name:  "too many running tasks",
+			quota: types.Quota{MaxConcurrentTasks: 1},
+			setup: func(t *testing.T, q *quotaTest) uuid.UUID {
+				q.task(t, "ci", types.TaskPhaseRunning)
+				return q.task(t, "ci", types.TaskPhaseAwaiting).ID
+			},
+			want: LimitConcurrentTasks,
+		},
+		{
+			name:  "running task does not count against itself",
+			quota: types.Quota{MaxConcurrentTasks: 1},
+			setup: func(t *testing.T, q *quotaTest) uuid.UUID {
+				return q.task(t, "ci", types.TaskPhaseRunning).ID
+			},
+		},
+		{
+			name:  "daily spend",
+			quota: types.Quota{DailySpend: 10},
+			setup: func(t *testing.T, q *quotaTest) uuid.UUID {
+				task := q.task(t, "ci", types.TaskPhaseAwaiting)
+				q.turn(t, task, q.now.Add(-time.Hour), 10, 6)
+				q.turn(t, task, q.now, 10, 4)
+				return task.ID
+			},
+			want: LimitDailySpend,
+		},
+		{
+			name:  "monthly spend",
+			quota: types.Quota{DailySpend: 10, MonthlySpend: 20},
+			setup: func(t *testing.T, q *quotaTest) uuid.UUID {
+				task := q.task(t, "ci", types.TaskPhaseAwaiting)
+				q.turn(t, task, q.now.AddDate(0, 0, -2), 10, 9)
+				q.turn(t, task, q.now.AddDate(0, 0, -1), 10, 9)
+				q.turn(t, task, q.now, 10, 2)
+				return task.ID
+			},
+			want: LimitMonthlySpend,
+		},
+		{
+			name:  "daily tokens",
+			quota: types.Quota{DailyTokens: 1000},
+			setup: func(t *testing.T, q *quotaTest) uuid.UUID {
+				task := q.task(t, "ci", types.TaskPhaseAwaiting)
+				q.turn(t, task, q.now, 1000, 0)
+				return task.ID
+			},
+			want: LimitDailyTokens,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := setupQuota(t, tt.quota)
+			taskID := tt.setup(t, q)
+
+			err := q.enforcer.Check(context.Background(), "ci", taskID)
+			if tt.want == "" {
+				if err != nil {
+					t.Errorf("Check() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var exceeded *ExceededError
+			if !errors.As(err, &exceeded) || exceeded.Limit != tt.want {
+				t.Errorf("Check() error = %v, want %s exceeded", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnforcer_CheckWithoutQuota(t *testing.T) {
+	q := setupQuota(t, types.Quota{MaxConcurrentTasks: 1})
+	q.task(t, "alice", types.TaskPhaseRunning)
+	q.task(t, "alice", types.TaskPhaseRunning)
+
+	for _, subject := range []string{"alice", "local-admin", ""} {
+		if err := q.enforcer.Check(context.Background(), subject, uuid.Nil); err != nil {
+			t.Errorf("Check(%q) error = %v, want nil", subject, err)
+		}
+	}
+}
+
+func TestExceededError(t *testing.T) {
+	err := &ExceededError{
+		Subject: "ci",
+		Limit:   LimitDailySpend,
+		Used:    10.5,
+		Max:     10,
+		ResetAt: time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC),
+	}
+	want := "daily spend quota of $10.00 exceeded for ci: $10.50 used, resets at 2025-03-16T00:00:00Z"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
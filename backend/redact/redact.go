@@ -0,0 +1,315 @@
+// Package redact removes secrets from the output of tools before it is stored, published to
+// subscribers or sent to a model provider.
+package redact
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// placeholderPrefix starts every placeholder. Text that already is a placeholder is not redacted
+// again.
+const placeholderPrefix = "[REDACTED:"
+
+const (
+	// minSecretLength is the length below which values are not considered secrets. Shorter exact
+	// secrets are not redacted either, they would match too much unrelated text.
+	minSecretLength = 8
+	// minAssignedEntropy is the entropy in bits per character above which a value that is
+	// assigned to a secret-like name is redacted.
+	minAssignedEntropy = 3.0
+	// minStandaloneEntropy is the entropy in bits per character above which a standalone string
+	// is redacted, if high-entropy strings are redacted at all.
+	minStandaloneEntropy = 4.0
+)
+
+// detector finds secrets of one kind. If group is set, only the text of that capturing group is
+// redacted, e.g. the value of an assignment.
+type detector struct {
+	kind    string
+	pattern *regexp.Regexp
+	group   int
+}
+
+// detectors find secrets with a well-known format, most specific first.
+var detectors = []detector{
+	{kind: "private_key", pattern: regexp.MustCompile(`-----BEGIN[A-Z ]* PRIVATE KEY-----[\s\S]*?-----END[A-Z ]* PRIVATE KEY-----`)},
+	{kind: "aws_access_key", pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{kind: "aws_secret_key", pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`), group: 1},
+	{kind: "anthropic_api_key", pattern: regexp.MustCompile(`\bsk-ant-[A-Za-z0-9_-]{20,}`)},
+	{kind: "openai_api_key", pattern: regexp.MustCompile(`\bsk-(?:proj-|svcacct-)?[A-Za-z0-9_-]{20,}`)},
+	{kind: "github_token", pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})`)},
+	{kind: "gitlab_token", pattern: regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}`)},
+	{kind: "google_api_key", pattern: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{kind: "slack_token", pattern: regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{kind: "stripe_key", pattern: regexp.MustCompile(`\b(?:sk|rk)_(?:live|test)_[A-Za-z0-9]{16,}`)},
+	{kind: "jwt", pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{kind: "construct_token", pattern: regexp.MustCompile(`\bct_[A-Za-z0-9_-]{20,}`)},
+	{kind: "webhook_secret", pattern: regexp.MustCompile(`\bwhsec_[A-Za-z0-9_-]{16,}`)},
+	{kind: "url_password", pattern: regexp.MustCompile(`\b[a-zA-Z][a-zA-Z0-9+.-]*://[^\s:/@]+:([^\s/@]+)@`), group: 1},
+}
+
+// assignmentPattern matches values that are assigned to secret-like names, e.g. in .env files,
+// the output of env or JSON and YAML configuration.
+var assignmentPattern = regexp.MustCompile(`(?i)[a-z0-9_.-]*(?:secret|token|passw(?:or)?d|api[_-]?key|access[_-]?key|private[_-]?key|credential|auth)[a-z0-9_.-]*["']?\s*[:=]\s*["']?([^\s"',;]+)`)
+
+// standalonePattern matches strings that may be random, if high-entropy strings are redacted.
+var standalonePattern = regexp.MustCompile(`[A-Za-z0-9+/_=-]{24,}`)
+
+// Options configures what a Redactor redacts in addition to secrets with a well-known format and
+// values that are assigned to secret-like names.
+type Options struct {
+	// Secrets are redacted wherever they appear, e.g. the API keys of model providers.
+	Secrets []string
+	// HighEntropyStrings also redacts standalone strings that look random. This catches secrets
+	// in unusual places, but also hashes and other random identifiers.
+	HighEntropyStrings bool
+	// Patterns match additional secrets. Only the first capturing group is redacted if the
+	// pattern has one, the whole match otherwise.
+	Patterns []*regexp.Regexp
+}
+
+// Redactor replaces secrets in text with placeholders like [REDACTED:github_token:1a2b3c4d].
+// The suffix is derived from the secret, so the same secret always gets the same placeholder and
+// the model can still tell different secrets apart. A nil Redactor redacts nothing.
+type Redactor struct {
+	secrets            []string
+	highEntropyStrings bool
+	detectors          []detector
+}
+
+func New(opts Options) *Redactor {
+	secrets := make([]string, 0, len(opts.Secrets))
+	for _, secret := range opts.Secrets {
+		if len(secret) >= minSecretLength && !slices.Contains(secrets, secret) {
+			secrets = append(secrets, secret)
+		}
+	}
+	// Longer secrets first, so that a secret that contains another one is replaced as a whole.
+	slices.SortFunc(secrets, func(a, b string) int {
+		return len(b) - len(a)
+	})
+
+	redactor := &Redactor{
+		secrets:            secrets,
+		highEntropyStrings: opts.HighEntropyStrings,
+		detectors:          slices.Clone(detectors),
+	}
+	for _, pattern := range opts.Patterns {
+		group := 0
+		if pattern.NumSubexp() > 0 {
+			group = 1
+		}
+		redactor.detectors = append(redactor.detectors, detector{kind: "custom", pattern: pattern, group: group})
+	}
+
+	return redactor
+}
+
+// CompilePatterns compiles the additional patterns of an agent.
+func CompilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// String returns the text with every secret replaced by a placeholder.
+func (r *Redactor) String(text string) string {
+	if r == nil || text == "" {
+		return text
+	}
+
+	for _, secret := range r.secrets {
+		if strings.Contains(text, secret) {
+			text = strings.ReplaceAll(text, secret, placeholder("provider_key", secret))
+		}
+	}
+
+	for _, d := range r.detectors {
+		text = replace(text, d.pattern, d.group, func(match string) (string, bool) {
+			return placeholder(d.kind, match), true
+		})
+	}
+
+	text = replace(text, assignmentPattern, 1, func(value string) (string, bool) {
+		if !looksAssignedSecret(value) {
+			return "", false
+		}
+		return placeholder("secret", value), true
+	})
+
+	if r.highEntropyStrings {
+		text = replace(text, standalonePattern, 0, func(match string) (string, bool) {
+			if !looksRandom(match) {
+				return "", false
+			}
+			return placeholder("high_entropy", match), true
+		})
+	}
+
+	return text
+}
+
+// Value redacts every string in v, which has to be a pointer to a value that can be encoded as
+// JSON, e.g. a tool result. v is left unchanged if it contains no secrets.
+func (r *Redactor) Value(v any) error {
+	if r == nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+	var generic any
+	if err := decoder.Decode(&generic); err != nil {
+		return err
+	}
+
+	redacted, changed := r.redactGeneric(generic)
+	if !changed {
+		return nil
+	}
+
+	encoded, err = json.Marshal(redacted)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, v)
+}
+
+func (r *Redactor) redactGeneric(value any) (any, bool) {
+	switch v := value.(type) {
+	case string:
+		redacted := r.String(v)
+		return redacted, redacted != v
+	case map[string]any:
+		changed := false
+		for key, item := range v {
+			redacted, itemChanged := r.redactGeneric(item)
+			if itemChanged {
+				v[key] = redacted
+				changed = true
+			}
+		}
+		return v, changed
+	case []any:
+		changed := false
+		for i, item := range v {
+			redacted, itemChanged := r.redactGeneric(item)
+			if itemChanged {
+				v[i] = redacted
+				changed = true
+			}
+		}
+		return v, changed
+	default:
+		return value, false
+	}
+}
+
+// replace replaces the matches of the pattern, or of one of its capturing groups, for which
+// replacement returns true. Matches that are already placeholders are kept.
+func replace(text string, pattern *regexp.Regexp, group int, replacement func(match string) (string, bool)) string {
+	matches := pattern.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var out strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[2*group], match[2*group+1]
+		if start < 0 || start < last {
+			continue
+		}
+
+		secret := text[start:end]
+		if strings.HasPrefix(secret, placeholderPrefix) || isInsidePlaceholder(text, start) {
+			continue
+		}
+
+		replaced, ok := replacement(secret)
+		if !ok {
+			continue
+		}
+
+		out.WriteString(text[last:start])
+		out.WriteString(replaced)
+		last = end
+	}
+	out.WriteString(text[last:])
+
+	return out.String()
+}
+
+// isInsidePlaceholder reports whether the position is part of a placeholder, e.g. the hash at the
+// end of one.
+func isInsidePlaceholder(text string, pos int) bool {
+	open := strings.LastIndex(text[:pos], placeholderPrefix)
+	if open < 0 {
+		return false
+	}
+	return !strings.Contains(text[open:pos], "]")
+}
+
+func placeholder(kind, secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return placeholderPrefix + kind + ":" + hex.EncodeToString(hash[:4]) + "]"
+}
+
+// looksAssignedSecret decides whether a value that is assigned to a secret-like name is a secret.
+// Values that look like words or references to other values, e.g. "AuthConfig" in source code or
+// "${API_KEY}" in a template, are kept.
+func looksAssignedSecret(value string) bool {
+	if len(value) < minSecretLength || strings.ContainsAny(value, "${}()<>") {
+		return false
+	}
+	if !strings.ContainsFunc(value, unicode.IsDigit) && len(value) < 20 {
+		return false
+	}
+	return entropy(value) >= minAssignedEntropy
+}
+
+// looksRandom decides whether a standalone string is random. Random strings mix upper and lower
+// case letters and digits, identifiers and words rarely do all three.
+func looksRandom(value string) bool {
+	return strings.ContainsFunc(value, unicode.IsUpper) &&
+		strings.ContainsFunc(value, unicode.IsLower) &&
+		strings.ContainsFunc(value, unicode.IsDigit) &&
+		entropy(value) >= minStandaloneEntropy
+}
+
+// entropy returns the Shannon entropy of the value in bits per character.
+func entropy(value string) float64 {
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range value {
+		counts[r]++
+		total++
+	}
+
+	var bits float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		bits -= p * math.Log2(p)
+	}
+	return bits
+}
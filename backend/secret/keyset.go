@@ -0,0 +1,156 @@
+package secret
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/tink-crypto/tink-go/aead"
+	"github.com/tink-crypto/tink-go/keyset"
+	tinkpb "github.com/tink-crypto/tink-go/proto/tink_go_proto"
+)
+
+// KeyStatus tells whether a key of a keyset is used.
+type KeyStatus string
+
+const (
+	KeyStatusEnabled   KeyStatus = "enabled"
+	KeyStatusDisabled  KeyStatus = "disabled"
+	KeyStatusDestroyed KeyStatus = "destroyed"
+)
+
+// KeyInfo describes a key of a keyset without its key material.
+type KeyInfo struct {
+	ID      uint32
+	Status  KeyStatus
+	Primary bool
+}
+
+// Keys describes the keys of a keyset.
+func Keys(keysetHandle *keyset.Handle) []KeyInfo {
+	info := keysetHandle.KeysetInfo()
+
+	keys := make([]KeyInfo, 0, len(info.GetKeyInfo()))
+	for _, key := range info.GetKeyInfo() {
+		keys = append(keys, KeyInfo{
+			ID:      key.GetKeyId(),
+			Status:  keyStatus(key.GetStatus()),
+			Primary: key.GetKeyId() == info.GetPrimaryKeyId(),
+		})
+	}
+	return keys
+}
+
+func keyStatus(status tinkpb.KeyStatusType) KeyStatus {
+	switch status {
+	case tinkpb.KeyStatusType_ENABLED:
+		return KeyStatusEnabled
+	case tinkpb.KeyStatusType_DESTROYED:
+		return KeyStatusDestroyed
+	default:
+		return KeyStatusDisabled
+	}
+}
+
+// PrimaryKeyID returns the ID of the key that encrypts new secrets.
+func PrimaryKeyID(keysetHandle *keyset.Handle) uint32 {
+	return keysetHandle.KeysetInfo().GetPrimaryKeyId()
+}
+
+// AddPrimaryKey returns a copy of the keyset with a new primary key. The previous keys stay
+// enabled, so that secrets that were encrypted with them can still be decrypted.
+func AddPrimaryKey(keysetHandle *keyset.Handle) (*keyset.Handle, error) {
+	manager, err := managerForCopy(keysetHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := manager.Add(aead.AES256GCMKeyTemplate())
+	if err != nil {
+		return nil, fmt.Errorf("failed to add key: %w", err)
+	}
+	if err := manager.SetPrimary(keyID); err != nil {
+		return nil, fmt.Errorf("failed to set primary key: %w", err)
+	}
+
+	return manager.Handle()
+}
+
+// DisableSecondaryKeys returns a copy of the keyset in which only the primary key is enabled.
+func DisableSecondaryKeys(keysetHandle *keyset.Handle) (*keyset.Handle, error) {
+	manager, err := managerForCopy(keysetHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	primaryKeyID := PrimaryKeyID(keysetHandle)
+	for _, key := range Keys(keysetHandle) {
+		if key.ID == primaryKeyID || key.Status != KeyStatusEnabled {
+			continue
+		}
+		if err := manager.Disable(key.ID); err != nil {
+			return nil, fmt.Errorf("failed to disable key %d: %w", key.ID, err)
+		}
+	}
+
+	return manager.Handle()
+}
+
+// managerForCopy returns a manager for a copy of the keyset. A manager changes the keyset of the
+// handle it was created from, which may still be in use.
+func managerForCopy(keysetHandle *keyset.Handle) (*keyset.Manager, error) {
+	keysetJSON, err := KeysetToJSON(keysetHandle)
+	if err != nil {
+		return nil, err
+	}
+
+	keysetCopy, err := KeysetFromJSON(keysetJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyset.NewManagerFromHandle(keysetCopy), nil
+}
+
+// KeysetStore keeps the encryption keyset in a secret provider, so that it survives restarts of
+// the daemon.
+type KeysetStore struct {
+	provider Provider
+}
+
+func NewKeysetStore(provider Provider) *KeysetStore {
+	return &KeysetStore{provider: provider}
+}
+
+// LoadOrGenerate loads the stored keyset. A new keyset is generated and stored if there is none.
+func (s *KeysetStore) LoadOrGenerate() (*keyset.Handle, error) {
+	keysetJSON, err := s.provider.Get(EncryptionKeySecret())
+	if err == nil {
+		return KeysetFromJSON(keysetJSON)
+	}
+	if !errors.Is(err, &ErrSecretNotFound{}) {
+		return nil, fmt.Errorf("failed to get encryption key secret: %w", err)
+	}
+
+	keysetHandle, err := GenerateKeyset()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate encryption keyset: %w", err)
+	}
+	if err := s.Save(keysetHandle); err != nil {
+		return nil, err
+	}
+
+	return keysetHandle, nil
+}
+
+// Save replaces the stored keyset.
+func (s *KeysetStore) Save(keysetHandle *keyset.Handle) error {
+	keysetJSON, err := KeysetToJSON(keysetHandle)
+	if err != nil {
+		return err
+	}
+
+	if err := s.provider.Set(EncryptionKeySecret(), keysetJSON); err != nil {
+		return fmt.Errorf("failed to store encryption key secret: %w", err)
+	}
+	return nil
+}
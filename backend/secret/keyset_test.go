@@ -0,0 +1,122 @@
+package secret
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/afero"
+)
+
+func TestKeyRotation(t *testing.T) {
+	keysetHandle, err := GenerateKeyset()
+	if err != nil {
+		t.Fatalf("Failed to generate keyset: %v", err)
+	}
+	previousKeyID := PrimaryKeyID(keysetHandle)
+
+	client, err := NewClient(keysetHandle)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	plaintext := []byte("This is a secret message")
+	associatedData := []byte("additional data")
+
+	oldCiphertext, err := client.Encrypt(plaintext, associatedData)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	rotated, err := AddPrimaryKey(keysetHandle)
+	if err != nil {
+		t.Fatalf("Failed to add primary key: %v", err)
+	}
+	if PrimaryKeyID(rotated) == previousKeyID {
+		t.Fatal("Rotated keyset should have a new primary key")
+	}
+	if PrimaryKeyID(keysetHandle) != previousKeyID || len(Keys(keysetHandle)) != 1 {
+		t.Fatal("Adding a key should not change the original keyset")
+	}
+
+	if err := client.SetKeyset(rotated); err != nil {
+		t.Fatalf("Failed to set keyset: %v", err)
+	}
+
+	if _, err := client.Decrypt(oldCiphertext, associatedData); err != nil {
+		t.Fatalf("Decryption with previous key failed: %v", err)
+	}
+
+	newCiphertext, err := client.Encrypt(plaintext, associatedData)
+	if err != nil {
+		t.Fatalf("Encryption failed: %v", err)
+	}
+
+	primaryOnly, err := DisableSecondaryKeys(rotated)
+	if err != nil {
+		t.Fatalf("Failed to disable secondary keys: %v", err)
+	}
+
+	for _, key := range Keys(primaryOnly) {
+		wantStatus := KeyStatusDisabled
+		if key.Primary {
+			wantStatus = KeyStatusEnabled
+		}
+		if key.Status != wantStatus {
+			t.Errorf("Key %d has status %s, want %s", key.ID, key.Status, wantStatus)
+		}
+	}
+
+	primaryOnlyClient, err := NewClient(primaryOnly)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	decrypted, err := primaryOnlyClient.Decrypt(newCiphertext, associatedData)
+	if err != nil {
+		t.Fatalf("Decryption with new primary key failed: %v", err)
+	}
+	if !bytes.Equal(decrypted, plaintext) {
+		t.Fatal("Decrypted data doesn't match original plaintext")
+	}
+
+	if _, err := primaryOnlyClient.Decrypt(oldCiphertext, associatedData); err == nil {
+		t.Fatal("Decryption with disabled key should fail")
+	}
+}
+
+func TestKeysetStore(t *testing.T) {
+	provider, err := NewFileProvider("/secrets", afero.NewMemMapFs())
+	if err != nil {
+		t.Fatalf("Failed to create file provider: %v", err)
+	}
+	store := NewKeysetStore(provider)
+
+	generated, err := store.LoadOrGenerate()
+	if err != nil {
+		t.Fatalf("Failed to generate keyset: %v", err)
+	}
+
+	loaded, err := store.LoadOrGenerate()
+	if err != nil {
+		t.Fatalf("Failed to load keyset: %v", err)
+	}
+	if PrimaryKeyID(loaded) != PrimaryKeyID(generated) {
+		t.Fatal("Loaded keyset doesn't match generated keyset")
+	}
+
+	rotated, err := AddPrimaryKey(loaded)
+	if err != nil {
+		t.Fatalf("Failed to add primary key: %v", err)
+	}
+	if err := store.Save(rotated); err != nil {
+		t.Fatalf("Failed to save keyset: %v", err)
+	}
+
+	loaded, err = store.LoadOrGenerate()
+	if err != nil {
+		t.Fatalf("Failed to load keyset: %v", err)
+	}
+	if PrimaryKeyID(loaded) != PrimaryKeyID(rotated) || len(Keys(loaded)) != 2 {
+		t.Fatal("Loaded keyset doesn't match saved keyset")
+	}
+}
@@ -22,6 +22,10 @@ func ModelProviderAssociated(id uuid.UUID) []byte {
 	return []byte(fmt.Sprintf("model_provider:%s", id))
 }
 
+func WebhookAssociated(id uuid.UUID) []byte {
+	return []byte(fmt.Sprintf("webhook:%s", id))
+}
+
 func EncryptionKeySecret() string {
 	return "encryption_key"
 }
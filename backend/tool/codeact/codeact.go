@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/redact"
 	"github.com/furisto/construct/shared"
 	"github.com/google/uuid"
 	"github.com/grafana/sobek"
@@ -71,6 +72,9 @@ func UnsetValue(s *Session, key string) {
 type Task struct {
 	ID               uuid.UUID
 	ProjectDirectory string
+	// Redactor removes secrets from tool results before they are published. Nothing is redacted
+	// if it is nil.
+	Redactor *redact.Redactor
 }
 
 type CodeActToolHandler func(session *Session) func(call sobek.FunctionCall) sobek.Value
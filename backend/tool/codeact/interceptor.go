@@ -2,11 +2,17 @@ package codeact
 
 import (
 	"log/slog"
+	"time"
 
 	"github.com/furisto/construct/backend/tool/base"
 	tooltypes "github.com/furisto/construct/backend/tool/types"
 	"github.com/google/uuid"
 	"github.com/grafana/sobek"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // EventPublisher is the interface for publishing tool events.
@@ -117,6 +123,79 @@ func ToolStatisticsInterceptor(session *Session, tool Tool, inner func(sobek.Fun
 	}
 }
 
+// ToolMetricsInterceptor counts the tool calls of scripts and measures how long they take. Tools
+// fail by throwing, so a call that panics is counted as an error before the panic continues.
+type ToolMetricsInterceptor struct {
+	calls    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewToolMetricsInterceptor(registry prometheus.Registerer) *ToolMetricsInterceptor {
+	i := &ToolMetricsInterceptor{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: "tool",
+			Name:      "calls_total",
+			Help:      "Total number of tool calls by tool and result",
+		}, []string{"tool", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Subsystem: "tool",
+			Name:      "call_duration_seconds",
+			Help:      "How long in seconds a tool call took",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 4, 10),
+		}, []string{"tool"}),
+	}
+
+	registry.MustRegister(i.calls, i.duration)
+	return i
+}
+
+func (i *ToolMetricsInterceptor) Intercept(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if tool.Name() == base.ToolNamePrint {
+			return inner(call)
+		}
+
+		start := time.Now()
+		result := "error"
+		defer func() {
+			i.duration.WithLabelValues(tool.Name()).Observe(time.Since(start).Seconds())
+			i.calls.WithLabelValues(tool.Name(), result).Inc()
+		}()
+
+		value := inner(call)
+		result = "success"
+		return value
+	}
+}
+
+var tracer = otel.Tracer("github.com/furisto/construct/backend/tool/codeact")
+
+// ToolTracingInterceptor traces every tool call of a script as a span of the context the script
+// runs in.
+func ToolTracingInterceptor(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
+	return func(call sobek.FunctionCall) sobek.Value {
+		if tool.Name() == base.ToolNamePrint {
+			return inner(call)
+		}
+
+		_, span := tracer.Start(session.Context, "tool_call", trace.WithAttributes(
+			attribute.String("tool", tool.Name()),
+			attribute.String("task.id", session.Task.ID.String()),
+		))
+		failed := true
+		defer func() {
+			if failed {
+				span.SetStatus(codes.Error, "tool call failed")
+			}
+			span.End()
+		}()
+
+		value := inner(call)
+		failed = false
+		return value
+	}
+}
+
 func ResetTemporarySessionValuesInterceptor(session *Session, tool Tool, inner func(sobek.FunctionCall) sobek.Value) func(sobek.FunctionCall) sobek.Value {
 	return func(call sobek.FunctionCall) sobek.Value {
 		UnsetValue(session, "result")
@@ -162,6 +241,8 @@ func (p *ToolEventPublisher) Intercept(session *Session, tool Tool, inner func(s
 				toolOutput, err := tooltypes.ToolOutputFrom(raw)
 				if err != nil {
 					slog.Error("failed to convert tool output", "error", err)
+				} else if err := session.Task.Redactor.Value(&toolOutput); err != nil {
+					slog.Error("failed to redact tool output", "error", err)
 				} else {
 					toolResultEvent := tooltypes.ToolResultEvent{
 						Tool:   tool.Name(),
@@ -0,0 +1,425 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/backend/api/conv"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	memory_webhook "github.com/furisto/construct/backend/memory/webhook"
+	"github.com/furisto/construct/backend/memory/webhookdelivery"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// EventHeader carries the type of the delivered event.
+	EventHeader = "X-Construct-Event"
+	// DeliveryHeader carries the ID of the delivery. It stays the same across retries.
+	DeliveryHeader = "X-Construct-Delivery"
+
+	DefaultMaxAttempts       = 8
+	DefaultInitialBackoff    = 10 * time.Second
+	DefaultMaxBackoff        = time.Hour
+	DefaultRequestTimeout    = 10 * time.Second
+	DefaultDeliveryRetention = 7 * 24 * time.Hour
+
+	pollInterval      = time.Second
+	pruneInterval     = time.Hour
+	deliveryBatchSize = 20
+	// maxErrorBodySize limits how much of the response of a failed attempt is kept.
+	maxErrorBodySize = 512
+)
+
+type Options struct {
+	// MaxAttempts is the number of attempts after which a delivery is dead lettered.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. It doubles with every further retry
+	// up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// RequestTimeout bounds a single delivery attempt.
+	RequestTimeout time.Duration
+	// DeliveryRetention is how long the history of finished deliveries is kept.
+	DeliveryRetention time.Duration
+	HTTPClient        *http.Client
+}
+
+func DefaultOptions() Options {
+	return Options{
+		MaxAttempts:       DefaultMaxAttempts,
+		InitialBackoff:    DefaultInitialBackoff,
+		MaxBackoff:        DefaultMaxBackoff,
+		RequestTimeout:    DefaultRequestTimeout,
+		DeliveryRetention: DefaultDeliveryRetention,
+		HTTPClient:        http.DefaultClient,
+	}
+}
+
+// Dispatcher pushes the events of the event router to the webhooks that match them. Every event
+// is queued as a delivery in the database first, so that deliveries survive restarts and can be
+// retried until the receiver acknowledges them.
+type Dispatcher struct {
+	db          *memory.Client
+	encryption  *secret.Encryption
+	eventRouter *event.EventRouter
+	options     Options
+	wakeup      chan struct{}
+}
+
+func NewDispatcher(db *memory.Client, encryption *secret.Encryption, eventRouter *event.EventRouter, options Options) *Dispatcher {
+	defaults := DefaultOptions()
+	if options.MaxAttempts <= 0 {
+		options.MaxAttempts = defaults.MaxAttempts
+	}
+	if options.InitialBackoff <= 0 {
+		options.InitialBackoff = defaults.InitialBackoff
+	}
+	if options.MaxBackoff <= 0 {
+		options.MaxBackoff = defaults.MaxBackoff
+	}
+	if options.RequestTimeout <= 0 {
+		options.RequestTimeout = defaults.RequestTimeout
+	}
+	if options.DeliveryRetention <= 0 {
+		options.DeliveryRetention = defaults.DeliveryRetention
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = defaults.HTTPClient
+	}
+
+	return &Dispatcher{
+		db:          db,
+		encryption:  encryption,
+		eventRouter: eventRouter,
+		options:     options,
+		wakeup:      make(chan struct{}, 1),
+	}
+}
+
+// Run queues the published events for delivery and delivers them until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	// The subscription is resumed from the event log, so that no event is missed if the
+	// dispatcher falls behind.
+	after := d.eventRouter.LastSequence()
+	events, cancel := d.eventRouter.Subscribe(ctx, event.SubscribeOptions{
+		Subscriber:          "webhook",
+		ResumeAfterSequence: &after,
+	})
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		d.deliverLoop(ctx)
+	}()
+
+	for e := range events {
+		if err := d.enqueue(ctx, e); err != nil {
+			slog.Error("failed to queue event for webhooks", "event_type", e.Type, "error", err)
+		}
+	}
+
+	wg.Wait()
+}
+
+func (d *Dispatcher) deliverLoop(ctx context.Context) {
+	poll := time.NewTicker(pollInterval)
+	defer poll.Stop()
+	prune := time.NewTicker(pruneInterval)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.wakeup:
+		case <-poll.C:
+		case <-prune.C:
+			if err := d.prune(ctx); err != nil {
+				slog.Error("failed to prune webhook deliveries", "error", err)
+			}
+			continue
+		}
+
+		if err := d.deliverDue(ctx); err != nil && ctx.Err() == nil {
+			slog.Error("failed to deliver webhooks", "error", err)
+		}
+	}
+}
+
+// enqueue creates a delivery of the event for every enabled webhook that matches it.
+func (d *Dispatcher) enqueue(ctx context.Context, e *event.StreamEvent) error {
+	if e.Type == event.EventTypeStreamGap {
+		if gap, ok := e.Payload.(*event.StreamGapPayload); ok {
+			slog.Warn("events were deleted from the event log before they could be delivered to webhooks",
+				"from_sequence", gap.FromSequence,
+				"to_sequence", gap.ToSequence,
+			)
+		}
+		return nil
+	}
+
+	webhooks, err := d.db.Webhook.Query().Where(memory_webhook.Enabled(true)).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	var (
+		task       *memory.Task
+		taskLoaded bool
+	)
+	taskOf := func() *memory.Task {
+		if !taskLoaded && e.TaskID != nil {
+			task, _ = d.db.Task.Get(ctx, *e.TaskID)
+			taskLoaded = true
+		}
+		return task
+	}
+
+	var matching []*memory.Webhook
+	for _, w := range webhooks {
+		if !event.MatchesAny(w.EventTypes, e.Type) {
+			continue
+		}
+		if w.TaskID != nil && (e.TaskID == nil || *e.TaskID != *w.TaskID) {
+			continue
+		}
+		if e.TaskID != nil && !canSeeTask(w, taskOf()) {
+			continue
+		}
+		matching = append(matching, w)
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	protoEvent, err := conv.ConvertStreamEventToProto(e)
+	if err != nil {
+		return err
+	}
+	payload, err := protojson.Marshal(protoEvent)
+	if err != nil {
+		return err
+	}
+
+	var (
+		agentID       uuid.UUID
+		agentResolved bool
+	)
+	now := time.Now()
+	creates := make([]*memory.WebhookDeliveryCreate, 0, len(matching))
+	for _, w := range matching {
+		if w.AgentID != nil {
+			if !agentResolved {
+				agentID = agentOf(taskOf(), protoEvent)
+				agentResolved = true
+			}
+			if agentID != *w.AgentID {
+				continue
+			}
+		}
+
+		creates = append(creates, d.db.WebhookDelivery.Create().
+			SetWebhookID(w.ID).
+			SetEventType(e.Type).
+			SetEventSequence(e.Sequence).
+			SetPayload(payload).
+			SetNextAttemptTime(now),
+		)
+	}
+	if len(creates) == 0 {
+		return nil
+	}
+
+	if err := d.db.WebhookDelivery.CreateBulk(creates...).Exec(ctx); err != nil {
+		return err
+	}
+
+	select {
+	case d.wakeup <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// canSeeTask reports whether the owner of a webhook may see the events of a task, that is, whether
+// they own the task or it is shared with them. This is the same visibility subscribers of the
+// event stream get. Webhooks of admins and webhooks without an owner see all tasks.
+func canSeeTask(w *memory.Webhook, task *memory.Task) bool {
+	if w.Admin || w.Owner == "" {
+		return true
+	}
+	if task == nil {
+		return false
+	}
+	return task.Owner == w.Owner || slices.Contains(task.SharedWith, w.Owner)
+}
+
+// agentOf returns the agent an event belongs to, either because it is about the agent itself
+// or about its task. It returns uuid.Nil if the event is not related to an agent.
+func agentOf(task *memory.Task, protoEvent *v1.Event) uuid.UUID {
+	if payload, ok := protoEvent.Payload.(*v1.Event_Agent); ok {
+		if id, err := uuid.Parse(payload.Agent.GetAgent().GetMetadata().GetId()); err == nil {
+			return id
+		}
+	}
+
+	if task == nil {
+		return uuid.Nil
+	}
+	return task.AgentID
+}
+
+// deliverDue attempts all deliveries that are due.
+func (d *Dispatcher) deliverDue(ctx context.Context) error {
+	for {
+		deliveries, err := d.db.WebhookDelivery.Query().
+			Where(
+				webhookdelivery.StateEQ(types.WebhookDeliveryStatePending),
+				webhookdelivery.NextAttemptTimeLTE(time.Now()),
+				webhookdelivery.HasWebhookWith(memory_webhook.Enabled(true)),
+			).
+			WithWebhook().
+			Order(webhookdelivery.ByNextAttemptTime(), webhookdelivery.ByCreateTime()).
+			Limit(deliveryBatchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Webhooks are delivered to concurrently, so that a slow or unreachable receiver does not
+		// hold up the others. The deliveries of a webhook are still attempted in order.
+		var webhooks []uuid.UUID
+		byWebhook := make(map[uuid.UUID][]*memory.WebhookDelivery)
+		for _, delivery := range deliveries {
+			if _, ok := byWebhook[delivery.WebhookID]; !ok {
+				webhooks = append(webhooks, delivery.WebhookID)
+			}
+			byWebhook[delivery.WebhookID] = append(byWebhook[delivery.WebhookID], delivery)
+		}
+
+		group, groupCtx := errgroup.WithContext(ctx)
+		for _, webhookID := range webhooks {
+			group.Go(func() error {
+				for _, delivery := range byWebhook[webhookID] {
+					if err := d.attempt(groupCtx, delivery); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		}
+		if err := group.Wait(); err != nil {
+			return err
+		}
+
+		if len(deliveries) < deliveryBatchSize {
+			return nil
+		}
+	}
+}
+
+// attempt posts a delivery to its webhook and records the outcome.
+func (d *Dispatcher) attempt(ctx context.Context, delivery *memory.WebhookDelivery) error {
+	now := time.Now()
+	statusCode, err := d.post(ctx, delivery)
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	attempts := delivery.Attempts + 1
+	update := d.db.WebhookDelivery.UpdateOne(delivery).
+		SetAttempts(attempts).
+		SetLastAttemptTime(now).
+		SetResponseStatusCode(statusCode)
+
+	switch {
+	case err == nil:
+		update = update.SetState(types.WebhookDeliveryStateSucceeded).ClearLastError()
+	case attempts >= d.options.MaxAttempts:
+		slog.Warn("webhook delivery failed permanently",
+			"webhook_id", delivery.WebhookID,
+			"delivery_id", delivery.ID,
+			"attempts", attempts,
+			"error", err,
+		)
+		update = update.SetState(types.WebhookDeliveryStateDeadLetter).SetLastError(err.Error())
+	default:
+		update = update.SetNextAttemptTime(now.Add(d.backoff(attempts))).SetLastError(err.Error())
+	}
+
+	return update.Exec(ctx)
+}
+
+// post sends the payload of a delivery and returns the status code of the response.
+func (d *Dispatcher) post(ctx context.Context, delivery *memory.WebhookDelivery) (int, error) {
+	w := delivery.Edges.Webhook
+	signingSecret, err := d.encryption.Decrypt(w.Secret, secret.WebhookAssociated(w.ID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.options.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "construct-webhook")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Sign(signingSecret, time.Now(), delivery.Payload))
+
+	resp, err := d.options.HTTPClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		if len(bytes.TrimSpace(body)) == 0 {
+			return resp.StatusCode, fmt.Errorf("receiver responded with %s", resp.Status)
+		}
+		return resp.StatusCode, fmt.Errorf("receiver responded with %s: %s", resp.Status, bytes.TrimSpace(body))
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
+// backoff returns the delay before the attempt that follows the given number of attempts.
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.options.InitialBackoff
+	for i := 1; i < attempts && delay < d.options.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.options.MaxBackoff)
+}
+
+// prune deletes the history of finished deliveries that are past their retention.
+func (d *Dispatcher) prune(ctx context.Context) error {
+	_, err := d.db.WebhookDelivery.Delete().
+		Where(
+			webhookdelivery.StateNEQ(types.WebhookDeliveryStatePending),
+			webhookdelivery.UpdateTimeLT(time.Now().Add(-d.options.DeliveryRetention)),
+		).
+		Exec(ctx)
+	return err
+}
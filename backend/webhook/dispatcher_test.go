@@ -0,0 +1,477 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/furisto/construct/backend/event"
+	"github.com/furisto/construct/backend/memory"
+	_ "github.com/furisto/construct/backend/memory/runtime"
+	"github.com/furisto/construct/backend/memory/schema/types"
+	"github.com/furisto/construct/backend/memory/test"
+	"github.com/furisto/construct/backend/secret"
+	"github.com/google/uuid"
+)
+
+var testSecret = []byte("whsec_test-secret")
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver is an httptest server that records the deliveries it receives and responds with status.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []receivedRequest
+	received chan struct{}
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	t.Helper()
+	r := &receiver{status: status, received: make(chan struct{}, 100)}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{header: req.Header.Clone(), body: body})
+		status := r.status
+		r.mu.Unlock()
+		w.WriteHeader(status)
+		r.received <- struct{}{}
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) setStatus(status int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.status = status
+}
+
+func (r *receiver) recorded() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest(nil), r.requests...)
+}
+
+type dispatcherTest struct {
+	db         *memory.Client
+	encryption *secret.Encryption
+	dispatcher *Dispatcher
+}
+
+func setupDispatcher(t *testing.T, router *event.EventRouter, options Options) *dispatcherTest {
+	t.Helper()
+	db, err := memory.Open(dialect.SQLite, "file:construct_test?mode=memory&cache=private&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if err := db.Schema.Create(context.Background()); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+
+	keyset, err := secret.GenerateKeyset()
+	if err != nil {
+		t.Fatalf("failed to generate keyset: %v", err)
+	}
+	encryption, err := secret.NewClient(keyset)
+	if err != nil {
+		t.Fatalf("failed to create encryption client: %v", err)
+	}
+
+	return &dispatcherTest{
+		db:         db,
+		encryption: encryption,
+		dispatcher: NewDispatcher(db, encryption, router, options),
+	}
+}
+
+func (d *dispatcherTest) createWebhook(t *testing.T, url string, configure func(*memory.WebhookCreate)) *memory.Webhook {
+	t.Helper()
+	id := uuid.New()
+	encrypted, err := d.encryption.Encrypt(testSecret, secret.WebhookAssociated(id))
+	if err != nil {
+		t.Fatalf("failed to encrypt secret: %v", err)
+	}
+
+	create := d.db.Webhook.Create().SetID(id).SetURL(url).SetSecret(encrypted)
+	if configure != nil {
+		configure(create)
+	}
+	webhook, err := create.Save(context.Background())
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+	return webhook
+}
+
+func (d *dispatcherTest) createTask(t *testing.T) *memory.Task {
+	t.Helper()
+	ctx := context.Background()
+	provider := test.NewModelProviderBuilder(t, uuid.New(), d.db).Build(ctx)
+	model := test.NewModelBuilder(t, uuid.New(), d.db, provider).Build(ctx)
+	agentID := uuid.New()
+	agent := test.NewAgentBuilder(t, agentID, d.db, model).WithName(agentID.String()).Build(ctx)
+	return test.NewTaskBuilder(t, uuid.New(), d.db, agent).Build(ctx)
+}
+
+func (d *dispatcherTest) deliveries(t *testing.T) []*memory.WebhookDelivery {
+	t.Helper()
+	deliveries, err := d.db.WebhookDelivery.Query().All(context.Background())
+	if err != nil {
+		t.Fatalf("failed to query deliveries: %v", err)
+	}
+	return deliveries
+}
+
+func TestDispatcher_DeliversSignedEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	router := event.NewEventRouter(event.DefaultChannelBufferSize)
+	defer router.Close()
+	d := setupDispatcher(t, router, DefaultOptions())
+	recv := newReceiver(t, http.StatusNoContent)
+
+	taskID := uuid.New()
+	webhook := d.createWebhook(t, recv.URL, func(c *memory.WebhookCreate) {
+		c.SetEventTypes([]string{"message.*"}).SetTaskID(taskID)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		d.dispatcher.Run(ctx)
+		close(done)
+	}()
+	// Give the dispatcher a moment to subscribe before events are published.
+	time.Sleep(50 * time.Millisecond)
+
+	router.Publish(event.NewAgentDeletedEvent(uuid.New()))
+	router.Publish(event.NewMessageChunkEvent(uuid.New(), uuid.New(), "other task", 0))
+	router.Publish(event.NewMessageChunkEvent(taskID, uuid.New(), "hello", 0))
+
+	select {
+	case <-recv.received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for delivery")
+	}
+
+	requests := recv.recorded()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+
+	if err := VerifySignature(testSecret, req.header.Get(SignatureHeader), req.body, time.Now(), DefaultSignatureTolerance); err != nil {
+		t.Errorf("VerifySignature() error = %v", err)
+	}
+	if got := req.header.Get(EventHeader); got != event.EventTypeMessageChunk {
+		t.Errorf("%s = %q, want %q", EventHeader, got, event.EventTypeMessageChunk)
+	}
+	if got := req.header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", got)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(req.body, &body); err != nil {
+		t.Fatalf("payload is not JSON: %v", err)
+	}
+	if body["type"] != event.EventTypeMessageChunk {
+		t.Errorf("type = %v, want %s", body["type"], event.EventTypeMessageChunk)
+	}
+
+	// The outcome of the attempt is recorded after the receiver responded.
+	var delivery *memory.WebhookDelivery
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		deliveries := d.deliveries(t)
+		if len(deliveries) != 1 {
+			t.Fatalf("got %d deliveries, want 1", len(deliveries))
+		}
+		delivery = deliveries[0]
+		if delivery.State != types.WebhookDeliveryStatePending || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	cancel()
+	<-done
+
+	if delivery.WebhookID != webhook.ID || delivery.State != types.WebhookDeliveryStateSucceeded || delivery.Attempts != 1 {
+		t.Errorf("unexpected delivery: webhook %s, state %s, attempts %d", delivery.WebhookID, delivery.State, delivery.Attempts)
+	}
+	if req.header.Get(DeliveryHeader) != delivery.ID.String() {
+		t.Errorf("%s = %q, want %s", DeliveryHeader, req.header.Get(DeliveryHeader), delivery.ID)
+	}
+	if delivery.ResponseStatusCode != http.StatusNoContent {
+		t.Errorf("response status code = %d, want %d", delivery.ResponseStatusCode, http.StatusNoContent)
+	}
+}
+
+func TestDispatcher_RetriesWithBackoffAndDeadLetters(t *testing.T) {
+	ctx := context.Background()
+	d := setupDispatcher(t, nil, Options{
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+		MaxBackoff:     90 * time.Second,
+	})
+	recv := newReceiver(t, http.StatusInternalServerError)
+	d.createWebhook(t, recv.URL, nil)
+
+	if err := d.dispatcher.enqueue(ctx, event.NewAgentDeletedEvent(uuid.New())); err != nil {
+		t.Fatalf("enqueue() error = %v", err)
+	}
+
+	// makeDue moves the next attempt of the delivery into the past and returns the delay that was scheduled.
+	makeDue := func(delivery *memory.WebhookDelivery) time.Duration {
+		t.Helper()
+		delay := delivery.NextAttemptTime.Sub(*delivery.LastAttemptTime)
+		if err := d.db.WebhookDelivery.UpdateOne(delivery).SetNextAttemptTime(time.Now()).Exec(ctx); err != nil {
+			t.Fatalf("failed to reschedule delivery: %v", err)
+		}
+		return delay
+	}
+
+	var delays []time.Duration
+	for attempt := 1; attempt <= 3; attempt++ {
+		if err := d.dispatcher.deliverDue(ctx); err != nil {
+			t.Fatalf("deliverDue() error = %v", err)
+		}
+		// A delivery that is not due yet must not be attempted.
+		if err := d.dispatcher.deliverDue(ctx); err != nil {
+			t.Fatalf("deliverDue() error = %v", err)
+		}
+
+		delivery := d.deliveries(t)[0]
+		if delivery.Attempts != attempt {
+			t.Fatalf("attempts = %d, want %d", delivery.Attempts, attempt)
+		}
+		if delivery.ResponseStatusCode != http.StatusInternalServerError || delivery.LastError == "" {
+			t.Errorf("failed attempt was not recorded: status %d, error %q", delivery.ResponseStatusCode, delivery.LastError)
+		}
+		if attempt < 3 {
+			if delivery.State != types.WebhookDeliveryStatePending {
+				t.Fatalf("state after attempt %d = %s, want pending", attempt, delivery.State)
+			}
+			delays = append(delays, makeDue(delivery))
+		} else if delivery.State != types.WebhookDeliveryStateDeadLetter {
+			t.Fatalf("state after last attempt = %s, want dead_letter", delivery.State)
+		}
+	}
+
+	if len(recv.recorded()) != 3 {
+		t.Errorf("receiver got %d requests, want 3", len(recv.recorded()))
+	}
+	if delays[0] != time.Minute || delays[1] != 90*time.Second {
+		t.Errorf("backoff delays = %v, want [1m0s 1m30s]", delays)
+	}
+
+	// Dead lettered deliveries are not retried until they are redelivered.
+	recv.setStatus(http.StatusOK)
+	if err := d.dispatcher.deliverDue(ctx); err != nil {
+		t.Fatalf("deliverDue() error = %v", err)
+	}
+	if len(recv.recorded()) != 3 {
+		t.Errorf("dead lettered delivery was retried")
+	}
+}
+
+func TestDispatcher_SlowReceiverDoesNotHoldUpOthers(t *testing.T) {
+	ctx := context.Background()
+	d := setupDispatcher(t, nil, Options{RequestTimeout: 10 * time.Second})
+
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(slow.Close)
+	// Closed before the server, whose Close waits for the blocked request.
+	t.Cleanup(func() {
+		select {
+		case <-release:
+		default:
+			close(release)
+		}
+	})
+	fast := newReceiver(t, http.StatusOK)
+
+	d.createWebhook(t, slow.URL, nil)
+	d.createWebhook(t, fast.URL, nil)
+	for range 2 {
+		if err := d.dispatcher.enqueue(ctx, event.NewAgentDeletedEvent(uuid.New())); err != nil {
+			t.Fatalf("enqueue() error = %v", err)
+		}
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- d.dispatcher.deliverDue(ctx) }()
+
+	for range 2 {
+		select {
+		case <-fast.received:
+		case <-time.After(2 * time.Second):
+			t.Fatalf("fast receiver was held up by the slow receiver, got %d deliveries", len(fast.recorded()))
+		}
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("deliverDue() error = %v", err)
+	}
+
+	for _, delivery := range d.deliveries(t) {
+		if delivery.State != types.WebhookDeliveryStateSucceeded {
+			t.Errorf("delivery %s to webhook %s is %s, want succeeded", delivery.ID, delivery.WebhookID, delivery.State)
+		}
+	}
+}
+
+func TestDispatcher_FiltersByAgent(t *testing.T) {
+	ctx := context.Background()
+	d := setupDispatcher(t, nil, DefaultOptions())
+	task := d.createTask(t)
+	other := d.createTask(t)
+
+	webhook := d.createWebhook(t, "http://127.0.0.1:1/hook", func(c *memory.WebhookCreate) {
+		c.SetAgentID(task.AgentID)
+	})
+	d.createWebhook(t, "http://127.0.0.1:1/disabled", func(c *memory.WebhookCreate) {
+		c.SetEnabled(false)
+	})
+
+	for _, e := range []*event.StreamEvent{
+		event.NewMessageChunkEvent(task.ID, uuid.New(), "match", 0),
+		event.NewMessageChunkEvent(other.ID, uuid.New(), "other agent", 0),
+		event.NewModelDeletedEvent(uuid.New()),
+	} {
+		if err := d.dispatcher.enqueue(ctx, e); err != nil {
+			t.Fatalf("enqueue() error = %v", err)
+		}
+	}
+
+	deliveries := d.deliveries(t)
+	if len(deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(deliveries))
+	}
+	if deliveries[0].WebhookID != webhook.ID || deliveries[0].EventType != event.EventTypeMessageChunk {
+		t.Errorf("unexpected delivery of %s to %s", deliveries[0].EventType, deliveries[0].WebhookID)
+	}
+}
+
+func TestDispatcher_OnlyDeliversEventsOfVisibleTasks(t *testing.T) {
+	ctx := context.Background()
+	d := setupDispatcher(t, nil, DefaultOptions())
+	own := d.createTask(t)
+	shared := d.createTask(t)
+	foreign := d.createTask(t)
+	d.db.Task.UpdateOne(own).SetOwner("alice").ExecX(ctx)
+	d.db.Task.UpdateOne(shared).SetOwner("bob").SetSharedWith([]string{"alice"}).ExecX(ctx)
+	d.db.Task.UpdateOne(foreign).SetOwner("bob").ExecX(ctx)
+
+	alice := d.createWebhook(t, "http://127.0.0.1:1/alice", func(c *memory.WebhookCreate) {
+		c.SetOwner("alice")
+	})
+	admin := d.createWebhook(t, "http://127.0.0.1:1/admin", func(c *memory.WebhookCreate) {
+		c.SetOwner("local-admin").SetAdmin(true)
+	})
+
+	for _, e := range []*event.StreamEvent{
+		event.NewMessageChunkEvent(own.ID, uuid.New(), "own", 0),
+		event.NewMessageChunkEvent(shared.ID, uuid.New(), "shared", 0),
+		event.NewMessageChunkEvent(foreign.ID, uuid.New(), "foreign", 0),
+	} {
+		if err := d.dispatcher.enqueue(ctx, e); err != nil {
+			t.Fatalf("enqueue() error = %v", err)
+		}
+	}
+
+	got := map[uuid.UUID]int{}
+	for _, delivery := range d.deliveries(t) {
+		got[delivery.WebhookID]++
+	}
+	if got[alice.ID] != 2 {
+		t.Errorf("webhook of alice got %d deliveries, want 2 for her own and the shared task", got[alice.ID])
+	}
+	if got[admin.ID] != 3 {
+		t.Errorf("webhook of the admin got %d deliveries, want 3", got[admin.ID])
+	}
+}
+
+func TestDispatcher_PrunesFinishedDeliveries(t *testing.T) {
+	ctx := context.Background()
+	d := setupDispatcher(t, nil, Options{DeliveryRetention: time.Hour})
+	webhook := d.createWebhook(t, "http://127.0.0.1:1/hook", nil)
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, state := range []types.WebhookDeliveryState{
+		types.WebhookDeliveryStateSucceeded,
+		types.WebhookDeliveryStateDeadLetter,
+		types.WebhookDeliveryStatePending,
+	} {
+		err := d.db.WebhookDelivery.Create().
+			SetWebhookID(webhook.ID).
+			SetEventType(event.EventTypeAgentDeleted).
+			SetPayload([]byte("{}")).
+			SetState(state).
+			SetNextAttemptTime(old).
+			SetCreateTime(old).
+			SetUpdateTime(old).
+			Exec(ctx)
+		if err != nil {
+			t.Fatalf("failed to create delivery: %v", err)
+		}
+	}
+
+	if err := d.dispatcher.prune(ctx); err != nil {
+		t.Fatalf("prune() error = %v", err)
+	}
+
+	deliveries := d.deliveries(t)
+	if len(deliveries) != 1 || deliveries[0].State != types.WebhookDeliveryStatePending {
+		t.Errorf("expected only the pending delivery to be kept, got %d deliveries", len(deliveries))
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"eventType":"task.created"}`)
+	now := time.Unix(1_700_000_000, 0)
+	header := Sign(testSecret, now, body)
+
+	tests := []struct {
+		name   string
+		secret []byte
+		header string
+		body   []byte
+		now    time.Time
+		want   error
+	}{
+		{name: "valid", secret: testSecret, header: header, body: body, now: now},
+		{name: "multiple signatures", secret: testSecret, header: header + ",v1=00", body: body, now: now},
+		{name: "wrong secret", secret: []byte("other"), header: header, body: body, now: now, want: ErrSignatureMismatch},
+		{name: "modified body", secret: testSecret, header: header, body: []byte(`{}`), now: now, want: ErrSignatureMismatch},
+		{name: "expired", secret: testSecret, header: header, body: body, now: now.Add(time.Hour), want: ErrSignatureExpired},
+		{name: "malformed", secret: testSecret, header: "v1=zz", body: body, now: now, want: ErrInvalidSignatureHeader},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := VerifySignature(tt.secret, tt.header, tt.body, tt.now, DefaultSignatureTolerance); err != tt.want {
+				t.Errorf("VerifySignature() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SignatureHeader carries the signature of the delivered payload.
+const SignatureHeader = "X-Construct-Signature"
+
+// DefaultSignatureTolerance is how old a signature may be before VerifySignature rejects it.
+const DefaultSignatureTolerance = 5 * time.Minute
+
+var (
+	ErrInvalidSignatureHeader = errors.New("invalid signature header")
+	ErrSignatureMismatch      = errors.New("signature does not match payload")
+	ErrSignatureExpired       = errors.New("signature timestamp is outside the tolerance")
+)
+
+// Sign returns the value of the signature header for body signed with secret at time t.
+// The header has the format "t=<unix timestamp>,v1=<signature>", where the signature is the hex
+// encoded HMAC-SHA256 of "<unix timestamp>.<body>".
+func Sign(secret []byte, t time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(computeSignature(secret, timestamp, body))
+}
+
+// VerifySignature checks that header is a valid signature of body with secret that was created
+// no longer than tolerance before now. Receivers written in Go can use it to verify deliveries.
+func VerifySignature(secret []byte, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var (
+		timestamp  string
+		signatures [][]byte
+	)
+	for part := range strings.SplitSeq(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return ErrInvalidSignatureHeader
+		}
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				return ErrInvalidSignatureHeader
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignatureHeader
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); tolerance > 0 && (age > tolerance || age < -tolerance) {
+		return ErrSignatureExpired
+	}
+
+	expected := computeSignature(secret, timestamp, body)
+	for _, signature := range signatures {
+		if hmac.Equal(signature, expected) {
+			return nil
+		}
+	}
+	return ErrSignatureMismatch
+}
+
+func computeSignature(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -19,11 +19,32 @@ type agentApplyOptions struct {
 
 // AgentSpec represents the YAML structure for agent apply
 type AgentSpec struct {
-	ID           string `yaml:"id,omitempty"`
-	Name         string `yaml:"name"`
-	Description  string `yaml:"description,omitempty"`
-	Instructions string `yaml:"instructions"`
-	Model        string `yaml:"model"`
+	ID           string               `yaml:"id,omitempty"`
+	Name         string               `yaml:"name"`
+	Description  string               `yaml:"description,omitempty"`
+	Instructions string               `yaml:"instructions"`
+	Model        string               `yaml:"model"`
+	Router       []AgentRouteRuleSpec `yaml:"router,omitempty"`
+	Redaction    *AgentRedactionSpec  `yaml:"redaction,omitempty"`
+}
+
+// AgentRedactionSpec represents the redaction of secrets from tool outputs in the YAML structure
+// for agent apply
+type AgentRedactionSpec struct {
+	Disabled           bool     `yaml:"disabled,omitempty"`
+	HighEntropyStrings bool     `yaml:"highEntropyStrings,omitempty"`
+	Patterns           []string `yaml:"patterns,omitempty"`
+}
+
+// AgentRouteRuleSpec represents a model routing rule in the YAML structure for agent apply
+type AgentRouteRuleSpec struct {
+	Name                  string   `yaml:"name"`
+	Model                 string   `yaml:"model"`
+	TurnTypes             []string `yaml:"turnTypes,omitempty"`
+	MinPromptTokens       int64    `yaml:"minPromptTokens,omitempty"`
+	MaxPromptTokens       int64    `yaml:"maxPromptTokens,omitempty"`
+	MinRecentToolFailures int64    `yaml:"minRecentToolFailures,omitempty"`
+	MaxRecentToolFailures *int64   `yaml:"maxRecentToolFailures,omitempty"`
 }
 
 func NewAgentApplyCmd() *cobra.Command {
@@ -114,6 +135,11 @@ func createAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 		modelID = resolvedID
 	}
 
+	modelRouter, err := buildModelRouter(ctx, client, spec.Router)
+	if err != nil {
+		return err
+	}
+
 	// Create the agent
 	agentResp, err := client.Agent().CreateAgent(ctx, &connect.Request[v1.CreateAgentRequest]{
 		Msg: &v1.CreateAgentRequest{
@@ -121,6 +147,8 @@ func createAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 			Description:  spec.Description,
 			Instructions: spec.Instructions,
 			ModelId:      modelID,
+			ModelRouter:  modelRouter,
+			Redaction:    buildRedaction(spec.Redaction),
 		},
 	})
 	if err != nil {
@@ -178,6 +206,23 @@ func updateAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 	if modelID != currentAgent.Spec.ModelId {
 		updateReq.ModelId = &modelID
 	}
+	if len(spec.Router) > 0 || len(currentAgent.Spec.GetModelRouter().GetRules()) > 0 {
+		modelRouter, err := buildModelRouter(ctx, client, spec.Router)
+		if err != nil {
+			return err
+		}
+		if modelRouter == nil {
+			modelRouter = &v1.ModelRouter{}
+		}
+		updateReq.ModelRouter = modelRouter
+	}
+	if spec.Redaction != nil || currentAgent.Spec.GetRedaction() != nil {
+		redaction := buildRedaction(spec.Redaction)
+		if redaction == nil {
+			redaction = &v1.Redaction{}
+		}
+		updateReq.Redaction = redaction
+	}
 
 	// Apply the update
 	_, err = client.Agent().UpdateAgent(ctx, &connect.Request[v1.UpdateAgentRequest]{
@@ -190,3 +235,69 @@ func updateAgentFromSpec(ctx context.Context, client *api.Client, spec *AgentSpe
 	fmt.Fprintf(cmd.OutOrStdout(), "agent.construct.ai/%s configured\n", spec.Name)
 	return nil
 }
+
+func buildModelRouter(ctx context.Context, client *api.Client, rules []AgentRouteRuleSpec) (*v1.ModelRouter, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	router := &v1.ModelRouter{}
+	for _, rule := range rules {
+		modelID := rule.Model
+		if _, err := uuid.Parse(modelID); err != nil {
+			resolvedID, err := getModelID(ctx, client, modelID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to resolve model %s of routing rule %s: %w", modelID, rule.Name, err)
+			}
+			modelID = resolvedID
+		}
+
+		turnTypes := make([]v1.TurnType, 0, len(rule.TurnTypes))
+		for _, turnType := range rule.TurnTypes {
+			parsed, err := parseTurnType(turnType)
+			if err != nil {
+				return nil, err
+			}
+			turnTypes = append(turnTypes, parsed)
+		}
+
+		router.Rules = append(router.Rules, &v1.ModelRouteRule{
+			Name:                  rule.Name,
+			ModelId:               modelID,
+			TurnTypes:             turnTypes,
+			MinPromptTokens:       rule.MinPromptTokens,
+			MaxPromptTokens:       rule.MaxPromptTokens,
+			MinRecentToolFailures: rule.MinRecentToolFailures,
+			MaxRecentToolFailures: rule.MaxRecentToolFailures,
+		})
+	}
+
+	return router, nil
+}
+
+func parseTurnType(turnType string) (v1.TurnType, error) {
+	switch turnType {
+	case "first":
+		return v1.TurnType_TURN_TYPE_FIRST, nil
+	case "user_message":
+		return v1.TurnType_TURN_TYPE_USER_MESSAGE, nil
+	case "tool_result":
+		return v1.TurnType_TURN_TYPE_TOOL_RESULT, nil
+	case "tool_error":
+		return v1.TurnType_TURN_TYPE_TOOL_ERROR, nil
+	default:
+		return v1.TurnType_TURN_TYPE_UNSPECIFIED, fmt.Errorf("invalid turn type %s (use first, user_message, tool_result or tool_error)", turnType)
+	}
+}
+
+func buildRedaction(spec *AgentRedactionSpec) *v1.Redaction {
+	if spec == nil {
+		return nil
+	}
+
+	return &v1.Redaction{
+		Disabled:           spec.Disabled,
+		HighEntropyStrings: spec.HighEntropyStrings,
+		Patterns:           spec.Patterns,
+	}
+}
@@ -18,6 +18,7 @@ type agentCreateOptions struct {
 	PromptFile   string
 	PromptStdin  bool
 	Model        string
+	Fallbacks    []string
 }
 
 func NewAgentCreateCmd() *cobra.Command {
@@ -44,6 +45,12 @@ or by piping it via --prompt-stdin.`,
     --model "claude-3-5-sonnet" \
     --prompt-file ./prompts/sql.txt
 
+  # Create an agent that switches to another model when the primary one is overloaded
+  construct agent create "coder" \
+    --model "claude-sonnet-4-5" \
+    --fallback-model "gpt-4o" \
+    --prompt "You are an expert Go developer."
+
   # Create an agent by piping the prompt
   echo "You are a security expert reviewing code for vulnerabilities." | \
     construct agent create "reviewer" --model "gpt-4o" --prompt-stdin`,
@@ -66,12 +73,27 @@ or by piping it via --prompt-stdin.`,
 				options.Model = modelID
 			}
 
+			var fallbackModelIDs []string
+			for _, fallback := range options.Fallbacks {
+				if _, err := uuid.Parse(fallback); err == nil {
+					fallbackModelIDs = append(fallbackModelIDs, fallback)
+					continue
+				}
+
+				modelID, err := getModelID(cmd.Context(), client, fallback)
+				if err != nil {
+					return err
+				}
+				fallbackModelIDs = append(fallbackModelIDs, modelID)
+			}
+
 			agentResp, err := client.Agent().CreateAgent(cmd.Context(), &connect.Request[v1.CreateAgentRequest]{
 				Msg: &v1.CreateAgentRequest{
-					Name:         name,
-					Description:  options.Description,
-					Instructions: systemPrompt,
-					ModelId:      options.Model,
+					Name:             name,
+					Description:      options.Description,
+					Instructions:     systemPrompt,
+					ModelId:          options.Model,
+					FallbackModelIds: fallbackModelIDs,
 				},
 			})
 
@@ -90,6 +112,8 @@ or by piping it via --prompt-stdin.`,
 	cmd.Flags().BoolVar(&options.PromptStdin, "prompt-stdin", false, "Read the system prompt from standard input (stdin)")
 	cmd.Flags().StringVarP(&options.Model, "model", "m", "", "The AI model the agent will use (e.g., gpt-4o) (required)")
 
+	cmd.Flags().StringArrayVar(&options.Fallbacks, "fallback-model", []string{}, "A model to fall back to when the primary model is unavailable. Can be used multiple times, order defines priority")
+
 	cmd.MarkFlagRequired("model")
 
 	return cmd
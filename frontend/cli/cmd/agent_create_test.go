@@ -18,6 +18,7 @@ func TestAgentCreate(t *testing.T) {
 
 	agentID := uuid.New().String()
 	modelID := uuid.New().String()
+	fallbackModelID := uuid.New().String()
 
 	setup.RunTests(t, []TestScenario{
 		{
@@ -52,6 +53,33 @@ func TestAgentCreate(t *testing.T) {
 				Stdout: conv.Ptr(fmt.Sprintln(agentID)),
 			},
 		},
+		{
+			Name:    "success with fallback models",
+			Command: []string{"agent", "create", "coder", "--prompt", "A helpful coding assistant", "--model", modelID, "--fallback-model", "gpt-4", "--fallback-model", fallbackModelID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelLookupMock(mockClient, "gpt-4", modelID)
+				mockClient.Agent.EXPECT().CreateAgent(
+					gomock.Any(),
+					connect.NewRequest(&v1.CreateAgentRequest{
+						Name:             "coder",
+						Instructions:     "A helpful coding assistant",
+						ModelId:          modelID,
+						FallbackModelIds: []string{modelID, fallbackModelID},
+					}),
+				).Return(&connect.Response[v1.CreateAgentResponse]{
+					Msg: &v1.CreateAgentResponse{
+						Agent: &v1.Agent{
+							Metadata: &v1.AgentMetadata{
+								Id: agentID,
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintln(agentID)),
+			},
+		},
 		{
 			Name:    "success with prompt from stdin",
 			Command: []string{"agent", "create", "coder", "--prompt-stdin", "--model", "gpt-4"},
@@ -0,0 +1,254 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func NewAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect the audit log of the daemon",
+		Long: `Inspect the audit log of the daemon.
+
+The daemon records every API call that changes something, e.g. creating a token or
+updating the instructions of an agent, together with the identity that made the
+call and whether it succeeded. Tool calls with side effects (execute_command,
+edit_file, create_file and fetch) are recorded with their task and agent.
+
+Reading the audit log requires the audit:read scope. Audit events are kept for 90
+days unless daemon.audit_retention says otherwise.`,
+		GroupID: "system",
+	}
+
+	cmd.AddCommand(NewAuditListCmd())
+	cmd.AddCommand(NewAuditExportCmd())
+
+	return cmd
+}
+
+type auditFilterOptions struct {
+	Subject  string
+	Action   string
+	Task     string
+	Agent    string
+	Resource string
+	Kind     AuditEventKind
+	Outcome  AuditOutcome
+	Since    string
+	Until    string
+}
+
+func addAuditFilterFlags(cmd *cobra.Command, options *auditFilterOptions) {
+	cmd.Flags().StringVar(&options.Subject, "subject", "", "Only show events of this identity")
+	cmd.Flags().StringVar(&options.Action, "action", "", "Only show events of this procedure or tool, e.g. execute_command")
+	cmd.Flags().StringVar(&options.Task, "task", "", "Only show events of this task")
+	cmd.Flags().StringVar(&options.Agent, "agent", "", "Only show events of this agent")
+	cmd.Flags().StringVar(&options.Resource, "resource", "", "Only show events that touched this resource")
+	cmd.Flags().Var(&options.Kind, "kind", "Only show events of this kind: api or tool")
+	cmd.Flags().Var(&options.Outcome, "outcome", "Only show events with this outcome: success or failure")
+	cmd.Flags().StringVar(&options.Since, "since", "", "Only show events since this time (RFC 3339) or duration ago, e.g. 24h or 7d")
+	cmd.Flags().StringVar(&options.Until, "until", "", "Only show events before this time (RFC 3339) or duration ago")
+}
+
+func (o *auditFilterOptions) toAPI(now time.Time) (*v1.ListAuditEventsRequest_Filter, error) {
+	filter := &v1.ListAuditEventsRequest_Filter{
+		Kind:    o.Kind.ToAPI(),
+		Outcome: o.Outcome.ToAPI(),
+	}
+	if o.Subject != "" {
+		filter.Subject = &o.Subject
+	}
+	if o.Action != "" {
+		filter.Action = &o.Action
+	}
+	if o.Task != "" {
+		filter.TaskId = &o.Task
+	}
+	if o.Agent != "" {
+		filter.AgentId = &o.Agent
+	}
+	if o.Resource != "" {
+		filter.ResourceId = &o.Resource
+	}
+
+	if o.Since != "" {
+		since, err := parseAuditTime(o.Since, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --since: %w", err)
+		}
+		filter.Since = timestamppb.New(since)
+	}
+	if o.Until != "" {
+		until, err := parseAuditTime(o.Until, now)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --until: %w", err)
+		}
+		filter.Until = timestamppb.New(until)
+	}
+
+	return filter, nil
+}
+
+// parseAuditTime accepts either a point in time in RFC 3339 format or a duration that is
+// subtracted from now.
+func parseAuditTime(value string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	duration, err := ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is neither a RFC 3339 time nor a duration", value)
+	}
+	return now.Add(-duration), nil
+}
+
+type AuditEventDisplay struct {
+	Id         string            `json:"id" yaml:"id" detail:"default"`
+	Time       string            `json:"time" yaml:"time" detail:"default"`
+	Kind       string            `json:"kind" yaml:"kind" detail:"default"`
+	Action     string            `json:"action" yaml:"action" detail:"default"`
+	Subject    string            `json:"subject" yaml:"subject" detail:"default"`
+	Outcome    string            `json:"outcome" yaml:"outcome" detail:"default"`
+	AuthMethod string            `json:"auth_method,omitempty" yaml:"auth_method,omitempty" detail:"full"`
+	Resources  []string          `json:"resources,omitempty" yaml:"resources,omitempty" detail:"full"`
+	TaskID     string            `json:"task_id,omitempty" yaml:"task_id,omitempty" detail:"full"`
+	AgentID    string            `json:"agent_id,omitempty" yaml:"agent_id,omitempty" detail:"full"`
+	Error      string            `json:"error,omitempty" yaml:"error,omitempty" detail:"full"`
+	Details    map[string]string `json:"details,omitempty" yaml:"details,omitempty" detail:"full"`
+}
+
+func ConvertAuditEventToDisplay(event *v1.AuditEvent) *AuditEventDisplay {
+	display := &AuditEventDisplay{
+		Id:         event.Id,
+		Kind:       string(ConvertAuditEventKindToDisplay(event.Kind)),
+		Action:     event.Action,
+		Subject:    event.Subject,
+		Outcome:    string(ConvertAuditOutcomeToDisplay(event.Outcome)),
+		AuthMethod: event.AuthMethod,
+		Resources:  event.ResourceIds,
+		TaskID:     event.GetTaskId(),
+		AgentID:    event.GetAgentId(),
+		Error:      event.Error,
+		Details:    event.Details,
+	}
+
+	if event.CreatedAt != nil {
+		display.Time = FormatRelativeTime(event.CreatedAt.AsTime())
+	}
+
+	return display
+}
+
+type AuditEventKind string
+
+const (
+	AuditEventKindAPI     AuditEventKind = "api"
+	AuditEventKindTool    AuditEventKind = "tool"
+	AuditEventKindUnknown AuditEventKind = "unknown"
+)
+
+func (e *AuditEventKind) String() string {
+	return string(*e)
+}
+
+func (e *AuditEventKind) Set(v string) error {
+	switch AuditEventKind(strings.ToLower(strings.TrimSpace(v))) {
+	case AuditEventKindAPI:
+		*e = AuditEventKindAPI
+	case AuditEventKindTool:
+		*e = AuditEventKindTool
+	default:
+		return errors.New(`must be one of "api","tool"`)
+	}
+	return nil
+}
+
+func (e *AuditEventKind) Type() string {
+	return "kind"
+}
+
+// ToAPI returns nil if no kind has been set.
+func (e *AuditEventKind) ToAPI() *v1.AuditEventKind {
+	var kind v1.AuditEventKind
+	switch *e {
+	case AuditEventKindAPI:
+		kind = v1.AuditEventKind_AUDIT_EVENT_KIND_API
+	case AuditEventKindTool:
+		kind = v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL
+	default:
+		return nil
+	}
+	return &kind
+}
+
+func ConvertAuditEventKindToDisplay(kind v1.AuditEventKind) AuditEventKind {
+	switch kind {
+	case v1.AuditEventKind_AUDIT_EVENT_KIND_API:
+		return AuditEventKindAPI
+	case v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL:
+		return AuditEventKindTool
+	}
+
+	return AuditEventKindUnknown
+}
+
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+	AuditOutcomeUnknown AuditOutcome = "unknown"
+)
+
+func (e *AuditOutcome) String() string {
+	return string(*e)
+}
+
+func (e *AuditOutcome) Set(v string) error {
+	switch AuditOutcome(strings.ToLower(strings.TrimSpace(v))) {
+	case AuditOutcomeSuccess:
+		*e = AuditOutcomeSuccess
+	case AuditOutcomeFailure:
+		*e = AuditOutcomeFailure
+	default:
+		return errors.New(`must be one of "success","failure"`)
+	}
+	return nil
+}
+
+func (e *AuditOutcome) Type() string {
+	return "outcome"
+}
+
+// ToAPI returns nil if no outcome has been set.
+func (e *AuditOutcome) ToAPI() *v1.AuditOutcome {
+	var outcome v1.AuditOutcome
+	switch *e {
+	case AuditOutcomeSuccess:
+		outcome = v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS
+	case AuditOutcomeFailure:
+		outcome = v1.AuditOutcome_AUDIT_OUTCOME_FAILURE
+	default:
+		return nil
+	}
+	return &outcome
+}
+
+func ConvertAuditOutcomeToDisplay(outcome v1.AuditOutcome) AuditOutcome {
+	switch outcome {
+	case v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS:
+		return AuditOutcomeSuccess
+	case v1.AuditOutcome_AUDIT_OUTCOME_FAILURE:
+		return AuditOutcomeFailure
+	}
+
+	return AuditOutcomeUnknown
+}
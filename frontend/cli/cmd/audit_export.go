@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// auditExportPageSize is the number of events fetched per request while exporting.
+const auditExportPageSize = 1000
+
+type auditExportOptions struct {
+	Filter auditFilterOptions
+	File   string
+}
+
+func NewAuditExportCmd() *cobra.Command {
+	var options auditExportOptions
+
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: "Export audit events as JSON lines",
+		Long: `Export audit events as JSON lines.
+
+Writes every audit event that matches the filters as one JSON object per line,
+newest first. The output can be archived or fed into other tools.`,
+		Args: cobra.NoArgs,
+		Example: `  # Archive the audit log of the last 30 days
+  construct audit export --since 30d --file audit.jsonl
+
+  # Find commands that deleted files
+  construct audit export --action execute_command | jq 'select(.details.command | contains("rm"))'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			filter, err := options.Filter.toAPI(time.Now())
+			if err != nil {
+				return err
+			}
+
+			var out bytes.Buffer
+			pageSize := int32(auditExportPageSize)
+			req := &v1.ListAuditEventsRequest{
+				Filter:   filter,
+				PageSize: &pageSize,
+			}
+
+			for {
+				resp, err := client.Audit().ListAuditEvents(cmd.Context(), &connect.Request[v1.ListAuditEventsRequest]{
+					Msg: req,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to list audit events: %w", err)
+				}
+
+				for _, event := range resp.Msg.Events {
+					line, err := protojson.Marshal(event)
+					if err != nil {
+						return fmt.Errorf("failed to encode audit event %s: %w", event.Id, err)
+					}
+					// protojson does not promise stable output, compact it to one event per line.
+					if err := json.Compact(&out, line); err != nil {
+						return fmt.Errorf("failed to encode audit event %s: %w", event.Id, err)
+					}
+					out.WriteByte('\n')
+				}
+
+				if resp.Msg.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.Msg.NextPageToken
+			}
+
+			if options.File == "" {
+				_, err := io.Copy(cmd.OutOrStdout(), &out)
+				return err
+			}
+
+			fs := getFileSystem(cmd.Context())
+			if err := fs.WriteFile(options.File, out.Bytes(), 0600); err != nil {
+				return fmt.Errorf("failed to write audit events to %s: %w", options.File, err)
+			}
+			return nil
+		},
+	}
+
+	addAuditFilterFlags(cmd, &options.Filter)
+	cmd.Flags().StringVarP(&options.File, "file", "f", "", "Write the events to this file instead of stdout")
+	return cmd
+}
@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuditExport(t *testing.T) {
+	setup := &TestSetup{}
+
+	pageSize := int32(auditExportPageSize)
+	success := v1.AuditOutcome_AUDIT_OUTCOME_SUCCESS
+	api := v1.AuditEventKind_AUDIT_EVENT_KIND_API
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - all pages",
+			Command: []string{"audit", "export", "--subject", "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				filter := &v1.ListAuditEventsRequest_Filter{Subject: stringPtr("ci")}
+				gomock.InOrder(
+					mockClient.Audit.EXPECT().ListAuditEvents(
+						gomock.Any(),
+						connect.NewRequest(&v1.ListAuditEventsRequest{
+							Filter:   filter,
+							PageSize: &pageSize,
+						}),
+					).Return(&connect.Response[v1.ListAuditEventsResponse]{
+						Msg: &v1.ListAuditEventsResponse{
+							Events: []*v1.AuditEvent{
+								{Id: "2", Kind: api, Action: "/construct.v1.AgentService/UpdateAgent", Subject: "ci", Outcome: success},
+							},
+							NextPageToken: "2",
+						},
+					}, nil),
+					mockClient.Audit.EXPECT().ListAuditEvents(
+						gomock.Any(),
+						connect.NewRequest(&v1.ListAuditEventsRequest{
+							Filter:    filter,
+							PageSize:  &pageSize,
+							PageToken: "2",
+						}),
+					).Return(&connect.Response[v1.ListAuditEventsResponse]{
+						Msg: &v1.ListAuditEventsResponse{
+							Events: []*v1.AuditEvent{
+								{Id: "1", Kind: api, Action: "/construct.v1.AuthService/CreateToken", Subject: "ci", Outcome: success},
+							},
+						},
+					}, nil),
+				)
+			},
+			Expected: TestExpectation{
+				Stdout: stringPtr(`{"id":"2","kind":"AUDIT_EVENT_KIND_API","action":"/construct.v1.AgentService/UpdateAgent","subject":"ci","outcome":"AUDIT_OUTCOME_SUCCESS"}
+{"id":"1","kind":"AUDIT_EVENT_KIND_API","action":"/construct.v1.AuthService/CreateToken","subject":"ci","outcome":"AUDIT_OUTCOME_SUCCESS"}
+`),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type auditListOptions struct {
+	Filter        auditFilterOptions
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewAuditListCmd() *cobra.Command {
+	var options auditListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   "List the most recent audit events",
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		Example: `  # Show what happened in the last day
+  construct audit list --since 24h
+
+  # Show the commands a task executed
+  construct audit list --task 01234567-89ab-cdef-0123-456789abcdef --action execute_command --wide
+
+  # Show failed calls of a token
+  construct audit list --subject ci --outcome failure`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			filter, err := options.Filter.toAPI(time.Now())
+			if err != nil {
+				return err
+			}
+
+			req := &v1.ListAuditEventsRequest{
+				Filter:   filter,
+				PageSize: &options.Limit,
+			}
+
+			resp, err := client.Audit().ListAuditEvents(cmd.Context(), &connect.Request[v1.ListAuditEventsRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list audit events: %w", err)
+			}
+
+			displayEvents := make([]*AuditEventDisplay, len(resp.Msg.Events))
+			for i, event := range resp.Msg.Events {
+				displayEvents[i] = ConvertAuditEventToDisplay(event)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayEvents, &options.RenderOptions)
+		},
+	}
+
+	addAuditFilterFlags(cmd, &options.Filter)
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "n", 50, "Maximum number of events to show (1-1000)")
+	addRenderOptions(cmd, &options.RenderOptions)
+	return cmd
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestAuditList(t *testing.T) {
+	setup := &TestSetup{}
+
+	eventID := uuid.New().String()
+	taskID := uuid.New().String()
+	since := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	failure := v1.AuditOutcome_AUDIT_OUTCOME_FAILURE
+	tool := v1.AuditEventKind_AUDIT_EVENT_KIND_TOOL
+	limit := int32(10)
+	defaultLimit := int32(50)
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - filter failed commands of a task",
+			Command: []string{"audit", "list", "--task", taskID, "--kind", "tool", "--outcome", "failure", "--since", since.Format(time.RFC3339), "--limit", "10"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Audit.EXPECT().ListAuditEvents(
+					gomock.Any(),
+					connect.NewRequest(&v1.ListAuditEventsRequest{
+						Filter: &v1.ListAuditEventsRequest_Filter{
+							Kind:    &tool,
+							TaskId:  &taskID,
+							Outcome: &failure,
+							Since:   timestamppb.New(since),
+						},
+						PageSize: &limit,
+					}),
+				).Return(&connect.Response[v1.ListAuditEventsResponse]{
+					Msg: &v1.ListAuditEventsResponse{
+						Events: []*v1.AuditEvent{
+							{
+								Id:          eventID,
+								Kind:        tool,
+								Action:      "execute_command",
+								Subject:     "ci",
+								ResourceIds: []string{taskID},
+								Outcome:     failure,
+								Error:       "command exited with code 1",
+								TaskId:      &taskID,
+								Details:     map[string]string{"command": "make test", "exit_code": "1"},
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*AuditEventDisplay{
+					{
+						Id:        eventID,
+						Kind:      "tool",
+						Action:    "execute_command",
+						Subject:   "ci",
+						Outcome:   "failure",
+						Resources: []string{taskID},
+						TaskID:    taskID,
+						Error:     "command exited with code 1",
+						Details:   map[string]string{"command": "make test", "exit_code": "1"},
+					},
+				},
+			},
+		},
+		{
+			Name:    "success - no events",
+			Command: []string{"audit", "list", "--subject", "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Audit.EXPECT().ListAuditEvents(
+					gomock.Any(),
+					connect.NewRequest(&v1.ListAuditEventsRequest{
+						Filter: &v1.ListAuditEventsRequest_Filter{
+							Subject: stringPtr("ci"),
+						},
+						PageSize: &defaultLimit,
+					}),
+				).Return(&connect.Response[v1.ListAuditEventsResponse]{
+					Msg: &v1.ListAuditEventsResponse{},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*AuditEventDisplay{},
+			},
+		},
+		{
+			Name:    "error - invalid outcome",
+			Command: []string{"audit", "list", "--outcome", "denied"},
+			Expected: TestExpectation{
+				Error: `invalid argument "denied" for "--outcome" flag: must be one of "success","failure"`,
+			},
+		},
+		{
+			Name:    "error - invalid since",
+			Command: []string{"audit", "list", "--since", "yesterday"},
+			Expected: TestExpectation{
+				Error: `invalid --since: "yesterday" is neither a RFC 3339 time nor a duration`,
+			},
+		},
+	})
+}
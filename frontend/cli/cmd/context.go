@@ -27,6 +27,7 @@ Examples:
 	cmd.AddCommand(NewContextCurrentCmd())
 	cmd.AddCommand(NewContextUseCmd())
 	cmd.AddCommand(NewContextAddCmd())
+	cmd.AddCommand(NewContextLoginCmd())
 	cmd.AddCommand(NewContextRemoveCmd())
 
 	return cmd
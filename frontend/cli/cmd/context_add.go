@@ -11,10 +11,14 @@ import (
 )
 
 type contextAddOptions struct {
-	Endpoint   string
-	Kind       string
-	AuthToken  bool
-	SetCurrent bool
+	Endpoint       string
+	Kind           string
+	AuthToken      bool
+	SetCurrent     bool
+	TLSCA          string
+	TLSFingerprint string
+	TLSCert        string
+	TLSKey         string
 }
 
 func NewContextAddCmd() *cobra.Command {
@@ -32,7 +36,11 @@ is auto-detected from the endpoint format if not explicitly specified:
   • URLs are treated as HTTP connections (kind: http)
 
 Authentication tokens are securely stored in the system keyring (macOS Keychain, 
-Linux Secret Service, Windows Credential Manager).`,
+Linux Secret Service, Windows Credential Manager).
+
+For daemons that serve TLS with a self-signed certificate, pin the fingerprint the
+daemon prints on startup with --tls-fingerprint. A client certificate for mutual
+TLS is configured with --tls-cert and --tls-key.`,
 		Example: `  # Add local Unix socket context
   construct context add local --endpoint /home/user/.construct/construct.sock
 
@@ -42,6 +50,17 @@ Linux Secret Service, Windows Credential Manager).`,
     --auth-token \
     --set-current
 
+  # Add remote context with a pinned self-signed certificate
+  construct context add devbox \
+    --endpoint https://devbox.local:8443 \
+    --tls-fingerprint sha256:3f7a...c2d1
+
+  # Add remote context that authenticates with a client certificate
+  construct context add ci \
+    --endpoint https://construct.example.com:8443 \
+    --tls-cert ~/.construct/ci.pem \
+    --tls-key ~/.construct/ci-key.pem
+
   # Update existing context endpoint
   construct context add staging --endpoint https://new-staging.example.com:8443`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -81,7 +100,28 @@ Linux Secret Service, Windows Credential Manager).`,
 				}
 			}
 
-			existed, err := contextManager.UpsertContext(contextName, kind, options.Endpoint, options.SetCurrent, authConfig)
+			var tlsConfig *api.TLSConfig
+			if options.TLSCA != "" || options.TLSFingerprint != "" || options.TLSCert != "" || options.TLSKey != "" {
+				tlsConfig = &api.TLSConfig{
+					CAFile:   options.TLSCA,
+					CertFile: options.TLSCert,
+					KeyFile:  options.TLSKey,
+				}
+				if options.TLSFingerprint != "" {
+					fingerprint, err := api.NormalizeFingerprint(options.TLSFingerprint)
+					if err != nil {
+						return err
+					}
+					tlsConfig.Fingerprint = fingerprint
+				}
+			}
+
+			existed, err := contextManager.UpsertEndpointContext(contextName, api.EndpointContext{
+				Address: options.Endpoint,
+				Kind:    kind,
+				Auth:    authConfig,
+				TLS:     tlsConfig,
+			}, options.SetCurrent)
 			if err != nil {
 				return err
 			}
@@ -105,6 +145,10 @@ Linux Secret Service, Windows Credential Manager).`,
 	cmd.Flags().StringVar(&options.Kind, "kind", "", "Connection type (unix or http, auto-detected if not specified)")
 	cmd.Flags().BoolVar(&options.AuthToken, "auth-token", false, "Prompt for authentication token")
 	cmd.Flags().BoolVar(&options.SetCurrent, "set-current", false, "Set this context as current")
+	cmd.Flags().StringVar(&options.TLSCA, "tls-ca", "", "Path to additional certificate authorities that are trusted for the daemon")
+	cmd.Flags().StringVar(&options.TLSFingerprint, "tls-fingerprint", "", "SHA-256 fingerprint of the daemon certificate to pin, e.g. of a self-signed certificate")
+	cmd.Flags().StringVar(&options.TLSCert, "tls-cert", "", "Path to the client certificate for mutual TLS")
+	cmd.Flags().StringVar(&options.TLSKey, "tls-key", "", "Path to the key of the client certificate")
 
 	cmd.MarkFlagRequired("endpoint")
 
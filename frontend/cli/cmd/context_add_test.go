@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"strings"
 	"testing"
 
 	api "github.com/furisto/construct/api/go/client"
@@ -72,6 +73,49 @@ func TestContextAdd(t *testing.T) {
 				Stdout: stringPtr("Context \"local\" updated\n"),
 			},
 		},
+		{
+			Name: "success - add http context with pinned fingerprint",
+			Command: []string{
+				"context", "add", "devbox", "--endpoint", "https://devbox.local:8443",
+				"--tls-fingerprint", "AB:" + strings.Repeat("CD:", 30) + "EF",
+			},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/home/user/.construct", 0700)
+			},
+			Expected: TestExpectation{
+				Stdout: stringPtr("Context \"devbox\" created\n"),
+			},
+		},
+		{
+			Name:    "error - invalid fingerprint",
+			Command: []string{"context", "add", "devbox", "--endpoint", "https://devbox.local:8443", "--tls-fingerprint", "sha256:abc"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/home/user/.construct", 0700)
+			},
+			Expected: TestExpectation{
+				Error: "invalid certificate fingerprint \"sha256:abc\": expected a SHA-256 hash",
+			},
+		},
+		{
+			Name:    "error - client certificate without key",
+			Command: []string{"context", "add", "ci", "--endpoint", "https://construct.example.com:8443", "--tls-cert", "/home/user/ci.pem"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/home/user/.construct", 0700)
+			},
+			Expected: TestExpectation{
+				Error: "invalid tls config: cert-file and key-file must be specified together",
+			},
+		},
+		{
+			Name:    "error - tls for unix context",
+			Command: []string{"context", "add", "local", "--endpoint", "/tmp/construct.sock", "--tls-ca", "/home/user/ca.pem"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/home/user/.construct", 0700)
+			},
+			Expected: TestExpectation{
+				Error: "tls can only be configured for http contexts",
+			},
+		},
 		{
 			Name:    "error - missing endpoint flag",
 			Command: []string{"context", "add", "mycontext"},
@@ -130,3 +174,39 @@ func TestContextAddWithAuth(t *testing.T) {
 		t.Errorf("expected token ref, got: %+v", ctx.Auth)
 	}
 }
+
+func TestContextAddWithTLS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := mocks.NewMockUserInfo(ctrl)
+	setupDefaultUserInfo(userInfo)
+
+	fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+	fs.MkdirAll("/home/user/.construct", 0700)
+
+	contextManager := shared.NewContextManagerWithKeyring(fs, userInfo, mocks.NewMockProvider(ctrl))
+
+	fingerprint := api.FingerprintPrefix + strings.Repeat("ab", 32)
+	_, err := contextManager.UpsertEndpointContext("devbox", api.EndpointContext{
+		Address: "https://devbox.local:8443",
+		Kind:    "http",
+		TLS: &api.TLSConfig{
+			Fingerprint: fingerprint,
+			CertFile:    "/home/user/ci.pem",
+			KeyFile:     "/home/user/ci-key.pem",
+		},
+	}, false)
+	if err != nil {
+		t.Fatalf("failed to add context with tls: %v", err)
+	}
+
+	endpointContext, err := contextManager.GetContext("devbox")
+	if err != nil {
+		t.Fatalf("failed to load context: %v", err)
+	}
+
+	if endpointContext.TLS == nil || endpointContext.TLS.Fingerprint != fingerprint || endpointContext.TLS.CertFile != "/home/user/ci.pem" {
+		t.Errorf("expected tls config to be stored, got: %+v", endpointContext.TLS)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"sort"
 
+	api "github.com/furisto/construct/api/go/client"
+
 	"github.com/spf13/cobra"
 )
 
@@ -35,7 +37,9 @@ func NewContextListCmd() *cobra.Command {
 			for name, context := range endpointContexts.Contexts {
 				authStatus := "none"
 				if context.Auth != nil && context.Auth.IsConfigured() {
-					if context.Auth.Token != "" {
+					if context.Auth.Type == api.AuthTypeOIDC {
+						authStatus = "oidc (" + context.Auth.Issuer + ")"
+					} else if context.Auth.Token != "" {
 						authStatus = "token (inline)"
 					} else if context.Auth.TokenRef != "" {
 						authStatus = context.Auth.TokenRef
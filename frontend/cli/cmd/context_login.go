@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+
+	api "github.com/furisto/construct/api/go/client"
+	"github.com/spf13/cobra"
+)
+
+type contextLoginOptions struct {
+	Issuer   string
+	ClientID string
+	Scopes   []string
+}
+
+func NewContextLoginCmd() *cobra.Command {
+	var options contextLoginOptions
+
+	cmd := &cobra.Command{
+		Use:   "login <name> [flags]",
+		Short: "Log in to a remote daemon with OpenID Connect",
+		Args:  cobra.ExactArgs(1),
+		Long: `Log in to a remote daemon with OpenID Connect.
+
+Instead of an API token created on the daemon, you can authenticate with your
+account at the identity provider that the daemon trusts (daemon.oidc_issuer).
+The login uses the device authorization flow: open the link that is printed,
+confirm the code and the CLI receives an ID token and a refresh token. They are
+stored in the system keyring and the ID token is refreshed automatically when it
+expires.
+
+The issuer and client ID only need to be given on the first login, they are kept
+in the context. What you may do on the daemon is decided by the scopes that the
+daemon maps from your token.`,
+		Example: `  # Log in for the first time
+  construct context add production --endpoint https://construct.example.com:8443
+  construct context login production \
+    --issuer https://login.example.com \
+    --client-id construct-cli
+
+  # Request additional scopes from the issuer
+  construct context login production --scope tasks:write
+
+  # Log in again after the refresh token expired
+  construct context login production`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contextName := args[0]
+			contextManager := getContextManager(cmd.Context())
+
+			endpointContext, err := contextManager.GetContext(contextName)
+			if err != nil {
+				return err
+			}
+			if endpointContext.Kind != "http" {
+				return fmt.Errorf("context %q connects over a Unix socket, which does not need a login", contextName)
+			}
+
+			auth := &api.AuthConfig{
+				Type:     api.AuthTypeOIDC,
+				TokenRef: api.KeyringRefPrefix + contextName,
+			}
+			if endpointContext.Auth != nil && endpointContext.Auth.Type == api.AuthTypeOIDC {
+				auth.Issuer = endpointContext.Auth.Issuer
+				auth.ClientID = endpointContext.Auth.ClientID
+				auth.Scopes = endpointContext.Auth.Scopes
+			}
+			if options.Issuer != "" {
+				auth.Issuer = options.Issuer
+			}
+			if options.ClientID != "" {
+				auth.ClientID = options.ClientID
+			}
+			if cmd.Flags().Changed("scope") {
+				auth.Scopes = options.Scopes
+			}
+			if auth.Issuer == "" || auth.ClientID == "" {
+				return fmt.Errorf("--issuer and --client-id are required for the first login")
+			}
+
+			credentials, err := oidcDeviceLogin(cmd.Context(), cmd.OutOrStdout(), auth)
+			if err != nil {
+				return err
+			}
+
+			if err := storeOIDCCredentials(contextManager, auth.KeyringKey(), credentials); err != nil {
+				return err
+			}
+
+			endpointContext.Auth = auth
+			if _, err := contextManager.UpsertEndpointContext(contextName, *endpointContext, false); err != nil {
+				return err
+			}
+
+			user := contextName
+			if claims, err := jwtClaims(credentials.IDToken); err == nil {
+				user = claims.Subject
+				if claims.Email != "" {
+					user = claims.Email
+				}
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Logged in to context %q as %s\n", contextName, user)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Issuer, "issuer", "", "URL of the OpenID Connect issuer")
+	cmd.Flags().StringVar(&options.ClientID, "client-id", "", "Client ID of the CLI at the issuer")
+	cmd.Flags().StringArrayVar(&options.Scopes, "scope", []string{}, "Additional scope to request from the issuer. Can be used multiple times")
+
+	return cmd
+}
@@ -16,5 +16,8 @@ func NewDaemonCmd() *cobra.Command {
 	cmd.AddCommand(NewDaemonUninstallCmd())
 	cmd.AddCommand(NewDaemonStopCmd())
 	cmd.AddCommand(NewDaemonTokenCmd())
+	cmd.AddCommand(NewDaemonRotateKeyCmd())
+	cmd.AddCommand(NewDaemonExportKeyCmd())
+	cmd.AddCommand(NewDaemonImportKeyCmd())
 	return cmd
 }
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type exportKeyOptions struct {
+	File string
+}
+
+func NewDaemonExportKeyCmd() *cobra.Command {
+	var options exportKeyOptions
+
+	cmd := &cobra.Command{
+		Use:   "export-key [flags]",
+		Short: "Export the keyset that encrypts stored secrets",
+		Args:  cobra.NoArgs,
+		Long: `Export the keyset that encrypts stored secrets.
+
+Writes the encryption keyset of the daemon as JSON. Keep it together with the
+backups of the database, the secrets in a backup can only be restored with the
+keyset that encrypted them. Use 'construct daemon import-key' to restore it.
+
+The keyset contains the key material in cleartext. Store it as securely as the
+API keys it protects.`,
+		Example: `  # Back up the keyset to a file
+  construct daemon export-key --file construct-keyset.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Encryption().ExportEncryptionKeyset(cmd.Context(), &connect.Request[v1.ExportEncryptionKeysetRequest]{
+				Msg: &v1.ExportEncryptionKeysetRequest{},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to export encryption keyset: %w", err)
+			}
+
+			if options.File == "" {
+				_, err := io.WriteString(cmd.OutOrStdout(), resp.Msg.Keyset)
+				return err
+			}
+
+			fs := getFileSystem(cmd.Context())
+			if err := fs.WriteFile(options.File, []byte(resp.Msg.Keyset), 0600); err != nil {
+				return fmt.Errorf("failed to write encryption keyset to %s: %w", options.File, err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "✅ Exported encryption keyset with %d keys to %s\n", len(resp.Msg.Keys), options.File)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.File, "file", "f", "", "Write the keyset to this file instead of stdout")
+	return cmd
+}
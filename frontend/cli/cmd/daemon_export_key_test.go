@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDaemonExportKey(t *testing.T) {
+	setup := &TestSetup{}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - stdout",
+			Command: []string{"daemon", "export-key"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Encryption.EXPECT().ExportEncryptionKeyset(
+					gomock.Any(),
+					connect.NewRequest(&v1.ExportEncryptionKeysetRequest{}),
+				).Return(&connect.Response[v1.ExportEncryptionKeysetResponse]{
+					Msg: &v1.ExportEncryptionKeysetResponse{
+						Keyset: `{"primaryKeyId":42,"key":[]}`,
+						Keys:   []*v1.EncryptionKey{{Id: 42, Status: v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ENABLED, Primary: true}},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: stringPtr(`{"primaryKeyId":42,"key":[]}`),
+			},
+		},
+		{
+			Name:    "success - file",
+			Command: []string{"daemon", "export-key", "--file", "keyset.json"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Encryption.EXPECT().ExportEncryptionKeyset(gomock.Any(), gomock.Any()).
+					Return(&connect.Response[v1.ExportEncryptionKeysetResponse]{
+						Msg: &v1.ExportEncryptionKeysetResponse{
+							Keyset: `{"primaryKeyId":42,"key":[]}`,
+							Keys:   []*v1.EncryptionKey{{Id: 42, Status: v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ENABLED, Primary: true}},
+						},
+					}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: stringPtr("✅ Exported encryption keyset with 1 keys to keyset.json\n"),
+			},
+		},
+		{
+			Name:    "error - not an admin",
+			Command: []string{"daemon", "export-key"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Encryption.EXPECT().ExportEncryptionKeyset(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodePermissionDenied, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to export encryption keyset: permission_denied",
+			},
+		},
+	})
+}
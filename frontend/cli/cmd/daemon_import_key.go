@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type importKeyOptions struct {
+	Force bool
+}
+
+func NewDaemonImportKeyCmd() *cobra.Command {
+	var options importKeyOptions
+
+	cmd := &cobra.Command{
+		Use:   "import-key <file> [flags]",
+		Short: "Replace the keyset that encrypts stored secrets",
+		Args:  cobra.ExactArgs(1),
+		Long: `Replace the keyset that encrypts stored secrets.
+
+Imports a keyset that was exported with 'construct daemon export-key', e.g.
+after restoring a backup of the database on a new machine.
+
+The keyset is only imported if it decrypts every stored secret. With --force
+it is imported anyway, secrets that it does not decrypt have to be set again.`,
+		Example: `  # Restore the keyset from a backup
+  construct daemon import-key construct-keyset.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fs := getFileSystem(cmd.Context())
+			keyset, err := fs.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read encryption keyset: %w", err)
+			}
+
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Encryption().ImportEncryptionKeyset(cmd.Context(), &connect.Request[v1.ImportEncryptionKeysetRequest]{
+				Msg: &v1.ImportEncryptionKeysetRequest{
+					Keyset: string(keyset),
+					Force:  options.Force,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to import encryption keyset: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "✅ Imported encryption keyset with %d keys\n", len(resp.Msg.Keys))
+			if resp.Msg.Undecryptable > 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "⚠️  %d stored secrets cannot be decrypted and have to be set again\n", resp.Msg.Undecryptable)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Force, "force", false, "Import the keyset even if it does not decrypt every stored secret")
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDaemonImportKey(t *testing.T) {
+	setup := &TestSetup{}
+
+	keyset := `{"primaryKeyId":42,"key":[]}`
+	keys := []*v1.EncryptionKey{{Id: 42, Status: v1.EncryptionKeyStatus_ENCRYPTION_KEY_STATUS_ENABLED, Primary: true}}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success",
+			Command: []string{"daemon", "import-key", "keyset.json"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("keyset.json", []byte(keyset), 0600)
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Encryption.EXPECT().ImportEncryptionKeyset(
+					gomock.Any(),
+					connect.NewRequest(&v1.ImportEncryptionKeysetRequest{Keyset: keyset}),
+				).Return(&connect.Response[v1.ImportEncryptionKeysetResponse]{
+					Msg: &v1.ImportEncryptionKeysetResponse{Keys: keys},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: stringPtr("✅ Imported encryption keyset with 1 keys\n"),
+			},
+		},
+		{
+			Name:    "success - force with undecryptable secrets",
+			Command: []string{"daemon", "import-key", "keyset.json", "--force"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("keyset.json", []byte(keyset), 0600)
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Encryption.EXPECT().ImportEncryptionKeyset(
+					gomock.Any(),
+					connect.NewRequest(&v1.ImportEncryptionKeysetRequest{Keyset: keyset, Force: true}),
+				).Return(&connect.Response[v1.ImportEncryptionKeysetResponse]{
+					Msg: &v1.ImportEncryptionKeysetResponse{Keys: keys, Undecryptable: 2},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				Stdout: stringPtr("✅ Imported encryption keyset with 1 keys\n⚠️  2 stored secrets cannot be decrypted and have to be set again\n"),
+			},
+		},
+		{
+			Name:    "error - keyset does not decrypt secrets",
+			Command: []string{"daemon", "import-key", "keyset.json"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.WriteFile("keyset.json", []byte(keyset), 0600)
+			},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Encryption.EXPECT().ImportEncryptionKeyset(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeFailedPrecondition, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to import encryption keyset: failed_precondition",
+			},
+		},
+		{
+			Name:    "error - missing file",
+			Command: []string{"daemon", "import-key", "missing.json"},
+			Expected: TestExpectation{
+				Error: "failed to read encryption keyset: open missing.json: file does not exist",
+			},
+		},
+	})
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type rotateKeyOptions struct {
+	DisablePreviousKeys bool
+}
+
+func NewDaemonRotateKeyCmd() *cobra.Command {
+	var options rotateKeyOptions
+
+	cmd := &cobra.Command{
+		Use:   "rotate-key [flags]",
+		Short: "Rotate the key that encrypts stored secrets",
+		Args:  cobra.NoArgs,
+		Long: `Rotate the key that encrypts stored secrets.
+
+Adds a new primary key to the encryption keyset of the daemon and re-encrypts
+the API keys of all model providers and the secrets of all webhooks with it in
+a single transaction. If the rotation fails, every secret stays readable.
+
+The previous keys stay in the keyset and can still decrypt secrets, e.g. from
+an older backup of the database. With --disable-previous-keys they are disabled
+once every secret was verified to decrypt with the new key.
+
+Export the keyset after rotating it with 'construct daemon export-key', a
+backup of the database is useless without it.`,
+		Example: `  # Rotate the key and keep the previous keys enabled
+  construct daemon rotate-key
+
+  # Rotate the key and disable the previous keys
+  construct daemon rotate-key --disable-previous-keys`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			stream, err := client.Encryption().RotateEncryptionKey(cmd.Context(), &connect.Request[v1.RotateEncryptionKeyRequest]{
+				Msg: &v1.RotateEncryptionKeyRequest{DisablePreviousKeys: options.DisablePreviousKeys},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to rotate encryption key: %w", err)
+			}
+
+			var result *v1.RotateEncryptionKeyResponse
+			progress := newKeyRotationProgress()
+			for stream.Receive() {
+				msg := stream.Msg()
+				if msg.Phase == v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_DONE {
+					result = msg
+					continue
+				}
+				progress.update(msg)
+			}
+			progress.finish()
+
+			if err := stream.Err(); err != nil {
+				return fmt.Errorf("failed to rotate encryption key: %w", err)
+			}
+			if result == nil {
+				return fmt.Errorf("failed to rotate encryption key: the daemon ended the rotation early")
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "✅ Rotated encryption key, %d secrets are encrypted with key %d\n", result.Total, result.PrimaryKeyId)
+			if options.DisablePreviousKeys {
+				fmt.Fprintln(cmd.OutOrStdout(), "   The previous keys were disabled")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.DisablePreviousKeys, "disable-previous-keys", false, "Disable the previous keys once every secret decrypts with the new key")
+
+	return cmd
+}
+
+// keyRotationProgress shows the progress of a key rotation on stderr, one line per phase that is
+// updated in place.
+type keyRotationProgress struct {
+	phase v1.EncryptionKeyRotationPhase
+}
+
+func newKeyRotationProgress() *keyRotationProgress {
+	return &keyRotationProgress{}
+}
+
+func (p *keyRotationProgress) update(msg *v1.RotateEncryptionKeyResponse) {
+	if p.phase != msg.Phase {
+		p.finish()
+		p.phase = msg.Phase
+	}
+
+	label := "Re-encrypting secrets"
+	if msg.Phase == v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_VERIFYING {
+		label = "Verifying secrets"
+	}
+	fmt.Fprintf(os.Stderr, "\r%s: %d/%d", label, msg.Processed, msg.Total)
+}
+
+func (p *keyRotationProgress) finish() {
+	if p.phase != v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED {
+		fmt.Fprintln(os.Stderr)
+	}
+	p.phase = v1.EncryptionKeyRotationPhase_ENCRYPTION_KEY_ROTATION_PHASE_UNSPECIFIED
+}
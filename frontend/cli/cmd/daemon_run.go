@@ -3,17 +3,23 @@ package cmd
 import (
 	"context"
 	"crypto/sha256"
-	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"entgo.io/ent/dialect"
+	api "github.com/furisto/construct/api/go/client"
 	"github.com/furisto/construct/backend/agent"
 	"github.com/furisto/construct/backend/analytics"
+	"github.com/furisto/construct/backend/api/auth"
+	"github.com/furisto/construct/backend/audit"
+	"github.com/furisto/construct/backend/event"
 	"github.com/furisto/construct/backend/memory"
 	"github.com/furisto/construct/backend/memory/migrate"
+	"github.com/furisto/construct/backend/model"
 	"github.com/furisto/construct/backend/secret"
 	"github.com/furisto/construct/backend/tool/codeact"
 	"github.com/furisto/construct/shared"
@@ -21,12 +27,16 @@ import (
 	"github.com/furisto/construct/shared/listener"
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
-	"github.com/tink-crypto/tink-go/keyset"
 )
 
 type daemonRunOptions struct {
-	HTTPAddress string
-	UnixSocket  string
+	HTTPAddress    string
+	UnixSocket     string
+	RecordCassette string
+	TLSCert        string
+	TLSKey         string
+	TLSSelfSigned  bool
+	TLSClientCA    string
 }
 
 func NewDaemonRunCmd() *cobra.Command {
@@ -37,7 +47,44 @@ func NewDaemonRunCmd() *cobra.Command {
 		Long: `Run the daemon process in the foreground.
 
 Starts the daemon process directly in the current terminal. This is useful for 
-debugging and development. For normal use, 'construct daemon install' is recommended.`,
+debugging and development. For normal use, 'construct daemon install' is recommended.
+
+Requests over the Unix socket are authenticated with the credentials of the
+connecting process. The user running the daemon and root have full access. Other
+local users are rejected unless they are listed in daemon.socket_users or belong
+to a group in daemon.socket_groups, in which case they get the scopes from
+daemon.socket_scopes (by default tasks:write, agents:read, providers:read and
+events:subscribe).
+
+The HTTP listener can serve TLS, either with a certificate and key (--tls-cert and
+--tls-key, or daemon.tls_cert and daemon.tls_key) or with a self-signed certificate
+(--tls-self-signed or daemon.tls_self_signed) that is generated once and kept in the
+data directory. Clients pin the fingerprint of a self-signed certificate, which is
+printed on startup:
+
+  construct context add remote --endpoint https://host:8443 --tls-fingerprint <fingerprint>
+
+With --tls-client-ca (daemon.tls_client_ca), clients can authenticate with a
+certificate signed by one of these certificate authorities instead of a bearer
+token. The common name of the certificate must be the name of a token, whose
+scopes and limits then apply.
+
+Users of an OpenID Connect issuer can authenticate with their ID tokens or other
+JWTs of the issuer, which 'construct context login' obtains. Set daemon.oidc_issuer
+and daemon.oidc_audience (the client ID the tokens are issued to). Users get the
+construct scopes in the scope claim of their token, the scopes that
+daemon.oidc_group_scopes grants to their groups (entries like
+platform=agents:admin) and the scopes in daemon.oidc_scopes. Tokens that grant no
+scope are rejected. The user is identified by the sub claim, or the claim in
+daemon.oidc_subject_claim.
+
+'construct model sync' compares the models with the catalog that is built into
+Construct. Set daemon.catalog_source to a URL or a file on this host to use a newer
+catalog instead.
+
+Every mutating API call and every tool call with side effects is recorded in the
+audit log. Audit events are kept for 90 days, set daemon.audit_retention to change
+this.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			userInfo := getUserInfo(cmd.Context())
 			config := getConfigStore(cmd.Context())
@@ -64,25 +111,49 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 				return fmt.Errorf("failed to get secret provider: %w", err)
 			}
 
-			encryption, err := getEncryptionClient(secretProvider)
+			keysetStore := secret.NewKeysetStore(secretProvider)
+			encryption, err := getEncryptionClient(keysetStore)
 			if err != nil {
 				return fmt.Errorf("failed to get encryption client: %w", err)
 			}
 
-			provider, err := listener.DetectProvider(options.HTTPAddress, options.UnixSocket)
+			var provider listener.Provider
+			provider, err = listener.DetectProvider(options.HTTPAddress, options.UnixSocket)
 			if err != nil {
 				return fmt.Errorf("failed to detect listener provider: %w", err)
 			}
 
+			tlsOptions, err := getTLSOptions(config, options, dataDir)
+			if err != nil {
+				return err
+			}
+
+			var fingerprint string
+			if tlsOptions.Enabled() {
+				tlsConfig, err := tlsOptions.ServerConfig(listener.CertificateHosts(options.HTTPAddress))
+				if err != nil {
+					return fmt.Errorf("failed to configure tls: %w", err)
+				}
+				provider = listener.NewTLSProvider(provider, tlsConfig)
+				if tlsOptions.SelfSignedDir != "" {
+					fingerprint = listener.Fingerprint(tlsConfig)
+				}
+			}
+
 			listener, err := provider.Create()
 			if err != nil {
 				return fmt.Errorf("failed to create listener: %w", err)
 			}
 
+			if fingerprint != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "🔒 Serving TLS with a self-signed certificate, fingerprint %s\n", fingerprint)
+			}
+
 			if explicitLaunch(provider.ActivationType()) {
 				contextManager := shared.NewContextManager(getFileSystem(cmd.Context()), getUserInfo(cmd.Context()))
 				contextName := generateContextName(provider.ActivationType(), listener)
-				_, err = contextManager.UpsertContext(contextName, provider.ActivationType(), listener.Addr().String(), true, nil)
+				endpointContext := listenerEndpointContext(provider.ActivationType(), listener, tlsOptions.Enabled(), fingerprint)
+				_, err = contextManager.UpsertEndpointContext(contextName, endpointContext, true)
 				if err != nil {
 					return fmt.Errorf("failed to upsert context: %w", err)
 				}
@@ -95,10 +166,7 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 				analyticsClient = analytics.NewNoopClient()
 			}
 
-			runtime, err := agent.NewRuntime(
-				db,
-				encryption,
-				listener,
+			runtimeOptions := []agent.RuntimeOption{
 				agent.WithCodeActTools(
 					codeact.NewCreateFileTool(),
 					codeact.NewReadFileTool(),
@@ -112,7 +180,87 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 					codeact.NewPrintTool(),
 				),
 				agent.WithAnalytics(analyticsClient),
-			)
+				agent.WithKeysetStore(keysetStore),
+			}
+
+			if options.RecordCassette != "" {
+				recorder, err := model.NewCassetteRecorder(options.RecordCassette)
+				if err != nil {
+					return fmt.Errorf("failed to open cassette: %w", err)
+				}
+				runtimeOptions = append(runtimeOptions, agent.WithCassetteRecorder(recorder))
+				fmt.Fprintf(cmd.OutOrStdout(), "📼 Recording model invocations to %s\n", options.RecordCassette)
+			}
+
+			traceConfig, err := getModelCallTraceConfig(config)
+			if err != nil {
+				return err
+			}
+			runtimeOptions = append(runtimeOptions, agent.WithModelCallTrace(traceConfig))
+
+			if value, ok := config.Get("daemon.metrics_auth"); ok {
+				required, ok := value.Bool()
+				if !ok {
+					return fmt.Errorf("daemon.metrics_auth is not a boolean")
+				}
+				runtimeOptions = append(runtimeOptions, agent.WithMetricsAuth(required))
+			}
+
+			tracingConfig, err := getTracingConfig(config)
+			if err != nil {
+				return err
+			}
+			runtimeOptions = append(runtimeOptions, agent.WithTracing(tracingConfig))
+
+			if value, ok := config.Get("daemon.event_retention"); ok {
+				retention, ok := value.String()
+				if !ok {
+					return fmt.Errorf("daemon.event_retention is not a duration")
+				}
+				duration, err := ParseDuration(retention)
+				if err != nil {
+					return fmt.Errorf("daemon.event_retention is not a duration: %w", err)
+				}
+				eventLogOptions := event.DefaultEventLogOptions()
+				eventLogOptions.Retention = duration
+				runtimeOptions = append(runtimeOptions, agent.WithEventLog(eventLogOptions))
+			}
+
+			if value, ok := config.Get("daemon.audit_retention"); ok {
+				retention, ok := value.String()
+				if !ok {
+					return fmt.Errorf("daemon.audit_retention is not a duration")
+				}
+				duration, err := ParseDuration(retention)
+				if err != nil {
+					return fmt.Errorf("daemon.audit_retention is not a duration: %w", err)
+				}
+				auditOptions := audit.DefaultOptions()
+				auditOptions.Retention = duration
+				runtimeOptions = append(runtimeOptions, agent.WithAudit(auditOptions))
+			}
+
+			socketPolicy, err := getSocketPolicy(config)
+			if err != nil {
+				return err
+			}
+			runtimeOptions = append(runtimeOptions, agent.WithSocketPolicy(socketPolicy))
+
+			oidcOptions, err := getOIDCOptions(config)
+			if err != nil {
+				return err
+			}
+			runtimeOptions = append(runtimeOptions, agent.WithOIDC(oidcOptions))
+
+			if value, ok := config.Get("daemon.catalog_source"); ok {
+				source, ok := value.String()
+				if !ok {
+					return fmt.Errorf("daemon.catalog_source is not a string")
+				}
+				runtimeOptions = append(runtimeOptions, agent.WithCatalogSource(source))
+			}
+
+			runtime, err := agent.NewRuntime(db, encryption, listener, runtimeOptions...)
 
 			if err != nil {
 				return fmt.Errorf("failed to create agent runtime: %w", err)
@@ -129,6 +277,11 @@ debugging and development. For normal use, 'construct daemon install' is recomme
 
 	cmd.Flags().StringVar(&options.HTTPAddress, "listen-http", "", "The address and port to listen on (e.g., 127.0.0.1:8080)")
 	cmd.Flags().StringVar(&options.UnixSocket, "listen-unix", "", "The path to listen on for Unix socket requests")
+	cmd.Flags().StringVar(&options.RecordCassette, "record-cassette", "", "Record all model invocations to a cassette that can be replayed with a provider of type replay")
+	cmd.Flags().StringVar(&options.TLSCert, "tls-cert", "", "Path to the PEM encoded TLS certificate for the HTTP listener")
+	cmd.Flags().StringVar(&options.TLSKey, "tls-key", "", "Path to the PEM encoded key of the TLS certificate")
+	cmd.Flags().BoolVar(&options.TLSSelfSigned, "tls-self-signed", false, "Serve TLS with a generated self-signed certificate")
+	cmd.Flags().StringVar(&options.TLSClientCA, "tls-client-ca", "", "Path to the certificate authorities whose client certificates are accepted for authentication")
 
 	return cmd
 }
@@ -142,34 +295,28 @@ func generateContextName(kind string, listener net.Listener) string {
 	return fmt.Sprintf("%s-%x", kind, hash[:3])
 }
 
-func getEncryptionClient(secretProvider secret.Provider) (*secret.Encryption, error) {
-	var keyHandle *keyset.Handle
-	keyHandleJson, err := secretProvider.Get(secret.EncryptionKeySecret())
-	if err != nil {
-		if !errors.Is(err, &secret.ErrSecretNotFound{}) {
-			return nil, fmt.Errorf("failed to get encryption key secret: %w", err)
-		}
+// listenerEndpointContext describes how clients on this machine reach the listener. A
+// self-signed certificate is pinned by its fingerprint.
+func listenerEndpointContext(kind string, listener net.Listener, secure bool, fingerprint string) api.EndpointContext {
+	if kind == "unix" {
+		return api.EndpointContext{Kind: "unix", Address: listener.Addr().String()}
+	}
 
-		slog.Debug("generating new encryption key")
-		keyHandle, err = secret.GenerateKeyset()
-		if err != nil {
-			return nil, fmt.Errorf("failed to generate encryption keyset: %w", err)
-		}
-		keysetJson, err := secret.KeysetToJSON(keyHandle)
-		if err != nil {
-			return nil, fmt.Errorf("failed to convert keyset to JSON: %w", err)
-		}
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	endpointContext := api.EndpointContext{Kind: "http", Address: scheme + "://" + listener.Addr().String()}
+	if fingerprint != "" {
+		endpointContext.TLS = &api.TLSConfig{Fingerprint: fingerprint}
+	}
+	return endpointContext
+}
 
-		err = secretProvider.Set(secret.EncryptionKeySecret(), keysetJson)
-		if err != nil {
-			return nil, fmt.Errorf("failed to store encryption key secret: %w", err)
-		}
-	} else {
-		slog.Debug("loading encryption key")
-		keyHandle, err = secret.KeysetFromJSON(keyHandleJson)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load encryption keyset from JSON: %w", err)
-		}
+func getEncryptionClient(keysetStore *secret.KeysetStore) (*secret.Encryption, error) {
+	keyHandle, err := keysetStore.LoadOrGenerate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load encryption keyset: %w", err)
 	}
 
 	return secret.NewClient(keyHandle)
@@ -190,6 +337,230 @@ func getSecretProvider(cfg *config.Store, userInfo shared.UserInfo, fs afero.Fs)
 	return secret.NewKeyringProvider(), nil
 }
 
+// getModelCallTraceConfig reads the capture of model calls from the daemon.trace_* settings.
+func getModelCallTraceConfig(cfg *config.Store) (agent.ModelCallTraceConfig, error) {
+	traceConfig := agent.DefaultModelCallTraceConfig()
+
+	if value, ok := cfg.Get("daemon.trace_model_calls"); ok {
+		enabled, ok := value.Bool()
+		if !ok {
+			return traceConfig, fmt.Errorf("daemon.trace_model_calls is not a boolean")
+		}
+		traceConfig.AllTasks = enabled
+	}
+
+	if value, ok := cfg.Get("daemon.trace_max_calls_per_task"); ok {
+		maxCalls, ok := value.Int()
+		if !ok || maxCalls < 0 {
+			return traceConfig, fmt.Errorf("daemon.trace_max_calls_per_task is not a positive number")
+		}
+		traceConfig.MaxCallsPerTask = int(maxCalls)
+	}
+
+	if value, ok := cfg.Get("daemon.trace_retention"); ok {
+		retention, ok := value.String()
+		if !ok {
+			return traceConfig, fmt.Errorf("daemon.trace_retention is not a duration")
+		}
+		duration, err := ParseDuration(retention)
+		if err != nil {
+			return traceConfig, fmt.Errorf("daemon.trace_retention is not a duration: %w", err)
+		}
+		traceConfig.Retention = duration
+	}
+
+	return traceConfig, nil
+}
+
+// getSocketPolicy reads which local users besides the owner may use the Unix socket from the
+// daemon.socket_* settings.
+func getSocketPolicy(cfg *config.Store) (auth.UnixSocketPolicy, error) {
+	var policy auth.UnixSocketPolicy
+
+	if value, ok := cfg.Get("daemon.socket_users"); ok {
+		users, ok := value.StringList()
+		if !ok {
+			return policy, fmt.Errorf("daemon.socket_users is not a list of users")
+		}
+		policy.Users = users
+	}
+
+	if value, ok := cfg.Get("daemon.socket_groups"); ok {
+		groups, ok := value.StringList()
+		if !ok {
+			return policy, fmt.Errorf("daemon.socket_groups is not a list of groups")
+		}
+		policy.Groups = groups
+	}
+
+	if value, ok := cfg.Get("daemon.socket_scopes"); ok {
+		names, ok := value.StringList()
+		if !ok {
+			return policy, fmt.Errorf("daemon.socket_scopes is not a list of scopes")
+		}
+		scopes, err := auth.ParseScopes(names)
+		if err != nil {
+			return policy, fmt.Errorf("daemon.socket_scopes: %w", err)
+		}
+		policy.Scopes = scopes
+	}
+
+	if err := policy.Validate(); err != nil {
+		return policy, fmt.Errorf("invalid daemon.socket_* settings: %w", err)
+	}
+
+	return policy, nil
+}
+
+// getOIDCOptions reads the OpenID Connect issuer whose tokens are accepted from the daemon.oidc_*
+// settings.
+func getOIDCOptions(cfg *config.Store) (auth.OIDCOptions, error) {
+	var options auth.OIDCOptions
+
+	settings := []struct {
+		key    string
+		target *string
+	}{
+		{key: "daemon.oidc_issuer", target: &options.Issuer},
+		{key: "daemon.oidc_audience", target: &options.Audience},
+		{key: "daemon.oidc_jwks_url", target: &options.JWKSURL},
+		{key: "daemon.oidc_subject_claim", target: &options.SubjectClaim},
+		{key: "daemon.oidc_groups_claim", target: &options.GroupsClaim},
+	}
+	for _, setting := range settings {
+		if value, ok := cfg.Get(setting.key); ok {
+			str, ok := value.String()
+			if !ok {
+				return options, fmt.Errorf("%s is not a string", setting.key)
+			}
+			*setting.target = str
+		}
+	}
+
+	if value, ok := cfg.Get("daemon.oidc_group_scopes"); ok {
+		entries, ok := value.StringList()
+		if !ok {
+			return options, fmt.Errorf("daemon.oidc_group_scopes is not a list of group=scope entries")
+		}
+		options.GroupScopes = make(map[string][]auth.Scope)
+		for _, entry := range entries {
+			group, name, ok := strings.Cut(entry, "=")
+			if !ok || group == "" {
+				return options, fmt.Errorf("daemon.oidc_group_scopes: %q is not a group=scope entry", entry)
+			}
+			scopes, err := auth.ParseScopes([]string{name})
+			if err != nil {
+				return options, fmt.Errorf("daemon.oidc_group_scopes: %w", err)
+			}
+			options.GroupScopes[group] = append(options.GroupScopes[group], scopes...)
+		}
+	}
+
+	if value, ok := cfg.Get("daemon.oidc_scopes"); ok {
+		names, ok := value.StringList()
+		if !ok {
+			return options, fmt.Errorf("daemon.oidc_scopes is not a list of scopes")
+		}
+		scopes, err := auth.ParseScopes(names)
+		if err != nil {
+			return options, fmt.Errorf("daemon.oidc_scopes: %w", err)
+		}
+		options.DefaultScopes = scopes
+	}
+
+	if err := options.Validate(); err != nil {
+		return options, fmt.Errorf("invalid daemon.oidc_* settings: %w", err)
+	}
+
+	return options, nil
+}
+
+// getTLSOptions reads the TLS configuration of the HTTP listener from the flags and the
+// daemon.tls_* settings. Flags take precedence.
+func getTLSOptions(cfg *config.Store, options daemonRunOptions, dataDir string) (listener.TLSOptions, error) {
+	var tlsOptions listener.TLSOptions
+	selfSigned := options.TLSSelfSigned
+
+	settings := []struct {
+		key    string
+		flag   string
+		target *string
+	}{
+		{key: "daemon.tls_cert", flag: options.TLSCert, target: &tlsOptions.CertFile},
+		{key: "daemon.tls_key", flag: options.TLSKey, target: &tlsOptions.KeyFile},
+		{key: "daemon.tls_client_ca", flag: options.TLSClientCA, target: &tlsOptions.ClientCAFile},
+	}
+	for _, setting := range settings {
+		*setting.target = setting.flag
+		if setting.flag != "" {
+			continue
+		}
+		if value, ok := cfg.Get(setting.key); ok {
+			path, ok := value.String()
+			if !ok {
+				return tlsOptions, fmt.Errorf("%s is not a path", setting.key)
+			}
+			*setting.target = path
+		}
+	}
+
+	if value, ok := cfg.Get("daemon.tls_self_signed"); ok && !selfSigned {
+		enabled, ok := value.Bool()
+		if !ok {
+			return tlsOptions, fmt.Errorf("daemon.tls_self_signed is not a boolean")
+		}
+		selfSigned = enabled
+	}
+	if selfSigned {
+		tlsOptions.SelfSignedDir = filepath.Join(dataDir, "tls")
+	}
+
+	if tlsOptions.Enabled() && options.UnixSocket != "" {
+		return tlsOptions, fmt.Errorf("tls is only supported for the HTTP listener")
+	}
+
+	if err := tlsOptions.Validate(); err != nil {
+		return tlsOptions, fmt.Errorf("invalid tls settings: %w", err)
+	}
+
+	return tlsOptions, nil
+}
+
+// getTracingConfig reads the export of OpenTelemetry traces from the daemon.tracing_* settings.
+func getTracingConfig(cfg *config.Store) (agent.TracingConfig, error) {
+	var tracingConfig agent.TracingConfig
+
+	if value, ok := cfg.Get("daemon.tracing_exporter"); ok {
+		exporter, ok := value.String()
+		if !ok || !slices.Contains(agent.SupportedTracingExporters(), agent.TracingExporter(exporter)) {
+			return tracingConfig, fmt.Errorf("daemon.tracing_exporter must be one of %v", agent.SupportedTracingExporters())
+		}
+		tracingConfig.Exporter = agent.TracingExporter(exporter)
+	}
+
+	if value, ok := cfg.Get("daemon.tracing_endpoint"); ok {
+		endpoint, ok := value.String()
+		if !ok {
+			return tracingConfig, fmt.Errorf("daemon.tracing_endpoint is not a string")
+		}
+		tracingConfig.Endpoint = endpoint
+	}
+
+	if value, ok := cfg.Get("daemon.tracing_file"); ok {
+		file, ok := value.String()
+		if !ok {
+			return tracingConfig, fmt.Errorf("daemon.tracing_file is not a string")
+		}
+		tracingConfig.File = file
+	}
+
+	if tracingConfig.Exporter == agent.TracingExporterFile && tracingConfig.File == "" {
+		return tracingConfig, fmt.Errorf("daemon.tracing_file must be set for the file exporter")
+	}
+
+	return tracingConfig, nil
+}
+
 func setupMemory(ctx context.Context, db *memory.Client) error {
 	return db.Schema.Create(ctx,
 		migrate.WithDropColumn(true),
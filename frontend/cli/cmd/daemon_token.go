@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
 	v1 "github.com/furisto/construct/api/go/v1"
 	"github.com/spf13/cobra"
 )
@@ -21,16 +24,25 @@ Token management requires admin privileges (local Unix socket connection).`,
 	cmd.AddCommand(NewDaemonTokenCreateSetupCmd())
 	cmd.AddCommand(NewDaemonTokenListCmd())
 	cmd.AddCommand(NewDaemonTokenRevokeCmd())
+	cmd.AddCommand(NewDaemonTokenRotateCmd())
 
 	return cmd
 }
 
 type TokenDisplay struct {
-	ID      string `json:"id" detail:"default"`
-	Name    string `json:"name" detail:"default"`
-	Created string `json:"created" detail:"default"`
-	Expires string `json:"expires" detail:"default"`
-	Status  string `json:"status" detail:"default"`
+	ID         string   `json:"id" detail:"default"`
+	Name       string   `json:"name" detail:"default"`
+	Created    string   `json:"created" detail:"default"`
+	Expires    string   `json:"expires" detail:"default"`
+	Status     string   `json:"status" detail:"default"`
+	LastUsed   string   `json:"last_used,omitempty" detail:"default"`
+	Scopes     []string `json:"scopes,omitempty" detail:"default"`
+	Agents     []string `json:"agents,omitempty" detail:"full"`
+	Workspaces []string `json:"workspaces,omitempty" detail:"full"`
+	Quota      string   `json:"quota,omitempty" detail:"full"`
+	LastUsedBy string   `json:"last_used_by,omitempty" detail:"full"`
+	Rotated    string   `json:"rotated,omitempty" detail:"full"`
+	Replaced   string   `json:"replaced_expires,omitempty" detail:"full"`
 }
 
 func ConvertTokenInfoToDisplay(token *v1.TokenInfo) *TokenDisplay {
@@ -39,13 +51,48 @@ func ConvertTokenInfoToDisplay(token *v1.TokenInfo) *TokenDisplay {
 		status = "Expired"
 	}
 
-	return &TokenDisplay{
-		ID:      token.Id,
-		Name:    token.Name,
-		Created: FormatRelativeTime(token.CreatedAt.AsTime()),
-		Expires: FormatRelativeTime(token.ExpiresAt.AsTime()),
-		Status:  status,
+	display := &TokenDisplay{
+		ID:         token.Id,
+		Name:       token.Name,
+		Created:    FormatRelativeTime(token.CreatedAt.AsTime()),
+		Expires:    FormatRelativeTime(token.ExpiresAt.AsTime()),
+		Status:     status,
+		Scopes:     token.Scopes,
+		Agents:     token.AgentIds,
+		Workspaces: token.Workspaces,
+		Quota:      formatQuota(token.Quota),
+		LastUsedBy: token.LastUsedAddress,
+	}
+
+	if token.LastUsedAt != nil {
+		display.LastUsed = FormatRelativeTime(token.LastUsedAt.AsTime())
+	}
+	if token.RotatedAt != nil {
+		display.Rotated = FormatRelativeTime(token.RotatedAt.AsTime())
 	}
+	if token.PreviousExpiresAt != nil {
+		display.Replaced = FormatRelativeTime(token.PreviousExpiresAt.AsTime())
+	}
+
+	return display
+}
+
+func formatQuota(quota *v1.Quota) string {
+	var limits []string
+	if quota.GetMaxConcurrentTasks() > 0 {
+		limits = append(limits, fmt.Sprintf("%d concurrent tasks", quota.GetMaxConcurrentTasks()))
+	}
+	if quota.GetDailySpend() > 0 {
+		limits = append(limits, fmt.Sprintf("$%.2f/day", quota.GetDailySpend()))
+	}
+	if quota.GetMonthlySpend() > 0 {
+		limits = append(limits, fmt.Sprintf("$%.2f/month", quota.GetMonthlySpend()))
+	}
+	if quota.GetDailyTokens() > 0 {
+		limits = append(limits, fmt.Sprintf("%d tokens/day", quota.GetDailyTokens()))
+	}
+
+	return strings.Join(limits, ", ")
 }
 
 type TokenCreateDisplay struct {
@@ -54,6 +101,13 @@ type TokenCreateDisplay struct {
 	ExpiresAt string `json:"expires_at" yaml:"expires_at"`
 }
 
+type TokenRotateDisplay struct {
+	Name              string `json:"name" yaml:"name"`
+	Token             string `json:"token" yaml:"token"`
+	ExpiresAt         string `json:"expires_at" yaml:"expires_at"`
+	PreviousExpiresAt string `json:"previous_expires_at" yaml:"previous_expires_at"`
+}
+
 type SetupCodeDisplay struct {
 	TokenName string `json:"token_name" yaml:"token_name"`
 	SetupCode string `json:"setup_code" yaml:"setup_code"`
@@ -14,9 +14,33 @@ import (
 type tokenCreateOptions struct {
 	Description   string
 	Expires       string
+	Scopes        []string
+	Agents        []string
+	Workspaces    []string
+	Quota         tokenQuotaOptions
 	RenderOptions RenderOptions
 }
 
+type tokenQuotaOptions struct {
+	MaxConcurrentTasks int32
+	DailySpend         float64
+	MonthlySpend       float64
+	DailyTokens        int64
+}
+
+func (o tokenQuotaOptions) toAPI() *v1.Quota {
+	if o == (tokenQuotaOptions{}) {
+		return nil
+	}
+
+	return &v1.Quota{
+		MaxConcurrentTasks: o.MaxConcurrentTasks,
+		DailySpend:         o.DailySpend,
+		MonthlySpend:       o.MonthlySpend,
+		DailyTokens:        o.DailyTokens,
+	}
+}
+
 func NewDaemonTokenCreateCmd() *cobra.Command {
 	var options tokenCreateOptions
 
@@ -30,7 +54,29 @@ The token is displayed once and cannot be retrieved again. Store it securely
 in a password manager or system keyring.
 
 Tokens are used to authenticate CLI commands against remote daemon instances
-over HTTPS. Configure a context with the token using 'construct context add'.`,
+over HTTPS. Configure a context with the token using 'construct context add'.
+
+Without scopes the token can use every API except token management and the
+audit log, which requires the audit:read scope. Scopes restrict it to a subset of
+the API:
+
+  tasks:read        read tasks and messages
+  tasks:write       create, update and delete tasks and messages (includes tasks:read)
+  agents:read       read agents, models and skills
+  agents:admin      manage agents and skills (includes agents:read)
+  providers:read    read model providers
+  providers:admin   manage model providers and models (includes providers:read)
+  events:subscribe  subscribe to the event stream
+  webhooks:admin    manage webhooks
+  metrics:read      scrape the /metrics endpoint
+  audit:read        read the audit log
+
+Tokens can additionally be limited to the tasks of specific agents or to tasks
+in specific workspaces on the daemon host.
+
+Quotas limit how many tasks of the token may run at the same time and how much
+it may spend per day and month (in USD) or how many tokens it may use per day.
+Days and months are counted in UTC. Use 'construct quota' to see the usage.`,
 		Example: `  # Create token with default 90-day expiry
   construct daemon token create laptop-token
 
@@ -39,6 +85,16 @@ over HTTPS. Configure a context with the token using 'construct context add'.`,
     --description "GitHub Actions pipeline token" \
     --expires 30d
 
+  # Create a read-only token for a dashboard
+  construct daemon token create dashboard --scope tasks:read --scope events:subscribe
+
+  # Create a token that can only work on one project with one agent
+  construct daemon token create project-bot \
+    --scope tasks:write --agent coder --workspace /home/user/project
+
+  # Create a CI token that runs two tasks at a time and spends at most $20 a day
+  construct daemon token create ci --max-concurrent-tasks 2 --daily-spend 20
+
   # Create token with JSON output for scripting
   construct daemon token create automation --output json`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -57,8 +113,11 @@ over HTTPS. Configure a context with the token using 'construct context add'.`,
 
 			req := &connect.Request[v1.CreateTokenRequest]{
 				Msg: &v1.CreateTokenRequest{
-					Name:      name,
-					ExpiresIn: durationpb.New(expiresDuration),
+					Name:       name,
+					ExpiresIn:  durationpb.New(expiresDuration),
+					Scopes:     options.Scopes,
+					Workspaces: options.Workspaces,
+					Quota:      options.Quota.toAPI(),
 				},
 			}
 
@@ -66,6 +125,14 @@ over HTTPS. Configure a context with the token using 'construct context add'.`,
 				req.Msg.Description = &options.Description
 			}
 
+			for _, agent := range options.Agents {
+				agentID, err := getAgentID(cmd.Context(), client, agent)
+				if err != nil {
+					return fmt.Errorf("failed to resolve agent %s: %w", agent, err)
+				}
+				req.Msg.AgentIds = append(req.Msg.AgentIds, agentID)
+			}
+
 			resp, err := client.Auth().CreateToken(cmd.Context(), req)
 			if err != nil {
 				return fmt.Errorf("failed to create token: %w", err)
@@ -92,6 +159,13 @@ over HTTPS. Configure a context with the token using 'construct context add'.`,
 
 	cmd.Flags().StringVar(&options.Description, "description", "", "Optional description of token purpose")
 	cmd.Flags().StringVar(&options.Expires, "expires", "90d", "Token lifetime (default: 90d, max: 365d)")
+	cmd.Flags().StringArrayVar(&options.Scopes, "scope", []string{}, "Restrict the token to a scope. Can be used multiple times")
+	cmd.Flags().StringArrayVar(&options.Agents, "agent", []string{}, "Limit the token to tasks of an agent (name or ID). Can be used multiple times")
+	cmd.Flags().StringArrayVar(&options.Workspaces, "workspace", []string{}, "Limit the token to tasks in a directory on the daemon host. Can be used multiple times")
+	cmd.Flags().Int32Var(&options.Quota.MaxConcurrentTasks, "max-concurrent-tasks", 0, "Maximum number of tasks of the token that run at the same time (0 for no limit)")
+	cmd.Flags().Float64Var(&options.Quota.DailySpend, "daily-spend", 0, "Maximum spend per day in USD (0 for no limit)")
+	cmd.Flags().Float64Var(&options.Quota.MonthlySpend, "monthly-spend", 0, "Maximum spend per month in USD (0 for no limit)")
+	cmd.Flags().Int64Var(&options.Quota.DailyTokens, "daily-tokens", 0, "Maximum input and output tokens per day (0 for no limit)")
 	addRenderOptions(cmd, &options.RenderOptions)
 	WithCardFormat(&options.RenderOptions)
 
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDaemonTokenCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	agentID := uuid.New().String()
+	expiresAt := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - scopes and limits",
+			Command: []string{"daemon", "token", "create", "project-bot", "--scope", "tasks:write", "--scope", "events:subscribe", "--agent", "coder", "--workspace", "/home/user/project", "--expires", "30d"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentListMock(mockClient, "coder", agentID)
+				mockClient.Auth.EXPECT().CreateToken(
+					gomock.Any(),
+					connect.NewRequest(&v1.CreateTokenRequest{
+						Name:       "project-bot",
+						ExpiresIn:  durationpb.New(30 * 24 * time.Hour),
+						Scopes:     []string{"tasks:write", "events:subscribe"},
+						AgentIds:   []string{agentID},
+						Workspaces: []string{"/home/user/project"},
+					}),
+				).Return(createTokenResponse("ct_secret", expiresAt), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &TokenCreateDisplay{
+					Name:      "project-bot",
+					Token:     "ct_secret",
+					ExpiresAt: "2026-01-15T12:00:00Z",
+				},
+			},
+		},
+		{
+			Name:    "success - quota",
+			Command: []string{"daemon", "token", "create", "ci", "--max-concurrent-tasks", "2", "--daily-spend", "20", "--daily-tokens", "1000000"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Auth.EXPECT().CreateToken(
+					gomock.Any(),
+					connect.NewRequest(&v1.CreateTokenRequest{
+						Name:       "ci",
+						ExpiresIn:  durationpb.New(90 * 24 * time.Hour),
+						Scopes:     []string{},
+						Workspaces: []string{},
+						Quota: &v1.Quota{
+							MaxConcurrentTasks: 2,
+							DailySpend:         20,
+							DailyTokens:        1000000,
+						},
+					}),
+				).Return(createTokenResponse("ct_secret", expiresAt), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &TokenCreateDisplay{
+					Name:      "ci",
+					Token:     "ct_secret",
+					ExpiresAt: "2026-01-15T12:00:00Z",
+				},
+			},
+		},
+		{
+			Name:    "error - unknown scope",
+			Command: []string{"daemon", "token", "create", "bot", "--scope", "tasks:delete"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Auth.EXPECT().CreateToken(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create token: invalid_argument",
+			},
+		},
+	})
+}
+
+func createTokenResponse(token string, expiresAt time.Time) *connect.Response[v1.CreateTokenResponse] {
+	return &connect.Response[v1.CreateTokenResponse]{
+		Msg: &v1.CreateTokenResponse{
+			Token:     token,
+			ExpiresAt: timestamppb.New(expiresAt),
+		},
+	}
+}
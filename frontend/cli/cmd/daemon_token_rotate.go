@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type tokenRotateOptions struct {
+	Expires       string
+	Overlap       string
+	RenderOptions RenderOptions
+}
+
+func NewDaemonTokenRotateCmd() *cobra.Command {
+	var options tokenRotateOptions
+
+	cmd := &cobra.Command{
+		Use:   "rotate <id> [flags]",
+		Short: "Replace a token with a successor",
+		Args:  cobra.ExactArgs(1),
+		Long: `Replace a token with a successor without downtime.
+
+The successor keeps the name, scopes, limits and quota of the token. The
+replaced token stays valid for an overlap window (default: 24h, max: 7d), so
+the systems that use it can switch over to the successor. An overlap of 0
+invalidates it immediately. The token cannot be rotated again until the overlap
+window has ended, revoke it if it has to stop working sooner.
+
+Contexts that authenticate with a token rotate it on their own when it is close
+to expiry, so rotating by hand is mostly needed for tokens that leaked or are
+used outside of the CLI.`,
+		Example: `  # Rotate a token and keep the old one valid for a day
+  construct daemon token rotate b7f8c9d0-1234-5678-90ab-cdef12345678
+
+  # Rotate a leaked token and invalidate it immediately
+  construct daemon token rotate b7f8c9d0-1234-5678-90ab-cdef12345678 --overlap 0
+
+  # Rotate a token and give the successor a lifetime of 30 days
+  construct daemon token rotate b7f8c9d0-1234-5678-90ab-cdef12345678 --expires 30d`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tokenID := args[0]
+
+			if _, err := uuid.Parse(tokenID); err != nil {
+				return fmt.Errorf("invalid token ID format: must be UUID")
+			}
+
+			req := &connect.Request[v1.RotateTokenRequest]{
+				Msg: &v1.RotateTokenRequest{Id: &tokenID},
+			}
+
+			if options.Expires != "" {
+				expires, err := ParseDuration(options.Expires)
+				if err != nil {
+					return fmt.Errorf("invalid expiry duration: %w", err)
+				}
+				if err := ValidateTokenExpiry(expires); err != nil {
+					return err
+				}
+				req.Msg.ExpiresIn = durationpb.New(expires)
+			}
+
+			if options.Overlap != "" {
+				overlap, err := ParseDuration(options.Overlap)
+				if err != nil {
+					return fmt.Errorf("invalid overlap duration: %w", err)
+				}
+				if overlap < 0 || overlap > 7*24*time.Hour {
+					return fmt.Errorf("overlap must be between 0 and 7 days")
+				}
+				req.Msg.Overlap = durationpb.New(overlap)
+			}
+
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Auth().RotateToken(cmd.Context(), req)
+			if err != nil {
+				return fmt.Errorf("failed to rotate token: %w", err)
+			}
+
+			display := &TokenRotateDisplay{
+				Name:              resp.Msg.Name,
+				Token:             resp.Msg.Token,
+				ExpiresAt:         resp.Msg.ExpiresAt.AsTime().Format(time.RFC3339),
+				PreviousExpiresAt: resp.Msg.PreviousExpiresAt.AsTime().Format(time.RFC3339),
+			}
+
+			if err := getRenderer(cmd.Context()).Render(display, &options.RenderOptions); err != nil {
+				return err
+			}
+
+			if options.RenderOptions.Format == OutputFormatCard || options.RenderOptions.Format == "" {
+				fmt.Fprintln(os.Stderr, "")
+				fmt.Fprintln(os.Stderr, "⚠️  Save this token securely - it cannot be retrieved again.")
+				fmt.Fprintln(os.Stderr, "   The replaced token stops working", FormatRelativeTime(resp.Msg.PreviousExpiresAt.AsTime()))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Expires, "expires", "", "Lifetime of the successor (default: lifetime of the token, max: 365d)")
+	cmd.Flags().StringVar(&options.Overlap, "overlap", "", "How long the replaced token stays valid (default: 24h, max: 7d)")
+	addRenderOptions(cmd, &options.RenderOptions)
+	WithCardFormat(&options.RenderOptions)
+
+	return cmd
+}
@@ -24,15 +24,18 @@ func NewMessageCmd() *cobra.Command {
 }
 
 type DisplayMessage struct {
-	ID        string              `json:"id" yaml:"id" detail:"default"`
-	TaskID    string              `json:"task_id" yaml:"task_id" detail:"default"`
-	Agent     string              `json:"agent" yaml:"agent" detail:"default"`
-	Model     string              `json:"model" yaml:"model" detail:"default"`
-	Role      string              `json:"role" yaml:"role" detail:"default"`
-	Content   string              `json:"content" yaml:"content"`
-	CreatedAt time.Time           `json:"created_at" yaml:"created_at" detail:"full"`
-	UpdatedAt time.Time           `json:"updated_at" yaml:"updated_at" detail:"full"`
-	Usage     DisplayMessageUsage `json:"usage" yaml:"usage"`
+	ID        string                 `json:"id" yaml:"id" detail:"default"`
+	TaskID    string                 `json:"task_id" yaml:"task_id" detail:"default"`
+	Agent     string                 `json:"agent" yaml:"agent" detail:"default"`
+	Model     string                 `json:"model" yaml:"model" detail:"default"`
+	Role      string                 `json:"role" yaml:"role" detail:"default"`
+	Content   string                 `json:"content" yaml:"content"`
+	CreatedAt time.Time              `json:"created_at" yaml:"created_at" detail:"full"`
+	UpdatedAt time.Time              `json:"updated_at" yaml:"updated_at" detail:"full"`
+	Usage     DisplayMessageUsage    `json:"usage" yaml:"usage"`
+	Routing   *DisplayMessageRouting `json:"routing,omitempty" yaml:"routing,omitempty"`
+	// TraceID identifies the trace of the reconcile pass that produced the message.
+	TraceID string `json:"trace_id,omitempty" yaml:"trace_id,omitempty" detail:"full"`
 }
 
 type DisplayMessageUsage struct {
@@ -41,6 +44,17 @@ type DisplayMessageUsage struct {
 	CacheWriteTokens int64   `json:"cache_write_tokens" yaml:"cache_write_tokens"`
 	CacheReadTokens  int64   `json:"cache_read_tokens" yaml:"cache_read_tokens"`
 	Cost             float64 `json:"cost" yaml:"cost"`
+	// EstimatedInputTokens is the prompt size that was estimated before the model was invoked.
+	EstimatedInputTokens int64  `json:"estimated_input_tokens,omitempty" yaml:"estimated_input_tokens,omitempty"`
+	EstimateSource       string `json:"estimate_source,omitempty" yaml:"estimate_source,omitempty"`
+}
+
+type DisplayMessageRouting struct {
+	Rule                  string  `json:"rule,omitempty" yaml:"rule,omitempty"`
+	TurnType              string  `json:"turn_type" yaml:"turn_type"`
+	EstimatedPromptTokens int64   `json:"estimated_prompt_tokens" yaml:"estimated_prompt_tokens"`
+	RecentToolFailures    int64   `json:"recent_tool_failures" yaml:"recent_tool_failures"`
+	DefaultModelCost      float64 `json:"default_model_cost" yaml:"default_model_cost"`
 }
 
 func ConvertMessageToDisplay(message *v1.Message) *DisplayMessage {
@@ -72,6 +86,37 @@ func ConvertMessageToDisplay(message *v1.Message) *DisplayMessage {
 		CreatedAt: message.Metadata.CreatedAt.AsTime(),
 		UpdatedAt: message.Metadata.UpdatedAt.AsTime(),
 		Usage:     usage,
+		Routing:   ConvertMessageRoutingToDisplay(message.Status.GetRouting()),
+		TraceID:   message.Status.GetTraceId(),
+	}
+}
+
+func ConvertMessageRoutingToDisplay(routing *v1.MessageRouting) *DisplayMessageRouting {
+	if routing == nil {
+		return nil
+	}
+
+	return &DisplayMessageRouting{
+		Rule:                  routing.Rule,
+		TurnType:              ConvertTurnTypeToString(routing.TurnType),
+		EstimatedPromptTokens: routing.EstimatedPromptTokens,
+		RecentToolFailures:    routing.RecentToolFailures,
+		DefaultModelCost:      routing.DefaultModelCost,
+	}
+}
+
+func ConvertTurnTypeToString(turnType v1.TurnType) string {
+	switch turnType {
+	case v1.TurnType_TURN_TYPE_FIRST:
+		return "first"
+	case v1.TurnType_TURN_TYPE_USER_MESSAGE:
+		return "user_message"
+	case v1.TurnType_TURN_TYPE_TOOL_RESULT:
+		return "tool_result"
+	case v1.TurnType_TURN_TYPE_TOOL_ERROR:
+		return "tool_error"
+	default:
+		return "unknown"
 	}
 }
 
@@ -81,11 +126,13 @@ func ConvertMessageUsageToDisplay(usage *v1.MessageUsage) DisplayMessageUsage {
 	}
 
 	return DisplayMessageUsage{
-		InputTokens:      usage.InputTokens,
-		OutputTokens:     usage.OutputTokens,
-		CacheWriteTokens: usage.CacheWriteTokens,
-		CacheReadTokens:  usage.CacheReadTokens,
-		Cost:             usage.Cost,
+		InputTokens:          usage.InputTokens,
+		OutputTokens:         usage.OutputTokens,
+		CacheWriteTokens:     usage.CacheWriteTokens,
+		CacheReadTokens:      usage.CacheReadTokens,
+		Cost:                 usage.Cost,
+		EstimatedInputTokens: usage.EstimatedInputTokens,
+		EstimateSource:       usage.EstimateSource,
 	}
 }
 
@@ -17,6 +17,7 @@ func NewModelCmd() *cobra.Command {
 	cmd.AddCommand(NewModelGetCmd())
 	cmd.AddCommand(NewModelListCmd())
 	cmd.AddCommand(NewModelDeleteCmd())
+	cmd.AddCommand(NewModelSyncCmd())
 
 	return cmd
 }
@@ -32,6 +32,8 @@ Supported providers:
 	cmd.AddCommand(NewModelProviderGetCmd())
 	cmd.AddCommand(NewModelProviderListCmd())
 	cmd.AddCommand(NewModelProviderDeleteCmd())
+	cmd.AddCommand(NewModelProviderKeyCmd())
+	cmd.AddCommand(NewModelProviderTestCmd())
 
 	return cmd
 }
@@ -44,6 +46,10 @@ const (
 	ModelProviderTypeAnthropic ModelProviderType = "anthropic"
 	ModelProviderTypeGemini    ModelProviderType = "gemini"
 	ModelProviderTypeXAI       ModelProviderType = "xai"
+	ModelProviderTypeDeepSeek  ModelProviderType = "deepseek"
+	ModelProviderTypeBedrock   ModelProviderType = "bedrock"
+	ModelProviderTypeReplay    ModelProviderType = "replay"
+	ModelProviderTypeMock      ModelProviderType = "mock"
 	ModelProviderTypeUnknown   ModelProviderType = "unknown"
 )
 
@@ -109,8 +115,16 @@ func ToModelProviderType(v string) (ModelProviderType, error) {
 		return ModelProviderTypeGemini, nil
 	case "xai":
 		return ModelProviderTypeXAI, nil
+	case "deepseek":
+		return ModelProviderTypeDeepSeek, nil
+	case "bedrock":
+		return ModelProviderTypeBedrock, nil
+	case "replay":
+		return ModelProviderTypeReplay, nil
+	case "mock":
+		return ModelProviderTypeMock, nil
 	default:
-		return ModelProviderTypeUnknown, errors.New(`must be one of "openai","anthropic","gemini","xai"`)
+		return ModelProviderTypeUnknown, errors.New(`must be one of "openai","anthropic","gemini","xai","deepseek","bedrock","replay","mock"`)
 	}
 }
 
@@ -124,6 +138,14 @@ func (e *ModelProviderType) ToAPI() (v1.ModelProviderType, error) {
 		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_GEMINI, nil
 	case ModelProviderTypeXAI:
 		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_XAI, nil
+	case ModelProviderTypeDeepSeek:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_DEEPSEEK, nil
+	case ModelProviderTypeBedrock:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_BEDROCK, nil
+	case ModelProviderTypeReplay:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_REPLAY, nil
+	case ModelProviderTypeMock:
+		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_MOCK, nil
 	default:
 		return v1.ModelProviderType_MODEL_PROVIDER_TYPE_UNSPECIFIED, errors.New("invalid model provider type")
 	}
@@ -139,25 +161,52 @@ func ConvertModelProviderTypeToDisplay(modelProviderType v1.ModelProviderType) M
 		return ModelProviderTypeGemini
 	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_XAI:
 		return ModelProviderTypeXAI
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_DEEPSEEK:
+		return ModelProviderTypeDeepSeek
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_BEDROCK:
+		return ModelProviderTypeBedrock
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_REPLAY:
+		return ModelProviderTypeReplay
+	case v1.ModelProviderType_MODEL_PROVIDER_TYPE_MOCK:
+		return ModelProviderTypeMock
 	}
 
 	return ModelProviderTypeUnknown
 }
 
 type ModelProviderDisplay struct {
-	Id           string            `json:"id" detail:"default"`
-	Name         string            `json:"name" detail:"default"`
-	ProviderType ModelProviderType `json:"provider_type" detail:"default"`
-	Enabled      bool              `json:"enabled" detail:"full"`
+	Id           string               `json:"id" detail:"default"`
+	Name         string               `json:"name" detail:"default"`
+	ProviderType ModelProviderType    `json:"provider_type" detail:"default"`
+	Enabled      bool                 `json:"enabled" detail:"full"`
+	KeySelection KeySelectionStrategy `json:"key_selection,omitempty" detail:"full"`
+	Keys         int                  `json:"keys,omitempty" detail:"full"`
+
+	RequestsPerMinute int64 `json:"requests_per_minute,omitempty" detail:"full"`
+	TokensPerMinute   int64 `json:"tokens_per_minute,omitempty" detail:"full"`
+	MaxInFlight       int64 `json:"max_in_flight,omitempty" detail:"full"`
 }
 
 func ConvertModelProviderToDisplay(modelProvider *v1.ModelProvider) *ModelProviderDisplay {
-	return &ModelProviderDisplay{
+	display := &ModelProviderDisplay{
 		Id:           modelProvider.Metadata.Id,
 		Name:         modelProvider.Spec.Name,
 		ProviderType: ConvertModelProviderTypeToDisplay(modelProvider.Metadata.ProviderType),
 		Enabled:      modelProvider.Spec.Enabled,
+		Keys:         len(modelProvider.GetStatus().GetKeys()),
 	}
+
+	if modelProvider.Spec.KeySelection != v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_UNSPECIFIED {
+		display.KeySelection = ConvertKeySelectionStrategyToDisplay(modelProvider.Spec.KeySelection)
+	}
+
+	if rateLimit := modelProvider.Spec.RateLimit; rateLimit != nil {
+		display.RequestsPerMinute = rateLimit.RequestsPerMinute
+		display.TokensPerMinute = rateLimit.TokensPerMinute
+		display.MaxInFlight = rateLimit.MaxInFlight
+	}
+
+	return display
 }
 
 // getModelProviderID resolves a model provider ID or name to an ID
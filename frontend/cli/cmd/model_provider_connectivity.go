@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type modelProviderTestOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewModelProviderTestCmd() *cobra.Command {
+	var options modelProviderTestOptions
+
+	cmd := &cobra.Command{
+		Use:   "test <id-or-name>",
+		Short: "Check that a model provider is reachable and accepts its credentials",
+		Args:  cobra.ExactArgs(1),
+		Long: `Check that a model provider is reachable and accepts its credentials.
+
+Makes a minimal authenticated call against the provider and reports the latency,
+whether the credentials were accepted and the state of the circuit breaker. If the
+provider can list its models, configured models that the provider does not offer
+are reported as missing. The command fails if the credentials were not accepted.`,
+		Example: `  # Test a model provider
+  construct modelprovider test anthropic-dev
+
+  # Show all models that are available to the credentials
+  construct modelprovider test anthropic-dev --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			modelProviderID, err := getModelProviderID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve model provider %s: %w", idOrName, err)
+			}
+
+			resp, err := client.ModelProvider().TestModelProvider(cmd.Context(), &connect.Request[v1.TestModelProviderRequest]{
+				Msg: &v1.TestModelProviderRequest{Id: modelProviderID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to test model provider %s: %w", idOrName, err)
+			}
+
+			err = getRenderer(cmd.Context()).Render(ConvertModelProviderTestToDisplay(resp.Msg), &options.RenderOptions)
+			if err != nil {
+				return err
+			}
+
+			if resp.Msg.AuthStatus != v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED {
+				return fmt.Errorf("model provider %s failed the connectivity test", idOrName)
+			}
+
+			return nil
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
+
+type ModelProviderTestDisplay struct {
+	AuthStatus      string   `json:"auth_status" detail:"default"`
+	Latency         string   `json:"latency" detail:"default"`
+	CircuitState    string   `json:"circuit_state" detail:"default"`
+	Error           string   `json:"error,omitempty" detail:"default"`
+	MissingModels   []string `json:"missing_models,omitempty" detail:"default"`
+	AvailableModels []string `json:"available_models,omitempty" detail:"full"`
+}
+
+func ConvertModelProviderTestToDisplay(resp *v1.TestModelProviderResponse) *ModelProviderTestDisplay {
+	return &ModelProviderTestDisplay{
+		AuthStatus:      ConvertAuthStatusToDisplay(resp.AuthStatus),
+		Latency:         resp.Latency.AsDuration().Round(time.Millisecond).String(),
+		CircuitState:    ConvertCircuitStateToDisplay(resp.CircuitState),
+		Error:           resp.GetError(),
+		MissingModels:   resp.MissingModels,
+		AvailableModels: resp.AvailableModels,
+	}
+}
+
+func ConvertAuthStatusToDisplay(status v1.ModelProviderAuthStatus) string {
+	switch status {
+	case v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED:
+		return "authenticated"
+	case v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED:
+		return "unauthenticated"
+	default:
+		return "unknown"
+	}
+}
+
+func ConvertCircuitStateToDisplay(state v1.CircuitBreakerState) string {
+	switch state {
+	case v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED:
+		return "closed"
+	case v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN:
+		return "open"
+	case v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_HALF_OPEN:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
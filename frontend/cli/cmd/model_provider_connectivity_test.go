@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestModelProviderTest(t *testing.T) {
+	setup := &TestSetup{}
+
+	modelProviderID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - provider accepts credentials",
+			Command: []string{"modelprovider", "test", "anthropic-dev"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelProviderLookupForGetMock(mockClient, "anthropic-dev", modelProviderID)
+				setupModelProviderTestMock(mockClient, modelProviderID, &v1.TestModelProviderResponse{
+					AuthStatus:       v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_AUTHENTICATED,
+					Latency:          durationpb.New(312400 * time.Microsecond),
+					ModelsListed:     true,
+					AvailableModels:  []string{"claude-sonnet-4-20250514"},
+					ConfiguredModels: []string{"claude-2.1", "claude-sonnet-4-20250514"},
+					MissingModels:    []string{"claude-2.1"},
+					CircuitState:     v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_CLOSED,
+				})
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &ModelProviderTestDisplay{
+					AuthStatus:      "authenticated",
+					Latency:         "312ms",
+					CircuitState:    "closed",
+					MissingModels:   []string{"claude-2.1"},
+					AvailableModels: []string{"claude-sonnet-4-20250514"},
+				},
+			},
+		},
+		{
+			Name:    "error - provider rejects credentials",
+			Command: []string{"modelprovider", "test", modelProviderID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelProviderTestMock(mockClient, modelProviderID, &v1.TestModelProviderResponse{
+					AuthStatus:   v1.ModelProviderAuthStatus_MODEL_PROVIDER_AUTH_STATUS_UNAUTHENTICATED,
+					Latency:      durationpb.New(80 * time.Millisecond),
+					Error:        conv.Ptr("anthropic: Authentication failed, check the API key"),
+					CircuitState: v1.CircuitBreakerState_CIRCUIT_BREAKER_STATE_OPEN,
+				})
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &ModelProviderTestDisplay{
+					AuthStatus:   "unauthenticated",
+					Latency:      "80ms",
+					CircuitState: "open",
+					Error:        "anthropic: Authentication failed, check the API key",
+				},
+				Error: "model provider " + modelProviderID + " failed the connectivity test",
+			},
+		},
+	})
+}
+
+func setupModelProviderTestMock(mockClient *api_client.MockClient, modelProviderID string, resp *v1.TestModelProviderResponse) {
+	mockClient.ModelProvider.EXPECT().TestModelProvider(
+		gomock.Any(),
+		&connect.Request[v1.TestModelProviderRequest]{
+			Msg: &v1.TestModelProviderRequest{Id: modelProviderID},
+		},
+	).Return(&connect.Response[v1.TestModelProviderResponse]{Msg: resp}, nil)
+}
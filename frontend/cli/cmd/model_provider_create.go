@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"syscall"
 
@@ -14,9 +15,16 @@ import (
 )
 
 type modelProviderCreateOptions struct {
-	ApiKey string
-	Type   ModelProviderType
-	Url    string
+	ApiKey            string
+	AWS               awsCredentialOptions
+	Type              ModelProviderType
+	Cassette          string
+	Script            string
+	RequestsPerMinute int64
+	TokensPerMinute   int64
+	MaxInFlight       int64
+	KeySelection      KeySelectionStrategy
+	Test              bool
 }
 
 func NewModelProviderCreateCmd() *cobra.Command {
@@ -30,34 +38,91 @@ func NewModelProviderCreateCmd() *cobra.Command {
 
 Connects construct to an external AI model provider. This step is required to 
 gain access to models. API credentials can be provided interactively, via flags
-or environment variables (e.g., $OPENAI_API_KEY, $ANTHROPIC_API_KEY).`,
+or environment variables (e.g., $OPENAI_API_KEY, $ANTHROPIC_API_KEY). Bedrock
+uses AWS credentials instead of an API key, either from the --aws-* flags or
+from $AWS_ACCESS_KEY_ID, $AWS_SECRET_ACCESS_KEY, $AWS_SESSION_TOKEN and $AWS_REGION.
+
+Providers of type replay need no credentials. They serve the model responses of a
+cassette that was recorded with 'construct daemon run --record-cassette'. Providers of
+type mock need no credentials either. They answer with the turns of a script, so that
+clients and tools can be developed against a running daemon without calling a model.`,
 		Example: `  # Create an OpenAI provider, using the API key from the environment
   export OPENAI_API_KEY="sk-..."
   construct provider create "openai-prod" --type openai
 
   # Create an Anthropic provider, passing the API key directly
-  construct provider create "anthropic-dev" --type anthropic --api-key "sk-ant-..."`,
+  construct provider create "anthropic-dev" --type anthropic --api-key "sk-ant-..."
+
+  # Create a provider that is shared by all tasks with at most 50 requests per minute
+  construct provider create "anthropic-team" --type anthropic --requests-per-minute 50 --max-in-flight 4
+
+  # Verify the API key with Anthropic before the provider is saved
+  construct provider create "anthropic-dev" --type anthropic --test
+
+  # Create a Bedrock provider, using the AWS credentials from the environment
+  export AWS_ACCESS_KEY_ID="AKIA..." AWS_SECRET_ACCESS_KEY="..." AWS_REGION="us-east-1"
+  construct provider create "bedrock-prod" --type bedrock
+
+  # Replay a recorded session without calling a model provider
+  construct provider create "ci" --type replay --cassette ./session.cassette.json
+
+  # Answer with the turns of a script instead of calling a model
+  construct provider create "dev" --type mock --script ./mock.yaml`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
 
-			apiKey, err := getAPIKey(&options, options.Type, name)
+			providerType, err := options.Type.ToAPI()
 			if err != nil {
 				return err
 			}
 
-			client := getAPIClient(cmd.Context())
+			req := &v1.CreateModelProviderRequest{
+				Name:           name,
+				ProviderType:   providerType,
+				RateLimit:      buildModelProviderRateLimit(options.RequestsPerMinute, options.TokensPerMinute, options.MaxInFlight),
+				KeySelection:   options.KeySelection.ToAPI(),
+				TestConnection: options.Test,
+			}
 
-			providerType, err := options.Type.ToAPI()
-			if err != nil {
-				return err
+			switch options.Type {
+			case ModelProviderTypeReplay:
+				if options.Cassette == "" {
+					return fmt.Errorf("--cassette is required for providers of type replay")
+				}
+
+				// The cassette is read by the daemon, which runs in a different directory.
+				cassette, err := filepath.Abs(options.Cassette)
+				if err != nil {
+					return fmt.Errorf("failed to resolve cassette path: %w", err)
+				}
+				req.Url = &cassette
+			case ModelProviderTypeMock:
+				if options.Script == "" {
+					return fmt.Errorf("--script is required for providers of type mock")
+				}
+
+				script, err := filepath.Abs(options.Script)
+				if err != nil {
+					return fmt.Errorf("failed to resolve script path: %w", err)
+				}
+				req.Url = &script
+			case ModelProviderTypeBedrock:
+				credentials, err := getAWSCredentials(&options.AWS)
+				if err != nil {
+					return err
+				}
+				req.Authentication = &v1.CreateModelProviderRequest_AwsCredentials{AwsCredentials: credentials}
+			default:
+				apiKey, err := getAPIKey(&options, options.Type, name)
+				if err != nil {
+					return err
+				}
+				req.Authentication = &v1.CreateModelProviderRequest_ApiKey{ApiKey: apiKey}
 			}
 
+			client := getAPIClient(cmd.Context())
 			resp, err := client.ModelProvider().CreateModelProvider(cmd.Context(), &connect.Request[v1.CreateModelProviderRequest]{
-				Msg: &v1.CreateModelProviderRequest{
-					Name:           name,
-					ProviderType:   providerType,
-					Authentication: &v1.CreateModelProviderRequest_ApiKey{ApiKey: apiKey},
-				},
+				Msg: req,
 			})
 
 			if err != nil {
@@ -71,12 +136,73 @@ or environment variables (e.g., $OPENAI_API_KEY, $ANTHROPIC_API_KEY).`,
 
 	cmd.Flags().StringVarP(&options.ApiKey, "api-key", "k", "", "The API key. If omitted, the corresponding environment variable will be used")
 	cmd.Flags().VarP(&options.Type, "type", "t", "The type of the model provider (required)")
+	cmd.Flags().StringVar(&options.AWS.AccessKeyID, "aws-access-key-id", "", "The AWS access key ID for Bedrock. If omitted, $AWS_ACCESS_KEY_ID will be used")
+	cmd.Flags().StringVar(&options.AWS.SecretAccessKey, "aws-secret-access-key", "", "The AWS secret access key for Bedrock. If omitted, $AWS_SECRET_ACCESS_KEY will be used")
+	cmd.Flags().StringVar(&options.AWS.SessionToken, "aws-session-token", "", "The AWS session token for Bedrock. If omitted, $AWS_SESSION_TOKEN will be used")
+	cmd.Flags().StringVar(&options.AWS.Region, "aws-region", "", "The AWS region for Bedrock. If omitted, $AWS_REGION or $AWS_DEFAULT_REGION will be used")
+	cmd.Flags().StringVar(&options.Cassette, "cassette", "", "The cassette to replay, for providers of type replay")
+	cmd.Flags().StringVar(&options.Script, "script", "", "The script that answers requests, for providers of type mock")
+	cmd.Flags().Int64Var(&options.RequestsPerMinute, "requests-per-minute", 0, "Maximum number of model requests per minute across all tasks (0 for no limit)")
+	cmd.Flags().Int64Var(&options.TokensPerMinute, "tokens-per-minute", 0, "Maximum number of tokens per minute across all tasks (0 for no limit)")
+	cmd.Flags().Int64Var(&options.MaxInFlight, "max-in-flight", 0, "Maximum number of concurrent model requests (0 for no limit)")
+	cmd.Flags().BoolVar(&options.Test, "test", false, "Verify the API key with the provider before the provider is saved")
+	cmd.Flags().Var(&options.KeySelection, "key-selection", "How an API key is chosen if the provider has several keys: primary-backup, round-robin or least-recently-limited")
 
 	cmd.MarkFlagRequired("type")
 
 	return cmd
 }
 
+func buildModelProviderRateLimit(requestsPerMinute, tokensPerMinute, maxInFlight int64) *v1.ModelProviderRateLimit {
+	if requestsPerMinute == 0 && tokensPerMinute == 0 && maxInFlight == 0 {
+		return nil
+	}
+
+	return &v1.ModelProviderRateLimit{
+		RequestsPerMinute: requestsPerMinute,
+		TokensPerMinute:   tokensPerMinute,
+		MaxInFlight:       maxInFlight,
+	}
+}
+
+type awsCredentialOptions struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Region          string
+}
+
+func getAWSCredentials(options *awsCredentialOptions) (*v1.AWSCredentials, error) {
+	firstNonEmpty := func(values ...string) string {
+		for _, v := range values {
+			if v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	credentials := &v1.AWSCredentials{
+		AccessKeyId:     firstNonEmpty(options.AccessKeyID, os.Getenv("AWS_ACCESS_KEY_ID")),
+		SecretAccessKey: firstNonEmpty(options.SecretAccessKey, os.Getenv("AWS_SECRET_ACCESS_KEY")),
+		Region:          firstNonEmpty(options.Region, os.Getenv("AWS_REGION"), os.Getenv("AWS_DEFAULT_REGION")),
+	}
+
+	if sessionToken := firstNonEmpty(options.SessionToken, os.Getenv("AWS_SESSION_TOKEN")); sessionToken != "" {
+		credentials.SessionToken = &sessionToken
+	}
+
+	if credentials.AccessKeyId == "" || credentials.SecretAccessKey == "" {
+		return nil, fmt.Errorf("AWS credentials are required for Bedrock\n\nTip: Use the --aws-access-key-id and --aws-secret-access-key flags or set $AWS_ACCESS_KEY_ID and $AWS_SECRET_ACCESS_KEY")
+	}
+
+	if credentials.Region == "" {
+		return nil, fmt.Errorf("AWS region is required for Bedrock\n\nTip: Use the --aws-region flag or set $AWS_REGION")
+	}
+
+	return credentials, nil
+}
+
 func getAPIKey(options *modelProviderCreateOptions, providerType ModelProviderType, name string) (string, error) {
 	// Check command line flag
 	if options.ApiKey != "" {
@@ -136,6 +262,8 @@ func APIKeyEnvVar(providerType ModelProviderType) (string, error) {
 		return "GEMINI_API_KEY", nil
 	case ModelProviderTypeXAI:
 		return "XAI_API_KEY", nil
+	case ModelProviderTypeDeepSeek:
+		return "DEEPSEEK_API_KEY", nil
 	default:
 		return "", fmt.Errorf("unknown provider type: %s", providerType)
 	}
@@ -151,6 +279,10 @@ func getProviderDisplayName(providerType ModelProviderType) (string, error) {
 		return "Gemini", nil
 	case ModelProviderTypeXAI:
 		return "xAI", nil
+	case ModelProviderTypeDeepSeek:
+		return "DeepSeek", nil
+	case ModelProviderTypeBedrock:
+		return "Bedrock", nil
 	default:
 		return "", fmt.Errorf("unknown provider type: %s", providerType)
 	}
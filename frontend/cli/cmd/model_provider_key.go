@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewModelProviderKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "key",
+		Short:   "Manage the API keys of a model provider",
+		Aliases: []string{"keys"},
+		Long: `Manage the API keys of a model provider.
+
+A provider can hold several named API keys, e.g. one per organization or billing
+project. The key selection strategy of the provider decides which key serves a
+model request. Usage is recorded per key so that spend can be attributed.`,
+	}
+
+	cmd.AddCommand(NewModelProviderKeyAddCmd())
+	cmd.AddCommand(NewModelProviderKeyListCmd())
+	cmd.AddCommand(NewModelProviderKeyRetireCmd())
+
+	return cmd
+}
+
+type KeySelectionStrategy string
+
+const (
+	KeySelectionStrategyPrimaryBackup        KeySelectionStrategy = "primary-backup"
+	KeySelectionStrategyRoundRobin           KeySelectionStrategy = "round-robin"
+	KeySelectionStrategyLeastRecentlyLimited KeySelectionStrategy = "least-recently-limited"
+	KeySelectionStrategyUnknown              KeySelectionStrategy = "unknown"
+)
+
+func (e *KeySelectionStrategy) String() string {
+	return string(*e)
+}
+
+func (e *KeySelectionStrategy) Set(v string) error {
+	switch KeySelectionStrategy(strings.ToLower(strings.TrimSpace(v))) {
+	case KeySelectionStrategyPrimaryBackup:
+		*e = KeySelectionStrategyPrimaryBackup
+	case KeySelectionStrategyRoundRobin:
+		*e = KeySelectionStrategyRoundRobin
+	case KeySelectionStrategyLeastRecentlyLimited:
+		*e = KeySelectionStrategyLeastRecentlyLimited
+	default:
+		return errors.New(`must be one of "primary-backup","round-robin","least-recently-limited"`)
+	}
+	return nil
+}
+
+func (e *KeySelectionStrategy) Type() string {
+	return "strategy"
+}
+
+// ToAPI returns nil if no strategy has been set.
+func (e *KeySelectionStrategy) ToAPI() *v1.KeySelectionStrategy {
+	var strategy v1.KeySelectionStrategy
+	switch *e {
+	case KeySelectionStrategyPrimaryBackup:
+		strategy = v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_PRIMARY_BACKUP
+	case KeySelectionStrategyRoundRobin:
+		strategy = v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_ROUND_ROBIN
+	case KeySelectionStrategyLeastRecentlyLimited:
+		strategy = v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED
+	default:
+		return nil
+	}
+	return &strategy
+}
+
+func ConvertKeySelectionStrategyToDisplay(strategy v1.KeySelectionStrategy) KeySelectionStrategy {
+	switch strategy {
+	case v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_PRIMARY_BACKUP:
+		return KeySelectionStrategyPrimaryBackup
+	case v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_ROUND_ROBIN:
+		return KeySelectionStrategyRoundRobin
+	case v1.KeySelectionStrategy_KEY_SELECTION_STRATEGY_LEAST_RECENTLY_LIMITED:
+		return KeySelectionStrategyLeastRecentlyLimited
+	}
+
+	return KeySelectionStrategyUnknown
+}
+
+type ModelProviderKeyDisplay struct {
+	Id              string  `json:"id" detail:"default"`
+	Name            string  `json:"name" detail:"default"`
+	Priority        int32   `json:"priority" detail:"default"`
+	Status          string  `json:"status" detail:"default"`
+	Requests        int64   `json:"requests" detail:"default"`
+	Cost            float64 `json:"cost" detail:"default"`
+	InputTokens     int64   `json:"input_tokens" detail:"full"`
+	OutputTokens    int64   `json:"output_tokens" detail:"full"`
+	LastUsed        string  `json:"last_used,omitempty" detail:"full"`
+	LastRateLimited string  `json:"last_rate_limited,omitempty" detail:"full"`
+}
+
+func ConvertModelProviderKeyToDisplay(key *v1.ModelProviderKey) *ModelProviderKeyDisplay {
+	display := &ModelProviderKeyDisplay{
+		Id:       key.Id,
+		Name:     key.Name,
+		Priority: key.Priority,
+		Status:   "Active",
+	}
+
+	if key.RetiredAt != nil {
+		display.Status = "Retired"
+	}
+
+	if key.Usage != nil {
+		display.Requests = key.Usage.Requests
+		display.Cost = key.Usage.Cost
+		display.InputTokens = key.Usage.InputTokens
+		display.OutputTokens = key.Usage.OutputTokens
+	}
+
+	if key.LastUsedAt != nil {
+		display.LastUsed = FormatRelativeTime(key.LastUsedAt.AsTime())
+	}
+
+	if key.LastRateLimitedAt != nil {
+		display.LastRateLimited = FormatRelativeTime(key.LastRateLimitedAt.AsTime())
+	}
+
+	return display
+}
+
+// getModelProviderKeyID resolves a key ID or name of the given model provider to an ID
+func getModelProviderKeyID(ctx context.Context, client *api.Client, modelProviderID, idOrName string) (string, error) {
+	resp, err := client.ModelProvider().GetModelProvider(ctx, &connect.Request[v1.GetModelProviderRequest]{
+		Msg: &v1.GetModelProviderRequest{Id: modelProviderID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get model provider: %w", err)
+	}
+
+	for _, key := range resp.Msg.ModelProvider.GetStatus().GetKeys() {
+		if key.Id == idOrName || key.Name == idOrName {
+			return key.Id, nil
+		}
+	}
+
+	return "", fmt.Errorf("key %s not found", idOrName)
+}
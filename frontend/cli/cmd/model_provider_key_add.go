@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type modelProviderKeyAddOptions struct {
+	ApiKey   string
+	Priority int32
+}
+
+func NewModelProviderKeyAddCmd() *cobra.Command {
+	var options modelProviderKeyAddOptions
+
+	cmd := &cobra.Command{
+		Use:   "add <provider-name-or-id> <key-name> [flags]",
+		Short: "Add an API key to a model provider",
+		Args:  cobra.ExactArgs(2),
+		Long: `Add an API key to a model provider.
+
+The key is stored encrypted and is used according to the key selection strategy
+of the provider. If no API key is passed, you will be prompted for it.`,
+		Example: `  # Add a backup key to the 'anthropic-prod' provider
+  construct modelprovider key add anthropic-prod backup --api-key "sk-ant-..."
+
+  # Add a key that is preferred over all existing keys
+  construct modelprovider key add anthropic-prod team-a --priority 0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			providerIDOrName, keyName := args[0], args[1]
+
+			modelProviderID, err := getModelProviderID(cmd.Context(), client, providerIDOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve model provider %s: %w", providerIDOrName, err)
+			}
+
+			apiKey := options.ApiKey
+			if apiKey == "" {
+				fmt.Printf("Enter API key %s for %s: ", keyName, providerIDOrName)
+				apiKey, err = readPasswordSecurely()
+				if err != nil {
+					return fmt.Errorf("failed to read API key: %w", err)
+				}
+			}
+
+			req := &v1.AddModelProviderKeyRequest{
+				ModelProviderId: modelProviderID,
+				Name:            keyName,
+				Authentication:  &v1.AddModelProviderKeyRequest_ApiKey{ApiKey: apiKey},
+			}
+			if cmd.Flags().Changed("priority") {
+				req.Priority = &options.Priority
+			}
+
+			_, err = client.ModelProvider().AddModelProviderKey(cmd.Context(), &connect.Request[v1.AddModelProviderKeyRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add key %s to model provider %s: %w", keyName, providerIDOrName, err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.ApiKey, "api-key", "k", "", "The API key. If omitted, you will be prompted for it")
+	cmd.Flags().Int32Var(&options.Priority, "priority", 0, "Order of the key for the primary-backup strategy, lower values are preferred (default: after all existing keys)")
+
+	return cmd
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestModelProviderKeyAdd(t *testing.T) {
+	setup := &TestSetup{}
+
+	providerID := uuid.New().String()
+	priority := int32(0)
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success",
+			Command: []string{"modelprovider", "key", "add", providerID, "backup", "--api-key", "sk-ant-backup"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelProviderKeyAddMock(mockClient, &v1.AddModelProviderKeyRequest{
+					ModelProviderId: providerID,
+					Name:            "backup",
+					Authentication:  &v1.AddModelProviderKeyRequest_ApiKey{ApiKey: "sk-ant-backup"},
+				})
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "success with priority",
+			Command: []string{"modelprovider", "key", "add", providerID, "team-a", "--api-key", "sk-ant-team-a", "--priority", "0"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelProviderKeyAddMock(mockClient, &v1.AddModelProviderKeyRequest{
+					ModelProviderId: providerID,
+					Name:            "team-a",
+					Authentication:  &v1.AddModelProviderKeyRequest_ApiKey{ApiKey: "sk-ant-team-a"},
+					Priority:        &priority,
+				})
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "error - API fails",
+			Command: []string{"modelprovider", "key", "add", providerID, "backup", "--api-key", "sk-ant-backup"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.ModelProvider.EXPECT().AddModelProviderKey(
+					gomock.Any(),
+					gomock.Any(),
+				).Return(nil, fmt.Errorf("model provider already has a key named backup"))
+			},
+			Expected: TestExpectation{
+				Error: fmt.Sprintf("failed to add key backup to model provider %s: model provider already has a key named backup", providerID),
+			},
+		},
+	})
+}
+
+func setupModelProviderKeyAddMock(mockClient *api_client.MockClient, req *v1.AddModelProviderKeyRequest) {
+	mockClient.ModelProvider.EXPECT().AddModelProviderKey(
+		gomock.Any(),
+		connect.NewRequest(req),
+	).Return(&connect.Response[v1.AddModelProviderKeyResponse]{
+		Msg: &v1.AddModelProviderKeyResponse{
+			ModelProvider: &v1.ModelProvider{
+				Metadata: &v1.ModelProviderMetadata{Id: req.ModelProviderId},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type modelProviderKeyListOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewModelProviderKeyListCmd() *cobra.Command {
+	var options modelProviderKeyListOptions
+
+	cmd := &cobra.Command{
+		Use:     "list <provider-name-or-id> [flags]",
+		Short:   "List the API keys of a model provider and their usage",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		Example: `  # List the keys of the 'anthropic-prod' provider
+  construct modelprovider key list anthropic-prod
+
+  # Show token usage per key
+  construct modelprovider key ls anthropic-prod --wide`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			idOrName := args[0]
+
+			modelProviderID, err := getModelProviderID(cmd.Context(), client, idOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve model provider %s: %w", idOrName, err)
+			}
+
+			resp, err := client.ModelProvider().GetModelProvider(cmd.Context(), &connect.Request[v1.GetModelProviderRequest]{
+				Msg: &v1.GetModelProviderRequest{Id: modelProviderID},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get model provider %s: %w", idOrName, err)
+			}
+
+			keys := resp.Msg.ModelProvider.GetStatus().GetKeys()
+			displayKeys := make([]*ModelProviderKeyDisplay, len(keys))
+			for i, key := range keys {
+				displayKeys[i] = ConvertModelProviderKeyToDisplay(key)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayKeys, &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, &options.RenderOptions)
+	return cmd
+}
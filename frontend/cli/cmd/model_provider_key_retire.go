@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewModelProviderKeyRetireCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire <provider-name-or-id> <key-name-or-id>",
+		Short: "Stop using an API key of a model provider",
+		Args:  cobra.ExactArgs(2),
+		Long: `Stop using an API key of a model provider.
+
+Retired keys are no longer used for model requests but are kept, so that their
+usage can still be attributed. The last active key of a provider cannot be retired.`,
+		Example: `  # Rotate a key without downtime
+  construct modelprovider key add anthropic-prod rotated --api-key "sk-ant-..."
+  construct modelprovider key retire anthropic-prod default`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			providerIDOrName, keyIDOrName := args[0], args[1]
+
+			modelProviderID, err := getModelProviderID(cmd.Context(), client, providerIDOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve model provider %s: %w", providerIDOrName, err)
+			}
+
+			keyID, err := getModelProviderKeyID(cmd.Context(), client, modelProviderID, keyIDOrName)
+			if err != nil {
+				return fmt.Errorf("failed to resolve key %s: %w", keyIDOrName, err)
+			}
+
+			_, err = client.ModelProvider().RetireModelProviderKey(cmd.Context(), &connect.Request[v1.RetireModelProviderKeyRequest]{
+				Msg: &v1.RetireModelProviderKeyRequest{
+					ModelProviderId: modelProviderID,
+					KeyId:           keyID,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to retire key %s: %w", keyIDOrName, err)
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
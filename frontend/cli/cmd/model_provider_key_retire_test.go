@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestModelProviderKeyRetire(t *testing.T) {
+	setup := &TestSetup{}
+
+	providerID := uuid.New().String()
+	keyID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success by key name",
+			Command: []string{"modelprovider", "key", "retire", providerID, "default"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelProviderWithKeysMock(mockClient, providerID, keyID)
+				mockClient.ModelProvider.EXPECT().RetireModelProviderKey(
+					gomock.Any(),
+					connect.NewRequest(&v1.RetireModelProviderKeyRequest{
+						ModelProviderId: providerID,
+						KeyId:           keyID,
+					}),
+				).Return(&connect.Response[v1.RetireModelProviderKeyResponse]{
+					Msg: &v1.RetireModelProviderKeyResponse{},
+				}, nil)
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "error - key not found",
+			Command: []string{"modelprovider", "key", "retire", providerID, "unknown"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelProviderWithKeysMock(mockClient, providerID, keyID)
+			},
+			Expected: TestExpectation{
+				Error: "failed to resolve key unknown: key unknown not found",
+			},
+		},
+	})
+}
+
+func setupModelProviderWithKeysMock(mockClient *api_client.MockClient, providerID, keyID string) {
+	mockClient.ModelProvider.EXPECT().GetModelProvider(
+		gomock.Any(),
+		connect.NewRequest(&v1.GetModelProviderRequest{Id: providerID}),
+	).Return(&connect.Response[v1.GetModelProviderResponse]{
+		Msg: &v1.GetModelProviderResponse{
+			ModelProvider: &v1.ModelProvider{
+				Metadata: &v1.ModelProviderMetadata{Id: providerID},
+				Status: &v1.ModelProviderStatus{
+					Keys: []*v1.ModelProviderKey{
+						{Id: keyID, Name: "default"},
+					},
+				},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type modelSyncOptions struct {
+	Apply         bool
+	RenderOptions RenderOptions
+}
+
+func NewModelSyncCmd() *cobra.Command {
+	var options modelSyncOptions
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Compare the models with the model catalog and apply updates",
+		Args:  cobra.NoArgs,
+		Long: `Compare the models with the model catalog and apply updates.
+
+The catalog lists the models of each provider type together with their pricing,
+context window and capabilities. By default the catalog that is built into Construct
+is used. The daemon loads a newer catalog from a URL or a file on its host if
+daemon.catalog_source is set in its configuration.
+
+Without --apply the command only reports new, retired and repriced models. With
+--apply new models are added, retired models are disabled and outdated models are
+updated. Models created by the user and fields that were changed by the user are
+never touched.`,
+		Example: `  # Show which models differ from the built-in catalog
+  construct model sync
+
+  # Apply the changes
+  construct model sync --apply
+
+  # Let the daemon sync from a catalog published on the web (takes effect after a restart)
+  construct config set daemon.catalog_source https://example.com/catalog.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.SyncModelsRequest{Apply: options.Apply}
+			resp, err := client.Model().SyncModels(cmd.Context(), &connect.Request[v1.SyncModelsRequest]{Msg: req})
+			if err != nil {
+				return fmt.Errorf("failed to sync models: %w", err)
+			}
+
+			displayChanges := make([]*ModelSyncChangeDisplay, len(resp.Msg.Changes))
+			for i, change := range resp.Msg.Changes {
+				displayChanges[i] = ConvertModelCatalogChangeToDisplay(change)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayChanges, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().BoolVar(&options.Apply, "apply", false, "Apply the changes instead of only reporting them")
+	addRenderOptions(cmd, &options.RenderOptions)
+	return cmd
+}
+
+type ModelSyncChangeDisplay struct {
+	Change        string   `json:"change" detail:"default"`
+	ModelProvider string   `json:"model_provider" detail:"default"`
+	Model         string   `json:"model" detail:"default"`
+	Fields        []string `json:"fields,omitempty" detail:"default"`
+	InputCost     string   `json:"input_cost,omitempty" detail:"full"`
+	OutputCost    string   `json:"output_cost,omitempty" detail:"full"`
+	ContextWindow string   `json:"context_window,omitempty" detail:"full"`
+}
+
+func ConvertModelCatalogChangeToDisplay(change *v1.ModelCatalogChange) *ModelSyncChangeDisplay {
+	display := &ModelSyncChangeDisplay{
+		Change:        ConvertModelCatalogChangeKindToDisplay(change.Kind),
+		ModelProvider: change.ModelProviderName,
+		Model:         change.ModelName,
+		Fields:        change.Fields,
+	}
+
+	switch change.Kind {
+	case v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_NEW:
+		display.InputCost = change.GetPricing().GetInputCost().GetValue()
+		display.OutputCost = change.GetPricing().GetOutputCost().GetValue()
+		display.ContextWindow = fmt.Sprint(change.ContextWindow)
+	case v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_REPRICED, v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UPDATED:
+		display.InputCost = formatCatalogChange(change.GetPreviousPricing().GetInputCost().GetValue(), change.GetPricing().GetInputCost().GetValue())
+		display.OutputCost = formatCatalogChange(change.GetPreviousPricing().GetOutputCost().GetValue(), change.GetPricing().GetOutputCost().GetValue())
+		display.ContextWindow = formatCatalogChange(fmt.Sprint(change.PreviousContextWindow), fmt.Sprint(change.ContextWindow))
+	}
+
+	return display
+}
+
+func formatCatalogChange(previous, current string) string {
+	if previous == current {
+		return current
+	}
+	return strings.Join([]string{previous, current}, " -> ")
+}
+
+func ConvertModelCatalogChangeKindToDisplay(kind v1.ModelCatalogChangeKind) string {
+	switch kind {
+	case v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_NEW:
+		return "new"
+	case v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_RETIRED:
+		return "retired"
+	case v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_REPRICED:
+		return "repriced"
+	case v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_UPDATED:
+		return "updated"
+	default:
+		return "unknown"
+	}
+}
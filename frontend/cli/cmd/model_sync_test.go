@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/genproto/googleapis/type/decimal"
+)
+
+func TestModelSync(t *testing.T) {
+	setup := &TestSetup{}
+
+	changes := []*v1.ModelCatalogChange{
+		{
+			Kind:                  v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_REPRICED,
+			ModelProviderName:     "anthropic-dev",
+			ModelName:             "claude-3-7-sonnet-20250219",
+			Fields:                []string{"pricing"},
+			Pricing:               testModelPricing("2", "15"),
+			PreviousPricing:       testModelPricing("3", "15"),
+			ContextWindow:         200000,
+			PreviousContextWindow: 200000,
+		},
+		{
+			Kind:              v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_NEW,
+			ModelProviderName: "anthropic-dev",
+			ModelName:         "claude-next",
+			Pricing:           testModelPricing("5", "25"),
+			ContextWindow:     400000,
+		},
+		{
+			Kind:              v1.ModelCatalogChangeKind_MODEL_CATALOG_CHANGE_KIND_RETIRED,
+			ModelProviderName: "anthropic-dev",
+			ModelName:         "claude-2.1",
+			Fields:            []string{"enabled"},
+		},
+	}
+
+	displayed := []*ModelSyncChangeDisplay{
+		{
+			Change:        "repriced",
+			ModelProvider: "anthropic-dev",
+			Model:         "claude-3-7-sonnet-20250219",
+			Fields:        []string{"pricing"},
+			InputCost:     "3 -> 2",
+			OutputCost:    "15",
+			ContextWindow: "200000",
+		},
+		{
+			Change:        "new",
+			ModelProvider: "anthropic-dev",
+			Model:         "claude-next",
+			InputCost:     "5",
+			OutputCost:    "25",
+			ContextWindow: "400000",
+		},
+		{
+			Change:        "retired",
+			ModelProvider: "anthropic-dev",
+			Model:         "claude-2.1",
+			Fields:        []string{"enabled"},
+		},
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - report changes against built-in catalog",
+			Command: []string{"model", "sync"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelSyncMock(mockClient, &v1.SyncModelsRequest{}, changes, false)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: displayed,
+			},
+		},
+		{
+			Name:    "success - apply changes",
+			Command: []string{"model", "sync", "--apply"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelSyncMock(mockClient, &v1.SyncModelsRequest{Apply: true}, changes, true)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: displayed,
+			},
+		},
+		{
+			Name:    "error - sync fails",
+			Command: []string{"model", "sync"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Model.EXPECT().SyncModels(
+					gomock.Any(),
+					&connect.Request[v1.SyncModelsRequest]{
+						Msg: &v1.SyncModelsRequest{},
+					},
+				).Return(nil, connect.NewError(connect.CodeFailedPrecondition, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to sync models: failed_precondition",
+			},
+		},
+	})
+}
+
+func setupModelSyncMock(mockClient *api_client.MockClient, req *v1.SyncModelsRequest, changes []*v1.ModelCatalogChange, applied bool) {
+	mockClient.Model.EXPECT().SyncModels(
+		gomock.Any(),
+		&connect.Request[v1.SyncModelsRequest]{Msg: req},
+	).Return(&connect.Response[v1.SyncModelsResponse]{
+		Msg: &v1.SyncModelsResponse{
+			CatalogVersion: "2025.12.01",
+			Changes:        changes,
+			Applied:        applied,
+		},
+	}, nil)
+}
+
+func testModelPricing(input, output string) *v1.ModelPricing {
+	return &v1.ModelPricing{
+		InputCost:  &decimal.Decimal{Value: input},
+		OutputCost: &decimal.Decimal{Value: output},
+	}
+}
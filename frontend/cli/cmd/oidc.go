@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	api "github.com/furisto/construct/api/go/client"
+	"github.com/furisto/construct/shared/keyring"
+	"golang.org/x/oauth2"
+)
+
+// oidcRefreshMargin is how long before it expires an ID token is refreshed, so that it does not
+// expire while a request is on its way to the daemon.
+const oidcRefreshMargin = time.Minute
+
+// oidcCredentials are the result of an OpenID Connect login. They are kept in the keyring as JSON.
+type oidcCredentials struct {
+	IDToken      string    `json:"id_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry"`
+}
+
+// credentialStore keeps secrets of contexts, usually in the keyring.
+type credentialStore interface {
+	StoreToken(key string, token string) error
+	RetrieveToken(key string) (string, error)
+}
+
+// oidcScopes returns the scopes that are requested from the issuer. The ID token needs openid and
+// the refresh token offline_access.
+func oidcScopes(scopes []string) []string {
+	requested := []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess}
+	for _, scope := range scopes {
+		if !slices.Contains(requested, scope) {
+			requested = append(requested, scope)
+		}
+	}
+	return requested
+}
+
+func oidcConfig(ctx context.Context, auth *api.AuthConfig) (*oauth2.Config, error) {
+	provider, err := oidc.NewProvider(ctx, auth.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover issuer %s: %w", auth.Issuer, err)
+	}
+
+	return &oauth2.Config{
+		ClientID: auth.ClientID,
+		Endpoint: provider.Endpoint(),
+		Scopes:   oidcScopes(auth.Scopes),
+	}, nil
+}
+
+// oidcDeviceLogin logs in with the device authorization flow: the user confirms a code in the
+// browser while the CLI waits for the issuer to hand out the tokens.
+func oidcDeviceLogin(ctx context.Context, out io.Writer, auth *api.AuthConfig) (*oidcCredentials, error) {
+	config, err := oidcConfig(ctx, auth)
+	if err != nil {
+		return nil, err
+	}
+	if config.Endpoint.DeviceAuthURL == "" {
+		return nil, fmt.Errorf("issuer %s does not support the device authorization flow", auth.Issuer)
+	}
+
+	deviceAuth, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start device authorization: %w", err)
+	}
+
+	verificationURI := deviceAuth.VerificationURIComplete
+	if verificationURI == "" {
+		verificationURI = deviceAuth.VerificationURI
+	}
+	fmt.Fprintf(out, "To log in, open %s and confirm the code %s\n", verificationURI, deviceAuth.UserCode)
+
+	token, err := config.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to log in: %w", err)
+	}
+
+	return oidcCredentialsFromToken(token)
+}
+
+func oidcCredentialsFromToken(token *oauth2.Token) (*oidcCredentials, error) {
+	idToken, ok := token.Extra("id_token").(string)
+	if !ok || idToken == "" {
+		return nil, fmt.Errorf("issuer did not return an id token")
+	}
+
+	claims, err := jwtClaims(idToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oidcCredentials{
+		IDToken:      idToken,
+		RefreshToken: token.RefreshToken,
+		Expiry:       time.Unix(claims.Expiry, 0),
+	}, nil
+}
+
+type idTokenClaims struct {
+	Subject string `json:"sub"`
+	Email   string `json:"email"`
+	Expiry  int64  `json:"exp"`
+}
+
+// jwtClaims reads the claims of a JWT without verifying it. The daemon verifies the token, the CLI
+// only needs to know who it is for and when it expires.
+func jwtClaims(token string) (*idTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("id token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode id token: %w", err)
+	}
+
+	var claims idTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to parse id token claims: %w", err)
+	}
+	return &claims, nil
+}
+
+// oidcTokenSource supplies the ID token of a login for API requests. Tokens that are about to
+// expire are refreshed with the refresh token, and the new credentials are written back to the
+// keyring.
+type oidcTokenSource struct {
+	contextName string
+	auth        *api.AuthConfig
+	store       credentialStore
+	now         func() time.Time
+
+	mu          sync.Mutex
+	credentials *oidcCredentials
+}
+
+var _ api.TokenSource = (*oidcTokenSource)(nil)
+
+func newOIDCTokenSource(contextName string, auth *api.AuthConfig, store credentialStore) *oidcTokenSource {
+	return &oidcTokenSource{
+		contextName: contextName,
+		auth:        auth,
+		store:       store,
+		now:         time.Now,
+	}
+}
+
+func (s *oidcTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.credentials == nil {
+		stored, err := s.store.RetrieveToken(s.auth.KeyringKey())
+		if err != nil {
+			if errors.Is(err, &keyring.ErrSecretNotFound{}) {
+				return "", fmt.Errorf("not logged in - run 'construct context login %s'", s.contextName)
+			}
+			return "", err
+		}
+
+		var credentials oidcCredentials
+		if err := json.Unmarshal([]byte(stored), &credentials); err != nil {
+			return "", fmt.Errorf("failed to parse stored login credentials: %w", err)
+		}
+		s.credentials = &credentials
+	}
+
+	if s.now().Add(oidcRefreshMargin).Before(s.credentials.Expiry) {
+		return s.credentials.IDToken, nil
+	}
+
+	if s.credentials.RefreshToken == "" {
+		return "", fmt.Errorf("login expired - run 'construct context login %s'", s.contextName)
+	}
+
+	config, err := oidcConfig(ctx, s.auth)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := config.TokenSource(ctx, &oauth2.Token{RefreshToken: s.credentials.RefreshToken}).Token()
+	if err != nil {
+		return "", fmt.Errorf("failed to refresh login, run 'construct context login %s': %w", s.contextName, err)
+	}
+
+	credentials, err := oidcCredentialsFromToken(token)
+	if err != nil {
+		return "", err
+	}
+	if err := storeOIDCCredentials(s.store, s.auth.KeyringKey(), credentials); err != nil {
+		return "", err
+	}
+
+	s.credentials = credentials
+	return credentials.IDToken, nil
+}
+
+func storeOIDCCredentials(store credentialStore, key string, credentials *oidcCredentials) error {
+	encoded, err := json.Marshal(credentials)
+	if err != nil {
+		return fmt.Errorf("failed to encode login credentials: %w", err)
+	}
+
+	if err := store.StoreToken(key, string(encoded)); err != nil {
+		return fmt.Errorf("failed to store login credentials in keyring: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/furisto/construct/api/go/client"
+	"github.com/furisto/construct/shared"
+	"github.com/furisto/construct/shared/keyring"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+)
+
+// testOIDCIssuer is a stand-in for an OpenID Connect issuer that supports the device authorization
+// flow and refresh tokens.
+type testOIDCIssuer struct {
+	*httptest.Server
+	expiry time.Time
+	grants []string
+}
+
+func newTestOIDCIssuer(t *testing.T) *testOIDCIssuer {
+	t.Helper()
+
+	issuer := &testOIDCIssuer{expiry: time.Now().Add(time.Hour).Truncate(time.Second)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                        issuer.URL,
+			"authorization_endpoint":        issuer.URL + "/authorize",
+			"token_endpoint":                issuer.URL + "/token",
+			"device_authorization_endpoint": issuer.URL + "/device",
+			"jwks_uri":                      issuer.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"device_code":               "device-123",
+			"user_code":                 "WXYZ-1234",
+			"verification_uri":          issuer.URL + "/activate",
+			"verification_uri_complete": issuer.URL + "/activate?code=WXYZ-1234",
+			"expires_in":                60,
+			"interval":                  1,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		issuer.grants = append(issuer.grants, r.Form.Get("grant_type"))
+
+		refreshToken := "refresh-1"
+		if r.Form.Get("grant_type") == "refresh_token" {
+			refreshToken = "refresh-2"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"access_token":  "access",
+			"token_type":    "Bearer",
+			"expires_in":    3600,
+			"refresh_token": refreshToken,
+			"id_token":      testIDToken(issuer.expiry),
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+
+	return issuer
+}
+
+// testIDToken builds an unsigned ID token. The CLI does not verify ID tokens, the daemon does.
+func testIDToken(expiry time.Time) string {
+	encode := func(v any) string {
+		b, _ := json.Marshal(v)
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+	return encode(map[string]any{"alg": "none"}) + "." +
+		encode(map[string]any{"sub": "alice", "email": "alice@example.com", "exp": expiry.Unix()}) + ".sig"
+}
+
+func TestOIDCDeviceLogin(t *testing.T) {
+	issuer := newTestOIDCIssuer(t)
+
+	var out bytes.Buffer
+	credentials, err := oidcDeviceLogin(context.Background(), &out, &api.AuthConfig{
+		Type:     api.AuthTypeOIDC,
+		Issuer:   issuer.URL,
+		ClientID: "construct-cli",
+	})
+	if err != nil {
+		t.Fatalf("oidcDeviceLogin() error = %v", err)
+	}
+
+	want := &oidcCredentials{
+		IDToken:      testIDToken(issuer.expiry),
+		RefreshToken: "refresh-1",
+		Expiry:       issuer.expiry,
+	}
+	if diff := cmp.Diff(want, credentials); diff != "" {
+		t.Errorf("oidcDeviceLogin() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantOut := "To log in, open " + issuer.URL + "/activate?code=WXYZ-1234 and confirm the code WXYZ-1234\n"
+	if out.String() != wantOut {
+		t.Errorf("oidcDeviceLogin() output = %q, want %q", out.String(), wantOut)
+	}
+}
+
+func TestOIDCTokenSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		stored      *oidcCredentials
+		wantToken   func(issuer *testOIDCIssuer) string
+		wantStored  func(issuer *testOIDCIssuer) *oidcCredentials
+		wantGrants  []string
+		wantErr     string
+		notLoggedIn bool
+	}{
+		{
+			name: "valid token is used as is",
+			stored: &oidcCredentials{
+				IDToken:      "stored-token",
+				RefreshToken: "refresh-1",
+				Expiry:       time.Now().Add(time.Hour),
+			},
+			wantToken: func(*testOIDCIssuer) string { return "stored-token" },
+		},
+		{
+			name: "expiring token is refreshed",
+			stored: &oidcCredentials{
+				IDToken:      "stored-token",
+				RefreshToken: "refresh-1",
+				Expiry:       time.Now().Add(30 * time.Second),
+			},
+			wantToken: func(issuer *testOIDCIssuer) string { return testIDToken(issuer.expiry) },
+			wantStored: func(issuer *testOIDCIssuer) *oidcCredentials {
+				return &oidcCredentials{IDToken: testIDToken(issuer.expiry), RefreshToken: "refresh-2", Expiry: issuer.expiry}
+			},
+			wantGrants: []string{"refresh_token"},
+		},
+		{
+			name: "expired token without refresh token",
+			stored: &oidcCredentials{
+				IDToken: "stored-token",
+				Expiry:  time.Now().Add(-time.Minute),
+			},
+			wantErr: "login expired - run 'construct context login production'",
+		},
+		{
+			name:        "not logged in",
+			notLoggedIn: true,
+			wantErr:     "not logged in - run 'construct context login production'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			issuer := newTestOIDCIssuer(t)
+
+			userInfo := mocks.NewMockUserInfo(ctrl)
+			mockKeyring := mocks.NewMockProvider(ctrl)
+			if tt.notLoggedIn {
+				mockKeyring.EXPECT().Get("production").Return("", &keyring.ErrSecretNotFound{Key: "production"})
+			} else {
+				stored, _ := json.Marshal(tt.stored)
+				mockKeyring.EXPECT().Get("production").Return(string(stored), nil)
+			}
+
+			var stored *oidcCredentials
+			if tt.wantStored != nil {
+				mockKeyring.EXPECT().Set("production", gomock.Any()).DoAndReturn(func(key, value string) error {
+					stored = &oidcCredentials{}
+					return json.Unmarshal([]byte(value), stored)
+				})
+			}
+
+			contextManager := shared.NewContextManagerWithKeyring(&afero.Afero{Fs: afero.NewMemMapFs()}, userInfo, mockKeyring)
+			source := newOIDCTokenSource("production", &api.AuthConfig{
+				Type:     api.AuthTypeOIDC,
+				TokenRef: api.KeyringRefPrefix + "production",
+				Issuer:   issuer.URL,
+				ClientID: "construct-cli",
+			}, contextManager)
+
+			token, err := source.Token(context.Background())
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Token() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Token() error = %v", err)
+			}
+
+			if want := tt.wantToken(issuer); token != want {
+				t.Errorf("Token() = %q, want %q", token, want)
+			}
+			if tt.wantStored != nil {
+				if diff := cmp.Diff(tt.wantStored(issuer), stored); diff != "" {
+					t.Errorf("stored credentials mismatch (-want +got):\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tt.wantGrants, issuer.grants); diff != "" {
+				t.Errorf("token requests mismatch (-want +got):\n%s", diff)
+			}
+
+			// The credentials are cached, so the keyring is not read again.
+			if again, err := source.Token(context.Background()); err != nil || again != token {
+				t.Errorf("second Token() = %q, %v, want %q", again, err, token)
+			}
+		})
+	}
+}
+
+func TestContextLogin(t *testing.T) {
+	setup := &TestSetup{}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "error - first login without issuer",
+			Command: []string{"context", "login", "production"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				setupContextFile(t, fs, &api.EndpointContexts{
+					Contexts: map[string]api.EndpointContext{
+						"production": {Address: "https://construct.example.com:8443", Kind: "http"},
+					},
+				})
+			},
+			Expected: TestExpectation{
+				Error: "--issuer and --client-id are required for the first login",
+			},
+		},
+		{
+			Name:    "error - unix socket context",
+			Command: []string{"context", "login", "local", "--issuer", "https://login.example.com", "--client-id", "construct-cli"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				setupContextFile(t, fs, &api.EndpointContexts{
+					Contexts: map[string]api.EndpointContext{
+						"local": {Address: "/home/user/.construct/construct.sock", Kind: "unix"},
+					},
+				})
+			},
+			Expected: TestExpectation{
+				Error: "context \"local\" connects over a Unix socket, which does not need a login",
+			},
+		},
+		{
+			Name:    "error - unknown context",
+			Command: []string{"context", "login", "staging"},
+			SetupFileSystem: func(fs *afero.Afero) {
+				fs.MkdirAll("/home/user/.construct", 0700)
+			},
+			Expected: TestExpectation{
+				Error: "context \"staging\" not found",
+			},
+		},
+	})
+}
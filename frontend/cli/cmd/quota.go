@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type quotaOptions struct {
+	Subject       string
+	RenderOptions RenderOptions
+}
+
+func NewQuotaCmd() *cobra.Command {
+	var options quotaOptions
+
+	cmd := &cobra.Command{
+		Use:   "quota [flags]",
+		Short: "Show the quota and usage of an identity",
+		Long: `Show the quota and usage of an identity.
+
+Quotas are set on tokens with 'construct daemon token create' and limit the
+concurrently running tasks, the spend per day and month and the tokens per day.
+Days and months are counted in UTC. Calls that would exceed a quota fail with a
+resource exhausted error until the usage resets.
+
+Without --subject the quota of the identity you are connected as is shown. Only
+admins can look at the quota of other identities.`,
+		Example: `  # Show your own quota
+  construct quota
+
+  # Show the quota of the ci token
+  construct quota --subject ci`,
+		Args:    cobra.NoArgs,
+		GroupID: "system",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.GetQuotaUsageRequest{}
+			if options.Subject != "" {
+				req.Subject = &options.Subject
+			}
+
+			resp, err := client.Quota().GetQuotaUsage(cmd.Context(), &connect.Request[v1.GetQuotaUsageRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get quota usage: %w", err)
+			}
+
+			return getRenderer(cmd.Context()).Render(ConvertQuotaUsageToDisplay(resp.Msg), &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().StringVar(&options.Subject, "subject", "", "Show the quota of this identity instead of your own")
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
+
+type QuotaUsageDisplay struct {
+	Subject        string `json:"subject" detail:"default"`
+	RunningTasks   string `json:"running_tasks" detail:"default"`
+	DailySpend     string `json:"daily_spend" detail:"default"`
+	MonthlySpend   string `json:"monthly_spend" detail:"default"`
+	DailyTokens    string `json:"daily_tokens" detail:"default"`
+	DailyResetAt   string `json:"daily_reset_at,omitempty" detail:"full"`
+	MonthlyResetAt string `json:"monthly_reset_at,omitempty" detail:"full"`
+}
+
+func ConvertQuotaUsageToDisplay(resp *v1.GetQuotaUsageResponse) *QuotaUsageDisplay {
+	quota, usage := resp.GetQuota(), resp.GetUsage()
+
+	display := &QuotaUsageDisplay{
+		Subject:      resp.Subject,
+		RunningTasks: formatQuotaUsage(fmt.Sprintf("%d", usage.GetRunningTasks()), fmt.Sprintf("%d", quota.GetMaxConcurrentTasks()), quota.GetMaxConcurrentTasks() > 0),
+		DailySpend:   formatQuotaUsage(fmt.Sprintf("$%.2f", usage.GetDailySpend()), fmt.Sprintf("$%.2f", quota.GetDailySpend()), quota.GetDailySpend() > 0),
+		MonthlySpend: formatQuotaUsage(fmt.Sprintf("$%.2f", usage.GetMonthlySpend()), fmt.Sprintf("$%.2f", quota.GetMonthlySpend()), quota.GetMonthlySpend() > 0),
+		DailyTokens:  formatQuotaUsage(fmt.Sprintf("%d", usage.GetDailyTokens()), fmt.Sprintf("%d", quota.GetDailyTokens()), quota.GetDailyTokens() > 0),
+	}
+
+	if usage.GetDailyResetAt() != nil {
+		display.DailyResetAt = usage.GetDailyResetAt().AsTime().Format(time.RFC3339)
+	}
+	if usage.GetMonthlyResetAt() != nil {
+		display.MonthlyResetAt = usage.GetMonthlyResetAt().AsTime().Format(time.RFC3339)
+	}
+
+	return display
+}
+
+func formatQuotaUsage(used, max string, limited bool) string {
+	if !limited {
+		return used + " (unlimited)"
+	}
+	return used + " / " + max
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestQuota(t *testing.T) {
+	setup := &TestSetup{}
+
+	dailyReset := time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)
+	monthlyReset := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	usage := &v1.QuotaUsage{
+		RunningTasks:   1,
+		DailySpend:     2.5,
+		MonthlySpend:   12.75,
+		DailyTokens:    4200,
+		DailyResetAt:   timestamppb.New(dailyReset),
+		MonthlyResetAt: timestamppb.New(monthlyReset),
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - own quota",
+			Command: []string{"quota"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Quota.EXPECT().GetQuotaUsage(
+					gomock.Any(),
+					connect.NewRequest(&v1.GetQuotaUsageRequest{}),
+				).Return(&connect.Response[v1.GetQuotaUsageResponse]{
+					Msg: &v1.GetQuotaUsageResponse{
+						Subject: "ci",
+						Quota:   &v1.Quota{MaxConcurrentTasks: 2, DailySpend: 10},
+						Usage:   usage,
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &QuotaUsageDisplay{
+					Subject:        "ci",
+					RunningTasks:   "1 / 2",
+					DailySpend:     "$2.50 / $10.00",
+					MonthlySpend:   "$12.75 (unlimited)",
+					DailyTokens:    "4200 (unlimited)",
+					DailyResetAt:   "2025-03-16T00:00:00Z",
+					MonthlyResetAt: "2025-04-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			Name:    "success - quota of another subject",
+			Command: []string{"quota", "--subject", "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Quota.EXPECT().GetQuotaUsage(
+					gomock.Any(),
+					connect.NewRequest(&v1.GetQuotaUsageRequest{Subject: stringPtr("ci")}),
+				).Return(&connect.Response[v1.GetQuotaUsageResponse]{
+					Msg: &v1.GetQuotaUsageResponse{
+						Subject: "ci",
+						Usage:   usage,
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &QuotaUsageDisplay{
+					Subject:        "ci",
+					RunningTasks:   "1 (unlimited)",
+					DailySpend:     "$2.50 (unlimited)",
+					MonthlySpend:   "$12.75 (unlimited)",
+					DailyTokens:    "4200 (unlimited)",
+					DailyResetAt:   "2025-03-16T00:00:00Z",
+					MonthlyResetAt: "2025-04-01T00:00:00Z",
+				},
+			},
+		},
+		{
+			Name:    "error - permission denied",
+			Command: []string{"quota", "--subject", "alice"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Quota.EXPECT().GetQuotaUsage(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodePermissionDenied, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to get quota usage: permission_denied",
+			},
+		},
+	})
+}
@@ -109,10 +109,13 @@ func NewRootCmd() *cobra.Command {
 	cmd.AddCommand(NewModelCmd())
 	cmd.AddCommand(NewModelProviderCmd())
 	cmd.AddCommand(NewSkillCmd())
+	cmd.AddCommand(NewWebhookCmd())
 
 	cmd.AddCommand(NewContextCmd())
 	cmd.AddCommand(NewConfigCmd())
 	cmd.AddCommand(NewDaemonCmd())
+	cmd.AddCommand(NewAuditCmd())
+	cmd.AddCommand(NewQuotaCmd())
 	cmd.AddCommand(NewInfoCmd())
 	cmd.AddCommand(NewUpdateCmd())
 	return cmd
@@ -174,7 +177,7 @@ func setAPIClient(ctx context.Context, cmd *cobra.Command, contextOverride strin
 		return fmt.Errorf("context %q not found", contextName)
 	}
 
-	clientOptions, err := buildClientOptions(endpointContext, contextManager)
+	clientOptions, err := buildClientOptions(contextName, endpointContext, contextManager)
 	if err != nil {
 		return fmt.Errorf("failed to configure client: %w", err)
 	}
@@ -201,15 +204,20 @@ func resolveContextName(flagValue, configValue string) string {
 	return configValue
 }
 
-func buildClientOptions(endpointContext api.EndpointContext, contextManager *shared.ContextManager) ([]api.ClientOption, error) {
+func buildClientOptions(contextName string, endpointContext api.EndpointContext, contextManager *shared.ContextManager) ([]api.ClientOption, error) {
 	var options []api.ClientOption
 
+	if endpointContext.Auth != nil && endpointContext.Auth.Type == api.AuthTypeOIDC {
+		options = append(options, api.WithTokenSource(newOIDCTokenSource(contextName, endpointContext.Auth, contextManager)))
+		return options, nil
+	}
+
 	if endpointContext.Auth != nil && endpointContext.Auth.IsConfigured() {
 		token, err := resolveToken(endpointContext.Auth, contextManager)
 		if err != nil {
 			return nil, fmt.Errorf("failed to resolve auth token: %w", err)
 		}
-		options = append(options, api.WithAuthToken(token))
+		options = append(options, api.WithTokenSource(newRotatingTokenSource(contextName, endpointContext, contextManager, token)))
 	}
 
 	return options, nil
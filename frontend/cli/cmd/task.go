@@ -19,6 +19,9 @@ func NewTaskCmd() *cobra.Command {
 	cmd.AddCommand(NewTaskGetCmd())
 	cmd.AddCommand(NewTaskListCmd())
 	cmd.AddCommand(NewTaskDeleteCmd())
+	cmd.AddCommand(NewTaskShareCmd())
+	cmd.AddCommand(NewTaskUnshareCmd())
+	cmd.AddCommand(NewTaskTraceCmd())
 
 	return cmd
 }
@@ -28,6 +31,8 @@ type DisplayTask struct {
 	Description string           `json:"description,omitempty" yaml:"description,omitempty" detail:"default"`
 	AgentId     string           `json:"agent_id" yaml:"agent_id" detail:"default"`
 	Workspace   string           `json:"workspace" yaml:"workspace" detail:"default"`
+	Owner       string           `json:"owner,omitempty" yaml:"owner,omitempty"`
+	SharedWith  []string         `json:"shared_with,omitempty" yaml:"shared_with,omitempty"`
 	CreatedAt   time.Time        `json:"created_at" yaml:"created_at"`
 	UpdatedAt   time.Time        `json:"updated_at" yaml:"updated_at"`
 	Usage       DisplayTaskUsage `json:"usage" yaml:"usage"`
@@ -40,6 +45,8 @@ type DisplayTaskUsage struct {
 	CacheReadTokens  int64            `json:"cache_read_tokens" yaml:"cache_read_tokens"`
 	Cost             float64          `json:"cost" yaml:"cost"`
 	ToolUses         map[string]int64 `json:"tool_uses" yaml:"tool_uses"`
+	RoutedTurns      int64            `json:"routed_turns,omitempty" yaml:"routed_turns,omitempty"`
+	RoutingSavings   float64          `json:"routing_savings,omitempty" yaml:"routing_savings,omitempty"`
 }
 
 func ConvertTaskToDisplay(task *v1.Task) *DisplayTask {
@@ -53,6 +60,8 @@ func ConvertTaskToDisplay(task *v1.Task) *DisplayTask {
 		Description: task.Spec.Description,
 		AgentId:     PtrToString(task.Spec.AgentId),
 		Workspace:   task.Spec.Workspace,
+		Owner:       task.Metadata.Owner,
+		SharedWith:  task.Spec.SharedWith,
 		Usage:       usage,
 		CreatedAt:   task.Metadata.CreatedAt.AsTime(),
 		UpdatedAt:   task.Metadata.UpdatedAt.AsTime(),
@@ -70,5 +79,7 @@ func ConvertTaskUsageToDisplay(usage *v1.TaskUsage) DisplayTaskUsage {
 		CacheReadTokens:  usage.CacheReadTokens,
 		Cost:             usage.Cost,
 		ToolUses:         usage.ToolUses,
+		RoutedTurns:      usage.RoutedTurns,
+		RoutingSavings:   usage.RoutingSavings,
 	}
 }
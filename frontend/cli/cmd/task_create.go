@@ -12,8 +12,9 @@ import (
 )
 
 type taskCreateOptions struct {
-	Agent     string
-	Workspace string
+	Agent           string
+	Workspace       string
+	TraceModelCalls bool
 }
 
 func NewTaskCreateCmd() *cobra.Command {
@@ -31,7 +32,10 @@ use construct new.`,
   construct task create --agent coder
 
   # Create a task with a specific workspace
-  construct task create --agent sql-expert --workspace /path/to/db/repo`,
+  construct task create --agent sql-expert --workspace /path/to/db/repo
+
+  # Create a task whose raw model calls can be inspected with 'construct task trace'
+  construct task create --agent coder --trace-model-calls`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := getAPIClient(cmd.Context())
 			fs := getFileSystem(cmd.Context())
@@ -69,6 +73,7 @@ use construct new.`,
 				Msg: &v1.CreateTaskRequest{
 					AgentId:          agentID,
 					ProjectDirectory: options.Workspace,
+					TraceModelCalls:  options.TraceModelCalls,
 				},
 			}
 
@@ -84,6 +89,7 @@ use construct new.`,
 
 	cmd.Flags().StringVarP(&options.Agent, "agent", "a", "", "The agent to assign to the task (required)")
 	cmd.Flags().StringVarP(&options.Workspace, "workspace", "w", "", "The workspace directory for the task")
+	cmd.Flags().BoolVar(&options.TraceModelCalls, "trace-model-calls", false, "Capture the raw requests and responses of the task's model calls")
 
 	cmd.MarkFlagRequired("agent")
 
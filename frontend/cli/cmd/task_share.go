@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewTaskShareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share <task-id> <subject>...",
+		Short: "Give other subjects access to a task",
+		Long: `Give other subjects access to a task.
+
+Tasks are only visible to the subject that created them. Sharing a task lets the given subjects,
+e.g. the names of API tokens or local users connecting as unix:<user>, read and continue it.
+Only the owner of a task can share it.`,
+		Args: cobra.MinimumNArgs(2),
+		Example: `  # Share a task with the holder of the "ci" token
+  construct task share 01974c1d-0be8-70e1-88b4-ad9462fff25e ci
+
+  # Share a task with a local user
+  construct task share 01974c1d-0be8-70e1-88b4-ad9462fff25e unix:alice`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			taskID := args[0]
+			for _, subject := range args[1:] {
+				_, err := client.Task().ShareTask(cmd.Context(), &connect.Request[v1.ShareTaskRequest]{
+					Msg: &v1.ShareTaskRequest{TaskId: taskID, Subject: subject},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to share task %s with %s: %w", taskID, subject, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
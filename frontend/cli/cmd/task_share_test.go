@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskShare(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - share task with one subject",
+			Command: []string{"task", "share", taskID, "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskShareMock(mockClient, taskID, "ci")
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "success - share task with multiple subjects",
+			Command: []string{"task", "share", taskID, "ci", "unix:alice"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskShareMock(mockClient, taskID, "ci")
+				setupTaskShareMock(mockClient, taskID, "unix:alice")
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "error - missing subject",
+			Command: []string{"task", "share", taskID},
+			Expected: TestExpectation{
+				Error: "requires at least 2 arg(s), only received 1",
+			},
+		},
+		{
+			Name:    "error - share task API failure",
+			Command: []string{"task", "share", taskID, "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().ShareTask(
+					gomock.Any(),
+					&connect.Request[v1.ShareTaskRequest]{
+						Msg: &v1.ShareTaskRequest{TaskId: taskID, Subject: "ci"},
+					},
+				).Return(nil, connect.NewError(connect.CodePermissionDenied, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to share task " + taskID + " with ci: permission_denied",
+			},
+		},
+	})
+}
+
+func setupTaskShareMock(mockClient *api_client.MockClient, taskID, subject string) {
+	mockClient.Task.EXPECT().ShareTask(
+		gomock.Any(),
+		&connect.Request[v1.ShareTaskRequest]{
+			Msg: &v1.ShareTaskRequest{TaskId: taskID, Subject: subject},
+		},
+	).Return(&connect.Response[v1.ShareTaskResponse]{
+		Msg: &v1.ShareTaskResponse{},
+	}, nil)
+}
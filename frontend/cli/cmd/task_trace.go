@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type taskTraceOptions struct {
+	Limit         int32
+	Enable        bool
+	Disable       bool
+	RenderOptions RenderOptions
+}
+
+func NewTaskTraceCmd() *cobra.Command {
+	var options taskTraceOptions
+
+	cmd := &cobra.Command{
+		Use:   "trace <task-id> [flags]",
+		Short: "Show the raw requests that a task sent to model providers",
+		Args:  cobra.ExactArgs(1),
+		Long: `Show the raw requests that a task sent to model providers.
+
+Lists the HTTP exchanges of the task's model calls, oldest first, as they were sent
+over the wire: the request body, the status and the headers and body of the response.
+API keys and other credentials in the headers are redacted. Use --wide to include the
+headers and bodies.
+
+Model calls are only captured while tracing is enabled, either for the task with
+--enable or for all tasks with the daemon.trace_model_calls setting. The daemon keeps a
+limited number of calls per task and deletes old calls.`,
+		Example: `  # Capture the model calls of a task
+  construct task trace 01974c1d-0be8-70e1-88b4-ad9462fff25e --enable
+
+  # Show the request and response of the last model call
+  construct task trace 01974c1d-0be8-70e1-88b4-ad9462fff25e --limit 1 --wide
+
+  # Export all captured model calls
+  construct task trace 01974c1d-0be8-70e1-88b4-ad9462fff25e --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+			taskID := args[0]
+
+			if options.Enable && options.Disable {
+				return errors.New("--enable and --disable cannot be used together")
+			}
+
+			if options.Enable || options.Disable {
+				_, err := client.Task().UpdateTask(cmd.Context(), &connect.Request[v1.UpdateTaskRequest]{
+					Msg: &v1.UpdateTaskRequest{
+						Id:              taskID,
+						TraceModelCalls: &options.Enable,
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to update task %s: %w", taskID, err)
+				}
+
+				if options.Enable {
+					cmd.Printf("Model calls of task %s are traced\n", taskID)
+				} else {
+					cmd.Printf("Model calls of task %s are no longer traced\n", taskID)
+				}
+				return nil
+			}
+
+			req := &v1.GetModelCallTraceRequest{TaskId: taskID}
+			if options.Limit > 0 {
+				req.Limit = &options.Limit
+			}
+
+			resp, err := client.Task().GetModelCallTrace(cmd.Context(), &connect.Request[v1.GetModelCallTraceRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get model call trace of task %s: %w", taskID, err)
+			}
+
+			displayCalls := make([]*DisplayModelCallTrace, 0, len(resp.Msg.Calls))
+			for _, call := range resp.Msg.Calls {
+				displayCalls = append(displayCalls, ConvertModelCallTraceToDisplay(call))
+			}
+
+			return getRenderer(cmd.Context()).Render(displayCalls, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Int32Var(&options.Limit, "limit", 0, "Show only the most recent model calls (1-100)")
+	cmd.Flags().BoolVar(&options.Enable, "enable", false, "Start capturing the model calls of the task")
+	cmd.Flags().BoolVar(&options.Disable, "disable", false, "Stop capturing the model calls of the task")
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
+
+type DisplayModelCallTrace struct {
+	CreatedAt       time.Time         `json:"created_at" yaml:"created_at" detail:"default"`
+	Model           string            `json:"model" yaml:"model" detail:"default"`
+	Method          string            `json:"method" yaml:"method" detail:"default"`
+	URL             string            `json:"url" yaml:"url" detail:"default"`
+	Status          int32             `json:"status" yaml:"status" detail:"default"`
+	Duration        string            `json:"duration" yaml:"duration" detail:"default"`
+	Error           string            `json:"error,omitempty" yaml:"error,omitempty" detail:"default"`
+	Truncated       bool              `json:"truncated,omitempty" yaml:"truncated,omitempty" detail:"full"`
+	RequestHeaders  map[string]string `json:"request_headers,omitempty" yaml:"request_headers,omitempty" detail:"full"`
+	RequestBody     string            `json:"request_body,omitempty" yaml:"request_body,omitempty" detail:"full"`
+	ResponseHeaders map[string]string `json:"response_headers,omitempty" yaml:"response_headers,omitempty" detail:"full"`
+	ResponseBody    string            `json:"response_body,omitempty" yaml:"response_body,omitempty" detail:"full"`
+}
+
+func ConvertModelCallTraceToDisplay(call *v1.ModelCallTrace) *DisplayModelCallTrace {
+	return &DisplayModelCallTrace{
+		CreatedAt:       call.CreatedAt.AsTime(),
+		Model:           call.Model,
+		Method:          call.Method,
+		URL:             call.Url,
+		Status:          call.StatusCode,
+		Duration:        (time.Duration(call.DurationMs) * time.Millisecond).String(),
+		Error:           call.Error,
+		Truncated:       call.Truncated,
+		RequestHeaders:  call.RequestHeaders,
+		RequestBody:     string(call.RequestBody),
+		ResponseHeaders: call.ResponseHeaders,
+		ResponseBody:    string(call.ResponseBody),
+	}
+}
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared/conv"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTaskTrace(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+	createdAt := time.Now().UTC()
+
+	call := &v1.ModelCallTrace{
+		Id:             uuid.New().String(),
+		CreatedAt:      timestamppb.New(createdAt),
+		Model:          "claude-sonnet-4-5",
+		Method:         "POST",
+		Url:            "https://api.anthropic.com/v1/messages",
+		RequestHeaders: map[string]string{"X-Api-Key": "[REDACTED]"},
+		RequestBody:    []byte(`{"model":"claude-sonnet-4-5"}`),
+		StatusCode:     400,
+		ResponseBody:   []byte(`{"error":"invalid tool schema"}`),
+		DurationMs:     1500,
+	}
+
+	displayCall := &DisplayModelCallTrace{
+		CreatedAt:      createdAt,
+		Model:          "claude-sonnet-4-5",
+		Method:         "POST",
+		URL:            "https://api.anthropic.com/v1/messages",
+		Status:         400,
+		Duration:       "1.5s",
+		RequestHeaders: map[string]string{"X-Api-Key": "[REDACTED]"},
+		RequestBody:    `{"model":"claude-sonnet-4-5"}`,
+		ResponseBody:   `{"error":"invalid tool schema"}`,
+	}
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - show model calls",
+			Command: []string{"task", "trace", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelCallTraceMock(mockClient, &v1.GetModelCallTraceRequest{TaskId: taskID}, call)
+			},
+			Expected: TestExpectation{
+				DisplayFormat: &RenderOptions{
+					Format: OutputFormatCard,
+				},
+				DisplayedObjects: []*DisplayModelCallTrace{displayCall},
+			},
+		},
+		{
+			Name:    "success - show most recent model call",
+			Command: []string{"task", "trace", taskID, "--limit", "1", "--output", "json"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupModelCallTraceMock(mockClient, &v1.GetModelCallTraceRequest{TaskId: taskID, Limit: conv.Ptr(int32(1))}, call)
+			},
+			Expected: TestExpectation{
+				DisplayFormat: &RenderOptions{
+					Format: OutputFormatJSON,
+				},
+				DisplayedObjects: []*DisplayModelCallTrace{displayCall},
+			},
+		},
+		{
+			Name:    "success - enable tracing",
+			Command: []string{"task", "trace", taskID, "--enable"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskTraceUpdateMock(mockClient, taskID, true)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintf("Model calls of task %s are traced\n", taskID)),
+			},
+		},
+		{
+			Name:    "success - disable tracing",
+			Command: []string{"task", "trace", taskID, "--disable"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupTaskTraceUpdateMock(mockClient, taskID, false)
+			},
+			Expected: TestExpectation{
+				Stdout: conv.Ptr(fmt.Sprintf("Model calls of task %s are no longer traced\n", taskID)),
+			},
+		},
+		{
+			Name:    "error - enable and disable",
+			Command: []string{"task", "trace", taskID, "--enable", "--disable"},
+			Expected: TestExpectation{
+				Error: "--enable and --disable cannot be used together",
+			},
+		},
+		{
+			Name:    "error - task not found",
+			Command: []string{"task", "trace", taskID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().GetModelCallTrace(
+					gomock.Any(),
+					&connect.Request[v1.GetModelCallTraceRequest]{
+						Msg: &v1.GetModelCallTraceRequest{TaskId: taskID},
+					},
+				).Return(nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("task not found")))
+			},
+			Expected: TestExpectation{
+				Error: fmt.Sprintf("failed to get model call trace of task %s: not_found: task not found", taskID),
+			},
+		},
+	})
+}
+
+func setupModelCallTraceMock(mockClient *api_client.MockClient, req *v1.GetModelCallTraceRequest, calls ...*v1.ModelCallTrace) {
+	mockClient.Task.EXPECT().GetModelCallTrace(
+		gomock.Any(),
+		&connect.Request[v1.GetModelCallTraceRequest]{Msg: req},
+	).Return(&connect.Response[v1.GetModelCallTraceResponse]{
+		Msg: &v1.GetModelCallTraceResponse{Calls: calls},
+	}, nil)
+}
+
+func setupTaskTraceUpdateMock(mockClient *api_client.MockClient, taskID string, enabled bool) {
+	mockClient.Task.EXPECT().UpdateTask(
+		gomock.Any(),
+		&connect.Request[v1.UpdateTaskRequest]{
+			Msg: &v1.UpdateTaskRequest{
+				Id:              taskID,
+				TraceModelCalls: conv.Ptr(enabled),
+			},
+		},
+	).Return(&connect.Response[v1.UpdateTaskResponse]{
+		Msg: &v1.UpdateTaskResponse{
+			Task: &v1.Task{
+				Metadata: &v1.TaskMetadata{Id: taskID},
+				Spec:     &v1.TaskSpec{TraceModelCalls: enabled},
+			},
+		},
+	}, nil)
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewTaskUnshareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unshare <task-id> <subject>...",
+		Short: "Revoke the access of other subjects to a task",
+		Args:  cobra.MinimumNArgs(2),
+		Example: `  # Stop sharing a task with the holder of the "ci" token
+  construct task unshare 01974c1d-0be8-70e1-88b4-ad9462fff25e ci`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			taskID := args[0]
+			for _, subject := range args[1:] {
+				_, err := client.Task().UnshareTask(cmd.Context(), &connect.Request[v1.UnshareTaskRequest]{
+					Msg: &v1.UnshareTaskRequest{TaskId: taskID, Subject: subject},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to unshare task %s with %s: %w", taskID, subject, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
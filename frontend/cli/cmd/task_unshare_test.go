@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTaskUnshare(t *testing.T) {
+	setup := &TestSetup{}
+
+	taskID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - unshare task",
+			Command: []string{"task", "unshare", taskID, "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().UnshareTask(
+					gomock.Any(),
+					&connect.Request[v1.UnshareTaskRequest]{
+						Msg: &v1.UnshareTaskRequest{TaskId: taskID, Subject: "ci"},
+					},
+				).Return(&connect.Response[v1.UnshareTaskResponse]{
+					Msg: &v1.UnshareTaskResponse{},
+				}, nil)
+			},
+			Expected: TestExpectation{},
+		},
+		{
+			Name:    "error - task not shared with subject",
+			Command: []string{"task", "unshare", taskID, "ci"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Task.EXPECT().UnshareTask(
+					gomock.Any(),
+					&connect.Request[v1.UnshareTaskRequest]{
+						Msg: &v1.UnshareTaskRequest{TaskId: taskID, Subject: "ci"},
+					},
+				).Return(nil, connect.NewError(connect.CodeNotFound, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to unshare task " + taskID + " with ci: not_found",
+			},
+		},
+	})
+}
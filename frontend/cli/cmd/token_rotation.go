@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared"
+)
+
+// tokenRotator exchanges a token for its successor.
+type tokenRotator func(ctx context.Context, token string) (*v1.RotateTokenResponse, error)
+
+// rotatingTokenSource supplies the API token of a context and rotates it once the daemon announces
+// that it is close to expiry. The successor is written back to where the token was kept, so the
+// next command uses it. A failed rotation does not fail the request, the token is still valid.
+type rotatingTokenSource struct {
+	contextName    string
+	auth           *api.AuthConfig
+	contextManager *shared.ContextManager
+	rotate         tokenRotator
+	now            func() time.Time
+
+	mu    sync.Mutex
+	token string
+	// attempted is set after the first rotation attempt, so a command rotates the token at most
+	// once, even if the rotation failed.
+	attempted bool
+}
+
+var (
+	_ api.TokenSource      = (*rotatingTokenSource)(nil)
+	_ api.RotationObserver = (*rotatingTokenSource)(nil)
+)
+
+func newRotatingTokenSource(contextName string, endpointContext api.EndpointContext, contextManager *shared.ContextManager, token string) *rotatingTokenSource {
+	return &rotatingTokenSource{
+		contextName:    contextName,
+		auth:           endpointContext.Auth,
+		contextManager: contextManager,
+		rotate:         daemonTokenRotator(endpointContext),
+		now:            time.Now,
+		token:          token,
+	}
+}
+
+// daemonTokenRotator rotates tokens with a client of its own, so that the rotation does not go
+// through the token source that asked for it.
+func daemonTokenRotator(endpointContext api.EndpointContext) tokenRotator {
+	return func(ctx context.Context, token string) (*v1.RotateTokenResponse, error) {
+		client, err := api.NewClient(endpointContext, api.WithAuthToken(token))
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.Auth().RotateToken(ctx, connect.NewRequest(&v1.RotateTokenRequest{}))
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+}
+
+func (s *rotatingTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.attempted || s.auth.RotateAfter == nil || s.now().Before(*s.auth.RotateAfter) {
+		return s.token, nil
+	}
+	s.attempted = true
+
+	resp, err := s.rotate(ctx, s.token)
+	if err != nil {
+		slog.Warn("failed to rotate token", "context", s.contextName, "error", err)
+		return s.token, nil
+	}
+
+	if err := s.storeToken(resp.Token); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: the token of context %q was rotated, but the successor could not be saved: %v\n", s.contextName, err)
+		fmt.Fprintf(os.Stderr, "The current token stops working %s, add the context again with a new token before that.\n", FormatRelativeTime(resp.PreviousExpiresAt.AsTime()))
+		return s.token, nil
+	}
+
+	slog.Info("rotated token", "context", s.contextName, "expires_at", resp.ExpiresAt.AsTime())
+	s.token = resp.Token
+	s.auth.RotateAfter = nil
+	return s.token, nil
+}
+
+// storeToken saves the successor and forgets when to rotate, the daemon announces it again for
+// the successor.
+func (s *rotatingTokenSource) storeToken(token string) error {
+	if s.auth.TokenRef != "" {
+		if err := s.contextManager.StoreToken(s.auth.KeyringKey(), token); err != nil {
+			return fmt.Errorf("failed to store token in keyring: %w", err)
+		}
+	}
+
+	return s.updateAuth(func(auth *api.AuthConfig) {
+		if auth.TokenRef == "" {
+			auth.Token = token
+		}
+		auth.RotateAfter = nil
+	})
+}
+
+// ObserveRotateAfter remembers when the daemon wants the token to be rotated.
+func (s *rotatingTokenSource) ObserveRotateAfter(rotateAfter time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.auth.RotateAfter != nil && s.auth.RotateAfter.Equal(rotateAfter) {
+		return
+	}
+	s.auth.RotateAfter = &rotateAfter
+
+	err := s.updateAuth(func(auth *api.AuthConfig) {
+		auth.RotateAfter = &rotateAfter
+	})
+	if err != nil {
+		slog.Debug("failed to save token rotation time", "context", s.contextName, "error", err)
+	}
+}
+
+// updateAuth changes the auth config of the context as it is stored, so that changes that other
+// commands made in the meantime are kept.
+func (s *rotatingTokenSource) updateAuth(update func(auth *api.AuthConfig)) error {
+	endpointContext, err := s.contextManager.GetContext(s.contextName)
+	if err != nil {
+		return err
+	}
+	if !endpointContext.Auth.IsConfigured() || endpointContext.Auth.Type == api.AuthTypeOIDC {
+		return fmt.Errorf("context %q does not authenticate with a token anymore", s.contextName)
+	}
+
+	update(endpointContext.Auth)
+	_, err = s.contextManager.UpsertEndpointContext(s.contextName, *endpointContext, false)
+	return err
+}
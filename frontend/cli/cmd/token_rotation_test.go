@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	api "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/furisto/construct/shared"
+	"github.com/furisto/construct/shared/mocks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/spf13/afero"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRotatingTokenSource(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		auth        *api.AuthConfig
+		rotateErr   error
+		keyringSet  bool
+		wantToken   string
+		wantRotated int
+		wantStored  *api.AuthConfig
+	}{
+		{
+			name:      "token is not due for rotation",
+			auth:      &api.AuthConfig{Type: api.AuthTypeToken, TokenRef: api.KeyringRefPrefix + "production", RotateAfter: &future},
+			wantToken: "ct_current",
+		},
+		{
+			name:      "rotation time is unknown",
+			auth:      &api.AuthConfig{Type: api.AuthTypeToken, TokenRef: api.KeyringRefPrefix + "production"},
+			wantToken: "ct_current",
+		},
+		{
+			name:        "successor is stored in keyring",
+			auth:        &api.AuthConfig{Type: api.AuthTypeToken, TokenRef: api.KeyringRefPrefix + "production", RotateAfter: &past},
+			keyringSet:  true,
+			wantToken:   "ct_successor",
+			wantRotated: 1,
+			wantStored:  &api.AuthConfig{Type: api.AuthTypeToken, TokenRef: api.KeyringRefPrefix + "production"},
+		},
+		{
+			name:        "successor is stored in context",
+			auth:        &api.AuthConfig{Type: api.AuthTypeToken, Token: "ct_current", RotateAfter: &past},
+			wantToken:   "ct_successor",
+			wantRotated: 1,
+			wantStored:  &api.AuthConfig{Type: api.AuthTypeToken, Token: "ct_successor"},
+		},
+		{
+			name:        "failed rotation keeps token",
+			auth:        &api.AuthConfig{Type: api.AuthTypeToken, TokenRef: api.KeyringRefPrefix + "production", RotateAfter: &past},
+			rotateErr:   fmt.Errorf("unavailable"),
+			wantToken:   "ct_current",
+			wantRotated: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+			setupContextFile(t, fs, &api.EndpointContexts{
+				Contexts: map[string]api.EndpointContext{
+					"production": {Address: "https://construct.example.com:8443", Kind: "http", Auth: tt.auth},
+				},
+			})
+
+			userInfo := mocks.NewMockUserInfo(ctrl)
+			userInfo.EXPECT().ConstructConfigDir().Return("/home/user/.construct", nil).AnyTimes()
+			mockKeyring := mocks.NewMockProvider(ctrl)
+			if tt.keyringSet {
+				mockKeyring.EXPECT().Set("production", "ct_successor").Return(nil)
+			}
+			contextManager := shared.NewContextManagerWithKeyring(fs, userInfo, mockKeyring)
+
+			rotations := 0
+			source := &rotatingTokenSource{
+				contextName:    "production",
+				auth:           tt.auth,
+				contextManager: contextManager,
+				now:            func() time.Time { return now },
+				token:          "ct_current",
+				rotate: func(ctx context.Context, token string) (*v1.RotateTokenResponse, error) {
+					rotations++
+					if token != "ct_current" {
+						t.Errorf("rotate() token = %q, want ct_current", token)
+					}
+					if tt.rotateErr != nil {
+						return nil, tt.rotateErr
+					}
+					return &v1.RotateTokenResponse{
+						Token:             "ct_successor",
+						ExpiresAt:         timestamppb.New(now.Add(90 * 24 * time.Hour)),
+						PreviousExpiresAt: timestamppb.New(now.Add(24 * time.Hour)),
+					}, nil
+				},
+			}
+
+			// The token is rotated at most once per command.
+			for range 2 {
+				token, err := source.Token(context.Background())
+				if err != nil {
+					t.Fatalf("Token() error = %v", err)
+				}
+				if token != tt.wantToken {
+					t.Errorf("Token() = %q, want %q", token, tt.wantToken)
+				}
+			}
+			if rotations != tt.wantRotated {
+				t.Errorf("rotated %d times, want %d", rotations, tt.wantRotated)
+			}
+
+			if tt.wantStored != nil {
+				stored, err := contextManager.GetContext("production")
+				if err != nil {
+					t.Fatalf("GetContext() error = %v", err)
+				}
+				if diff := cmp.Diff(tt.wantStored, stored.Auth); diff != "" {
+					t.Errorf("stored auth mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRotatingTokenSource_ObserveRotateAfter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	fs := &afero.Afero{Fs: afero.NewMemMapFs()}
+	setupContextFile(t, fs, &api.EndpointContexts{
+		Contexts: map[string]api.EndpointContext{
+			"production": {Address: "https://construct.example.com:8443", Kind: "http", Auth: &api.AuthConfig{Type: api.AuthTypeToken, Token: "ct_current"}},
+		},
+	})
+
+	userInfo := mocks.NewMockUserInfo(ctrl)
+	userInfo.EXPECT().ConstructConfigDir().Return("/home/user/.construct", nil).AnyTimes()
+	contextManager := shared.NewContextManagerWithKeyring(fs, userInfo, mocks.NewMockProvider(ctrl))
+
+	endpointContext, err := contextManager.GetContext("production")
+	if err != nil {
+		t.Fatalf("GetContext() error = %v", err)
+	}
+	source := newRotatingTokenSource("production", *endpointContext, contextManager, "ct_current")
+
+	rotateAfter := time.Date(2026, 5, 24, 12, 0, 0, 0, time.UTC)
+	source.ObserveRotateAfter(rotateAfter)
+
+	stored, err := contextManager.GetContext("production")
+	if err != nil {
+		t.Fatalf("GetContext() error = %v", err)
+	}
+	if stored.Auth.RotateAfter == nil || !stored.Auth.RotateAfter.Equal(rotateAfter) {
+		t.Errorf("stored rotate after = %v, want %v", stored.Auth.RotateAfter, rotateAfter)
+	}
+}
@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewWebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Push task and message events to HTTP endpoints",
+		Long: `Manage webhooks that push events to HTTP endpoints.
+
+Every event that matches a webhook is posted to its URL as JSON. The body is signed
+with the secret of the webhook, the signature is sent in the X-Construct-Signature
+header as "t=<unix timestamp>,v1=<hex HMAC-SHA256 of '<timestamp>.<body>'>".
+Failed deliveries are retried with exponential backoff and are dead lettered if
+they keep failing.`,
+		Aliases: []string{"webhooks", "wh"},
+		GroupID: "resource",
+	}
+
+	cmd.AddCommand(NewWebhookCreateCmd())
+	cmd.AddCommand(NewWebhookGetCmd())
+	cmd.AddCommand(NewWebhookListCmd())
+	cmd.AddCommand(NewWebhookDeleteCmd())
+	cmd.AddCommand(NewWebhookDeliveriesCmd())
+	cmd.AddCommand(NewWebhookRedeliverCmd())
+
+	return cmd
+}
+
+type WebhookDisplay struct {
+	Id          string   `json:"id" yaml:"id" detail:"default"`
+	URL         string   `json:"url" yaml:"url" detail:"default"`
+	EventTypes  []string `json:"event_types,omitempty" yaml:"event_types,omitempty" detail:"default"`
+	Enabled     bool     `json:"enabled" yaml:"enabled" detail:"default"`
+	TaskID      string   `json:"task_id,omitempty" yaml:"task_id,omitempty" detail:"full"`
+	AgentID     string   `json:"agent_id,omitempty" yaml:"agent_id,omitempty" detail:"full"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty" detail:"full"`
+}
+
+func ConvertWebhookToDisplay(webhook *v1.Webhook) *WebhookDisplay {
+	if webhook == nil || webhook.Metadata == nil || webhook.Spec == nil {
+		return nil
+	}
+
+	return &WebhookDisplay{
+		Id:          webhook.Metadata.Id,
+		URL:         webhook.Spec.Url,
+		EventTypes:  webhook.Spec.EventTypes,
+		Enabled:     webhook.Spec.Enabled,
+		TaskID:      webhook.Spec.GetTaskId(),
+		AgentID:     webhook.Spec.GetAgentId(),
+		Description: webhook.Spec.Description,
+	}
+}
+
+type WebhookDeliveryDisplay struct {
+	Id          string `json:"id" yaml:"id" detail:"default"`
+	EventType   string `json:"event_type" yaml:"event_type" detail:"default"`
+	State       string `json:"state" yaml:"state" detail:"default"`
+	Attempts    int32  `json:"attempts" yaml:"attempts" detail:"default"`
+	StatusCode  int32  `json:"status_code,omitempty" yaml:"status_code,omitempty" detail:"default"`
+	Created     string `json:"created" yaml:"created" detail:"default"`
+	Sequence    int64  `json:"sequence" yaml:"sequence" detail:"full"`
+	LastAttempt string `json:"last_attempt,omitempty" yaml:"last_attempt,omitempty" detail:"full"`
+	NextAttempt string `json:"next_attempt,omitempty" yaml:"next_attempt,omitempty" detail:"full"`
+	LastError   string `json:"last_error,omitempty" yaml:"last_error,omitempty" detail:"full"`
+}
+
+func ConvertWebhookDeliveryToDisplay(delivery *v1.WebhookDelivery) *WebhookDeliveryDisplay {
+	display := &WebhookDeliveryDisplay{
+		Id:         delivery.Id,
+		EventType:  delivery.EventType,
+		State:      string(ConvertWebhookDeliveryStateToDisplay(delivery.State)),
+		Attempts:   delivery.Attempts,
+		StatusCode: delivery.ResponseStatusCode,
+		Sequence:   delivery.EventSequence,
+		LastError:  delivery.LastError,
+	}
+
+	if delivery.CreatedAt != nil {
+		display.Created = FormatRelativeTime(delivery.CreatedAt.AsTime())
+	}
+	if delivery.LastAttemptAt != nil {
+		display.LastAttempt = FormatRelativeTime(delivery.LastAttemptAt.AsTime())
+	}
+	if delivery.NextAttemptAt != nil {
+		display.NextAttempt = FormatRelativeTime(delivery.NextAttemptAt.AsTime())
+	}
+
+	return display
+}
+
+type WebhookDeliveryState string
+
+const (
+	WebhookDeliveryStatePending    WebhookDeliveryState = "pending"
+	WebhookDeliveryStateSucceeded  WebhookDeliveryState = "succeeded"
+	WebhookDeliveryStateDeadLetter WebhookDeliveryState = "dead-letter"
+	WebhookDeliveryStateUnknown    WebhookDeliveryState = "unknown"
+)
+
+func (e *WebhookDeliveryState) String() string {
+	return string(*e)
+}
+
+func (e *WebhookDeliveryState) Set(v string) error {
+	switch WebhookDeliveryState(strings.ToLower(strings.TrimSpace(v))) {
+	case WebhookDeliveryStatePending:
+		*e = WebhookDeliveryStatePending
+	case WebhookDeliveryStateSucceeded:
+		*e = WebhookDeliveryStateSucceeded
+	case WebhookDeliveryStateDeadLetter:
+		*e = WebhookDeliveryStateDeadLetter
+	default:
+		return errors.New(`must be one of "pending","succeeded","dead-letter"`)
+	}
+	return nil
+}
+
+func (e *WebhookDeliveryState) Type() string {
+	return "state"
+}
+
+// ToAPI returns nil if no state has been set.
+func (e *WebhookDeliveryState) ToAPI() *v1.WebhookDeliveryState {
+	var state v1.WebhookDeliveryState
+	switch *e {
+	case WebhookDeliveryStatePending:
+		state = v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_PENDING
+	case WebhookDeliveryStateSucceeded:
+		state = v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_SUCCEEDED
+	case WebhookDeliveryStateDeadLetter:
+		state = v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER
+	default:
+		return nil
+	}
+	return &state
+}
+
+func ConvertWebhookDeliveryStateToDisplay(state v1.WebhookDeliveryState) WebhookDeliveryState {
+	switch state {
+	case v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_PENDING:
+		return WebhookDeliveryStatePending
+	case v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_SUCCEEDED:
+		return WebhookDeliveryStateSucceeded
+	case v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER:
+		return WebhookDeliveryStateDeadLetter
+	}
+
+	return WebhookDeliveryStateUnknown
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookCreateOptions struct {
+	EventTypes    []string
+	Task          string
+	Agent         string
+	Secret        string
+	Description   string
+	RenderOptions RenderOptions
+}
+
+type WebhookCreateDisplay struct {
+	Id     string `json:"id" yaml:"id"`
+	URL    string `json:"url" yaml:"url"`
+	Secret string `json:"secret" yaml:"secret"`
+}
+
+func NewWebhookCreateCmd() *cobra.Command {
+	var options webhookCreateOptions
+
+	cmd := &cobra.Command{
+		Use:   "create <url> [flags]",
+		Short: "Create a webhook that receives matching events",
+		Args:  cobra.ExactArgs(1),
+		Long: `Create a webhook that receives matching events.
+
+Event types are glob patterns like "task.*" or "message.created", the same patterns
+an event subscription accepts. Without --event-type all events are delivered.
+If no secret is passed, a random secret is generated. The secret is shown only once.`,
+		Example: `  # Notify a CI gate whenever a task changes
+  construct webhook create https://ci.example.com/hooks/construct --event-type "task.*"
+
+  # Forward the messages of one agent to a chat bot
+  construct webhook create https://bot.example.com/construct --agent coder --event-type message.created`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.CreateWebhookRequest{
+				Url:         args[0],
+				EventTypes:  options.EventTypes,
+				Description: options.Description,
+			}
+
+			if options.Task != "" {
+				req.TaskId = &options.Task
+			}
+
+			if options.Agent != "" {
+				agentID, err := getAgentID(cmd.Context(), client, options.Agent)
+				if err != nil {
+					return fmt.Errorf("failed to resolve agent %s: %w", options.Agent, err)
+				}
+				req.AgentId = &agentID
+			}
+
+			if options.Secret != "" {
+				req.Secret = &options.Secret
+			}
+
+			resp, err := client.Webhook().CreateWebhook(cmd.Context(), &connect.Request[v1.CreateWebhookRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create webhook: %w", err)
+			}
+
+			display := &WebhookCreateDisplay{
+				Id:     resp.Msg.Webhook.Metadata.Id,
+				URL:    resp.Msg.Webhook.Spec.Url,
+				Secret: resp.Msg.Secret,
+			}
+
+			if err := getRenderer(cmd.Context()).Render(display, &options.RenderOptions); err != nil {
+				return err
+			}
+
+			if options.RenderOptions.Format == OutputFormatCard || options.RenderOptions.Format == "" {
+				fmt.Fprintln(os.Stderr, "")
+				fmt.Fprintln(os.Stderr, "⚠️  Save this secret securely - it cannot be retrieved again.")
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&options.EventTypes, "event-type", "e", nil, "Event type pattern to deliver, can be repeated (default: all events)")
+	cmd.Flags().StringVar(&options.Task, "task", "", "Only deliver the events of this task")
+	cmd.Flags().StringVar(&options.Agent, "agent", "", "Only deliver the events of this agent and its tasks")
+	cmd.Flags().StringVar(&options.Secret, "secret", "", "The secret that signs the payloads (default: generated)")
+	cmd.Flags().StringVarP(&options.Description, "description", "d", "", "Description of the webhook")
+	addRenderOptions(cmd, &options.RenderOptions)
+	WithCardFormat(&options.RenderOptions)
+
+	return cmd
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookCreate(t *testing.T) {
+	setup := &TestSetup{}
+
+	webhookID := uuid.New().String()
+	agentID := uuid.New().String()
+	url := "https://ci.example.com/hooks/construct"
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - agent filter and event types",
+			Command: []string{"webhook", "create", url, "--agent", "coder", "--event-type", "task.*", "-e", "message.created"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				setupAgentListMock(mockClient, "coder", agentID)
+				mockClient.Webhook.EXPECT().CreateWebhook(
+					gomock.Any(),
+					connect.NewRequest(&v1.CreateWebhookRequest{
+						Url:        url,
+						EventTypes: []string{"task.*", "message.created"},
+						AgentId:    &agentID,
+					}),
+				).Return(createWebhookResponse(webhookID, url, "whsec_generated"), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &WebhookCreateDisplay{
+					Id:     webhookID,
+					URL:    url,
+					Secret: "whsec_generated",
+				},
+			},
+		},
+		{
+			Name:    "success - explicit secret",
+			Command: []string{"webhook", "create", url, "--secret", "0123456789abcdef", "--description", "CI gate"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				secret := "0123456789abcdef"
+				mockClient.Webhook.EXPECT().CreateWebhook(
+					gomock.Any(),
+					connect.NewRequest(&v1.CreateWebhookRequest{
+						Url:         url,
+						Secret:      &secret,
+						Description: "CI gate",
+					}),
+				).Return(createWebhookResponse(webhookID, url, secret), nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: &WebhookCreateDisplay{
+					Id:     webhookID,
+					URL:    url,
+					Secret: "0123456789abcdef",
+				},
+			},
+		},
+		{
+			Name:    "error - create fails",
+			Command: []string{"webhook", "create", "ftp://example.com"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().CreateWebhook(gomock.Any(), gomock.Any()).
+					Return(nil, connect.NewError(connect.CodeInvalidArgument, nil))
+			},
+			Expected: TestExpectation{
+				Error: "failed to create webhook: invalid_argument",
+			},
+		},
+	})
+}
+
+func createWebhookResponse(id, url, secret string) *connect.Response[v1.CreateWebhookResponse] {
+	return &connect.Response[v1.CreateWebhookResponse]{
+		Msg: &v1.CreateWebhookResponse{
+			Webhook: &v1.Webhook{
+				Metadata: &v1.WebhookMetadata{Id: id},
+				Spec:     &v1.WebhookSpec{Url: url, Enabled: true},
+			},
+			Secret: secret,
+		},
+	}
+}
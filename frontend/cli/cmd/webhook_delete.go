@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookDeleteOptions struct {
+	Force bool
+}
+
+func NewWebhookDeleteCmd() *cobra.Command {
+	options := new(webhookDeleteOptions)
+	cmd := &cobra.Command{
+		Use:     "delete <id>... [flags]",
+		Short:   "Permanently delete one or more webhooks",
+		Args:    cobra.MinimumNArgs(1),
+		Aliases: []string{"rm"},
+		Long: `Permanently delete one or more webhooks.
+
+The delivery history of the webhooks is deleted as well and pending deliveries are dropped.`,
+		Example: `  # Delete a webhook without confirmation
+  construct webhook delete 01234567-89ab-cdef-0123-456789abcdef --force`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			if !options.Force && !confirmDeletion(cmd.InOrStdin(), cmd.OutOrStdout(), "webhook", args) {
+				return nil
+			}
+
+			for _, id := range args {
+				_, err := client.Webhook().DeleteWebhook(cmd.Context(), &connect.Request[v1.DeleteWebhookRequest]{
+					Msg: &v1.DeleteWebhookRequest{Id: id},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete webhook %s: %w", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVarP(&options.Force, "force", "f", false, "Skip the confirmation prompt")
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookDeliveriesOptions struct {
+	State         WebhookDeliveryState
+	Limit         int32
+	RenderOptions RenderOptions
+}
+
+func NewWebhookDeliveriesCmd() *cobra.Command {
+	var options webhookDeliveriesOptions
+
+	cmd := &cobra.Command{
+		Use:   "deliveries <webhook-id> [flags]",
+		Short: "Show the most recent deliveries of a webhook",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Show the recent deliveries of a webhook
+  construct webhook deliveries 01234567-89ab-cdef-0123-456789abcdef
+
+  # Show why deliveries were dead lettered
+  construct webhook deliveries 01234567-89ab-cdef-0123-456789abcdef --state dead-letter --wide`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			req := &v1.ListWebhookDeliveriesRequest{
+				WebhookId: args[0],
+				State:     options.State.ToAPI(),
+			}
+			if cmd.Flags().Changed("limit") {
+				req.PageSize = &options.Limit
+			}
+
+			resp, err := client.Webhook().ListWebhookDeliveries(cmd.Context(), &connect.Request[v1.ListWebhookDeliveriesRequest]{
+				Msg: req,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list deliveries of webhook %s: %w", args[0], err)
+			}
+
+			displayDeliveries := make([]*WebhookDeliveryDisplay, len(resp.Msg.Deliveries))
+			for i, delivery := range resp.Msg.Deliveries {
+				displayDeliveries[i] = ConvertWebhookDeliveryToDisplay(delivery)
+			}
+
+			return getRenderer(cmd.Context()).Render(displayDeliveries, &options.RenderOptions)
+		},
+	}
+
+	cmd.Flags().Var(&options.State, "state", "Only show deliveries in this state: pending, succeeded or dead-letter")
+	cmd.Flags().Int32VarP(&options.Limit, "limit", "n", 50, "Maximum number of deliveries to show (1-100)")
+	addRenderOptions(cmd, &options.RenderOptions)
+	return cmd
+}
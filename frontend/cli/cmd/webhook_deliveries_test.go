@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"testing"
+
+	"connectrpc.com/connect"
+	api_client "github.com/furisto/construct/api/go/client"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+	setup := &TestSetup{}
+
+	webhookID := uuid.New().String()
+	deliveryID := uuid.New().String()
+	deadLetter := v1.WebhookDeliveryState_WEBHOOK_DELIVERY_STATE_DEAD_LETTER
+	limit := int32(10)
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success - filter dead letters",
+			Command: []string{"webhook", "deliveries", webhookID, "--state", "dead-letter", "--limit", "10"},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().ListWebhookDeliveries(
+					gomock.Any(),
+					connect.NewRequest(&v1.ListWebhookDeliveriesRequest{
+						WebhookId: webhookID,
+						State:     &deadLetter,
+						PageSize:  &limit,
+					}),
+				).Return(&connect.Response[v1.ListWebhookDeliveriesResponse]{
+					Msg: &v1.ListWebhookDeliveriesResponse{
+						Deliveries: []*v1.WebhookDelivery{
+							{
+								Id:                 deliveryID,
+								WebhookId:          webhookID,
+								EventType:          "task.updated",
+								EventSequence:      42,
+								State:              deadLetter,
+								Attempts:           8,
+								ResponseStatusCode: 502,
+								LastError:          "receiver responded with 502 Bad Gateway",
+							},
+						},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{
+				DisplayedObjects: []*WebhookDeliveryDisplay{
+					{
+						Id:         deliveryID,
+						EventType:  "task.updated",
+						State:      "dead-letter",
+						Attempts:   8,
+						StatusCode: 502,
+						Sequence:   42,
+						LastError:  "receiver responded with 502 Bad Gateway",
+					},
+				},
+			},
+		},
+		{
+			Name:    "error - invalid state",
+			Command: []string{"webhook", "deliveries", webhookID, "--state", "failed"},
+			Expected: TestExpectation{
+				Error: `invalid argument "failed" for "--state" flag: must be one of "pending","succeeded","dead-letter"`,
+			},
+		},
+	})
+}
+
+func TestWebhookRedeliver(t *testing.T) {
+	setup := &TestSetup{}
+
+	deliveryID := uuid.New().String()
+
+	setup.RunTests(t, []TestScenario{
+		{
+			Name:    "success",
+			Command: []string{"webhook", "redeliver", deliveryID},
+			SetupMocks: func(mockClient *api_client.MockClient) {
+				mockClient.Webhook.EXPECT().RedeliverWebhookDelivery(
+					gomock.Any(),
+					connect.NewRequest(&v1.RedeliverWebhookDeliveryRequest{Id: deliveryID}),
+				).Return(&connect.Response[v1.RedeliverWebhookDeliveryResponse]{
+					Msg: &v1.RedeliverWebhookDeliveryResponse{
+						Delivery: &v1.WebhookDelivery{Id: deliveryID},
+					},
+				}, nil)
+			},
+			Expected: TestExpectation{},
+		},
+	})
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+type webhookGetOptions struct {
+	RenderOptions RenderOptions
+}
+
+func NewWebhookGetCmd() *cobra.Command {
+	var options webhookGetOptions
+
+	cmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a webhook by ID",
+		Args:  cobra.ExactArgs(1),
+		Example: `  # Get a webhook with all details
+  construct webhook get 01234567-89ab-cdef-0123-456789abcdef --output json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			resp, err := client.Webhook().GetWebhook(cmd.Context(), &connect.Request[v1.GetWebhookRequest]{
+				Msg: &v1.GetWebhookRequest{Id: args[0]},
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get webhook %s: %w", args[0], err)
+			}
+
+			return getRenderer(cmd.Context()).Render(ConvertWebhookToDisplay(resp.Msg.Webhook), &options.RenderOptions)
+		},
+	}
+
+	addRenderOptions(cmd, WithCardFormat(&options.RenderOptions))
+	return cmd
+}
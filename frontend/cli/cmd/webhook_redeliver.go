@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/furisto/construct/api/go/v1"
+	"github.com/spf13/cobra"
+)
+
+func NewWebhookRedeliverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeliver <delivery-id>...",
+		Short: "Deliver events again",
+		Args:  cobra.MinimumNArgs(1),
+		Long: `Deliver events again.
+
+The deliveries are scheduled for another round of attempts, e.g. after the receiver
+of dead lettered deliveries was fixed. The delivery ID stays the same, so that
+receivers can detect duplicates.`,
+		Example: `  # Retry a dead lettered delivery
+  construct webhook redeliver 01234567-89ab-cdef-0123-456789abcdef`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client := getAPIClient(cmd.Context())
+
+			for _, id := range args {
+				_, err := client.Webhook().RedeliverWebhookDelivery(cmd.Context(), &connect.Request[v1.RedeliverWebhookDeliveryRequest]{
+					Msg: &v1.RedeliverWebhookDeliveryRequest{Id: id},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to redeliver %s: %w", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
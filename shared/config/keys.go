@@ -24,6 +24,33 @@ func SupportedKeys() []string {
 		"cmd.resume",
 		"cmd.resume.recent_task_limit",
 
+		// Daemon
+		"daemon",
+		"daemon.trace_model_calls",
+		"daemon.trace_max_calls_per_task",
+		"daemon.trace_retention",
+		"daemon.metrics_auth",
+		"daemon.tracing_exporter",
+		"daemon.tracing_endpoint",
+		"daemon.tracing_file",
+		"daemon.event_retention",
+		"daemon.audit_retention",
+		"daemon.socket_users",
+		"daemon.socket_groups",
+		"daemon.socket_scopes",
+		"daemon.tls_cert",
+		"daemon.tls_key",
+		"daemon.tls_self_signed",
+		"daemon.tls_client_ca",
+		"daemon.oidc_issuer",
+		"daemon.oidc_audience",
+		"daemon.oidc_jwks_url",
+		"daemon.oidc_subject_claim",
+		"daemon.oidc_groups_claim",
+		"daemon.oidc_group_scopes",
+		"daemon.oidc_scopes",
+		"daemon.catalog_source",
+
 		// Logging
 		"log",
 		"log.level",
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/furisto/construct/shared"
@@ -161,6 +162,47 @@ func (v Value) Bool() (bool, bool) {
 	return false, false
 }
 
+// StringList returns a list of strings. Lists can be written as comma separated strings, which
+// is how 'construct config set' stores them, or as YAML sequences.
+func (v Value) StringList() ([]string, bool) {
+	switch raw := v.raw.(type) {
+	case string:
+		var list []string
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		return list, true
+	case []any:
+		list := make([]string, 0, len(raw))
+		for _, item := range raw {
+			str, ok := Value{raw: item}.scalarString()
+			if !ok {
+				return nil, false
+			}
+			list = append(list, str)
+		}
+		return list, true
+	default:
+		str, ok := v.scalarString()
+		if !ok {
+			return nil, false
+		}
+		return []string{str}, true
+	}
+}
+
+func (v Value) scalarString() (string, bool) {
+	if str, ok := v.String(); ok {
+		return str, true
+	}
+	if i, ok := v.Int(); ok {
+		return strconv.FormatInt(i, 10), true
+	}
+	return "", false
+}
+
 func (v Value) Raw() any {
 	return v.raw
 }
@@ -0,0 +1,234 @@
+package listener
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	api "github.com/furisto/construct/api/go/client"
+)
+
+const (
+	selfSignedCertFile = "cert.pem"
+	selfSignedKeyFile  = "key.pem"
+	selfSignedValidity = 365 * 24 * time.Hour
+	// selfSignedRenewal is how long before expiry a self-signed certificate is replaced.
+	selfSignedRenewal = 30 * 24 * time.Hour
+)
+
+// TLSOptions configures TLS for TCP listeners.
+type TLSOptions struct {
+	// CertFile and KeyFile are the PEM encoded certificate and key of the daemon.
+	CertFile string
+	KeyFile  string
+	// SelfSignedDir is where a self-signed certificate is generated and kept if no certificate
+	// is given. Clients have to pin its fingerprint.
+	SelfSignedDir string
+	// ClientCAFile enables mutual TLS. Client certificates signed by one of these certificate
+	// authorities are verified and can be used to authenticate.
+	ClientCAFile string
+}
+
+func (o TLSOptions) Enabled() bool {
+	return o.CertFile != "" || o.SelfSignedDir != ""
+}
+
+func (o TLSOptions) Validate() error {
+	if (o.CertFile == "") != (o.KeyFile == "") {
+		return fmt.Errorf("certificate and key must be specified together")
+	}
+
+	if o.CertFile != "" && o.SelfSignedDir != "" {
+		return fmt.Errorf("a certificate cannot be combined with a self-signed certificate")
+	}
+
+	if o.ClientCAFile != "" && !o.Enabled() {
+		return fmt.Errorf("client certificate authorities require a certificate or a self-signed certificate")
+	}
+
+	return nil
+}
+
+// ServerConfig loads or generates the certificate and builds the configuration for crypto/tls.
+// hosts are added to a generated certificate as subject alternative names.
+func (o TLSOptions) ServerConfig(hosts []string) (*tls.Config, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+
+	var certificate tls.Certificate
+	var err error
+	if o.CertFile != "" {
+		certificate, err = tls.LoadX509KeyPair(o.CertFile, o.KeyFile)
+	} else {
+		certificate, err = LoadOrCreateSelfSignedCertificate(o.SelfSignedDir, hosts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if o.ClientCAFile != "" {
+		content, err := os.ReadFile(o.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", o.ClientCAFile)
+		}
+		config.ClientCAs = pool
+		// Clients without a certificate can still authenticate with a token.
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return config, nil
+}
+
+// Fingerprint returns the fingerprint of the leaf certificate of the configuration.
+func Fingerprint(config *tls.Config) string {
+	if len(config.Certificates) == 0 || len(config.Certificates[0].Certificate) == 0 {
+		return ""
+	}
+	return api.CertificateFingerprint(config.Certificates[0].Certificate[0])
+}
+
+// LoadOrCreateSelfSignedCertificate loads the self-signed certificate from dir. A new one is
+// generated if there is none yet, or if it is about to expire.
+func LoadOrCreateSelfSignedCertificate(dir string, hosts []string) (tls.Certificate, error) {
+	certFile := filepath.Join(dir, selfSignedCertFile)
+	keyFile := filepath.Join(dir, selfSignedKeyFile)
+
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err == nil && time.Until(certificate.Leaf.NotAfter) > selfSignedRenewal {
+		return certificate, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return tls.Certificate{}, fmt.Errorf("failed to load self-signed certificate: %w", err)
+	}
+
+	certPEM, keyPEM, err := generateSelfSignedCertificate(hosts)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to create certificate directory: %w", err)
+	}
+	if err := os.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to write self-signed key: %w", err)
+	}
+	if err := os.WriteFile(certFile, certPEM, 0644); err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to write self-signed certificate: %w", err)
+	}
+
+	return tls.X509KeyPair(certPEM, keyPEM)
+}
+
+func generateSelfSignedCertificate(hosts []string) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Construct"}, CommonName: "construct daemon"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(selfSignedValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else if host != "" {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to encode key: %w", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
+
+// CertificateHosts returns the names a certificate for the listen address should be valid for.
+// Wildcard addresses are valid for all names of the machine.
+func CertificateHosts(address string) []string {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+
+	ip := net.ParseIP(host)
+	if host != "" && (ip == nil || !ip.IsUnspecified()) {
+		return []string{host}
+	}
+
+	hosts := []string{"localhost", "127.0.0.1", "::1"}
+	if hostname, err := os.Hostname(); err == nil {
+		hosts = append(hosts, hostname)
+	}
+	return hosts
+}
+
+// TLSProvider serves TLS on the listener of another provider.
+type TLSProvider struct {
+	Provider
+	config *tls.Config
+}
+
+var _ Provider = (*TLSProvider)(nil)
+
+func NewTLSProvider(provider Provider, config *tls.Config) *TLSProvider {
+	return &TLSProvider{
+		Provider: provider,
+		config:   config,
+	}
+}
+
+func (p *TLSProvider) Create() (net.Listener, error) {
+	listener, err := p.Provider.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	if listener.Addr().Network() == "unix" {
+		listener.Close()
+		return nil, fmt.Errorf("tls is only supported for tcp listeners")
+	}
+
+	return tls.NewListener(listener, p.config), nil
+}
@@ -0,0 +1,113 @@
+package listener
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+
+	api "github.com/furisto/construct/api/go/client"
+)
+
+func TestLoadOrCreateSelfSignedCertificate(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := LoadOrCreateSelfSignedCertificate(dir, []string{"localhost", "127.0.0.1"})
+	if err != nil {
+		t.Fatalf("LoadOrCreateSelfSignedCertificate() failed: %v", err)
+	}
+
+	leaf := first.Leaf
+	if leaf == nil {
+		t.Fatal("generated certificate has no leaf")
+	}
+	if len(leaf.DNSNames) != 1 || leaf.DNSNames[0] != "localhost" {
+		t.Errorf("DNSNames = %v, want [localhost]", leaf.DNSNames)
+	}
+	if len(leaf.IPAddresses) != 1 || leaf.IPAddresses[0].String() != "127.0.0.1" {
+		t.Errorf("IPAddresses = %v, want [127.0.0.1]", leaf.IPAddresses)
+	}
+
+	second, err := LoadOrCreateSelfSignedCertificate(dir, []string{"localhost"})
+	if err != nil {
+		t.Fatalf("LoadOrCreateSelfSignedCertificate() failed on reload: %v", err)
+	}
+	if api.CertificateFingerprint(first.Certificate[0]) != api.CertificateFingerprint(second.Certificate[0]) {
+		t.Error("reloading generated a new certificate, want the existing one")
+	}
+}
+
+func TestTLSProvider_FingerprintPinning(t *testing.T) {
+	config, err := TLSOptions{SelfSignedDir: t.TempDir()}.ServerConfig([]string{"127.0.0.1"})
+	if err != nil {
+		t.Fatalf("ServerConfig() failed: %v", err)
+	}
+
+	provider := NewTLSProvider(NewTCPListenerProvider("127.0.0.1:0"), config)
+	listener, err := provider.Create()
+	if err != nil {
+		t.Fatalf("Create() failed: %v", err)
+	}
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	fingerprint := Fingerprint(config)
+	if !strings.HasPrefix(fingerprint, api.FingerprintPrefix) {
+		t.Fatalf("Fingerprint() = %q, want prefix %q", fingerprint, api.FingerprintPrefix)
+	}
+
+	tests := []struct {
+		name        string
+		fingerprint string
+		wantErr     bool
+	}{
+		{
+			name:        "pinned fingerprint",
+			fingerprint: fingerprint,
+		},
+		{
+			name:        "pinned fingerprint in openssl format",
+			fingerprint: opensslFingerprint(fingerprint),
+		},
+		{
+			name:        "wrong fingerprint",
+			fingerprint: api.FingerprintPrefix + strings.Repeat("00", 32),
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientConfig, err := (&api.TLSConfig{Fingerprint: tt.fingerprint}).ClientConfig()
+			if err != nil {
+				t.Fatalf("ClientConfig() failed: %v", err)
+			}
+
+			conn, err := tls.Dial("tcp", listener.Addr().String(), clientConfig)
+			if err == nil {
+				conn.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Dial() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func opensslFingerprint(fingerprint string) string {
+	hex := strings.ToUpper(strings.TrimPrefix(fingerprint, api.FingerprintPrefix))
+	pairs := make([]string, 0, len(hex)/2)
+	for i := 0; i < len(hex); i += 2 {
+		pairs = append(pairs, hex[i:i+2])
+	}
+	return strings.Join(pairs, ":")
+}
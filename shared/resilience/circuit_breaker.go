@@ -25,6 +25,19 @@ const (
 	CircuitHalfOpen
 )
 
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
 func NewCircuitBreaker(provider string, threshold int, resetTimeout time.Duration) *CircuitBreaker {
 	return &CircuitBreaker{
 		provider:         provider,
@@ -54,6 +67,18 @@ func (cb *CircuitBreaker) Allow() bool {
 	}
 }
 
+// State returns the current state of the circuit. An open circuit whose reset timeout has
+// passed is reported as half open, because the next call is let through.
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.RLock()
+	defer cb.mu.RUnlock()
+
+	if cb.state == CircuitOpen && time.Now().After(cb.reopenAt) {
+		return CircuitHalfOpen
+	}
+	return cb.state
+}
+
 func (cb *CircuitBreaker) RecordResult(err error) {
 	cb.mu.Lock()
 	defer cb.mu.Unlock()
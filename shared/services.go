@@ -98,15 +98,17 @@ func (m *ContextManager) ListContexts() ([]string, error) {
 }
 
 func (m *ContextManager) UpsertContext(contextName string, kind string, address string, setCurrent bool, auth *api.AuthConfig) (bool, error) {
-	endpointContexts, err := m.LoadContext()
-	if err != nil {
-		return false, err
-	}
-
-	endpointContext := api.EndpointContext{
+	return m.UpsertEndpointContext(contextName, api.EndpointContext{
 		Address: address,
 		Kind:    kind,
 		Auth:    auth,
+	}, setCurrent)
+}
+
+func (m *ContextManager) UpsertEndpointContext(contextName string, endpointContext api.EndpointContext, setCurrent bool) (bool, error) {
+	endpointContexts, err := m.LoadContext()
+	if err != nil {
+		return false, err
 	}
 
 	if err := endpointContext.Validate(); err != nil {